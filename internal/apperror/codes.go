@@ -24,6 +24,9 @@ const (
 	CodeDefaultPlaylist      ErrorCode = "DEFAULT_PLAYLIST"        // 409
 	CodeCharacterInUse       ErrorCode = "CHARACTER_IN_USE"        // 409
 	CodeBgmInUse             ErrorCode = "BGM_IN_USE"              // 409
+	CodeSoundEffectInUse     ErrorCode = "SOUND_EFFECT_IN_USE"     // 409
+	CodeImportConflict       ErrorCode = "IMPORT_CONFLICT"         // 409
+	CodeRateLimited          ErrorCode = "RATE_LIMITED"            // 429
 	CodeCanceled             ErrorCode = "CANCELED"                // 499
 	CodeInternal             ErrorCode = "INTERNAL_ERROR"          // 500
 	CodeGenerationFailed     ErrorCode = "GENERATION_FAILED"       // 500
@@ -63,6 +66,11 @@ var (
 	ErrDefaultPlaylist   = newError(CodeDefaultPlaylist, "デフォルト再生リストは変更できません", http.StatusConflict)
 	ErrCharacterInUse    = newError(CodeCharacterInUse, "このキャラクターは使用中です", http.StatusConflict)
 	ErrBgmInUse          = newError(CodeBgmInUse, "この BGM は使用中です", http.StatusConflict)
+	ErrSoundEffectInUse  = newError(CodeSoundEffectInUse, "この効果音は使用中です", http.StatusConflict)
+	ErrImportConflict    = newError(CodeImportConflict, "取り込み先に存在しないデータがあるため取り込めません", http.StatusConflict)
+
+	// 429 Too Many Requests
+	ErrRateLimited = newError(CodeRateLimited, "リクエストが多すぎます。しばらくしてから再度お試しください", http.StatusTooManyRequests)
 
 	// 499 Client Closed Request（キャンセル）
 	ErrCanceled = newError(CodeCanceled, "ジョブがキャンセルされました", 499)
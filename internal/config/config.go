@@ -2,6 +2,7 @@ package config
 
 import (
 	"os"
+	"strconv"
 	"strings"
 )
 
@@ -13,6 +14,15 @@ const (
 	EnvDevelopment Env = "development"
 )
 
+// StorageBackend はメディアファイルの保存先を表す型
+type StorageBackend string
+
+const (
+	StorageBackendGCS   StorageBackend = "gcs"
+	StorageBackendS3    StorageBackend = "s3"
+	StorageBackendLocal StorageBackend = "local"
+)
+
 // DBLogLevel はデータベースのログレベルを表す型
 type DBLogLevel string
 
@@ -23,18 +33,53 @@ const (
 	DBLogLevelInfo   DBLogLevel = "info"
 )
 
+// MediaAudioDelivery は安定 URL（/media/audio/:audioId）での音声の配信方式を表す型
+type MediaAudioDelivery string
+
+const (
+	// 署名付き URL へリダイレクトする
+	MediaAudioDeliveryRedirect MediaAudioDelivery = "redirect"
+	// バックエンドがストレージから読み込んで配信する（Range / ETag 対応）
+	MediaAudioDeliveryProxy MediaAudioDelivery = "proxy"
+)
+
 // Config はアプリケーション設定
 type Config struct {
-	Port                                string
-	DatabaseURL                         string
-	DBLogLevel                          DBLogLevel
-	AppEnv                              Env
-	AuthSecret                          string
-	CORSAllowedOrigins                  []string
-	OpenAIAPIKey                        string
-	GoogleCloudProjectID                string
-	GoogleCloudCredentialsJSON          string
-	GoogleCloudStorageBucketName        string
+	Port                         string
+	DatabaseURL                  string
+	DBLogLevel                   DBLogLevel
+	AppEnv                       Env
+	AuthSecret                   string
+	CORSAllowedOrigins           []string
+	OpenAIAPIKey                 string
+	GoogleCloudProjectID         string
+	GoogleCloudCredentialsJSON   string
+	GoogleCloudStorageBucketName string
+	// API の公開ベース URL（RSS フィードなど外部に渡す URL に使用、空の場合は http://localhost:{PORT}/api/v1）
+	PublicAPIBaseURL string
+	// Web フロントエンドの公開ベース URL（デフォルト: http://localhost:3210）
+	PublicWebBaseURL string
+	// メディアファイルの保存先（gcs / s3 / local、デフォルト: gcs）
+	StorageBackend StorageBackend
+	// S3 互換ストレージのバケット名
+	S3Bucket string
+	// S3 互換ストレージのリージョン（空の場合は AWS のデフォルト設定）
+	S3Region string
+	// S3 互換ストレージのエンドポイント（MinIO など、空の場合は AWS）
+	S3Endpoint string
+	// S3 互換ストレージの署名付き URL に使うエンドポイント（空の場合は S3_ENDPOINT）
+	S3PublicEndpoint string
+	// S3 互換ストレージのアクセスキー（空の場合は AWS のデフォルトの認証情報チェーン）
+	S3AccessKeyID     string
+	S3SecretAccessKey string
+	// S3 互換ストレージでパス形式の URL を使うか（MinIO では true）
+	S3UsePathStyle bool
+	// ローカルストレージの保存先ディレクトリ（デフォルト: tmp/storage）
+	LocalStorageRoot string
+	// ローカルストレージの署名付き URL のベース URL（空の場合は http://localhost:{PORT}/api/v1）
+	LocalStorageBaseURL string
+	// ローカルストレージの署名付き URL の署名用シークレット（空の場合は AUTH_SECRET を使用）
+	StorageSigningSecret                string
 	GoogleCloudTasksLocation            string
 	GoogleCloudTasksQueueName           string
 	GoogleCloudTasksServiceAccountEmail string
@@ -65,6 +110,16 @@ type Config struct {
 	TraceMode string
 	// ElevenLabs API キー
 	ElevenLabsAPIKey string
+	// 配信用音声のフォーマットとビットレート（format:bitrateKbps のカンマ区切り、none で無効）
+	AudioRenditions []string
+	// 生成した MP3 の ID3 タグに台本を歌詞（USLT）として埋め込むか
+	AudioID3Lyrics bool
+	// 台本行プレビュー・ボイス試聴の 1 ユーザーあたり 1 分間のリクエスト上限（合算）
+	TTSPreviewRateLimitPerMinute int
+	// ポッドキャスト取り込みでループバック・プライベートアドレスのフィードの取得を許可するか（ローカル開発用）
+	ImportAllowPrivateNetwork bool
+	// 安定 URL（/media/audio/:audioId）での音声の配信方式（redirect / proxy、デフォルト: redirect）
+	MediaAudioDelivery MediaAudioDelivery
 }
 
 // Load は環境変数から設定を読み込む
@@ -80,6 +135,19 @@ func Load() *Config {
 		GoogleCloudProjectID:                getEnv("GOOGLE_CLOUD_PROJECT_ID", ""),
 		GoogleCloudCredentialsJSON:          getEnv("GOOGLE_CLOUD_CREDENTIALS_JSON", ""),
 		GoogleCloudStorageBucketName:        getEnv("GOOGLE_CLOUD_STORAGE_BUCKET_NAME", ""),
+		PublicAPIBaseURL:                    getEnv("PUBLIC_API_BASE_URL", ""),
+		PublicWebBaseURL:                    getEnv("PUBLIC_WEB_BASE_URL", "http://localhost:3210"),
+		StorageBackend:                      StorageBackend(getEnv("STORAGE_BACKEND", string(StorageBackendGCS))),
+		S3Bucket:                            getEnv("S3_BUCKET", ""),
+		S3Region:                            getEnv("S3_REGION", ""),
+		S3Endpoint:                          getEnv("S3_ENDPOINT", ""),
+		S3PublicEndpoint:                    getEnv("S3_PUBLIC_ENDPOINT", ""),
+		S3AccessKeyID:                       getEnv("S3_ACCESS_KEY_ID", ""),
+		S3SecretAccessKey:                   getEnv("S3_SECRET_ACCESS_KEY", ""),
+		S3UsePathStyle:                      getEnvAsBool("S3_USE_PATH_STYLE", false),
+		LocalStorageRoot:                    getEnv("LOCAL_STORAGE_ROOT", "tmp/storage"),
+		LocalStorageBaseURL:                 getEnv("LOCAL_STORAGE_BASE_URL", ""),
+		StorageSigningSecret:                getEnv("STORAGE_SIGNING_SECRET", ""),
 		GoogleCloudTasksLocation:            getEnv("GOOGLE_CLOUD_TASKS_LOCATION", "asia-northeast1"),
 		GoogleCloudTasksQueueName:           getEnv("GOOGLE_CLOUD_TASKS_QUEUE_NAME", "audio-generation-queue"),
 		GoogleCloudTasksServiceAccountEmail: getEnv("GOOGLE_CLOUD_TASKS_SERVICE_ACCOUNT_EMAIL", ""),
@@ -97,7 +165,20 @@ func Load() *Config {
 		SlackRegistrationWebhookURL:         getEnv("SLACK_REGISTRATION_WEBHOOK_URL", ""),
 		TraceMode:                           getEnv("TRACE_MODE", "none"),
 		ElevenLabsAPIKey:                    getEnv("ELEVENLABS_API_KEY", ""),
+		AudioRenditions:                     getEnvAsSlice("AUDIO_RENDITIONS", []string{"mp3:128", "aac:64", "opus:32"}),
+		AudioID3Lyrics:                      getEnvAsBool("AUDIO_ID3_LYRICS", false),
+		TTSPreviewRateLimitPerMinute:        getEnvAsInt("TTS_PREVIEW_RATE_LIMIT_PER_MINUTE", 20),
+		ImportAllowPrivateNetwork:           getEnvAsBool("IMPORT_ALLOW_PRIVATE_NETWORK", false),
+		MediaAudioDelivery:                  MediaAudioDelivery(getEnv("MEDIA_AUDIO_DELIVERY", string(MediaAudioDeliveryRedirect))),
+	}
+}
+
+// APIBaseURL は API の公開ベース URL を返す（PUBLIC_API_BASE_URL が空の場合は http://localhost:{PORT}/api/v1）
+func (c *Config) APIBaseURL() string {
+	if c.PublicAPIBaseURL != "" {
+		return strings.TrimSuffix(c.PublicAPIBaseURL, "/")
 	}
+	return "http://localhost:" + c.Port + "/api/v1"
 }
 
 // 環境変数を取得し、未設定の場合はデフォルト値を返す
@@ -109,6 +190,26 @@ func getEnv(key, defaultValue string) string {
 	return defaultValue
 }
 
+// 環境変数を真偽値として取得し、未設定または不正な値の場合はデフォルト値を返す
+func getEnvAsBool(key string, defaultValue bool) bool {
+	value, err := strconv.ParseBool(os.Getenv(key))
+	if err != nil {
+		return defaultValue
+	}
+
+	return value
+}
+
+// 環境変数を整数として取得し、未設定または不正な値の場合はデフォルト値を返す
+func getEnvAsInt(key string, defaultValue int) int {
+	value, err := strconv.Atoi(os.Getenv(key))
+	if err != nil {
+		return defaultValue
+	}
+
+	return value
+}
+
 // 環境変数をカンマ区切りで分割してスライスとして取得する
 func getEnvAsSlice(key string, defaultValue []string) []string {
 	value := os.Getenv(key)
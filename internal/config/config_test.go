@@ -50,6 +50,20 @@ func TestLoad(t *testing.T) {
 	})
 }
 
+func TestConfig_APIBaseURL(t *testing.T) {
+	t.Run("PUBLIC_API_BASE_URL が未設定の場合はローカルの URL を返す", func(t *testing.T) {
+		cfg := &Config{Port: "8081"}
+
+		assert.Equal(t, "http://localhost:8081/api/v1", cfg.APIBaseURL())
+	})
+
+	t.Run("PUBLIC_API_BASE_URL が設定されている場合は末尾のスラッシュを除いて返す", func(t *testing.T) {
+		cfg := &Config{Port: "8081", PublicAPIBaseURL: "https://api.example.com/api/v1/"}
+
+		assert.Equal(t, "https://api.example.com/api/v1", cfg.APIBaseURL())
+	})
+}
+
 func TestGetEnv(t *testing.T) {
 	t.Run("環境変数が設定されている場合はその値を返す", func(t *testing.T) {
 		t.Setenv("TEST_VAR", "test_value")
@@ -115,3 +129,43 @@ func TestGetEnvAsSlice(t *testing.T) {
 		assert.Equal(t, []string{"single"}, result)
 	})
 }
+
+func TestGetEnvAsBool(t *testing.T) {
+	t.Run("true を指定した場合は true を返す", func(t *testing.T) {
+		t.Setenv("TEST_BOOL", "true")
+
+		assert.True(t, getEnvAsBool("TEST_BOOL", false))
+	})
+
+	t.Run("環境変数が未設定の場合はデフォルト値を返す", func(t *testing.T) {
+		t.Setenv("TEST_BOOL", "")
+
+		assert.True(t, getEnvAsBool("TEST_BOOL", true))
+	})
+
+	t.Run("不正な値の場合はデフォルト値を返す", func(t *testing.T) {
+		t.Setenv("TEST_BOOL", "yes")
+
+		assert.False(t, getEnvAsBool("TEST_BOOL", false))
+	})
+}
+
+func TestGetEnvAsInt(t *testing.T) {
+	t.Run("整数を指定した場合はその値を返す", func(t *testing.T) {
+		t.Setenv("TEST_INT", "30")
+
+		assert.Equal(t, 30, getEnvAsInt("TEST_INT", 20))
+	})
+
+	t.Run("環境変数が未設定の場合はデフォルト値を返す", func(t *testing.T) {
+		t.Setenv("TEST_INT", "")
+
+		assert.Equal(t, 20, getEnvAsInt("TEST_INT", 20))
+	})
+
+	t.Run("不正な値の場合はデフォルト値を返す", func(t *testing.T) {
+		t.Setenv("TEST_INT", "abc")
+
+		assert.Equal(t, 20, getEnvAsInt("TEST_INT", 20))
+	})
+}
@@ -3,7 +3,9 @@ package di
 import (
 	"context"
 	"errors"
+	"fmt"
 	"os"
+	"time"
 
 	"gorm.io/gorm"
 
@@ -21,6 +23,8 @@ import (
 	"github.com/siropaca/anycast-backend/internal/pkg/crypto"
 	"github.com/siropaca/anycast-backend/internal/pkg/jwt"
 	"github.com/siropaca/anycast-backend/internal/pkg/logger"
+	"github.com/siropaca/anycast-backend/internal/pkg/ratelimit"
+	"github.com/siropaca/anycast-backend/internal/pkg/safehttp"
 	"github.com/siropaca/anycast-backend/internal/pkg/tracer"
 	"github.com/siropaca/anycast-backend/internal/repository"
 	"github.com/siropaca/anycast-backend/internal/service"
@@ -46,6 +50,8 @@ type Container struct {
 	ImageHandler           *handler.ImageHandler
 	AudioHandler           *handler.AudioHandler
 	BgmHandler             *handler.BgmHandler
+	SoundEffectHandler     *handler.SoundEffectHandler
+	SfxCueHandler          *handler.SfxCueHandler
 	AudioJobHandler        *handler.AudioJobHandler
 	WorkerHandler          *handler.WorkerHandler
 	WebSocketHandler       *handler.WebSocketHandler
@@ -59,10 +65,21 @@ type Container struct {
 	SearchHandler          *handler.SearchHandler
 	UserHandler            *handler.UserHandler
 	APIKeyHandler          *handler.APIKeyHandler
+	PronunciationHandler   *handler.PronunciationHandler
+	FeedHandler            *handler.FeedHandler
+	PrivateFeedHandler     *handler.PrivateFeedHandler
+	ImportJobHandler       *handler.ImportJobHandler
+	ClipJobHandler         *handler.ClipJobHandler
+	EpisodePackageHandler  *handler.EpisodePackageHandler
+	ChannelArchiveHandler  *handler.ChannelArchiveHandler
+	MediaHandler           *handler.MediaHandler
+	ShareHandler           *handler.ShareHandler
+	StorageHandler         *handler.StorageHandler // ローカルストレージ使用時のみ設定
 	TokenManager           jwt.TokenManager
 	UserRepository         repository.UserRepository
 	APIKeyService          service.APIKeyService
 	WebSocketHub           *websocket.Hub
+	TTSPreviewLimiter      *ratelimit.Limiter
 	closers                []closer
 }
 
@@ -129,12 +146,15 @@ func NewContainer(ctx context.Context, db *gorm.DB, cfg *config.Config) *Contain
 		}
 	}
 
-	// Storage クライアント（GCS）
-	storageClient, err := storage.NewGCSClient(ctx, cfg.GoogleCloudStorageBucketName, cfg.GoogleCloudCredentialsJSON)
+	// Storage クライアント（STORAGE_BACKEND で切り替え）
+	storageClient, err := NewStorageClient(ctx, cfg, cfg.StorageBackend)
 	if err != nil {
 		log.Error("failed to create storage client", "error", err)
 		os.Exit(1)
 	}
+	log.Info("Storage backend selected", "backend", cfg.StorageBackend)
+	// ローカルストレージの場合のみ設定（署名付き URL の配信ルートで使用）
+	localStorageClient, _ := storageClient.(storage.LocalClient)
 
 	// TTS クライアント（レジストリパターン）
 	ttsRegistry := tts.NewRegistry()
@@ -227,6 +247,13 @@ func NewContainer(ctx context.Context, db *gorm.DB, cfg *config.Config) *Contain
 	// FFmpeg サービス
 	ffmpegService := service.NewFFmpegService()
 
+	// 配信用音声の設定
+	renditionSpecs, err := service.ParseRenditionSpecs(cfg.AudioRenditions)
+	if err != nil {
+		log.Error("invalid audio renditions config", "error", err)
+		os.Exit(1)
+	}
+
 	// キャッシュクライアント
 	cacheClient, err := cache.New(ctx, cfg.RedisURL)
 	if err != nil {
@@ -249,7 +276,12 @@ func NewContainer(ctx context.Context, db *gorm.DB, cfg *config.Config) *Contain
 	audioRepo := repository.NewAudioRepository(db)
 	bgmRepo := repository.NewBgmRepository(db)
 	systemBgmRepo := repository.NewSystemBgmRepository(db)
+	soundEffectRepo := repository.NewSoundEffectRepository(db)
+	systemSoundEffectRepo := repository.NewSystemSoundEffectRepository(db)
+	sfxCueRepo := repository.NewSfxCueRepository(db)
 	audioJobRepo := repository.NewAudioJobRepository(db)
+	episodeAudioRenditionRepo := repository.NewEpisodeAudioRenditionRepository(db)
+	episodeHLSPackageRepo := repository.NewEpisodeHLSPackageRepository(db)
 	scriptJobRepo := repository.NewScriptJobRepository(db)
 	feedbackRepo := repository.NewFeedbackRepository(db)
 	contactRepo := repository.NewContactRepository(db)
@@ -260,20 +292,26 @@ func NewContainer(ctx context.Context, db *gorm.DB, cfg *config.Config) *Contain
 	recommendationRepo := repository.NewRecommendationRepository(db)
 	refreshTokenRepo := repository.NewRefreshTokenRepository(db)
 	apiKeyRepo := repository.NewAPIKeyRepository(db)
+	pronunciationRepo := repository.NewPronunciationRepository(db)
+	privateFeedTokenRepo := repository.NewPrivateFeedTokenRepository(db)
+	importJobRepo := repository.NewImportJobRepository(db)
+	videoRepo := repository.NewVideoRepository(db)
+	clipJobRepo := repository.NewClipJobRepository(db)
 
 	// Service 層
-	voiceService := service.NewVoiceService(voiceRepo, favVoiceRepo)
+	voiceService := service.NewVoiceService(voiceRepo, favVoiceRepo, storageClient, ttsRegistry, ffmpegService)
 	authService := service.NewAuthService(userRepo, credentialRepo, oauthAccountRepo, refreshTokenRepo, imageRepo, playlistRepo, audioJobRepo, scriptJobRepo, passwordHasher, storageClient, slackClient)
-	channelService := service.NewChannelService(db, channelRepo, characterRepo, categoryRepo, imageRepo, voiceRepo, episodeRepo, scriptLineRepo, bgmRepo, systemBgmRepo, playbackHistoryRepo, storageClient)
+	channelService := service.NewChannelService(db, channelRepo, characterRepo, categoryRepo, imageRepo, voiceRepo, episodeRepo, scriptLineRepo, bgmRepo, systemBgmRepo, playbackHistoryRepo, audioRepo, pronunciationRepo, storageClient, ttsRegistry, ffmpegService)
 	characterService := service.NewCharacterService(characterRepo, voiceRepo, imageRepo, storageClient)
 	categoryService := service.NewCategoryService(categoryRepo, storageClient)
-	episodeService := service.NewEpisodeService(episodeRepo, channelRepo, scriptLineRepo, audioRepo, imageRepo, bgmRepo, systemBgmRepo, playbackHistoryRepo, playlistRepo, storageClient, ttsRegistry)
-	scriptLineService := service.NewScriptLineService(db, scriptLineRepo, episodeRepo, channelRepo)
-	scriptService := service.NewScriptService(db, channelRepo, episodeRepo, scriptLineRepo, storageClient)
-	cleanupService := service.NewCleanupService(audioRepo, imageRepo, storageClient)
+	scriptLineService := service.NewScriptLineService(db, scriptLineRepo, episodeRepo, channelRepo, pronunciationRepo, soundEffectRepo, systemSoundEffectRepo, storageClient, ttsRegistry, ffmpegService)
+	scriptService := service.NewScriptService(db, channelRepo, episodeRepo, scriptLineRepo, soundEffectRepo, systemSoundEffectRepo, storageClient)
+	cleanupService := service.NewCleanupService(audioRepo, imageRepo, videoRepo, storageClient)
 	imageService := service.NewImageService(imageRepo, storageClient, imagegenClient)
-	audioService := service.NewAudioService(audioRepo, storageClient)
+	audioService := service.NewAudioService(audioRepo, storageClient, ffmpegService)
 	bgmService := service.NewBgmService(bgmRepo, systemBgmRepo, audioRepo, storageClient)
+	soundEffectService := service.NewSoundEffectService(soundEffectRepo, systemSoundEffectRepo, audioRepo, storageClient)
+	sfxCueService := service.NewSfxCueService(channelRepo, episodeRepo, scriptLineRepo, sfxCueRepo, soundEffectRepo, systemSoundEffectRepo, storageClient)
 	audioJobService := service.NewAudioJobService(
 		audioJobRepo,
 		episodeRepo,
@@ -289,7 +327,16 @@ func NewContainer(ctx context.Context, db *gorm.DB, cfg *config.Config) *Contain
 		tasksClient,
 		wsHub,
 		slackClient,
+		episodeAudioRenditionRepo,
+		renditionSpecs,
+		episodeHLSPackageRepo,
+		cfg.AudioID3Lyrics,
+		pronunciationRepo,
+		sfxCueRepo,
+		soundEffectRepo,
+		systemSoundEffectRepo,
 	)
+	episodeService := service.NewEpisodeService(episodeRepo, channelRepo, scriptLineRepo, audioRepo, imageRepo, bgmRepo, systemBgmRepo, playbackHistoryRepo, playlistRepo, episodeHLSPackageRepo, audioJobRepo, storageClient, ttsRegistry, ffmpegService, audioJobService, cfg.AudioID3Lyrics)
 	scriptJobService := service.NewScriptJobService(
 		db,
 		scriptJobRepo,
@@ -312,7 +359,62 @@ func NewContainer(ctx context.Context, db *gorm.DB, cfg *config.Config) *Contain
 	recommendationService := service.NewRecommendationService(recommendationRepo, categoryRepo, storageClient)
 	searchService := service.NewSearchService(channelRepo, episodeRepo, userRepo, storageClient)
 	apiKeyService := service.NewAPIKeyService(apiKeyRepo)
+	pronunciationService := service.NewPronunciationService(pronunciationRepo, channelRepo)
 	userService := service.NewUserService(userRepo, channelRepo, episodeRepo, followRepo, storageClient)
+	feedService := service.NewFeedService(channelRepo, episodeRepo, scriptLineRepo, privateFeedTokenRepo, storageClient, cfg.APIBaseURL(), cfg.PublicWebBaseURL)
+	privateFeedService := service.NewPrivateFeedService(privateFeedTokenRepo, channelRepo, userRepo, cfg.APIBaseURL())
+	importJobService := service.NewImportJobService(
+		importJobRepo,
+		channelRepo,
+		episodeRepo,
+		audioService,
+		imageService,
+		audioJobService,
+		safehttp.NewClient(safehttp.Config{Timeout: 10 * time.Minute, AllowPrivateNetwork: cfg.ImportAllowPrivateNetwork}),
+		tasksClient,
+		wsHub,
+		slackClient,
+	)
+	clipJobService := service.NewClipJobService(
+		clipJobRepo,
+		episodeRepo,
+		scriptLineRepo,
+		videoRepo,
+		storageClient,
+		ffmpegService,
+		tasksClient,
+		wsHub,
+		slackClient,
+	)
+	episodePackageService := service.NewEpisodePackageService(channelRepo, episodeRepo, scriptLineRepo, storageClient)
+	channelArchiveService := service.NewChannelArchiveService(
+		db,
+		channelRepo,
+		characterRepo,
+		episodeRepo,
+		scriptLineRepo,
+		sfxCueRepo,
+		pronunciationRepo,
+		categoryRepo,
+		voiceRepo,
+		bgmRepo,
+		systemBgmRepo,
+		soundEffectRepo,
+		systemSoundEffectRepo,
+		storageClient,
+	)
+
+	// 安定 URL での音声の配信方式（redirect / proxy）
+	switch cfg.MediaAudioDelivery {
+	case config.MediaAudioDeliveryRedirect, config.MediaAudioDeliveryProxy:
+		log.Info("Media audio delivery selected", "delivery", cfg.MediaAudioDelivery)
+	default:
+		log.Error("unknown media audio delivery", "delivery", cfg.MediaAudioDelivery)
+		os.Exit(1)
+	}
+	mediaService := service.NewMediaService(episodeRepo, audioRepo, storageClient, cfg.MediaAudioDelivery == config.MediaAudioDeliveryProxy)
+	shareService := service.NewShareService(channelRepo, episodeRepo, cfg.APIBaseURL(), cfg.PublicWebBaseURL)
+
 	// Handler 層
 	voiceHandler := handler.NewVoiceHandler(voiceService)
 	authHandler := handler.NewAuthHandler(authService, tokenManager)
@@ -327,8 +429,10 @@ func NewContainer(ctx context.Context, db *gorm.DB, cfg *config.Config) *Contain
 	imageHandler := handler.NewImageHandler(imageService)
 	audioHandler := handler.NewAudioHandler(audioService)
 	bgmHandler := handler.NewBgmHandler(bgmService)
+	soundEffectHandler := handler.NewSoundEffectHandler(soundEffectService)
+	sfxCueHandler := handler.NewSfxCueHandler(sfxCueService)
 	audioJobHandler := handler.NewAudioJobHandler(audioJobService)
-	workerHandler := handler.NewWorkerHandler(audioJobService, scriptJobService)
+	workerHandler := handler.NewWorkerHandler(audioJobService, scriptJobService, importJobService, clipJobService)
 	webSocketHandler := handler.NewWebSocketHandler(wsHub, tokenManager)
 	feedbackHandler := handler.NewFeedbackHandler(feedbackService)
 	contactHandler := handler.NewContactHandler(contactService)
@@ -339,7 +443,20 @@ func NewContainer(ctx context.Context, db *gorm.DB, cfg *config.Config) *Contain
 	recommendationHandler := handler.NewRecommendationHandler(recommendationService)
 	searchHandler := handler.NewSearchHandler(searchService)
 	apiKeyHandler := handler.NewAPIKeyHandler(apiKeyService)
+	pronunciationHandler := handler.NewPronunciationHandler(pronunciationService)
 	userHandler := handler.NewUserHandler(userService)
+	feedHandler := handler.NewFeedHandler(feedService)
+	privateFeedHandler := handler.NewPrivateFeedHandler(privateFeedService)
+	importJobHandler := handler.NewImportJobHandler(importJobService)
+	clipJobHandler := handler.NewClipJobHandler(clipJobService)
+	episodePackageHandler := handler.NewEpisodePackageHandler(episodePackageService)
+	channelArchiveHandler := handler.NewChannelArchiveHandler(channelArchiveService)
+	mediaHandler := handler.NewMediaHandler(mediaService)
+	shareHandler := handler.NewShareHandler(shareService)
+	var storageHandler *handler.StorageHandler
+	if localStorageClient != nil {
+		storageHandler = handler.NewStorageHandler(localStorageClient)
+	}
 	// クローズ対象のリソースを収集
 	var closers []closer
 	closers = append(closers, cacheClient)
@@ -362,6 +479,8 @@ func NewContainer(ctx context.Context, db *gorm.DB, cfg *config.Config) *Contain
 		ImageHandler:           imageHandler,
 		AudioHandler:           audioHandler,
 		BgmHandler:             bgmHandler,
+		SoundEffectHandler:     soundEffectHandler,
+		SfxCueHandler:          sfxCueHandler,
 		AudioJobHandler:        audioJobHandler,
 		WorkerHandler:          workerHandler,
 		WebSocketHandler:       webSocketHandler,
@@ -375,10 +494,21 @@ func NewContainer(ctx context.Context, db *gorm.DB, cfg *config.Config) *Contain
 		SearchHandler:          searchHandler,
 		UserHandler:            userHandler,
 		APIKeyHandler:          apiKeyHandler,
+		PronunciationHandler:   pronunciationHandler,
+		FeedHandler:            feedHandler,
+		PrivateFeedHandler:     privateFeedHandler,
+		ImportJobHandler:       importJobHandler,
+		ClipJobHandler:         clipJobHandler,
+		EpisodePackageHandler:  episodePackageHandler,
+		ChannelArchiveHandler:  channelArchiveHandler,
+		MediaHandler:           mediaHandler,
+		ShareHandler:           shareHandler,
+		StorageHandler:         storageHandler,
 		TokenManager:           tokenManager,
 		UserRepository:         userRepo,
 		APIKeyService:          apiKeyService,
 		WebSocketHub:           wsHub,
+		TTSPreviewLimiter:      ratelimit.New(cfg.TTSPreviewRateLimitPerMinute, time.Minute),
 		closers:                closers,
 	}
 }
@@ -393,3 +523,35 @@ func (c *Container) Close() error {
 	}
 	return errors.Join(errs...)
 }
+
+// NewStorageClient は指定したバックエンドのストレージクライアントを作成する
+//
+// バックエンド間の移行スクリプトからも使用するため、STORAGE_BACKEND とは別にバックエンドを指定できる
+func NewStorageClient(ctx context.Context, cfg *config.Config, backend config.StorageBackend) (storage.Client, error) {
+	switch backend {
+	case config.StorageBackendGCS:
+		return storage.NewGCSClient(ctx, cfg.GoogleCloudStorageBucketName, cfg.GoogleCloudCredentialsJSON)
+	case config.StorageBackendS3:
+		return storage.NewS3Client(ctx, storage.S3Config{
+			Bucket:          cfg.S3Bucket,
+			Region:          cfg.S3Region,
+			Endpoint:        cfg.S3Endpoint,
+			PublicEndpoint:  cfg.S3PublicEndpoint,
+			AccessKeyID:     cfg.S3AccessKeyID,
+			SecretAccessKey: cfg.S3SecretAccessKey,
+			UsePathStyle:    cfg.S3UsePathStyle,
+		})
+	case config.StorageBackendLocal:
+		baseURL := cfg.LocalStorageBaseURL
+		if baseURL == "" {
+			baseURL = cfg.APIBaseURL()
+		}
+		signingSecret := cfg.StorageSigningSecret
+		if signingSecret == "" {
+			signingSecret = cfg.AuthSecret
+		}
+		return storage.NewLocalClient(cfg.LocalStorageRoot, baseURL, signingSecret)
+	default:
+		return nil, fmt.Errorf("unknown storage backend: %s", backend)
+	}
+}
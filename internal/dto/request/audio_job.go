@@ -9,6 +9,22 @@ type GenerateAudioAsyncRequest struct {
 	FadeOutMs      *int     `json:"fadeOutMs" binding:"omitempty,min=0,max=30000"`
 	PaddingStartMs *int     `json:"paddingStartMs" binding:"omitempty,min=0,max=10000"`
 	PaddingEndMs   *int     `json:"paddingEndMs" binding:"omitempty,min=0,max=10000"`
+
+	// BGM ダッキング設定（bgmDucking=true の場合のみ有効）
+	BgmDucking         *bool    `json:"bgmDucking"`
+	DuckingThresholdDB *float64 `json:"duckingThresholdDb" binding:"omitempty,min=-60,max=0"`
+	DuckingRatio       *float64 `json:"duckingRatio" binding:"omitempty,min=1,max=20"`
+	DuckingAttackMs    *int     `json:"duckingAttackMs" binding:"omitempty,min=1,max=2000"`
+	DuckingReleaseMs   *int     `json:"duckingReleaseMs" binding:"omitempty,min=10,max=9000"`
+	IntroSwellMs       *int     `json:"introSwellMs" binding:"omitempty,min=0,max=10000"`
+
+	// HLS 出力（true の場合は音声から HLS パッケージも生成する）
+	HLS *bool `json:"hls"`
+
+	// 発音 QA 設定（未指定の場合は無効）
+	QAEnabled             *bool    `json:"qaEnabled"`
+	QASimilarityThreshold *float64 `json:"qaSimilarityThreshold" binding:"omitempty,min=0,max=1"`
+	QAMaxRetries          *int     `json:"qaMaxRetries" binding:"omitempty,min=0,max=5"`
 }
 
 // 自分の音声生成ジョブ一覧取得リクエスト
@@ -14,6 +14,7 @@ type CreateChannelRequest struct {
 	Description    string                 `json:"description" binding:"omitempty,max=2000"`
 	CategoryID     string                 `json:"categoryId" binding:"required,uuid"`
 	ArtworkImageID *string                `json:"artworkImageId" binding:"omitempty,uuid"`
+	Explicit       bool                   `json:"explicit"`
 	Characters     ChannelCharactersInput `json:"characters" binding:"required"`
 }
 
@@ -47,6 +48,7 @@ type UpdateChannelRequest struct {
 	Description    string                 `json:"description" binding:"omitempty,max=2000"`
 	CategoryID     string                 `json:"categoryId" binding:"required,uuid"`
 	ArtworkImageID optional.Field[string] `json:"artworkImageId"`
+	Explicit       *bool                  `json:"explicit"` // 省略時は変更しない
 }
 
 // 台本プロンプト設定リクエスト
@@ -60,6 +62,21 @@ type SetDefaultBgmRequest struct {
 	SystemBgmID *string `json:"systemBgmId" binding:"omitempty,uuid"`
 }
 
+// イントロ・アウトロ設定リクエスト
+// audioId（アップロード済み音声）または tts（キャラクターのボイスで読み上げ）のどちらか一方を指定
+type SetChannelSegmentRequest struct {
+	AudioID     *string                 `json:"audioId" binding:"omitempty,uuid"`
+	TTS         *ChannelSegmentTTSInput `json:"tts" binding:"omitempty"`
+	CrossfadeMs *int                    `json:"crossfadeMs" binding:"omitempty,min=0,max=10000"`
+}
+
+// イントロ・アウトロを TTS で生成する場合の入力
+type ChannelSegmentTTSInput struct {
+	CharacterID string  `json:"characterId" binding:"required,uuid"`
+	Text        string  `json:"text" binding:"required,max=500"`
+	Emotion     *string `json:"emotion" binding:"omitempty,max=20"`
+}
+
 // チャンネルキャラクター追加リクエスト
 // connect（既存キャラクター紐づけ）または create（新規作成）のどちらか一方を指定
 type AddChannelCharacterRequest struct {
@@ -0,0 +1,6 @@
+package request
+
+// チャンネルアーカイブ取り込みリクエスト（クエリパラメータ）
+type ImportChannelArchiveRequest struct {
+	DryRun bool `form:"dryRun"`
+}
@@ -9,16 +9,18 @@ type ListMyCharactersRequest struct {
 
 // キャラクター作成リクエスト
 type CreateCharacterRequest struct {
-	Name     string  `json:"name" binding:"required,max=255"`
-	Persona  string  `json:"persona" binding:"max=2000"`
-	AvatarID *string `json:"avatarId" binding:"omitempty,uuid"`
-	VoiceID  string  `json:"voiceId" binding:"required,uuid"`
+	Name          string                `json:"name" binding:"required,max=255"`
+	Persona       string                `json:"persona" binding:"max=2000"`
+	AvatarID      *string               `json:"avatarId" binding:"omitempty,uuid"`
+	VoiceID       string                `json:"voiceId" binding:"required,uuid"`
+	VoiceSettings *VoiceSettingsRequest `json:"voiceSettings"`
 }
 
 // キャラクター更新リクエスト
 type UpdateCharacterRequest struct {
-	Name     *string                `json:"name" binding:"omitempty,max=255"`
-	Persona  *string                `json:"persona" binding:"omitempty,max=2000"`
-	AvatarID optional.Field[string] `json:"avatarId"`
-	VoiceID  *string                `json:"voiceId" binding:"omitempty,uuid"`
+	Name          *string                              `json:"name" binding:"omitempty,max=255"`
+	Persona       *string                              `json:"persona" binding:"omitempty,max=2000"`
+	AvatarID      optional.Field[string]               `json:"avatarId"`
+	VoiceID       *string                              `json:"voiceId" binding:"omitempty,uuid"`
+	VoiceSettings optional.Field[VoiceSettingsRequest] `json:"voiceSettings"`
 }
@@ -0,0 +1,15 @@
+package request
+
+// クリップ動画生成ジョブ作成リクエスト
+//
+// 切り出す範囲は startMs・endMs（ミリ秒）か startScriptLineId・endScriptLineId（台本行）のどちらか一方で指定する
+type CreateClipJobRequest struct {
+	StartMs           *int    `json:"startMs" binding:"omitempty,min=0"`
+	EndMs             *int    `json:"endMs" binding:"omitempty,min=1"`
+	StartScriptLineID *string `json:"startScriptLineId" binding:"omitempty,uuid"`
+	EndScriptLineID   *string `json:"endScriptLineId" binding:"omitempty,uuid"`
+
+	// 動画の設定
+	AspectRatio *string `json:"aspectRatio" binding:"omitempty,oneof=square portrait landscape"` // 未指定の場合は square
+	Captions    *bool   `json:"captions"`                                                        // 台本の字幕を焼き込むか（未指定の場合は true）
+}
@@ -0,0 +1,6 @@
+package request
+
+// ポッドキャスト取り込みジョブ作成リクエスト
+type CreateImportJobRequest struct {
+	FeedURL string `json:"feedUrl" binding:"required,url,max=2048"` // 取り込み元の RSS / Atom フィードの URL
+}
@@ -0,0 +1,7 @@
+package request
+
+// 限定公開フィード作成リクエスト
+type CreatePrivateFeedRequest struct {
+	Username  *string `json:"username" binding:"omitempty,min=1"` // 発行先ユーザー。省略時は自分
+	ExpiresAt *string `json:"expiresAt"`                          // RFC3339 形式。省略時は無期限
+}
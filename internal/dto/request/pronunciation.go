@@ -0,0 +1,13 @@
+package request
+
+// 発音辞書エントリ作成リクエスト
+type CreatePronunciationRequest struct {
+	Surface string `json:"surface" binding:"required,max=100"`
+	Reading string `json:"reading" binding:"required,max=200"`
+}
+
+// 発音辞書エントリ更新リクエスト
+type UpdatePronunciationRequest struct {
+	Surface *string `json:"surface" binding:"omitempty,max=100"`
+	Reading *string `json:"reading" binding:"omitempty,max=200"`
+}
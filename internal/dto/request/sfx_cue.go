@@ -0,0 +1,9 @@
+package request
+
+// 効果音キュー作成リクエスト
+type CreateSfxCueRequest struct {
+	SoundEffectID       *string  `json:"soundEffectId" binding:"omitempty,uuid"`
+	SystemSoundEffectID *string  `json:"systemSoundEffectId" binding:"omitempty,uuid"`
+	Position            string   `json:"position" binding:"required,oneof=before after"`
+	VolumeDB            *float64 `json:"volumeDb" binding:"omitempty,min=-30,max=10"`
+}
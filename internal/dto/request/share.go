@@ -0,0 +1,15 @@
+package request
+
+// 埋め込みプレイヤーのリクエスト
+type EmbedPlayerRequest struct {
+	// 再生開始位置（秒）
+	T int `form:"t" binding:"omitempty,min=0"`
+}
+
+// oEmbed リクエスト
+type OEmbedRequest struct {
+	URL       string `form:"url" binding:"required,max=2048"`
+	Format    string `form:"format" binding:"omitempty,oneof=json"`
+	MaxWidth  int    `form:"maxwidth" binding:"omitempty,min=1"`
+	MaxHeight int    `form:"maxheight" binding:"omitempty,min=1"`
+}
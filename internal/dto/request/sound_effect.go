@@ -0,0 +1,18 @@
+package request
+
+// 自分の効果音一覧取得リクエスト
+type ListMySoundEffectsRequest struct {
+	PaginationRequest
+	IncludeSystem bool `form:"include_system,default=false"`
+}
+
+// 効果音作成リクエスト
+type CreateSoundEffectRequest struct {
+	Name    string `json:"name" binding:"required,max=255"`
+	AudioID string `json:"audioId" binding:"required,uuid"`
+}
+
+// 効果音更新リクエスト
+type UpdateSoundEffectRequest struct {
+	Name *string `json:"name" binding:"omitempty,max=255"`
+}
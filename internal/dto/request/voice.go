@@ -5,3 +5,19 @@ type ListVoicesRequest struct {
 	Provider *string `form:"provider"`
 	Gender   *string `form:"gender"`
 }
+
+// ボイス試聴リクエスト
+type AuditionVoiceRequest struct {
+	Text          string                `json:"text" binding:"required,max=100"`
+	Emotion       *string               `json:"emotion" binding:"omitempty,max=20"`
+	VoiceSettings *VoiceSettingsRequest `json:"voiceSettings"`
+}
+
+// 音声合成パラメータのリクエスト（未指定の項目はプロバイダのデフォルト値）
+type VoiceSettingsRequest struct {
+	SpeakingRate    *float64 `json:"speakingRate"`
+	Pitch           *float64 `json:"pitch"`
+	Stability       *float64 `json:"stability"`
+	SimilarityBoost *float64 `json:"similarityBoost"`
+	Style           *float64 `json:"style"`
+}
@@ -4,11 +4,37 @@ import "github.com/siropaca/anycast-backend/internal/pkg/uuid"
 
 // 音声ファイル情報のレスポンス
 type AudioResponse struct {
-	ID         uuid.UUID `json:"id" validate:"required"`
-	URL        string    `json:"url" validate:"required"`
-	MimeType   string    `json:"mimeType" validate:"required"`
-	FileSize   int       `json:"fileSize" validate:"required"`
-	DurationMs int       `json:"durationMs" validate:"required"`
+	ID         uuid.UUID              `json:"id" validate:"required"`
+	URL        string                 `json:"url" validate:"required"`
+	MimeType   string                 `json:"mimeType" validate:"required"`
+	FileSize   int                    `json:"fileSize" validate:"required"`
+	DurationMs int                    `json:"durationMs" validate:"required"`
+	Waveform   *AudioWaveformResponse `json:"waveform,omitempty"`
+}
+
+// 波形ピークデータのレスポンス
+//
+// audiowaveform（BBC）の JSON 形式と互換にするため、キーはスネークケースとする
+type AudioWaveformResponse struct {
+	Version         int   `json:"version" validate:"required"`
+	Channels        int   `json:"channels" validate:"required"`
+	SampleRate      int   `json:"sample_rate" validate:"required"`
+	SamplesPerPixel int   `json:"samples_per_pixel" validate:"required"`
+	Bits            int   `json:"bits" validate:"required"`
+	Length          int   `json:"length" validate:"required"`
+	Data            []int `json:"data" validate:"required"`
+}
+
+// 解像度別の波形ピークデータのレスポンス（data ラッパー付き）
+type AudioWaveformsDataResponse struct {
+	Data []AudioWaveformResponse `json:"data" validate:"required"`
+}
+
+// 配信用音声（フォーマット・ビットレート別）のレスポンス
+type AudioRenditionResponse struct {
+	Format      string        `json:"format" validate:"required"`
+	BitrateKbps int           `json:"bitrateKbps" validate:"required"`
+	Audio       AudioResponse `json:"audio" validate:"required"`
 }
 
 // 音声生成レスポンス
@@ -18,12 +44,13 @@ type GenerateAudioResponse struct {
 
 // 音声アップロードのレスポンス
 type AudioUploadResponse struct {
-	ID         uuid.UUID `json:"id" validate:"required"`
-	MimeType   string    `json:"mimeType" validate:"required"`
-	URL        string    `json:"url" validate:"required"`
-	Filename   string    `json:"filename" validate:"required"`
-	FileSize   int       `json:"fileSize" validate:"required"`
-	DurationMs int       `json:"durationMs" validate:"required"`
+	ID         uuid.UUID               `json:"id" validate:"required"`
+	MimeType   string                  `json:"mimeType" validate:"required"`
+	URL        string                  `json:"url" validate:"required"`
+	Filename   string                  `json:"filename" validate:"required"`
+	FileSize   int                     `json:"fileSize" validate:"required"`
+	DurationMs int                     `json:"durationMs" validate:"required"`
+	Waveforms  []AudioWaveformResponse `json:"waveforms,omitempty"`
 }
 
 // 音声アップロードのレスポンス（data ラッパー付き）
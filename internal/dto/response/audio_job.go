@@ -8,24 +8,54 @@ import (
 
 // 音声生成ジョブのレスポンス
 type AudioJobResponse struct {
-	ID             uuid.UUID                `json:"id" validate:"required"`
-	EpisodeID      uuid.UUID                `json:"episodeId" validate:"required"`
-	Status         string                   `json:"status" validate:"required"`
-	JobType        string                   `json:"jobType" validate:"required"`
-	Progress       int                      `json:"progress" validate:"required"`
-	BgmVolumeDB    float64                  `json:"bgmVolumeDb"`
-	FadeOutMs      int                      `json:"fadeOutMs"`
-	PaddingStartMs int                      `json:"paddingStartMs"`
-	PaddingEndMs   int                      `json:"paddingEndMs"`
-	Episode        *AudioJobEpisodeResponse `json:"episode" extensions:"x-nullable"`
-	Bgm            *EpisodeBgmResponse      `json:"bgm" extensions:"x-nullable"`
-	ResultAudio    *AudioResponse           `json:"resultAudio" extensions:"x-nullable"`
-	ErrorMessage   *string                  `json:"errorMessage" extensions:"x-nullable"`
-	ErrorCode      *string                  `json:"errorCode" extensions:"x-nullable"`
-	StartedAt      *time.Time               `json:"startedAt" extensions:"x-nullable"`
-	CompletedAt    *time.Time               `json:"completedAt" extensions:"x-nullable"`
-	CreatedAt      time.Time                `json:"createdAt" validate:"required"`
-	UpdatedAt      time.Time                `json:"updatedAt" validate:"required"`
+	ID             uuid.UUID                 `json:"id" validate:"required"`
+	EpisodeID      uuid.UUID                 `json:"episodeId" validate:"required"`
+	Status         string                    `json:"status" validate:"required"`
+	JobType        string                    `json:"jobType" validate:"required"`
+	Progress       int                       `json:"progress" validate:"required"`
+	BgmVolumeDB    float64                   `json:"bgmVolumeDb"`
+	FadeOutMs      int                       `json:"fadeOutMs"`
+	PaddingStartMs int                       `json:"paddingStartMs"`
+	PaddingEndMs   int                       `json:"paddingEndMs"`
+	HLS            bool                      `json:"hls"`
+	Ducking        *AudioJobDuckingResponse  `json:"ducking" extensions:"x-nullable"`
+	QAEnabled      bool                      `json:"qaEnabled"`
+	QAReport       *AudioJobQAReportResponse `json:"qaReport" extensions:"x-nullable"`
+	Episode        *AudioJobEpisodeResponse  `json:"episode" extensions:"x-nullable"`
+	Bgm            *EpisodeBgmResponse       `json:"bgm" extensions:"x-nullable"`
+	ResultAudio    *AudioResponse            `json:"resultAudio" extensions:"x-nullable"`
+	ErrorMessage   *string                   `json:"errorMessage" extensions:"x-nullable"`
+	ErrorCode      *string                   `json:"errorCode" extensions:"x-nullable"`
+	StartedAt      *time.Time                `json:"startedAt" extensions:"x-nullable"`
+	CompletedAt    *time.Time                `json:"completedAt" extensions:"x-nullable"`
+	CreatedAt      time.Time                 `json:"createdAt" validate:"required"`
+	UpdatedAt      time.Time                 `json:"updatedAt" validate:"required"`
+}
+
+// 音声生成ジョブの BGM ダッキング設定
+type AudioJobDuckingResponse struct {
+	ThresholdDB  float64 `json:"thresholdDb" validate:"required"`
+	Ratio        float64 `json:"ratio" validate:"required"`
+	AttackMs     int     `json:"attackMs" validate:"required"`
+	ReleaseMs    int     `json:"releaseMs" validate:"required"`
+	IntroSwellMs int     `json:"introSwellMs" validate:"required"`
+}
+
+// 音声生成ジョブの発音 QA レポート
+type AudioJobQAReportResponse struct {
+	Threshold          float64                         `json:"threshold" validate:"required"`
+	CheckedLines       int                             `json:"checkedLines" validate:"required"`
+	ResynthesizedLines int                             `json:"resynthesizedLines" validate:"required"`
+	FlaggedLines       []AudioJobQAFlaggedLineResponse `json:"flaggedLines" validate:"required"`
+}
+
+// 発音 QA で閾値に届かなかった台本行
+type AudioJobQAFlaggedLineResponse struct {
+	ScriptLineID uuid.UUID `json:"scriptLineId" validate:"required"`
+	Text         string    `json:"text" validate:"required"`
+	Transcript   string    `json:"transcript" validate:"required"`
+	Similarity   float64   `json:"similarity" validate:"required"`
+	Attempts     int       `json:"attempts" validate:"required"`
 }
 
 // 音声生成ジョブに含まれるエピソード情報
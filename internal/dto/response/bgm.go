@@ -60,7 +60,8 @@ type BgmChannelResponse struct {
 
 // BGM に紐づく音声情報のレスポンス
 type BgmAudioResponse struct {
-	ID         uuid.UUID `json:"id" validate:"required"`
-	URL        string    `json:"url" validate:"required"`
-	DurationMs int       `json:"durationMs" validate:"required"`
+	ID         uuid.UUID              `json:"id" validate:"required"`
+	URL        string                 `json:"url" validate:"required"`
+	DurationMs int                    `json:"durationMs" validate:"required"`
+	Waveform   *AudioWaveformResponse `json:"waveform,omitempty"`
 }
@@ -16,6 +16,9 @@ type ChannelResponse struct {
 	Category    CategoryResponse           `json:"category" validate:"required"`
 	Artwork     *ArtworkResponse           `json:"artwork" extensions:"x-nullable"`
 	DefaultBgm  *ChannelDefaultBgmResponse `json:"defaultBgm" extensions:"x-nullable"`
+	Intro       *ChannelSegmentResponse    `json:"intro" extensions:"x-nullable"`
+	Outro       *ChannelSegmentResponse    `json:"outro" extensions:"x-nullable"`
+	Explicit    bool                       `json:"explicit" validate:"required"`
 	Characters  []CharacterResponse        `json:"characters" validate:"required"`
 	Episodes    []EpisodeResponse          `json:"episodes" validate:"required"`
 	PublishedAt *time.Time                 `json:"publishedAt" extensions:"x-nullable"`
@@ -31,6 +34,19 @@ type ChannelDefaultBgmResponse struct {
 	Audio    BgmAudioResponse `json:"audio" validate:"required"`
 }
 
+// チャンネルのイントロ・アウトロ情報のレスポンス
+type ChannelSegmentResponse struct {
+	Audio       ChannelSegmentAudioResponse `json:"audio" validate:"required"`
+	CrossfadeMs int                         `json:"crossfadeMs" validate:"required"`
+}
+
+// イントロ・アウトロ音声情報のレスポンス
+type ChannelSegmentAudioResponse struct {
+	ID         uuid.UUID `json:"id" validate:"required"`
+	URL        string    `json:"url" validate:"required"`
+	DurationMs int       `json:"durationMs" validate:"required"`
+}
+
 // キャラクター情報のレスポンス
 type CharacterResponse struct {
 	ID        uuid.UUID              `json:"id" validate:"required"`
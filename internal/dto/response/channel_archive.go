@@ -0,0 +1,61 @@
+package response
+
+import (
+	"time"
+
+	"github.com/siropaca/anycast-backend/internal/pkg/uuid"
+)
+
+// チャンネルのエクスポートアーカイブのレスポンス
+type ChannelArchiveResponse struct {
+	URL       string    `json:"url" validate:"required"`
+	Filename  string    `json:"filename" validate:"required"`
+	MimeType  string    `json:"mimeType" validate:"required"`
+	ExpiresAt time.Time `json:"expiresAt" validate:"required"`
+	Cached    bool      `json:"cached" validate:"required"`
+}
+
+// チャンネルのエクスポートアーカイブのレスポンス（data ラッパー）
+type ChannelArchiveDataResponse struct {
+	Data ChannelArchiveResponse `json:"data" validate:"required"`
+}
+
+// チャンネルアーカイブの取り込み結果のレスポンス
+type ChannelArchiveImportResponse struct {
+	DryRun    bool                                 `json:"dryRun" validate:"required"`
+	Channel   *ChannelArchiveImportChannelResponse `json:"channel" extensions:"x-nullable"`
+	Counts    ChannelArchiveImportCountsResponse   `json:"counts" validate:"required"`
+	Conflicts []ChannelArchiveConflictResponse     `json:"conflicts" validate:"required"`
+}
+
+// チャンネルアーカイブから作成したチャンネル
+type ChannelArchiveImportChannelResponse struct {
+	ID   uuid.UUID `json:"id" validate:"required"`
+	Name string    `json:"name" validate:"required"`
+}
+
+// チャンネルアーカイブから取り込む（取り込んだ）データの件数
+type ChannelArchiveImportCountsResponse struct {
+	Characters     int `json:"characters" validate:"required"`
+	Bgms           int `json:"bgms" validate:"required"`
+	SoundEffects   int `json:"soundEffects" validate:"required"`
+	Pronunciations int `json:"pronunciations" validate:"required"`
+	Episodes       int `json:"episodes" validate:"required"`
+	ScriptLines    int `json:"scriptLines" validate:"required"`
+	SfxCues        int `json:"sfxCues" validate:"required"`
+	MediaFiles     int `json:"mediaFiles" validate:"required"`
+}
+
+// チャンネルアーカイブの取り込み時に見つかった競合
+type ChannelArchiveConflictResponse struct {
+	Type       string  `json:"type" validate:"required"`
+	Name       string  `json:"name" validate:"required"`
+	Resolution string  `json:"resolution" validate:"required"`
+	RenamedTo  *string `json:"renamedTo" extensions:"x-nullable"`
+	Message    string  `json:"message" validate:"required"`
+}
+
+// チャンネルアーカイブの取り込み結果のレスポンス（data ラッパー）
+type ChannelArchiveImportDataResponse struct {
+	Data ChannelArchiveImportResponse `json:"data" validate:"required"`
+}
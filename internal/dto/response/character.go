@@ -19,14 +19,15 @@ type CharacterDataResponse struct {
 
 // チャンネル情報付きキャラクターのレスポンス
 type CharacterWithChannelsResponse struct {
-	ID        uuid.UUID                  `json:"id" validate:"required"`
-	Name      string                     `json:"name" validate:"required"`
-	Persona   string                     `json:"persona" validate:"required"`
-	Avatar    *AvatarResponse            `json:"avatar" extensions:"x-nullable"`
-	Voice     CharacterVoiceResponse     `json:"voice" validate:"required"`
-	Channels  []CharacterChannelResponse `json:"channels" validate:"required"`
-	CreatedAt time.Time                  `json:"createdAt" validate:"required"`
-	UpdatedAt time.Time                  `json:"updatedAt" validate:"required"`
+	ID            uuid.UUID                  `json:"id" validate:"required"`
+	Name          string                     `json:"name" validate:"required"`
+	Persona       string                     `json:"persona" validate:"required"`
+	Avatar        *AvatarResponse            `json:"avatar" extensions:"x-nullable"`
+	Voice         CharacterVoiceResponse     `json:"voice" validate:"required"`
+	VoiceSettings VoiceSettingsResponse      `json:"voiceSettings" validate:"required"`
+	Channels      []CharacterChannelResponse `json:"channels" validate:"required"`
+	CreatedAt     time.Time                  `json:"createdAt" validate:"required"`
+	UpdatedAt     time.Time                  `json:"updatedAt" validate:"required"`
 }
 
 // キャラクターに紐づくチャンネル情報のレスポンス
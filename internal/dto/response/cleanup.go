@@ -24,13 +24,25 @@ type OrphanedImageResponse struct {
 	CreatedAt time.Time `json:"createdAt" validate:"required"`
 }
 
+// 孤児動画ファイル情報
+type OrphanedVideoResponse struct {
+	ID        uuid.UUID `json:"id" validate:"required"`
+	URL       string    `json:"url" validate:"required"`
+	Filename  string    `json:"filename" validate:"required"`
+	FileSize  int       `json:"fileSize" validate:"required"`
+	CreatedAt time.Time `json:"createdAt" validate:"required"`
+}
+
 // 孤児メディアファイル削除レスポンス
 type CleanupOrphanedMediaResponse struct {
 	DryRun            bool                    `json:"dryRun" validate:"required"`
 	OrphanedAudios    []OrphanedAudioResponse `json:"orphanedAudios" validate:"required"`
 	OrphanedImages    []OrphanedImageResponse `json:"orphanedImages" validate:"required"`
+	OrphanedVideos    []OrphanedVideoResponse `json:"orphanedVideos" validate:"required"`
 	DeletedAudioCount int                     `json:"deletedAudioCount" validate:"required"`
 	DeletedImageCount int                     `json:"deletedImageCount" validate:"required"`
+	DeletedVideoCount int                     `json:"deletedVideoCount" validate:"required"`
 	FailedAudioCount  int                     `json:"failedAudioCount" validate:"required"`
 	FailedImageCount  int                     `json:"failedImageCount" validate:"required"`
+	FailedVideoCount  int                     `json:"failedVideoCount" validate:"required"`
 }
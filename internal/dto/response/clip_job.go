@@ -0,0 +1,55 @@
+package response
+
+import (
+	"time"
+
+	"github.com/siropaca/anycast-backend/internal/pkg/uuid"
+)
+
+// クリップ動画生成ジョブのレスポンス
+type ClipJobResponse struct {
+	ID                uuid.UUID              `json:"id" validate:"required"`
+	Episode           ClipJobEpisodeResponse `json:"episode" validate:"required"`
+	StartMs           int                    `json:"startMs" validate:"required"`
+	EndMs             int                    `json:"endMs" validate:"required"`
+	StartScriptLineID *uuid.UUID             `json:"startScriptLineId" extensions:"x-nullable"`
+	EndScriptLineID   *uuid.UUID             `json:"endScriptLineId" extensions:"x-nullable"`
+	AspectRatio       string                 `json:"aspectRatio" validate:"required"`
+	Captions          bool                   `json:"captions" validate:"required"`
+	Status            string                 `json:"status" validate:"required"`
+	Progress          int                    `json:"progress" validate:"required"`
+	Video             *VideoResponse         `json:"video" extensions:"x-nullable"`
+	ErrorMessage      *string                `json:"errorMessage" extensions:"x-nullable"`
+	ErrorCode         *string                `json:"errorCode" extensions:"x-nullable"`
+	StartedAt         *time.Time             `json:"startedAt" extensions:"x-nullable"`
+	CompletedAt       *time.Time             `json:"completedAt" extensions:"x-nullable"`
+	CreatedAt         time.Time              `json:"createdAt" validate:"required"`
+	UpdatedAt         time.Time              `json:"updatedAt" validate:"required"`
+}
+
+// クリップ動画生成ジョブに含まれるエピソード情報
+type ClipJobEpisodeResponse struct {
+	ID    uuid.UUID `json:"id" validate:"required"`
+	Title string    `json:"title" validate:"required"`
+}
+
+// 動画ファイル情報のレスポンス
+type VideoResponse struct {
+	ID         uuid.UUID `json:"id" validate:"required"`
+	URL        string    `json:"url" validate:"required"`
+	MimeType   string    `json:"mimeType" validate:"required"`
+	FileSize   int       `json:"fileSize" validate:"required"`
+	DurationMs int       `json:"durationMs" validate:"required"`
+	Width      int       `json:"width" validate:"required"`
+	Height     int       `json:"height" validate:"required"`
+}
+
+// クリップ動画生成ジョブ一覧のレスポンス
+type ClipJobListResponse struct {
+	Data []ClipJobResponse `json:"data" validate:"required"`
+}
+
+// クリップ動画生成ジョブ詳細のレスポンス
+type ClipJobDataResponse struct {
+	Data ClipJobResponse `json:"data" validate:"required"`
+}
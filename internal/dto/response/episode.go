@@ -15,6 +15,8 @@ type EpisodeResponse struct {
 	Artwork         *ArtworkResponse         `json:"artwork" extensions:"x-nullable"`
 	VoiceAudio      *AudioResponse           `json:"voiceAudio,omitempty" extensions:"x-nullable"`
 	FullAudio       *AudioResponse           `json:"fullAudio" extensions:"x-nullable"`
+	Renditions      []AudioRenditionResponse `json:"renditions" validate:"required"`
+	HLS             *EpisodeHLSResponse      `json:"hls" extensions:"x-nullable"`
 	Bgm             *EpisodeBgmResponse      `json:"bgm" extensions:"x-nullable"`
 	Playback        *EpisodePlaybackResponse `json:"playback" extensions:"x-nullable"`
 	PlaylistIDs     []uuid.UUID              `json:"playlistIds" extensions:"x-nullable"`
@@ -26,6 +28,15 @@ type EpisodeResponse struct {
 	UpdatedAt       time.Time                `json:"updatedAt" validate:"required"`
 }
 
+// エピソードの HLS パッケージ情報のレスポンス
+type EpisodeHLSResponse struct {
+	PlaylistURL        string `json:"playlistUrl" validate:"required"`
+	SegmentCount       int    `json:"segmentCount" validate:"required"`
+	SegmentDurationSec int    `json:"segmentDurationSec" validate:"required"`
+	BitrateKbps        int    `json:"bitrateKbps" validate:"required"`
+	DurationMs         int    `json:"durationMs" validate:"required"`
+}
+
 // エピソードの再生位置情報のレスポンス
 type EpisodePlaybackResponse struct {
 	ProgressMs int       `json:"progressMs" validate:"required"`
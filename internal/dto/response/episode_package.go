@@ -0,0 +1,17 @@
+package response
+
+import "time"
+
+// エピソードのダウンロードパッケージのレスポンス
+type EpisodePackageResponse struct {
+	URL       string    `json:"url" validate:"required"`
+	Filename  string    `json:"filename" validate:"required"`
+	MimeType  string    `json:"mimeType" validate:"required"`
+	ExpiresAt time.Time `json:"expiresAt" validate:"required"`
+	Cached    bool      `json:"cached" validate:"required"`
+}
+
+// エピソードのダウンロードパッケージのレスポンス（data ラッパー）
+type EpisodePackageDataResponse struct {
+	Data EpisodePackageResponse `json:"data" validate:"required"`
+}
@@ -0,0 +1,42 @@
+package response
+
+import (
+	"time"
+
+	"github.com/siropaca/anycast-backend/internal/pkg/uuid"
+)
+
+// ポッドキャスト取り込みジョブのレスポンス
+type ImportJobResponse struct {
+	ID               uuid.UUID                `json:"id" validate:"required"`
+	Channel          ImportJobChannelResponse `json:"channel" validate:"required"`
+	FeedURL          string                   `json:"feedUrl" validate:"required"`
+	Status           string                   `json:"status" validate:"required"`
+	Progress         int                      `json:"progress" validate:"required"`
+	TotalEpisodes    int                      `json:"totalEpisodes" validate:"required"`
+	ImportedEpisodes int                      `json:"importedEpisodes" validate:"required"`
+	SkippedEpisodes  int                      `json:"skippedEpisodes" validate:"required"`
+	FailedEpisodes   int                      `json:"failedEpisodes" validate:"required"`
+	ErrorMessage     *string                  `json:"errorMessage" extensions:"x-nullable"`
+	ErrorCode        *string                  `json:"errorCode" extensions:"x-nullable"`
+	StartedAt        *time.Time               `json:"startedAt" extensions:"x-nullable"`
+	CompletedAt      *time.Time               `json:"completedAt" extensions:"x-nullable"`
+	CreatedAt        time.Time                `json:"createdAt" validate:"required"`
+	UpdatedAt        time.Time                `json:"updatedAt" validate:"required"`
+}
+
+// 取り込みジョブに含まれるチャンネル情報
+type ImportJobChannelResponse struct {
+	ID   uuid.UUID `json:"id" validate:"required"`
+	Name string    `json:"name" validate:"required"`
+}
+
+// 取り込みジョブ一覧のレスポンス
+type ImportJobListResponse struct {
+	Data []ImportJobResponse `json:"data" validate:"required"`
+}
+
+// 取り込みジョブ詳細のレスポンス
+type ImportJobDataResponse struct {
+	Data ImportJobResponse `json:"data" validate:"required"`
+}
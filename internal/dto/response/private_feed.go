@@ -0,0 +1,41 @@
+package response
+
+import (
+	"time"
+
+	"github.com/siropaca/anycast-backend/internal/pkg/uuid"
+)
+
+// 限定公開フィードのレスポンス
+type PrivateFeedResponse struct {
+	ID         uuid.UUID                  `json:"id" validate:"required"`
+	Channel    PrivateFeedChannelResponse `json:"channel" validate:"required"`
+	User       PrivateFeedUserResponse    `json:"user" validate:"required"`
+	FeedURL    string                     `json:"feedUrl" validate:"required"`
+	ExpiresAt  *time.Time                 `json:"expiresAt" extensions:"x-nullable"`
+	LastUsedAt *time.Time                 `json:"lastUsedAt" extensions:"x-nullable"`
+	CreatedAt  time.Time                  `json:"createdAt" validate:"required"`
+}
+
+// 限定公開フィードのチャンネル情報
+type PrivateFeedChannelResponse struct {
+	ID   uuid.UUID `json:"id" validate:"required"`
+	Name string    `json:"name" validate:"required"`
+}
+
+// 限定公開フィードの発行先ユーザー情報
+type PrivateFeedUserResponse struct {
+	ID          uuid.UUID `json:"id" validate:"required"`
+	Username    string    `json:"username" validate:"required"`
+	DisplayName string    `json:"displayName" validate:"required"`
+}
+
+// 限定公開フィードレスポンス（data ラッパー）
+type PrivateFeedDataResponse struct {
+	Data PrivateFeedResponse `json:"data" validate:"required"`
+}
+
+// 限定公開フィード一覧レスポンス（data ラッパー）
+type PrivateFeedListDataResponse struct {
+	Data []PrivateFeedResponse `json:"data" validate:"required"`
+}
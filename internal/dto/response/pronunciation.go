@@ -0,0 +1,26 @@
+package response
+
+import (
+	"time"
+
+	"github.com/siropaca/anycast-backend/internal/pkg/uuid"
+)
+
+// 発音辞書エントリのレスポンス
+type PronunciationResponse struct {
+	ID        uuid.UUID `json:"id" validate:"required"`
+	Surface   string    `json:"surface" validate:"required"`
+	Reading   string    `json:"reading" validate:"required"`
+	CreatedAt time.Time `json:"createdAt" validate:"required"`
+	UpdatedAt time.Time `json:"updatedAt" validate:"required"`
+}
+
+// 発音辞書エントリ一覧のレスポンス
+type PronunciationListDataResponse struct {
+	Data []PronunciationResponse `json:"data" validate:"required"`
+}
+
+// 発音辞書エントリ単体のレスポンス
+type PronunciationDataResponse struct {
+	Data PronunciationResponse `json:"data" validate:"required"`
+}
@@ -29,3 +29,15 @@ type ScriptLineResponse struct {
 type ScriptLineListResponse struct {
 	Data []ScriptLineResponse `json:"data" validate:"required"`
 }
+
+// 台本行プレビュー音声のレスポンス
+type ScriptLinePreviewResponse struct {
+	URL      string `json:"url" validate:"required"`
+	MimeType string `json:"mimeType" validate:"required"`
+	Cached   bool   `json:"cached" validate:"required"`
+}
+
+// 台本行プレビュー音声のレスポンス（data ラッパー）
+type ScriptLinePreviewDataResponse struct {
+	Data ScriptLinePreviewResponse `json:"data" validate:"required"`
+}
@@ -0,0 +1,27 @@
+package response
+
+import (
+	"time"
+
+	"github.com/siropaca/anycast-backend/internal/pkg/uuid"
+)
+
+// 効果音キュー一覧のレスポンス
+type SfxCueListResponse struct {
+	Data []SfxCueResponse `json:"data" validate:"required"`
+}
+
+// 効果音キュー単体のレスポンス
+type SfxCueDataResponse struct {
+	Data SfxCueResponse `json:"data" validate:"required"`
+}
+
+// 効果音キューのレスポンス
+type SfxCueResponse struct {
+	ID           uuid.UUID           `json:"id" validate:"required"`
+	ScriptLineID uuid.UUID           `json:"scriptLineId" validate:"required"`
+	Position     string              `json:"position" validate:"required"`
+	VolumeDB     float64             `json:"volumeDb" validate:"required"`
+	SoundEffect  SoundEffectResponse `json:"soundEffect" validate:"required"`
+	CreatedAt    time.Time           `json:"createdAt" validate:"required"`
+}
@@ -0,0 +1,42 @@
+package response
+
+// 共有用メタデータのレスポンス
+type ShareMetadataResponse struct {
+	Title       string  `json:"title" validate:"required"`
+	Description string  `json:"description" validate:"required"`
+	URL         string  `json:"url" validate:"required"`
+	ImageURL    *string `json:"imageUrl" extensions:"x-nullable"`
+	AudioURL    *string `json:"audioUrl" extensions:"x-nullable"`
+	EmbedURL    *string `json:"embedUrl" extensions:"x-nullable"`
+	OEmbedURL   string  `json:"oembedUrl" validate:"required"`
+	// <meta property="..." content="..."> として出力する Open Graph のタグ
+	OpenGraph []ShareMetaTagResponse `json:"openGraph" validate:"required"`
+	// <meta name="..." content="..."> として出力する Twitter カードのタグ
+	Twitter []ShareMetaTagResponse `json:"twitter" validate:"required"`
+}
+
+// 共有用メタデータの meta タグ
+type ShareMetaTagResponse struct {
+	Name    string `json:"name" validate:"required"`
+	Content string `json:"content" validate:"required"`
+}
+
+// 共有用メタデータのレスポンス（data ラッパー）
+type ShareMetadataDataResponse struct {
+	Data ShareMetadataResponse `json:"data" validate:"required"`
+}
+
+// oEmbed のレスポンス（oEmbed 仕様に合わせてフィールド名はスネークケース、data ラッパーなし）
+type OEmbedResponse struct {
+	Type         string  `json:"type" validate:"required"`
+	Version      string  `json:"version" validate:"required"`
+	Title        string  `json:"title" validate:"required"`
+	AuthorName   string  `json:"author_name" validate:"required"`
+	AuthorURL    string  `json:"author_url" validate:"required"`
+	ProviderName string  `json:"provider_name" validate:"required"`
+	ProviderURL  string  `json:"provider_url" validate:"required"`
+	CacheAge     int     `json:"cache_age" validate:"required"`
+	HTML         *string `json:"html,omitempty"`
+	Width        *int    `json:"width,omitempty"`
+	Height       *int    `json:"height,omitempty"`
+}
@@ -0,0 +1,35 @@
+package response
+
+import (
+	"time"
+
+	"github.com/siropaca/anycast-backend/internal/pkg/uuid"
+)
+
+// 効果音一覧（ページネーション付き）のレスポンス
+type SoundEffectListWithPaginationResponse struct {
+	Data       []SoundEffectResponse `json:"data" validate:"required"`
+	Pagination PaginationResponse    `json:"pagination" validate:"required"`
+}
+
+// 効果音単体のレスポンス
+type SoundEffectDataResponse struct {
+	Data SoundEffectResponse `json:"data" validate:"required"`
+}
+
+// 効果音のレスポンス
+type SoundEffectResponse struct {
+	ID        uuid.UUID                `json:"id" validate:"required"`
+	Name      string                   `json:"name" validate:"required"`
+	IsSystem  bool                     `json:"isSystem" validate:"required"`
+	Audio     SoundEffectAudioResponse `json:"audio" validate:"required"`
+	CreatedAt time.Time                `json:"createdAt" validate:"required"`
+	UpdatedAt time.Time                `json:"updatedAt" validate:"required"`
+}
+
+// 効果音に紐づく音声情報のレスポンス
+type SoundEffectAudioResponse struct {
+	ID         uuid.UUID `json:"id" validate:"required"`
+	URL        string    `json:"url" validate:"required"`
+	DurationMs int       `json:"durationMs" validate:"required"`
+}
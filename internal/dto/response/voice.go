@@ -33,3 +33,24 @@ type FavoriteVoiceResponse struct {
 	VoiceID   uuid.UUID `json:"voiceId" validate:"required"`
 	CreatedAt time.Time `json:"createdAt" validate:"required"`
 }
+
+// ボイス試聴のレスポンス
+type VoiceAuditionResponse struct {
+	URL      string `json:"url" validate:"required"`
+	MimeType string `json:"mimeType" validate:"required"`
+	Cached   bool   `json:"cached" validate:"required"`
+}
+
+// ボイス試聴のレスポンス（data ラッパー）
+type VoiceAuditionDataResponse struct {
+	Data VoiceAuditionResponse `json:"data" validate:"required"`
+}
+
+// 音声合成パラメータのレスポンス
+type VoiceSettingsResponse struct {
+	SpeakingRate    *float64 `json:"speakingRate" extensions:"x-nullable"`
+	Pitch           *float64 `json:"pitch" extensions:"x-nullable"`
+	Stability       *float64 `json:"stability" extensions:"x-nullable"`
+	SimilarityBoost *float64 `json:"similarityBoost" extensions:"x-nullable"`
+	Style           *float64 `json:"style" extensions:"x-nullable"`
+}
@@ -51,6 +51,16 @@ func (m *mockAudioJobService) ListMyJobs(ctx context.Context, userID string, fil
 	return args.Get(0).(*response.AudioJobListResponse), args.Error(1)
 }
 
+func (m *mockAudioJobService) CreateRenditionJob(ctx context.Context, userID, episodeID uuid.UUID) error {
+	args := m.Called(ctx, userID, episodeID)
+	return args.Error(0)
+}
+
+func (m *mockAudioJobService) CreateRetagJob(ctx context.Context, userID, episodeID uuid.UUID) error {
+	args := m.Called(ctx, userID, episodeID)
+	return args.Error(0)
+}
+
 func (m *mockAudioJobService) ExecuteJob(ctx context.Context, jobID string) error {
 	args := m.Called(ctx, jobID)
 	return args.Error(0)
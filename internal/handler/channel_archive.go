@@ -0,0 +1,121 @@
+package handler
+
+import (
+	"net/http"
+
+	"github.com/gin-gonic/gin"
+
+	"github.com/siropaca/anycast-backend/internal/apperror"
+	"github.com/siropaca/anycast-backend/internal/dto/request"
+	"github.com/siropaca/anycast-backend/internal/middleware"
+	"github.com/siropaca/anycast-backend/internal/service"
+)
+
+// チャンネルのエクスポート・インポート関連のハンドラー
+type ChannelArchiveHandler struct {
+	channelArchiveService service.ChannelArchiveService
+}
+
+// ChannelArchiveHandler を作成する
+func NewChannelArchiveHandler(cas service.ChannelArchiveService) *ChannelArchiveHandler {
+	return &ChannelArchiveHandler{channelArchiveService: cas}
+}
+
+// ExportChannel godoc
+// @Summary チャンネルのエクスポート
+// @Description チャンネルの設定・キャラクター・エピソード・台本・BGM・効果音・読み方辞書とメディアファイルをまとめたアーカイブ（ZIP）を作成し、署名付き URL を返します。内容が変わっていなければ作成済みの ZIP を返します。
+// @Tags channels
+// @Produce json
+// @Param channelId path string true "チャンネル ID"
+// @Success 200 {object} response.ChannelArchiveDataResponse
+// @Failure 400 {object} response.ErrorResponse
+// @Failure 401 {object} response.ErrorResponse
+// @Failure 403 {object} response.ErrorResponse
+// @Failure 404 {object} response.ErrorResponse
+// @Failure 500 {object} response.ErrorResponse
+// @Security BearerAuth
+// @Router /channels/{channelId}/archive [post]
+func (h *ChannelArchiveHandler) ExportChannel(c *gin.Context) {
+	userID, ok := middleware.GetUserID(c)
+	if !ok {
+		Error(c, apperror.ErrUnauthorized)
+		return
+	}
+
+	channelID := c.Param("channelId")
+	if channelID == "" {
+		Error(c, apperror.ErrValidation.WithMessage("channelId は必須です"))
+		return
+	}
+
+	result, err := h.channelArchiveService.ExportChannel(c.Request.Context(), userID, channelID)
+	if err != nil {
+		Error(c, err)
+		return
+	}
+
+	c.JSON(http.StatusOK, result)
+}
+
+// ImportChannel godoc
+// @Summary チャンネルのインポート
+// @Description チャンネルのエクスポートで作成したアーカイブ（ZIP）から、ログイン中のユーザーのチャンネルを作成します。ID はすべて採番し直し、ボイスは Provider と ProviderVoiceID で照合します。ボイス・カテゴリが見つからない場合は 409 を返します。dryRun=true の場合は競合の確認のみ行います。
+// @Tags channels
+// @Accept multipart/form-data
+// @Produce json
+// @Param file formData file true "チャンネルアーカイブ（ZIP、最大 2GB）"
+// @Param dryRun query bool false "競合の確認のみ行い、チャンネルを作成しない"
+// @Success 200 {object} response.ChannelArchiveImportDataResponse "dryRun=true の場合"
+// @Success 201 {object} response.ChannelArchiveImportDataResponse
+// @Failure 400 {object} response.ErrorResponse
+// @Failure 401 {object} response.ErrorResponse
+// @Failure 409 {object} response.ErrorResponse
+// @Failure 500 {object} response.ErrorResponse
+// @Security BearerAuth
+// @Router /channel-archives/import [post]
+func (h *ChannelArchiveHandler) ImportChannel(c *gin.Context) {
+	userID, ok := middleware.GetUserID(c)
+	if !ok {
+		Error(c, apperror.ErrUnauthorized)
+		return
+	}
+
+	var req request.ImportChannelArchiveRequest
+	if err := c.ShouldBindQuery(&req); err != nil {
+		Error(c, apperror.ErrValidation.WithMessage(err.Error()))
+		return
+	}
+
+	// ファイルの取得
+	fileHeader, err := c.FormFile("file")
+	if err != nil {
+		Error(c, apperror.ErrValidation.WithMessage("ファイルは必須です"))
+		return
+	}
+
+	// ファイルを開く
+	file, err := fileHeader.Open()
+	if err != nil {
+		Error(c, apperror.ErrInternal.WithMessage("ファイルを開けませんでした").WithError(err))
+		return
+	}
+	defer file.Close()
+
+	input := service.ImportChannelArchiveInput{
+		File:   file,
+		Size:   fileHeader.Size,
+		DryRun: req.DryRun,
+	}
+
+	result, err := h.channelArchiveService.ImportChannel(c.Request.Context(), userID, input)
+	if err != nil {
+		Error(c, err)
+		return
+	}
+
+	status := http.StatusCreated
+	if req.DryRun {
+		status = http.StatusOK
+	}
+	c.JSON(status, result)
+}
@@ -0,0 +1,178 @@
+package handler
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/gin-gonic/gin"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+
+	"github.com/siropaca/anycast-backend/internal/apperror"
+	"github.com/siropaca/anycast-backend/internal/dto/response"
+	"github.com/siropaca/anycast-backend/internal/middleware"
+	"github.com/siropaca/anycast-backend/internal/pkg/uuid"
+	"github.com/siropaca/anycast-backend/internal/service"
+)
+
+// ChannelArchiveService のモック
+type mockChannelArchiveService struct {
+	mock.Mock
+}
+
+func (m *mockChannelArchiveService) ExportChannel(ctx context.Context, userID, channelID string) (*response.ChannelArchiveDataResponse, error) {
+	args := m.Called(ctx, userID, channelID)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).(*response.ChannelArchiveDataResponse), args.Error(1)
+}
+
+func (m *mockChannelArchiveService) ImportChannel(ctx context.Context, userID string, input service.ImportChannelArchiveInput) (*response.ChannelArchiveImportDataResponse, error) {
+	args := m.Called(ctx, userID, input.Size, input.DryRun)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).(*response.ChannelArchiveImportDataResponse), args.Error(1)
+}
+
+func setupChannelArchiveRouter(service *mockChannelArchiveService) *gin.Engine {
+	gin.SetMode(gin.TestMode)
+	r := gin.New()
+
+	handler := NewChannelArchiveHandler(service)
+
+	// 認証済みユーザーをシミュレートするミドルウェア
+	authMiddleware := func(c *gin.Context) {
+		c.Set(string(middleware.UserIDKey), "user-123")
+		c.Next()
+	}
+
+	r.POST("/channels/:channelId/archive", authMiddleware, handler.ExportChannel)
+	r.POST("/channel-archives/import", authMiddleware, handler.ImportChannel)
+
+	return r
+}
+
+func TestChannelArchiveHandler_ExportChannel(t *testing.T) {
+	channelID := uuid.New().String()
+
+	t.Run("チャンネルアーカイブの署名付き URL を返す", func(t *testing.T) {
+		mockService := new(mockChannelArchiveService)
+		mockService.On("ExportChannel", mock.Anything, "user-123", channelID).Return(&response.ChannelArchiveDataResponse{
+			Data: response.ChannelArchiveResponse{
+				URL:      "https://storage.example.com/channel.zip",
+				Filename: "channel-" + channelID + ".zip",
+				MimeType: "application/zip",
+			},
+		}, nil)
+		router := setupChannelArchiveRouter(mockService)
+
+		w := httptest.NewRecorder()
+		req := httptest.NewRequest(http.MethodPost, "/channels/"+channelID+"/archive", nil)
+		router.ServeHTTP(w, req)
+
+		assert.Equal(t, http.StatusOK, w.Code)
+
+		var body response.ChannelArchiveDataResponse
+		require.NoError(t, json.Unmarshal(w.Body.Bytes(), &body))
+		assert.Equal(t, "https://storage.example.com/channel.zip", body.Data.URL)
+		assert.Equal(t, "channel-"+channelID+".zip", body.Data.Filename)
+	})
+
+	t.Run("オーナーでない場合は 403 を返す", func(t *testing.T) {
+		mockService := new(mockChannelArchiveService)
+		mockService.On("ExportChannel", mock.Anything, "user-123", channelID).Return(nil, apperror.ErrForbidden)
+		router := setupChannelArchiveRouter(mockService)
+
+		w := httptest.NewRecorder()
+		req := httptest.NewRequest(http.MethodPost, "/channels/"+channelID+"/archive", nil)
+		router.ServeHTTP(w, req)
+
+		assert.Equal(t, http.StatusForbidden, w.Code)
+	})
+}
+
+func TestChannelArchiveHandler_ImportChannel(t *testing.T) {
+	content := []byte("PK\x05\x06archive")
+
+	t.Run("チャンネルを作成して 201 を返す", func(t *testing.T) {
+		channelID := uuid.New()
+		mockService := new(mockChannelArchiveService)
+		mockService.On("ImportChannel", mock.Anything, "user-123", int64(len(content)), false).Return(&response.ChannelArchiveImportDataResponse{
+			Data: response.ChannelArchiveImportResponse{
+				Channel:   &response.ChannelArchiveImportChannelResponse{ID: channelID, Name: "テックトーク"},
+				Conflicts: []response.ChannelArchiveConflictResponse{},
+			},
+		}, nil)
+		router := setupChannelArchiveRouter(mockService)
+
+		body, contentType := createAudioMultipartForm(t, "channel.zip", "application/zip", content)
+		w := httptest.NewRecorder()
+		req := httptest.NewRequest(http.MethodPost, "/channel-archives/import", body)
+		req.Header.Set("Content-Type", contentType)
+		router.ServeHTTP(w, req)
+
+		assert.Equal(t, http.StatusCreated, w.Code)
+
+		var resp response.ChannelArchiveImportDataResponse
+		require.NoError(t, json.Unmarshal(w.Body.Bytes(), &resp))
+		assert.Equal(t, channelID, resp.Data.Channel.ID)
+	})
+
+	t.Run("dryRun の場合は 200 を返す", func(t *testing.T) {
+		mockService := new(mockChannelArchiveService)
+		mockService.On("ImportChannel", mock.Anything, "user-123", int64(len(content)), true).Return(&response.ChannelArchiveImportDataResponse{
+			Data: response.ChannelArchiveImportResponse{DryRun: true, Conflicts: []response.ChannelArchiveConflictResponse{}},
+		}, nil)
+		router := setupChannelArchiveRouter(mockService)
+
+		body, contentType := createAudioMultipartForm(t, "channel.zip", "application/zip", content)
+		w := httptest.NewRecorder()
+		req := httptest.NewRequest(http.MethodPost, "/channel-archives/import?dryRun=true", body)
+		req.Header.Set("Content-Type", contentType)
+		router.ServeHTTP(w, req)
+
+		assert.Equal(t, http.StatusOK, w.Code)
+		mockService.AssertExpectations(t)
+	})
+
+	t.Run("ファイルがない場合は 400 を返す", func(t *testing.T) {
+		mockService := new(mockChannelArchiveService)
+		router := setupChannelArchiveRouter(mockService)
+
+		w := httptest.NewRecorder()
+		req := httptest.NewRequest(http.MethodPost, "/channel-archives/import", nil)
+		req.Header.Set("Content-Type", "multipart/form-data")
+		router.ServeHTTP(w, req)
+
+		assert.Equal(t, http.StatusBadRequest, w.Code)
+		mockService.AssertNotCalled(t, "ImportChannel", mock.Anything, mock.Anything, mock.Anything, mock.Anything)
+	})
+
+	t.Run("ボイスが見つからない場合は 409 と競合の一覧を返す", func(t *testing.T) {
+		mockService := new(mockChannelArchiveService)
+		mockService.On("ImportChannel", mock.Anything, "user-123", int64(len(content)), false).Return(nil, apperror.ErrImportConflict.WithDetails([]response.ChannelArchiveConflictResponse{
+			{Type: "voice", Name: "google/ja-JP-Voice-A", Resolution: "blocked", Message: "ボイスが見つかりません"},
+		}))
+		router := setupChannelArchiveRouter(mockService)
+
+		body, contentType := createAudioMultipartForm(t, "channel.zip", "application/zip", content)
+		w := httptest.NewRecorder()
+		req := httptest.NewRequest(http.MethodPost, "/channel-archives/import", body)
+		req.Header.Set("Content-Type", contentType)
+		router.ServeHTTP(w, req)
+
+		assert.Equal(t, http.StatusConflict, w.Code)
+
+		var resp map[string]any
+		require.NoError(t, json.Unmarshal(w.Body.Bytes(), &resp))
+		errBody := resp["error"].(map[string]any)
+		assert.Equal(t, "IMPORT_CONFLICT", errBody["code"])
+		assert.Len(t, errBody["details"], 1)
+	})
+}
@@ -111,6 +111,38 @@ func (m *mockChannelService) DeleteDefaultBgm(ctx context.Context, userID, chann
 	return args.Get(0).(*response.ChannelDataResponse), args.Error(1)
 }
 
+func (m *mockChannelService) SetIntro(ctx context.Context, userID, channelID string, req request.SetChannelSegmentRequest) (*response.ChannelDataResponse, error) {
+	args := m.Called(ctx, userID, channelID, req)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).(*response.ChannelDataResponse), args.Error(1)
+}
+
+func (m *mockChannelService) DeleteIntro(ctx context.Context, userID, channelID string) (*response.ChannelDataResponse, error) {
+	args := m.Called(ctx, userID, channelID)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).(*response.ChannelDataResponse), args.Error(1)
+}
+
+func (m *mockChannelService) SetOutro(ctx context.Context, userID, channelID string, req request.SetChannelSegmentRequest) (*response.ChannelDataResponse, error) {
+	args := m.Called(ctx, userID, channelID, req)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).(*response.ChannelDataResponse), args.Error(1)
+}
+
+func (m *mockChannelService) DeleteOutro(ctx context.Context, userID, channelID string) (*response.ChannelDataResponse, error) {
+	args := m.Called(ctx, userID, channelID)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).(*response.ChannelDataResponse), args.Error(1)
+}
+
 func (m *mockChannelService) AddChannelCharacter(ctx context.Context, userID, channelID string, req request.AddChannelCharacterRequest) (*response.ChannelDataResponse, error) {
 	args := m.Called(ctx, userID, channelID, req)
 	if args.Get(0) == nil {
@@ -25,7 +25,7 @@ func NewCleanupHandler(cs service.CleanupService, sc storage.Client) *CleanupHan
 
 // CleanupOrphanedMedia godoc
 // @Summary 孤児メディアファイル削除
-// @Description どのテーブルからも参照されていない audios / images レコードを検出し、GCS ファイルと DB レコードを削除する
+// @Description どのテーブルからも参照されていない audios / images / videos レコードを検出し、GCS ファイルと DB レコードを削除する
 // @Tags admin
 // @Accept json
 // @Produce json
@@ -53,10 +53,13 @@ func toCleanupResponse(result *service.CleanupResult, dryRun bool, storageClient
 		DryRun:            dryRun,
 		OrphanedAudios:    toOrphanedAudioResponses(result.OrphanedAudios, storageClient, c),
 		OrphanedImages:    toOrphanedImageResponses(result.OrphanedImages, storageClient, c),
+		OrphanedVideos:    toOrphanedVideoResponses(result.OrphanedVideos, storageClient, c),
 		DeletedAudioCount: result.DeletedAudioCount,
 		DeletedImageCount: result.DeletedImageCount,
+		DeletedVideoCount: result.DeletedVideoCount,
 		FailedAudioCount:  result.FailedAudioCount,
 		FailedImageCount:  result.FailedImageCount,
+		FailedVideoCount:  result.FailedVideoCount,
 	}
 }
 
@@ -115,3 +118,31 @@ func toOrphanedImageResponse(image *model.Image, storageClient storage.Client, c
 		CreatedAt: image.CreatedAt,
 	}
 }
+
+// Video モデルのスライスをレスポンス DTO のスライスに変換する
+func toOrphanedVideoResponses(videos []model.Video, storageClient storage.Client, c *gin.Context) []response.OrphanedVideoResponse {
+	result := make([]response.OrphanedVideoResponse, len(videos))
+	for i, video := range videos {
+		result[i] = toOrphanedVideoResponse(&video, storageClient, c)
+	}
+	return result
+}
+
+// Video モデルをレスポンス DTO に変換する
+func toOrphanedVideoResponse(video *model.Video, storageClient storage.Client, c *gin.Context) response.OrphanedVideoResponse {
+	url := ""
+	if storageClient != nil {
+		signedURL, err := storageClient.GenerateSignedURL(c.Request.Context(), video.Path, 1*time.Hour)
+		if err == nil {
+			url = signedURL
+		}
+	}
+
+	return response.OrphanedVideoResponse{
+		ID:        video.ID,
+		URL:       url,
+		Filename:  video.Filename,
+		FileSize:  video.FileSize,
+		CreatedAt: video.CreatedAt,
+	}
+}
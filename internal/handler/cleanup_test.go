@@ -3,6 +3,7 @@ package handler
 import (
 	"context"
 	"encoding/json"
+	"io"
 	"net/http"
 	"net/http/httptest"
 	"testing"
@@ -41,6 +42,35 @@ func (m *mockStorageClient) Upload(ctx context.Context, data []byte, path, conte
 	return args.String(0), args.Error(1)
 }
 
+func (m *mockStorageClient) UploadStream(ctx context.Context, r io.Reader, path, contentType string) (string, error) {
+	data, err := io.ReadAll(r)
+	if err != nil {
+		return "", err
+	}
+	args := m.Called(ctx, data, path, contentType)
+	return args.String(0), args.Error(1)
+}
+
+func (m *mockStorageClient) DownloadStream(ctx context.Context, path string, w io.Writer) (int64, error) {
+	args := m.Called(ctx, path)
+	if args.Get(0) == nil {
+		return 0, args.Error(1)
+	}
+	n, err := w.Write(args.Get(0).([]byte))
+	if err != nil {
+		return int64(n), err
+	}
+	return int64(n), args.Error(1)
+}
+
+func (m *mockStorageClient) OpenRange(ctx context.Context, path string, offset, length int64) (io.ReadCloser, error) {
+	args := m.Called(ctx, path, offset, length)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).(io.ReadCloser), args.Error(1)
+}
+
 func (m *mockStorageClient) GenerateSignedURL(ctx context.Context, path string, expiration time.Duration) (string, error) {
 	args := m.Called(ctx, path, expiration)
 	return args.String(0), args.Error(1)
@@ -51,6 +81,11 @@ func (m *mockStorageClient) Delete(ctx context.Context, path string) error {
 	return args.Error(0)
 }
 
+func (m *mockStorageClient) Exists(ctx context.Context, path string) (bool, error) {
+	args := m.Called(ctx, path)
+	return args.Bool(0), args.Error(1)
+}
+
 func (m *mockStorageClient) Close() error {
 	args := m.Called()
 	return args.Error(0)
@@ -70,6 +105,7 @@ func TestCleanupHandler_CleanupOrphanedMedia(t *testing.T) {
 
 		audioID := uuid.New()
 		imageID := uuid.New()
+		videoID := uuid.New()
 		now := time.Now()
 
 		result := &service.CleanupResult{
@@ -79,6 +115,9 @@ func TestCleanupHandler_CleanupOrphanedMedia(t *testing.T) {
 			OrphanedImages: []model.Image{
 				{ID: imageID, Path: "images/test.png", Filename: "test.png", FileSize: 512, CreatedAt: now},
 			},
+			OrphanedVideos: []model.Video{
+				{ID: videoID, Path: "videos/test.mp4", Filename: "test.mp4", FileSize: 2048, CreatedAt: now},
+			},
 			DeletedAudioCount: 0,
 			DeletedImageCount: 0,
 			FailedAudioCount:  0,
@@ -87,6 +126,7 @@ func TestCleanupHandler_CleanupOrphanedMedia(t *testing.T) {
 		mockSvc.On("CleanupOrphanedMedia", mock.Anything, true).Return(result, nil)
 		mockStorage.On("GenerateSignedURL", mock.Anything, "audios/test.mp3", 1*time.Hour).Return("https://signed-url/audio", nil)
 		mockStorage.On("GenerateSignedURL", mock.Anything, "images/test.png", 1*time.Hour).Return("https://signed-url/image", nil)
+		mockStorage.On("GenerateSignedURL", mock.Anything, "videos/test.mp4", 1*time.Hour).Return("https://signed-url/video", nil)
 
 		handler := NewCleanupHandler(mockSvc, mockStorage)
 		router := setupCleanupRouter(handler)
@@ -105,6 +145,7 @@ func TestCleanupHandler_CleanupOrphanedMedia(t *testing.T) {
 		assert.True(t, data["dryRun"].(bool))
 		assert.Len(t, data["orphanedAudios"], 1)
 		assert.Len(t, data["orphanedImages"], 1)
+		assert.Len(t, data["orphanedVideos"], 1)
 		assert.Equal(t, float64(0), data["deletedAudioCount"])
 		assert.Equal(t, float64(0), data["deletedImageCount"])
 
@@ -0,0 +1,117 @@
+package handler
+
+import (
+	"net/http"
+
+	"github.com/gin-gonic/gin"
+
+	"github.com/siropaca/anycast-backend/internal/apperror"
+	"github.com/siropaca/anycast-backend/internal/dto/request"
+	"github.com/siropaca/anycast-backend/internal/middleware"
+	"github.com/siropaca/anycast-backend/internal/service"
+)
+
+// ClipJobHandler はクリップ動画生成ジョブ関連のハンドラー
+type ClipJobHandler struct {
+	clipJobService service.ClipJobService
+}
+
+// NewClipJobHandler は ClipJobHandler を作成する
+func NewClipJobHandler(cjs service.ClipJobService) *ClipJobHandler {
+	return &ClipJobHandler{clipJobService: cjs}
+}
+
+// CreateClipJob godoc
+// @Summary クリップ動画生成
+// @Description エピソードの一部を切り出し、アートワーク・波形アニメーション・台本の字幕を重ねた SNS 用の MP4 動画を非同期で生成します。範囲は startMs・endMs か startScriptLineId・endScriptLineId のどちらか一方で指定します。進捗は WebSocket で通知されます。チャンネルのオーナーのみ実行できます。
+// @Tags clip-jobs
+// @Accept json
+// @Produce json
+// @Param channelId path string true "チャンネル ID"
+// @Param episodeId path string true "エピソード ID"
+// @Param body body request.CreateClipJobRequest true "切り出す範囲と動画の設定"
+// @Success 202 {object} response.ClipJobDataResponse
+// @Failure 400 {object} response.ErrorResponse
+// @Failure 401 {object} response.ErrorResponse
+// @Failure 403 {object} response.ErrorResponse
+// @Failure 404 {object} response.ErrorResponse
+// @Failure 500 {object} response.ErrorResponse
+// @Security BearerAuth
+// @Router /channels/{channelId}/episodes/{episodeId}/clips [post]
+func (h *ClipJobHandler) CreateClipJob(c *gin.Context) {
+	userID, ok := middleware.GetUserID(c)
+	if !ok {
+		Error(c, apperror.ErrUnauthorized)
+		return
+	}
+
+	var req request.CreateClipJobRequest
+	if err := c.ShouldBindJSON(&req); err != nil {
+		Error(c, apperror.ErrValidation.WithMessage(formatValidationError(err)))
+		return
+	}
+
+	result, err := h.clipJobService.CreateJob(c.Request.Context(), userID, c.Param("channelId"), c.Param("episodeId"), req)
+	if err != nil {
+		Error(c, err)
+		return
+	}
+
+	c.JSON(http.StatusAccepted, gin.H{"data": result})
+}
+
+// GetClipJob godoc
+// @Summary クリップ動画生成ジョブ詳細取得
+// @Description クリップ動画生成ジョブの詳細を取得します。完了したジョブには動画の署名付き URL が含まれます。
+// @Tags clip-jobs
+// @Produce json
+// @Param jobId path string true "ジョブ ID"
+// @Success 200 {object} response.ClipJobDataResponse
+// @Failure 400 {object} response.ErrorResponse
+// @Failure 401 {object} response.ErrorResponse
+// @Failure 403 {object} response.ErrorResponse
+// @Failure 404 {object} response.ErrorResponse
+// @Failure 500 {object} response.ErrorResponse
+// @Security BearerAuth
+// @Router /clip-jobs/{jobId} [get]
+func (h *ClipJobHandler) GetClipJob(c *gin.Context) {
+	userID, ok := middleware.GetUserID(c)
+	if !ok {
+		Error(c, apperror.ErrUnauthorized)
+		return
+	}
+
+	result, err := h.clipJobService.GetJob(c.Request.Context(), userID, c.Param("jobId"))
+	if err != nil {
+		Error(c, err)
+		return
+	}
+
+	c.JSON(http.StatusOK, gin.H{"data": result})
+}
+
+// ListMyClipJobs godoc
+// @Summary 自分のクリップ動画生成ジョブ一覧取得
+// @Description 自分のクリップ動画生成ジョブ一覧を取得します
+// @Tags me
+// @Produce json
+// @Success 200 {object} response.ClipJobListResponse
+// @Failure 401 {object} response.ErrorResponse
+// @Failure 500 {object} response.ErrorResponse
+// @Security BearerAuth
+// @Router /me/clip-jobs [get]
+func (h *ClipJobHandler) ListMyClipJobs(c *gin.Context) {
+	userID, ok := middleware.GetUserID(c)
+	if !ok {
+		Error(c, apperror.ErrUnauthorized)
+		return
+	}
+
+	result, err := h.clipJobService.ListMyJobs(c.Request.Context(), userID)
+	if err != nil {
+		Error(c, err)
+		return
+	}
+
+	c.JSON(http.StatusOK, result)
+}
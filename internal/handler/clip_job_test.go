@@ -0,0 +1,213 @@
+package handler
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+
+	"github.com/gin-gonic/gin"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+
+	"github.com/siropaca/anycast-backend/internal/apperror"
+	"github.com/siropaca/anycast-backend/internal/dto/request"
+	"github.com/siropaca/anycast-backend/internal/dto/response"
+	"github.com/siropaca/anycast-backend/internal/middleware"
+	"github.com/siropaca/anycast-backend/internal/pkg/uuid"
+)
+
+// ClipJobService のモック
+type mockClipJobService struct {
+	mock.Mock
+}
+
+func (m *mockClipJobService) CreateJob(ctx context.Context, userID, channelID, episodeID string, req request.CreateClipJobRequest) (*response.ClipJobResponse, error) {
+	args := m.Called(ctx, userID, channelID, episodeID, req)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).(*response.ClipJobResponse), args.Error(1)
+}
+
+func (m *mockClipJobService) GetJob(ctx context.Context, userID, jobID string) (*response.ClipJobResponse, error) {
+	args := m.Called(ctx, userID, jobID)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).(*response.ClipJobResponse), args.Error(1)
+}
+
+func (m *mockClipJobService) ListMyJobs(ctx context.Context, userID string) (*response.ClipJobListResponse, error) {
+	args := m.Called(ctx, userID)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).(*response.ClipJobListResponse), args.Error(1)
+}
+
+func (m *mockClipJobService) ExecuteJob(ctx context.Context, jobID string) error {
+	args := m.Called(ctx, jobID)
+	return args.Error(0)
+}
+
+func setupClipJobRouter(service *mockClipJobService) *gin.Engine {
+	gin.SetMode(gin.TestMode)
+	r := gin.New()
+
+	handler := NewClipJobHandler(service)
+
+	// 認証済みユーザーをシミュレートするミドルウェア
+	authMiddleware := func(c *gin.Context) {
+		c.Set(string(middleware.UserIDKey), "user-123")
+		c.Next()
+	}
+
+	r.POST("/channels/:channelId/episodes/:episodeId/clips", authMiddleware, handler.CreateClipJob)
+	r.GET("/clip-jobs/:jobId", authMiddleware, handler.GetClipJob)
+	r.GET("/me/clip-jobs", authMiddleware, handler.ListMyClipJobs)
+
+	return r
+}
+
+func TestClipJobHandler_CreateClipJob(t *testing.T) {
+	channelID := uuid.New()
+	episodeID := uuid.New()
+	path := "/channels/" + channelID.String() + "/episodes/" + episodeID.String() + "/clips"
+
+	t.Run("クリップ動画生成ジョブを作成して 202 を返す", func(t *testing.T) {
+		mockService := new(mockClipJobService)
+		startMs, endMs := 1000, 31000
+		portrait := "portrait"
+		mockService.On("CreateJob", mock.Anything, "user-123", channelID.String(), episodeID.String(), request.CreateClipJobRequest{
+			StartMs:     &startMs,
+			EndMs:       &endMs,
+			AspectRatio: &portrait,
+		}).Return(&response.ClipJobResponse{
+			ID:          uuid.New(),
+			Episode:     response.ClipJobEpisodeResponse{ID: episodeID, Title: "第1回"},
+			StartMs:     startMs,
+			EndMs:       endMs,
+			AspectRatio: portrait,
+			Captions:    true,
+			Status:      "pending",
+		}, nil)
+
+		router := setupClipJobRouter(mockService)
+		req := httptest.NewRequest(http.MethodPost, path, strings.NewReader(`{"startMs":1000,"endMs":31000,"aspectRatio":"portrait"}`))
+		req.Header.Set("Content-Type", "application/json")
+		rec := httptest.NewRecorder()
+
+		router.ServeHTTP(rec, req)
+
+		assert.Equal(t, http.StatusAccepted, rec.Code)
+
+		var resp map[string]response.ClipJobResponse
+		err := json.Unmarshal(rec.Body.Bytes(), &resp)
+		require.NoError(t, err)
+		assert.Equal(t, "pending", resp["data"].Status)
+		assert.Equal(t, "portrait", resp["data"].AspectRatio)
+		mockService.AssertExpectations(t)
+	})
+
+	t.Run("aspectRatio が不正な場合は 400 を返す", func(t *testing.T) {
+		mockService := new(mockClipJobService)
+
+		router := setupClipJobRouter(mockService)
+		req := httptest.NewRequest(http.MethodPost, path, strings.NewReader(`{"startMs":0,"endMs":10000,"aspectRatio":"wide"}`))
+		req.Header.Set("Content-Type", "application/json")
+		rec := httptest.NewRecorder()
+
+		router.ServeHTTP(rec, req)
+
+		assert.Equal(t, http.StatusBadRequest, rec.Code)
+		mockService.AssertNotCalled(t, "CreateJob", mock.Anything, mock.Anything, mock.Anything, mock.Anything, mock.Anything)
+	})
+
+	t.Run("台本行 ID が UUID でない場合は 400 を返す", func(t *testing.T) {
+		mockService := new(mockClipJobService)
+
+		router := setupClipJobRouter(mockService)
+		req := httptest.NewRequest(http.MethodPost, path, strings.NewReader(`{"startScriptLineId":"line-1","endScriptLineId":"line-2"}`))
+		req.Header.Set("Content-Type", "application/json")
+		rec := httptest.NewRecorder()
+
+		router.ServeHTTP(rec, req)
+
+		assert.Equal(t, http.StatusBadRequest, rec.Code)
+	})
+
+	t.Run("オーナー以外は 403 を返す", func(t *testing.T) {
+		mockService := new(mockClipJobService)
+		mockService.On("CreateJob", mock.Anything, "user-123", channelID.String(), episodeID.String(), mock.Anything).Return(nil, apperror.ErrForbidden)
+
+		router := setupClipJobRouter(mockService)
+		req := httptest.NewRequest(http.MethodPost, path, strings.NewReader(`{"startMs":0,"endMs":10000}`))
+		req.Header.Set("Content-Type", "application/json")
+		rec := httptest.NewRecorder()
+
+		router.ServeHTTP(rec, req)
+
+		assert.Equal(t, http.StatusForbidden, rec.Code)
+	})
+}
+
+func TestClipJobHandler_GetClipJob(t *testing.T) {
+	t.Run("完了したジョブの動画を返す", func(t *testing.T) {
+		jobID := uuid.New()
+		mockService := new(mockClipJobService)
+		mockService.On("GetJob", mock.Anything, "user-123", jobID.String()).Return(&response.ClipJobResponse{
+			ID:       jobID,
+			Status:   "completed",
+			Progress: 100,
+			Video: &response.VideoResponse{
+				ID:         uuid.New(),
+				URL:        "https://storage.example.com/videos/clip.mp4",
+				MimeType:   "video/mp4",
+				DurationMs: 30000,
+				Width:      1080,
+				Height:     1080,
+			},
+		}, nil)
+
+		router := setupClipJobRouter(mockService)
+		req := httptest.NewRequest(http.MethodGet, "/clip-jobs/"+jobID.String(), http.NoBody)
+		rec := httptest.NewRecorder()
+
+		router.ServeHTTP(rec, req)
+
+		assert.Equal(t, http.StatusOK, rec.Code)
+
+		var resp map[string]response.ClipJobResponse
+		err := json.Unmarshal(rec.Body.Bytes(), &resp)
+		require.NoError(t, err)
+		require.NotNil(t, resp["data"].Video)
+		assert.Equal(t, "video/mp4", resp["data"].Video.MimeType)
+	})
+}
+
+func TestClipJobHandler_ListMyClipJobs(t *testing.T) {
+	t.Run("自分のジョブ一覧を返す", func(t *testing.T) {
+		mockService := new(mockClipJobService)
+		mockService.On("ListMyJobs", mock.Anything, "user-123").Return(&response.ClipJobListResponse{
+			Data: []response.ClipJobResponse{{ID: uuid.New(), Status: "processing"}},
+		}, nil)
+
+		router := setupClipJobRouter(mockService)
+		req := httptest.NewRequest(http.MethodGet, "/me/clip-jobs", http.NoBody)
+		rec := httptest.NewRecorder()
+
+		router.ServeHTTP(rec, req)
+
+		assert.Equal(t, http.StatusOK, rec.Code)
+
+		var resp response.ClipJobListResponse
+		err := json.Unmarshal(rec.Body.Bytes(), &resp)
+		require.NoError(t, err)
+		require.Len(t, resp.Data, 1)
+		assert.Equal(t, "processing", resp.Data[0].Status)
+	})
+}
@@ -1,6 +1,7 @@
 package handler
 
 import (
+	"fmt"
 	"net/http"
 
 	"github.com/gin-gonic/gin"
@@ -59,6 +60,44 @@ func (h *EpisodeHandler) GetEpisode(c *gin.Context) {
 	c.JSON(http.StatusOK, result)
 }
 
+// GetHLSPlaylist godoc
+// @Summary エピソードの HLS プレイリスト取得
+// @Description エピソードの HLS プレイリスト（m3u8）を取得します。セグメント URI はリクエストごとに署名付き URL に書き換えて返します。閲覧可否はエピソード取得と同じです。
+// @Tags episodes
+// @Produce application/vnd.apple.mpegurl
+// @Param channelId path string true "チャンネル ID"
+// @Param episodeId path string true "エピソード ID"
+// @Success 200 {string} string "m3u8 プレイリスト"
+// @Failure 400 {object} response.ErrorResponse
+// @Failure 404 {object} response.ErrorResponse
+// @Failure 500 {object} response.ErrorResponse
+// @Router /channels/{channelId}/episodes/{episodeId}/hls/playlist.m3u8 [get]
+func (h *EpisodeHandler) GetHLSPlaylist(c *gin.Context) {
+	userID, _ := middleware.GetUserID(c)
+
+	channelID := c.Param("channelId")
+	if channelID == "" {
+		Error(c, apperror.ErrValidation.WithMessage("channelId は必須です"))
+		return
+	}
+
+	episodeID := c.Param("episodeId")
+	if episodeID == "" {
+		Error(c, apperror.ErrValidation.WithMessage("episodeId は必須です"))
+		return
+	}
+
+	result, err := h.episodeService.GetHLSPlaylist(c.Request.Context(), userID, channelID, episodeID)
+	if err != nil {
+		Error(c, err)
+		return
+	}
+
+	// セグメント URL は署名付きのため、共有キャッシュには保存させない
+	c.Header("Cache-Control", fmt.Sprintf("private, max-age=%d", int(result.MaxAge.Seconds())))
+	c.Data(http.StatusOK, result.ContentType, result.Data)
+}
+
 // ListMyChannelEpisodes godoc
 // @Summary 自分のチャンネルのエピソード一覧取得
 // @Description 自分のチャンネルに紐付くエピソード一覧を取得します（非公開含む）
@@ -0,0 +1,64 @@
+package handler
+
+import (
+	"net/http"
+
+	"github.com/gin-gonic/gin"
+
+	"github.com/siropaca/anycast-backend/internal/apperror"
+	"github.com/siropaca/anycast-backend/internal/middleware"
+	"github.com/siropaca/anycast-backend/internal/service"
+)
+
+// エピソードのダウンロードパッケージ関連のハンドラー
+type EpisodePackageHandler struct {
+	episodePackageService service.EpisodePackageService
+}
+
+// EpisodePackageHandler を作成する
+func NewEpisodePackageHandler(eps service.EpisodePackageService) *EpisodePackageHandler {
+	return &EpisodePackageHandler{episodePackageService: eps}
+}
+
+// CreateEpisodePackage godoc
+// @Summary エピソードのダウンロードパッケージ作成
+// @Description エピソードの音声（完成版・ボイスのみ）・台本（テキスト・JSON）・字幕・アートワーク・メタデータをまとめた ZIP を作成し、署名付き URL を返します。内容が変わっていなければ作成済みの ZIP を返します。
+// @Tags episodes
+// @Produce json
+// @Param channelId path string true "チャンネル ID"
+// @Param episodeId path string true "エピソード ID"
+// @Success 200 {object} response.EpisodePackageDataResponse
+// @Failure 400 {object} response.ErrorResponse
+// @Failure 401 {object} response.ErrorResponse
+// @Failure 403 {object} response.ErrorResponse
+// @Failure 404 {object} response.ErrorResponse
+// @Failure 500 {object} response.ErrorResponse
+// @Security BearerAuth
+// @Router /channels/{channelId}/episodes/{episodeId}/package [post]
+func (h *EpisodePackageHandler) CreateEpisodePackage(c *gin.Context) {
+	userID, ok := middleware.GetUserID(c)
+	if !ok {
+		Error(c, apperror.ErrUnauthorized)
+		return
+	}
+
+	channelID := c.Param("channelId")
+	if channelID == "" {
+		Error(c, apperror.ErrValidation.WithMessage("channelId は必須です"))
+		return
+	}
+
+	episodeID := c.Param("episodeId")
+	if episodeID == "" {
+		Error(c, apperror.ErrValidation.WithMessage("episodeId は必須です"))
+		return
+	}
+
+	result, err := h.episodePackageService.CreatePackage(c.Request.Context(), userID, channelID, episodeID)
+	if err != nil {
+		Error(c, err)
+		return
+	}
+
+	c.JSON(http.StatusOK, result)
+}
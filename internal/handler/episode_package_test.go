@@ -0,0 +1,90 @@
+package handler
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/gin-gonic/gin"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+
+	"github.com/siropaca/anycast-backend/internal/apperror"
+	"github.com/siropaca/anycast-backend/internal/dto/response"
+	"github.com/siropaca/anycast-backend/internal/middleware"
+	"github.com/siropaca/anycast-backend/internal/pkg/uuid"
+)
+
+// EpisodePackageService のモック
+type mockEpisodePackageService struct {
+	mock.Mock
+}
+
+func (m *mockEpisodePackageService) CreatePackage(ctx context.Context, userID, channelID, episodeID string) (*response.EpisodePackageDataResponse, error) {
+	args := m.Called(ctx, userID, channelID, episodeID)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).(*response.EpisodePackageDataResponse), args.Error(1)
+}
+
+func setupEpisodePackageRouter(service *mockEpisodePackageService) *gin.Engine {
+	gin.SetMode(gin.TestMode)
+	r := gin.New()
+
+	handler := NewEpisodePackageHandler(service)
+
+	// 認証済みユーザーをシミュレートするミドルウェア
+	authMiddleware := func(c *gin.Context) {
+		c.Set(string(middleware.UserIDKey), "user-123")
+		c.Next()
+	}
+
+	r.POST("/channels/:channelId/episodes/:episodeId/package", authMiddleware, handler.CreateEpisodePackage)
+
+	return r
+}
+
+func TestEpisodePackageHandler_CreateEpisodePackage(t *testing.T) {
+	channelID := uuid.New().String()
+	episodeID := uuid.New().String()
+	path := "/channels/" + channelID + "/episodes/" + episodeID + "/package"
+
+	t.Run("ダウンロードパッケージの署名付き URL を返す", func(t *testing.T) {
+		mockService := new(mockEpisodePackageService)
+		mockService.On("CreatePackage", mock.Anything, "user-123", channelID, episodeID).Return(&response.EpisodePackageDataResponse{
+			Data: response.EpisodePackageResponse{
+				URL:      "https://storage.example.com/package.zip",
+				Filename: "episode-" + episodeID + ".zip",
+				MimeType: "application/zip",
+				Cached:   true,
+			},
+		}, nil)
+
+		router := setupEpisodePackageRouter(mockService)
+		rec := httptest.NewRecorder()
+		router.ServeHTTP(rec, httptest.NewRequest(http.MethodPost, path, nil))
+
+		assert.Equal(t, http.StatusOK, rec.Code)
+
+		var resp response.EpisodePackageDataResponse
+		require.NoError(t, json.Unmarshal(rec.Body.Bytes(), &resp))
+		assert.Equal(t, "https://storage.example.com/package.zip", resp.Data.URL)
+		assert.True(t, resp.Data.Cached)
+		mockService.AssertExpectations(t)
+	})
+
+	t.Run("オーナーでない場合は 403 を返す", func(t *testing.T) {
+		mockService := new(mockEpisodePackageService)
+		mockService.On("CreatePackage", mock.Anything, "user-123", channelID, episodeID).Return(nil, apperror.ErrForbidden.WithMessage("このチャンネルへのアクセス権限がありません"))
+
+		router := setupEpisodePackageRouter(mockService)
+		rec := httptest.NewRecorder()
+		router.ServeHTTP(rec, httptest.NewRequest(http.MethodPost, path, nil))
+
+		assert.Equal(t, http.StatusForbidden, rec.Code)
+	})
+}
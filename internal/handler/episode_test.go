@@ -132,6 +132,14 @@ func (m *mockEpisodeService) UploadAudio(ctx context.Context, userID, channelID,
 	return args.Get(0).(*response.EpisodeDataResponse), args.Error(1)
 }
 
+func (m *mockEpisodeService) GetHLSPlaylist(ctx context.Context, userID, channelID, episodeID string) (*service.HLSPlaylist, error) {
+	args := m.Called(ctx, userID, channelID, episodeID)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).(*service.HLSPlaylist), args.Error(1)
+}
+
 // テスト用のルーターをセットアップする
 func setupEpisodeRouter(h *EpisodeHandler) *gin.Engine {
 	gin.SetMode(gin.TestMode)
@@ -139,6 +147,7 @@ func setupEpisodeRouter(h *EpisodeHandler) *gin.Engine {
 	r.GET("/me/channels/:channelId/episodes", h.ListMyChannelEpisodes)
 	r.GET("/me/channels/:channelId/episodes/:episodeId", h.GetMyChannelEpisode)
 	r.GET("/channels/:channelId/episodes", h.ListChannelEpisodes)
+	r.GET("/channels/:channelId/episodes/:episodeId/hls/playlist.m3u8", h.GetHLSPlaylist)
 	r.PATCH("/channels/:channelId/episodes/:episodeId", h.UpdateEpisode)
 	r.DELETE("/channels/:channelId/episodes/:episodeId", h.DeleteEpisode)
 	r.POST("/channels/:channelId/episodes/:episodeId/publish", h.PublishEpisode)
@@ -1172,3 +1181,47 @@ func TestEpisodeHandler_UploadAudio(t *testing.T) {
 		assert.Equal(t, http.StatusUnauthorized, w.Code)
 	})
 }
+
+func TestEpisodeHandler_GetHLSPlaylist(t *testing.T) {
+	channelID := uuid.New().String()
+	episodeID := uuid.New().String()
+	path := "/channels/" + channelID + "/episodes/" + episodeID + "/hls/playlist.m3u8"
+
+	t.Run("未認証でもプレイリストを取得できる", func(t *testing.T) {
+		mockSvc := new(mockEpisodeService)
+		playlist := &service.HLSPlaylist{
+			Data:        []byte("#EXTM3U\nhttps://example.com/segment_00000.ts\n"),
+			ContentType: "application/vnd.apple.mpegurl",
+			MaxAge:      5 * time.Minute,
+		}
+		mockSvc.On("GetHLSPlaylist", mock.Anything, "", channelID, episodeID).Return(playlist, nil)
+
+		handler := NewEpisodeHandler(mockSvc)
+		router := setupEpisodeRouter(handler)
+
+		w := httptest.NewRecorder()
+		req := httptest.NewRequest("GET", path, http.NoBody)
+		router.ServeHTTP(w, req)
+
+		assert.Equal(t, http.StatusOK, w.Code)
+		assert.Equal(t, "application/vnd.apple.mpegurl", w.Header().Get("Content-Type"))
+		assert.Equal(t, "private, max-age=300", w.Header().Get("Cache-Control"))
+		assert.Equal(t, string(playlist.Data), w.Body.String())
+		mockSvc.AssertExpectations(t)
+	})
+
+	t.Run("HLS パッケージがない場合は 404 を返す", func(t *testing.T) {
+		mockSvc := new(mockEpisodeService)
+		mockSvc.On("GetHLSPlaylist", mock.Anything, "", channelID, episodeID).Return(nil, apperror.ErrNotFound.WithMessage("HLS パッケージが見つかりません"))
+
+		handler := NewEpisodeHandler(mockSvc)
+		router := setupEpisodeRouter(handler)
+
+		w := httptest.NewRecorder()
+		req := httptest.NewRequest("GET", path, http.NoBody)
+		router.ServeHTTP(w, req)
+
+		assert.Equal(t, http.StatusNotFound, w.Code)
+		mockSvc.AssertExpectations(t)
+	})
+}
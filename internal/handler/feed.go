@@ -0,0 +1,313 @@
+package handler
+
+import (
+	"net/http"
+
+	"github.com/gin-gonic/gin"
+
+	"github.com/siropaca/anycast-backend/internal/apperror"
+	"github.com/siropaca/anycast-backend/internal/pkg/podcastfeed"
+	"github.com/siropaca/anycast-backend/internal/service"
+)
+
+// feedCacheControl はフィードの Cache-Control（ポッドキャストアプリの定期取得を共有キャッシュで受ける）
+const feedCacheControl = "public, max-age=300"
+
+// privateFeedCacheControl は限定公開フィードの Cache-Control（URL にトークンを含むため共有キャッシュに載せない）
+const privateFeedCacheControl = "private, max-age=300"
+
+// RSS フィード関連のハンドラー
+type FeedHandler struct {
+	feedService service.FeedService
+}
+
+// FeedHandler を作成する
+func NewFeedHandler(fs service.FeedService) *FeedHandler {
+	return &FeedHandler{feedService: fs}
+}
+
+// GetChannelFeed godoc
+// @Summary チャンネルの RSS フィード取得
+// @Description 公開済みチャンネルのポッドキャスト RSS フィード（iTunes / Podcasting 2.0 タグ付き）を取得します。公開済みで音声のあるエピソードのみを含みます。
+// @Tags feeds
+// @Produce application/rss+xml
+// @Param channelId path string true "チャンネル ID"
+// @Success 200 {string} string "RSS フィード"
+// @Failure 400 {object} response.ErrorResponse
+// @Failure 404 {object} response.ErrorResponse
+// @Failure 500 {object} response.ErrorResponse
+// @Router /channels/{channelId}/feed.xml [get]
+func (h *FeedHandler) GetChannelFeed(c *gin.Context) {
+	channelID := c.Param("channelId")
+	if channelID == "" {
+		Error(c, apperror.ErrValidation.WithMessage("channelId は必須です"))
+		return
+	}
+
+	data, err := h.feedService.GetChannelFeed(c.Request.Context(), channelID)
+	if err != nil {
+		Error(c, err)
+		return
+	}
+
+	c.Header("Cache-Control", feedCacheControl)
+	c.Data(http.StatusOK, podcastfeed.ContentType, data)
+}
+
+// GetEpisodeEnclosure godoc
+// @Summary エピソード音声のエンクロージャー
+// @Description RSS フィードのエンクロージャー URL です。公開済みエピソードの音声の署名付き URL へリダイレクトします。filename は拡張子を示すためのもので、内容は参照しません。
+// @Tags feeds
+// @Param channelId path string true "チャンネル ID"
+// @Param episodeId path string true "エピソード ID"
+// @Param filename path string true "ファイル名（例: {episodeId}.mp3）"
+// @Success 302 "署名付き URL へのリダイレクト"
+// @Failure 400 {object} response.ErrorResponse
+// @Failure 404 {object} response.ErrorResponse
+// @Failure 500 {object} response.ErrorResponse
+// @Router /channels/{channelId}/episodes/{episodeId}/enclosure/{filename} [get]
+func (h *FeedHandler) GetEpisodeEnclosure(c *gin.Context) {
+	channelID, episodeID, ok := bindFeedEpisodeParams(c)
+	if !ok {
+		return
+	}
+
+	url, err := h.feedService.GetEpisodeEnclosureURL(c.Request.Context(), channelID, episodeID)
+	if err != nil {
+		Error(c, err)
+		return
+	}
+
+	redirectToSignedURL(c, url)
+}
+
+// GetChannelArtwork godoc
+// @Summary チャンネルのアートワーク
+// @Description 公開済みチャンネルのアートワークの署名付き URL へリダイレクトします（RSS フィードの itunes:image 用）。
+// @Tags feeds
+// @Param channelId path string true "チャンネル ID"
+// @Success 302 "署名付き URL へのリダイレクト"
+// @Failure 400 {object} response.ErrorResponse
+// @Failure 404 {object} response.ErrorResponse
+// @Failure 500 {object} response.ErrorResponse
+// @Router /channels/{channelId}/artwork [get]
+func (h *FeedHandler) GetChannelArtwork(c *gin.Context) {
+	channelID := c.Param("channelId")
+	if channelID == "" {
+		Error(c, apperror.ErrValidation.WithMessage("channelId は必須です"))
+		return
+	}
+
+	url, err := h.feedService.GetArtworkURL(c.Request.Context(), channelID, "")
+	if err != nil {
+		Error(c, err)
+		return
+	}
+
+	redirectToSignedURL(c, url)
+}
+
+// GetEpisodeArtwork godoc
+// @Summary エピソードのアートワーク
+// @Description 公開済みエピソードのアートワークの署名付き URL へリダイレクトします。エピソードにアートワークがない場合はチャンネルのアートワークへリダイレクトします。
+// @Tags feeds
+// @Param channelId path string true "チャンネル ID"
+// @Param episodeId path string true "エピソード ID"
+// @Success 302 "署名付き URL へのリダイレクト"
+// @Failure 400 {object} response.ErrorResponse
+// @Failure 404 {object} response.ErrorResponse
+// @Failure 500 {object} response.ErrorResponse
+// @Router /channels/{channelId}/episodes/{episodeId}/artwork [get]
+func (h *FeedHandler) GetEpisodeArtwork(c *gin.Context) {
+	channelID, episodeID, ok := bindFeedEpisodeParams(c)
+	if !ok {
+		return
+	}
+
+	url, err := h.feedService.GetArtworkURL(c.Request.Context(), channelID, episodeID)
+	if err != nil {
+		Error(c, err)
+		return
+	}
+
+	redirectToSignedURL(c, url)
+}
+
+// GetEpisodeTranscript godoc
+// @Summary エピソードの文字起こし
+// @Description 公開済みエピソードの台本を「話者名: セリフ」形式のテキストで返します（RSS フィードの podcast:transcript 用）。
+// @Tags feeds
+// @Produce plain
+// @Param channelId path string true "チャンネル ID"
+// @Param episodeId path string true "エピソード ID"
+// @Success 200 {string} string "文字起こし"
+// @Failure 400 {object} response.ErrorResponse
+// @Failure 404 {object} response.ErrorResponse
+// @Failure 500 {object} response.ErrorResponse
+// @Router /channels/{channelId}/episodes/{episodeId}/transcript.txt [get]
+func (h *FeedHandler) GetEpisodeTranscript(c *gin.Context) {
+	channelID, episodeID, ok := bindFeedEpisodeParams(c)
+	if !ok {
+		return
+	}
+
+	transcript, err := h.feedService.GetEpisodeTranscript(c.Request.Context(), channelID, episodeID)
+	if err != nil {
+		Error(c, err)
+		return
+	}
+
+	c.Header("Cache-Control", feedCacheControl)
+	c.Data(http.StatusOK, "text/plain; charset=utf-8", []byte(transcript))
+}
+
+// GetPrivateFeed godoc
+// @Summary 限定公開 RSS フィード取得
+// @Description トークンに対応するチャンネルの RSS フィードを取得します。未公開・予約公開のエピソードも音声があれば含みます。認証はパスのトークンで行います。
+// @Tags feeds
+// @Produce application/rss+xml
+// @Param token path string true "限定公開フィードのトークン"
+// @Success 200 {string} string "RSS フィード"
+// @Failure 404 {object} response.ErrorResponse
+// @Failure 500 {object} response.ErrorResponse
+// @Router /private-feeds/{token}/feed.xml [get]
+func (h *FeedHandler) GetPrivateFeed(c *gin.Context) {
+	data, err := h.feedService.GetPrivateFeed(c.Request.Context(), c.Param("token"))
+	if err != nil {
+		Error(c, err)
+		return
+	}
+
+	c.Header("Cache-Control", privateFeedCacheControl)
+	c.Data(http.StatusOK, podcastfeed.ContentType, data)
+}
+
+// GetPrivateEpisodeEnclosure godoc
+// @Summary 限定公開フィードのエピソード音声のエンクロージャー
+// @Description トークンで閲覧できるエピソードの音声の署名付き URL へリダイレクトします。未公開のエピソードも対象です。
+// @Tags feeds
+// @Param token path string true "限定公開フィードのトークン"
+// @Param episodeId path string true "エピソード ID"
+// @Param filename path string true "ファイル名（例: {episodeId}.mp3）"
+// @Success 302 "署名付き URL へのリダイレクト"
+// @Failure 400 {object} response.ErrorResponse
+// @Failure 404 {object} response.ErrorResponse
+// @Failure 500 {object} response.ErrorResponse
+// @Router /private-feeds/{token}/episodes/{episodeId}/enclosure/{filename} [get]
+func (h *FeedHandler) GetPrivateEpisodeEnclosure(c *gin.Context) {
+	episodeID := c.Param("episodeId")
+	if episodeID == "" {
+		Error(c, apperror.ErrValidation.WithMessage("episodeId は必須です"))
+		return
+	}
+
+	url, err := h.feedService.GetPrivateEpisodeEnclosureURL(c.Request.Context(), c.Param("token"), episodeID)
+	if err != nil {
+		Error(c, err)
+		return
+	}
+
+	redirectToSignedURL(c, url)
+}
+
+// GetPrivateChannelArtwork godoc
+// @Summary 限定公開フィードのチャンネルのアートワーク
+// @Description トークンに対応するチャンネルのアートワークの署名付き URL へリダイレクトします。
+// @Tags feeds
+// @Param token path string true "限定公開フィードのトークン"
+// @Success 302 "署名付き URL へのリダイレクト"
+// @Failure 404 {object} response.ErrorResponse
+// @Failure 500 {object} response.ErrorResponse
+// @Router /private-feeds/{token}/artwork [get]
+func (h *FeedHandler) GetPrivateChannelArtwork(c *gin.Context) {
+	url, err := h.feedService.GetPrivateArtworkURL(c.Request.Context(), c.Param("token"), "")
+	if err != nil {
+		Error(c, err)
+		return
+	}
+
+	redirectToSignedURL(c, url)
+}
+
+// GetPrivateEpisodeArtwork godoc
+// @Summary 限定公開フィードのエピソードのアートワーク
+// @Description トークンで閲覧できるエピソードのアートワークの署名付き URL へリダイレクトします。エピソードにアートワークがない場合はチャンネルのアートワークへリダイレクトします。
+// @Tags feeds
+// @Param token path string true "限定公開フィードのトークン"
+// @Param episodeId path string true "エピソード ID"
+// @Success 302 "署名付き URL へのリダイレクト"
+// @Failure 400 {object} response.ErrorResponse
+// @Failure 404 {object} response.ErrorResponse
+// @Failure 500 {object} response.ErrorResponse
+// @Router /private-feeds/{token}/episodes/{episodeId}/artwork [get]
+func (h *FeedHandler) GetPrivateEpisodeArtwork(c *gin.Context) {
+	episodeID := c.Param("episodeId")
+	if episodeID == "" {
+		Error(c, apperror.ErrValidation.WithMessage("episodeId は必須です"))
+		return
+	}
+
+	url, err := h.feedService.GetPrivateArtworkURL(c.Request.Context(), c.Param("token"), episodeID)
+	if err != nil {
+		Error(c, err)
+		return
+	}
+
+	redirectToSignedURL(c, url)
+}
+
+// GetPrivateEpisodeTranscript godoc
+// @Summary 限定公開フィードのエピソードの文字起こし
+// @Description トークンで閲覧できるエピソードの台本を「話者名: セリフ」形式のテキストで返します。
+// @Tags feeds
+// @Produce plain
+// @Param token path string true "限定公開フィードのトークン"
+// @Param episodeId path string true "エピソード ID"
+// @Success 200 {string} string "文字起こし"
+// @Failure 400 {object} response.ErrorResponse
+// @Failure 404 {object} response.ErrorResponse
+// @Failure 500 {object} response.ErrorResponse
+// @Router /private-feeds/{token}/episodes/{episodeId}/transcript.txt [get]
+func (h *FeedHandler) GetPrivateEpisodeTranscript(c *gin.Context) {
+	episodeID := c.Param("episodeId")
+	if episodeID == "" {
+		Error(c, apperror.ErrValidation.WithMessage("episodeId は必須です"))
+		return
+	}
+
+	transcript, err := h.feedService.GetPrivateEpisodeTranscript(c.Request.Context(), c.Param("token"), episodeID)
+	if err != nil {
+		Error(c, err)
+		return
+	}
+
+	c.Header("Cache-Control", privateFeedCacheControl)
+	c.Data(http.StatusOK, "text/plain; charset=utf-8", []byte(transcript))
+}
+
+// bindFeedEpisodeParams はパスパラメータのチャンネル ID とエピソード ID を取得する
+//
+// 空の場合はバリデーションエラーを返して false を返す
+func bindFeedEpisodeParams(c *gin.Context) (channelID, episodeID string, ok bool) {
+	channelID = c.Param("channelId")
+	if channelID == "" {
+		Error(c, apperror.ErrValidation.WithMessage("channelId は必須です"))
+		return "", "", false
+	}
+
+	episodeID = c.Param("episodeId")
+	if episodeID == "" {
+		Error(c, apperror.ErrValidation.WithMessage("episodeId は必須です"))
+		return "", "", false
+	}
+
+	return channelID, episodeID, true
+}
+
+// redirectToSignedURL は署名付き URL へ一時リダイレクトする
+//
+// リダイレクト先は有効期限付きのため、キャッシュさせない
+func redirectToSignedURL(c *gin.Context, url string) {
+	c.Header("Cache-Control", "no-store")
+	c.Redirect(http.StatusFound, url)
+}
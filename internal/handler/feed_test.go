@@ -0,0 +1,248 @@
+package handler
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/gin-gonic/gin"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+
+	"github.com/siropaca/anycast-backend/internal/apperror"
+	"github.com/siropaca/anycast-backend/internal/pkg/podcastfeed"
+	"github.com/siropaca/anycast-backend/internal/pkg/uuid"
+)
+
+// FeedService のモック
+type mockFeedService struct {
+	mock.Mock
+}
+
+func (m *mockFeedService) GetChannelFeed(ctx context.Context, channelID string) ([]byte, error) {
+	args := m.Called(ctx, channelID)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).([]byte), args.Error(1)
+}
+
+func (m *mockFeedService) GetEpisodeEnclosureURL(ctx context.Context, channelID, episodeID string) (string, error) {
+	args := m.Called(ctx, channelID, episodeID)
+	return args.String(0), args.Error(1)
+}
+
+func (m *mockFeedService) GetArtworkURL(ctx context.Context, channelID, episodeID string) (string, error) {
+	args := m.Called(ctx, channelID, episodeID)
+	return args.String(0), args.Error(1)
+}
+
+func (m *mockFeedService) GetEpisodeTranscript(ctx context.Context, channelID, episodeID string) (string, error) {
+	args := m.Called(ctx, channelID, episodeID)
+	return args.String(0), args.Error(1)
+}
+
+func (m *mockFeedService) GetPrivateFeed(ctx context.Context, token string) ([]byte, error) {
+	args := m.Called(ctx, token)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).([]byte), args.Error(1)
+}
+
+func (m *mockFeedService) GetPrivateEpisodeEnclosureURL(ctx context.Context, token, episodeID string) (string, error) {
+	args := m.Called(ctx, token, episodeID)
+	return args.String(0), args.Error(1)
+}
+
+func (m *mockFeedService) GetPrivateArtworkURL(ctx context.Context, token, episodeID string) (string, error) {
+	args := m.Called(ctx, token, episodeID)
+	return args.String(0), args.Error(1)
+}
+
+func (m *mockFeedService) GetPrivateEpisodeTranscript(ctx context.Context, token, episodeID string) (string, error) {
+	args := m.Called(ctx, token, episodeID)
+	return args.String(0), args.Error(1)
+}
+
+func setupFeedRouter(h *FeedHandler) *gin.Engine {
+	gin.SetMode(gin.TestMode)
+	r := gin.New()
+	r.GET("/channels/:channelId/feed.xml", h.GetChannelFeed)
+	r.GET("/channels/:channelId/artwork", h.GetChannelArtwork)
+	r.GET("/channels/:channelId/episodes/:episodeId/enclosure/:filename", h.GetEpisodeEnclosure)
+	r.GET("/channels/:channelId/episodes/:episodeId/artwork", h.GetEpisodeArtwork)
+	r.GET("/channels/:channelId/episodes/:episodeId/transcript.txt", h.GetEpisodeTranscript)
+	r.GET("/private-feeds/:token/feed.xml", h.GetPrivateFeed)
+	r.GET("/private-feeds/:token/episodes/:episodeId/enclosure/:filename", h.GetPrivateEpisodeEnclosure)
+	return r
+}
+
+func TestFeedHandler_GetChannelFeed(t *testing.T) {
+	channelID := uuid.New().String()
+
+	t.Run("RSS フィードを返す", func(t *testing.T) {
+		mockSvc := new(mockFeedService)
+		mockSvc.On("GetChannelFeed", mock.Anything, channelID).Return([]byte("<rss></rss>"), nil)
+
+		router := setupFeedRouter(NewFeedHandler(mockSvc))
+
+		w := httptest.NewRecorder()
+		req := httptest.NewRequest("GET", "/channels/"+channelID+"/feed.xml", http.NoBody)
+		router.ServeHTTP(w, req)
+
+		assert.Equal(t, http.StatusOK, w.Code)
+		assert.Equal(t, podcastfeed.ContentType, w.Header().Get("Content-Type"))
+		assert.Equal(t, "public, max-age=300", w.Header().Get("Cache-Control"))
+		assert.Equal(t, "<rss></rss>", w.Body.String())
+		mockSvc.AssertExpectations(t)
+	})
+
+	t.Run("未公開のチャンネルの場合は 404 を返す", func(t *testing.T) {
+		mockSvc := new(mockFeedService)
+		mockSvc.On("GetChannelFeed", mock.Anything, channelID).Return(nil, apperror.ErrNotFound.WithMessage("チャンネルが見つかりません"))
+
+		router := setupFeedRouter(NewFeedHandler(mockSvc))
+
+		w := httptest.NewRecorder()
+		req := httptest.NewRequest("GET", "/channels/"+channelID+"/feed.xml", http.NoBody)
+		router.ServeHTTP(w, req)
+
+		assert.Equal(t, http.StatusNotFound, w.Code)
+	})
+}
+
+func TestFeedHandler_GetEpisodeEnclosure(t *testing.T) {
+	channelID := uuid.New().String()
+	episodeID := uuid.New().String()
+
+	t.Run("署名付き URL へリダイレクトする", func(t *testing.T) {
+		mockSvc := new(mockFeedService)
+		mockSvc.On("GetEpisodeEnclosureURL", mock.Anything, channelID, episodeID).Return("https://storage.example.com/audios/a.mp3?sig=xxx", nil)
+
+		router := setupFeedRouter(NewFeedHandler(mockSvc))
+
+		w := httptest.NewRecorder()
+		req := httptest.NewRequest("GET", "/channels/"+channelID+"/episodes/"+episodeID+"/enclosure/"+episodeID+".mp3", http.NoBody)
+		router.ServeHTTP(w, req)
+
+		assert.Equal(t, http.StatusFound, w.Code)
+		assert.Equal(t, "https://storage.example.com/audios/a.mp3?sig=xxx", w.Header().Get("Location"))
+		assert.Equal(t, "no-store", w.Header().Get("Cache-Control"))
+	})
+
+	t.Run("音声がない場合は 404 を返す", func(t *testing.T) {
+		mockSvc := new(mockFeedService)
+		mockSvc.On("GetEpisodeEnclosureURL", mock.Anything, channelID, episodeID).Return("", apperror.ErrNotFound)
+
+		router := setupFeedRouter(NewFeedHandler(mockSvc))
+
+		w := httptest.NewRecorder()
+		req := httptest.NewRequest("GET", "/channels/"+channelID+"/episodes/"+episodeID+"/enclosure/"+episodeID+".mp3", http.NoBody)
+		router.ServeHTTP(w, req)
+
+		assert.Equal(t, http.StatusNotFound, w.Code)
+	})
+}
+
+func TestFeedHandler_GetArtwork(t *testing.T) {
+	channelID := uuid.New().String()
+	episodeID := uuid.New().String()
+
+	t.Run("チャンネルのアートワークへリダイレクトする", func(t *testing.T) {
+		mockSvc := new(mockFeedService)
+		mockSvc.On("GetArtworkURL", mock.Anything, channelID, "").Return("https://storage.example.com/images/a.png", nil)
+
+		router := setupFeedRouter(NewFeedHandler(mockSvc))
+
+		w := httptest.NewRecorder()
+		req := httptest.NewRequest("GET", "/channels/"+channelID+"/artwork", http.NoBody)
+		router.ServeHTTP(w, req)
+
+		assert.Equal(t, http.StatusFound, w.Code)
+		assert.Equal(t, "https://storage.example.com/images/a.png", w.Header().Get("Location"))
+	})
+
+	t.Run("エピソードのアートワークへリダイレクトする", func(t *testing.T) {
+		mockSvc := new(mockFeedService)
+		mockSvc.On("GetArtworkURL", mock.Anything, channelID, episodeID).Return("https://storage.example.com/images/b.png", nil)
+
+		router := setupFeedRouter(NewFeedHandler(mockSvc))
+
+		w := httptest.NewRecorder()
+		req := httptest.NewRequest("GET", "/channels/"+channelID+"/episodes/"+episodeID+"/artwork", http.NoBody)
+		router.ServeHTTP(w, req)
+
+		assert.Equal(t, http.StatusFound, w.Code)
+		assert.Equal(t, "https://storage.example.com/images/b.png", w.Header().Get("Location"))
+	})
+}
+
+func TestFeedHandler_GetEpisodeTranscript(t *testing.T) {
+	channelID := uuid.New().String()
+	episodeID := uuid.New().String()
+
+	t.Run("文字起こしをテキストで返す", func(t *testing.T) {
+		mockSvc := new(mockFeedService)
+		mockSvc.On("GetEpisodeTranscript", mock.Anything, channelID, episodeID).Return("太郎: こんにちは\n", nil)
+
+		router := setupFeedRouter(NewFeedHandler(mockSvc))
+
+		w := httptest.NewRecorder()
+		req := httptest.NewRequest("GET", "/channels/"+channelID+"/episodes/"+episodeID+"/transcript.txt", http.NoBody)
+		router.ServeHTTP(w, req)
+
+		assert.Equal(t, http.StatusOK, w.Code)
+		assert.Equal(t, "text/plain; charset=utf-8", w.Header().Get("Content-Type"))
+		assert.Equal(t, "太郎: こんにちは\n", w.Body.String())
+	})
+}
+
+func TestFeedHandler_GetPrivateFeed(t *testing.T) {
+	t.Run("限定公開フィードを共有キャッシュ不可で返す", func(t *testing.T) {
+		mockSvc := new(mockFeedService)
+		mockSvc.On("GetPrivateFeed", mock.Anything, "secret-token").Return([]byte("<rss></rss>"), nil)
+
+		router := setupFeedRouter(NewFeedHandler(mockSvc))
+
+		w := httptest.NewRecorder()
+		req := httptest.NewRequest("GET", "/private-feeds/secret-token/feed.xml", http.NoBody)
+		router.ServeHTTP(w, req)
+
+		assert.Equal(t, http.StatusOK, w.Code)
+		assert.Equal(t, "private, max-age=300", w.Header().Get("Cache-Control"))
+		assert.Equal(t, "<rss></rss>", w.Body.String())
+	})
+
+	t.Run("無効なトークンの場合は 404 を返す", func(t *testing.T) {
+		mockSvc := new(mockFeedService)
+		mockSvc.On("GetPrivateFeed", mock.Anything, "invalid").Return(nil, apperror.ErrNotFound)
+
+		router := setupFeedRouter(NewFeedHandler(mockSvc))
+
+		w := httptest.NewRecorder()
+		req := httptest.NewRequest("GET", "/private-feeds/invalid/feed.xml", http.NoBody)
+		router.ServeHTTP(w, req)
+
+		assert.Equal(t, http.StatusNotFound, w.Code)
+	})
+}
+
+func TestFeedHandler_GetPrivateEpisodeEnclosure(t *testing.T) {
+	episodeID := uuid.New().String()
+
+	t.Run("署名付き URL へリダイレクトする", func(t *testing.T) {
+		mockSvc := new(mockFeedService)
+		mockSvc.On("GetPrivateEpisodeEnclosureURL", mock.Anything, "secret-token", episodeID).Return("https://storage.example.com/audios/draft.mp3?sig=xxx", nil)
+
+		router := setupFeedRouter(NewFeedHandler(mockSvc))
+
+		w := httptest.NewRecorder()
+		req := httptest.NewRequest("GET", "/private-feeds/secret-token/episodes/"+episodeID+"/enclosure/"+episodeID+".mp3", http.NoBody)
+		router.ServeHTTP(w, req)
+
+		assert.Equal(t, http.StatusFound, w.Code)
+		assert.Equal(t, "https://storage.example.com/audios/draft.mp3?sig=xxx", w.Header().Get("Location"))
+	})
+}
@@ -0,0 +1,116 @@
+package handler
+
+import (
+	"net/http"
+
+	"github.com/gin-gonic/gin"
+
+	"github.com/siropaca/anycast-backend/internal/apperror"
+	"github.com/siropaca/anycast-backend/internal/dto/request"
+	"github.com/siropaca/anycast-backend/internal/middleware"
+	"github.com/siropaca/anycast-backend/internal/service"
+)
+
+// ImportJobHandler はポッドキャスト取り込みジョブ関連のハンドラー
+type ImportJobHandler struct {
+	importJobService service.ImportJobService
+}
+
+// NewImportJobHandler は ImportJobHandler を作成する
+func NewImportJobHandler(ijs service.ImportJobService) *ImportJobHandler {
+	return &ImportJobHandler{importJobService: ijs}
+}
+
+// CreateImportJob godoc
+// @Summary ポッドキャスト取り込み
+// @Description 外部の RSS / Atom フィードからエピソードを非同期で取り込みます。ジョブを作成し、進捗は WebSocket で通知されます。チャンネルのオーナーのみ実行できます。
+// @Tags import-jobs
+// @Accept json
+// @Produce json
+// @Param channelId path string true "チャンネル ID"
+// @Param body body request.CreateImportJobRequest true "取り込み元フィード"
+// @Success 202 {object} response.ImportJobDataResponse
+// @Failure 400 {object} response.ErrorResponse
+// @Failure 401 {object} response.ErrorResponse
+// @Failure 403 {object} response.ErrorResponse
+// @Failure 404 {object} response.ErrorResponse
+// @Failure 500 {object} response.ErrorResponse
+// @Security BearerAuth
+// @Router /channels/{channelId}/import [post]
+func (h *ImportJobHandler) CreateImportJob(c *gin.Context) {
+	userID, ok := middleware.GetUserID(c)
+	if !ok {
+		Error(c, apperror.ErrUnauthorized)
+		return
+	}
+
+	var req request.CreateImportJobRequest
+	if err := c.ShouldBindJSON(&req); err != nil {
+		Error(c, apperror.ErrValidation.WithMessage(formatValidationError(err)))
+		return
+	}
+
+	result, err := h.importJobService.CreateJob(c.Request.Context(), userID, c.Param("channelId"), req)
+	if err != nil {
+		Error(c, err)
+		return
+	}
+
+	c.JSON(http.StatusAccepted, gin.H{"data": result})
+}
+
+// GetImportJob godoc
+// @Summary 取り込みジョブ詳細取得
+// @Description ポッドキャスト取り込みジョブの詳細を取得します
+// @Tags import-jobs
+// @Produce json
+// @Param jobId path string true "ジョブ ID"
+// @Success 200 {object} response.ImportJobDataResponse
+// @Failure 400 {object} response.ErrorResponse
+// @Failure 401 {object} response.ErrorResponse
+// @Failure 403 {object} response.ErrorResponse
+// @Failure 404 {object} response.ErrorResponse
+// @Failure 500 {object} response.ErrorResponse
+// @Security BearerAuth
+// @Router /import-jobs/{jobId} [get]
+func (h *ImportJobHandler) GetImportJob(c *gin.Context) {
+	userID, ok := middleware.GetUserID(c)
+	if !ok {
+		Error(c, apperror.ErrUnauthorized)
+		return
+	}
+
+	result, err := h.importJobService.GetJob(c.Request.Context(), userID, c.Param("jobId"))
+	if err != nil {
+		Error(c, err)
+		return
+	}
+
+	c.JSON(http.StatusOK, gin.H{"data": result})
+}
+
+// ListMyImportJobs godoc
+// @Summary 自分の取り込みジョブ一覧取得
+// @Description 自分のポッドキャスト取り込みジョブ一覧を取得します
+// @Tags me
+// @Produce json
+// @Success 200 {object} response.ImportJobListResponse
+// @Failure 401 {object} response.ErrorResponse
+// @Failure 500 {object} response.ErrorResponse
+// @Security BearerAuth
+// @Router /me/import-jobs [get]
+func (h *ImportJobHandler) ListMyImportJobs(c *gin.Context) {
+	userID, ok := middleware.GetUserID(c)
+	if !ok {
+		Error(c, apperror.ErrUnauthorized)
+		return
+	}
+
+	result, err := h.importJobService.ListMyJobs(c.Request.Context(), userID)
+	if err != nil {
+		Error(c, err)
+		return
+	}
+
+	c.JSON(http.StatusOK, result)
+}
@@ -0,0 +1,185 @@
+package handler
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+
+	"github.com/gin-gonic/gin"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+
+	"github.com/siropaca/anycast-backend/internal/apperror"
+	"github.com/siropaca/anycast-backend/internal/dto/request"
+	"github.com/siropaca/anycast-backend/internal/dto/response"
+	"github.com/siropaca/anycast-backend/internal/middleware"
+	"github.com/siropaca/anycast-backend/internal/pkg/uuid"
+)
+
+// ImportJobService のモック
+type mockImportJobService struct {
+	mock.Mock
+}
+
+func (m *mockImportJobService) CreateJob(ctx context.Context, userID, channelID string, req request.CreateImportJobRequest) (*response.ImportJobResponse, error) {
+	args := m.Called(ctx, userID, channelID, req)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).(*response.ImportJobResponse), args.Error(1)
+}
+
+func (m *mockImportJobService) GetJob(ctx context.Context, userID, jobID string) (*response.ImportJobResponse, error) {
+	args := m.Called(ctx, userID, jobID)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).(*response.ImportJobResponse), args.Error(1)
+}
+
+func (m *mockImportJobService) ListMyJobs(ctx context.Context, userID string) (*response.ImportJobListResponse, error) {
+	args := m.Called(ctx, userID)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).(*response.ImportJobListResponse), args.Error(1)
+}
+
+func (m *mockImportJobService) ExecuteJob(ctx context.Context, jobID string) error {
+	args := m.Called(ctx, jobID)
+	return args.Error(0)
+}
+
+func setupImportJobRouter(service *mockImportJobService) *gin.Engine {
+	gin.SetMode(gin.TestMode)
+	r := gin.New()
+
+	handler := NewImportJobHandler(service)
+
+	// 認証済みユーザーをシミュレートするミドルウェア
+	authMiddleware := func(c *gin.Context) {
+		c.Set(string(middleware.UserIDKey), "user-123")
+		c.Next()
+	}
+
+	r.POST("/channels/:channelId/import", authMiddleware, handler.CreateImportJob)
+	r.GET("/import-jobs/:jobId", authMiddleware, handler.GetImportJob)
+	r.GET("/me/import-jobs", authMiddleware, handler.ListMyImportJobs)
+
+	return r
+}
+
+func TestImportJobHandler_CreateImportJob(t *testing.T) {
+	channelID := uuid.New()
+	feedURL := "https://example.com/feed.xml"
+
+	t.Run("取り込みジョブを作成して 202 を返す", func(t *testing.T) {
+		mockService := new(mockImportJobService)
+		jobResponse := &response.ImportJobResponse{
+			ID:      uuid.New(),
+			Channel: response.ImportJobChannelResponse{ID: channelID, Name: "テックトーク"},
+			FeedURL: feedURL,
+			Status:  "pending",
+		}
+		mockService.On("CreateJob", mock.Anything, "user-123", channelID.String(), request.CreateImportJobRequest{FeedURL: feedURL}).Return(jobResponse, nil)
+
+		router := setupImportJobRouter(mockService)
+		req := httptest.NewRequest(http.MethodPost, "/channels/"+channelID.String()+"/import", strings.NewReader(`{"feedUrl":"`+feedURL+`"}`))
+		req.Header.Set("Content-Type", "application/json")
+		rec := httptest.NewRecorder()
+
+		router.ServeHTTP(rec, req)
+
+		assert.Equal(t, http.StatusAccepted, rec.Code)
+
+		var resp map[string]response.ImportJobResponse
+		err := json.Unmarshal(rec.Body.Bytes(), &resp)
+		require.NoError(t, err)
+		assert.Equal(t, "pending", resp["data"].Status)
+		assert.Equal(t, feedURL, resp["data"].FeedURL)
+		mockService.AssertExpectations(t)
+	})
+
+	t.Run("feedUrl が URL でない場合は 400 を返す", func(t *testing.T) {
+		mockService := new(mockImportJobService)
+
+		router := setupImportJobRouter(mockService)
+		req := httptest.NewRequest(http.MethodPost, "/channels/"+channelID.String()+"/import", strings.NewReader(`{"feedUrl":"not a url"}`))
+		req.Header.Set("Content-Type", "application/json")
+		rec := httptest.NewRecorder()
+
+		router.ServeHTTP(rec, req)
+
+		assert.Equal(t, http.StatusBadRequest, rec.Code)
+		mockService.AssertNotCalled(t, "CreateJob", mock.Anything, mock.Anything, mock.Anything, mock.Anything)
+	})
+
+	t.Run("オーナー以外は 403 を返す", func(t *testing.T) {
+		mockService := new(mockImportJobService)
+		mockService.On("CreateJob", mock.Anything, "user-123", channelID.String(), mock.Anything).Return(nil, apperror.ErrForbidden)
+
+		router := setupImportJobRouter(mockService)
+		req := httptest.NewRequest(http.MethodPost, "/channels/"+channelID.String()+"/import", strings.NewReader(`{"feedUrl":"`+feedURL+`"}`))
+		req.Header.Set("Content-Type", "application/json")
+		rec := httptest.NewRecorder()
+
+		router.ServeHTTP(rec, req)
+
+		assert.Equal(t, http.StatusForbidden, rec.Code)
+	})
+}
+
+func TestImportJobHandler_GetImportJob(t *testing.T) {
+	t.Run("ジョブの詳細を返す", func(t *testing.T) {
+		jobID := uuid.New()
+		mockService := new(mockImportJobService)
+		mockService.On("GetJob", mock.Anything, "user-123", jobID.String()).Return(&response.ImportJobResponse{
+			ID:               jobID,
+			Status:           "completed",
+			Progress:         100,
+			TotalEpisodes:    3,
+			ImportedEpisodes: 2,
+			SkippedEpisodes:  1,
+		}, nil)
+
+		router := setupImportJobRouter(mockService)
+		req := httptest.NewRequest(http.MethodGet, "/import-jobs/"+jobID.String(), http.NoBody)
+		rec := httptest.NewRecorder()
+
+		router.ServeHTTP(rec, req)
+
+		assert.Equal(t, http.StatusOK, rec.Code)
+
+		var resp map[string]response.ImportJobResponse
+		err := json.Unmarshal(rec.Body.Bytes(), &resp)
+		require.NoError(t, err)
+		assert.Equal(t, 2, resp["data"].ImportedEpisodes)
+	})
+}
+
+func TestImportJobHandler_ListMyImportJobs(t *testing.T) {
+	t.Run("自分のジョブ一覧を返す", func(t *testing.T) {
+		mockService := new(mockImportJobService)
+		mockService.On("ListMyJobs", mock.Anything, "user-123").Return(&response.ImportJobListResponse{
+			Data: []response.ImportJobResponse{{ID: uuid.New(), Status: "processing"}},
+		}, nil)
+
+		router := setupImportJobRouter(mockService)
+		req := httptest.NewRequest(http.MethodGet, "/me/import-jobs", http.NoBody)
+		rec := httptest.NewRecorder()
+
+		router.ServeHTTP(rec, req)
+
+		assert.Equal(t, http.StatusOK, rec.Code)
+
+		var resp response.ImportJobListResponse
+		err := json.Unmarshal(rec.Body.Bytes(), &resp)
+		require.NoError(t, err)
+		require.Len(t, resp.Data, 1)
+		assert.Equal(t, "processing", resp.Data[0].Status)
+	})
+}
@@ -0,0 +1,105 @@
+package handler
+
+import (
+	"fmt"
+	"net/http"
+	"time"
+
+	"github.com/gin-gonic/gin"
+
+	"github.com/siropaca/anycast-backend/internal/apperror"
+	"github.com/siropaca/anycast-backend/internal/middleware"
+	"github.com/siropaca/anycast-backend/internal/service"
+)
+
+// mediaAudioCacheMaxAge はストレージから読み込んで配信する音声の Cache-Control の max-age
+const mediaAudioCacheMaxAge = time.Hour
+
+// 安定 URL でのメディア配信のハンドラー
+type MediaHandler struct {
+	mediaService service.MediaService
+}
+
+// MediaHandler を作成する
+func NewMediaHandler(ms service.MediaService) *MediaHandler {
+	return &MediaHandler{mediaService: ms}
+}
+
+// GetAudio godoc
+// @Summary 音声の配信
+// @Description 有効期限のない URL で音声を配信します。設定（MEDIA_AUDIO_DELIVERY）に応じて署名付き URL へリダイレクトするか、Range / ETag に対応してストリーミング配信します。公開済みエピソードの音声、またはチャンネルのオーナーのみ取得できます。
+// @Tags media
+// @Produce octet-stream
+// @Param audioId path string true "音声 ID"
+// @Param Range header string false "取得する範囲（例: bytes=0-1023）"
+// @Success 200 {file} binary
+// @Success 206 {file} binary
+// @Success 302 "署名付き URL へのリダイレクト"
+// @Success 304 "変更なし（If-None-Match が一致）"
+// @Failure 400 {object} response.ErrorResponse
+// @Failure 404 {object} response.ErrorResponse
+// @Failure 416 "範囲が不正"
+// @Failure 500 {object} response.ErrorResponse
+// @Router /media/audio/{audioId} [get]
+func (h *MediaHandler) GetAudio(c *gin.Context) {
+	userID, _ := middleware.GetUserID(c)
+
+	audioID := c.Param("audioId")
+	if audioID == "" {
+		Error(c, apperror.ErrValidation.WithMessage("audioId は必須です"))
+		return
+	}
+
+	result, err := h.mediaService.GetAudio(c.Request.Context(), userID, audioID)
+	if err != nil {
+		Error(c, err)
+		return
+	}
+
+	if result.Content == nil {
+		redirectToSignedURL(c, result.RedirectURL)
+		return
+	}
+	defer result.Content.Close()
+
+	cacheScope := "private"
+	if result.Public {
+		cacheScope = "public"
+	}
+	c.Header("Cache-Control", fmt.Sprintf("%s, max-age=%d", cacheScope, int(mediaAudioCacheMaxAge.Seconds())))
+	c.Header("Content-Type", result.Audio.MimeType)
+	c.Header("ETag", result.ETag())
+
+	// Range / If-None-Match / If-Range などの条件付きリクエストは ServeContent が処理する
+	// ファイルは書き換えのたびにパス（ETag）が変わり、作成日時は更新日時を表さないため、Last-Modified は返さずに ETag のみで検証する
+	http.ServeContent(c.Writer, c.Request, result.Audio.Filename, time.Time{}, result.Content)
+}
+
+// GetAudioWaveforms godoc
+// @Summary 音声の波形ピークデータ取得
+// @Description 音声の解像度別（最大ピーク数 100 / 400 / 1600）の波形ピークデータを粗い順に取得します。音声のレスポンスには最も粗い解像度のみが含まれるため、プレイヤー・エディタで詳細な波形を描画する場合に使います。取得できる音声は音声の配信と同じです。
+// @Tags media
+// @Produce json
+// @Param audioId path string true "音声 ID"
+// @Success 200 {object} response.AudioWaveformsDataResponse
+// @Failure 400 {object} response.ErrorResponse
+// @Failure 404 {object} response.ErrorResponse
+// @Failure 500 {object} response.ErrorResponse
+// @Router /media/audio/{audioId}/waveforms [get]
+func (h *MediaHandler) GetAudioWaveforms(c *gin.Context) {
+	userID, _ := middleware.GetUserID(c)
+
+	audioID := c.Param("audioId")
+	if audioID == "" {
+		Error(c, apperror.ErrValidation.WithMessage("audioId は必須です"))
+		return
+	}
+
+	result, err := h.mediaService.GetAudioWaveforms(c.Request.Context(), userID, audioID)
+	if err != nil {
+		Error(c, err)
+		return
+	}
+
+	c.JSON(http.StatusOK, result)
+}
@@ -0,0 +1,190 @@
+package handler
+
+import (
+	"context"
+	"encoding/json"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/gin-gonic/gin"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+
+	"github.com/siropaca/anycast-backend/internal/apperror"
+	"github.com/siropaca/anycast-backend/internal/dto/response"
+	"github.com/siropaca/anycast-backend/internal/model"
+	"github.com/siropaca/anycast-backend/internal/pkg/uuid"
+	"github.com/siropaca/anycast-backend/internal/service"
+)
+
+// MediaService のモック
+type mockMediaService struct {
+	mock.Mock
+}
+
+func (m *mockMediaService) GetAudio(ctx context.Context, userID, audioID string) (*service.MediaAudio, error) {
+	args := m.Called(ctx, userID, audioID)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).(*service.MediaAudio), args.Error(1)
+}
+
+func (m *mockMediaService) GetAudioWaveforms(ctx context.Context, userID, audioID string) (*response.AudioWaveformsDataResponse, error) {
+	args := m.Called(ctx, userID, audioID)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).(*response.AudioWaveformsDataResponse), args.Error(1)
+}
+
+// nopReadSeekCloser は Close で何もしない io.ReadSeekCloser
+type nopReadSeekCloser struct {
+	io.ReadSeeker
+}
+
+func (nopReadSeekCloser) Close() error { return nil }
+
+func setupMediaRouter(h *MediaHandler) *gin.Engine {
+	gin.SetMode(gin.TestMode)
+	r := gin.New()
+	r.GET("/media/audio/:audioId", h.GetAudio)
+	r.HEAD("/media/audio/:audioId", h.GetAudio)
+	r.GET("/media/audio/:audioId/waveforms", h.GetAudioWaveforms)
+	return r
+}
+
+func TestMediaHandler_GetAudio(t *testing.T) {
+	audio := &model.Audio{
+		ID:        uuid.New(),
+		MimeType:  "audio/mpeg",
+		Path:      "audios/episode.mp3",
+		Filename:  "episode.mp3",
+		FileSize:  10,
+		CreatedAt: time.Date(2026, 1, 1, 0, 0, 0, 0, time.UTC),
+	}
+	etag := (&service.MediaAudio{Audio: audio}).ETag()
+	newProxyResult := func(public bool) *service.MediaAudio {
+		return &service.MediaAudio{
+			Audio:   audio,
+			Public:  public,
+			Content: nopReadSeekCloser{strings.NewReader("0123456789")},
+		}
+	}
+
+	t.Run("redirect の場合は署名付き URL へリダイレクトする", func(t *testing.T) {
+		svc := new(mockMediaService)
+		svc.On("GetAudio", mock.Anything, "", audio.ID.String()).Return(&service.MediaAudio{Audio: audio, Public: true, RedirectURL: "https://storage.example.com/signed"}, nil)
+		router := setupMediaRouter(NewMediaHandler(svc))
+
+		w := httptest.NewRecorder()
+		req := httptest.NewRequest(http.MethodGet, "/media/audio/"+audio.ID.String(), nil)
+		router.ServeHTTP(w, req)
+
+		assert.Equal(t, http.StatusFound, w.Code)
+		assert.Equal(t, "https://storage.example.com/signed", w.Header().Get("Location"))
+		assert.Equal(t, "no-store", w.Header().Get("Cache-Control"))
+	})
+
+	t.Run("proxy の場合は ETag とキャッシュヘッダー付きで配信する", func(t *testing.T) {
+		svc := new(mockMediaService)
+		svc.On("GetAudio", mock.Anything, "", audio.ID.String()).Return(newProxyResult(true), nil)
+		router := setupMediaRouter(NewMediaHandler(svc))
+
+		w := httptest.NewRecorder()
+		req := httptest.NewRequest(http.MethodGet, "/media/audio/"+audio.ID.String(), nil)
+		router.ServeHTTP(w, req)
+
+		assert.Equal(t, http.StatusOK, w.Code)
+		assert.Equal(t, "0123456789", w.Body.String())
+		assert.Equal(t, "audio/mpeg", w.Header().Get("Content-Type"))
+		assert.Equal(t, etag, w.Header().Get("ETag"))
+		assert.Empty(t, w.Header().Get("Last-Modified"))
+		assert.Equal(t, "public, max-age=3600", w.Header().Get("Cache-Control"))
+		assert.Equal(t, "bytes", w.Header().Get("Accept-Ranges"))
+	})
+
+	t.Run("Range リクエストには部分コンテンツを返す", func(t *testing.T) {
+		svc := new(mockMediaService)
+		svc.On("GetAudio", mock.Anything, "", audio.ID.String()).Return(newProxyResult(false), nil)
+		router := setupMediaRouter(NewMediaHandler(svc))
+
+		w := httptest.NewRecorder()
+		req := httptest.NewRequest(http.MethodGet, "/media/audio/"+audio.ID.String(), nil)
+		req.Header.Set("Range", "bytes=2-5")
+		router.ServeHTTP(w, req)
+
+		assert.Equal(t, http.StatusPartialContent, w.Code)
+		assert.Equal(t, "2345", w.Body.String())
+		assert.Equal(t, "bytes 2-5/10", w.Header().Get("Content-Range"))
+		assert.Equal(t, "private, max-age=3600", w.Header().Get("Cache-Control"))
+	})
+
+	t.Run("If-None-Match が一致する場合は 304 を返す", func(t *testing.T) {
+		svc := new(mockMediaService)
+		svc.On("GetAudio", mock.Anything, "", audio.ID.String()).Return(newProxyResult(true), nil)
+		router := setupMediaRouter(NewMediaHandler(svc))
+
+		w := httptest.NewRecorder()
+		req := httptest.NewRequest(http.MethodGet, "/media/audio/"+audio.ID.String(), nil)
+		req.Header.Set("If-None-Match", etag)
+		router.ServeHTTP(w, req)
+
+		assert.Equal(t, http.StatusNotModified, w.Code)
+		assert.Empty(t, w.Body.String())
+	})
+
+	t.Run("閲覧できない音声は 404 を返す", func(t *testing.T) {
+		svc := new(mockMediaService)
+		svc.On("GetAudio", mock.Anything, "", audio.ID.String()).Return(nil, apperror.ErrNotFound.WithMessage("音声が見つかりません"))
+		router := setupMediaRouter(NewMediaHandler(svc))
+
+		w := httptest.NewRecorder()
+		req := httptest.NewRequest(http.MethodGet, "/media/audio/"+audio.ID.String(), nil)
+		router.ServeHTTP(w, req)
+
+		assert.Equal(t, http.StatusNotFound, w.Code)
+	})
+}
+
+func TestMediaHandler_GetAudioWaveforms(t *testing.T) {
+	audioID := uuid.New()
+
+	t.Run("解像度別の波形ピークデータを返す", func(t *testing.T) {
+		svc := new(mockMediaService)
+		svc.On("GetAudioWaveforms", mock.Anything, "", audioID.String()).Return(&response.AudioWaveformsDataResponse{
+			Data: []response.AudioWaveformResponse{
+				{Version: 2, Channels: 1, SampleRate: 16000, SamplesPerPixel: 160, Bits: 8, Length: 1, Data: []int{-1, 1}},
+				{Version: 2, Channels: 1, SampleRate: 16000, SamplesPerPixel: 40, Bits: 8, Length: 1, Data: []int{-2, 2}},
+			},
+		}, nil)
+		router := setupMediaRouter(NewMediaHandler(svc))
+
+		w := httptest.NewRecorder()
+		req := httptest.NewRequest(http.MethodGet, "/media/audio/"+audioID.String()+"/waveforms", nil)
+		router.ServeHTTP(w, req)
+
+		assert.Equal(t, http.StatusOK, w.Code)
+
+		var body response.AudioWaveformsDataResponse
+		assert.NoError(t, json.Unmarshal(w.Body.Bytes(), &body))
+		assert.Len(t, body.Data, 2)
+		assert.Equal(t, 40, body.Data[1].SamplesPerPixel)
+	})
+
+	t.Run("閲覧できない音声は 404 を返す", func(t *testing.T) {
+		svc := new(mockMediaService)
+		svc.On("GetAudioWaveforms", mock.Anything, "", audioID.String()).Return(nil, apperror.ErrNotFound.WithMessage("音声が見つかりません"))
+		router := setupMediaRouter(NewMediaHandler(svc))
+
+		w := httptest.NewRecorder()
+		req := httptest.NewRequest(http.MethodGet, "/media/audio/"+audioID.String()+"/waveforms", nil)
+		router.ServeHTTP(w, req)
+
+		assert.Equal(t, http.StatusNotFound, w.Code)
+	})
+}
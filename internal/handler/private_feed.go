@@ -0,0 +1,175 @@
+package handler
+
+import (
+	"net/http"
+
+	"github.com/gin-gonic/gin"
+
+	"github.com/siropaca/anycast-backend/internal/apperror"
+	"github.com/siropaca/anycast-backend/internal/dto/request"
+	"github.com/siropaca/anycast-backend/internal/dto/response"
+	"github.com/siropaca/anycast-backend/internal/middleware"
+	"github.com/siropaca/anycast-backend/internal/service"
+)
+
+// 限定公開フィード関連のハンドラー
+type PrivateFeedHandler struct {
+	privateFeedService service.PrivateFeedService
+}
+
+// PrivateFeedHandler を作成する
+func NewPrivateFeedHandler(pfs service.PrivateFeedService) *PrivateFeedHandler {
+	return &PrivateFeedHandler{
+		privateFeedService: pfs,
+	}
+}
+
+// CreatePrivateFeed godoc
+// @Summary 限定公開フィード発行
+// @Description チャンネルの限定公開 RSS フィードのトークンを発行します。username を指定すると他のユーザーに発行します。チャンネルのオーナーのみ実行できます。
+// @Tags private-feeds
+// @Accept json
+// @Produce json
+// @Param channelId path string true "チャンネル ID"
+// @Param request body request.CreatePrivateFeedRequest true "限定公開フィード発行情報"
+// @Success 201 {object} response.PrivateFeedDataResponse
+// @Failure 400 {object} response.ErrorResponse
+// @Failure 401 {object} response.ErrorResponse
+// @Failure 403 {object} response.ErrorResponse
+// @Failure 404 {object} response.ErrorResponse
+// @Failure 500 {object} response.ErrorResponse
+// @Security BearerAuth
+// @Router /channels/{channelId}/private-feeds [post]
+func (h *PrivateFeedHandler) CreatePrivateFeed(c *gin.Context) {
+	userID, ok := middleware.GetUserID(c)
+	if !ok {
+		Error(c, apperror.ErrUnauthorized)
+		return
+	}
+
+	var req request.CreatePrivateFeedRequest
+	if err := c.ShouldBindJSON(&req); err != nil {
+		Error(c, apperror.ErrValidation.WithMessage(formatValidationError(err)))
+		return
+	}
+
+	resp, err := h.privateFeedService.Create(c.Request.Context(), userID, c.Param("channelId"), req)
+	if err != nil {
+		Error(c, err)
+		return
+	}
+
+	Success(c, http.StatusCreated, resp)
+}
+
+// ListChannelPrivateFeeds godoc
+// @Summary チャンネルの限定公開フィード一覧取得
+// @Description チャンネルに発行された限定公開フィードの一覧を取得します。チャンネルのオーナーのみ実行できます。
+// @Tags private-feeds
+// @Produce json
+// @Param channelId path string true "チャンネル ID"
+// @Success 200 {object} response.PrivateFeedListDataResponse
+// @Failure 401 {object} response.ErrorResponse
+// @Failure 403 {object} response.ErrorResponse
+// @Failure 404 {object} response.ErrorResponse
+// @Failure 500 {object} response.ErrorResponse
+// @Security BearerAuth
+// @Router /channels/{channelId}/private-feeds [get]
+func (h *PrivateFeedHandler) ListChannelPrivateFeeds(c *gin.Context) {
+	userID, ok := middleware.GetUserID(c)
+	if !ok {
+		Error(c, apperror.ErrUnauthorized)
+		return
+	}
+
+	feeds, err := h.privateFeedService.ListByChannel(c.Request.Context(), userID, c.Param("channelId"))
+	if err != nil {
+		Error(c, err)
+		return
+	}
+
+	c.JSON(http.StatusOK, gin.H{"data": feeds})
+}
+
+// DeleteChannelPrivateFeed godoc
+// @Summary チャンネルの限定公開フィード失効
+// @Description チャンネルに発行された限定公開フィードを失効させます。チャンネルのオーナーのみ実行できます。
+// @Tags private-feeds
+// @Param channelId path string true "チャンネル ID"
+// @Param privateFeedId path string true "限定公開フィード ID"
+// @Success 204
+// @Failure 401 {object} response.ErrorResponse
+// @Failure 403 {object} response.ErrorResponse
+// @Failure 404 {object} response.ErrorResponse
+// @Failure 500 {object} response.ErrorResponse
+// @Security BearerAuth
+// @Router /channels/{channelId}/private-feeds/{privateFeedId} [delete]
+func (h *PrivateFeedHandler) DeleteChannelPrivateFeed(c *gin.Context) {
+	userID, ok := middleware.GetUserID(c)
+	if !ok {
+		Error(c, apperror.ErrUnauthorized)
+		return
+	}
+
+	if err := h.privateFeedService.DeleteByChannel(c.Request.Context(), userID, c.Param("channelId"), c.Param("privateFeedId")); err != nil {
+		Error(c, err)
+		return
+	}
+
+	c.Status(http.StatusNoContent)
+}
+
+// ListMyPrivateFeeds godoc
+// @Summary 自分の限定公開フィード一覧取得
+// @Description 自分に発行された限定公開フィードの一覧を取得します。
+// @Tags private-feeds
+// @Produce json
+// @Success 200 {object} response.PrivateFeedListDataResponse
+// @Failure 401 {object} response.ErrorResponse
+// @Failure 500 {object} response.ErrorResponse
+// @Security BearerAuth
+// @Router /me/private-feeds [get]
+func (h *PrivateFeedHandler) ListMyPrivateFeeds(c *gin.Context) {
+	userID, ok := middleware.GetUserID(c)
+	if !ok {
+		Error(c, apperror.ErrUnauthorized)
+		return
+	}
+
+	feeds, err := h.privateFeedService.ListMine(c.Request.Context(), userID)
+	if err != nil {
+		Error(c, err)
+		return
+	}
+
+	c.JSON(http.StatusOK, gin.H{"data": feeds})
+}
+
+// DeleteMyPrivateFeed godoc
+// @Summary 自分の限定公開フィード失効
+// @Description 自分に発行された限定公開フィードを失効させます。
+// @Tags private-feeds
+// @Param privateFeedId path string true "限定公開フィード ID"
+// @Success 204
+// @Failure 401 {object} response.ErrorResponse
+// @Failure 404 {object} response.ErrorResponse
+// @Failure 500 {object} response.ErrorResponse
+// @Security BearerAuth
+// @Router /me/private-feeds/{privateFeedId} [delete]
+func (h *PrivateFeedHandler) DeleteMyPrivateFeed(c *gin.Context) {
+	userID, ok := middleware.GetUserID(c)
+	if !ok {
+		Error(c, apperror.ErrUnauthorized)
+		return
+	}
+
+	if err := h.privateFeedService.DeleteMine(c.Request.Context(), userID, c.Param("privateFeedId")); err != nil {
+		Error(c, err)
+		return
+	}
+
+	c.Status(http.StatusNoContent)
+}
+
+// ListChannelPrivateFeeds / ListMyPrivateFeeds で使用するレスポンス型のコンパイル時チェック
+var _ = response.PrivateFeedListDataResponse{}
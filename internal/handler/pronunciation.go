@@ -0,0 +1,293 @@
+package handler
+
+import (
+	"net/http"
+
+	"github.com/gin-gonic/gin"
+
+	"github.com/siropaca/anycast-backend/internal/apperror"
+	"github.com/siropaca/anycast-backend/internal/dto/request"
+	"github.com/siropaca/anycast-backend/internal/middleware"
+	"github.com/siropaca/anycast-backend/internal/service"
+)
+
+// 発音辞書関連のハンドラー
+type PronunciationHandler struct {
+	pronunciationService service.PronunciationService
+}
+
+// PronunciationHandler を作成する
+func NewPronunciationHandler(ps service.PronunciationService) *PronunciationHandler {
+	return &PronunciationHandler{pronunciationService: ps}
+}
+
+// ListMyPronunciations godoc
+// @Summary ユーザー辞書一覧取得
+// @Description 認証ユーザーの発音辞書（全チャンネル共通）のエントリ一覧を表記順に取得します
+// @Tags me
+// @Accept json
+// @Produce json
+// @Success 200 {object} response.PronunciationListDataResponse
+// @Failure 401 {object} response.ErrorResponse
+// @Failure 500 {object} response.ErrorResponse
+// @Security BearerAuth
+// @Router /me/pronunciations [get]
+func (h *PronunciationHandler) ListMyPronunciations(c *gin.Context) {
+	userID, ok := middleware.GetUserID(c)
+	if !ok {
+		Error(c, apperror.ErrUnauthorized)
+		return
+	}
+
+	result, err := h.pronunciationService.ListMyPronunciations(c.Request.Context(), userID)
+	if err != nil {
+		Error(c, err)
+		return
+	}
+
+	c.JSON(http.StatusOK, result)
+}
+
+// CreateMyPronunciation godoc
+// @Summary ユーザー辞書登録
+// @Description 認証ユーザーの発音辞書にエントリ（表記 → 読み）を登録します
+// @Tags me
+// @Accept json
+// @Produce json
+// @Param request body request.CreatePronunciationRequest true "発音辞書エントリ作成リクエスト"
+// @Success 201 {object} response.PronunciationDataResponse
+// @Failure 400 {object} response.ErrorResponse
+// @Failure 401 {object} response.ErrorResponse
+// @Failure 409 {object} response.ErrorResponse "同じ表記のエントリが既に登録されている場合"
+// @Failure 500 {object} response.ErrorResponse
+// @Security BearerAuth
+// @Router /me/pronunciations [post]
+func (h *PronunciationHandler) CreateMyPronunciation(c *gin.Context) {
+	userID, ok := middleware.GetUserID(c)
+	if !ok {
+		Error(c, apperror.ErrUnauthorized)
+		return
+	}
+
+	var req request.CreatePronunciationRequest
+	if err := c.ShouldBindJSON(&req); err != nil {
+		Error(c, apperror.ErrValidation.WithMessage(formatValidationError(err)))
+		return
+	}
+
+	result, err := h.pronunciationService.CreateMyPronunciation(c.Request.Context(), userID, req)
+	if err != nil {
+		Error(c, err)
+		return
+	}
+
+	c.JSON(http.StatusCreated, result)
+}
+
+// UpdateMyPronunciation godoc
+// @Summary ユーザー辞書更新
+// @Description 認証ユーザーの発音辞書のエントリを更新します
+// @Tags me
+// @Accept json
+// @Produce json
+// @Param pronunciationId path string true "発音辞書エントリ ID"
+// @Param request body request.UpdatePronunciationRequest true "発音辞書エントリ更新リクエスト"
+// @Success 200 {object} response.PronunciationDataResponse
+// @Failure 400 {object} response.ErrorResponse
+// @Failure 401 {object} response.ErrorResponse
+// @Failure 404 {object} response.ErrorResponse
+// @Failure 409 {object} response.ErrorResponse "同じ表記のエントリが既に登録されている場合"
+// @Failure 500 {object} response.ErrorResponse
+// @Security BearerAuth
+// @Router /me/pronunciations/{pronunciationId} [patch]
+func (h *PronunciationHandler) UpdateMyPronunciation(c *gin.Context) {
+	userID, ok := middleware.GetUserID(c)
+	if !ok {
+		Error(c, apperror.ErrUnauthorized)
+		return
+	}
+
+	var req request.UpdatePronunciationRequest
+	if err := c.ShouldBindJSON(&req); err != nil {
+		Error(c, apperror.ErrValidation.WithMessage(formatValidationError(err)))
+		return
+	}
+
+	result, err := h.pronunciationService.UpdateMyPronunciation(c.Request.Context(), userID, c.Param("pronunciationId"), req)
+	if err != nil {
+		Error(c, err)
+		return
+	}
+
+	c.JSON(http.StatusOK, result)
+}
+
+// DeleteMyPronunciation godoc
+// @Summary ユーザー辞書削除
+// @Description 認証ユーザーの発音辞書のエントリを削除します
+// @Tags me
+// @Accept json
+// @Produce json
+// @Param pronunciationId path string true "発音辞書エントリ ID"
+// @Success 204 "No Content"
+// @Failure 400 {object} response.ErrorResponse
+// @Failure 401 {object} response.ErrorResponse
+// @Failure 404 {object} response.ErrorResponse
+// @Failure 500 {object} response.ErrorResponse
+// @Security BearerAuth
+// @Router /me/pronunciations/{pronunciationId} [delete]
+func (h *PronunciationHandler) DeleteMyPronunciation(c *gin.Context) {
+	userID, ok := middleware.GetUserID(c)
+	if !ok {
+		Error(c, apperror.ErrUnauthorized)
+		return
+	}
+
+	if err := h.pronunciationService.DeleteMyPronunciation(c.Request.Context(), userID, c.Param("pronunciationId")); err != nil {
+		Error(c, err)
+		return
+	}
+
+	c.Status(http.StatusNoContent)
+}
+
+// ListChannelPronunciations godoc
+// @Summary チャンネル辞書一覧取得
+// @Description チャンネル固有の発音辞書のエントリ一覧を表記順に取得します
+// @Tags channels
+// @Accept json
+// @Produce json
+// @Param channelId path string true "チャンネル ID"
+// @Success 200 {object} response.PronunciationListDataResponse
+// @Failure 400 {object} response.ErrorResponse
+// @Failure 401 {object} response.ErrorResponse
+// @Failure 403 {object} response.ErrorResponse
+// @Failure 404 {object} response.ErrorResponse
+// @Failure 500 {object} response.ErrorResponse
+// @Security BearerAuth
+// @Router /channels/{channelId}/pronunciations [get]
+func (h *PronunciationHandler) ListChannelPronunciations(c *gin.Context) {
+	userID, ok := middleware.GetUserID(c)
+	if !ok {
+		Error(c, apperror.ErrUnauthorized)
+		return
+	}
+
+	result, err := h.pronunciationService.ListChannelPronunciations(c.Request.Context(), userID, c.Param("channelId"))
+	if err != nil {
+		Error(c, err)
+		return
+	}
+
+	c.JSON(http.StatusOK, result)
+}
+
+// CreateChannelPronunciation godoc
+// @Summary チャンネル辞書登録
+// @Description チャンネル固有の発音辞書にエントリを登録します。同じ表記のユーザー辞書エントリより優先されます
+// @Tags channels
+// @Accept json
+// @Produce json
+// @Param channelId path string true "チャンネル ID"
+// @Param request body request.CreatePronunciationRequest true "発音辞書エントリ作成リクエスト"
+// @Success 201 {object} response.PronunciationDataResponse
+// @Failure 400 {object} response.ErrorResponse
+// @Failure 401 {object} response.ErrorResponse
+// @Failure 403 {object} response.ErrorResponse
+// @Failure 404 {object} response.ErrorResponse
+// @Failure 409 {object} response.ErrorResponse "同じ表記のエントリが既に登録されている場合"
+// @Failure 500 {object} response.ErrorResponse
+// @Security BearerAuth
+// @Router /channels/{channelId}/pronunciations [post]
+func (h *PronunciationHandler) CreateChannelPronunciation(c *gin.Context) {
+	userID, ok := middleware.GetUserID(c)
+	if !ok {
+		Error(c, apperror.ErrUnauthorized)
+		return
+	}
+
+	var req request.CreatePronunciationRequest
+	if err := c.ShouldBindJSON(&req); err != nil {
+		Error(c, apperror.ErrValidation.WithMessage(formatValidationError(err)))
+		return
+	}
+
+	result, err := h.pronunciationService.CreateChannelPronunciation(c.Request.Context(), userID, c.Param("channelId"), req)
+	if err != nil {
+		Error(c, err)
+		return
+	}
+
+	c.JSON(http.StatusCreated, result)
+}
+
+// UpdateChannelPronunciation godoc
+// @Summary チャンネル辞書更新
+// @Description チャンネル固有の発音辞書のエントリを更新します
+// @Tags channels
+// @Accept json
+// @Produce json
+// @Param channelId path string true "チャンネル ID"
+// @Param pronunciationId path string true "発音辞書エントリ ID"
+// @Param request body request.UpdatePronunciationRequest true "発音辞書エントリ更新リクエスト"
+// @Success 200 {object} response.PronunciationDataResponse
+// @Failure 400 {object} response.ErrorResponse
+// @Failure 401 {object} response.ErrorResponse
+// @Failure 403 {object} response.ErrorResponse
+// @Failure 404 {object} response.ErrorResponse
+// @Failure 409 {object} response.ErrorResponse "同じ表記のエントリが既に登録されている場合"
+// @Failure 500 {object} response.ErrorResponse
+// @Security BearerAuth
+// @Router /channels/{channelId}/pronunciations/{pronunciationId} [patch]
+func (h *PronunciationHandler) UpdateChannelPronunciation(c *gin.Context) {
+	userID, ok := middleware.GetUserID(c)
+	if !ok {
+		Error(c, apperror.ErrUnauthorized)
+		return
+	}
+
+	var req request.UpdatePronunciationRequest
+	if err := c.ShouldBindJSON(&req); err != nil {
+		Error(c, apperror.ErrValidation.WithMessage(formatValidationError(err)))
+		return
+	}
+
+	result, err := h.pronunciationService.UpdateChannelPronunciation(c.Request.Context(), userID, c.Param("channelId"), c.Param("pronunciationId"), req)
+	if err != nil {
+		Error(c, err)
+		return
+	}
+
+	c.JSON(http.StatusOK, result)
+}
+
+// DeleteChannelPronunciation godoc
+// @Summary チャンネル辞書削除
+// @Description チャンネル固有の発音辞書のエントリを削除します
+// @Tags channels
+// @Accept json
+// @Produce json
+// @Param channelId path string true "チャンネル ID"
+// @Param pronunciationId path string true "発音辞書エントリ ID"
+// @Success 204 "No Content"
+// @Failure 400 {object} response.ErrorResponse
+// @Failure 401 {object} response.ErrorResponse
+// @Failure 403 {object} response.ErrorResponse
+// @Failure 404 {object} response.ErrorResponse
+// @Failure 500 {object} response.ErrorResponse
+// @Security BearerAuth
+// @Router /channels/{channelId}/pronunciations/{pronunciationId} [delete]
+func (h *PronunciationHandler) DeleteChannelPronunciation(c *gin.Context) {
+	userID, ok := middleware.GetUserID(c)
+	if !ok {
+		Error(c, apperror.ErrUnauthorized)
+		return
+	}
+
+	if err := h.pronunciationService.DeleteChannelPronunciation(c.Request.Context(), userID, c.Param("channelId"), c.Param("pronunciationId")); err != nil {
+		Error(c, err)
+		return
+	}
+
+	c.Status(http.StatusNoContent)
+}
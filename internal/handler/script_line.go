@@ -173,6 +173,58 @@ func (h *ScriptLineHandler) UpdateScriptLine(c *gin.Context) {
 	c.JSON(http.StatusOK, result)
 }
 
+// PreviewScriptLine godoc
+// @Summary 台本行プレビュー
+// @Description 指定した台本行 1 行分の音声を話者のボイス・感情で合成し、MP3 の署名付き URL を返します。同じ内容の音声はキャッシュを返します。ユーザーごとにリクエスト数の上限があります
+// @Tags script
+// @Accept json
+// @Produce json
+// @Param channelId path string true "チャンネル ID"
+// @Param episodeId path string true "エピソード ID"
+// @Param lineId path string true "台本行 ID"
+// @Success 200 {object} response.ScriptLinePreviewDataResponse
+// @Failure 400 {object} response.ErrorResponse
+// @Failure 401 {object} response.ErrorResponse
+// @Failure 403 {object} response.ErrorResponse
+// @Failure 404 {object} response.ErrorResponse
+// @Failure 429 {object} response.ErrorResponse
+// @Failure 500 {object} response.ErrorResponse
+// @Security BearerAuth
+// @Router /channels/{channelId}/episodes/{episodeId}/script/lines/{lineId}/preview [post]
+func (h *ScriptLineHandler) PreviewScriptLine(c *gin.Context) {
+	userID, ok := middleware.GetUserID(c)
+	if !ok {
+		Error(c, apperror.ErrUnauthorized)
+		return
+	}
+
+	channelID := c.Param("channelId")
+	if channelID == "" {
+		Error(c, apperror.ErrValidation.WithMessage("channelId は必須です"))
+		return
+	}
+
+	episodeID := c.Param("episodeId")
+	if episodeID == "" {
+		Error(c, apperror.ErrValidation.WithMessage("episodeId は必須です"))
+		return
+	}
+
+	lineID := c.Param("lineId")
+	if lineID == "" {
+		Error(c, apperror.ErrValidation.WithMessage("lineId は必須です"))
+		return
+	}
+
+	result, err := h.scriptLineService.Preview(c.Request.Context(), userID, channelID, episodeID, lineID)
+	if err != nil {
+		Error(c, err)
+		return
+	}
+
+	c.JSON(http.StatusOK, result)
+}
+
 // DeleteScriptLine godoc
 // @Summary 台本行削除
 // @Description 指定した台本行を削除します
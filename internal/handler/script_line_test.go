@@ -66,6 +66,14 @@ func (m *mockScriptLineService) Reorder(ctx context.Context, userID, channelID,
 	return args.Get(0).(*response.ScriptLineListResponse), args.Error(1)
 }
 
+func (m *mockScriptLineService) Preview(ctx context.Context, userID, channelID, episodeID, lineID string) (*response.ScriptLinePreviewDataResponse, error) {
+	args := m.Called(ctx, userID, channelID, episodeID, lineID)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).(*response.ScriptLinePreviewDataResponse), args.Error(1)
+}
+
 // テスト用のルーターをセットアップする
 func setupScriptLineRouter(h *ScriptLineHandler) *gin.Engine {
 	gin.SetMode(gin.TestMode)
@@ -328,3 +336,79 @@ func TestScriptLineHandler_DeleteAllScriptLines(t *testing.T) {
 		assert.Equal(t, http.StatusUnauthorized, w.Code)
 	})
 }
+
+func TestScriptLineHandler_PreviewScriptLine(t *testing.T) {
+	userID := uuid.New().String()
+	channelID := uuid.New().String()
+	episodeID := uuid.New().String()
+	lineID := uuid.New().String()
+	path := "/channels/" + channelID + "/episodes/" + episodeID + "/script/lines/" + lineID + "/preview"
+
+	setupRouter := func(h *ScriptLineHandler, uid string) *gin.Engine {
+		gin.SetMode(gin.TestMode)
+		r := gin.New()
+		r.Use(func(c *gin.Context) {
+			c.Set(string(middleware.UserIDKey), uid)
+			c.Next()
+		})
+		r.POST("/channels/:channelId/episodes/:episodeId/script/lines/:lineId/preview", h.PreviewScriptLine)
+		return r
+	}
+
+	t.Run("プレビュー音声の URL を返す", func(t *testing.T) {
+		mockSvc := new(mockScriptLineService)
+		result := &response.ScriptLinePreviewDataResponse{
+			Data: response.ScriptLinePreviewResponse{
+				URL:      "https://storage.example.com/tts-previews/abc.mp3",
+				MimeType: "audio/mpeg",
+				Cached:   true,
+			},
+		}
+		mockSvc.On("Preview", mock.Anything, userID, channelID, episodeID, lineID).Return(result, nil)
+
+		handler := NewScriptLineHandler(mockSvc)
+		router := setupRouter(handler, userID)
+
+		w := httptest.NewRecorder()
+		req := httptest.NewRequest("POST", path, http.NoBody)
+		router.ServeHTTP(w, req)
+
+		assert.Equal(t, http.StatusOK, w.Code)
+
+		var resp response.ScriptLinePreviewDataResponse
+		err := json.Unmarshal(w.Body.Bytes(), &resp)
+		assert.NoError(t, err)
+		assert.Equal(t, result.Data, resp.Data)
+		mockSvc.AssertExpectations(t)
+	})
+
+	t.Run("台本行が見つからない場合は 404 を返す", func(t *testing.T) {
+		mockSvc := new(mockScriptLineService)
+		mockSvc.On("Preview", mock.Anything, userID, channelID, episodeID, lineID).Return(nil, apperror.ErrNotFound.WithMessage("Script line not found"))
+
+		handler := NewScriptLineHandler(mockSvc)
+		router := setupRouter(handler, userID)
+
+		w := httptest.NewRecorder()
+		req := httptest.NewRequest("POST", path, http.NoBody)
+		router.ServeHTTP(w, req)
+
+		assert.Equal(t, http.StatusNotFound, w.Code)
+		mockSvc.AssertExpectations(t)
+	})
+
+	t.Run("未認証の場合は 401 を返す", func(t *testing.T) {
+		mockSvc := new(mockScriptLineService)
+		handler := NewScriptLineHandler(mockSvc)
+
+		gin.SetMode(gin.TestMode)
+		r := gin.New()
+		r.POST("/channels/:channelId/episodes/:episodeId/script/lines/:lineId/preview", handler.PreviewScriptLine)
+
+		w := httptest.NewRecorder()
+		req := httptest.NewRequest("POST", path, http.NoBody)
+		r.ServeHTTP(w, req)
+
+		assert.Equal(t, http.StatusUnauthorized, w.Code)
+	})
+}
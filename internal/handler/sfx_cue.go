@@ -0,0 +1,172 @@
+package handler
+
+import (
+	"net/http"
+
+	"github.com/gin-gonic/gin"
+
+	"github.com/siropaca/anycast-backend/internal/apperror"
+	"github.com/siropaca/anycast-backend/internal/dto/request"
+	"github.com/siropaca/anycast-backend/internal/middleware"
+	"github.com/siropaca/anycast-backend/internal/service"
+)
+
+// 効果音キュー関連のハンドラー
+type SfxCueHandler struct {
+	sfxCueService service.SfxCueService
+}
+
+// SfxCueHandler を作成する
+func NewSfxCueHandler(scs service.SfxCueService) *SfxCueHandler {
+	return &SfxCueHandler{sfxCueService: scs}
+}
+
+// ListSfxCues godoc
+// @Summary 効果音キュー一覧取得
+// @Description 指定したエピソードの台本行に設定された効果音キュー一覧を台本の行順で取得します
+// @Tags script
+// @Accept json
+// @Produce json
+// @Param channelId path string true "チャンネル ID"
+// @Param episodeId path string true "エピソード ID"
+// @Success 200 {object} response.SfxCueListResponse
+// @Failure 401 {object} response.ErrorResponse
+// @Failure 403 {object} response.ErrorResponse
+// @Failure 404 {object} response.ErrorResponse
+// @Failure 500 {object} response.ErrorResponse
+// @Security BearerAuth
+// @Router /channels/{channelId}/episodes/{episodeId}/script/sfx-cues [get]
+func (h *SfxCueHandler) ListSfxCues(c *gin.Context) {
+	userID, ok := middleware.GetUserID(c)
+	if !ok {
+		Error(c, apperror.ErrUnauthorized)
+		return
+	}
+
+	channelID := c.Param("channelId")
+	if channelID == "" {
+		Error(c, apperror.ErrValidation.WithMessage("channelId は必須です"))
+		return
+	}
+
+	episodeID := c.Param("episodeId")
+	if episodeID == "" {
+		Error(c, apperror.ErrValidation.WithMessage("episodeId は必須です"))
+		return
+	}
+
+	result, err := h.sfxCueService.ListByEpisodeID(c.Request.Context(), userID, channelID, episodeID)
+	if err != nil {
+		Error(c, err)
+		return
+	}
+
+	c.JSON(http.StatusOK, result)
+}
+
+// CreateSfxCue godoc
+// @Summary 効果音キュー作成
+// @Description 指定した台本行の前または後に効果音キューを追加します。soundEffectId と systemSoundEffectId のどちらか一方を指定してください
+// @Tags script
+// @Accept json
+// @Produce json
+// @Param channelId path string true "チャンネル ID"
+// @Param episodeId path string true "エピソード ID"
+// @Param lineId path string true "台本行 ID"
+// @Param request body request.CreateSfxCueRequest true "効果音キュー作成リクエスト"
+// @Success 201 {object} response.SfxCueDataResponse
+// @Failure 400 {object} response.ErrorResponse
+// @Failure 401 {object} response.ErrorResponse
+// @Failure 403 {object} response.ErrorResponse
+// @Failure 404 {object} response.ErrorResponse
+// @Failure 500 {object} response.ErrorResponse
+// @Security BearerAuth
+// @Router /channels/{channelId}/episodes/{episodeId}/script/lines/{lineId}/sfx-cues [post]
+func (h *SfxCueHandler) CreateSfxCue(c *gin.Context) {
+	userID, ok := middleware.GetUserID(c)
+	if !ok {
+		Error(c, apperror.ErrUnauthorized)
+		return
+	}
+
+	channelID := c.Param("channelId")
+	if channelID == "" {
+		Error(c, apperror.ErrValidation.WithMessage("channelId は必須です"))
+		return
+	}
+
+	episodeID := c.Param("episodeId")
+	if episodeID == "" {
+		Error(c, apperror.ErrValidation.WithMessage("episodeId は必須です"))
+		return
+	}
+
+	lineID := c.Param("lineId")
+	if lineID == "" {
+		Error(c, apperror.ErrValidation.WithMessage("lineId は必須です"))
+		return
+	}
+
+	var req request.CreateSfxCueRequest
+	if err := c.ShouldBindJSON(&req); err != nil {
+		Error(c, apperror.ErrValidation.WithMessage(formatValidationError(err)))
+		return
+	}
+
+	result, err := h.sfxCueService.Create(c.Request.Context(), userID, channelID, episodeID, lineID, req)
+	if err != nil {
+		Error(c, err)
+		return
+	}
+
+	c.JSON(http.StatusCreated, result)
+}
+
+// DeleteSfxCue godoc
+// @Summary 効果音キュー削除
+// @Description 指定した効果音キューを削除します
+// @Tags script
+// @Accept json
+// @Produce json
+// @Param channelId path string true "チャンネル ID"
+// @Param episodeId path string true "エピソード ID"
+// @Param cueId path string true "効果音キュー ID"
+// @Success 204 "No Content"
+// @Failure 401 {object} response.ErrorResponse
+// @Failure 403 {object} response.ErrorResponse
+// @Failure 404 {object} response.ErrorResponse
+// @Failure 500 {object} response.ErrorResponse
+// @Security BearerAuth
+// @Router /channels/{channelId}/episodes/{episodeId}/script/sfx-cues/{cueId} [delete]
+func (h *SfxCueHandler) DeleteSfxCue(c *gin.Context) {
+	userID, ok := middleware.GetUserID(c)
+	if !ok {
+		Error(c, apperror.ErrUnauthorized)
+		return
+	}
+
+	channelID := c.Param("channelId")
+	if channelID == "" {
+		Error(c, apperror.ErrValidation.WithMessage("channelId は必須です"))
+		return
+	}
+
+	episodeID := c.Param("episodeId")
+	if episodeID == "" {
+		Error(c, apperror.ErrValidation.WithMessage("episodeId は必須です"))
+		return
+	}
+
+	cueID := c.Param("cueId")
+	if cueID == "" {
+		Error(c, apperror.ErrValidation.WithMessage("cueId は必須です"))
+		return
+	}
+
+	if err := h.sfxCueService.Delete(c.Request.Context(), userID, channelID, episodeID, cueID); err != nil {
+		Error(c, err)
+		return
+	}
+
+	c.Status(http.StatusNoContent)
+}
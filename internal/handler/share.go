@@ -0,0 +1,148 @@
+package handler
+
+import (
+	"net/http"
+
+	"github.com/gin-gonic/gin"
+
+	"github.com/siropaca/anycast-backend/internal/apperror"
+	"github.com/siropaca/anycast-backend/internal/dto/request"
+	"github.com/siropaca/anycast-backend/internal/pkg/embedplayer"
+	"github.com/siropaca/anycast-backend/internal/service"
+)
+
+// shareCacheControl は共有用エンドポイントの Cache-Control（SNS のクローラーのアクセスを共有キャッシュで受ける）
+const shareCacheControl = "public, max-age=300"
+
+// チャンネル・エピソードの共有関連のハンドラー
+type ShareHandler struct {
+	shareService service.ShareService
+}
+
+// ShareHandler を作成する
+func NewShareHandler(ss service.ShareService) *ShareHandler {
+	return &ShareHandler{shareService: ss}
+}
+
+// GetChannelMetadata godoc
+// @Summary チャンネルの共有用メタデータ取得
+// @Description 公開済みチャンネルの Open Graph・Twitter カードのメタデータを取得します。Web フロントエンドがページの meta タグを出力するために使います。
+// @Tags share
+// @Produce json
+// @Param channelId path string true "チャンネル ID"
+// @Success 200 {object} response.ShareMetadataDataResponse
+// @Failure 400 {object} response.ErrorResponse
+// @Failure 404 {object} response.ErrorResponse
+// @Failure 500 {object} response.ErrorResponse
+// @Router /share/channels/{channelId} [get]
+func (h *ShareHandler) GetChannelMetadata(c *gin.Context) {
+	channelID := c.Param("channelId")
+	if channelID == "" {
+		Error(c, apperror.ErrValidation.WithMessage("channelId は必須です"))
+		return
+	}
+
+	result, err := h.shareService.GetChannelMetadata(c.Request.Context(), channelID)
+	if err != nil {
+		Error(c, err)
+		return
+	}
+
+	c.Header("Cache-Control", shareCacheControl)
+	c.JSON(http.StatusOK, result)
+}
+
+// GetEpisodeMetadata godoc
+// @Summary エピソードの共有用メタデータ取得
+// @Description 公開済みエピソードの Open Graph・Twitter カードのメタデータを取得します。音声のあるエピソードは埋め込みプレイヤーを Twitter のプレイヤーカードとして指定します。
+// @Tags share
+// @Produce json
+// @Param channelId path string true "チャンネル ID"
+// @Param episodeId path string true "エピソード ID"
+// @Success 200 {object} response.ShareMetadataDataResponse
+// @Failure 400 {object} response.ErrorResponse
+// @Failure 404 {object} response.ErrorResponse
+// @Failure 500 {object} response.ErrorResponse
+// @Router /share/channels/{channelId}/episodes/{episodeId} [get]
+func (h *ShareHandler) GetEpisodeMetadata(c *gin.Context) {
+	channelID, episodeID, ok := bindFeedEpisodeParams(c)
+	if !ok {
+		return
+	}
+
+	result, err := h.shareService.GetEpisodeMetadata(c.Request.Context(), channelID, episodeID)
+	if err != nil {
+		Error(c, err)
+		return
+	}
+
+	c.Header("Cache-Control", shareCacheControl)
+	c.JSON(http.StatusOK, result)
+}
+
+// GetOEmbed godoc
+// @Summary oEmbed
+// @Description チャンネル・エピソードの Web ページの URL に対する oEmbed のレスポンスを返します。音声のあるエピソードは埋め込みプレイヤーの iframe を返します。format は json のみ対応しています。
+// @Tags share
+// @Produce json
+// @Param url query string true "チャンネル・エピソードの Web ページの URL"
+// @Param format query string false "レスポンス形式（json のみ）"
+// @Param maxwidth query int false "埋め込みの最大幅（px）"
+// @Param maxheight query int false "埋め込みの最大高さ（px）"
+// @Success 200 {object} response.OEmbedResponse
+// @Failure 400 {object} response.ErrorResponse
+// @Failure 404 {object} response.ErrorResponse
+// @Failure 500 {object} response.ErrorResponse
+// @Router /oembed [get]
+func (h *ShareHandler) GetOEmbed(c *gin.Context) {
+	var req request.OEmbedRequest
+	if err := c.ShouldBindQuery(&req); err != nil {
+		Error(c, apperror.ErrValidation.WithMessage(formatValidationError(err)))
+		return
+	}
+
+	result, err := h.shareService.GetOEmbed(c.Request.Context(), req)
+	if err != nil {
+		Error(c, err)
+		return
+	}
+
+	c.Header("Cache-Control", shareCacheControl)
+	c.JSON(http.StatusOK, result)
+}
+
+// GetEmbedPlayer godoc
+// @Summary 埋め込みプレイヤー
+// @Description 公開済みエピソードの埋め込みプレイヤー（iframe 用の HTML ページ）を返します。t で再生開始位置（秒）を指定できます。
+// @Tags share
+// @Produce html
+// @Param channelId path string true "チャンネル ID"
+// @Param episodeId path string true "エピソード ID"
+// @Param t query int false "再生開始位置（秒）"
+// @Success 200 {string} string "埋め込みプレイヤーの HTML"
+// @Failure 400 {object} response.ErrorResponse
+// @Failure 404 {object} response.ErrorResponse
+// @Failure 500 {object} response.ErrorResponse
+// @Router /embed/channels/{channelId}/episodes/{episodeId} [get]
+func (h *ShareHandler) GetEmbedPlayer(c *gin.Context) {
+	channelID, episodeID, ok := bindFeedEpisodeParams(c)
+	if !ok {
+		return
+	}
+
+	var req request.EmbedPlayerRequest
+	if err := c.ShouldBindQuery(&req); err != nil {
+		Error(c, apperror.ErrValidation.WithMessage(formatValidationError(err)))
+		return
+	}
+
+	data, err := h.shareService.GetEmbedPlayer(c.Request.Context(), channelID, episodeID, req.T)
+	if err != nil {
+		Error(c, err)
+		return
+	}
+
+	c.Header("Cache-Control", shareCacheControl)
+	c.Header("Content-Security-Policy", embedplayer.ContentSecurityPolicy)
+	c.Data(http.StatusOK, embedplayer.ContentType, data)
+}
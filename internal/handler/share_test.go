@@ -0,0 +1,182 @@
+package handler
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/gin-gonic/gin"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+
+	"github.com/siropaca/anycast-backend/internal/apperror"
+	"github.com/siropaca/anycast-backend/internal/dto/request"
+	"github.com/siropaca/anycast-backend/internal/dto/response"
+	"github.com/siropaca/anycast-backend/internal/pkg/embedplayer"
+	"github.com/siropaca/anycast-backend/internal/pkg/uuid"
+)
+
+// ShareService のモック
+type mockShareService struct {
+	mock.Mock
+}
+
+func (m *mockShareService) GetChannelMetadata(ctx context.Context, channelID string) (*response.ShareMetadataDataResponse, error) {
+	args := m.Called(ctx, channelID)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).(*response.ShareMetadataDataResponse), args.Error(1)
+}
+
+func (m *mockShareService) GetEpisodeMetadata(ctx context.Context, channelID, episodeID string) (*response.ShareMetadataDataResponse, error) {
+	args := m.Called(ctx, channelID, episodeID)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).(*response.ShareMetadataDataResponse), args.Error(1)
+}
+
+func (m *mockShareService) GetOEmbed(ctx context.Context, req request.OEmbedRequest) (*response.OEmbedResponse, error) {
+	args := m.Called(ctx, req)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).(*response.OEmbedResponse), args.Error(1)
+}
+
+func (m *mockShareService) GetEmbedPlayer(ctx context.Context, channelID, episodeID string, startSeconds int) ([]byte, error) {
+	args := m.Called(ctx, channelID, episodeID, startSeconds)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).([]byte), args.Error(1)
+}
+
+func setupShareRouter(h *ShareHandler) *gin.Engine {
+	gin.SetMode(gin.TestMode)
+	r := gin.New()
+	r.GET("/share/channels/:channelId", h.GetChannelMetadata)
+	r.GET("/share/channels/:channelId/episodes/:episodeId", h.GetEpisodeMetadata)
+	r.GET("/oembed", h.GetOEmbed)
+	r.GET("/embed/channels/:channelId/episodes/:episodeId", h.GetEmbedPlayer)
+	return r
+}
+
+func TestShareHandler_GetEpisodeMetadata(t *testing.T) {
+	channelID := uuid.New().String()
+	episodeID := uuid.New().String()
+
+	t.Run("共有用メタデータを返す", func(t *testing.T) {
+		mockSvc := new(mockShareService)
+		mockSvc.On("GetEpisodeMetadata", mock.Anything, channelID, episodeID).Return(&response.ShareMetadataDataResponse{
+			Data: response.ShareMetadataResponse{
+				Title:     "第1回",
+				OpenGraph: []response.ShareMetaTagResponse{{Name: "og:title", Content: "第1回"}},
+			},
+		}, nil)
+		router := setupShareRouter(NewShareHandler(mockSvc))
+
+		w := httptest.NewRecorder()
+		req := httptest.NewRequest(http.MethodGet, "/share/channels/"+channelID+"/episodes/"+episodeID, nil)
+		router.ServeHTTP(w, req)
+
+		assert.Equal(t, http.StatusOK, w.Code)
+		assert.Equal(t, shareCacheControl, w.Header().Get("Cache-Control"))
+
+		var body response.ShareMetadataDataResponse
+		require.NoError(t, json.Unmarshal(w.Body.Bytes(), &body))
+		assert.Equal(t, "第1回", body.Data.Title)
+		assert.Equal(t, "og:title", body.Data.OpenGraph[0].Name)
+	})
+
+	t.Run("未公開のエピソードは 404 を返す", func(t *testing.T) {
+		mockSvc := new(mockShareService)
+		mockSvc.On("GetEpisodeMetadata", mock.Anything, channelID, episodeID).Return(nil, apperror.ErrNotFound.WithMessage("エピソードが見つかりません"))
+		router := setupShareRouter(NewShareHandler(mockSvc))
+
+		w := httptest.NewRecorder()
+		req := httptest.NewRequest(http.MethodGet, "/share/channels/"+channelID+"/episodes/"+episodeID, nil)
+		router.ServeHTTP(w, req)
+
+		assert.Equal(t, http.StatusNotFound, w.Code)
+	})
+}
+
+func TestShareHandler_GetOEmbed(t *testing.T) {
+	pageURL := "https://anycast.example.com/channels/" + uuid.New().String()
+
+	t.Run("oEmbed のレスポンスを data ラッパーなしで返す", func(t *testing.T) {
+		mockSvc := new(mockShareService)
+		mockSvc.On("GetOEmbed", mock.Anything, request.OEmbedRequest{URL: pageURL, Format: "json", MaxWidth: 320}).
+			Return(&response.OEmbedResponse{Type: "link", Version: "1.0", Title: "テックトーク"}, nil)
+		router := setupShareRouter(NewShareHandler(mockSvc))
+
+		w := httptest.NewRecorder()
+		req := httptest.NewRequest(http.MethodGet, "/oembed?format=json&maxwidth=320&url="+pageURL, nil)
+		router.ServeHTTP(w, req)
+
+		assert.Equal(t, http.StatusOK, w.Code)
+
+		var body map[string]any
+		require.NoError(t, json.Unmarshal(w.Body.Bytes(), &body))
+		assert.Equal(t, "link", body["type"])
+		assert.Equal(t, "1.0", body["version"])
+		assert.NotContains(t, body, "data")
+		assert.NotContains(t, body, "html")
+	})
+
+	t.Run("url がない場合は 400 を返す", func(t *testing.T) {
+		router := setupShareRouter(NewShareHandler(new(mockShareService)))
+
+		w := httptest.NewRecorder()
+		req := httptest.NewRequest(http.MethodGet, "/oembed", nil)
+		router.ServeHTTP(w, req)
+
+		assert.Equal(t, http.StatusBadRequest, w.Code)
+	})
+
+	t.Run("json 以外の format は 400 を返す", func(t *testing.T) {
+		router := setupShareRouter(NewShareHandler(new(mockShareService)))
+
+		w := httptest.NewRecorder()
+		req := httptest.NewRequest(http.MethodGet, "/oembed?format=xml&url="+pageURL, nil)
+		router.ServeHTTP(w, req)
+
+		assert.Equal(t, http.StatusBadRequest, w.Code)
+	})
+}
+
+func TestShareHandler_GetEmbedPlayer(t *testing.T) {
+	channelID := uuid.New().String()
+	episodeID := uuid.New().String()
+
+	t.Run("埋め込みプレイヤーの HTML を返す", func(t *testing.T) {
+		mockSvc := new(mockShareService)
+		mockSvc.On("GetEmbedPlayer", mock.Anything, channelID, episodeID, 90).Return([]byte("<!DOCTYPE html>"), nil)
+		router := setupShareRouter(NewShareHandler(mockSvc))
+
+		w := httptest.NewRecorder()
+		req := httptest.NewRequest(http.MethodGet, "/embed/channels/"+channelID+"/episodes/"+episodeID+"?t=90", nil)
+		router.ServeHTTP(w, req)
+
+		assert.Equal(t, http.StatusOK, w.Code)
+		assert.Equal(t, "<!DOCTYPE html>", w.Body.String())
+		assert.Equal(t, embedplayer.ContentType, w.Header().Get("Content-Type"))
+		assert.Equal(t, embedplayer.ContentSecurityPolicy, w.Header().Get("Content-Security-Policy"))
+		assert.Empty(t, w.Header().Get("X-Frame-Options"))
+	})
+
+	t.Run("負の再生開始位置は 400 を返す", func(t *testing.T) {
+		router := setupShareRouter(NewShareHandler(new(mockShareService)))
+
+		w := httptest.NewRecorder()
+		req := httptest.NewRequest(http.MethodGet, "/embed/channels/"+channelID+"/episodes/"+episodeID+"?t=-1", nil)
+		router.ServeHTTP(w, req)
+
+		assert.Equal(t, http.StatusBadRequest, w.Code)
+	})
+}
@@ -0,0 +1,200 @@
+package handler
+
+import (
+	"net/http"
+
+	"github.com/gin-gonic/gin"
+
+	"github.com/siropaca/anycast-backend/internal/apperror"
+	"github.com/siropaca/anycast-backend/internal/dto/request"
+	"github.com/siropaca/anycast-backend/internal/middleware"
+	"github.com/siropaca/anycast-backend/internal/service"
+)
+
+// 効果音関連のハンドラー
+type SoundEffectHandler struct {
+	soundEffectService service.SoundEffectService
+}
+
+// SoundEffectHandler を作成する
+func NewSoundEffectHandler(ss service.SoundEffectService) *SoundEffectHandler {
+	return &SoundEffectHandler{soundEffectService: ss}
+}
+
+// ListMySoundEffects godoc
+// @Summary 自分の効果音一覧取得
+// @Description 認証ユーザーの所有する効果音一覧を取得します。include_system=true の場合はシステム効果音も含めます。
+// @Tags me
+// @Accept json
+// @Produce json
+// @Param include_system query bool false "システム効果音を含めるかどうか（デフォルト: false）"
+// @Param limit query int false "取得件数（デフォルト: 20、最大: 100）"
+// @Param offset query int false "オフセット（デフォルト: 0）"
+// @Success 200 {object} response.SoundEffectListWithPaginationResponse
+// @Failure 400 {object} response.ErrorResponse
+// @Failure 401 {object} response.ErrorResponse
+// @Failure 500 {object} response.ErrorResponse
+// @Security BearerAuth
+// @Router /me/sound-effects [get]
+func (h *SoundEffectHandler) ListMySoundEffects(c *gin.Context) {
+	userID, ok := middleware.GetUserID(c)
+	if !ok {
+		Error(c, apperror.ErrUnauthorized)
+		return
+	}
+
+	var req request.ListMySoundEffectsRequest
+	if err := c.ShouldBindQuery(&req); err != nil {
+		Error(c, apperror.ErrValidation.WithMessage(formatValidationError(err)))
+		return
+	}
+
+	result, err := h.soundEffectService.ListMySoundEffects(c.Request.Context(), userID, req)
+	if err != nil {
+		Error(c, err)
+		return
+	}
+
+	c.JSON(http.StatusOK, result)
+}
+
+// CreateSoundEffect godoc
+// @Summary 効果音作成
+// @Description 新しい効果音を作成します
+// @Tags me
+// @Accept json
+// @Produce json
+// @Param request body request.CreateSoundEffectRequest true "効果音作成リクエスト"
+// @Success 201 {object} response.SoundEffectDataResponse
+// @Failure 400 {object} response.ErrorResponse
+// @Failure 401 {object} response.ErrorResponse
+// @Failure 404 {object} response.ErrorResponse "指定された音声ファイルが見つからない場合"
+// @Failure 409 {object} response.ErrorResponse "同じ名前の効果音が既に存在する場合"
+// @Failure 500 {object} response.ErrorResponse
+// @Security BearerAuth
+// @Router /me/sound-effects [post]
+func (h *SoundEffectHandler) CreateSoundEffect(c *gin.Context) {
+	userID, ok := middleware.GetUserID(c)
+	if !ok {
+		Error(c, apperror.ErrUnauthorized)
+		return
+	}
+
+	var req request.CreateSoundEffectRequest
+	if err := c.ShouldBindJSON(&req); err != nil {
+		Error(c, apperror.ErrValidation.WithMessage(formatValidationError(err)))
+		return
+	}
+
+	result, err := h.soundEffectService.CreateSoundEffect(c.Request.Context(), userID, req)
+	if err != nil {
+		Error(c, err)
+		return
+	}
+
+	c.JSON(http.StatusCreated, result)
+}
+
+// GetMySoundEffect godoc
+// @Summary 自分の効果音詳細取得
+// @Description 認証ユーザーが所有する指定された効果音の詳細を取得します
+// @Tags me
+// @Accept json
+// @Produce json
+// @Param soundEffectId path string true "効果音 ID"
+// @Success 200 {object} response.SoundEffectDataResponse
+// @Failure 400 {object} response.ErrorResponse
+// @Failure 401 {object} response.ErrorResponse
+// @Failure 404 {object} response.ErrorResponse
+// @Failure 500 {object} response.ErrorResponse
+// @Security BearerAuth
+// @Router /me/sound-effects/{soundEffectId} [get]
+func (h *SoundEffectHandler) GetMySoundEffect(c *gin.Context) {
+	userID, ok := middleware.GetUserID(c)
+	if !ok {
+		Error(c, apperror.ErrUnauthorized)
+		return
+	}
+
+	soundEffectID := c.Param("soundEffectId")
+
+	result, err := h.soundEffectService.GetMySoundEffect(c.Request.Context(), userID, soundEffectID)
+	if err != nil {
+		Error(c, err)
+		return
+	}
+
+	c.JSON(http.StatusOK, result)
+}
+
+// UpdateMySoundEffect godoc
+// @Summary 自分の効果音更新
+// @Description 認証ユーザーが所有する指定された効果音を更新します
+// @Tags me
+// @Accept json
+// @Produce json
+// @Param soundEffectId path string true "効果音 ID"
+// @Param request body request.UpdateSoundEffectRequest true "効果音更新リクエスト"
+// @Success 200 {object} response.SoundEffectDataResponse
+// @Failure 400 {object} response.ErrorResponse
+// @Failure 401 {object} response.ErrorResponse
+// @Failure 404 {object} response.ErrorResponse
+// @Failure 409 {object} response.ErrorResponse "同じ名前の効果音が既に存在する場合"
+// @Failure 500 {object} response.ErrorResponse
+// @Security BearerAuth
+// @Router /me/sound-effects/{soundEffectId} [patch]
+func (h *SoundEffectHandler) UpdateMySoundEffect(c *gin.Context) {
+	userID, ok := middleware.GetUserID(c)
+	if !ok {
+		Error(c, apperror.ErrUnauthorized)
+		return
+	}
+
+	soundEffectID := c.Param("soundEffectId")
+
+	var req request.UpdateSoundEffectRequest
+	if err := c.ShouldBindJSON(&req); err != nil {
+		Error(c, apperror.ErrValidation.WithMessage(formatValidationError(err)))
+		return
+	}
+
+	result, err := h.soundEffectService.UpdateMySoundEffect(c.Request.Context(), userID, soundEffectID, req)
+	if err != nil {
+		Error(c, err)
+		return
+	}
+
+	c.JSON(http.StatusOK, result)
+}
+
+// DeleteMySoundEffect godoc
+// @Summary 自分の効果音削除
+// @Description 認証ユーザーが所有する指定された効果音を削除します。台本の効果音キューで使用中の場合は削除できません。
+// @Tags me
+// @Accept json
+// @Produce json
+// @Param soundEffectId path string true "効果音 ID"
+// @Success 204 "No Content"
+// @Failure 400 {object} response.ErrorResponse
+// @Failure 401 {object} response.ErrorResponse
+// @Failure 404 {object} response.ErrorResponse
+// @Failure 409 {object} response.ErrorResponse "効果音が効果音キューで使用中の場合"
+// @Failure 500 {object} response.ErrorResponse
+// @Security BearerAuth
+// @Router /me/sound-effects/{soundEffectId} [delete]
+func (h *SoundEffectHandler) DeleteMySoundEffect(c *gin.Context) {
+	userID, ok := middleware.GetUserID(c)
+	if !ok {
+		Error(c, apperror.ErrUnauthorized)
+		return
+	}
+
+	soundEffectID := c.Param("soundEffectId")
+
+	if err := h.soundEffectService.DeleteMySoundEffect(c.Request.Context(), userID, soundEffectID); err != nil {
+		Error(c, err)
+		return
+	}
+
+	c.Status(http.StatusNoContent)
+}
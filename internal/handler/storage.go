@@ -0,0 +1,72 @@
+package handler
+
+import (
+	"net/http"
+	"path"
+	"strings"
+
+	"github.com/gin-gonic/gin"
+
+	"github.com/siropaca/anycast-backend/internal/apperror"
+	"github.com/siropaca/anycast-backend/internal/infrastructure/storage"
+	"github.com/siropaca/anycast-backend/internal/pkg/signedurl"
+)
+
+// ローカルストレージのファイル配信のハンドラー
+type StorageHandler struct {
+	storageClient storage.LocalClient
+}
+
+// StorageHandler を作成する
+func NewStorageHandler(sc storage.LocalClient) *StorageHandler {
+	return &StorageHandler{storageClient: sc}
+}
+
+// ServeFile godoc
+// @Summary ローカルストレージのファイル配信
+// @Description ローカルストレージ（STORAGE_BACKEND=local）の署名付き URL のファイルを配信します。Range リクエストに対応しています。
+// @Tags storage
+// @Produce octet-stream
+// @Param path path string true "ファイルのパス（例: audios/{audioId}.mp3）"
+// @Param expires query int true "有効期限（Unix 秒）"
+// @Param signature query string true "署名"
+// @Success 200 {file} binary
+// @Success 206 {file} binary
+// @Failure 400 {object} response.ErrorResponse
+// @Failure 403 {object} response.ErrorResponse
+// @Failure 404 {object} response.ErrorResponse
+// @Router /storage/{path} [get]
+func (h *StorageHandler) ServeFile(c *gin.Context) {
+	filePath := strings.TrimPrefix(c.Param("path"), "/")
+	if filePath == "" {
+		Error(c, apperror.ErrValidation.WithMessage("path は必須です"))
+		return
+	}
+
+	f, err := h.storageClient.OpenSigned(
+		c.Request.Context(),
+		filePath,
+		c.Query(signedurl.QueryExpires),
+		c.Query(signedurl.QuerySignature),
+	)
+	if err != nil {
+		Error(c, err)
+		return
+	}
+	defer f.Close()
+
+	info, err := f.Stat()
+	if err != nil {
+		Error(c, apperror.ErrInternal.WithMessage("ファイルの読み込みに失敗しました").WithError(err))
+		return
+	}
+
+	if contentType := storage.ContentTypeFromPath(filePath); contentType != "" {
+		c.Header("Content-Type", contentType)
+	}
+	// 署名付き URL ごとに有効期限があるため、共有キャッシュには保存させない
+	c.Header("Cache-Control", "private, max-age=3600")
+
+	// Range / If-Modified-Since などの条件付きリクエストは ServeContent が処理する
+	http.ServeContent(c.Writer, c.Request, path.Base(filePath), info.ModTime(), f)
+}
@@ -0,0 +1,99 @@
+package handler
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"net/url"
+	"testing"
+	"time"
+
+	"github.com/gin-gonic/gin"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/siropaca/anycast-backend/internal/infrastructure/storage"
+)
+
+func setupStorageRouter(h *StorageHandler) *gin.Engine {
+	gin.SetMode(gin.TestMode)
+	r := gin.New()
+	r.GET("/api/v1/storage/*path", h.ServeFile)
+	return r
+}
+
+// newTestLocalStorage はファイルを 1 つ保存したローカルストレージと、その署名付き URL のパス + クエリを返す
+func newTestLocalStorage(t *testing.T, path string, data []byte, expiration time.Duration) (storage.LocalClient, string) {
+	t.Helper()
+
+	client, err := storage.NewLocalClient(t.TempDir(), "http://localhost:8081/api/v1", "secret")
+	require.NoError(t, err)
+
+	_, err = client.Upload(context.Background(), data, path, "audio/mpeg")
+	require.NoError(t, err)
+
+	signedURL, err := client.GenerateSignedURL(context.Background(), path, expiration)
+	require.NoError(t, err)
+
+	u, err := url.Parse(signedURL)
+	require.NoError(t, err)
+
+	return client, u.RequestURI()
+}
+
+func TestStorageHandler_ServeFile(t *testing.T) {
+	t.Run("署名付き URL のファイルを配信する", func(t *testing.T) {
+		client, target := newTestLocalStorage(t, "audios/test.mp3", []byte("0123456789"), time.Hour)
+		router := setupStorageRouter(NewStorageHandler(client))
+
+		w := httptest.NewRecorder()
+		req := httptest.NewRequest("GET", target, http.NoBody)
+		router.ServeHTTP(w, req)
+
+		assert.Equal(t, http.StatusOK, w.Code)
+		assert.Equal(t, "audio/mpeg", w.Header().Get("Content-Type"))
+		assert.Equal(t, "bytes", w.Header().Get("Accept-Ranges"))
+		assert.Equal(t, "0123456789", w.Body.String())
+	})
+
+	t.Run("Range リクエストに部分レスポンスを返す", func(t *testing.T) {
+		client, target := newTestLocalStorage(t, "audios/test.mp3", []byte("0123456789"), time.Hour)
+		router := setupStorageRouter(NewStorageHandler(client))
+
+		w := httptest.NewRecorder()
+		req := httptest.NewRequest("GET", target, http.NoBody)
+		req.Header.Set("Range", "bytes=2-5")
+		router.ServeHTTP(w, req)
+
+		assert.Equal(t, http.StatusPartialContent, w.Code)
+		assert.Equal(t, "bytes 2-5/10", w.Header().Get("Content-Range"))
+		assert.Equal(t, "2345", w.Body.String())
+	})
+
+	t.Run("署名がない場合は 403 を返す", func(t *testing.T) {
+		client, _ := newTestLocalStorage(t, "audios/test.mp3", []byte("0123456789"), time.Hour)
+		router := setupStorageRouter(NewStorageHandler(client))
+
+		w := httptest.NewRecorder()
+		req := httptest.NewRequest("GET", "/api/v1/storage/audios/test.mp3", http.NoBody)
+		router.ServeHTTP(w, req)
+
+		assert.Equal(t, http.StatusForbidden, w.Code)
+
+		var resp map[string]any
+		require.NoError(t, json.Unmarshal(w.Body.Bytes(), &resp))
+		assert.Equal(t, "FORBIDDEN", resp["error"].(map[string]any)["code"])
+	})
+
+	t.Run("有効期限が切れている場合は 403 を返す", func(t *testing.T) {
+		client, target := newTestLocalStorage(t, "audios/test.mp3", []byte("0123456789"), -time.Minute)
+		router := setupStorageRouter(NewStorageHandler(client))
+
+		w := httptest.NewRecorder()
+		req := httptest.NewRequest("GET", target, http.NoBody)
+		router.ServeHTTP(w, req)
+
+		assert.Equal(t, http.StatusForbidden, w.Code)
+	})
+}
@@ -180,6 +180,49 @@ func (h *VoiceHandler) RemoveFavorite(c *gin.Context) {
 	c.Status(http.StatusNoContent)
 }
 
+// AuditionVoice godoc
+// @Summary ボイス試聴
+// @Description 任意の短いテキストを指定したボイス・感情で合成し、MP3 の署名付き URL を返します。同じ内容の音声はキャッシュを返します。ユーザーごとにリクエスト数の上限があります
+// @Tags voices
+// @Accept json
+// @Produce json
+// @Param voiceId path string true "ボイス ID（UUID 形式）"
+// @Param request body request.AuditionVoiceRequest true "ボイス試聴リクエスト"
+// @Success 200 {object} response.VoiceAuditionDataResponse
+// @Failure 400 {object} response.ErrorResponse
+// @Failure 401 {object} response.ErrorResponse
+// @Failure 404 {object} response.ErrorResponse
+// @Failure 429 {object} response.ErrorResponse
+// @Failure 500 {object} response.ErrorResponse
+// @Security BearerAuth
+// @Router /voices/{voiceId}/audition [post]
+func (h *VoiceHandler) AuditionVoice(c *gin.Context) {
+	if _, ok := middleware.GetUserID(c); !ok {
+		Error(c, apperror.ErrUnauthorized)
+		return
+	}
+
+	voiceID := c.Param("voiceId")
+	if err := uuid.Validate(voiceID); err != nil {
+		Error(c, err)
+		return
+	}
+
+	var req request.AuditionVoiceRequest
+	if err := c.ShouldBindJSON(&req); err != nil {
+		Error(c, apperror.ErrValidation.WithMessage(formatValidationError(err)))
+		return
+	}
+
+	result, err := h.voiceService.Audition(c.Request.Context(), voiceID, req)
+	if err != nil {
+		Error(c, err)
+		return
+	}
+
+	c.JSON(http.StatusOK, result)
+}
+
 // Voice モデルのスライスをレスポンス DTO のスライスに変換する（お気に入り情報付き）
 func toVoiceResponsesWithFavorites(voices []model.Voice, favIDs []uuid.UUID) []response.VoiceResponse {
 	result := make([]response.VoiceResponse, len(voices))
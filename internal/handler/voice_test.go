@@ -6,6 +6,7 @@ import (
 	"errors"
 	"net/http"
 	"net/http/httptest"
+	"strings"
 	"testing"
 
 	"github.com/gin-gonic/gin"
@@ -13,6 +14,8 @@ import (
 	"github.com/stretchr/testify/mock"
 
 	"github.com/siropaca/anycast-backend/internal/apperror"
+	"github.com/siropaca/anycast-backend/internal/dto/request"
+	"github.com/siropaca/anycast-backend/internal/dto/response"
 	"github.com/siropaca/anycast-backend/internal/model"
 	"github.com/siropaca/anycast-backend/internal/pkg/uuid"
 	"github.com/siropaca/anycast-backend/internal/repository"
@@ -58,6 +61,14 @@ func (m *mockVoiceService) RemoveFavorite(ctx context.Context, userID, voiceID s
 	return args.Error(0)
 }
 
+func (m *mockVoiceService) Audition(ctx context.Context, voiceID string, req request.AuditionVoiceRequest) (*response.VoiceAuditionDataResponse, error) {
+	args := m.Called(ctx, voiceID, req)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).(*response.VoiceAuditionDataResponse), args.Error(1)
+}
+
 // setupVoiceRouter はテスト用のルーターを作成する（ユーザー ID をコンテキストに設定するミドルウェア付き）
 func setupVoiceRouter(h *VoiceHandler) *gin.Engine {
 	gin.SetMode(gin.TestMode)
@@ -68,6 +79,7 @@ func setupVoiceRouter(h *VoiceHandler) *gin.Engine {
 	})
 	r.GET("/voices", h.ListVoices)
 	r.GET("/voices/:voiceId", h.GetVoice)
+	r.POST("/voices/:voiceId/audition", h.AuditionVoice)
 	return r
 }
 
@@ -291,3 +303,58 @@ func TestToVoiceResponsesWithFavorites(t *testing.T) {
 		assert.Len(t, resp, len(voices))
 	})
 }
+
+func TestVoiceHandler_AuditionVoice(t *testing.T) {
+	t.Run("試聴音声の URL を返す", func(t *testing.T) {
+		mockSvc := new(mockVoiceService)
+		id := uuid.New()
+		req := request.AuditionVoiceRequest{Text: "こんにちは"}
+		result := &response.VoiceAuditionDataResponse{
+			Data: response.VoiceAuditionResponse{URL: "https://example.com/audition.mp3", MimeType: "audio/mpeg"},
+		}
+		mockSvc.On("Audition", mock.Anything, id.String(), req).Return(result, nil)
+
+		handler := NewVoiceHandler(mockSvc)
+		router := setupVoiceRouter(handler)
+
+		w := httptest.NewRecorder()
+		httpReq := httptest.NewRequest("POST", "/voices/"+id.String()+"/audition", strings.NewReader(`{"text":"こんにちは"}`))
+		httpReq.Header.Set("Content-Type", "application/json")
+		router.ServeHTTP(w, httpReq)
+
+		assert.Equal(t, http.StatusOK, w.Code)
+		assert.Contains(t, w.Body.String(), "https://example.com/audition.mp3")
+		mockSvc.AssertExpectations(t)
+	})
+
+	t.Run("テキストが長すぎる場合は 400 を返す", func(t *testing.T) {
+		mockSvc := new(mockVoiceService)
+		id := uuid.New()
+
+		handler := NewVoiceHandler(mockSvc)
+		router := setupVoiceRouter(handler)
+
+		w := httptest.NewRecorder()
+		body := `{"text":"` + strings.Repeat("あ", 101) + `"}`
+		httpReq := httptest.NewRequest("POST", "/voices/"+id.String()+"/audition", strings.NewReader(body))
+		httpReq.Header.Set("Content-Type", "application/json")
+		router.ServeHTTP(w, httpReq)
+
+		assert.Equal(t, http.StatusBadRequest, w.Code)
+		mockSvc.AssertNotCalled(t, "Audition", mock.Anything, mock.Anything, mock.Anything)
+	})
+
+	t.Run("無効な UUID の場合は 400 を返す", func(t *testing.T) {
+		mockSvc := new(mockVoiceService)
+
+		handler := NewVoiceHandler(mockSvc)
+		router := setupVoiceRouter(handler)
+
+		w := httptest.NewRecorder()
+		httpReq := httptest.NewRequest("POST", "/voices/invalid/audition", strings.NewReader(`{"text":"こんにちは"}`))
+		httpReq.Header.Set("Content-Type", "application/json")
+		router.ServeHTTP(w, httpReq)
+
+		assert.Equal(t, http.StatusBadRequest, w.Code)
+	})
+}
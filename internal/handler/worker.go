@@ -14,13 +14,17 @@ import (
 type WorkerHandler struct {
 	audioJobService  service.AudioJobService
 	scriptJobService service.ScriptJobService
+	importJobService service.ImportJobService
+	clipJobService   service.ClipJobService
 }
 
 // NewWorkerHandler は WorkerHandler を作成する
-func NewWorkerHandler(ajs service.AudioJobService, sjs service.ScriptJobService) *WorkerHandler {
+func NewWorkerHandler(ajs service.AudioJobService, sjs service.ScriptJobService, ijs service.ImportJobService, cjs service.ClipJobService) *WorkerHandler {
 	return &WorkerHandler{
 		audioJobService:  ajs,
 		scriptJobService: sjs,
+		importJobService: ijs,
+		clipJobService:   cjs,
 	}
 }
 
@@ -34,6 +38,16 @@ type ScriptJobPayload struct {
 	JobID string `json:"jobId" binding:"required"`
 }
 
+// ImportJobPayload はポッドキャスト取り込みワーカーに送信されるペイロード
+type ImportJobPayload struct {
+	JobID string `json:"jobId" binding:"required"`
+}
+
+// ClipJobPayload はクリップ動画生成ワーカーに送信されるペイロード
+type ClipJobPayload struct {
+	JobID string `json:"jobId" binding:"required"`
+}
+
 // ProcessAudioJob godoc
 // @Summary 音声生成ジョブを処理
 // @Description Cloud Tasks から呼び出される音声生成ワーカーエンドポイント
@@ -129,3 +143,99 @@ func (h *WorkerHandler) ProcessScriptJob(c *gin.Context) {
 		"job_id": payload.JobID,
 	})
 }
+
+// ProcessImportJob godoc
+// @Summary ポッドキャスト取り込みジョブを処理
+// @Description Cloud Tasks から呼び出されるポッドキャスト取り込みワーカーエンドポイント
+// @Tags internal
+// @Accept json
+// @Produce json
+// @Param payload body ImportJobPayload true "ジョブ情報"
+// @Success 200 {object} map[string]string
+// @Failure 400 {object} response.ErrorResponse
+// @Failure 401 {object} response.ErrorResponse
+// @Failure 500 {object} response.ErrorResponse
+// @Router /internal/worker/import [post]
+func (h *WorkerHandler) ProcessImportJob(c *gin.Context) {
+	log := logger.FromContext(c.Request.Context())
+
+	var payload ImportJobPayload
+	if err := c.ShouldBindJSON(&payload); err != nil {
+		log.Error("invalid payload", "error", err)
+		Error(c, apperror.ErrValidation.WithMessage("jobId は必須です"))
+		return
+	}
+
+	log.Info("processing import job", "job_id", payload.JobID)
+
+	if err := h.importJobService.ExecuteJob(c.Request.Context(), payload.JobID); err != nil {
+		log.Error("failed to execute import job", "error", err, "job_id", payload.JobID)
+		// Cloud Tasks はエラーレスポンスを受け取るとリトライするため、
+		// ビジネスエラーでも 200 を返す（ジョブ自体は失敗状態で記録される）
+		// 500 を返すのはリトライ可能なエラーのみ
+		if apperror.IsRetryable(err) {
+			Error(c, err)
+			return
+		}
+		// 非リトライエラーは 200 で返す（ジョブは失敗状態）
+		c.JSON(http.StatusOK, gin.H{
+			"status":  "failed",
+			"job_id":  payload.JobID,
+			"message": "job failed but should not retry",
+		})
+		return
+	}
+
+	c.JSON(http.StatusOK, gin.H{
+		"status": "completed",
+		"job_id": payload.JobID,
+	})
+}
+
+// ProcessClipJob godoc
+// @Summary クリップ動画生成ジョブを処理
+// @Description Cloud Tasks から呼び出されるクリップ動画生成ワーカーエンドポイント
+// @Tags internal
+// @Accept json
+// @Produce json
+// @Param payload body ClipJobPayload true "ジョブ情報"
+// @Success 200 {object} map[string]string
+// @Failure 400 {object} response.ErrorResponse
+// @Failure 401 {object} response.ErrorResponse
+// @Failure 500 {object} response.ErrorResponse
+// @Router /internal/worker/clip [post]
+func (h *WorkerHandler) ProcessClipJob(c *gin.Context) {
+	log := logger.FromContext(c.Request.Context())
+
+	var payload ClipJobPayload
+	if err := c.ShouldBindJSON(&payload); err != nil {
+		log.Error("invalid payload", "error", err)
+		Error(c, apperror.ErrValidation.WithMessage("jobId は必須です"))
+		return
+	}
+
+	log.Info("processing clip job", "job_id", payload.JobID)
+
+	if err := h.clipJobService.ExecuteJob(c.Request.Context(), payload.JobID); err != nil {
+		log.Error("failed to execute clip job", "error", err, "job_id", payload.JobID)
+		// Cloud Tasks はエラーレスポンスを受け取るとリトライするため、
+		// ビジネスエラーでも 200 を返す（ジョブ自体は失敗状態で記録される）
+		// 500 を返すのはリトライ可能なエラーのみ
+		if apperror.IsRetryable(err) {
+			Error(c, err)
+			return
+		}
+		// 非リトライエラーは 200 で返す（ジョブは失敗状態）
+		c.JSON(http.StatusOK, gin.H{
+			"status":  "failed",
+			"job_id":  payload.JobID,
+			"message": "job failed but should not retry",
+		})
+		return
+	}
+
+	c.JSON(http.StatusOK, gin.H{
+		"status": "completed",
+		"job_id": payload.JobID,
+	})
+}
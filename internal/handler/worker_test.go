@@ -89,7 +89,7 @@ func TestWorkerHandler_ProcessAudioJob(t *testing.T) {
 		mockSvc := new(mockAudioJobService)
 		mockSvc.On("ExecuteJob", mock.Anything, jobID).Return(nil)
 
-		handler := NewWorkerHandler(mockSvc, new(mockScriptJobService))
+		handler := NewWorkerHandler(mockSvc, new(mockScriptJobService), new(mockImportJobService), new(mockClipJobService))
 		router := setupWorkerRouter(handler)
 
 		payload := AudioJobPayload{JobID: jobID}
@@ -112,7 +112,7 @@ func TestWorkerHandler_ProcessAudioJob(t *testing.T) {
 
 	t.Run("jobId が指定されていない場合は 400 を返す", func(t *testing.T) {
 		mockSvc := new(mockAudioJobService)
-		handler := NewWorkerHandler(mockSvc, new(mockScriptJobService))
+		handler := NewWorkerHandler(mockSvc, new(mockScriptJobService), new(mockImportJobService), new(mockClipJobService))
 		router := setupWorkerRouter(handler)
 
 		payload := map[string]string{}
@@ -132,7 +132,7 @@ func TestWorkerHandler_ProcessAudioJob(t *testing.T) {
 		retryableErr := apperror.ErrInternal.WithMessage("temporary error")
 		mockSvc.On("ExecuteJob", mock.Anything, jobID).Return(retryableErr)
 
-		handler := NewWorkerHandler(mockSvc, new(mockScriptJobService))
+		handler := NewWorkerHandler(mockSvc, new(mockScriptJobService), new(mockImportJobService), new(mockClipJobService))
 		router := setupWorkerRouter(handler)
 
 		payload := AudioJobPayload{JobID: jobID}
@@ -153,7 +153,7 @@ func TestWorkerHandler_ProcessAudioJob(t *testing.T) {
 		nonRetryableErr := apperror.ErrValidation.WithMessage("validation error")
 		mockSvc.On("ExecuteJob", mock.Anything, jobID).Return(nonRetryableErr)
 
-		handler := NewWorkerHandler(mockSvc, new(mockScriptJobService))
+		handler := NewWorkerHandler(mockSvc, new(mockScriptJobService), new(mockImportJobService), new(mockClipJobService))
 		router := setupWorkerRouter(handler)
 
 		payload := AudioJobPayload{JobID: jobID}
@@ -178,7 +178,107 @@ func TestWorkerHandler_ProcessAudioJob(t *testing.T) {
 func TestNewWorkerHandler(t *testing.T) {
 	t.Run("WorkerHandler を作成できる", func(t *testing.T) {
 		mockSvc := new(mockAudioJobService)
-		handler := NewWorkerHandler(mockSvc, new(mockScriptJobService))
+		handler := NewWorkerHandler(mockSvc, new(mockScriptJobService), new(mockImportJobService), new(mockClipJobService))
 		assert.NotNil(t, handler)
 	})
 }
+
+func TestWorkerHandler_ProcessImportJob(t *testing.T) {
+	jobID := uuid.New().String()
+
+	setupRouter := func(h *WorkerHandler) *gin.Engine {
+		gin.SetMode(gin.TestMode)
+		r := gin.New()
+		r.POST("/internal/worker/import", h.ProcessImportJob)
+		return r
+	}
+
+	t.Run("ジョブを正常に処理できる", func(t *testing.T) {
+		mockSvc := new(mockImportJobService)
+		mockSvc.On("ExecuteJob", mock.Anything, jobID).Return(nil)
+
+		router := setupRouter(NewWorkerHandler(new(mockAudioJobService), new(mockScriptJobService), mockSvc, new(mockClipJobService)))
+
+		body, _ := json.Marshal(ImportJobPayload{JobID: jobID})
+		w := httptest.NewRecorder()
+		req := httptest.NewRequest("POST", "/internal/worker/import", bytes.NewReader(body))
+		req.Header.Set("Content-Type", "application/json")
+		router.ServeHTTP(w, req)
+
+		assert.Equal(t, http.StatusOK, w.Code)
+		mockSvc.AssertExpectations(t)
+	})
+
+	t.Run("フィードの取得失敗などの非リトライエラーは 200 で失敗ステータスを返す", func(t *testing.T) {
+		mockSvc := new(mockImportJobService)
+		mockSvc.On("ExecuteJob", mock.Anything, jobID).Return(apperror.ErrValidation.WithMessage("フィードを取得できませんでした"))
+
+		router := setupRouter(NewWorkerHandler(new(mockAudioJobService), new(mockScriptJobService), mockSvc, new(mockClipJobService)))
+
+		body, _ := json.Marshal(ImportJobPayload{JobID: jobID})
+		w := httptest.NewRecorder()
+		req := httptest.NewRequest("POST", "/internal/worker/import", bytes.NewReader(body))
+		req.Header.Set("Content-Type", "application/json")
+		router.ServeHTTP(w, req)
+
+		assert.Equal(t, http.StatusOK, w.Code)
+
+		var resp map[string]string
+		err := json.Unmarshal(w.Body.Bytes(), &resp)
+		assert.NoError(t, err)
+		assert.Equal(t, "failed", resp["status"])
+	})
+}
+
+func TestWorkerHandler_ProcessClipJob(t *testing.T) {
+	jobID := uuid.New().String()
+
+	setupRouter := func(h *WorkerHandler) *gin.Engine {
+		gin.SetMode(gin.TestMode)
+		r := gin.New()
+		r.POST("/internal/worker/clip", h.ProcessClipJob)
+		return r
+	}
+
+	t.Run("ジョブを正常に処理できる", func(t *testing.T) {
+		mockSvc := new(mockClipJobService)
+		mockSvc.On("ExecuteJob", mock.Anything, jobID).Return(nil)
+
+		router := setupRouter(NewWorkerHandler(new(mockAudioJobService), new(mockScriptJobService), new(mockImportJobService), mockSvc))
+
+		body, _ := json.Marshal(ClipJobPayload{JobID: jobID})
+		w := httptest.NewRecorder()
+		req := httptest.NewRequest("POST", "/internal/worker/clip", bytes.NewReader(body))
+		req.Header.Set("Content-Type", "application/json")
+		router.ServeHTTP(w, req)
+
+		assert.Equal(t, http.StatusOK, w.Code)
+		mockSvc.AssertExpectations(t)
+	})
+
+	t.Run("リトライ可能なエラーは 500 を返す", func(t *testing.T) {
+		mockSvc := new(mockClipJobService)
+		mockSvc.On("ExecuteJob", mock.Anything, jobID).Return(apperror.ErrInternal.WithMessage("動画のアップロードに失敗しました"))
+
+		router := setupRouter(NewWorkerHandler(new(mockAudioJobService), new(mockScriptJobService), new(mockImportJobService), mockSvc))
+
+		body, _ := json.Marshal(ClipJobPayload{JobID: jobID})
+		w := httptest.NewRecorder()
+		req := httptest.NewRequest("POST", "/internal/worker/clip", bytes.NewReader(body))
+		req.Header.Set("Content-Type", "application/json")
+		router.ServeHTTP(w, req)
+
+		assert.Equal(t, http.StatusInternalServerError, w.Code)
+	})
+
+	t.Run("jobId がない場合は 400 を返す", func(t *testing.T) {
+		router := setupRouter(NewWorkerHandler(new(mockAudioJobService), new(mockScriptJobService), new(mockImportJobService), new(mockClipJobService)))
+
+		w := httptest.NewRecorder()
+		req := httptest.NewRequest("POST", "/internal/worker/clip", bytes.NewReader([]byte(`{}`)))
+		req.Header.Set("Content-Type", "application/json")
+		router.ServeHTTP(w, req)
+
+		assert.Equal(t, http.StatusBadRequest, w.Code)
+	})
+}
@@ -17,6 +17,8 @@ import (
 type Client interface {
 	EnqueueAudioJob(ctx context.Context, jobID string) error
 	EnqueueScriptJob(ctx context.Context, jobID string) error
+	EnqueueImportJob(ctx context.Context, jobID string) error
+	EnqueueClipJob(ctx context.Context, jobID string) error
 	Close() error
 }
 
@@ -70,6 +72,16 @@ func (c *client) EnqueueScriptJob(ctx context.Context, jobID string) error {
 	return c.enqueueJob(ctx, jobID, "/script", "script")
 }
 
+// EnqueueImportJob はポッドキャスト取り込みジョブをキューに追加する
+func (c *client) EnqueueImportJob(ctx context.Context, jobID string) error {
+	return c.enqueueJob(ctx, jobID, "/import", "import")
+}
+
+// EnqueueClipJob はクリップ動画生成ジョブをキューに追加する
+func (c *client) EnqueueClipJob(ctx context.Context, jobID string) error {
+	return c.enqueueJob(ctx, jobID, "/clip", "clip")
+}
+
 // enqueueJob はジョブをキューに追加する共通処理
 func (c *client) enqueueJob(ctx context.Context, jobID, pathSuffix, jobType string) error {
 	log := logger.FromContext(ctx)
@@ -1,6 +1,7 @@
 package storage
 
 import (
+	"bytes"
 	"context"
 	"fmt"
 	"io"
@@ -21,15 +22,24 @@ func IsExternalURL(path string) bool {
 
 // 署名付き URL の有効期限
 const (
-	SignedURLExpirationAudio = 1 * time.Hour
-	SignedURLExpirationImage = 1 * time.Hour
+	SignedURLExpirationAudio   = 1 * time.Hour
+	SignedURLExpirationImage   = 1 * time.Hour
+	SignedURLExpirationVideo   = 1 * time.Hour
+	SignedURLExpirationPackage = 1 * time.Hour
 )
 
 // Client はストレージクライアントのインターフェース
 type Client interface {
 	Upload(ctx context.Context, data []byte, path, contentType string) (string, error)
+	// UploadStream は r の内容を最後まで読み込みながらアップロードする（ファイル全体をメモリに保持しない）
+	UploadStream(ctx context.Context, r io.Reader, path, contentType string) (string, error)
+	// DownloadStream はファイルの内容を w に書き込み、書き込んだバイト数を返す
+	DownloadStream(ctx context.Context, path string, w io.Writer) (int64, error)
+	// OpenRange はファイルの offset バイト目から length バイトを読み込むリーダーを返す（length が負の場合は末尾まで）
+	OpenRange(ctx context.Context, path string, offset, length int64) (io.ReadCloser, error)
 	GenerateSignedURL(ctx context.Context, path string, expiration time.Duration) (string, error)
 	Delete(ctx context.Context, path string) error
+	Exists(ctx context.Context, path string) (bool, error)
 	Close() error
 }
 
@@ -44,12 +54,44 @@ func GenerateAudioPathWithExt(audioID, ext string) string {
 	return fmt.Sprintf("audios/%s%s", audioID, ext)
 }
 
+// GenerateAudioRevisionPath は既存の音声ファイルを書き換えたリビジョンの GCS パスを生成する
+// 同じパスに上書きすると配信中のキャッシュと内容が食い違うため、書き換えのたびに別のパスに保存する
+// ext は拡張子（例: ".mp3"）
+func GenerateAudioRevisionPath(audioID, revision, ext string) string {
+	return fmt.Sprintf("audios/%s-%s%s", audioID, revision, ext)
+}
+
+// GenerateHLSPathPrefix は HLS パッケージ（プレイリスト・セグメント）を保存する GCS のディレクトリパスを生成する
+func GenerateHLSPathPrefix(packageID string) string {
+	return fmt.Sprintf("hls/%s", packageID)
+}
+
+// GenerateTTSPreviewPath は TTS プレビュー音声の GCS パスを生成する（内容のハッシュをキーとする）
+func GenerateTTSPreviewPath(contentHash string) string {
+	return fmt.Sprintf("tts-previews/%s.mp3", contentHash)
+}
+
 // GenerateImagePath は画像ファイルの GCS パスを生成する
 // ext は拡張子（例: ".png", ".jpg"）
 func GenerateImagePath(imageID, ext string) string {
 	return fmt.Sprintf("images/%s%s", imageID, ext)
 }
 
+// GenerateVideoPath は動画ファイル（クリップ動画、常に MP4）の GCS パスを生成する
+func GenerateVideoPath(videoID string) string {
+	return fmt.Sprintf("videos/%s.mp4", videoID)
+}
+
+// GenerateEpisodePackagePath はエピソードのダウンロードパッケージ（ZIP）の GCS パスを生成する（内容のハッシュをキーとする）
+func GenerateEpisodePackagePath(episodeID, contentHash string) string {
+	return fmt.Sprintf("downloads/episodes/%s/%s.zip", episodeID, contentHash)
+}
+
+// GenerateChannelArchivePath はチャンネルのエクスポートアーカイブ（ZIP）の GCS パスを生成する（内容のハッシュをキーとする）
+func GenerateChannelArchivePath(channelID, contentHash string) string {
+	return fmt.Sprintf("downloads/channels/%s/%s.zip", channelID, contentHash)
+}
+
 type gcsClient struct {
 	client     *storage.Client
 	bucketName string
@@ -75,8 +117,15 @@ func NewGCSClient(ctx context.Context, bucketName, credentialsJSON string) (Clie
 
 // Upload はファイルをアップロードする
 func (c *gcsClient) Upload(ctx context.Context, data []byte, path, contentType string) (string, error) {
+	return c.UploadStream(ctx, bytes.NewReader(data), path, contentType)
+}
+
+// UploadStream は r の内容をアップロードする
+//
+// GCS のライターがチャンク単位で送信するため、ファイル全体をメモリに保持しない
+func (c *gcsClient) UploadStream(ctx context.Context, r io.Reader, path, contentType string) (string, error) {
 	log := logger.FromContext(ctx)
-	log.Debug("uploading file to GCS", "path", path, "size", len(data))
+	log.Debug("uploading file to GCS", "path", path)
 
 	bucket := c.client.Bucket(c.bucketName)
 	obj := bucket.Object(path)
@@ -84,7 +133,8 @@ func (c *gcsClient) Upload(ctx context.Context, data []byte, path, contentType s
 	writer := obj.NewWriter(ctx)
 	writer.ContentType = contentType
 
-	if _, err := writer.Write(data); err != nil {
+	size, err := io.Copy(writer, r)
+	if err != nil {
 		log.Error("failed to write to GCS", "error", err)
 		return "", apperror.ErrMediaUploadFailed.WithMessage("ファイルのアップロードに失敗しました").WithError(err)
 	}
@@ -94,7 +144,7 @@ func (c *gcsClient) Upload(ctx context.Context, data []byte, path, contentType s
 		return "", apperror.ErrMediaUploadFailed.WithMessage("ファイルのアップロードに失敗しました").WithError(err)
 	}
 
-	log.Debug("file uploaded successfully", "path", path)
+	log.Debug("file uploaded successfully", "path", path, "size", size)
 
 	return path, nil
 }
@@ -142,8 +192,24 @@ func (c *gcsClient) Delete(ctx context.Context, path string) error {
 	return nil
 }
 
-// Download はファイルをダウンロードする
-func (c *gcsClient) Download(ctx context.Context, path string) ([]byte, error) {
+// Exists はファイルが存在するかどうかを返す
+func (c *gcsClient) Exists(ctx context.Context, path string) (bool, error) {
+	bucket := c.client.Bucket(c.bucketName)
+	obj := bucket.Object(path)
+
+	if _, err := obj.Attrs(ctx); err != nil {
+		if err == storage.ErrObjectNotExist {
+			return false, nil
+		}
+		logger.FromContext(ctx).Error("failed to get GCS object attrs", "error", err, "path", path)
+		return false, apperror.ErrInternal.WithMessage("ファイルの確認に失敗しました").WithError(err)
+	}
+
+	return true, nil
+}
+
+// DownloadStream はファイルをダウンロードして w に書き込む
+func (c *gcsClient) DownloadStream(ctx context.Context, path string, w io.Writer) (int64, error) {
 	log := logger.FromContext(ctx)
 	log.Debug("downloading file from GCS", "path", path)
 
@@ -153,10 +219,10 @@ func (c *gcsClient) Download(ctx context.Context, path string) ([]byte, error) {
 	reader, err := obj.NewReader(ctx)
 	if err != nil {
 		if err == storage.ErrObjectNotExist {
-			return nil, apperror.ErrNotFound.WithMessage("ファイルが見つかりません")
+			return 0, apperror.ErrNotFound.WithMessage("ファイルが見つかりません")
 		}
 		log.Error("failed to create GCS reader", "error", err)
-		return nil, apperror.ErrInternal.WithMessage("ファイルのダウンロードに失敗しました").WithError(err)
+		return 0, apperror.ErrInternal.WithMessage("ファイルのダウンロードに失敗しました").WithError(err)
 	}
 
 	defer func() {
@@ -165,14 +231,28 @@ func (c *gcsClient) Download(ctx context.Context, path string) ([]byte, error) {
 		}
 	}()
 
-	data, err := io.ReadAll(reader)
+	size, err := io.Copy(w, reader)
 	if err != nil {
 		log.Error("failed to read from GCS", "error", err)
+		return size, apperror.ErrInternal.WithMessage("ファイルのダウンロードに失敗しました").WithError(err)
+	}
+
+	log.Debug("file downloaded successfully", "path", path, "size", size)
+	return size, nil
+}
+
+// OpenRange はファイルの指定範囲を読み込むリーダーを返す
+func (c *gcsClient) OpenRange(ctx context.Context, path string, offset, length int64) (io.ReadCloser, error) {
+	reader, err := c.client.Bucket(c.bucketName).Object(path).NewRangeReader(ctx, offset, length)
+	if err != nil {
+		if err == storage.ErrObjectNotExist {
+			return nil, apperror.ErrNotFound.WithMessage("ファイルが見つかりません")
+		}
+		logger.FromContext(ctx).Error("failed to create GCS range reader", "error", err, "path", path)
 		return nil, apperror.ErrInternal.WithMessage("ファイルのダウンロードに失敗しました").WithError(err)
 	}
 
-	log.Debug("file downloaded successfully", "path", path, "size", len(data))
-	return data, nil
+	return reader, nil
 }
 
 // Close はクライアントを閉じる
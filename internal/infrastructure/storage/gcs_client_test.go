@@ -34,3 +34,35 @@ func TestGenerateAudioPath(t *testing.T) {
 		})
 	}
 }
+
+func TestGenerateHLSPathPrefix(t *testing.T) {
+	got := GenerateHLSPathPrefix("550e8400-e29b-41d4-a716-446655440000")
+	want := "hls/550e8400-e29b-41d4-a716-446655440000"
+	if got != want {
+		t.Errorf("GenerateHLSPathPrefix() = %v, want %v", got, want)
+	}
+}
+
+func TestGenerateTTSPreviewPath(t *testing.T) {
+	got := GenerateTTSPreviewPath("abc123")
+	want := "tts-previews/abc123.mp3"
+	if got != want {
+		t.Errorf("GenerateTTSPreviewPath() = %v, want %v", got, want)
+	}
+}
+
+func TestGenerateEpisodePackagePath(t *testing.T) {
+	got := GenerateEpisodePackagePath("550e8400-e29b-41d4-a716-446655440000", "abc123")
+	want := "downloads/episodes/550e8400-e29b-41d4-a716-446655440000/abc123.zip"
+	if got != want {
+		t.Errorf("GenerateEpisodePackagePath() = %v, want %v", got, want)
+	}
+}
+
+func TestGenerateChannelArchivePath(t *testing.T) {
+	got := GenerateChannelArchivePath("550e8400-e29b-41d4-a716-446655440000", "abc123")
+	want := "downloads/channels/550e8400-e29b-41d4-a716-446655440000/abc123.zip"
+	if got != want {
+		t.Errorf("GenerateChannelArchivePath() = %v, want %v", got, want)
+	}
+}
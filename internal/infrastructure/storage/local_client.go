@@ -0,0 +1,312 @@
+package storage
+
+import (
+	"bytes"
+	"context"
+	"errors"
+	"fmt"
+	"io"
+	"mime"
+	"net/url"
+	"os"
+	"path"
+	"path/filepath"
+	"strings"
+	"time"
+
+	"github.com/siropaca/anycast-backend/internal/apperror"
+	"github.com/siropaca/anycast-backend/internal/pkg/logger"
+	"github.com/siropaca/anycast-backend/internal/pkg/signedurl"
+)
+
+// LocalFileRoutePath はローカルストレージのファイルを配信するルートのパス（API のベースパスからの相対）
+const LocalFileRoutePath = "/storage"
+
+// LocalClient はローカルファイルシステムをストレージとして使うクライアントのインターフェース
+//
+// 署名付き URL はバックエンドの配信ルートを指すため、ルートのハンドラーから OpenSigned でファイルを開く
+type LocalClient interface {
+	Client
+	// OpenSigned は署名と有効期限を検証してファイルを開く
+	OpenSigned(ctx context.Context, path, expires, signature string) (*os.File, error)
+}
+
+// localContentTypes は拡張子ごとの Content-Type（OS の MIME 設定に依存せず音声・動画・アーカイブを配信するため）
+var localContentTypes = map[string]string{
+	".mp3":  "audio/mpeg",
+	".m4a":  "audio/mp4",
+	".opus": "audio/ogg",
+	".ogg":  "audio/ogg",
+	".wav":  "audio/wav",
+	".ts":   "video/mp2t",
+	".mp4":  "video/mp4",
+	".m3u8": "application/vnd.apple.mpegurl",
+	".png":  "image/png",
+	".jpg":  "image/jpeg",
+	".jpeg": "image/jpeg",
+	".webp": "image/webp",
+	".gif":  "image/gif",
+	".zip":  "application/zip",
+}
+
+// ContentTypeFromPath はストレージのファイルの Content-Type を拡張子から判定する
+//
+// 判定できない場合は空文字を返す（配信時に内容から判定する）
+func ContentTypeFromPath(p string) string {
+	ext := strings.ToLower(path.Ext(p))
+	if ct, ok := localContentTypes[ext]; ok {
+		return ct
+	}
+	return mime.TypeByExtension(ext)
+}
+
+type localClient struct {
+	rootDir string
+	baseURL string
+	signer  *signedurl.Signer
+}
+
+// NewLocalClient はローカルファイルシステムのストレージクライアントを作成する
+//
+// ファイルは rootDir 以下に保存する。署名付き URL は baseURL（例: http://localhost:8081/api/v1）の
+// 配信ルートを指し、signingSecret で HMAC 署名する
+func NewLocalClient(rootDir, baseURL, signingSecret string) (LocalClient, error) {
+	if signingSecret == "" {
+		return nil, fmt.Errorf("signing secret is required for local storage")
+	}
+
+	absRoot, err := filepath.Abs(rootDir)
+	if err != nil {
+		return nil, fmt.Errorf("failed to resolve local storage root: %w", err)
+	}
+
+	if err := os.MkdirAll(absRoot, 0o755); err != nil {
+		return nil, fmt.Errorf("failed to create local storage root: %w", err)
+	}
+
+	return &localClient{
+		rootDir: absRoot,
+		baseURL: strings.TrimSuffix(baseURL, "/"),
+		signer:  signedurl.NewSigner(signingSecret),
+	}, nil
+}
+
+// resolve はストレージのパスを rootDir 以下のファイルパスに変換する
+//
+// rootDir の外を指すパス（".." を含むパスや絶対パス）はエラーにする
+func (c *localClient) resolve(p string) (string, error) {
+	if !filepath.IsLocal(filepath.FromSlash(p)) {
+		return "", apperror.ErrValidation.WithMessage("不正なファイルパスです")
+	}
+	return filepath.Join(c.rootDir, filepath.FromSlash(p)), nil
+}
+
+// Upload はファイルを保存する
+func (c *localClient) Upload(ctx context.Context, data []byte, path, contentType string) (string, error) {
+	return c.UploadStream(ctx, bytes.NewReader(data), path, contentType)
+}
+
+// UploadStream は r の内容をファイルに保存する
+//
+// 同じディレクトリの一時ファイルに書き込んでからリネームするため、書き込み途中のファイルは配信されない
+func (c *localClient) UploadStream(ctx context.Context, r io.Reader, path, contentType string) (string, error) {
+	log := logger.FromContext(ctx)
+	log.Debug("saving file to local storage", "path", path)
+
+	filePath, err := c.resolve(path)
+	if err != nil {
+		return "", err
+	}
+
+	if err := os.MkdirAll(filepath.Dir(filePath), 0o755); err != nil {
+		log.Error("failed to create local storage directory", "error", err)
+		return "", apperror.ErrMediaUploadFailed.WithMessage("ファイルのアップロードに失敗しました").WithError(err)
+	}
+
+	tmp, err := os.CreateTemp(filepath.Dir(filePath), ".upload-*")
+	if err != nil {
+		log.Error("failed to create temp file", "error", err)
+		return "", apperror.ErrMediaUploadFailed.WithMessage("ファイルのアップロードに失敗しました").WithError(err)
+	}
+	defer os.Remove(tmp.Name())
+
+	size, err := io.Copy(tmp, r)
+	if err != nil {
+		tmp.Close()
+		log.Error("failed to write to local storage", "error", err)
+		return "", apperror.ErrMediaUploadFailed.WithMessage("ファイルのアップロードに失敗しました").WithError(err)
+	}
+
+	if err := tmp.Close(); err != nil {
+		log.Error("failed to close temp file", "error", err)
+		return "", apperror.ErrMediaUploadFailed.WithMessage("ファイルのアップロードに失敗しました").WithError(err)
+	}
+
+	if err := os.Rename(tmp.Name(), filePath); err != nil {
+		log.Error("failed to rename temp file", "error", err)
+		return "", apperror.ErrMediaUploadFailed.WithMessage("ファイルのアップロードに失敗しました").WithError(err)
+	}
+
+	log.Debug("file saved successfully", "path", path, "size", size)
+
+	return path, nil
+}
+
+// GenerateSignedURL はバックエンドの配信ルートを指す署名付き URL を生成する
+func (c *localClient) GenerateSignedURL(ctx context.Context, p string, expiration time.Duration) (string, error) {
+	if _, err := c.resolve(p); err != nil {
+		return "", err
+	}
+
+	segments := strings.Split(p, "/")
+	for i, s := range segments {
+		segments[i] = url.PathEscape(s)
+	}
+
+	q := c.signer.Query(p, time.Now().Add(expiration))
+	return c.baseURL + LocalFileRoutePath + "/" + strings.Join(segments, "/") + "?" + q.Encode(), nil
+}
+
+// OpenSigned は署名と有効期限を検証してファイルを開く
+func (c *localClient) OpenSigned(ctx context.Context, path, expires, signature string) (*os.File, error) {
+	if err := c.signer.Verify(path, expires, signature, time.Now()); err != nil {
+		if errors.Is(err, signedurl.ErrExpired) {
+			return nil, apperror.ErrForbidden.WithMessage("URL の有効期限が切れています")
+		}
+		return nil, apperror.ErrForbidden.WithMessage("URL の署名が不正です")
+	}
+
+	filePath, err := c.resolve(path)
+	if err != nil {
+		return nil, err
+	}
+
+	f, err := os.Open(filePath)
+	if err != nil {
+		if errors.Is(err, os.ErrNotExist) {
+			return nil, apperror.ErrNotFound.WithMessage("ファイルが見つかりません")
+		}
+		logger.FromContext(ctx).Error("failed to open local file", "error", err, "path", path)
+		return nil, apperror.ErrInternal.WithMessage("ファイルの読み込みに失敗しました").WithError(err)
+	}
+
+	return f, nil
+}
+
+// Delete はファイルを削除する
+//
+// 削除後に空になったディレクトリ（HLS パッケージなど）も rootDir まで遡って削除する
+func (c *localClient) Delete(ctx context.Context, path string) error {
+	log := logger.FromContext(ctx)
+	log.Debug("deleting file from local storage", "path", path)
+
+	filePath, err := c.resolve(path)
+	if err != nil {
+		return err
+	}
+
+	if err := os.Remove(filePath); err != nil {
+		// ファイルが存在しない場合はエラーにしない
+		if errors.Is(err, os.ErrNotExist) {
+			log.Debug("skipping deletion as file does not exist", "path", path)
+			return nil
+		}
+		log.Error("failed to delete from local storage", "error", err)
+		return apperror.ErrInternal.WithMessage("ファイルの削除に失敗しました").WithError(err)
+	}
+
+	for dir := filepath.Dir(filePath); dir != c.rootDir; dir = filepath.Dir(dir) {
+		// 空でないディレクトリの削除は失敗するため、そこで止める
+		if err := os.Remove(dir); err != nil {
+			break
+		}
+	}
+
+	log.Debug("file deleted successfully", "path", path)
+	return nil
+}
+
+// Exists はファイルが存在するかどうかを返す
+func (c *localClient) Exists(ctx context.Context, path string) (bool, error) {
+	filePath, err := c.resolve(path)
+	if err != nil {
+		return false, err
+	}
+
+	if _, err := os.Stat(filePath); err != nil {
+		if errors.Is(err, os.ErrNotExist) {
+			return false, nil
+		}
+		logger.FromContext(ctx).Error("failed to stat local file", "error", err, "path", path)
+		return false, apperror.ErrInternal.WithMessage("ファイルの確認に失敗しました").WithError(err)
+	}
+
+	return true, nil
+}
+
+// DownloadStream はファイルの内容を w に書き込む
+func (c *localClient) DownloadStream(ctx context.Context, path string, w io.Writer) (int64, error) {
+	log := logger.FromContext(ctx)
+	log.Debug("reading file from local storage", "path", path)
+
+	filePath, err := c.resolve(path)
+	if err != nil {
+		return 0, err
+	}
+
+	f, err := os.Open(filePath)
+	if err != nil {
+		if errors.Is(err, os.ErrNotExist) {
+			return 0, apperror.ErrNotFound.WithMessage("ファイルが見つかりません")
+		}
+		log.Error("failed to open local file", "error", err)
+		return 0, apperror.ErrInternal.WithMessage("ファイルのダウンロードに失敗しました").WithError(err)
+	}
+	defer f.Close()
+
+	size, err := io.Copy(w, f)
+	if err != nil {
+		log.Error("failed to read from local storage", "error", err)
+		return size, apperror.ErrInternal.WithMessage("ファイルのダウンロードに失敗しました").WithError(err)
+	}
+
+	log.Debug("file read successfully", "path", path, "size", size)
+	return size, nil
+}
+
+// OpenRange はファイルの指定範囲を読み込むリーダーを返す
+func (c *localClient) OpenRange(ctx context.Context, path string, offset, length int64) (io.ReadCloser, error) {
+	filePath, err := c.resolve(path)
+	if err != nil {
+		return nil, err
+	}
+
+	f, err := os.Open(filePath)
+	if err != nil {
+		if errors.Is(err, os.ErrNotExist) {
+			return nil, apperror.ErrNotFound.WithMessage("ファイルが見つかりません")
+		}
+		logger.FromContext(ctx).Error("failed to open local file", "error", err, "path", path)
+		return nil, apperror.ErrInternal.WithMessage("ファイルのダウンロードに失敗しました").WithError(err)
+	}
+
+	if _, err := f.Seek(offset, io.SeekStart); err != nil {
+		f.Close()
+		logger.FromContext(ctx).Error("failed to seek local file", "error", err, "path", path)
+		return nil, apperror.ErrInternal.WithMessage("ファイルのダウンロードに失敗しました").WithError(err)
+	}
+
+	if length < 0 {
+		return f, nil
+	}
+
+	return struct {
+		io.Reader
+		io.Closer
+	}{io.LimitReader(f, length), f}, nil
+}
+
+// Close は何もしない（ローカルストレージは接続を持たない）
+func (c *localClient) Close() error {
+	return nil
+}
@@ -0,0 +1,211 @@
+package storage
+
+import (
+	"bytes"
+	"context"
+	"io"
+	"net/url"
+	"path/filepath"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/siropaca/anycast-backend/internal/apperror"
+	"github.com/siropaca/anycast-backend/internal/pkg/signedurl"
+)
+
+func newTestLocalClient(t *testing.T) (LocalClient, string) {
+	t.Helper()
+
+	root := t.TempDir()
+	client, err := NewLocalClient(root, "http://localhost:8081/api/v1/", "secret")
+	require.NoError(t, err)
+
+	return client, root
+}
+
+// signedQuery は署名付き URL からパスとクエリを取り出す
+func signedQuery(t *testing.T, signedURL string) (string, url.Values) {
+	t.Helper()
+
+	u, err := url.Parse(signedURL)
+	require.NoError(t, err)
+
+	return strings.TrimPrefix(u.Path, "/api/v1"+LocalFileRoutePath+"/"), u.Query()
+}
+
+func TestNewLocalClient(t *testing.T) {
+	t.Run("署名用シークレットが空の場合はエラーを返す", func(t *testing.T) {
+		_, err := NewLocalClient(t.TempDir(), "http://localhost:8081/api/v1", "")
+
+		assert.Error(t, err)
+	})
+}
+
+func TestLocalClient_UploadAndDownload(t *testing.T) {
+	ctx := context.Background()
+
+	t.Run("アップロードしたファイルをダウンロードできる", func(t *testing.T) {
+		client, root := newTestLocalClient(t)
+
+		path, err := client.UploadStream(ctx, strings.NewReader("audio"), "audios/a.mp3", "audio/mpeg")
+		require.NoError(t, err)
+		assert.Equal(t, "audios/a.mp3", path)
+		assert.FileExists(t, filepath.Join(root, "audios", "a.mp3"))
+
+		var buf bytes.Buffer
+		n, err := client.DownloadStream(ctx, "audios/a.mp3", &buf)
+		require.NoError(t, err)
+		assert.Equal(t, int64(5), n)
+		assert.Equal(t, "audio", buf.String())
+	})
+
+	t.Run("ルートの外を指すパスはエラーを返す", func(t *testing.T) {
+		client, _ := newTestLocalClient(t)
+
+		_, err := client.Upload(ctx, []byte("x"), "../outside.mp3", "audio/mpeg")
+
+		assert.Error(t, err)
+	})
+
+	t.Run("存在しないファイルのダウンロードは NotFound を返す", func(t *testing.T) {
+		client, _ := newTestLocalClient(t)
+
+		_, err := client.DownloadStream(ctx, "audios/missing.mp3", io.Discard)
+
+		assert.True(t, apperror.IsCode(err, apperror.CodeNotFound))
+	})
+}
+
+func TestLocalClient_Delete(t *testing.T) {
+	ctx := context.Background()
+
+	t.Run("ファイルと空になったディレクトリを削除する", func(t *testing.T) {
+		client, root := newTestLocalClient(t)
+
+		_, err := client.Upload(ctx, []byte("seg"), "hls/pkg/segment_00000.ts", "video/mp2t")
+		require.NoError(t, err)
+
+		require.NoError(t, client.Delete(ctx, "hls/pkg/segment_00000.ts"))
+
+		exists, err := client.Exists(ctx, "hls/pkg/segment_00000.ts")
+		require.NoError(t, err)
+		assert.False(t, exists)
+		assert.NoDirExists(t, filepath.Join(root, "hls"))
+		assert.DirExists(t, root)
+	})
+
+	t.Run("存在しないファイルの削除はエラーにしない", func(t *testing.T) {
+		client, _ := newTestLocalClient(t)
+
+		assert.NoError(t, client.Delete(ctx, "audios/missing.mp3"))
+	})
+}
+
+func TestLocalClient_OpenSigned(t *testing.T) {
+	ctx := context.Background()
+
+	t.Run("署名付き URL のファイルを開ける", func(t *testing.T) {
+		client, _ := newTestLocalClient(t)
+		_, err := client.Upload(ctx, []byte("audio"), "sfx/拍手.mp3", "audio/mpeg")
+		require.NoError(t, err)
+
+		signedURL, err := client.GenerateSignedURL(ctx, "sfx/拍手.mp3", time.Hour)
+		require.NoError(t, err)
+		assert.True(t, strings.HasPrefix(signedURL, "http://localhost:8081/api/v1/storage/sfx/"))
+
+		path, q := signedQuery(t, signedURL)
+		f, err := client.OpenSigned(ctx, path, q.Get(signedurl.QueryExpires), q.Get(signedurl.QuerySignature))
+		require.NoError(t, err)
+		defer f.Close()
+
+		data, err := io.ReadAll(f)
+		require.NoError(t, err)
+		assert.Equal(t, "audio", string(data))
+	})
+
+	t.Run("別のファイルの署名では開けない", func(t *testing.T) {
+		client, _ := newTestLocalClient(t)
+		_, err := client.Upload(ctx, []byte("audio"), "audios/b.mp3", "audio/mpeg")
+		require.NoError(t, err)
+
+		signedURL, err := client.GenerateSignedURL(ctx, "audios/a.mp3", time.Hour)
+		require.NoError(t, err)
+
+		_, q := signedQuery(t, signedURL)
+		_, err = client.OpenSigned(ctx, "audios/b.mp3", q.Get(signedurl.QueryExpires), q.Get(signedurl.QuerySignature))
+
+		assert.True(t, apperror.IsCode(err, apperror.CodeForbidden))
+	})
+
+	t.Run("有効期限が切れた URL では開けない", func(t *testing.T) {
+		client, _ := newTestLocalClient(t)
+		_, err := client.Upload(ctx, []byte("audio"), "audios/a.mp3", "audio/mpeg")
+		require.NoError(t, err)
+
+		signedURL, err := client.GenerateSignedURL(ctx, "audios/a.mp3", -time.Minute)
+		require.NoError(t, err)
+
+		path, q := signedQuery(t, signedURL)
+		_, err = client.OpenSigned(ctx, path, q.Get(signedurl.QueryExpires), q.Get(signedurl.QuerySignature))
+
+		assert.True(t, apperror.IsCode(err, apperror.CodeForbidden))
+	})
+
+	t.Run("署名が正しくてもファイルがなければ NotFound を返す", func(t *testing.T) {
+		client, _ := newTestLocalClient(t)
+
+		signedURL, err := client.GenerateSignedURL(ctx, "audios/missing.mp3", time.Hour)
+		require.NoError(t, err)
+
+		path, q := signedQuery(t, signedURL)
+		_, err = client.OpenSigned(ctx, path, q.Get(signedurl.QueryExpires), q.Get(signedurl.QuerySignature))
+
+		assert.True(t, apperror.IsCode(err, apperror.CodeNotFound))
+	})
+}
+
+func TestLocalClient_OpenRange(t *testing.T) {
+	ctx := context.Background()
+	client, _ := newTestLocalClient(t)
+	_, err := client.Upload(ctx, []byte("0123456789"), "audios/a.mp3", "audio/mpeg")
+	require.NoError(t, err)
+
+	t.Run("指定した範囲を読み込む", func(t *testing.T) {
+		r, err := client.OpenRange(ctx, "audios/a.mp3", 3, 4)
+		require.NoError(t, err)
+		defer r.Close()
+
+		data, err := io.ReadAll(r)
+		require.NoError(t, err)
+		assert.Equal(t, "3456", string(data))
+	})
+
+	t.Run("length が負の場合は末尾まで読み込む", func(t *testing.T) {
+		r, err := client.OpenRange(ctx, "audios/a.mp3", 6, -1)
+		require.NoError(t, err)
+		defer r.Close()
+
+		data, err := io.ReadAll(r)
+		require.NoError(t, err)
+		assert.Equal(t, "6789", string(data))
+	})
+
+	t.Run("存在しないファイルは NotFound を返す", func(t *testing.T) {
+		_, err := client.OpenRange(ctx, "audios/missing.mp3", 0, -1)
+
+		assert.True(t, apperror.IsCode(err, apperror.CodeNotFound))
+	})
+}
+
+func TestContentTypeFromPath(t *testing.T) {
+	assert.Equal(t, "audio/mpeg", ContentTypeFromPath("audios/a.mp3"))
+	assert.Equal(t, "audio/mp4", ContentTypeFromPath("audios/a.M4A"))
+	assert.Equal(t, "video/mp2t", ContentTypeFromPath("hls/pkg/segment_00000.ts"))
+	assert.Equal(t, "video/mp4", ContentTypeFromPath("videos/a.mp4"))
+	assert.Equal(t, "application/zip", ContentTypeFromPath("downloads/episodes/e/abc.zip"))
+	assert.Equal(t, "", ContentTypeFromPath("audios/a"))
+}
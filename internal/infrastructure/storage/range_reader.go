@@ -0,0 +1,86 @@
+package storage
+
+import (
+	"context"
+	"errors"
+	"io"
+)
+
+// RangeReadSeeker はストレージのファイルを必要な範囲だけ読み込む io.ReadSeekCloser
+//
+// http.ServeContent に渡して Range リクエストに応答するために使う。ファイルサイズは呼び出し元が
+// 把握している値（audios.file_size など）を使うため、Seek ではストレージにアクセスしない。
+// Read の時点で現在位置から末尾までのリーダーを開き、位置が変わった場合は開き直す
+type RangeReadSeeker struct {
+	ctx    context.Context
+	client Client
+	path   string
+	size   int64
+	pos    int64
+	reader io.ReadCloser
+}
+
+// NewRangeReadSeeker は path のファイルを size バイトのファイルとして読み込む RangeReadSeeker を作成する
+func NewRangeReadSeeker(ctx context.Context, client Client, path string, size int64) *RangeReadSeeker {
+	return &RangeReadSeeker{ctx: ctx, client: client, path: path, size: size}
+}
+
+// Read は現在位置からファイルを読み込む
+func (r *RangeReadSeeker) Read(p []byte) (int, error) {
+	if r.pos >= r.size {
+		return 0, io.EOF
+	}
+
+	if r.reader == nil {
+		reader, err := r.client.OpenRange(r.ctx, r.path, r.pos, -1)
+		if err != nil {
+			return 0, err
+		}
+		r.reader = reader
+	}
+
+	n, err := r.reader.Read(p)
+	r.pos += int64(n)
+	return n, err
+}
+
+// Seek は読み込み位置を変更する
+func (r *RangeReadSeeker) Seek(offset int64, whence int) (int64, error) {
+	var pos int64
+	switch whence {
+	case io.SeekStart:
+		pos = offset
+	case io.SeekCurrent:
+		pos = r.pos + offset
+	case io.SeekEnd:
+		pos = r.size + offset
+	default:
+		return 0, errors.New("storage: invalid whence")
+	}
+	if pos < 0 {
+		return 0, errors.New("storage: negative position")
+	}
+
+	if pos != r.pos {
+		if err := r.closeReader(); err != nil {
+			return 0, err
+		}
+		r.pos = pos
+	}
+
+	return pos, nil
+}
+
+// Close は開いているリーダーを閉じる
+func (r *RangeReadSeeker) Close() error {
+	return r.closeReader()
+}
+
+func (r *RangeReadSeeker) closeReader() error {
+	if r.reader == nil {
+		return nil
+	}
+	err := r.reader.Close()
+	r.reader = nil
+	return err
+}
@@ -0,0 +1,66 @@
+package storage
+
+import (
+	"context"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestRangeReadSeeker(t *testing.T) {
+	ctx := context.Background()
+	client, _ := newTestLocalClient(t)
+	_, err := client.Upload(ctx, []byte("0123456789"), "audios/a.mp3", "audio/mpeg")
+	require.NoError(t, err)
+
+	t.Run("Seek した位置から読み込む", func(t *testing.T) {
+		rs := NewRangeReadSeeker(ctx, client, "audios/a.mp3", 10)
+		defer rs.Close()
+
+		size, err := rs.Seek(0, io.SeekEnd)
+		require.NoError(t, err)
+		assert.Equal(t, int64(10), size)
+
+		_, err = rs.Seek(-3, io.SeekEnd)
+		require.NoError(t, err)
+		data, err := io.ReadAll(rs)
+		require.NoError(t, err)
+		assert.Equal(t, "789", string(data))
+
+		_, err = rs.Seek(1, io.SeekStart)
+		require.NoError(t, err)
+		buf := make([]byte, 2)
+		_, err = io.ReadFull(rs, buf)
+		require.NoError(t, err)
+		assert.Equal(t, "12", string(buf))
+	})
+
+	t.Run("負の位置への Seek はエラーを返す", func(t *testing.T) {
+		rs := NewRangeReadSeeker(ctx, client, "audios/a.mp3", 10)
+
+		_, err := rs.Seek(-1, io.SeekStart)
+
+		assert.Error(t, err)
+	})
+
+	t.Run("http.ServeContent で複数の範囲に応答できる", func(t *testing.T) {
+		rs := NewRangeReadSeeker(ctx, client, "audios/a.mp3", 10)
+		defer rs.Close()
+
+		w := httptest.NewRecorder()
+		req := httptest.NewRequest(http.MethodGet, "/", nil)
+		req.Header.Set("Range", "bytes=0-1,8-9")
+		http.ServeContent(w, req, "a.mp3", time.Time{}, rs)
+
+		assert.Equal(t, http.StatusPartialContent, w.Code)
+		assert.True(t, strings.HasPrefix(w.Header().Get("Content-Type"), "multipart/byteranges"))
+		assert.Contains(t, w.Body.String(), "01")
+		assert.Contains(t, w.Body.String(), "89")
+	})
+}
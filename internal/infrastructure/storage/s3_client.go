@@ -0,0 +1,356 @@
+package storage
+
+import (
+	"bytes"
+	"context"
+	"errors"
+	"fmt"
+	"io"
+	"time"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	v4 "github.com/aws/aws-sdk-go-v2/aws/signer/v4"
+	awsconfig "github.com/aws/aws-sdk-go-v2/config"
+	"github.com/aws/aws-sdk-go-v2/credentials"
+	"github.com/aws/aws-sdk-go-v2/service/s3"
+	"github.com/aws/aws-sdk-go-v2/service/s3/types"
+	"github.com/aws/smithy-go"
+
+	"github.com/siropaca/anycast-backend/internal/apperror"
+	"github.com/siropaca/anycast-backend/internal/pkg/logger"
+)
+
+// s3MultipartPartSize はマルチパートアップロードの 1 パートのサイズ
+//
+// これ未満のファイルは 1 回の PutObject でアップロードする。S3 の最小パートサイズ（5 MiB）以上にする必要がある
+const s3MultipartPartSize = 8 * 1024 * 1024
+
+// S3Config は S3 互換ストレージの接続設定を表す
+type S3Config struct {
+	Bucket          string
+	Region          string
+	Endpoint        string // S3 互換ストレージ（MinIO など）のエンドポイント（空の場合は AWS）
+	PublicEndpoint  string // 署名付き URL に使うエンドポイント（空の場合は Endpoint）
+	AccessKeyID     string // 空の場合は AWS のデフォルトの認証情報チェーンを使用
+	SecretAccessKey string
+	UsePathStyle    bool // パス形式の URL を使うか（MinIO では true）
+}
+
+// s3API は s3Client が使用する S3 API
+type s3API interface {
+	PutObject(ctx context.Context, params *s3.PutObjectInput, optFns ...func(*s3.Options)) (*s3.PutObjectOutput, error)
+	CreateMultipartUpload(ctx context.Context, params *s3.CreateMultipartUploadInput, optFns ...func(*s3.Options)) (*s3.CreateMultipartUploadOutput, error)
+	UploadPart(ctx context.Context, params *s3.UploadPartInput, optFns ...func(*s3.Options)) (*s3.UploadPartOutput, error)
+	CompleteMultipartUpload(ctx context.Context, params *s3.CompleteMultipartUploadInput, optFns ...func(*s3.Options)) (*s3.CompleteMultipartUploadOutput, error)
+	AbortMultipartUpload(ctx context.Context, params *s3.AbortMultipartUploadInput, optFns ...func(*s3.Options)) (*s3.AbortMultipartUploadOutput, error)
+	GetObject(ctx context.Context, params *s3.GetObjectInput, optFns ...func(*s3.Options)) (*s3.GetObjectOutput, error)
+	HeadObject(ctx context.Context, params *s3.HeadObjectInput, optFns ...func(*s3.Options)) (*s3.HeadObjectOutput, error)
+	DeleteObject(ctx context.Context, params *s3.DeleteObjectInput, optFns ...func(*s3.Options)) (*s3.DeleteObjectOutput, error)
+}
+
+// s3Presigner は署名付き URL を生成する S3 API
+type s3Presigner interface {
+	PresignGetObject(ctx context.Context, params *s3.GetObjectInput, optFns ...func(*s3.PresignOptions)) (*v4.PresignedHTTPRequest, error)
+}
+
+type s3Client struct {
+	api       s3API
+	presigner s3Presigner
+	bucket    string
+	partSize  int
+}
+
+// NewS3Client は S3 互換ストレージのクライアントを作成する
+func NewS3Client(ctx context.Context, cfg S3Config) (Client, error) {
+	if cfg.Bucket == "" {
+		return nil, fmt.Errorf("S3 bucket is required")
+	}
+
+	var loadOpts []func(*awsconfig.LoadOptions) error
+	if cfg.Region != "" {
+		loadOpts = append(loadOpts, awsconfig.WithRegion(cfg.Region))
+	}
+	if cfg.AccessKeyID != "" {
+		loadOpts = append(loadOpts, awsconfig.WithCredentialsProvider(
+			credentials.NewStaticCredentialsProvider(cfg.AccessKeyID, cfg.SecretAccessKey, ""),
+		))
+	}
+
+	awsCfg, err := awsconfig.LoadDefaultConfig(ctx, loadOpts...)
+	if err != nil {
+		return nil, fmt.Errorf("failed to load AWS config: %w", err)
+	}
+
+	newClient := func(endpoint string) *s3.Client {
+		return s3.NewFromConfig(awsCfg, func(o *s3.Options) {
+			if endpoint != "" {
+				o.BaseEndpoint = aws.String(endpoint)
+			}
+			o.UsePathStyle = cfg.UsePathStyle
+			// S3 互換ストレージには追加のチェックサムに未対応のものがあるため、必須の場合のみ付与する
+			o.RequestChecksumCalculation = aws.RequestChecksumCalculationWhenRequired
+			o.ResponseChecksumValidation = aws.ResponseChecksumValidationWhenRequired
+		})
+	}
+
+	client := newClient(cfg.Endpoint)
+
+	presignClient := client
+	if cfg.PublicEndpoint != "" {
+		presignClient = newClient(cfg.PublicEndpoint)
+	}
+
+	return &s3Client{
+		api:       client,
+		presigner: s3.NewPresignClient(presignClient),
+		bucket:    cfg.Bucket,
+		partSize:  s3MultipartPartSize,
+	}, nil
+}
+
+// Upload はファイルをアップロードする
+func (c *s3Client) Upload(ctx context.Context, data []byte, path, contentType string) (string, error) {
+	return c.UploadStream(ctx, bytes.NewReader(data), path, contentType)
+}
+
+// UploadStream は r の内容をアップロードする
+//
+// パートサイズ未満の場合は PutObject、それ以上の場合はマルチパートアップロードを使う。
+// パート 1 つ分のバッファを使い回すため、ファイル全体をメモリに保持しない
+func (c *s3Client) UploadStream(ctx context.Context, r io.Reader, path, contentType string) (string, error) {
+	log := logger.FromContext(ctx)
+	log.Debug("uploading file to S3", "path", path)
+
+	buf := make([]byte, c.partSize)
+	n, err := io.ReadFull(r, buf)
+	if err != nil && !errors.Is(err, io.ErrUnexpectedEOF) && !errors.Is(err, io.EOF) {
+		log.Error("failed to read upload data", "error", err)
+		return "", apperror.ErrMediaUploadFailed.WithMessage("ファイルのアップロードに失敗しました").WithError(err)
+	}
+
+	// パートサイズ未満のファイルは 1 回でアップロードする
+	if n < c.partSize {
+		if _, err := c.api.PutObject(ctx, &s3.PutObjectInput{
+			Bucket:        aws.String(c.bucket),
+			Key:           aws.String(path),
+			Body:          bytes.NewReader(buf[:n]),
+			ContentLength: aws.Int64(int64(n)),
+			ContentType:   aws.String(contentType),
+		}); err != nil {
+			log.Error("failed to put object to S3", "error", err)
+			return "", apperror.ErrMediaUploadFailed.WithMessage("ファイルのアップロードに失敗しました").WithError(err)
+		}
+
+		log.Debug("file uploaded successfully", "path", path, "size", n)
+		return path, nil
+	}
+
+	size, err := c.uploadMultipart(ctx, r, buf, path, contentType)
+	if err != nil {
+		log.Error("failed to upload multipart to S3", "error", err)
+		return "", apperror.ErrMediaUploadFailed.WithMessage("ファイルのアップロードに失敗しました").WithError(err)
+	}
+
+	log.Debug("file uploaded successfully", "path", path, "size", size)
+	return path, nil
+}
+
+// uploadMultipart はマルチパートアップロードを行い、アップロードしたバイト数を返す
+//
+// buf には 1 パート目のデータが読み込まれている前提。失敗した場合はアップロードを中断する
+func (c *s3Client) uploadMultipart(ctx context.Context, r io.Reader, buf []byte, path, contentType string) (int64, error) {
+	created, err := c.api.CreateMultipartUpload(ctx, &s3.CreateMultipartUploadInput{
+		Bucket:      aws.String(c.bucket),
+		Key:         aws.String(path),
+		ContentType: aws.String(contentType),
+	})
+	if err != nil {
+		return 0, err
+	}
+
+	size, parts, err := c.uploadParts(ctx, r, buf, path, created.UploadId)
+	if err == nil {
+		_, err = c.api.CompleteMultipartUpload(ctx, &s3.CompleteMultipartUploadInput{
+			Bucket:          aws.String(c.bucket),
+			Key:             aws.String(path),
+			UploadId:        created.UploadId,
+			MultipartUpload: &types.CompletedMultipartUpload{Parts: parts},
+		})
+	}
+	if err != nil {
+		// 中断しないとアップロード済みのパートがバケットに残り続ける
+		if _, abortErr := c.api.AbortMultipartUpload(context.WithoutCancel(ctx), &s3.AbortMultipartUploadInput{
+			Bucket:   aws.String(c.bucket),
+			Key:      aws.String(path),
+			UploadId: created.UploadId,
+		}); abortErr != nil {
+			logger.FromContext(ctx).Warn("failed to abort multipart upload", "error", abortErr, "path", path)
+		}
+		return 0, err
+	}
+
+	return size, nil
+}
+
+// uploadParts は buf の 1 パート目と r の残りをパートごとにアップロードする
+func (c *s3Client) uploadParts(ctx context.Context, r io.Reader, buf []byte, path string, uploadID *string) (int64, []types.CompletedPart, error) {
+	var size int64
+	var parts []types.CompletedPart
+
+	n := len(buf)
+	for partNumber := int32(1); n > 0; partNumber++ {
+		out, err := c.api.UploadPart(ctx, &s3.UploadPartInput{
+			Bucket:        aws.String(c.bucket),
+			Key:           aws.String(path),
+			UploadId:      uploadID,
+			PartNumber:    aws.Int32(partNumber),
+			Body:          bytes.NewReader(buf[:n]),
+			ContentLength: aws.Int64(int64(n)),
+		})
+		if err != nil {
+			return 0, nil, err
+		}
+		parts = append(parts, types.CompletedPart{ETag: out.ETag, PartNumber: aws.Int32(partNumber)})
+		size += int64(n)
+
+		n, err = io.ReadFull(r, buf)
+		if err != nil && !errors.Is(err, io.ErrUnexpectedEOF) && !errors.Is(err, io.EOF) {
+			return 0, nil, err
+		}
+	}
+
+	return size, parts, nil
+}
+
+// GenerateSignedURL は署名付き URL を生成する
+func (c *s3Client) GenerateSignedURL(ctx context.Context, path string, expiration time.Duration) (string, error) {
+	log := logger.FromContext(ctx)
+	log.Debug("generating signed URL", "path", path, "expiration", expiration)
+
+	req, err := c.presigner.PresignGetObject(ctx, &s3.GetObjectInput{
+		Bucket: aws.String(c.bucket),
+		Key:    aws.String(path),
+	}, s3.WithPresignExpires(expiration))
+	if err != nil {
+		log.Error("failed to generate signed URL", "error", err)
+		return "", apperror.ErrInternal.WithMessage("署名付き URL の生成に失敗しました").WithError(err)
+	}
+
+	return req.URL, nil
+}
+
+// Delete はファイルを削除する（存在しない場合もエラーにしない）
+func (c *s3Client) Delete(ctx context.Context, path string) error {
+	log := logger.FromContext(ctx)
+	log.Debug("deleting file from S3", "path", path)
+
+	if _, err := c.api.DeleteObject(ctx, &s3.DeleteObjectInput{
+		Bucket: aws.String(c.bucket),
+		Key:    aws.String(path),
+	}); err != nil {
+		if isS3NotFound(err) {
+			log.Debug("skipping deletion as file does not exist", "path", path)
+			return nil
+		}
+		log.Error("failed to delete from S3", "error", err)
+		return apperror.ErrInternal.WithMessage("ファイルの削除に失敗しました").WithError(err)
+	}
+
+	log.Debug("file deleted successfully", "path", path)
+	return nil
+}
+
+// Exists はファイルが存在するかどうかを返す
+func (c *s3Client) Exists(ctx context.Context, path string) (bool, error) {
+	if _, err := c.api.HeadObject(ctx, &s3.HeadObjectInput{
+		Bucket: aws.String(c.bucket),
+		Key:    aws.String(path),
+	}); err != nil {
+		if isS3NotFound(err) {
+			return false, nil
+		}
+		logger.FromContext(ctx).Error("failed to head S3 object", "error", err, "path", path)
+		return false, apperror.ErrInternal.WithMessage("ファイルの確認に失敗しました").WithError(err)
+	}
+
+	return true, nil
+}
+
+// DownloadStream はファイルをダウンロードして w に書き込む
+func (c *s3Client) DownloadStream(ctx context.Context, path string, w io.Writer) (int64, error) {
+	log := logger.FromContext(ctx)
+	log.Debug("downloading file from S3", "path", path)
+
+	out, err := c.api.GetObject(ctx, &s3.GetObjectInput{
+		Bucket: aws.String(c.bucket),
+		Key:    aws.String(path),
+	})
+	if err != nil {
+		if isS3NotFound(err) {
+			return 0, apperror.ErrNotFound.WithMessage("ファイルが見つかりません")
+		}
+		log.Error("failed to get S3 object", "error", err)
+		return 0, apperror.ErrInternal.WithMessage("ファイルのダウンロードに失敗しました").WithError(err)
+	}
+
+	defer func() {
+		if err := out.Body.Close(); err != nil {
+			log.Warn("failed to close S3 object body", "error", err)
+		}
+	}()
+
+	size, err := io.Copy(w, out.Body)
+	if err != nil {
+		log.Error("failed to read from S3", "error", err)
+		return size, apperror.ErrInternal.WithMessage("ファイルのダウンロードに失敗しました").WithError(err)
+	}
+
+	log.Debug("file downloaded successfully", "path", path, "size", size)
+	return size, nil
+}
+
+// OpenRange はファイルの指定範囲を読み込むリーダーを返す
+func (c *s3Client) OpenRange(ctx context.Context, path string, offset, length int64) (io.ReadCloser, error) {
+	if length == 0 {
+		return io.NopCloser(bytes.NewReader(nil)), nil
+	}
+
+	byteRange := fmt.Sprintf("bytes=%d-", offset)
+	if length > 0 {
+		byteRange = fmt.Sprintf("bytes=%d-%d", offset, offset+length-1)
+	}
+
+	out, err := c.api.GetObject(ctx, &s3.GetObjectInput{
+		Bucket: aws.String(c.bucket),
+		Key:    aws.String(path),
+		Range:  aws.String(byteRange),
+	})
+	if err != nil {
+		if isS3NotFound(err) {
+			return nil, apperror.ErrNotFound.WithMessage("ファイルが見つかりません")
+		}
+		logger.FromContext(ctx).Error("failed to get S3 object range", "error", err, "path", path)
+		return nil, apperror.ErrInternal.WithMessage("ファイルのダウンロードに失敗しました").WithError(err)
+	}
+
+	return out.Body, nil
+}
+
+// Close は何もしない（S3 クライアントは HTTP クライアントを共有するため閉じる必要がない）
+func (c *s3Client) Close() error {
+	return nil
+}
+
+// isS3NotFound はオブジェクトが存在しないことを表すエラーかどうかを判定する
+//
+// GetObject は NoSuchKey、HeadObject はボディがないため NotFound を返す
+func isS3NotFound(err error) bool {
+	var apiErr smithy.APIError
+	if errors.As(err, &apiErr) {
+		switch apiErr.ErrorCode() {
+		case "NoSuchKey", "NotFound":
+			return true
+		}
+	}
+	return false
+}
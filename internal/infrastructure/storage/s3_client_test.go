@@ -0,0 +1,251 @@
+package storage
+
+import (
+	"bytes"
+	"context"
+	"errors"
+	"fmt"
+	"io"
+	"strings"
+	"testing"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/service/s3"
+	"github.com/aws/aws-sdk-go-v2/service/s3/types"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/siropaca/anycast-backend/internal/apperror"
+)
+
+// fakeS3API はオブジェクトをメモリ上に保持する s3API
+type fakeS3API struct {
+	objects map[string][]byte
+	// uploads はマルチパートアップロード中のパート（UploadId ごと）
+	uploads map[string][][]byte
+
+	putCount     int
+	partSizes    []int
+	aborted      bool
+	failPartNum  int32
+	completedKey string
+}
+
+func newFakeS3API() *fakeS3API {
+	return &fakeS3API{objects: map[string][]byte{}, uploads: map[string][][]byte{}}
+}
+
+func (f *fakeS3API) PutObject(ctx context.Context, params *s3.PutObjectInput, optFns ...func(*s3.Options)) (*s3.PutObjectOutput, error) {
+	data, err := io.ReadAll(params.Body)
+	if err != nil {
+		return nil, err
+	}
+	f.putCount++
+	f.objects[*params.Key] = data
+	return &s3.PutObjectOutput{}, nil
+}
+
+func (f *fakeS3API) CreateMultipartUpload(ctx context.Context, params *s3.CreateMultipartUploadInput, optFns ...func(*s3.Options)) (*s3.CreateMultipartUploadOutput, error) {
+	f.uploads["upload-1"] = nil
+	return &s3.CreateMultipartUploadOutput{UploadId: aws.String("upload-1")}, nil
+}
+
+func (f *fakeS3API) UploadPart(ctx context.Context, params *s3.UploadPartInput, optFns ...func(*s3.Options)) (*s3.UploadPartOutput, error) {
+	if *params.PartNumber == f.failPartNum {
+		return nil, errors.New("upload part failed")
+	}
+	data, err := io.ReadAll(params.Body)
+	if err != nil {
+		return nil, err
+	}
+	f.partSizes = append(f.partSizes, len(data))
+	f.uploads[*params.UploadId] = append(f.uploads[*params.UploadId], data)
+	return &s3.UploadPartOutput{ETag: aws.String("etag")}, nil
+}
+
+func (f *fakeS3API) CompleteMultipartUpload(ctx context.Context, params *s3.CompleteMultipartUploadInput, optFns ...func(*s3.Options)) (*s3.CompleteMultipartUploadOutput, error) {
+	f.objects[*params.Key] = bytes.Join(f.uploads[*params.UploadId], nil)
+	f.completedKey = *params.Key
+	return &s3.CompleteMultipartUploadOutput{}, nil
+}
+
+func (f *fakeS3API) AbortMultipartUpload(ctx context.Context, params *s3.AbortMultipartUploadInput, optFns ...func(*s3.Options)) (*s3.AbortMultipartUploadOutput, error) {
+	f.aborted = true
+	delete(f.uploads, *params.UploadId)
+	return &s3.AbortMultipartUploadOutput{}, nil
+}
+
+func (f *fakeS3API) GetObject(ctx context.Context, params *s3.GetObjectInput, optFns ...func(*s3.Options)) (*s3.GetObjectOutput, error) {
+	data, ok := f.objects[*params.Key]
+	if !ok {
+		return nil, &types.NoSuchKey{}
+	}
+	if params.Range != nil {
+		var start, end int
+		n, _ := fmt.Sscanf(*params.Range, "bytes=%d-%d", &start, &end) //nolint:errcheck // 終端を省略した形式は n で判定する
+		if n < 2 || end >= len(data) {
+			end = len(data) - 1
+		}
+		data = data[start : end+1]
+	}
+	return &s3.GetObjectOutput{Body: io.NopCloser(bytes.NewReader(data))}, nil
+}
+
+func (f *fakeS3API) HeadObject(ctx context.Context, params *s3.HeadObjectInput, optFns ...func(*s3.Options)) (*s3.HeadObjectOutput, error) {
+	if _, ok := f.objects[*params.Key]; !ok {
+		return nil, &types.NotFound{}
+	}
+	return &s3.HeadObjectOutput{}, nil
+}
+
+func (f *fakeS3API) DeleteObject(ctx context.Context, params *s3.DeleteObjectInput, optFns ...func(*s3.Options)) (*s3.DeleteObjectOutput, error) {
+	delete(f.objects, *params.Key)
+	return &s3.DeleteObjectOutput{}, nil
+}
+
+func newTestS3Client(api *fakeS3API, partSize int) *s3Client {
+	return &s3Client{api: api, bucket: "bucket", partSize: partSize}
+}
+
+func TestS3Client_UploadStream(t *testing.T) {
+	ctx := context.Background()
+
+	t.Run("パートサイズ未満のファイルは PutObject でアップロードする", func(t *testing.T) {
+		api := newFakeS3API()
+		client := newTestS3Client(api, 8)
+
+		path, err := client.UploadStream(ctx, strings.NewReader("audio"), "audios/a.mp3", "audio/mpeg")
+
+		require.NoError(t, err)
+		assert.Equal(t, "audios/a.mp3", path)
+		assert.Equal(t, 1, api.putCount)
+		assert.Equal(t, []byte("audio"), api.objects["audios/a.mp3"])
+	})
+
+	t.Run("パートサイズ以上のファイルはマルチパートでアップロードする", func(t *testing.T) {
+		api := newFakeS3API()
+		client := newTestS3Client(api, 4)
+
+		_, err := client.UploadStream(ctx, strings.NewReader("0123456789"), "audios/a.mp3", "audio/mpeg")
+
+		require.NoError(t, err)
+		assert.Equal(t, 0, api.putCount)
+		assert.Equal(t, []int{4, 4, 2}, api.partSizes)
+		assert.Equal(t, "audios/a.mp3", api.completedKey)
+		assert.Equal(t, []byte("0123456789"), api.objects["audios/a.mp3"])
+	})
+
+	t.Run("パートサイズちょうどのファイルは空のパートを送らない", func(t *testing.T) {
+		api := newFakeS3API()
+		client := newTestS3Client(api, 4)
+
+		_, err := client.UploadStream(ctx, strings.NewReader("01234567"), "audios/a.mp3", "audio/mpeg")
+
+		require.NoError(t, err)
+		assert.Equal(t, []int{4, 4}, api.partSizes)
+	})
+
+	t.Run("パートのアップロードに失敗した場合はマルチパートアップロードを中断する", func(t *testing.T) {
+		api := newFakeS3API()
+		api.failPartNum = 2
+		client := newTestS3Client(api, 4)
+
+		_, err := client.UploadStream(ctx, strings.NewReader("0123456789"), "audios/a.mp3", "audio/mpeg")
+
+		assert.True(t, apperror.IsCode(err, apperror.CodeMediaUploadFailed))
+		assert.True(t, api.aborted)
+		assert.NotContains(t, api.objects, "audios/a.mp3")
+	})
+}
+
+func TestS3Client_DownloadAndExists(t *testing.T) {
+	ctx := context.Background()
+
+	t.Run("アップロードしたファイルをダウンロードできる", func(t *testing.T) {
+		api := newFakeS3API()
+		api.objects["audios/a.mp3"] = []byte("audio")
+		client := newTestS3Client(api, 8)
+
+		var buf bytes.Buffer
+		n, err := client.DownloadStream(ctx, "audios/a.mp3", &buf)
+
+		require.NoError(t, err)
+		assert.Equal(t, int64(5), n)
+		assert.Equal(t, "audio", buf.String())
+	})
+
+	t.Run("存在しないファイルのダウンロードは NotFound を返す", func(t *testing.T) {
+		client := newTestS3Client(newFakeS3API(), 8)
+
+		_, err := client.DownloadStream(ctx, "audios/missing.mp3", io.Discard)
+
+		assert.True(t, apperror.IsCode(err, apperror.CodeNotFound))
+	})
+
+	t.Run("OpenRange は指定した範囲を読み込む", func(t *testing.T) {
+		api := newFakeS3API()
+		api.objects["audios/a.mp3"] = []byte("0123456789")
+		client := newTestS3Client(api, 8)
+
+		r, err := client.OpenRange(ctx, "audios/a.mp3", 2, 3)
+		require.NoError(t, err)
+		data, err := io.ReadAll(r)
+		require.NoError(t, err)
+		assert.Equal(t, "234", string(data))
+
+		r, err = client.OpenRange(ctx, "audios/a.mp3", 7, -1)
+		require.NoError(t, err)
+		data, err = io.ReadAll(r)
+		require.NoError(t, err)
+		assert.Equal(t, "789", string(data))
+	})
+
+	t.Run("存在しないファイルの OpenRange は NotFound を返す", func(t *testing.T) {
+		client := newTestS3Client(newFakeS3API(), 8)
+
+		_, err := client.OpenRange(ctx, "audios/missing.mp3", 0, -1)
+
+		assert.True(t, apperror.IsCode(err, apperror.CodeNotFound))
+	})
+
+	t.Run("Exists はファイルの有無を返す", func(t *testing.T) {
+		api := newFakeS3API()
+		api.objects["audios/a.mp3"] = []byte("audio")
+		client := newTestS3Client(api, 8)
+
+		exists, err := client.Exists(ctx, "audios/a.mp3")
+		require.NoError(t, err)
+		assert.True(t, exists)
+
+		exists, err = client.Exists(ctx, "audios/missing.mp3")
+		require.NoError(t, err)
+		assert.False(t, exists)
+	})
+}
+
+func TestNewS3Client(t *testing.T) {
+	t.Run("バケットが空の場合はエラーを返す", func(t *testing.T) {
+		_, err := NewS3Client(context.Background(), S3Config{Region: "ap-northeast-1"})
+
+		assert.Error(t, err)
+	})
+
+	t.Run("MinIO のエンドポイントで署名付き URL を生成できる", func(t *testing.T) {
+		client, err := NewS3Client(context.Background(), S3Config{
+			Bucket:          "anycast",
+			Region:          "us-east-1",
+			Endpoint:        "http://minio:9000",
+			PublicEndpoint:  "http://localhost:9000",
+			AccessKeyID:     "minioadmin",
+			SecretAccessKey: "minioadmin",
+			UsePathStyle:    true,
+		})
+		require.NoError(t, err)
+
+		url, err := client.GenerateSignedURL(context.Background(), "audios/a.mp3", SignedURLExpirationAudio)
+
+		require.NoError(t, err)
+		assert.True(t, strings.HasPrefix(url, "http://localhost:9000/anycast/audios/a.mp3?"))
+		assert.Contains(t, url, "X-Amz-Expires=3600")
+	})
+}
@@ -8,9 +8,10 @@ import (
 
 // SpeakerTurn は multi-speaker 合成用の話者とテキストのペア
 type SpeakerTurn struct {
-	Speaker string  // 話者名（キャラクター名）
-	Text    string  // セリフ
-	Emotion *string // 感情（オプション）
+	Speaker      string  // 話者名（キャラクター名）
+	Text         string  // セリフ（発音辞書適用後の TTS に渡すテキスト）
+	OriginalText string  // 発音辞書適用前のセリフ（アライメント用、空の場合は Text と同じ）
+	Emotion      *string // 感情（オプション）
 }
 
 // SpeakerVoiceConfig は話者名と Voice ID のマッピング
@@ -29,7 +30,8 @@ type SynthesisResult struct {
 // Client は TTS クライアントのインターフェース
 type Client interface {
 	// Synthesize はテキストから音声を合成する（シングルスピーカー）
-	Synthesize(ctx context.Context, text string, emotion *string, voiceID string, gender model.Gender) (*SynthesisResult, error)
+	// settings が nil の場合はプロバイダのデフォルトの音声パラメータで合成する
+	Synthesize(ctx context.Context, text string, emotion *string, voiceID string, gender model.Gender, settings *VoiceSettings) (*SynthesisResult, error)
 	// SynthesizeMultiSpeaker は複数話者のテキストから音声を合成する（マルチスピーカー）
 	SynthesizeMultiSpeaker(ctx context.Context, turns []SpeakerTurn, voiceConfigs []SpeakerVoiceConfig) (*SynthesisResult, error)
 }
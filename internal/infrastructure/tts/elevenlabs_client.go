@@ -78,27 +78,24 @@ type ttsRequest struct {
 }
 
 // Synthesize はテキストから音声を合成する（シングルスピーカー）
-func (c *elevenLabsTTSClient) Synthesize(ctx context.Context, text string, emotion *string, voiceID string, gender model.Gender) (*SynthesisResult, error) {
+func (c *elevenLabsTTSClient) Synthesize(ctx context.Context, text string, emotion *string, voiceID string, gender model.Gender, settings *VoiceSettings) (*SynthesisResult, error) {
 	log := logger.FromContext(ctx)
 
+	// セリフ内マークアップ（ポーズ・強調・効果音）をプロバイダのタグに変換
+	synthesisText := renderElevenLabsMarkup(text)
+
 	// emotion がある場合は [emotion] 形式でテキストの先頭に付加
-	synthesisText := text
 	if emotion != nil && *emotion != "" {
-		synthesisText = fmt.Sprintf("[%s] %s", *emotion, text)
+		synthesisText = fmt.Sprintf("[%s] %s", *emotion, synthesisText)
 	}
 
 	log.Debug("ElevenLabs TTS input", "text", synthesisText, "voiceID", voiceID)
 
 	reqBody := ttsRequest{
-		Text:         synthesisText,
-		ModelID:      elevenLabsTTSModelID,
-		LanguageCode: elevenLabsDialogueLanguage,
-		VoiceSettings: voiceSettings{
-			Stability:       elevenLabsDefaultStability,
-			SimilarityBoost: elevenLabsDefaultSimilarity,
-			Style:           elevenLabsDefaultStyle,
-			Speed:           elevenLabsDefaultSpeed,
-		},
+		Text:          synthesisText,
+		ModelID:       elevenLabsTTSModelID,
+		LanguageCode:  elevenLabsDialogueLanguage,
+		VoiceSettings: newElevenLabsVoiceSettings(settings),
 	}
 
 	body, err := json.Marshal(reqBody)
@@ -121,6 +118,34 @@ func (c *elevenLabsTTSClient) Synthesize(ctx context.Context, text string, emoti
 	}, nil
 }
 
+// newElevenLabsVoiceSettings はデフォルト値に音声パラメータの指定を上書きしたボイス設定を返す
+func newElevenLabsVoiceSettings(settings *VoiceSettings) voiceSettings {
+	vs := voiceSettings{
+		Stability:       elevenLabsDefaultStability,
+		SimilarityBoost: elevenLabsDefaultSimilarity,
+		Style:           elevenLabsDefaultStyle,
+		Speed:           elevenLabsDefaultSpeed,
+	}
+
+	if settings == nil {
+		return vs
+	}
+	if settings.Stability != nil {
+		vs.Stability = *settings.Stability
+	}
+	if settings.SimilarityBoost != nil {
+		vs.SimilarityBoost = *settings.SimilarityBoost
+	}
+	if settings.Style != nil {
+		vs.Style = *settings.Style
+	}
+	if settings.SpeakingRate != nil {
+		vs.Speed = *settings.SpeakingRate
+	}
+
+	return vs
+}
+
 // SynthesizeMultiSpeaker は複数話者のテキストから音声を合成する（マルチスピーカー）
 func (c *elevenLabsTTSClient) SynthesizeMultiSpeaker(ctx context.Context, turns []SpeakerTurn, voiceConfigs []SpeakerVoiceConfig) (*SynthesisResult, error) {
 	log := logger.FromContext(ctx)
@@ -147,10 +172,10 @@ func (c *elevenLabsTTSClient) SynthesizeMultiSpeaker(ctx context.Context, turns
 			return nil, apperror.ErrValidation.WithMessage(fmt.Sprintf("話者 %q の voice_id が見つかりません", turn.Speaker))
 		}
 
-		// emotion がある場合は [emotion] 形式でテキストの先頭に付加
-		text := turn.Text
+		// セリフ内マークアップを音声タグに変換し、emotion がある場合は [emotion] 形式でテキストの先頭に付加
+		text := renderElevenLabsMarkup(turn.Text)
 		if turn.Emotion != nil && *turn.Emotion != "" {
-			text = fmt.Sprintf("[%s] %s", *turn.Emotion, turn.Text)
+			text = fmt.Sprintf("[%s] %s", *turn.Emotion, text)
 		}
 
 		inputs = append(inputs, dialogueInput{
@@ -65,30 +65,20 @@ func NewGeminiTTSClient(ctx context.Context, projectID, location, credentialsJSO
 }
 
 // Synthesize はテキストから音声を合成する（シングルスピーカー）
-func (c *geminiTTSClient) Synthesize(ctx context.Context, text string, emotion *string, voiceID string, gender model.Gender) (*SynthesisResult, error) {
+func (c *geminiTTSClient) Synthesize(ctx context.Context, text string, emotion *string, voiceID string, gender model.Gender, settings *VoiceSettings) (*SynthesisResult, error) {
 	log := logger.FromContext(ctx)
 
+	// セリフ内マークアップ（ポーズ・強調・効果音）をプロバイダのタグに変換
+	synthesisText := renderGeminiMarkup(text)
+
 	// emotion がある場合は [emotion] 形式でテキストの先頭に付加
-	synthesisText := text
 	if emotion != nil && *emotion != "" {
-		synthesisText = fmt.Sprintf("[%s] %s", *emotion, text)
+		synthesisText = fmt.Sprintf("[%s] %s", *emotion, synthesisText)
 	}
 
 	log.Debug("Gemini TTS input", "text", synthesisText, "voiceID", voiceID)
 
-	config := &genai.GenerateContentConfig{
-		ResponseModalities: []string{"AUDIO"},
-		// Temperature:        genai.Ptr(float32(0.5)),
-		// Seed:               genai.Ptr(int32(42)),
-		SpeechConfig: &genai.SpeechConfig{
-			LanguageCode: geminiDefaultLanguageCode,
-			VoiceConfig: &genai.VoiceConfig{
-				PrebuiltVoiceConfig: &genai.PrebuiltVoiceConfig{
-					VoiceName: voiceID,
-				},
-			},
-		},
-	}
+	config := newGeminiSingleSpeakerConfig(voiceID, settings)
 
 	resp, err := c.client.Models.GenerateContent(ctx, geminiAPITTSModelName, genai.Text(synthesisText), config)
 	if err != nil {
@@ -131,9 +121,9 @@ func (c *geminiTTSClient) SynthesizeMultiSpeaker(ctx context.Context, turns []Sp
 	promptBuilder.WriteString("\n\n")
 
 	for _, turn := range turns {
-		text := turn.Text
+		text := renderGeminiMarkup(turn.Text)
 		if turn.Emotion != nil && *turn.Emotion != "" {
-			text = fmt.Sprintf("[%s] %s", *turn.Emotion, turn.Text)
+			text = fmt.Sprintf("[%s] %s", *turn.Emotion, text)
 		}
 
 		promptBuilder.WriteString(fmt.Sprintf("%s: %s\n", turn.Speaker, text))
@@ -189,6 +179,52 @@ func (c *geminiTTSClient) SynthesizeMultiSpeaker(ctx context.Context, turns []Sp
 	}, nil
 }
 
+// newGeminiSingleSpeakerConfig はシングルスピーカー合成のリクエスト設定を生成する
+//
+// 速度・ピッチの指定はシステム指示として渡し、読み上げるテキストには含めない
+func newGeminiSingleSpeakerConfig(voiceID string, settings *VoiceSettings) *genai.GenerateContentConfig {
+	config := &genai.GenerateContentConfig{
+		ResponseModalities: []string{"AUDIO"},
+		// Temperature:        genai.Ptr(float32(0.5)),
+		// Seed:               genai.Ptr(int32(42)),
+		SpeechConfig: &genai.SpeechConfig{
+			LanguageCode: geminiDefaultLanguageCode,
+			VoiceConfig: &genai.VoiceConfig{
+				PrebuiltVoiceConfig: &genai.PrebuiltVoiceConfig{
+					VoiceName: voiceID,
+				},
+			},
+		},
+	}
+
+	if instruction := geminiVoiceSettingsInstruction(settings); instruction != "" {
+		config.SystemInstruction = genai.NewContentFromText(instruction, genai.RoleUser)
+	}
+
+	return config
+}
+
+// geminiVoiceSettingsInstruction は音声パラメータを Gemini TTS 向けの話し方の指示文に変換する
+//
+// Gemini TTS には速度・ピッチを直接指定するパラメータがないため、自然言語で指示する
+func geminiVoiceSettingsInstruction(settings *VoiceSettings) string {
+	if settings == nil {
+		return ""
+	}
+
+	var parts []string
+	if settings.SpeakingRate != nil && *settings.SpeakingRate != 1.0 {
+		parts = append(parts, fmt.Sprintf("通常の %g 倍の速さで話してください。", *settings.SpeakingRate))
+	}
+	if settings.Pitch != nil && *settings.Pitch > 0 {
+		parts = append(parts, fmt.Sprintf("通常より %g 半音高い声で話してください。", *settings.Pitch))
+	} else if settings.Pitch != nil && *settings.Pitch < 0 {
+		parts = append(parts, fmt.Sprintf("通常より %g 半音低い声で話してください。", -*settings.Pitch))
+	}
+
+	return strings.Join(parts, "")
+}
+
 // extractAudioFromResponse はレスポンスから音声データを取得する
 func extractAudioFromResponse(resp *genai.GenerateContentResponse) ([]byte, error) {
 	if resp == nil || len(resp.Candidates) == 0 {
@@ -0,0 +1,86 @@
+package tts
+
+import (
+	"fmt"
+	"regexp"
+	"strings"
+	"time"
+
+	"github.com/siropaca/anycast-backend/internal/pkg/script"
+)
+
+var (
+	markupSpacesRegex         = regexp.MustCompile(` {2,}`)
+	markupLineEdgeSpacesRegex = regexp.MustCompile(`(?m)^ +| +$`)
+)
+
+// renderGeminiMarkup はセリフ内マークアップを Gemini TTS のマークアップタグに変換する
+//
+// Gemini TTS には効果音を鳴らすタグがないため、効果音は除去する
+func renderGeminiMarkup(text string) string {
+	return renderMarkup(text, func(seg script.MarkupSegment) string {
+		switch seg.Kind {
+		case script.MarkupKindPause:
+			switch {
+			case seg.Duration <= 400*time.Millisecond:
+				return "[short pause]"
+			case seg.Duration <= 800*time.Millisecond:
+				return "[medium pause]"
+			default:
+				return "[long pause]"
+			}
+		case script.MarkupKindEmphasis:
+			return fmt.Sprintf("[emphasized] %s", seg.Text)
+		default:
+			return ""
+		}
+	})
+}
+
+// renderElevenLabsMarkup はセリフ内マークアップを ElevenLabs v3 の音声タグに変換する
+//
+// 効果音は効果音名をそのまま音声タグとして渡す（例: [sfx:door_knock] → [door knock]）
+func renderElevenLabsMarkup(text string) string {
+	return renderMarkup(text, func(seg script.MarkupSegment) string {
+		switch seg.Kind {
+		case script.MarkupKindPause:
+			if seg.Duration <= 500*time.Millisecond {
+				return "[short pause]"
+			}
+			return "[long pause]"
+		case script.MarkupKindEmphasis:
+			return fmt.Sprintf("[emphasized] %s", seg.Text)
+		case script.MarkupKindSFX:
+			return fmt.Sprintf("[%s]", strings.NewReplacer("_", " ", "-", " ").Replace(seg.SFX))
+		default:
+			return ""
+		}
+	})
+}
+
+// renderMarkup はテキスト中のマークアップタグを render の結果に置き換える
+//
+// マークアップを含まないテキストや書式が不正なテキストはそのまま返す
+func renderMarkup(text string, render func(seg script.MarkupSegment) string) string {
+	if !script.HasMarkup(text) {
+		return text
+	}
+	segments, err := script.ParseMarkup(text)
+	if err != nil {
+		return text
+	}
+
+	var b strings.Builder
+	for _, seg := range segments {
+		if seg.Kind == script.MarkupKindText {
+			b.WriteString(seg.Text)
+			continue
+		}
+		// 前後の文字と連結されないよう空白で区切る
+		b.WriteString(" " + render(seg) + " ")
+	}
+
+	// タグの前後に生じた連続する空白を 1 つにまとめ、行頭・行末の空白を除去する
+	rendered := markupSpacesRegex.ReplaceAllString(b.String(), " ")
+	return markupLineEdgeSpacesRegex.ReplaceAllString(rendered, "")
+}
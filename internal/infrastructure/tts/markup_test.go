@@ -0,0 +1,42 @@
+package tts
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestRenderGeminiMarkup(t *testing.T) {
+	t.Run("マークアップを含まないテキストはそのまま返す", func(t *testing.T) {
+		assert.Equal(t, "こんにちは", renderGeminiMarkup("こんにちは"))
+	})
+
+	t.Run("ポーズは長さに応じたタグに変換する", func(t *testing.T) {
+		assert.Equal(t, "あ [short pause] い", renderGeminiMarkup("あ[pause:300ms]い"))
+		assert.Equal(t, "あ [medium pause] い", renderGeminiMarkup("あ [pause:800ms] い"))
+		assert.Equal(t, "あ [long pause] い", renderGeminiMarkup("あ[pause:2s]い"))
+	})
+
+	t.Run("強調はタグ付きのテキストに変換し、効果音は除去する", func(t *testing.T) {
+		assert.Equal(t, "それは [emphasized] 本当に すごい", renderGeminiMarkup("それは[em:本当に]すごい[sfx:applause]"))
+	})
+
+	t.Run("改行は保持する", func(t *testing.T) {
+		assert.Equal(t, "あ [short pause]\nい", renderGeminiMarkup("あ[pause:200ms]\nい"))
+	})
+}
+
+func TestRenderElevenLabsMarkup(t *testing.T) {
+	t.Run("ポーズは長さに応じたタグに変換する", func(t *testing.T) {
+		assert.Equal(t, "あ [short pause] い", renderElevenLabsMarkup("あ[pause:500ms]い"))
+		assert.Equal(t, "あ [long pause] い", renderElevenLabsMarkup("あ[pause:1s]い"))
+	})
+
+	t.Run("効果音は音声タグに変換する", func(t *testing.T) {
+		assert.Equal(t, "[door knock] 誰か来た", renderElevenLabsMarkup("[sfx:door_knock]誰か来た"))
+	})
+
+	t.Run("強調はタグ付きのテキストに変換する", func(t *testing.T) {
+		assert.Equal(t, "それは [emphasized] 本当に すごい", renderElevenLabsMarkup("それは[em:本当に]すごい"))
+	})
+}
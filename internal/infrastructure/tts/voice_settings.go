@@ -0,0 +1,77 @@
+package tts
+
+import (
+	"fmt"
+	"strings"
+)
+
+// VoiceSettings は話者ごとの音声合成パラメータを表す
+//
+// nil のフィールドはプロバイダのデフォルト値を使用する
+type VoiceSettings struct {
+	SpeakingRate    *float64 // 読み上げ速度（1.0 が標準）
+	Pitch           *float64 // 声の高さ（半音単位、0 が標準）
+	Stability       *float64 // 安定性（ElevenLabs のみ、0〜1）
+	SimilarityBoost *float64 // 類似度ブースト（ElevenLabs のみ、0〜1）
+	Style           *float64 // スタイル誇張度（ElevenLabs のみ、0〜1）
+}
+
+// voiceSettingRange は音声合成パラメータの許容範囲を表す
+type voiceSettingRange struct {
+	min float64
+	max float64
+}
+
+// voiceSettingRanges はプロバイダごとに対応しているパラメータと許容範囲
+//
+// 含まれていないパラメータはそのプロバイダでは指定できない
+var voiceSettingRanges = map[Provider]map[string]voiceSettingRange{
+	ProviderGoogle: {
+		"speakingRate": {min: 0.5, max: 2.0},
+		"pitch":        {min: -10, max: 10},
+	},
+	ProviderElevenLabs: {
+		"speakingRate":    {min: 0.7, max: 1.2},
+		"stability":       {min: 0, max: 1},
+		"similarityBoost": {min: 0, max: 1},
+		"style":           {min: 0, max: 1},
+	},
+}
+
+// ValidateVoiceSettings は音声合成パラメータがプロバイダで対応している範囲内かを検証する
+func ValidateVoiceSettings(provider Provider, settings VoiceSettings) error {
+	ranges := voiceSettingRanges[provider]
+
+	fields := []struct {
+		name  string
+		value *float64
+	}{
+		{"speakingRate", settings.SpeakingRate},
+		{"pitch", settings.Pitch},
+		{"stability", settings.Stability},
+		{"similarityBoost", settings.SimilarityBoost},
+		{"style", settings.Style},
+	}
+
+	var errs []string
+	for _, f := range fields {
+		if f.value == nil {
+			continue
+		}
+
+		r, ok := ranges[f.name]
+		if !ok {
+			errs = append(errs, fmt.Sprintf("%s は %s のボイスでは指定できません", f.name, provider))
+			continue
+		}
+		if *f.value < r.min || *f.value > r.max {
+			errs = append(errs, fmt.Sprintf("%s は %g 〜 %g の範囲で指定してください", f.name, r.min, r.max))
+		}
+	}
+
+	if len(errs) > 0 {
+		return fmt.Errorf("%s", strings.Join(errs, "、"))
+	}
+
+	return nil
+}
@@ -0,0 +1,98 @@
+package tts
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func float64Ptr(v float64) *float64 {
+	return &v
+}
+
+func TestValidateVoiceSettings(t *testing.T) {
+	t.Run("未指定の場合はエラーにならない", func(t *testing.T) {
+		assert.NoError(t, ValidateVoiceSettings(ProviderGoogle, VoiceSettings{}))
+		assert.NoError(t, ValidateVoiceSettings(ProviderElevenLabs, VoiceSettings{}))
+	})
+
+	t.Run("範囲内の値は許可する", func(t *testing.T) {
+		assert.NoError(t, ValidateVoiceSettings(ProviderGoogle, VoiceSettings{
+			SpeakingRate: float64Ptr(0.8),
+			Pitch:        float64Ptr(-2),
+		}))
+		assert.NoError(t, ValidateVoiceSettings(ProviderElevenLabs, VoiceSettings{
+			SpeakingRate:    float64Ptr(1.0),
+			Stability:       float64Ptr(0.3),
+			SimilarityBoost: float64Ptr(0.9),
+			Style:           float64Ptr(0),
+		}))
+	})
+
+	t.Run("範囲外の値はエラーを返す", func(t *testing.T) {
+		err := ValidateVoiceSettings(ProviderElevenLabs, VoiceSettings{SpeakingRate: float64Ptr(1.5)})
+
+		assert.EqualError(t, err, "speakingRate は 0.7 〜 1.2 の範囲で指定してください")
+	})
+
+	t.Run("プロバイダが対応していないパラメータはエラーを返す", func(t *testing.T) {
+		err := ValidateVoiceSettings(ProviderGoogle, VoiceSettings{Stability: float64Ptr(0.5)})
+
+		assert.EqualError(t, err, "stability は google のボイスでは指定できません")
+	})
+
+	t.Run("未知のプロバイダはパラメータを指定した場合のみエラーを返す", func(t *testing.T) {
+		assert.NoError(t, ValidateVoiceSettings(Provider("unknown"), VoiceSettings{}))
+		assert.Error(t, ValidateVoiceSettings(Provider("unknown"), VoiceSettings{SpeakingRate: float64Ptr(1.0)}))
+	})
+}
+
+func TestGeminiVoiceSettingsInstruction(t *testing.T) {
+	t.Run("指定がない場合は空文字を返す", func(t *testing.T) {
+		assert.Empty(t, geminiVoiceSettingsInstruction(nil))
+		assert.Empty(t, geminiVoiceSettingsInstruction(&VoiceSettings{SpeakingRate: float64Ptr(1.0), Pitch: float64Ptr(0)}))
+	})
+
+	t.Run("速度とピッチを指示文に変換する", func(t *testing.T) {
+		instruction := geminiVoiceSettingsInstruction(&VoiceSettings{SpeakingRate: float64Ptr(0.8), Pitch: float64Ptr(-2)})
+
+		assert.Equal(t, "通常の 0.8 倍の速さで話してください。通常より 2 半音低い声で話してください。", instruction)
+	})
+}
+
+func TestNewGeminiSingleSpeakerConfig(t *testing.T) {
+	t.Run("指定がない場合はシステム指示を設定しない", func(t *testing.T) {
+		config := newGeminiSingleSpeakerConfig("Kore", nil)
+
+		assert.Nil(t, config.SystemInstruction)
+		assert.Equal(t, "Kore", config.SpeechConfig.VoiceConfig.PrebuiltVoiceConfig.VoiceName)
+	})
+
+	t.Run("速度とピッチの指示をシステム指示として設定する", func(t *testing.T) {
+		config := newGeminiSingleSpeakerConfig("Kore", &VoiceSettings{SpeakingRate: float64Ptr(1.2)})
+
+		require.NotNil(t, config.SystemInstruction)
+		require.Len(t, config.SystemInstruction.Parts, 1)
+		assert.Equal(t, "通常の 1.2 倍の速さで話してください。", config.SystemInstruction.Parts[0].Text)
+	})
+}
+
+func TestNewElevenLabsVoiceSettings(t *testing.T) {
+	t.Run("指定がない場合はデフォルト値を使用する", func(t *testing.T) {
+		vs := newElevenLabsVoiceSettings(nil)
+
+		assert.Equal(t, elevenLabsDefaultStability, vs.Stability)
+		assert.Equal(t, elevenLabsDefaultSimilarity, vs.SimilarityBoost)
+		assert.Equal(t, elevenLabsDefaultStyle, vs.Style)
+		assert.Equal(t, elevenLabsDefaultSpeed, vs.Speed)
+	})
+
+	t.Run("指定したパラメータのみ上書きする", func(t *testing.T) {
+		vs := newElevenLabsVoiceSettings(&VoiceSettings{SpeakingRate: float64Ptr(0.9), Stability: float64Ptr(0.2)})
+
+		assert.Equal(t, 0.2, vs.Stability)
+		assert.Equal(t, 0.9, vs.Speed)
+		assert.Equal(t, elevenLabsDefaultSimilarity, vs.SimilarityBoost)
+	})
+}
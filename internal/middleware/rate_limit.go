@@ -0,0 +1,40 @@
+package middleware
+
+import (
+	"math"
+	"strconv"
+
+	"github.com/gin-gonic/gin"
+
+	"github.com/siropaca/anycast-backend/internal/apperror"
+	"github.com/siropaca/anycast-backend/internal/pkg/logger"
+	"github.com/siropaca/anycast-backend/internal/pkg/ratelimit"
+)
+
+// RateLimitByUser はユーザーごとにリクエスト数を制限するミドルウェア
+// Auth ミドルウェアの後に使用する必要がある
+func RateLimitByUser(limiter *ratelimit.Limiter) gin.HandlerFunc {
+	return func(c *gin.Context) {
+		userID, exists := GetUserID(c)
+		if !exists {
+			c.Next()
+			return
+		}
+
+		allowed, retryAfter := limiter.Allow(userID)
+		if !allowed {
+			logger.FromContext(c.Request.Context()).Warn("rate limit exceeded", "user_id", userID, "path", c.FullPath())
+
+			c.Header("Retry-After", strconv.Itoa(int(math.Ceil(retryAfter.Seconds()))))
+			c.AbortWithStatusJSON(apperror.ErrRateLimited.HTTPStatus, gin.H{
+				"error": gin.H{
+					"code":    apperror.ErrRateLimited.Code,
+					"message": apperror.ErrRateLimited.Message,
+				},
+			})
+			return
+		}
+
+		c.Next()
+	}
+}
@@ -0,0 +1,57 @@
+package middleware
+
+import (
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"github.com/gin-gonic/gin"
+	"github.com/stretchr/testify/assert"
+
+	"github.com/siropaca/anycast-backend/internal/pkg/ratelimit"
+)
+
+func setupRateLimitRouter(limiter *ratelimit.Limiter, userID string) *gin.Engine {
+	gin.SetMode(gin.TestMode)
+	r := gin.New()
+	r.Use(func(c *gin.Context) {
+		if userID != "" {
+			c.Set(string(UserIDKey), userID)
+		}
+		c.Next()
+	})
+	r.Use(RateLimitByUser(limiter))
+	r.POST("/test", func(c *gin.Context) {
+		c.JSON(http.StatusOK, gin.H{"ok": true})
+	})
+	return r
+}
+
+func TestRateLimitByUser(t *testing.T) {
+	t.Run("上限を超えた場合は 429 と Retry-After を返す", func(t *testing.T) {
+		router := setupRateLimitRouter(ratelimit.New(1, time.Minute), "user-123")
+
+		rec := httptest.NewRecorder()
+		router.ServeHTTP(rec, httptest.NewRequest(http.MethodPost, "/test", http.NoBody))
+		assert.Equal(t, http.StatusOK, rec.Code)
+
+		rec = httptest.NewRecorder()
+		router.ServeHTTP(rec, httptest.NewRequest(http.MethodPost, "/test", http.NoBody))
+		assert.Equal(t, http.StatusTooManyRequests, rec.Code)
+		assert.Contains(t, rec.Body.String(), "RATE_LIMITED")
+		assert.NotEmpty(t, rec.Header().Get("Retry-After"))
+	})
+
+	t.Run("ユーザーごとに制限する", func(t *testing.T) {
+		limiter := ratelimit.New(1, time.Minute)
+
+		rec := httptest.NewRecorder()
+		setupRateLimitRouter(limiter, "user-1").ServeHTTP(rec, httptest.NewRequest(http.MethodPost, "/test", http.NoBody))
+		assert.Equal(t, http.StatusOK, rec.Code)
+
+		rec = httptest.NewRecorder()
+		setupRateLimitRouter(limiter, "user-2").ServeHTTP(rec, httptest.NewRequest(http.MethodPost, "/test", http.NoBody))
+		assert.Equal(t, http.StatusOK, rec.Code)
+	})
+}
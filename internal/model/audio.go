@@ -1,6 +1,9 @@
 package model
 
 import (
+	"database/sql/driver"
+	"encoding/json"
+	"errors"
 	"time"
 
 	"github.com/siropaca/anycast-backend/internal/pkg/uuid"
@@ -8,11 +11,124 @@ import (
 
 // Audio は音声ファイル情報を表す
 type Audio struct {
-	ID         uuid.UUID `gorm:"type:uuid;primaryKey;default:gen_random_uuid()"`
-	MimeType   string    `gorm:"type:varchar(100);not null;column:mime_type"`
-	Path       string    `gorm:"type:varchar(1024);not null"`
-	Filename   string    `gorm:"type:varchar(255);not null"`
-	FileSize   int       `gorm:"not null;column:file_size"`
-	DurationMs int       `gorm:"not null;column:duration_ms"`
-	CreatedAt  time.Time `gorm:"not null;default:CURRENT_TIMESTAMP"`
+	ID         uuid.UUID      `gorm:"type:uuid;primaryKey;default:gen_random_uuid()"`
+	MimeType   string         `gorm:"type:varchar(100);not null;column:mime_type"`
+	Path       string         `gorm:"type:varchar(1024);not null"`
+	Filename   string         `gorm:"type:varchar(255);not null"`
+	FileSize   int            `gorm:"not null;column:file_size"`
+	DurationMs int            `gorm:"not null;column:duration_ms"`
+	Waveforms  AudioWaveforms `gorm:"type:jsonb;column:waveforms"`
+	// LineTimings は音声内での台本行の再生区間（行ごとに合成した場合のみ記録される）
+	LineTimings AudioLineTimings `gorm:"type:jsonb;column:line_timings"`
+	CreatedAt   time.Time        `gorm:"not null;default:CURRENT_TIMESTAMP"`
+}
+
+// AudioWaveform は audiowaveform（BBC）の JSON 形式（version 2）と互換の波形ピークデータを表す
+//
+// Data は [min0, max0, min1, max1, ...] の順に並ぶ
+type AudioWaveform struct {
+	Version         int   `json:"version"`
+	Channels        int   `json:"channels"`
+	SampleRate      int   `json:"sample_rate"`
+	SamplesPerPixel int   `json:"samples_per_pixel"`
+	Bits            int   `json:"bits"`
+	Length          int   `json:"length"`
+	Data            []int `json:"data"`
+}
+
+// AudioWaveforms は解像度別の波形ピークデータ（粗い順）を表す
+type AudioWaveforms []AudioWaveform
+
+// Value は driver.Valuer を実装する
+func (w AudioWaveforms) Value() (driver.Value, error) {
+	if w == nil {
+		return nil, nil
+	}
+	return json.Marshal(w)
+}
+
+// Scan は sql.Scanner を実装する
+func (w *AudioWaveforms) Scan(value any) error {
+	if value == nil {
+		*w = nil
+		return nil
+	}
+
+	var data []byte
+	switch v := value.(type) {
+	case []byte:
+		data = v
+	case string:
+		data = []byte(v)
+	default:
+		return errors.New("unsupported type for AudioWaveforms")
+	}
+
+	return json.Unmarshal(data, w)
+}
+
+// AudioLineTiming は音声内での台本行の再生区間 (ms) を表す
+type AudioLineTiming struct {
+	ScriptLineID uuid.UUID `json:"scriptLineId"`
+	StartMs      int       `json:"startMs"`
+	EndMs        int       `json:"endMs"`
+}
+
+// AudioLineTimings は台本の順に並んだ台本行の再生区間を表す
+type AudioLineTimings []AudioLineTiming
+
+// Find は台本行の再生区間を返す（記録されていない場合は false）
+func (t AudioLineTimings) Find(scriptLineID uuid.UUID) (AudioLineTiming, bool) {
+	for _, timing := range t {
+		if timing.ScriptLineID == scriptLineID {
+			return timing, true
+		}
+	}
+	return AudioLineTiming{}, false
+}
+
+// Shift は再生区間を offsetMs だけ後ろにずらしたコピーを返す
+//
+// ボイス音声の前にイントロや余白を付けた音声の再生区間を求めるために使う
+func (t AudioLineTimings) Shift(offsetMs int) AudioLineTimings {
+	if t == nil {
+		return nil
+	}
+	shifted := make(AudioLineTimings, len(t))
+	for i, timing := range t {
+		shifted[i] = AudioLineTiming{
+			ScriptLineID: timing.ScriptLineID,
+			StartMs:      timing.StartMs + offsetMs,
+			EndMs:        timing.EndMs + offsetMs,
+		}
+	}
+	return shifted
+}
+
+// Value は driver.Valuer を実装する
+func (t AudioLineTimings) Value() (driver.Value, error) {
+	if t == nil {
+		return nil, nil
+	}
+	return json.Marshal(t)
+}
+
+// Scan は sql.Scanner を実装する
+func (t *AudioLineTimings) Scan(value any) error {
+	if value == nil {
+		*t = nil
+		return nil
+	}
+
+	var data []byte
+	switch v := value.(type) {
+	case []byte:
+		data = v
+	case string:
+		data = []byte(v)
+	default:
+		return errors.New("unsupported type for AudioLineTimings")
+	}
+
+	return json.Unmarshal(data, t)
 }
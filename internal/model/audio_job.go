@@ -1,6 +1,9 @@
 package model
 
 import (
+	"database/sql/driver"
+	"encoding/json"
+	"errors"
 	"time"
 
 	"github.com/siropaca/anycast-backend/internal/pkg/uuid"
@@ -25,8 +28,18 @@ const (
 	AudioJobTypeVoice AudioJobType = "voice"
 	AudioJobTypeFull  AudioJobType = "full"
 	AudioJobTypeRemix AudioJobType = "remix"
+	// AudioJobTypeRenditions はアップロードした音声から配信用音声を生成し直すジョブ（API からは作成できない）
+	AudioJobTypeRenditions AudioJobType = "renditions"
+	// AudioJobTypeRetag はエピソード情報の変更を既存の MP3 の ID3 タグに反映するジョブ（API からは作成できない）
+	AudioJobTypeRetag AudioJobType = "retag"
 )
 
+// UserAudioJobTypes は API から作成できる音声生成ジョブの種別
+//
+// 配信用音声の生成・ID3 タグの書き換えはシステムが内部で作成するジョブのため、
+// ユーザーのジョブ一覧や、音声生成・アップロード前の処理中ジョブの確認の対象にしない
+var UserAudioJobTypes = []AudioJobType{AudioJobTypeVoice, AudioJobTypeFull, AudioJobTypeRemix}
+
 // AudioJob は非同期音声生成ジョブを表す
 type AudioJob struct {
 	ID        uuid.UUID      `gorm:"type:uuid;primaryKey;default:gen_random_uuid()"`
@@ -41,15 +54,32 @@ type AudioJob struct {
 	SystemBgmID *uuid.UUID `gorm:"type:uuid;column:system_bgm_id"`
 
 	// BGM ミキシング設定
-	BgmVolumeDB    float64 `gorm:"type:decimal(5,2);not null;default:-25.0;column:bgm_volume_db"`
-	FadeOutMs      int     `gorm:"not null;default:3000;column:fade_out_ms"`
-	PaddingStartMs int     `gorm:"not null;default:1000;column:padding_start_ms"`
-	PaddingEndMs   int     `gorm:"not null;default:3000;column:padding_end_ms"`
+	BgmVolumeDB    float64 `gorm:"type:decimal(5,2);not null;column:bgm_volume_db"`
+	FadeOutMs      int     `gorm:"not null;column:fade_out_ms"`
+	PaddingStartMs int     `gorm:"not null;column:padding_start_ms"`
+	PaddingEndMs   int     `gorm:"not null;column:padding_end_ms"`
+
+	// BGM ダッキング設定（BgmDucking が false の場合は BgmVolumeDB の固定音量でミキシング）
+	BgmDucking         bool    `gorm:"not null;default:false;column:bgm_ducking"`
+	DuckingThresholdDB float64 `gorm:"type:decimal(5,2);not null;column:ducking_threshold_db"`
+	DuckingRatio       float64 `gorm:"type:decimal(4,2);not null;column:ducking_ratio"`
+	DuckingAttackMs    int     `gorm:"not null;column:ducking_attack_ms"`
+	DuckingReleaseMs   int     `gorm:"not null;column:ducking_release_ms"`
+	IntroSwellMs       int     `gorm:"not null;column:intro_swell_ms"`
+
+	// HLS 出力（true の場合は FullAudio から HLS パッケージを生成する）
+	HLSEnabled bool `gorm:"not null;default:false;column:hls_enabled"`
+
+	// 発音 QA 設定（QAEnabled が true の場合は STT で各行の一致度をチェックし、閾値未満の行を再合成する）
+	QAEnabled             bool    `gorm:"not null;default:false;column:qa_enabled"`
+	QASimilarityThreshold float64 `gorm:"type:decimal(3,2);not null;column:qa_similarity_threshold"`
+	QAMaxRetries          int     `gorm:"not null;column:qa_max_retries"`
 
 	// 結果
-	ResultAudioID *uuid.UUID `gorm:"type:uuid;column:result_audio_id"`
-	ErrorMessage  *string    `gorm:"type:text;column:error_message"`
-	ErrorCode     *string    `gorm:"type:varchar(50);column:error_code"`
+	ResultAudioID *uuid.UUID     `gorm:"type:uuid;column:result_audio_id"`
+	ErrorMessage  *string        `gorm:"type:text;column:error_message"`
+	ErrorCode     *string        `gorm:"type:varchar(50);column:error_code"`
+	QAReport      *AudioQAReport `gorm:"type:jsonb;column:qa_report"`
 
 	// タイムスタンプ
 	StartedAt   *time.Time `gorm:"column:started_at"`
@@ -64,3 +94,45 @@ type AudioJob struct {
 	SystemBgm   *SystemBgm `gorm:"foreignKey:SystemBgmID"`
 	ResultAudio *Audio     `gorm:"foreignKey:ResultAudioID"`
 }
+
+// AudioQAReport は音声合成後の発音 QA の結果を表す
+type AudioQAReport struct {
+	Threshold          float64              `json:"threshold"`
+	CheckedLines       int                  `json:"checked_lines"`
+	ResynthesizedLines int                  `json:"resynthesized_lines"`
+	FlaggedLines       []AudioQAFlaggedLine `json:"flagged_lines"`
+}
+
+// AudioQAFlaggedLine は再合成しても一致度が閾値に届かなかった台本行を表す
+type AudioQAFlaggedLine struct {
+	ScriptLineID uuid.UUID `json:"script_line_id"`
+	Text         string    `json:"text"`
+	Transcript   string    `json:"transcript"`
+	Similarity   float64   `json:"similarity"`
+	Attempts     int       `json:"attempts"`
+}
+
+// Value は driver.Valuer を実装する
+func (r AudioQAReport) Value() (driver.Value, error) {
+	return json.Marshal(r)
+}
+
+// Scan は sql.Scanner を実装する
+func (r *AudioQAReport) Scan(value any) error {
+	if value == nil {
+		*r = AudioQAReport{}
+		return nil
+	}
+
+	var data []byte
+	switch v := value.(type) {
+	case []byte:
+		data = v
+	case string:
+		data = []byte(v)
+	default:
+		return errors.New("unsupported type for AudioQAReport")
+	}
+
+	return json.Unmarshal(data, r)
+}
@@ -17,6 +17,11 @@ type Channel struct {
 	ArtworkID          *uuid.UUID `gorm:"type:uuid;column:artwork_id"`
 	DefaultBgmID       *uuid.UUID `gorm:"type:uuid;column:default_bgm_id"`
 	DefaultSystemBgmID *uuid.UUID `gorm:"type:uuid;column:default_system_bgm_id"`
+	IntroAudioID       *uuid.UUID `gorm:"type:uuid;column:intro_audio_id"`
+	IntroCrossfadeMs   int        `gorm:"not null;default:0;column:intro_crossfade_ms"`
+	OutroAudioID       *uuid.UUID `gorm:"type:uuid;column:outro_audio_id"`
+	OutroCrossfadeMs   int        `gorm:"not null;default:0;column:outro_crossfade_ms"`
+	Explicit           bool       `gorm:"not null;default:false"`
 	PublishedAt        *time.Time `gorm:"column:published_at"`
 	CreatedAt          time.Time  `gorm:"not null;default:CURRENT_TIMESTAMP"`
 	UpdatedAt          time.Time  `gorm:"not null;default:CURRENT_TIMESTAMP"`
@@ -27,5 +32,7 @@ type Channel struct {
 	Artwork           *Image             `gorm:"foreignKey:ArtworkID"`
 	DefaultBgm        *Bgm               `gorm:"foreignKey:DefaultBgmID"`
 	DefaultSystemBgm  *SystemBgm         `gorm:"foreignKey:DefaultSystemBgmID"`
+	IntroAudio        *Audio             `gorm:"foreignKey:IntroAudioID"`
+	OutroAudio        *Audio             `gorm:"foreignKey:OutroAudioID"`
 	ChannelCharacters []ChannelCharacter `gorm:"foreignKey:ChannelID"`
 }
@@ -1,6 +1,9 @@
 package model
 
 import (
+	"database/sql/driver"
+	"encoding/json"
+	"errors"
 	"time"
 
 	"github.com/siropaca/anycast-backend/internal/pkg/uuid"
@@ -8,17 +11,59 @@ import (
 
 // Character はキャラクター情報を表す
 type Character struct {
-	ID        uuid.UUID  `gorm:"type:uuid;primaryKey;default:gen_random_uuid()"`
-	UserID    uuid.UUID  `gorm:"type:uuid;not null;column:user_id"`
-	Name      string     `gorm:"type:varchar(255);not null"`
-	Persona   string     `gorm:"type:text;not null"`
-	AvatarID  *uuid.UUID `gorm:"type:uuid;column:avatar_id"`
-	VoiceID   uuid.UUID  `gorm:"type:uuid;not null;column:voice_id"`
-	CreatedAt time.Time  `gorm:"not null;default:CURRENT_TIMESTAMP"`
-	UpdatedAt time.Time  `gorm:"not null;default:CURRENT_TIMESTAMP"`
+	ID            uuid.UUID     `gorm:"type:uuid;primaryKey;default:gen_random_uuid()"`
+	UserID        uuid.UUID     `gorm:"type:uuid;not null;column:user_id"`
+	Name          string        `gorm:"type:varchar(255);not null"`
+	Persona       string        `gorm:"type:text;not null"`
+	AvatarID      *uuid.UUID    `gorm:"type:uuid;column:avatar_id"`
+	VoiceID       uuid.UUID     `gorm:"type:uuid;not null;column:voice_id"`
+	VoiceSettings VoiceSettings `gorm:"type:jsonb;not null;default:'{}';column:voice_settings"`
+	CreatedAt     time.Time     `gorm:"not null;default:CURRENT_TIMESTAMP"`
+	UpdatedAt     time.Time     `gorm:"not null;default:CURRENT_TIMESTAMP"`
 
 	// リレーション
 	Avatar            *Image             `gorm:"foreignKey:AvatarID"`
 	Voice             Voice              `gorm:"foreignKey:VoiceID"`
 	ChannelCharacters []ChannelCharacter `gorm:"foreignKey:CharacterID"`
 }
+
+// VoiceSettings はキャラクターごとの音声合成パラメータを表す
+//
+// nil のフィールドは TTS プロバイダのデフォルト値を使用する
+type VoiceSettings struct {
+	SpeakingRate    *float64 `json:"speakingRate,omitempty"`
+	Pitch           *float64 `json:"pitch,omitempty"`
+	Stability       *float64 `json:"stability,omitempty"`
+	SimilarityBoost *float64 `json:"similarityBoost,omitempty"`
+	Style           *float64 `json:"style,omitempty"`
+}
+
+// IsEmpty はパラメータが 1 つも指定されていないかを返す
+func (v VoiceSettings) IsEmpty() bool {
+	return v.SpeakingRate == nil && v.Pitch == nil && v.Stability == nil && v.SimilarityBoost == nil && v.Style == nil
+}
+
+// Value は driver.Valuer を実装する
+func (v VoiceSettings) Value() (driver.Value, error) {
+	return json.Marshal(v)
+}
+
+// Scan は sql.Scanner を実装する
+func (v *VoiceSettings) Scan(value any) error {
+	if value == nil {
+		*v = VoiceSettings{}
+		return nil
+	}
+
+	var data []byte
+	switch val := value.(type) {
+	case []byte:
+		data = val
+	case string:
+		data = []byte(val)
+	default:
+		return errors.New("unsupported type for VoiceSettings")
+	}
+
+	return json.Unmarshal(data, v)
+}
@@ -0,0 +1,76 @@
+package model
+
+import (
+	"time"
+
+	"github.com/siropaca/anycast-backend/internal/pkg/uuid"
+)
+
+// ClipJobStatus はクリップ動画生成ジョブのステータスを表す
+type ClipJobStatus string
+
+const (
+	ClipJobStatusPending    ClipJobStatus = "pending"
+	ClipJobStatusProcessing ClipJobStatus = "processing"
+	ClipJobStatusCompleted  ClipJobStatus = "completed"
+	ClipJobStatusFailed     ClipJobStatus = "failed"
+)
+
+// ClipAspectRatio はクリップ動画のアスペクト比を表す
+type ClipAspectRatio string
+
+const (
+	ClipAspectRatioSquare    ClipAspectRatio = "square"
+	ClipAspectRatioPortrait  ClipAspectRatio = "portrait"
+	ClipAspectRatioLandscape ClipAspectRatio = "landscape"
+)
+
+// Size はアスペクト比に対応する動画の解像度（幅・高さ）を返す
+func (a ClipAspectRatio) Size() (width, height int) {
+	switch a {
+	case ClipAspectRatioPortrait:
+		return 1080, 1920
+	case ClipAspectRatioLandscape:
+		return 1920, 1080
+	default:
+		return 1080, 1080
+	}
+}
+
+// ClipJob はエピソードの一部からソーシャルメディア用のクリップ動画（オーディオグラム）を生成する非同期ジョブを表す
+type ClipJob struct {
+	ID        uuid.UUID `gorm:"type:uuid;primaryKey;default:gen_random_uuid()"`
+	UserID    uuid.UUID `gorm:"type:uuid;not null;column:user_id"`
+	EpisodeID uuid.UUID `gorm:"type:uuid;not null;column:episode_id"`
+	// 切り出し元の音声（ジョブ作成時のエピソードの結合済み音声。孤児メディアとして削除された場合は nil）
+	AudioID *uuid.UUID `gorm:"type:uuid;column:audio_id"`
+
+	// 切り出す範囲（台本行で指定した場合は再生区間から求めた値）
+	StartMs           int        `gorm:"not null;column:start_ms"`
+	EndMs             int        `gorm:"not null;column:end_ms"`
+	StartScriptLineID *uuid.UUID `gorm:"type:uuid;column:start_script_line_id"`
+	EndScriptLineID   *uuid.UUID `gorm:"type:uuid;column:end_script_line_id"`
+
+	// 動画の設定
+	AspectRatio ClipAspectRatio `gorm:"type:clip_aspect_ratio;not null;default:'square';column:aspect_ratio"`
+	Captions    bool            `gorm:"not null"`
+
+	Status   ClipJobStatus `gorm:"type:clip_job_status;not null;default:'pending'"`
+	Progress int           `gorm:"not null;default:0"`
+
+	// 結果
+	ResultVideoID *uuid.UUID `gorm:"type:uuid;column:result_video_id"`
+	ErrorMessage  *string    `gorm:"type:text;column:error_message"`
+	ErrorCode     *string    `gorm:"type:varchar(50);column:error_code"`
+
+	// タイムスタンプ
+	StartedAt   *time.Time `gorm:"column:started_at"`
+	CompletedAt *time.Time `gorm:"column:completed_at"`
+	CreatedAt   time.Time  `gorm:"not null;default:CURRENT_TIMESTAMP"`
+	UpdatedAt   time.Time  `gorm:"not null;default:CURRENT_TIMESTAMP"`
+
+	// リレーション
+	Episode     Episode `gorm:"foreignKey:EpisodeID"`
+	Audio       *Audio  `gorm:"foreignKey:AudioID"`
+	ResultVideo *Video  `gorm:"foreignKey:ResultVideoID"`
+}
@@ -18,6 +18,7 @@ type Episode struct {
 	VoiceAudioID *uuid.UUID `gorm:"type:uuid;column:voice_audio_id"`
 	FullAudioID  *uuid.UUID `gorm:"type:uuid;column:full_audio_id"`
 	PlayCount    int        `gorm:"not null;default:0;column:play_count"`
+	SourceGUID   *string    `gorm:"type:varchar(512);column:source_guid"` // 外部フィードから取り込んだ item の GUID
 	PublishedAt  *time.Time `gorm:"column:published_at"`
 	CreatedAt    time.Time  `gorm:"not null;default:CURRENT_TIMESTAMP"`
 	UpdatedAt    time.Time  `gorm:"not null;default:CURRENT_TIMESTAMP"`
@@ -29,4 +30,10 @@ type Episode struct {
 	SystemBgm  *SystemBgm `gorm:"foreignKey:SystemBgmID"`
 	VoiceAudio *Audio     `gorm:"foreignKey:VoiceAudioID"`
 	FullAudio  *Audio     `gorm:"foreignKey:FullAudioID"`
+
+	// AudioRenditions は FullAudio から生成した配信用音声（Update 時は保存対象外）
+	AudioRenditions []EpisodeAudioRendition `gorm:"foreignKey:EpisodeID"`
+
+	// HLSPackage は FullAudio から生成した HLS パッケージ（Update 時は保存対象外）
+	HLSPackage *EpisodeHLSPackage `gorm:"foreignKey:EpisodeID"`
 }
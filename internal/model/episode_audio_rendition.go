@@ -0,0 +1,67 @@
+package model
+
+import (
+	"time"
+
+	"github.com/siropaca/anycast-backend/internal/pkg/uuid"
+)
+
+// AudioFormat は配信用音声のフォーマットを表す
+type AudioFormat string
+
+const (
+	AudioFormatMP3  AudioFormat = "mp3"
+	AudioFormatAAC  AudioFormat = "aac"
+	AudioFormatOpus AudioFormat = "opus"
+)
+
+// MimeType はフォーマットに対応する MIME タイプを返す
+func (f AudioFormat) MimeType() string {
+	switch f {
+	case AudioFormatMP3:
+		return "audio/mpeg"
+	case AudioFormatAAC:
+		return "audio/mp4"
+	case AudioFormatOpus:
+		return "audio/ogg"
+	default:
+		return "application/octet-stream"
+	}
+}
+
+// Extension はフォーマットに対応するファイル拡張子を返す
+func (f AudioFormat) Extension() string {
+	switch f {
+	case AudioFormatMP3:
+		return ".mp3"
+	case AudioFormatAAC:
+		return ".m4a"
+	case AudioFormatOpus:
+		return ".opus"
+	default:
+		return ""
+	}
+}
+
+// IsValid は対応しているフォーマットかどうかを判定する
+func (f AudioFormat) IsValid() bool {
+	switch f {
+	case AudioFormatMP3, AudioFormatAAC, AudioFormatOpus:
+		return true
+	default:
+		return false
+	}
+}
+
+// EpisodeAudioRendition はエピソードの配信用音声（フォーマット・ビットレート別）を表す
+type EpisodeAudioRendition struct {
+	ID          uuid.UUID   `gorm:"type:uuid;primaryKey;default:gen_random_uuid()"`
+	EpisodeID   uuid.UUID   `gorm:"type:uuid;not null;column:episode_id"`
+	AudioID     uuid.UUID   `gorm:"type:uuid;not null;column:audio_id"`
+	Format      AudioFormat `gorm:"type:audio_format;not null"`
+	BitrateKbps int         `gorm:"not null;column:bitrate_kbps"`
+	CreatedAt   time.Time   `gorm:"not null;default:CURRENT_TIMESTAMP"`
+
+	// リレーション
+	Audio Audio `gorm:"foreignKey:AudioID"`
+}
@@ -0,0 +1,56 @@
+package model
+
+import (
+	"fmt"
+	"time"
+
+	"github.com/siropaca/anycast-backend/internal/pkg/uuid"
+)
+
+// HLSPlaylistFilename は HLS プレイリストのファイル名
+const HLSPlaylistFilename = "playlist.m3u8"
+
+// EpisodeHLSPackage はエピソードの HLS パッケージ（セグメント化した AAC + m3u8 プレイリスト）を表す
+//
+// プレイリストとセグメントは PathPrefix 配下に保存し、プレイリスト内のセグメント URI はファイル名のみの相対パスとする
+type EpisodeHLSPackage struct {
+	ID                 uuid.UUID `gorm:"type:uuid;primaryKey;default:gen_random_uuid()"`
+	EpisodeID          uuid.UUID `gorm:"type:uuid;not null;column:episode_id"`
+	SourceAudioID      uuid.UUID `gorm:"type:uuid;not null;column:source_audio_id"`
+	PathPrefix         string    `gorm:"type:varchar(1024);not null;column:path_prefix"`
+	SegmentCount       int       `gorm:"not null;column:segment_count"`
+	SegmentDurationSec int       `gorm:"not null;column:segment_duration_sec"`
+	BitrateKbps        int       `gorm:"not null;column:bitrate_kbps"`
+	DurationMs         int       `gorm:"not null;column:duration_ms"`
+	CreatedAt          time.Time `gorm:"not null;default:CURRENT_TIMESTAMP"`
+}
+
+// TableName はテーブル名を返す
+func (EpisodeHLSPackage) TableName() string {
+	return "episode_hls_packages"
+}
+
+// PlaylistPath はプレイリストのストレージパスを返す
+func (p *EpisodeHLSPackage) PlaylistPath() string {
+	return p.PathPrefix + "/" + HLSPlaylistFilename
+}
+
+// SegmentPath はセグメントファイル名からストレージパスを返す
+func (p *EpisodeHLSPackage) SegmentPath(name string) string {
+	return p.PathPrefix + "/" + name
+}
+
+// HLSSegmentName は指定したインデックスのセグメントファイル名を返す
+func HLSSegmentName(index int) string {
+	return fmt.Sprintf("segment_%05d.ts", index)
+}
+
+// FilePaths はプレイリストと全セグメントのストレージパスを返す
+func (p *EpisodeHLSPackage) FilePaths() []string {
+	paths := make([]string, 0, p.SegmentCount+1)
+	paths = append(paths, p.PlaylistPath())
+	for i := 0; i < p.SegmentCount; i++ {
+		paths = append(paths, p.SegmentPath(HLSSegmentName(i)))
+	}
+	return paths
+}
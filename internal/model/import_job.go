@@ -0,0 +1,45 @@
+package model
+
+import (
+	"time"
+
+	"github.com/siropaca/anycast-backend/internal/pkg/uuid"
+)
+
+// ImportJobStatus はポッドキャスト取り込みジョブのステータスを表す
+type ImportJobStatus string
+
+const (
+	ImportJobStatusPending    ImportJobStatus = "pending"
+	ImportJobStatusProcessing ImportJobStatus = "processing"
+	ImportJobStatusCompleted  ImportJobStatus = "completed"
+	ImportJobStatusFailed     ImportJobStatus = "failed"
+)
+
+// ImportJob は外部 RSS / Atom フィードからエピソードを取り込む非同期ジョブを表す
+type ImportJob struct {
+	ID        uuid.UUID       `gorm:"type:uuid;primaryKey;default:gen_random_uuid()"`
+	UserID    uuid.UUID       `gorm:"type:uuid;not null;column:user_id"`
+	ChannelID uuid.UUID       `gorm:"type:uuid;not null;column:channel_id"`
+	FeedURL   string          `gorm:"type:varchar(2048);not null;column:feed_url"`
+	Status    ImportJobStatus `gorm:"type:import_job_status;not null;default:'pending'"`
+	Progress  int             `gorm:"not null;default:0"`
+
+	// 結果
+	TotalEpisodes    int     `gorm:"not null;default:0;column:total_episodes"`
+	ImportedEpisodes int     `gorm:"not null;default:0;column:imported_episodes"`
+	SkippedEpisodes  int     `gorm:"not null;default:0;column:skipped_episodes"`
+	FailedEpisodes   int     `gorm:"not null;default:0;column:failed_episodes"`
+	ErrorMessage     *string `gorm:"type:text;column:error_message"`
+	ErrorCode        *string `gorm:"type:varchar(50);column:error_code"`
+
+	// タイムスタンプ
+	StartedAt   *time.Time `gorm:"column:started_at"`
+	CompletedAt *time.Time `gorm:"column:completed_at"`
+	CreatedAt   time.Time  `gorm:"not null;default:CURRENT_TIMESTAMP"`
+	UpdatedAt   time.Time  `gorm:"not null;default:CURRENT_TIMESTAMP"`
+
+	// リレーション
+	Channel Channel `gorm:"foreignKey:ChannelID"`
+	User    User    `gorm:"foreignKey:UserID"`
+}
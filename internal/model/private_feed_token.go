@@ -0,0 +1,28 @@
+package model
+
+import (
+	"time"
+
+	"github.com/siropaca/anycast-backend/internal/pkg/uuid"
+)
+
+// PrivateFeedToken は限定公開 RSS フィードのトークンを表す
+//
+// トークンを知っていれば未公開エピソードを含むチャンネルのフィードを購読できる
+type PrivateFeedToken struct {
+	ID         uuid.UUID  `gorm:"type:uuid;primaryKey;default:gen_random_uuid()"`
+	UserID     uuid.UUID  `gorm:"type:uuid;not null;index"`
+	User       User       `gorm:"foreignKey:UserID"`
+	ChannelID  uuid.UUID  `gorm:"type:uuid;not null;index"`
+	Channel    Channel    `gorm:"foreignKey:ChannelID"`
+	Token      string     `gorm:"type:varchar(64);not null;uniqueIndex"`
+	ExpiresAt  *time.Time `gorm:"column:expires_at"`
+	LastUsedAt *time.Time `gorm:"column:last_used_at"`
+	CreatedAt  time.Time  `gorm:"not null;default:CURRENT_TIMESTAMP"`
+	UpdatedAt  time.Time  `gorm:"not null;default:CURRENT_TIMESTAMP"`
+}
+
+// IsExpired は指定時刻の時点で有効期限が切れているかどうかを返す
+func (t *PrivateFeedToken) IsExpired(now time.Time) bool {
+	return t.ExpiresAt != nil && !now.Before(*t.ExpiresAt)
+}
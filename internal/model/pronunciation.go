@@ -0,0 +1,20 @@
+package model
+
+import (
+	"time"
+
+	"github.com/siropaca/anycast-backend/internal/pkg/uuid"
+)
+
+// Pronunciation は発音辞書のエントリ（表記 → 読み）を表す
+//
+// ChannelID が nil の場合はユーザー辞書、指定されている場合はチャンネル辞書のエントリ
+type Pronunciation struct {
+	ID        uuid.UUID  `gorm:"type:uuid;primaryKey;default:gen_random_uuid()"`
+	UserID    uuid.UUID  `gorm:"type:uuid;not null;column:user_id"`
+	ChannelID *uuid.UUID `gorm:"type:uuid;column:channel_id"`
+	Surface   string     `gorm:"type:varchar(100);not null"`
+	Reading   string     `gorm:"type:varchar(200);not null"`
+	CreatedAt time.Time  `gorm:"not null;default:CURRENT_TIMESTAMP"`
+	UpdatedAt time.Time  `gorm:"not null;default:CURRENT_TIMESTAMP"`
+}
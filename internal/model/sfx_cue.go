@@ -0,0 +1,46 @@
+package model
+
+import (
+	"time"
+
+	"github.com/siropaca/anycast-backend/internal/pkg/uuid"
+)
+
+// SfxCuePosition は効果音キューを鳴らす位置（台本行の前 / 後）を表す
+type SfxCuePosition string
+
+const (
+	SfxCuePositionBefore SfxCuePosition = "before"
+	SfxCuePositionAfter  SfxCuePosition = "after"
+)
+
+// SfxCue は台本行の前後に鳴らす効果音キューを表す
+//
+// SoundEffectID（ユーザー効果音）と SystemSoundEffectID（システム効果音）はどちらか一方のみ設定する
+type SfxCue struct {
+	ID                  uuid.UUID      `gorm:"type:uuid;primaryKey;default:gen_random_uuid()"`
+	ScriptLineID        uuid.UUID      `gorm:"type:uuid;not null;column:script_line_id"`
+	SoundEffectID       *uuid.UUID     `gorm:"type:uuid;column:sound_effect_id"`
+	SystemSoundEffectID *uuid.UUID     `gorm:"type:uuid;column:system_sound_effect_id"`
+	Position            SfxCuePosition `gorm:"type:sfx_cue_position;not null"`
+	VolumeDB            float64        `gorm:"type:decimal(5,2);not null;default:0.0;column:volume_db"`
+	CreatedAt           time.Time      `gorm:"not null;default:CURRENT_TIMESTAMP"`
+	UpdatedAt           time.Time      `gorm:"not null;default:CURRENT_TIMESTAMP"`
+
+	// リレーション
+	ScriptLine        ScriptLine         `gorm:"foreignKey:ScriptLineID"`
+	SoundEffect       *SoundEffect       `gorm:"foreignKey:SoundEffectID"`
+	SystemSoundEffect *SystemSoundEffect `gorm:"foreignKey:SystemSoundEffectID"`
+}
+
+// Audio は効果音キューが参照する効果音の音声を返す
+func (c *SfxCue) Audio() *Audio {
+	switch {
+	case c.SoundEffect != nil:
+		return &c.SoundEffect.Audio
+	case c.SystemSoundEffect != nil:
+		return &c.SystemSoundEffect.Audio
+	default:
+		return nil
+	}
+}
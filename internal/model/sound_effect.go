@@ -0,0 +1,34 @@
+package model
+
+import (
+	"time"
+
+	"github.com/siropaca/anycast-backend/internal/pkg/uuid"
+)
+
+// SoundEffect はユーザーが所有する効果音を表す
+type SoundEffect struct {
+	ID        uuid.UUID `gorm:"type:uuid;primaryKey;default:gen_random_uuid()"`
+	UserID    uuid.UUID `gorm:"type:uuid;not null;column:user_id"`
+	AudioID   uuid.UUID `gorm:"type:uuid;not null;column:audio_id"`
+	Name      string    `gorm:"type:varchar(255);not null"`
+	CreatedAt time.Time `gorm:"not null;default:CURRENT_TIMESTAMP"`
+	UpdatedAt time.Time `gorm:"not null;default:CURRENT_TIMESTAMP"`
+
+	// リレーション
+	Audio Audio `gorm:"foreignKey:AudioID"`
+}
+
+// SystemSoundEffect はシステム効果音（管理者が提供）を表す
+type SystemSoundEffect struct {
+	ID        uuid.UUID `gorm:"type:uuid;primaryKey;default:gen_random_uuid()"`
+	AudioID   uuid.UUID `gorm:"type:uuid;not null;column:audio_id"`
+	Name      string    `gorm:"type:varchar(255);not null"`
+	SortOrder int       `gorm:"not null;default:0;column:sort_order"`
+	IsActive  bool      `gorm:"not null;default:true;column:is_active"`
+	CreatedAt time.Time `gorm:"not null;default:CURRENT_TIMESTAMP"`
+	UpdatedAt time.Time `gorm:"not null;default:CURRENT_TIMESTAMP"`
+
+	// リレーション
+	Audio Audio `gorm:"foreignKey:AudioID"`
+}
@@ -306,12 +306,21 @@ func SnapBoundariesToSilence(boundaries []LineBoundary, silences []SilenceInterv
 	return result
 }
 
-// SplitPCMByTimestamps は PCM データをタイムスタンプ境界で分割する
-func SplitPCMByTimestamps(pcmData []byte, boundaries []LineBoundary, sampleRate, channels, bytesPerSample int) [][]byte {
+// PCMRange は PCM データ内のバイト範囲を表す
+type PCMRange struct {
+	Offset int // 開始位置（バイト）
+	Length int // 長さ（バイト）
+}
+
+// PCMRangesByTimestamps はタイムスタンプ境界に対応する PCM データ内のバイト範囲を返す
+//
+// totalBytes は PCM データ全体のバイト数。範囲はブロックアライメントに合わせ、PCM データの範囲内に収める。
+// PCM データをメモリに持たずにファイル上の範囲として扱う場合に使用する
+func PCMRangesByTimestamps(totalBytes int, boundaries []LineBoundary, sampleRate, channels, bytesPerSample int) []PCMRange {
 	bytesPerSec := sampleRate * channels * bytesPerSample
 	blockAlign := channels * bytesPerSample
 
-	segments := make([][]byte, len(boundaries))
+	ranges := make([]PCMRange, len(boundaries))
 	for i, b := range boundaries {
 		startByte := int(b.StartTime.Seconds() * float64(bytesPerSec))
 		endByte := int(b.EndTime.Seconds() * float64(bytesPerSec))
@@ -324,15 +333,31 @@ func SplitPCMByTimestamps(pcmData []byte, boundaries []LineBoundary, sampleRate,
 		if startByte < 0 {
 			startByte = 0
 		}
-		if endByte > len(pcmData) {
-			endByte = len(pcmData)
+		if endByte > totalBytes {
+			endByte = totalBytes
 		}
 		if startByte >= endByte {
-			segments[i] = []byte{}
+			ranges[i] = PCMRange{Offset: startByte}
 			continue
 		}
 
-		segments[i] = pcmData[startByte:endByte]
+		ranges[i] = PCMRange{Offset: startByte, Length: endByte - startByte}
+	}
+
+	return ranges
+}
+
+// SplitPCMByTimestamps は PCM データをタイムスタンプ境界で分割する
+func SplitPCMByTimestamps(pcmData []byte, boundaries []LineBoundary, sampleRate, channels, bytesPerSample int) [][]byte {
+	ranges := PCMRangesByTimestamps(len(pcmData), boundaries, sampleRate, channels, bytesPerSample)
+
+	segments := make([][]byte, len(ranges))
+	for i, r := range ranges {
+		if r.Length == 0 {
+			segments[i] = []byte{}
+			continue
+		}
+		segments[i] = pcmData[r.Offset : r.Offset+r.Length]
 	}
 
 	return segments
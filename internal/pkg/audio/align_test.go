@@ -605,6 +605,36 @@ func TestSnapBoundariesToSilence(t *testing.T) {
 	})
 }
 
+func TestPCMRangesByTimestamps(t *testing.T) {
+	t.Run("タイムスタンプ境界に対応するバイト範囲を返す", func(t *testing.T) {
+		boundaries := []LineBoundary{
+			{StartTime: 0, EndTime: 1 * time.Second},
+			{StartTime: 1 * time.Second, EndTime: 2 * time.Second},
+		}
+
+		ranges := PCMRangesByTimestamps(96000, boundaries, 24000, 1, 2)
+
+		assert.Equal(t, []PCMRange{
+			{Offset: 0, Length: 48000},
+			{Offset: 48000, Length: 48000},
+		}, ranges)
+	})
+
+	t.Run("範囲外のタイムスタンプはクリップされる", func(t *testing.T) {
+		boundaries := []LineBoundary{
+			{StartTime: 500 * time.Millisecond, EndTime: 2 * time.Second},
+			{StartTime: 2 * time.Second, EndTime: 3 * time.Second},
+		}
+
+		ranges := PCMRangesByTimestamps(48000, boundaries, 24000, 1, 2)
+
+		assert.Equal(t, []PCMRange{
+			{Offset: 24000, Length: 24000},
+			{Offset: 96000, Length: 0},
+		}, ranges)
+	})
+}
+
 func TestSplitPCMByTimestamps(t *testing.T) {
 	t.Run("タイムスタンプ境界で PCM を分割する", func(t *testing.T) {
 		// 2秒分の PCM データ（24kHz, mono, s16le = 96000 bytes）
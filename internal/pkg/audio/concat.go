@@ -3,29 +3,34 @@ package audio
 import (
 	"bytes"
 	"fmt"
+	"io"
 	"os"
 	"os/exec"
 	"path/filepath"
 )
 
-// Concat は複数の音声データを連結する
+// Concat は複数の音声データを連結して w に書き込む
 //
 // FFmpeg の concat demuxer を使用して、複数の MP3 ファイルをシームレスに結合する。
-// audioChunks には連結する音声データの配列を渡す。
-func Concat(audioChunks [][]byte) ([]byte, error) {
+// audioChunks には連結する音声データの配列を渡す。各チャンクは一時ファイルに書き出し、
+// 連結結果は FFmpeg の標準出力から w にコピーするため、音声全体をメモリに保持しない
+func Concat(w io.Writer, audioChunks []io.Reader) error {
 	if len(audioChunks) == 0 {
-		return nil, fmt.Errorf("no audio chunks to concatenate")
+		return fmt.Errorf("no audio chunks to concatenate")
 	}
 
-	// 1つだけの場合はそのまま返す
+	// 1つだけの場合はそのまま書き込む
 	if len(audioChunks) == 1 {
-		return audioChunks[0], nil
+		if _, err := io.Copy(w, audioChunks[0]); err != nil {
+			return fmt.Errorf("failed to copy audio chunk: %w", err)
+		}
+		return nil
 	}
 
 	// 一時ディレクトリを作成
 	tmpDir, err := os.MkdirTemp("", "ffmpeg-concat-*")
 	if err != nil {
-		return nil, fmt.Errorf("failed to create temp dir: %w", err)
+		return fmt.Errorf("failed to create temp dir: %w", err)
 	}
 	defer os.RemoveAll(tmpDir)
 
@@ -33,8 +38,8 @@ func Concat(audioChunks [][]byte) ([]byte, error) {
 	var concatList bytes.Buffer
 	for i, chunk := range audioChunks {
 		chunkPath := filepath.Join(tmpDir, fmt.Sprintf("chunk_%03d.mp3", i))
-		if err := os.WriteFile(chunkPath, chunk, 0o644); err != nil {
-			return nil, fmt.Errorf("failed to write chunk file %d: %w", i, err)
+		if err := writeFileFrom(chunkPath, chunk); err != nil {
+			return fmt.Errorf("failed to write chunk file %d: %w", i, err)
 		}
 		// concat demuxer 用のリスト形式
 		concatList.WriteString(fmt.Sprintf("file '%s'\n", chunkPath))
@@ -43,35 +48,43 @@ func Concat(audioChunks [][]byte) ([]byte, error) {
 	// concat リストファイルを作成
 	listPath := filepath.Join(tmpDir, "concat_list.txt")
 	if err := os.WriteFile(listPath, concatList.Bytes(), 0o644); err != nil {
-		return nil, fmt.Errorf("failed to write concat list: %w", err)
+		return fmt.Errorf("failed to write concat list: %w", err)
 	}
 
-	outputPath := filepath.Join(tmpDir, "output.mp3")
-
-	// FFmpeg コマンドを実行（concat demuxer を使用）
+	// FFmpeg コマンドを実行（concat demuxer を使用し、MP3 を標準出力に書き出す）
 	args := []string{
 		"-f", "concat",
 		"-safe", "0",
 		"-i", listPath,
 		"-c:a", "libmp3lame",
 		"-b:a", "192k",
-		"-y",
-		outputPath,
+		"-f", "mp3",
+		"pipe:1",
 	}
 
 	cmd := exec.Command("ffmpeg", args...)
 	var stderr bytes.Buffer
+	cmd.Stdout = w
 	cmd.Stderr = &stderr
 
 	if err := cmd.Run(); err != nil {
-		return nil, fmt.Errorf("ffmpeg concat failed: %w (stderr: %s)", err, stderr.String())
+		return fmt.Errorf("ffmpeg concat failed: %w (stderr: %s)", err, stderr.String())
 	}
 
-	// 出力ファイルを読み込み
-	outputData, err := os.ReadFile(outputPath)
+	return nil
+}
+
+// writeFileFrom は r の内容を path のファイルに書き込む
+func writeFileFrom(path string, r io.Reader) error {
+	f, err := os.Create(path)
 	if err != nil {
-		return nil, fmt.Errorf("failed to read output file: %w", err)
+		return err
+	}
+
+	if _, err := io.Copy(f, r); err != nil {
+		f.Close()
+		return err
 	}
 
-	return outputData, nil
+	return f.Close()
 }
@@ -31,12 +31,17 @@ func GetDurationMsE(data []byte) (int, error) {
 	}
 	tmpFile.Close()
 
-	// ffprobe で再生時間を取得
+	return GetFileDurationMs(tmpFile.Name())
+}
+
+// GetFileDurationMs は音声ファイルの再生時間（ミリ秒）を取得する
+// ffprobe を使用するため、ファイルの内容をメモリに読み込まない
+func GetFileDurationMs(path string) (int, error) {
 	cmd := exec.Command("ffprobe",
 		"-v", "error",
 		"-show_entries", "format=duration",
 		"-of", "default=noprint_wrappers=1:nokey=1",
-		tmpFile.Name(),
+		path,
 	)
 
 	var stdout, stderr bytes.Buffer
@@ -1,7 +1,9 @@
 package audio
 
 import (
+	"os"
 	"os/exec"
+	"path/filepath"
 	"testing"
 
 	"github.com/stretchr/testify/assert"
@@ -59,3 +61,27 @@ func TestGetDurationMsE(t *testing.T) {
 		assert.Equal(t, 0, result)
 	})
 }
+
+func TestGetFileDurationMs(t *testing.T) {
+	// ffprobe が利用可能かチェック
+	if _, err := exec.LookPath("ffprobe"); err != nil {
+		t.Skip("ffprobe not available, skipping test")
+	}
+
+	t.Run("存在しないファイルの場合はエラーを返す", func(t *testing.T) {
+		result, err := GetFileDurationMs(filepath.Join(t.TempDir(), "missing.mp3"))
+
+		assert.Error(t, err)
+		assert.Equal(t, 0, result)
+	})
+
+	t.Run("無効なデータのファイルの場合はエラーを返す", func(t *testing.T) {
+		path := filepath.Join(t.TempDir(), "invalid.mp3")
+		assert.NoError(t, os.WriteFile(path, []byte("invalid audio data"), 0o644))
+
+		result, err := GetFileDurationMs(path)
+
+		assert.Error(t, err)
+		assert.Equal(t, 0, result)
+	})
+}
@@ -0,0 +1,233 @@
+package audio
+
+import (
+	"bytes"
+	"encoding/binary"
+	"errors"
+	"io"
+	"strconv"
+	"unicode/utf16"
+)
+
+// ID3 フレームのテキストエンコーディング
+const (
+	id3EncodingISO88591 byte = 0x00
+	id3EncodingUTF16    byte = 0x01
+)
+
+// id3Language は COMM / USLT フレームの言語コード（ISO 639-2）
+const id3Language = "jpn"
+
+// id3PictureTypeFrontCover は APIC フレームの画像種別（表紙）
+const id3PictureTypeFrontCover byte = 0x03
+
+// ID3Tag は MP3 に埋め込む ID3v2 タグの内容を表す
+//
+// 空文字列・0・nil のフィールドはフレームを出力しない
+type ID3Tag struct {
+	Title       string      // TIT2
+	Artist      string      // TPE1
+	Album       string      // TALB
+	TrackNumber int         // TRCK
+	Comment     string      // COMM
+	Lyrics      string      // USLT
+	Picture     *ID3Picture // APIC
+}
+
+// ID3Picture は ID3v2 タグに埋め込む画像を表す
+type ID3Picture struct {
+	MimeType string
+	Data     []byte
+}
+
+// WriteID3v2 は MP3 データ先頭の既存 ID3v2 タグを取り除き、新しい ID3v2.3 タグを付与する
+func WriteID3v2(mp3Data []byte, tag ID3Tag) []byte {
+	body := StripID3v2(mp3Data)
+	header := EncodeID3v2(tag)
+
+	result := make([]byte, 0, len(header)+len(body))
+	result = append(result, header...)
+	result = append(result, body...)
+	return result
+}
+
+// StripID3v2 は MP3 データ先頭の ID3v2 タグを取り除く
+//
+// タグがない場合や、ヘッダーが壊れている場合はそのまま返す
+func StripID3v2(data []byte) []byte {
+	if len(data) < 10 || !bytes.Equal(data[0:3], []byte("ID3")) {
+		return data
+	}
+
+	size, ok := decodeSyncsafe(data[6:10])
+	if !ok {
+		return data
+	}
+
+	total := 10 + size
+	// フッター付き（ID3v2.4）の場合はさらに 10 バイト
+	if data[5]&0x10 != 0 {
+		total += 10
+	}
+	if total > len(data) {
+		return data
+	}
+
+	return data[total:]
+}
+
+// CopyWithID3v2 は r から読み込んだ MP3 データ先頭の既存 ID3v2 タグを取り除き、新しい ID3v2.3 タグを付与して w に書き込む
+//
+// WriteID3v2 のストリーム版。タグ以降の音声データはメモリに保持せずにコピーし、書き込んだバイト数を返す
+func CopyWithID3v2(w io.Writer, r io.Reader, tag ID3Tag) (int64, error) {
+	body, err := stripID3v2Reader(r)
+	if err != nil {
+		return 0, err
+	}
+
+	n, err := w.Write(EncodeID3v2(tag))
+	if err != nil {
+		return int64(n), err
+	}
+
+	copied, err := io.Copy(w, body)
+	return int64(n) + copied, err
+}
+
+// stripID3v2Reader は r の先頭の ID3v2 タグを読み飛ばした Reader を返す
+//
+// StripID3v2 のストリーム版。タグがない場合や、ヘッダーが壊れている場合は読み込んだヘッダーを戻して返す。
+// ストリームでは全体の長さが分からないため、タグのサイズがデータより大きい場合は空の Reader を返す
+func stripID3v2Reader(r io.Reader) (io.Reader, error) {
+	header := make([]byte, 10)
+	n, err := io.ReadFull(r, header)
+	if err != nil {
+		if errors.Is(err, io.EOF) || errors.Is(err, io.ErrUnexpectedEOF) {
+			return bytes.NewReader(header[:n]), nil
+		}
+		return nil, err
+	}
+
+	if !bytes.Equal(header[0:3], []byte("ID3")) {
+		return io.MultiReader(bytes.NewReader(header), r), nil
+	}
+
+	size, ok := decodeSyncsafe(header[6:10])
+	if !ok {
+		return io.MultiReader(bytes.NewReader(header), r), nil
+	}
+
+	// フッター付き（ID3v2.4）の場合はさらに 10 バイト
+	if header[5]&0x10 != 0 {
+		size += 10
+	}
+
+	if _, err := io.CopyN(io.Discard, r, int64(size)); err != nil {
+		if errors.Is(err, io.EOF) {
+			return bytes.NewReader(nil), nil
+		}
+		return nil, err
+	}
+
+	return r, nil
+}
+
+// EncodeID3v2 は ID3v2.3 タグ（ヘッダー + フレーム）をエンコードする
+func EncodeID3v2(tag ID3Tag) []byte {
+	var frames bytes.Buffer
+
+	writeTextFrame(&frames, "TIT2", tag.Title)
+	writeTextFrame(&frames, "TPE1", tag.Artist)
+	writeTextFrame(&frames, "TALB", tag.Album)
+	if tag.TrackNumber > 0 {
+		writeFrame(&frames, "TRCK", append([]byte{id3EncodingISO88591}, strconv.Itoa(tag.TrackNumber)...))
+	}
+	writeLangTextFrame(&frames, "COMM", tag.Comment)
+	writeLangTextFrame(&frames, "USLT", tag.Lyrics)
+	if tag.Picture != nil && len(tag.Picture.Data) > 0 {
+		var payload bytes.Buffer
+		payload.WriteByte(id3EncodingISO88591)
+		payload.WriteString(tag.Picture.MimeType)
+		payload.WriteByte(0x00)
+		payload.WriteByte(id3PictureTypeFrontCover)
+		payload.WriteByte(0x00) // 説明（空）
+		payload.Write(tag.Picture.Data)
+		writeFrame(&frames, "APIC", payload.Bytes())
+	}
+
+	header := make([]byte, 10)
+	copy(header[0:3], "ID3")
+	header[3] = 0x03 // メジャーバージョン
+	header[4] = 0x00 // リビジョン
+	header[5] = 0x00 // フラグ
+	copy(header[6:10], encodeSyncsafe(frames.Len()))
+
+	return append(header, frames.Bytes()...)
+}
+
+// writeTextFrame は UTF-16 のテキスト情報フレームを書き込む
+func writeTextFrame(buf *bytes.Buffer, id, text string) {
+	if text == "" {
+		return
+	}
+
+	payload := append([]byte{id3EncodingUTF16}, encodeUTF16(text)...)
+	writeFrame(buf, id, payload)
+}
+
+// writeLangTextFrame は言語コードと説明を持つフレーム（COMM / USLT）を書き込む
+func writeLangTextFrame(buf *bytes.Buffer, id, text string) {
+	if text == "" {
+		return
+	}
+
+	var payload bytes.Buffer
+	payload.WriteByte(id3EncodingUTF16)
+	payload.WriteString(id3Language)
+	payload.Write(encodeUTF16("")) // 説明（空）
+	payload.Write([]byte{0x00, 0x00})
+	payload.Write(encodeUTF16(text))
+	writeFrame(buf, id, payload.Bytes())
+}
+
+// writeFrame は ID3v2.3 のフレームヘッダーとペイロードを書き込む
+func writeFrame(buf *bytes.Buffer, id string, payload []byte) {
+	header := make([]byte, 10)
+	copy(header[0:4], id)
+	binary.BigEndian.PutUint32(header[4:8], uint32(len(payload)))
+	buf.Write(header)
+	buf.Write(payload)
+}
+
+// encodeUTF16 は文字列を BOM 付き UTF-16LE にエンコードする
+func encodeUTF16(s string) []byte {
+	units := utf16.Encode([]rune(s))
+	result := make([]byte, 2+len(units)*2)
+	result[0], result[1] = 0xFF, 0xFE
+	for i, u := range units {
+		binary.LittleEndian.PutUint16(result[2+i*2:], u)
+	}
+	return result
+}
+
+// encodeSyncsafe は 28bit の値を syncsafe 整数（各バイト 7bit）にエンコードする
+func encodeSyncsafe(n int) []byte {
+	return []byte{
+		byte(n>>21) & 0x7F,
+		byte(n>>14) & 0x7F,
+		byte(n>>7) & 0x7F,
+		byte(n) & 0x7F,
+	}
+}
+
+// decodeSyncsafe は syncsafe 整数をデコードする
+func decodeSyncsafe(b []byte) (int, bool) {
+	n := 0
+	for _, v := range b {
+		if v&0x80 != 0 {
+			return 0, false
+		}
+		n = n<<7 | int(v)
+	}
+	return n, true
+}
@@ -0,0 +1,139 @@
+package audio
+
+import (
+	"bytes"
+	"encoding/binary"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// parseID3Frames はテスト用に ID3v2.3 タグのフレームをフレーム ID ごとのペイロードに分解する
+func parseID3Frames(t *testing.T, data []byte) map[string][]byte {
+	t.Helper()
+
+	require.True(t, bytes.HasPrefix(data, []byte("ID3")))
+	size, ok := decodeSyncsafe(data[6:10])
+	require.True(t, ok)
+
+	frames := make(map[string][]byte)
+	body := data[10 : 10+size]
+	for len(body) >= 10 {
+		id := string(body[0:4])
+		frameSize := int(binary.BigEndian.Uint32(body[4:8]))
+		frames[id] = body[10 : 10+frameSize]
+		body = body[10+frameSize:]
+	}
+
+	return frames
+}
+
+func TestEncodeID3v2(t *testing.T) {
+	t.Run("指定したフィールドのフレームを出力する", func(t *testing.T) {
+		tag := ID3Tag{
+			Title:       "第1話",
+			Artist:      "チャンネル",
+			Album:       "チャンネル",
+			TrackNumber: 3,
+			Comment:     "説明",
+			Lyrics:      "太郎: こんにちは",
+			Picture:     &ID3Picture{MimeType: "image/png", Data: []byte{0x89, 0x50}},
+		}
+
+		frames := parseID3Frames(t, EncodeID3v2(tag))
+
+		assert.Equal(t, append([]byte{id3EncodingUTF16}, encodeUTF16("第1話")...), frames["TIT2"])
+		assert.Equal(t, append([]byte{id3EncodingUTF16}, encodeUTF16("チャンネル")...), frames["TPE1"])
+		assert.Equal(t, append([]byte{id3EncodingUTF16}, encodeUTF16("チャンネル")...), frames["TALB"])
+		assert.Equal(t, []byte{id3EncodingISO88591, '3'}, frames["TRCK"])
+		assert.True(t, bytes.HasPrefix(frames["COMM"], []byte{id3EncodingUTF16, 'j', 'p', 'n', 0xFF, 0xFE, 0x00, 0x00}))
+		assert.True(t, bytes.HasSuffix(frames["USLT"], encodeUTF16("太郎: こんにちは")))
+		assert.Equal(t, []byte("\x00image/png\x00\x03\x00\x89\x50"), frames["APIC"])
+	})
+
+	t.Run("空のフィールドはフレームを出力しない", func(t *testing.T) {
+		frames := parseID3Frames(t, EncodeID3v2(ID3Tag{Title: "タイトル"}))
+
+		assert.Len(t, frames, 1)
+		assert.Contains(t, frames, "TIT2")
+	})
+
+	t.Run("ヘッダーは ID3v2.3 で syncsafe のサイズを持つ", func(t *testing.T) {
+		data := EncodeID3v2(ID3Tag{Title: "タイトル"})
+
+		assert.Equal(t, []byte{'I', 'D', '3', 0x03, 0x00, 0x00}, data[0:6])
+		size, ok := decodeSyncsafe(data[6:10])
+		assert.True(t, ok)
+		assert.Equal(t, len(data)-10, size)
+	})
+}
+
+func TestWriteID3v2(t *testing.T) {
+	mp3Body := []byte{0xFF, 0xFB, 0x90, 0x00}
+
+	t.Run("タグのない MP3 の先頭にタグを付与する", func(t *testing.T) {
+		result := WriteID3v2(mp3Body, ID3Tag{Title: "タイトル"})
+
+		assert.True(t, bytes.HasPrefix(result, []byte("ID3")))
+		assert.True(t, bytes.HasSuffix(result, mp3Body))
+	})
+
+	t.Run("既存のタグを置き換える", func(t *testing.T) {
+		tagged := WriteID3v2(mp3Body, ID3Tag{Title: "旧タイトル"})
+
+		result := WriteID3v2(tagged, ID3Tag{Title: "新タイトル"})
+
+		assert.Equal(t, append(EncodeID3v2(ID3Tag{Title: "新タイトル"}), mp3Body...), result)
+	})
+}
+
+func TestCopyWithID3v2(t *testing.T) {
+	mp3Body := []byte{0xFF, 0xFB, 0x90, 0x00}
+
+	t.Run("WriteID3v2 と同じ結果を書き込む", func(t *testing.T) {
+		for _, input := range [][]byte{
+			mp3Body,
+			WriteID3v2(mp3Body, ID3Tag{Title: "旧タイトル", Artist: "チャンネル"}),
+		} {
+			var buf bytes.Buffer
+
+			n, err := CopyWithID3v2(&buf, bytes.NewReader(input), ID3Tag{Title: "新タイトル"})
+
+			assert.NoError(t, err)
+			assert.Equal(t, WriteID3v2(input, ID3Tag{Title: "新タイトル"}), buf.Bytes())
+			assert.Equal(t, int64(buf.Len()), n)
+		}
+	})
+
+	t.Run("10 バイト未満のデータもそのまま残す", func(t *testing.T) {
+		var buf bytes.Buffer
+
+		_, err := CopyWithID3v2(&buf, bytes.NewReader([]byte{0xFF, 0xFB}), ID3Tag{Title: "タイトル"})
+
+		assert.NoError(t, err)
+		assert.True(t, bytes.HasSuffix(buf.Bytes(), []byte{0xFF, 0xFB}))
+	})
+}
+
+func TestStripID3v2(t *testing.T) {
+	t.Run("タグがない場合はそのまま返す", func(t *testing.T) {
+		data := []byte{0xFF, 0xFB, 0x90, 0x00}
+
+		assert.Equal(t, data, StripID3v2(data))
+	})
+
+	t.Run("サイズが不正な場合はそのまま返す", func(t *testing.T) {
+		data := []byte{'I', 'D', '3', 0x03, 0x00, 0x00, 0x00, 0x00, 0x7F, 0x7F, 0xFF}
+
+		assert.Equal(t, data, StripID3v2(data))
+	})
+
+	t.Run("フッター付きのタグも取り除く", func(t *testing.T) {
+		data := []byte{'I', 'D', '3', 0x04, 0x00, 0x10, 0x00, 0x00, 0x00, 0x00}
+		data = append(data, []byte("3DI\x04\x00\x10\x00\x00\x00\x00")...)
+		data = append(data, 0xFF, 0xFB)
+
+		assert.Equal(t, []byte{0xFF, 0xFB}, StripID3v2(data))
+	})
+}
@@ -4,9 +4,19 @@ import "encoding/binary"
 
 // EncodeWAV は s16le PCM データに WAV ヘッダーを付与して WAV 形式に変換する
 func EncodeWAV(pcmData []byte, sampleRate, channels, bytesPerSample int) []byte {
-	dataSize := len(pcmData)
+	header := WAVHeader(len(pcmData), sampleRate, channels, bytesPerSample)
+	buf := make([]byte, len(header)+len(pcmData))
+	copy(buf, header)
+	copy(buf[len(header):], pcmData)
+	return buf
+}
+
+// WAVHeader は指定サイズの s16le PCM データ用の WAV ヘッダー（44 バイト）を返す
+//
+// PCM データをメモリに読み込まずに、ヘッダーに続けてファイルからコピーして WAV を書き出す場合に使用する
+func WAVHeader(dataSize, sampleRate, channels, bytesPerSample int) []byte {
 	headerSize := 44
-	buf := make([]byte, headerSize+dataSize)
+	buf := make([]byte, headerSize)
 
 	// RIFF header
 	copy(buf[0:4], "RIFF")
@@ -26,7 +36,6 @@ func EncodeWAV(pcmData []byte, sampleRate, channels, bytesPerSample int) []byte
 	// data chunk
 	copy(buf[36:40], "data")
 	binary.LittleEndian.PutUint32(buf[40:44], uint32(dataSize))
-	copy(buf[headerSize:], pcmData)
 
 	return buf
 }
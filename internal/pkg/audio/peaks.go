@@ -0,0 +1,109 @@
+package audio
+
+import (
+	"bufio"
+	"encoding/binary"
+	"errors"
+	"io"
+	"math"
+)
+
+// PeakBlocks は s16le モノラル PCM を一定サンプル数ごとのブロックに分け、各ブロックの最小値・最大値を保持する
+//
+// 全サンプルを保持せずに任意の解像度の波形を生成するための中間データ
+type PeakBlocks struct {
+	SampleRate   int     // PCM のサンプルレート (Hz)
+	BlockSize    int     // 1 ブロックあたりのサンプル数
+	TotalSamples int     // 総サンプル数
+	Min          []int16 // ブロックごとの最小値
+	Max          []int16 // ブロックごとの最大値
+}
+
+// ReadPeakBlocks は s16le モノラル PCM を読み込みながらブロックごとの最小値・最大値を集計する
+func ReadPeakBlocks(r io.Reader, sampleRate, blockSize int) (*PeakBlocks, error) {
+	if sampleRate <= 0 || blockSize <= 0 {
+		return nil, errors.New("sampleRate and blockSize must be positive")
+	}
+
+	blocks := &PeakBlocks{
+		SampleRate: sampleRate,
+		BlockSize:  blockSize,
+	}
+
+	br := bufio.NewReader(r)
+	buf := make([]byte, 2)
+	var blockMin, blockMax int16
+	inBlock := 0
+
+	for {
+		if _, err := io.ReadFull(br, buf); err != nil {
+			// 末尾の端数バイトは無視する
+			if errors.Is(err, io.EOF) || errors.Is(err, io.ErrUnexpectedEOF) {
+				break
+			}
+			return nil, err
+		}
+
+		sample := int16(binary.LittleEndian.Uint16(buf))
+		if inBlock == 0 || sample < blockMin {
+			blockMin = sample
+		}
+		if inBlock == 0 || sample > blockMax {
+			blockMax = sample
+		}
+		inBlock++
+		blocks.TotalSamples++
+
+		if inBlock == blockSize {
+			blocks.Min = append(blocks.Min, blockMin)
+			blocks.Max = append(blocks.Max, blockMax)
+			inBlock = 0
+		}
+	}
+
+	if inBlock > 0 {
+		blocks.Min = append(blocks.Min, blockMin)
+		blocks.Max = append(blocks.Max, blockMax)
+	}
+
+	return blocks, nil
+}
+
+// Downsample はブロックをまとめて最大 maxLength 個のピークに縮約する
+//
+// 1 ピークあたりのサンプル数はブロックサイズの整数倍に切り上げるため、
+// 返却されるピーク数は maxLength 以下になる。min / max は 8bit（-128 〜 127）に量子化する
+func (p *PeakBlocks) Downsample(maxLength int) (samplesPerPixel int, mins, maxs []int8) {
+	if maxLength <= 0 || len(p.Min) == 0 {
+		return p.BlockSize, []int8{}, []int8{}
+	}
+
+	blocksPerPixel := int(math.Ceil(float64(len(p.Min)) / float64(maxLength)))
+	length := int(math.Ceil(float64(len(p.Min)) / float64(blocksPerPixel)))
+
+	mins = make([]int8, length)
+	maxs = make([]int8, length)
+
+	for i := 0; i < length; i++ {
+		start := i * blocksPerPixel
+		end := start + blocksPerPixel
+		if end > len(p.Min) {
+			end = len(p.Min)
+		}
+
+		lo, hi := p.Min[start], p.Max[start]
+		for j := start + 1; j < end; j++ {
+			if p.Min[j] < lo {
+				lo = p.Min[j]
+			}
+			if p.Max[j] > hi {
+				hi = p.Max[j]
+			}
+		}
+
+		mins[i] = int8(lo >> 8)
+		maxs[i] = int8(hi >> 8)
+	}
+
+	return blocksPerPixel * p.BlockSize, mins, maxs
+}
@@ -0,0 +1,89 @@
+package audio
+
+import (
+	"bytes"
+	"encoding/binary"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func encodeS16LE(samples ...int16) []byte {
+	buf := make([]byte, len(samples)*2)
+	for i, s := range samples {
+		binary.LittleEndian.PutUint16(buf[i*2:], uint16(s))
+	}
+	return buf
+}
+
+func TestReadPeakBlocks(t *testing.T) {
+	t.Run("ブロックごとの最小値・最大値を集計する", func(t *testing.T) {
+		pcm := encodeS16LE(100, -200, 300, -400, 500, 50)
+
+		result, err := ReadPeakBlocks(bytes.NewReader(pcm), 16000, 2)
+
+		require.NoError(t, err)
+		assert.Equal(t, 6, result.TotalSamples)
+		assert.Equal(t, []int16{-200, -400, 50}, result.Min)
+		assert.Equal(t, []int16{100, 300, 500}, result.Max)
+	})
+
+	t.Run("端数のサンプルは最後のブロックにまとめる", func(t *testing.T) {
+		pcm := encodeS16LE(1, 2, 3)
+
+		result, err := ReadPeakBlocks(bytes.NewReader(pcm), 16000, 2)
+
+		require.NoError(t, err)
+		assert.Equal(t, []int16{1, 3}, result.Min)
+		assert.Equal(t, []int16{2, 3}, result.Max)
+	})
+
+	t.Run("末尾の端数バイトは無視する", func(t *testing.T) {
+		pcm := append(encodeS16LE(10, 20), 0xff)
+
+		result, err := ReadPeakBlocks(bytes.NewReader(pcm), 16000, 2)
+
+		require.NoError(t, err)
+		assert.Equal(t, 2, result.TotalSamples)
+	})
+
+	t.Run("ブロックサイズが 0 以下の場合はエラーを返す", func(t *testing.T) {
+		_, err := ReadPeakBlocks(bytes.NewReader(nil), 16000, 0)
+
+		assert.Error(t, err)
+	})
+}
+
+func TestPeakBlocks_Downsample(t *testing.T) {
+	blocks := &PeakBlocks{
+		SampleRate: 16000,
+		BlockSize:  10,
+		Min:        []int16{-256, -512, -32768, -1024, -2048},
+		Max:        []int16{256, 512, 32767, 1024, 2048},
+	}
+
+	t.Run("最大長に収まるようにブロックをまとめる", func(t *testing.T) {
+		spp, mins, maxs := blocks.Downsample(2)
+
+		assert.Equal(t, 30, spp)
+		assert.Equal(t, []int8{-128, -8}, mins)
+		assert.Equal(t, []int8{127, 8}, maxs)
+	})
+
+	t.Run("最大長がブロック数以上の場合はブロックをそのまま使う", func(t *testing.T) {
+		spp, mins, maxs := blocks.Downsample(100)
+
+		assert.Equal(t, 10, spp)
+		assert.Equal(t, []int8{-1, -2, -128, -4, -8}, mins)
+		assert.Equal(t, []int8{1, 2, 127, 4, 8}, maxs)
+	})
+
+	t.Run("ブロックがない場合は空を返す", func(t *testing.T) {
+		spp, mins, maxs := (&PeakBlocks{BlockSize: 10}).Downsample(100)
+
+		assert.Equal(t, 10, spp)
+		assert.Empty(t, mins)
+		assert.Empty(t, maxs)
+	})
+}
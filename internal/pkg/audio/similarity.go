@@ -0,0 +1,64 @@
+package audio
+
+import "strings"
+
+// TextSimilarity は期待するテキストと STT の認識結果の一致度を 0〜1 で返す
+//
+// 句読点・空白・記号を除いた文字列の編集距離（Levenshtein 距離）を長い方の文字数で割り、1 から引いた値。
+// 期待するテキストが空の場合は比較対象がないため 1 を返す
+func TextSimilarity(expected, actual string) float64 {
+	e := []rune(normalizeText(expected))
+	a := []rune(normalizeText(actual))
+
+	if len(e) == 0 {
+		return 1
+	}
+
+	return 1 - float64(levenshteinDistance(e, a))/float64(max(len(e), len(a)))
+}
+
+// TranscribeLines は STT の単語を行境界ごとに振り分け、各行の認識結果を返す
+//
+// 単語の中間時刻が行の区間 [StartTime, EndTime] に含まれる単語を、その行の認識結果とする。
+// 日本語の認識結果を想定し、単語は区切り文字なしで連結する
+func TranscribeLines(words []WordTimestamp, boundaries []LineBoundary) []string {
+	builders := make([]strings.Builder, len(boundaries))
+	for _, w := range words {
+		mid := w.StartTime + (w.EndTime-w.StartTime)/2
+		for i, b := range boundaries {
+			if mid >= b.StartTime && mid <= b.EndTime {
+				builders[i].WriteString(w.Word)
+				break
+			}
+		}
+	}
+
+	transcripts := make([]string, len(boundaries))
+	for i := range builders {
+		transcripts[i] = builders[i].String()
+	}
+	return transcripts
+}
+
+// levenshteinDistance は 2 つの文字列の編集距離を返す
+func levenshteinDistance(a, b []rune) int {
+	prev := make([]int, len(b)+1)
+	curr := make([]int, len(b)+1)
+	for j := range prev {
+		prev[j] = j
+	}
+
+	for i := 1; i <= len(a); i++ {
+		curr[0] = i
+		for j := 1; j <= len(b); j++ {
+			cost := 1
+			if a[i-1] == b[j-1] {
+				cost = 0
+			}
+			curr[j] = min(prev[j]+1, curr[j-1]+1, prev[j-1]+cost)
+		}
+		prev, curr = curr, prev
+	}
+
+	return prev[len(b)]
+}
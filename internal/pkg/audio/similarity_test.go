@@ -0,0 +1,52 @@
+package audio
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestTextSimilarity(t *testing.T) {
+	t.Run("句読点と空白を除いて一致すれば 1 を返す", func(t *testing.T) {
+		assert.Equal(t, 1.0, TextSimilarity("こんにちは、世界。", "こんにちは 世界"))
+	})
+
+	t.Run("1 文字違いの場合は長い方の文字数に対する割合で下がる", func(t *testing.T) {
+		assert.InDelta(t, 0.8, TextSimilarity("あいうえお", "あいうえか"), 1e-9)
+	})
+
+	t.Run("認識結果が空の場合は 0 を返す", func(t *testing.T) {
+		assert.Equal(t, 0.0, TextSimilarity("こんにちは", ""))
+	})
+
+	t.Run("期待するテキストが空の場合は 1 を返す", func(t *testing.T) {
+		assert.Equal(t, 1.0, TextSimilarity("。", "えー"))
+	})
+}
+
+func TestTranscribeLines(t *testing.T) {
+	words := []WordTimestamp{
+		{Word: "こんにちは", StartTime: 0, EndTime: 800 * time.Millisecond},
+		{Word: "世界", StartTime: 900 * time.Millisecond, EndTime: 1300 * time.Millisecond},
+		{Word: "さようなら", StartTime: 2000 * time.Millisecond, EndTime: 2800 * time.Millisecond},
+	}
+
+	t.Run("単語の中間時刻が含まれる行に振り分ける", func(t *testing.T) {
+		result := TranscribeLines(words, []LineBoundary{
+			{StartTime: 0, EndTime: 1500 * time.Millisecond},
+			{StartTime: 1500 * time.Millisecond, EndTime: 3000 * time.Millisecond},
+		})
+
+		assert.Equal(t, []string{"こんにちは世界", "さようなら"}, result)
+	})
+
+	t.Run("単語が含まれない行は空文字になる", func(t *testing.T) {
+		result := TranscribeLines(words, []LineBoundary{
+			{StartTime: 0, EndTime: 3000 * time.Millisecond},
+			{StartTime: 3000 * time.Millisecond, EndTime: 3000 * time.Millisecond},
+		})
+
+		assert.Equal(t, []string{"こんにちは世界さようなら", ""}, result)
+	})
+}
@@ -0,0 +1,95 @@
+package embedplayer
+
+import (
+	"bytes"
+	"fmt"
+	"html/template"
+)
+
+// ContentType は埋め込みプレイヤーの Content-Type
+const ContentType = "text/html; charset=utf-8"
+
+// ContentSecurityPolicy は埋め込みプレイヤーの Content-Security-Policy
+//
+// スクリプトは使わず、任意のサイトの iframe への埋め込みのみを許可する。
+// 音声・画像は署名付き URL（ストレージのホスト）へリダイレクトされるため、取得元は制限しない
+const ContentSecurityPolicy = "default-src 'none'; img-src * data:; media-src *; style-src 'unsafe-inline'; frame-ancestors *"
+
+// 埋め込みプレイヤーの推奨サイズ（px）
+const (
+	DefaultWidth  = 480
+	DefaultHeight = 160
+)
+
+// Player は埋め込みプレイヤーに表示するエピソードを表す
+type Player struct {
+	Title       string
+	ChannelName string
+	// PageURL はエピソードの Web ページの URL（タイトルのリンク先）
+	PageURL string
+	// ImageURL はアートワークの URL（空の場合は表示しない）
+	ImageURL  string
+	AudioURL  string
+	AudioType string
+	// StartSeconds は再生開始位置（秒）。0 の場合は先頭から再生する
+	StartSeconds int
+}
+
+var playerTemplate = template.Must(template.New("player").Parse(`<!DOCTYPE html>
+<html lang="ja">
+<head>
+<meta charset="utf-8">
+<meta name="viewport" content="width=device-width, initial-scale=1">
+<meta name="robots" content="noindex">
+<title>{{.Title}} - {{.ChannelName}}</title>
+<style>
+html, body { margin: 0; height: 100%; font-family: system-ui, -apple-system, sans-serif; background: #fff; color: #111; }
+.player { display: flex; gap: 12px; align-items: center; box-sizing: border-box; height: 100%; padding: 12px; border: 1px solid #e5e5e5; border-radius: 12px; overflow: hidden; }
+.artwork { flex: none; width: 120px; height: 120px; max-height: 100%; border-radius: 8px; object-fit: cover; }
+.body { flex: 1; min-width: 0; display: flex; flex-direction: column; gap: 8px; }
+.channel { margin: 0; font-size: 12px; color: #666; white-space: nowrap; overflow: hidden; text-overflow: ellipsis; }
+.title { margin: 0; font-size: 15px; font-weight: bold; white-space: nowrap; overflow: hidden; text-overflow: ellipsis; }
+.title a { color: inherit; text-decoration: none; }
+audio { width: 100%; }
+</style>
+</head>
+<body>
+<div class="player">
+{{- if .ImageURL}}
+<img class="artwork" src="{{.ImageURL}}" alt="">
+{{- end}}
+<div class="body">
+<p class="channel">{{.ChannelName}}</p>
+<p class="title"><a href="{{.PageURL}}" target="_blank" rel="noopener">{{.Title}}</a></p>
+<audio controls preload="metadata"><source src="{{.audioSrc}}" type="{{.AudioType}}"></audio>
+</div>
+</div>
+</body>
+</html>
+`))
+
+// Render は埋め込みプレイヤーの HTML を生成する
+//
+// 再生開始位置はメディアフラグメント（#t=秒）で指定するため、スクリプトを必要としない
+func (p *Player) Render() ([]byte, error) {
+	audioSrc := p.AudioURL
+	if p.StartSeconds > 0 {
+		audioSrc = fmt.Sprintf("%s#t=%d", p.AudioURL, p.StartSeconds)
+	}
+
+	data := map[string]any{
+		"Title":       p.Title,
+		"ChannelName": p.ChannelName,
+		"PageURL":     p.PageURL,
+		"ImageURL":    p.ImageURL,
+		"AudioType":   p.AudioType,
+		"audioSrc":    audioSrc,
+	}
+
+	var buf bytes.Buffer
+	if err := playerTemplate.Execute(&buf, data); err != nil {
+		return nil, fmt.Errorf("failed to render embed player: %w", err)
+	}
+
+	return buf.Bytes(), nil
+}
@@ -0,0 +1,61 @@
+package embedplayer
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func newTestPlayer() *Player {
+	return &Player{
+		Title:       "第1回 <速報>",
+		ChannelName: "テックトーク",
+		PageURL:     "https://anycast.example.com/channels/ch-1/episodes/ep-1",
+		ImageURL:    "https://api.example.com/api/v1/channels/ch-1/episodes/ep-1/artwork",
+		AudioURL:    "https://api.example.com/api/v1/media/audio/audio-1",
+		AudioType:   "audio/mpeg",
+	}
+}
+
+func TestPlayer_Render(t *testing.T) {
+	t.Run("エピソードの情報と音声を含む HTML を生成する", func(t *testing.T) {
+		data, err := newTestPlayer().Render()
+		require.NoError(t, err)
+
+		html := string(data)
+		assert.Contains(t, html, `<source src="https://api.example.com/api/v1/media/audio/audio-1" type="audio/mpeg">`)
+		assert.Contains(t, html, `<img class="artwork" src="https://api.example.com/api/v1/channels/ch-1/episodes/ep-1/artwork" alt="">`)
+		assert.Contains(t, html, `href="https://anycast.example.com/channels/ch-1/episodes/ep-1"`)
+		assert.Contains(t, html, "テックトーク")
+		assert.NotContains(t, html, "<script")
+	})
+
+	t.Run("タイトルを HTML エスケープする", func(t *testing.T) {
+		data, err := newTestPlayer().Render()
+		require.NoError(t, err)
+
+		assert.Contains(t, string(data), "第1回 &lt;速報&gt;")
+		assert.NotContains(t, string(data), "<速報>")
+	})
+
+	t.Run("再生開始位置をメディアフラグメントで指定する", func(t *testing.T) {
+		p := newTestPlayer()
+		p.StartSeconds = 90
+
+		data, err := p.Render()
+		require.NoError(t, err)
+
+		assert.Contains(t, string(data), `src="https://api.example.com/api/v1/media/audio/audio-1#t=90"`)
+	})
+
+	t.Run("アートワークがない場合は画像を表示しない", func(t *testing.T) {
+		p := newTestPlayer()
+		p.ImageURL = ""
+
+		data, err := p.Render()
+		require.NoError(t, err)
+
+		assert.NotContains(t, string(data), "<img")
+	})
+}
@@ -0,0 +1,38 @@
+package podcastfeed
+
+// Category は Apple Podcasts のカテゴリ（itunes:category）を表す
+type Category struct {
+	Name        string
+	Subcategory string
+}
+
+// categoriesBySlug は Anycast のカテゴリのスラッグと Apple Podcasts のカテゴリの対応
+var categoriesBySlug = map[string]Category{
+	"technology":            {Name: "Technology"},
+	"business":              {Name: "Business"},
+	"news":                  {Name: "News"},
+	"education":             {Name: "Education"},
+	"comedy":                {Name: "Comedy"},
+	"society-culture":       {Name: "Society & Culture"},
+	"arts":                  {Name: "Arts"},
+	"science":               {Name: "Science"},
+	"health-fitness":        {Name: "Health & Fitness"},
+	"sports":                {Name: "Sports"},
+	"music":                 {Name: "Music"},
+	"tv-film":               {Name: "TV & Film"},
+	"history":               {Name: "History"},
+	"documentary":           {Name: "Society & Culture", Subcategory: "Documentary"},
+	"fiction":               {Name: "Fiction"},
+	"kids-family":           {Name: "Kids & Family"},
+	"leisure":               {Name: "Leisure"},
+	"religion-spirituality": {Name: "Religion & Spirituality"},
+	"government":            {Name: "Government"},
+}
+
+// CategoryFromSlug はカテゴリのスラッグに対応する Apple Podcasts のカテゴリを返す
+//
+// 対応するカテゴリがない場合は false を返す
+func CategoryFromSlug(slug string) (Category, bool) {
+	c, ok := categoriesBySlug[slug]
+	return c, ok
+}
@@ -0,0 +1,271 @@
+package podcastfeed
+
+import (
+	"encoding/xml"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/siropaca/anycast-backend/internal/pkg/uuid"
+)
+
+// 名前空間
+const (
+	NamespaceITunes  = "http://www.itunes.com/dtds/podcast-1.0.dtd"
+	NamespacePodcast = "https://podcastindex.org/namespace/1.0"
+	NamespaceAtom    = "http://www.w3.org/2005/Atom"
+)
+
+// ContentType は RSS フィードの Content-Type
+const ContentType = "application/rss+xml; charset=utf-8"
+
+// podcastGUIDNamespace は podcast:guid（UUID v5）の名前空間（Podcasting 2.0 の仕様で固定）
+var podcastGUIDNamespace = uuid.MustParse("ead4c236-bf58-58c6-a2c6-a6b28d128cb6")
+
+// Feed はポッドキャストの RSS フィードを表す
+type Feed struct {
+	Title       string
+	Link        string // 番組の Web ページ
+	Description string
+	Language    string
+	Author      string
+	// SelfURL はフィード自身の URL（atom:link と podcast:guid に使用）
+	SelfURL       string
+	ImageURL      string
+	Category      *Category
+	Explicit      bool
+	Block         bool // ディレクトリへの掲載を拒否する（itunes:block。限定公開のフィードで使用）
+	LastBuildDate time.Time
+	Items         []Item
+}
+
+// Item はフィードのエピソードを表す
+type Item struct {
+	GUID        string
+	Title       string
+	Description string
+	Link        string
+	PubDate     time.Time
+	Enclosure   Enclosure
+	DurationMs  int
+	ImageURL    string
+	Explicit    bool
+	Transcript  *Transcript
+}
+
+// Enclosure はエピソードの音声ファイルを表す
+type Enclosure struct {
+	URL    string
+	Length int64
+	Type   string
+}
+
+// Transcript は podcast:transcript の文字起こしファイルを表す
+type Transcript struct {
+	URL      string
+	Type     string
+	Language string
+}
+
+type rss struct {
+	XMLName   xml.Name   `xml:"rss"`
+	Version   string     `xml:"version,attr"`
+	NSITunes  string     `xml:"xmlns:itunes,attr"`
+	NSPodcast string     `xml:"xmlns:podcast,attr"`
+	NSAtom    string     `xml:"xmlns:atom,attr"`
+	Channel   rssChannel `xml:"channel"`
+}
+
+type rssChannel struct {
+	AtomLink       *atomLink       `xml:"atom:link,omitempty"`
+	Title          string          `xml:"title"`
+	Link           string          `xml:"link"`
+	Description    string          `xml:"description"`
+	Language       string          `xml:"language,omitempty"`
+	LastBuildDate  string          `xml:"lastBuildDate,omitempty"`
+	Image          *rssImage       `xml:"image,omitempty"`
+	ITunesAuthor   string          `xml:"itunes:author,omitempty"`
+	ITunesOwner    *itunesOwner    `xml:"itunes:owner,omitempty"`
+	ITunesImage    *itunesImage    `xml:"itunes:image,omitempty"`
+	ITunesCategory *itunesCategory `xml:"itunes:category,omitempty"`
+	ITunesExplicit string          `xml:"itunes:explicit"`
+	ITunesType     string          `xml:"itunes:type"`
+	ITunesBlock    string          `xml:"itunes:block,omitempty"`
+	PodcastGUID    string          `xml:"podcast:guid,omitempty"`
+	Items          []rssItem       `xml:"item"`
+}
+
+type atomLink struct {
+	Href string `xml:"href,attr"`
+	Rel  string `xml:"rel,attr"`
+	Type string `xml:"type,attr"`
+}
+
+type rssImage struct {
+	URL   string `xml:"url"`
+	Title string `xml:"title"`
+	Link  string `xml:"link"`
+}
+
+type itunesOwner struct {
+	Name string `xml:"itunes:name"`
+}
+
+type itunesImage struct {
+	Href string `xml:"href,attr"`
+}
+
+type itunesCategory struct {
+	Text        string          `xml:"text,attr"`
+	Subcategory *itunesCategory `xml:"itunes:category,omitempty"`
+}
+
+type rssItem struct {
+	Title             string             `xml:"title"`
+	Description       string             `xml:"description"`
+	Link              string             `xml:"link,omitempty"`
+	GUID              rssGUID            `xml:"guid"`
+	PubDate           string             `xml:"pubDate"`
+	Enclosure         rssEnclosure       `xml:"enclosure"`
+	ITunesTitle       string             `xml:"itunes:title"`
+	ITunesDuration    string             `xml:"itunes:duration,omitempty"`
+	ITunesImage       *itunesImage       `xml:"itunes:image,omitempty"`
+	ITunesExplicit    string             `xml:"itunes:explicit"`
+	ITunesEpisodeType string             `xml:"itunes:episodeType"`
+	PodcastTranscript *podcastTranscript `xml:"podcast:transcript,omitempty"`
+}
+
+type rssGUID struct {
+	Value       string `xml:",chardata"`
+	IsPermaLink string `xml:"isPermaLink,attr"`
+}
+
+type rssEnclosure struct {
+	URL    string `xml:"url,attr"`
+	Length int64  `xml:"length,attr"`
+	Type   string `xml:"type,attr"`
+}
+
+type podcastTranscript struct {
+	URL      string `xml:"url,attr"`
+	Type     string `xml:"type,attr"`
+	Language string `xml:"language,attr,omitempty"`
+}
+
+// Marshal はフィードを XML 宣言付きの RSS 2.0 として出力する
+func (f *Feed) Marshal() ([]byte, error) {
+	ch := rssChannel{
+		Title:          f.Title,
+		Link:           f.Link,
+		Description:    f.Description,
+		Language:       f.Language,
+		ITunesAuthor:   f.Author,
+		ITunesExplicit: formatExplicit(f.Explicit),
+		ITunesType:     "episodic",
+	}
+
+	if !f.LastBuildDate.IsZero() {
+		ch.LastBuildDate = f.LastBuildDate.UTC().Format(time.RFC1123Z)
+	}
+
+	if f.SelfURL != "" {
+		ch.AtomLink = &atomLink{Href: f.SelfURL, Rel: "self", Type: "application/rss+xml"}
+		ch.PodcastGUID = PodcastGUID(f.SelfURL)
+	}
+
+	if f.Block {
+		ch.ITunesBlock = "Yes"
+	}
+
+	if f.Author != "" {
+		ch.ITunesOwner = &itunesOwner{Name: f.Author}
+	}
+
+	if f.ImageURL != "" {
+		ch.Image = &rssImage{URL: f.ImageURL, Title: f.Title, Link: f.Link}
+		ch.ITunesImage = &itunesImage{Href: f.ImageURL}
+	}
+
+	if f.Category != nil {
+		ch.ITunesCategory = &itunesCategory{Text: f.Category.Name}
+		if f.Category.Subcategory != "" {
+			ch.ITunesCategory.Subcategory = &itunesCategory{Text: f.Category.Subcategory}
+		}
+	}
+
+	ch.Items = make([]rssItem, len(f.Items))
+	for i, item := range f.Items {
+		ch.Items[i] = toRSSItem(item)
+	}
+
+	body, err := xml.MarshalIndent(rss{
+		Version:   "2.0",
+		NSITunes:  NamespaceITunes,
+		NSPodcast: NamespacePodcast,
+		NSAtom:    NamespaceAtom,
+		Channel:   ch,
+	}, "", "  ")
+	if err != nil {
+		return nil, err
+	}
+
+	return append([]byte(xml.Header), body...), nil
+}
+
+// toRSSItem はエピソードを RSS の item に変換する
+func toRSSItem(item Item) rssItem {
+	ri := rssItem{
+		Title:       item.Title,
+		Description: item.Description,
+		Link:        item.Link,
+		GUID:        rssGUID{Value: item.GUID, IsPermaLink: "false"},
+		PubDate:     item.PubDate.UTC().Format(time.RFC1123Z),
+		Enclosure: rssEnclosure{
+			URL:    item.Enclosure.URL,
+			Length: item.Enclosure.Length,
+			Type:   item.Enclosure.Type,
+		},
+		ITunesTitle:       item.Title,
+		ITunesExplicit:    formatExplicit(item.Explicit),
+		ITunesEpisodeType: "full",
+	}
+
+	if item.DurationMs > 0 {
+		ri.ITunesDuration = strconv.Itoa((item.DurationMs + 500) / 1000)
+	}
+
+	if item.ImageURL != "" {
+		ri.ITunesImage = &itunesImage{Href: item.ImageURL}
+	}
+
+	if item.Transcript != nil {
+		ri.PodcastTranscript = &podcastTranscript{
+			URL:      item.Transcript.URL,
+			Type:     item.Transcript.Type,
+			Language: item.Transcript.Language,
+		}
+	}
+
+	return ri
+}
+
+// formatExplicit は itunes:explicit の値を返す
+func formatExplicit(explicit bool) string {
+	if explicit {
+		return "true"
+	}
+	return "false"
+}
+
+// PodcastGUID はフィード URL から podcast:guid を生成する
+//
+// Podcasting 2.0 の仕様に従い、スキームと末尾のスラッシュを除いた URL から UUID v5 を生成する
+func PodcastGUID(feedURL string) string {
+	u := feedURL
+	if i := strings.Index(u, "://"); i >= 0 {
+		u = u[i+3:]
+	}
+	u = strings.TrimRight(u, "/")
+
+	return uuid.NewSHA1(podcastGUIDNamespace, []byte(u)).String()
+}
@@ -0,0 +1,135 @@
+package podcastfeed
+
+import (
+	"encoding/xml"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func newTestFeed() *Feed {
+	return &Feed{
+		Title:         "テックトーク",
+		Link:          "https://anycast.example.com/channels/ch-1",
+		Description:   "最新のテクノロジーニュース & 解説",
+		Language:      "ja",
+		Author:        "山田太郎",
+		SelfURL:       "https://api.example.com/api/v1/channels/ch-1/feed.xml",
+		ImageURL:      "https://api.example.com/api/v1/channels/ch-1/artwork",
+		Category:      &Category{Name: "Society & Culture", Subcategory: "Documentary"},
+		Explicit:      true,
+		LastBuildDate: time.Date(2026, 1, 2, 3, 4, 5, 0, time.UTC),
+		Items: []Item{
+			{
+				GUID:        "ep-1",
+				Title:       "第1回",
+				Description: "初回の配信です",
+				PubDate:     time.Date(2026, 1, 1, 9, 0, 0, 0, time.UTC),
+				Enclosure:   Enclosure{URL: "https://api.example.com/audio.mp3", Length: 12345, Type: "audio/mpeg"},
+				DurationMs:  61400,
+				Transcript:  &Transcript{URL: "https://api.example.com/transcript.txt", Type: "text/plain", Language: "ja"},
+			},
+		},
+	}
+}
+
+func TestFeed_Marshal(t *testing.T) {
+	t.Run("iTunes と Podcasting 2.0 の名前空間付きの RSS を出力する", func(t *testing.T) {
+		data, err := newTestFeed().Marshal()
+		require.NoError(t, err)
+
+		out := string(data)
+		assert.True(t, strings.HasPrefix(out, xml.Header))
+		assert.Contains(t, out, `xmlns:itunes="`+NamespaceITunes+`"`)
+		assert.Contains(t, out, `xmlns:podcast="`+NamespacePodcast+`"`)
+		assert.Contains(t, out, `<atom:link href="https://api.example.com/api/v1/channels/ch-1/feed.xml" rel="self" type="application/rss+xml"></atom:link>`)
+		assert.Contains(t, out, `<itunes:author>山田太郎</itunes:author>`)
+		assert.Contains(t, out, `<itunes:image href="https://api.example.com/api/v1/channels/ch-1/artwork"></itunes:image>`)
+		assert.Contains(t, out, `<itunes:category text="Society &amp; Culture">`)
+		assert.Contains(t, out, `<itunes:category text="Documentary"></itunes:category>`)
+		assert.Contains(t, out, `<itunes:explicit>true</itunes:explicit>`)
+		assert.Contains(t, out, `<lastBuildDate>Fri, 02 Jan 2026 03:04:05 +0000</lastBuildDate>`)
+	})
+
+	t.Run("エピソードを item として出力する", func(t *testing.T) {
+		data, err := newTestFeed().Marshal()
+		require.NoError(t, err)
+
+		out := string(data)
+		assert.Contains(t, out, `<guid isPermaLink="false">ep-1</guid>`)
+		assert.Contains(t, out, `<pubDate>Thu, 01 Jan 2026 09:00:00 +0000</pubDate>`)
+		assert.Contains(t, out, `<enclosure url="https://api.example.com/audio.mp3" length="12345" type="audio/mpeg"></enclosure>`)
+		assert.Contains(t, out, `<itunes:duration>61</itunes:duration>`)
+		assert.Contains(t, out, `<podcast:transcript url="https://api.example.com/transcript.txt" type="text/plain" language="ja"></podcast:transcript>`)
+		assert.NotContains(t, out, `podcast:chapters`)
+	})
+
+	t.Run("任意項目が空の場合は出力しない", func(t *testing.T) {
+		f := &Feed{Title: "番組", Link: "https://example.com", Description: "説明"}
+
+		data, err := f.Marshal()
+		require.NoError(t, err)
+
+		out := string(data)
+		assert.NotContains(t, out, "itunes:image")
+		assert.NotContains(t, out, "itunes:category")
+		assert.NotContains(t, out, "podcast:guid")
+		assert.NotContains(t, out, "itunes:block")
+		assert.Contains(t, out, `<itunes:explicit>false</itunes:explicit>`)
+	})
+
+	t.Run("Block が true の場合は itunes:block を出力する", func(t *testing.T) {
+		f := newTestFeed()
+		f.Block = true
+
+		data, err := f.Marshal()
+		require.NoError(t, err)
+
+		assert.Contains(t, string(data), `<itunes:block>Yes</itunes:block>`)
+	})
+
+	t.Run("出力した XML をパースできる", func(t *testing.T) {
+		data, err := newTestFeed().Marshal()
+		require.NoError(t, err)
+
+		var parsed struct {
+			Channel struct {
+				Title string `xml:"title"`
+				Items []struct {
+					Title string `xml:"title"`
+				} `xml:"item"`
+			} `xml:"channel"`
+		}
+		require.NoError(t, xml.Unmarshal(data, &parsed))
+		assert.Equal(t, "テックトーク", parsed.Channel.Title)
+		assert.Len(t, parsed.Channel.Items, 1)
+	})
+}
+
+func TestPodcastGUID(t *testing.T) {
+	t.Run("Podcasting 2.0 の仕様例と同じ GUID を生成する", func(t *testing.T) {
+		assert.Equal(t, "917393e3-1b1e-5cef-ace4-edaa54e1f810", PodcastGUID("https://mp3s.nashownotes.com/pc20rss.xml"))
+	})
+
+	t.Run("スキームと末尾のスラッシュは無視する", func(t *testing.T) {
+		assert.Equal(t, PodcastGUID("http://example.com/feed/"), PodcastGUID("https://example.com/feed"))
+	})
+}
+
+func TestCategoryFromSlug(t *testing.T) {
+	t.Run("スラッグに対応する Apple Podcasts のカテゴリを返す", func(t *testing.T) {
+		c, ok := CategoryFromSlug("tv-film")
+
+		assert.True(t, ok)
+		assert.Equal(t, Category{Name: "TV & Film"}, c)
+	})
+
+	t.Run("対応するカテゴリがない場合は false を返す", func(t *testing.T) {
+		_, ok := CategoryFromSlug("unknown")
+
+		assert.False(t, ok)
+	})
+}
@@ -0,0 +1,323 @@
+package podcastfeed
+
+import (
+	"encoding/xml"
+	"errors"
+	"fmt"
+	"io"
+	"strconv"
+	"strings"
+	"time"
+)
+
+// ErrUnsupportedFormat は RSS 2.0 / Atom 以外の XML だった場合のエラー
+var ErrUnsupportedFormat = errors.New("podcastfeed: unsupported feed format")
+
+// pubDateLayouts は pubDate / published として受け付ける日時の書式
+//
+// RFC 822 系の書式は配信サービスごとに揺れがあるため、よく見かける変種も含める
+var pubDateLayouts = []string{
+	time.RFC1123Z,
+	time.RFC1123,
+	"Mon, 2 Jan 2006 15:04:05 -0700",
+	"Mon, 2 Jan 2006 15:04:05 MST",
+	"Mon, 02 Jan 2006 15:04 -0700",
+	"Mon, 2 Jan 2006 15:04 -0700",
+	"02 Jan 2006 15:04:05 -0700",
+	"2 Jan 2006 15:04:05 -0700",
+	time.RFC3339,
+	"2006-01-02T15:04:05",
+	"2006-01-02",
+}
+
+// 読み込み用の RSS 2.0 の構造
+//
+// 名前空間を指定しないタグは任意の名前空間の同名要素に一致するため、
+// image（RSS）と itunes:image、summary（Atom）と itunes:summary などは同じフィールドで受け取る
+type parsedRSS struct {
+	Channel parsedChannel `xml:"channel"`
+}
+
+type parsedChannel struct {
+	Title       string        `xml:"title"`
+	Description string        `xml:"description"`
+	Summary     string        `xml:"summary"`
+	Language    string        `xml:"language"`
+	Author      string        `xml:"author"`
+	Explicit    string        `xml:"explicit"`
+	Images      []parsedImage `xml:"image"`
+	Items       []parsedItem  `xml:"item"`
+}
+
+type parsedImage struct {
+	URL  string `xml:"url"`
+	Href string `xml:"href,attr"`
+}
+
+type parsedItem struct {
+	GUID        string          `xml:"guid"`
+	Titles      []string        `xml:"title"`
+	Description string          `xml:"description"`
+	Summary     string          `xml:"summary"`
+	Encoded     string          `xml:"encoded"`
+	Link        string          `xml:"link"`
+	PubDate     string          `xml:"pubDate"`
+	Enclosure   parsedEnclosure `xml:"enclosure"`
+	Duration    string          `xml:"duration"`
+	Explicit    string          `xml:"explicit"`
+	Images      []parsedImage   `xml:"image"`
+}
+
+type parsedEnclosure struct {
+	URL    string `xml:"url,attr"`
+	Length string `xml:"length,attr"`
+	Type   string `xml:"type,attr"`
+}
+
+// 読み込み用の Atom の構造
+type parsedAtomFeed struct {
+	Title    string            `xml:"title"`
+	Subtitle string            `xml:"subtitle"`
+	Logo     string            `xml:"logo"`
+	Icon     string            `xml:"icon"`
+	Author   parsedAtomAuthor  `xml:"author"`
+	Images   []parsedImage     `xml:"image"`
+	Entries  []parsedAtomEntry `xml:"entry"`
+}
+
+type parsedAtomAuthor struct {
+	Name string `xml:"name"`
+}
+
+type parsedAtomEntry struct {
+	ID        string           `xml:"id"`
+	Title     string           `xml:"title"`
+	Summary   string           `xml:"summary"`
+	Content   string           `xml:"content"`
+	Published string           `xml:"published"`
+	Updated   string           `xml:"updated"`
+	Links     []parsedAtomLink `xml:"link"`
+	Duration  string           `xml:"duration"`
+	Images    []parsedImage    `xml:"image"`
+}
+
+type parsedAtomLink struct {
+	Href   string `xml:"href,attr"`
+	Rel    string `xml:"rel,attr"`
+	Type   string `xml:"type,attr"`
+	Length string `xml:"length,attr"`
+}
+
+// Parse は RSS 2.0 または Atom のフィードを読み込む
+//
+// 出力用の Feed 構造に変換して返す。取り込みに必要な項目（タイトル・説明・公開日時・
+// エンクロージャー・アートワーク・再生時間）のみを読み込み、それ以外の要素は無視する。
+// 公開日時を解釈できない item の PubDate はゼロ値になる
+func Parse(r io.Reader) (*Feed, error) {
+	dec := newDecoder(r)
+
+	// ルート要素で形式を判定する
+	var root xml.StartElement
+	for {
+		tok, err := dec.Token()
+		if err != nil {
+			if errors.Is(err, io.EOF) {
+				return nil, ErrUnsupportedFormat
+			}
+			return nil, fmt.Errorf("podcastfeed: failed to read feed: %w", err)
+		}
+		if se, ok := tok.(xml.StartElement); ok {
+			root = se
+			break
+		}
+	}
+
+	switch root.Name.Local {
+	case "rss":
+		var doc parsedRSS
+		if err := dec.DecodeElement(&doc, &root); err != nil {
+			return nil, fmt.Errorf("podcastfeed: failed to decode rss: %w", err)
+		}
+		return doc.toFeed(), nil
+	case "feed":
+		if root.Name.Space != NamespaceAtom {
+			return nil, ErrUnsupportedFormat
+		}
+		var doc parsedAtomFeed
+		if err := dec.DecodeElement(&doc, &root); err != nil {
+			return nil, fmt.Errorf("podcastfeed: failed to decode atom: %w", err)
+		}
+		return doc.toFeed(), nil
+	default:
+		return nil, ErrUnsupportedFormat
+	}
+}
+
+// newDecoder は HTML の実体参照（&nbsp; など）を含むフィードも読み込めるデコーダーを返す
+func newDecoder(r io.Reader) *xml.Decoder {
+	dec := xml.NewDecoder(r)
+	dec.Strict = false
+	dec.Entity = xml.HTMLEntity
+	dec.CharsetReader = func(charset string, input io.Reader) (io.Reader, error) {
+		switch strings.ToLower(charset) {
+		case "utf-8", "utf8", "us-ascii", "ascii":
+			return input, nil
+		default:
+			return nil, fmt.Errorf("podcastfeed: unsupported charset %q", charset)
+		}
+	}
+	return dec
+}
+
+// toFeed は RSS 2.0 を Feed に変換する
+func (doc *parsedRSS) toFeed() *Feed {
+	ch := doc.Channel
+
+	f := &Feed{
+		Title:       strings.TrimSpace(ch.Title),
+		Description: firstNonEmpty(ch.Description, ch.Summary),
+		Language:    strings.TrimSpace(ch.Language),
+		Author:      strings.TrimSpace(ch.Author),
+		ImageURL:    imageURL(ch.Images),
+		Explicit:    parseExplicit(ch.Explicit),
+		Items:       make([]Item, 0, len(ch.Items)),
+	}
+
+	for _, it := range ch.Items {
+		length, _ := strconv.ParseInt(strings.TrimSpace(it.Enclosure.Length), 10, 64) //nolint:errcheck // 不正な length は 0 として扱う
+		item := Item{
+			GUID:        strings.TrimSpace(it.GUID),
+			Title:       firstNonEmpty(it.Titles...),
+			Description: firstNonEmpty(it.Description, it.Summary, it.Encoded),
+			Link:        strings.TrimSpace(it.Link),
+			PubDate:     parsePubDate(it.PubDate),
+			Enclosure: Enclosure{
+				URL:    strings.TrimSpace(it.Enclosure.URL),
+				Length: length,
+				Type:   strings.TrimSpace(it.Enclosure.Type),
+			},
+			DurationMs: parseDurationMs(it.Duration),
+			ImageURL:   imageURL(it.Images),
+			Explicit:   parseExplicit(it.Explicit),
+		}
+		if item.GUID == "" {
+			item.GUID = item.Enclosure.URL
+		}
+		f.Items = append(f.Items, item)
+	}
+
+	return f
+}
+
+// toFeed は Atom を Feed に変換する
+func (doc *parsedAtomFeed) toFeed() *Feed {
+	f := &Feed{
+		Title:       strings.TrimSpace(doc.Title),
+		Description: strings.TrimSpace(doc.Subtitle),
+		Author:      strings.TrimSpace(doc.Author.Name),
+		ImageURL:    firstNonEmpty(imageURL(doc.Images), doc.Logo, doc.Icon),
+		Items:       make([]Item, 0, len(doc.Entries)),
+	}
+
+	for _, e := range doc.Entries {
+		item := Item{
+			GUID:        strings.TrimSpace(e.ID),
+			Title:       strings.TrimSpace(e.Title),
+			Description: firstNonEmpty(e.Summary, e.Content),
+			PubDate:     parsePubDate(firstNonEmpty(e.Published, e.Updated)),
+			DurationMs:  parseDurationMs(e.Duration),
+			ImageURL:    imageURL(e.Images),
+		}
+		for _, l := range e.Links {
+			switch l.Rel {
+			case "enclosure":
+				if item.Enclosure.URL == "" {
+					length, _ := strconv.ParseInt(strings.TrimSpace(l.Length), 10, 64) //nolint:errcheck // 不正な length は 0 として扱う
+					item.Enclosure = Enclosure{URL: strings.TrimSpace(l.Href), Length: length, Type: strings.TrimSpace(l.Type)}
+				}
+			case "", "alternate":
+				if item.Link == "" {
+					item.Link = strings.TrimSpace(l.Href)
+				}
+			}
+		}
+		if item.GUID == "" {
+			item.GUID = item.Enclosure.URL
+		}
+		f.Items = append(f.Items, item)
+	}
+
+	return f
+}
+
+// imageURL は image / itunes:image のうち最初に見つかった URL を返す
+func imageURL(images []parsedImage) string {
+	for _, img := range images {
+		if u := firstNonEmpty(img.Href, img.URL); u != "" {
+			return u
+		}
+	}
+	return ""
+}
+
+// firstNonEmpty は前後の空白を除いて最初の空でない文字列を返す
+func firstNonEmpty(values ...string) string {
+	for _, v := range values {
+		if v = strings.TrimSpace(v); v != "" {
+			return v
+		}
+	}
+	return ""
+}
+
+// parsePubDate は公開日時を解釈して UTC で返す。解釈できない場合はゼロ値を返す
+func parsePubDate(s string) time.Time {
+	s = strings.TrimSpace(s)
+	if s == "" {
+		return time.Time{}
+	}
+
+	for _, layout := range pubDateLayouts {
+		if t, err := time.Parse(layout, s); err == nil {
+			return t.UTC()
+		}
+	}
+
+	return time.Time{}
+}
+
+// parseDurationMs は itunes:duration（秒数、MM:SS、HH:MM:SS）をミリ秒に変換する
+//
+// 解釈できない場合は 0 を返す
+func parseDurationMs(s string) int {
+	s = strings.TrimSpace(s)
+	if s == "" {
+		return 0
+	}
+
+	parts := strings.Split(s, ":")
+	if len(parts) > 3 {
+		return 0
+	}
+
+	var seconds float64
+	for _, p := range parts {
+		v, err := strconv.ParseFloat(p, 64)
+		if err != nil || v < 0 {
+			return 0
+		}
+		seconds = seconds*60 + v
+	}
+
+	return int(seconds * 1000)
+}
+
+// parseExplicit は itunes:explicit の値を解釈する
+func parseExplicit(s string) bool {
+	switch strings.ToLower(strings.TrimSpace(s)) {
+	case "true", "yes", "explicit":
+		return true
+	default:
+		return false
+	}
+}
@@ -0,0 +1,181 @@
+package podcastfeed
+
+import (
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+const testRSS = `<?xml version="1.0" encoding="UTF-8"?>
+<rss version="2.0" xmlns:itunes="http://www.itunes.com/dtds/podcast-1.0.dtd" xmlns:content="http://purl.org/rss/1.0/modules/content/">
+  <channel>
+    <title>外部ポッドキャスト</title>
+    <link>https://example.com/</link>
+    <description>番組の説明&nbsp;です</description>
+    <language>ja</language>
+    <itunes:author>山田太郎</itunes:author>
+    <itunes:explicit>yes</itunes:explicit>
+    <image>
+      <url>https://example.com/rss-image.png</url>
+      <title>外部ポッドキャスト</title>
+    </image>
+    <itunes:image href="https://example.com/itunes-image.jpg"/>
+    <item>
+      <title>第2回</title>
+      <itunes:title>第2回（iTunes）</itunes:title>
+      <guid isPermaLink="false">ep-2</guid>
+      <pubDate>Tue, 3 Feb 2026 10:00:00 +0900</pubDate>
+      <enclosure url="https://example.com/ep2.mp3" length="2048" type="audio/mpeg"/>
+      <itunes:duration>01:02:03</itunes:duration>
+      <itunes:summary>iTunes の概要</itunes:summary>
+      <itunes:image href="https://example.com/ep2.png"/>
+    </item>
+    <item>
+      <title>第1回</title>
+      <content:encoded><![CDATA[<p>HTML の説明</p>]]></content:encoded>
+      <pubDate>不明な日付</pubDate>
+      <enclosure url="https://example.com/ep1.m4a" length="abc" type="audio/x-m4a"/>
+      <itunes:duration>95</itunes:duration>
+    </item>
+  </channel>
+</rss>`
+
+const testAtom = `<?xml version="1.0" encoding="utf-8"?>
+<feed xmlns="http://www.w3.org/2005/Atom">
+  <title>Atom の番組</title>
+  <subtitle>Atom の説明</subtitle>
+  <logo>https://example.com/logo.png</logo>
+  <author><name>Atom 作者</name></author>
+  <entry>
+    <id>urn:uuid:entry-1</id>
+    <title>エントリー1</title>
+    <summary>エントリーの概要</summary>
+    <published>2026-01-05T12:00:00+09:00</published>
+    <updated>2026-01-06T12:00:00Z</updated>
+    <link rel="alternate" href="https://example.com/entry-1"/>
+    <link rel="enclosure" href="https://example.com/entry-1.mp3" type="audio/mpeg" length="4096"/>
+  </entry>
+  <entry>
+    <id>urn:uuid:entry-2</id>
+    <title>エントリー2</title>
+    <updated>2026-01-07T00:00:00Z</updated>
+  </entry>
+</feed>`
+
+func TestParse(t *testing.T) {
+	t.Run("RSS 2.0 のフィードを読み込む", func(t *testing.T) {
+		f, err := Parse(strings.NewReader(testRSS))
+		require.NoError(t, err)
+
+		assert.Equal(t, "外部ポッドキャスト", f.Title)
+		assert.Equal(t, "番組の説明 です", f.Description)
+		assert.Equal(t, "ja", f.Language)
+		assert.Equal(t, "山田太郎", f.Author)
+		assert.True(t, f.Explicit)
+		assert.Equal(t, "https://example.com/rss-image.png", f.ImageURL)
+		require.Len(t, f.Items, 2)
+
+		ep2 := f.Items[0]
+		assert.Equal(t, "ep-2", ep2.GUID)
+		assert.Equal(t, "第2回", ep2.Title)
+		assert.Equal(t, "iTunes の概要", ep2.Description)
+		assert.Equal(t, time.Date(2026, 2, 3, 1, 0, 0, 0, time.UTC), ep2.PubDate)
+		assert.Equal(t, Enclosure{URL: "https://example.com/ep2.mp3", Length: 2048, Type: "audio/mpeg"}, ep2.Enclosure)
+		assert.Equal(t, 3723000, ep2.DurationMs)
+		assert.Equal(t, "https://example.com/ep2.png", ep2.ImageURL)
+	})
+
+	t.Run("guid がない item はエンクロージャーの URL を GUID にする", func(t *testing.T) {
+		f, err := Parse(strings.NewReader(testRSS))
+		require.NoError(t, err)
+
+		ep1 := f.Items[1]
+		assert.Equal(t, "https://example.com/ep1.m4a", ep1.GUID)
+		assert.Equal(t, "<p>HTML の説明</p>", ep1.Description)
+		assert.True(t, ep1.PubDate.IsZero())
+		assert.Equal(t, int64(0), ep1.Enclosure.Length)
+		assert.Equal(t, 95000, ep1.DurationMs)
+	})
+
+	t.Run("Atom のフィードを読み込む", func(t *testing.T) {
+		f, err := Parse(strings.NewReader(testAtom))
+		require.NoError(t, err)
+
+		assert.Equal(t, "Atom の番組", f.Title)
+		assert.Equal(t, "Atom の説明", f.Description)
+		assert.Equal(t, "Atom 作者", f.Author)
+		assert.Equal(t, "https://example.com/logo.png", f.ImageURL)
+		require.Len(t, f.Items, 2)
+
+		e1 := f.Items[0]
+		assert.Equal(t, "urn:uuid:entry-1", e1.GUID)
+		assert.Equal(t, "エントリーの概要", e1.Description)
+		assert.Equal(t, "https://example.com/entry-1", e1.Link)
+		assert.Equal(t, time.Date(2026, 1, 5, 3, 0, 0, 0, time.UTC), e1.PubDate)
+		assert.Equal(t, Enclosure{URL: "https://example.com/entry-1.mp3", Length: 4096, Type: "audio/mpeg"}, e1.Enclosure)
+
+		e2 := f.Items[1]
+		assert.Equal(t, time.Date(2026, 1, 7, 0, 0, 0, 0, time.UTC), e2.PubDate)
+		assert.Empty(t, e2.Enclosure.URL)
+	})
+
+	t.Run("Marshal で出力したフィードを読み戻せる", func(t *testing.T) {
+		data, err := newTestFeed().Marshal()
+		require.NoError(t, err)
+
+		f, err := Parse(strings.NewReader(string(data)))
+		require.NoError(t, err)
+
+		want := newTestFeed()
+		assert.Equal(t, want.Title, f.Title)
+		assert.Equal(t, want.ImageURL, f.ImageURL)
+		require.Len(t, f.Items, 1)
+		assert.Equal(t, want.Items[0].GUID, f.Items[0].GUID)
+		assert.Equal(t, want.Items[0].PubDate, f.Items[0].PubDate)
+		assert.Equal(t, want.Items[0].Enclosure, f.Items[0].Enclosure)
+		assert.Equal(t, 61000, f.Items[0].DurationMs)
+	})
+
+	t.Run("RSS / Atom 以外の XML は ErrUnsupportedFormat を返す", func(t *testing.T) {
+		_, err := Parse(strings.NewReader(`<?xml version="1.0"?><html><body></body></html>`))
+
+		assert.ErrorIs(t, err, ErrUnsupportedFormat)
+	})
+
+	t.Run("空の入力は ErrUnsupportedFormat を返す", func(t *testing.T) {
+		_, err := Parse(strings.NewReader(""))
+
+		assert.ErrorIs(t, err, ErrUnsupportedFormat)
+	})
+
+	t.Run("UTF-8 以外の文字コードはエラーを返す", func(t *testing.T) {
+		_, err := Parse(strings.NewReader(`<?xml version="1.0" encoding="Shift_JIS"?><rss><channel></channel></rss>`))
+
+		require.Error(t, err)
+		assert.NotErrorIs(t, err, ErrUnsupportedFormat)
+	})
+}
+
+func TestParseDurationMs(t *testing.T) {
+	tests := []struct {
+		in   string
+		want int
+	}{
+		{"", 0},
+		{"61", 61000},
+		{"1:01", 61000},
+		{"1:00:00", 3600000},
+		{"12.5", 12500},
+		{"abc", 0},
+		{"1:2:3:4", 0},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.in, func(t *testing.T) {
+			assert.Equal(t, tt.want, parseDurationMs(tt.in))
+		})
+	}
+}
@@ -0,0 +1,67 @@
+package pronunciation
+
+import (
+	"sort"
+	"strings"
+	"unicode/utf8"
+)
+
+// Entry は発音辞書の 1 エントリ（表記 → 読み）を表す
+type Entry struct {
+	Surface string
+	Reading string
+}
+
+// Dictionary は TTS に渡すテキスト中の表記を読みに置き換える発音辞書
+//
+// 同じ位置で複数の表記に一致する場合は長い表記を優先する
+// （「東京タワー」と「東京」の両方が登録されている場合は「東京タワー」の読みを使う）
+type Dictionary struct {
+	replacer *strings.Replacer
+}
+
+// NewDictionary はエントリ一覧から発音辞書を構築する
+//
+// 同じ表記のエントリが複数ある場合は後のエントリを優先するため、
+// ユーザー辞書 → チャンネル辞書の順に渡すとチャンネル辞書の読みが使われる
+func NewDictionary(entries []Entry) *Dictionary {
+	readings := make(map[string]string, len(entries))
+	for _, e := range entries {
+		if e.Surface == "" {
+			continue
+		}
+		readings[e.Surface] = e.Reading
+	}
+
+	if len(readings) == 0 {
+		return &Dictionary{}
+	}
+
+	surfaces := make([]string, 0, len(readings))
+	for surface := range readings {
+		surfaces = append(surfaces, surface)
+	}
+	// strings.Replacer は同じ位置で一致する候補のうち引数で先に指定したものを使うため、長い順に並べる
+	sort.Slice(surfaces, func(i, j int) bool {
+		li, lj := utf8.RuneCountInString(surfaces[i]), utf8.RuneCountInString(surfaces[j])
+		if li != lj {
+			return li > lj
+		}
+		return surfaces[i] < surfaces[j]
+	})
+
+	oldnew := make([]string, 0, len(surfaces)*2)
+	for _, surface := range surfaces {
+		oldnew = append(oldnew, surface, readings[surface])
+	}
+
+	return &Dictionary{replacer: strings.NewReplacer(oldnew...)}
+}
+
+// Apply はテキスト中の表記を読みに置き換えたテキストを返す
+func (d *Dictionary) Apply(text string) string {
+	if d == nil || d.replacer == nil {
+		return text
+	}
+	return d.replacer.Replace(text)
+}
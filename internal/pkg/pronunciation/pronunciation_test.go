@@ -0,0 +1,46 @@
+package pronunciation
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestDictionary_Apply(t *testing.T) {
+	t.Run("表記を読みに置き換える", func(t *testing.T) {
+		dict := NewDictionary([]Entry{
+			{Surface: "API", Reading: "エーピーアイ"},
+			{Surface: "杏寿郎", Reading: "きょうじゅろう"},
+		})
+
+		assert.Equal(t, "エーピーアイの話をきょうじゅろうとする", dict.Apply("APIの話を杏寿郎とする"))
+	})
+
+	t.Run("長い表記を優先する", func(t *testing.T) {
+		dict := NewDictionary([]Entry{
+			{Surface: "東京", Reading: "とうきょう"},
+			{Surface: "東京タワー", Reading: "トーキョータワー"},
+		})
+
+		assert.Equal(t, "トーキョータワーととうきょう駅", dict.Apply("東京タワーと東京駅"))
+	})
+
+	t.Run("同じ表記は後のエントリを優先する", func(t *testing.T) {
+		dict := NewDictionary([]Entry{
+			{Surface: "AI", Reading: "エーアイ"},
+			{Surface: "AI", Reading: "アイ"},
+		})
+
+		assert.Equal(t, "アイ", dict.Apply("AI"))
+	})
+
+	t.Run("エントリがない場合はそのまま返す", func(t *testing.T) {
+		assert.Equal(t, "こんにちは", NewDictionary(nil).Apply("こんにちは"))
+	})
+
+	t.Run("nil の辞書はそのまま返す", func(t *testing.T) {
+		var dict *Dictionary
+
+		assert.Equal(t, "こんにちは", dict.Apply("こんにちは"))
+	})
+}
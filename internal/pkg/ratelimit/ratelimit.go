@@ -0,0 +1,66 @@
+package ratelimit
+
+import (
+	"sync"
+	"time"
+)
+
+// Limiter はキーごとに一定時間内のリクエスト数を制限する固定ウィンドウ方式のレートリミッター
+//
+// カウンタはプロセス内のメモリに保持するため、複数インスタンスで動作する場合は
+// インスタンスごとに制限がかかる
+type Limiter struct {
+	mu      sync.Mutex
+	limit   int
+	window  time.Duration
+	windows map[string]*counter
+	now     func() time.Time
+}
+
+type counter struct {
+	start time.Time
+	count int
+}
+
+// New は window あたり limit 回までリクエストを許可する Limiter を生成する
+func New(limit int, window time.Duration) *Limiter {
+	return &Limiter{
+		limit:   limit,
+		window:  window,
+		windows: make(map[string]*counter),
+		now:     time.Now,
+	}
+}
+
+// Allow はキーのリクエストを 1 回分消費し、許可されたかどうかを返す
+//
+// 許可されなかった場合は、次のウィンドウが始まるまでの待ち時間を返す
+func (l *Limiter) Allow(key string) (bool, time.Duration) {
+	l.mu.Lock()
+	defer l.mu.Unlock()
+
+	now := l.now()
+	l.sweep(now)
+
+	w, ok := l.windows[key]
+	if !ok || now.Sub(w.start) >= l.window {
+		w = &counter{start: now}
+		l.windows[key] = w
+	}
+
+	if w.count >= l.limit {
+		return false, w.start.Add(l.window).Sub(now)
+	}
+
+	w.count++
+	return true, 0
+}
+
+// sweep は期限切れのウィンドウを削除する
+func (l *Limiter) sweep(now time.Time) {
+	for key, w := range l.windows {
+		if now.Sub(w.start) >= l.window {
+			delete(l.windows, key)
+		}
+	}
+}
@@ -0,0 +1,66 @@
+package ratelimit
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func newTestLimiter(limit int, window time.Duration, now *time.Time) *Limiter {
+	l := New(limit, window)
+	l.now = func() time.Time { return *now }
+	return l
+}
+
+func TestLimiter_Allow(t *testing.T) {
+	t.Run("上限までは許可し、超えた場合は待ち時間を返す", func(t *testing.T) {
+		now := time.Date(2025, 1, 1, 0, 0, 0, 0, time.UTC)
+		l := newTestLimiter(2, time.Minute, &now)
+
+		ok, _ := l.Allow("user-1")
+		assert.True(t, ok)
+		ok, _ = l.Allow("user-1")
+		assert.True(t, ok)
+
+		now = now.Add(20 * time.Second)
+		ok, retryAfter := l.Allow("user-1")
+		assert.False(t, ok)
+		assert.Equal(t, 40*time.Second, retryAfter)
+	})
+
+	t.Run("キーごとに独立して制限する", func(t *testing.T) {
+		now := time.Date(2025, 1, 1, 0, 0, 0, 0, time.UTC)
+		l := newTestLimiter(1, time.Minute, &now)
+
+		ok, _ := l.Allow("user-1")
+		assert.True(t, ok)
+		ok, _ = l.Allow("user-2")
+		assert.True(t, ok)
+	})
+
+	t.Run("ウィンドウが経過するとリセットされる", func(t *testing.T) {
+		now := time.Date(2025, 1, 1, 0, 0, 0, 0, time.UTC)
+		l := newTestLimiter(1, time.Minute, &now)
+
+		ok, _ := l.Allow("user-1")
+		assert.True(t, ok)
+		ok, _ = l.Allow("user-1")
+		assert.False(t, ok)
+
+		now = now.Add(time.Minute)
+		ok, _ = l.Allow("user-1")
+		assert.True(t, ok)
+	})
+
+	t.Run("期限切れのウィンドウは削除される", func(t *testing.T) {
+		now := time.Date(2025, 1, 1, 0, 0, 0, 0, time.UTC)
+		l := newTestLimiter(1, time.Minute, &now)
+
+		l.Allow("user-1")
+		now = now.Add(2 * time.Minute)
+		l.Allow("user-2")
+
+		assert.Len(t, l.windows, 1)
+	})
+}
@@ -0,0 +1,67 @@
+package safehttp
+
+import (
+	"net"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestIsPublicIP(t *testing.T) {
+	tests := []struct {
+		name string
+		ip   string
+		want bool
+	}{
+		{"グローバルな IPv4", "93.184.216.34", true},
+		{"グローバルな IPv6", "2606:2800:220:1:248:1893:25c8:1946", true},
+		{"ループバック", "127.0.0.1", false},
+		{"IPv6 ループバック", "::1", false},
+		{"プライベート 10/8", "10.0.0.1", false},
+		{"プライベート 172.16/12", "172.16.5.4", false},
+		{"プライベート 192.168/16", "192.168.1.1", false},
+		{"リンクローカル（メタデータサーバー）", "169.254.169.254", false},
+		{"未指定アドレス", "0.0.0.0", false},
+		{"キャリアグレード NAT", "100.64.0.1", false},
+		{"ユニークローカル IPv6", "fd00::1", false},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			assert.Equal(t, tt.want, IsPublicIP(net.ParseIP(tt.ip)))
+		})
+	}
+}
+
+func TestNewClient(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, _ *http.Request) {
+		_, _ = w.Write([]byte("ok"))
+	}))
+	defer server.Close()
+
+	t.Run("プライベートネットワークへの接続を拒否する", func(t *testing.T) {
+		client := NewClient(Config{Timeout: 5 * time.Second})
+
+		resp, err := client.Get(server.URL)
+		if resp != nil {
+			_ = resp.Body.Close()
+		}
+
+		require.Error(t, err)
+		assert.ErrorIs(t, err, ErrDisallowedAddress)
+	})
+
+	t.Run("AllowPrivateNetwork が有効な場合は接続できる", func(t *testing.T) {
+		client := NewClient(Config{Timeout: 5 * time.Second, AllowPrivateNetwork: true})
+
+		resp, err := client.Get(server.URL)
+		require.NoError(t, err)
+		defer func() { _ = resp.Body.Close() }()
+
+		assert.Equal(t, http.StatusOK, resp.StatusCode)
+	})
+}
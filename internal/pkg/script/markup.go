@@ -0,0 +1,304 @@
+package script
+
+import (
+	"fmt"
+	"regexp"
+	"slices"
+	"strconv"
+	"strings"
+	"time"
+)
+
+// MarkupKind はセリフ内マークアップのセグメント種別
+type MarkupKind string
+
+const (
+	MarkupKindText     MarkupKind = "text"
+	MarkupKindPause    MarkupKind = "pause"
+	MarkupKindEmphasis MarkupKind = "emphasis"
+	MarkupKindSFX      MarkupKind = "sfx"
+)
+
+const (
+	// MinPauseDuration はポーズの最短時間
+	MinPauseDuration = 100 * time.Millisecond
+	// MaxPauseDuration はポーズの最長時間
+	MaxPauseDuration = 5 * time.Second
+)
+
+// MarkupSegment はセリフをマークアップで分割した 1 区間
+type MarkupSegment struct {
+	Kind     MarkupKind    // セグメント種別
+	Text     string        // テキスト（text / emphasis のみ）
+	Duration time.Duration // ポーズの長さ（pause のみ）
+	SFX      string        // 効果音名（sfx のみ）
+}
+
+// セリフ内マークアップタグ: [pause:800ms] / [em:強調するテキスト] / [sfx:効果音名]
+var markupTagRegex = regexp.MustCompile(`\[(pause|em|sfx):([^\[\]]*)\]`)
+
+// 閉じられていない・入れ子になったマークアップタグの検出用
+var markupOpenRegex = regexp.MustCompile(`\[(pause|em|sfx):`)
+
+// 効果音名に使用できる文字
+var sfxNameRegex = regexp.MustCompile(`^[a-z0-9_-]{1,50}$`)
+
+// ParseMarkup はセリフをマークアップタグで分割したセグメント一覧を返す
+//
+// マークアップ:
+//
+//	[pause:800ms] / [pause:1.5s]  指定時間のポーズ（100ms〜5s）
+//	[em:テキスト]                  テキストを強調して読む
+//	[sfx:効果音名]                 効果音（英小文字・数字・_・- のみ）
+//
+// 上記以外の角括弧はテキストとして扱う。タグの書式が不正な場合はエラーを返す
+func ParseMarkup(text string) ([]MarkupSegment, error) {
+	var segments []MarkupSegment
+	appendText := func(s string) error {
+		if s == "" {
+			return nil
+		}
+		if markupOpenRegex.MatchString(s) {
+			return fmt.Errorf("マークアップタグが正しく閉じられていません")
+		}
+		segments = append(segments, MarkupSegment{Kind: MarkupKindText, Text: s})
+		return nil
+	}
+
+	pos := 0
+	for _, m := range markupTagRegex.FindAllStringSubmatchIndex(text, -1) {
+		if err := appendText(text[pos:m[0]]); err != nil {
+			return nil, err
+		}
+		pos = m[1]
+
+		name := text[m[2]:m[3]]
+		value := strings.TrimSpace(text[m[4]:m[5]])
+		switch name {
+		case "pause":
+			d, err := parsePauseDuration(value)
+			if err != nil {
+				return nil, err
+			}
+			segments = append(segments, MarkupSegment{Kind: MarkupKindPause, Duration: d})
+		case "em":
+			if value == "" {
+				return nil, fmt.Errorf("強調するテキストが空です")
+			}
+			segments = append(segments, MarkupSegment{Kind: MarkupKindEmphasis, Text: value})
+		case "sfx":
+			if !sfxNameRegex.MatchString(value) {
+				return nil, fmt.Errorf("効果音名が不正です: %q（英小文字・数字・_・- の50文字以内）", value)
+			}
+			segments = append(segments, MarkupSegment{Kind: MarkupKindSFX, SFX: value})
+		}
+	}
+	if err := appendText(text[pos:]); err != nil {
+		return nil, err
+	}
+
+	return segments, nil
+}
+
+// parsePauseDuration はポーズの長さ（"800ms" / "1.5s"）をパースする
+func parsePauseDuration(value string) (time.Duration, error) {
+	var d time.Duration
+	switch {
+	case strings.HasSuffix(value, "ms"):
+		n, err := strconv.Atoi(strings.TrimSuffix(value, "ms"))
+		if err != nil {
+			return 0, fmt.Errorf("ポーズの長さが不正です: %q", value)
+		}
+		d = time.Duration(n) * time.Millisecond
+	case strings.HasSuffix(value, "s"):
+		f, err := strconv.ParseFloat(strings.TrimSuffix(value, "s"), 64)
+		if err != nil {
+			return 0, fmt.Errorf("ポーズの長さが不正です: %q", value)
+		}
+		d = time.Duration(f * float64(time.Second))
+	default:
+		return 0, fmt.Errorf("ポーズの長さには単位（ms または s）が必要です: %q", value)
+	}
+
+	if d < MinPauseDuration || d > MaxPauseDuration {
+		return 0, fmt.Errorf("ポーズの長さは%dms〜%dmsで指定してください: %q", MinPauseDuration.Milliseconds(), MaxPauseDuration.Milliseconds(), value)
+	}
+	return d, nil
+}
+
+// ValidateMarkup はセリフ内のマークアップタグの書式を検証する
+func ValidateMarkup(text string) error {
+	_, err := ParseMarkup(text)
+	return err
+}
+
+// HasMarkup はセリフにマークアップタグが含まれるかどうかを返す
+func HasMarkup(text string) bool {
+	return markupOpenRegex.MatchString(text)
+}
+
+// PlainText はセリフからマークアップを除いた読み上げテキストを返す
+//
+// 強調はテキストのみ残し、ポーズ・効果音は除去する。
+// 文字数チェックや STT アライメントなど、実際に発話される文字列が必要な箇所で使用する。
+// マークアップの書式が不正な場合はそのまま返す
+func PlainText(text string) string {
+	if !HasMarkup(text) {
+		return text
+	}
+	segments, err := ParseMarkup(text)
+	if err != nil {
+		return text
+	}
+
+	var b strings.Builder
+	for _, seg := range segments {
+		if seg.Kind == MarkupKindText || seg.Kind == MarkupKindEmphasis {
+			b.WriteString(seg.Text)
+		}
+	}
+	return strings.Join(strings.Fields(b.String()), " ")
+}
+
+// MapMarkupText はセリフ中のテキスト部分（通常テキスト・強調）にのみ fn を適用する
+//
+// 発音辞書の置換がタグ内の効果音名などを書き換えないようにするために使用する。
+// マークアップの書式が不正な場合はセリフ全体に fn を適用する
+func MapMarkupText(text string, fn func(string) string) string {
+	if !HasMarkup(text) {
+		return fn(text)
+	}
+	segments, err := ParseMarkup(text)
+	if err != nil {
+		return fn(text)
+	}
+
+	for i := range segments {
+		if segments[i].Kind == MarkupKindText || segments[i].Kind == MarkupKindEmphasis {
+			segments[i].Text = fn(segments[i].Text)
+		}
+	}
+	return FormatMarkup(segments)
+}
+
+// FormatMarkup はセグメント一覧をマークアップ付きのセリフに戻す
+func FormatMarkup(segments []MarkupSegment) string {
+	var b strings.Builder
+	for _, seg := range segments {
+		switch seg.Kind {
+		case MarkupKindText:
+			b.WriteString(seg.Text)
+		case MarkupKindPause:
+			fmt.Fprintf(&b, "[pause:%dms]", seg.Duration.Milliseconds())
+		case MarkupKindEmphasis:
+			fmt.Fprintf(&b, "[em:%s]", seg.Text)
+		case MarkupKindSFX:
+			fmt.Fprintf(&b, "[sfx:%s]", seg.SFX)
+		}
+	}
+	return b.String()
+}
+
+// SplitEdgeMarkup はセリフの先頭・末尾にあるポーズ・効果音を本文から切り出す
+//
+// 再アセンブル時に先頭・末尾のポーズ・効果音を TTS に任せず音声として挿入するために使用する。
+// 空白のみのテキストは無視する。マークアップの書式が不正な場合はセリフをそのまま本文として返す
+func SplitEdgeMarkup(text string) (leading []MarkupSegment, body string, trailing []MarkupSegment) {
+	if !HasMarkup(text) {
+		return nil, text, nil
+	}
+	segments, err := ParseMarkup(text)
+	if err != nil {
+		return nil, text, nil
+	}
+
+	isEdge := func(seg MarkupSegment) bool {
+		return seg.Kind == MarkupKindPause || seg.Kind == MarkupKindSFX ||
+			(seg.Kind == MarkupKindText && strings.TrimSpace(seg.Text) == "")
+	}
+
+	start := 0
+	for start < len(segments) && isEdge(segments[start]) {
+		if segments[start].Kind != MarkupKindText {
+			leading = append(leading, segments[start])
+		}
+		start++
+	}
+	end := len(segments)
+	for end > start && isEdge(segments[end-1]) {
+		end--
+	}
+	for _, seg := range segments[end:] {
+		if seg.Kind != MarkupKindText {
+			trailing = append(trailing, seg)
+		}
+	}
+
+	return leading, strings.TrimSpace(FormatMarkup(segments[start:end])), trailing
+}
+
+// SFXNames はセリフ中の効果音名を重複を除いて出現順に返す
+//
+// マークアップの書式が不正な場合は nil を返す
+func SFXNames(text string) []string {
+	if !HasMarkup(text) {
+		return nil
+	}
+	segments, err := ParseMarkup(text)
+	if err != nil {
+		return nil
+	}
+
+	var names []string
+	for _, seg := range segments {
+		if seg.Kind == MarkupKindSFX && !slices.Contains(names, seg.SFX) {
+			names = append(names, seg.SFX)
+		}
+	}
+	return names
+}
+
+// SplitAtInlineSFX はセリフの途中にある効果音の位置でセリフを分割する
+//
+// 分割後の各セリフでは効果音が先頭・末尾にのみ現れるため、再アセンブル時に SplitEdgeMarkup で切り出して
+// 効果音の音声を挿入できる。効果音は直前のセリフの末尾に含める。
+// 途中に効果音がない場合やマークアップの書式が不正な場合はセリフをそのまま返す
+func SplitAtInlineSFX(text string) []string {
+	if !HasMarkup(text) {
+		return []string{text}
+	}
+	segments, err := ParseMarkup(text)
+	if err != nil {
+		return []string{text}
+	}
+
+	isSpoken := func(seg MarkupSegment) bool {
+		return seg.Kind == MarkupKindEmphasis ||
+			(seg.Kind == MarkupKindText && strings.TrimSpace(seg.Text) != "")
+	}
+
+	var parts []string
+	start := 0
+	spoken := false
+	for i, seg := range segments {
+		if isSpoken(seg) {
+			spoken = true
+			continue
+		}
+		if seg.Kind != MarkupKindSFX || !spoken {
+			continue
+		}
+		// 以降に発話がなければ末尾の効果音のため分割しない
+		if !slices.ContainsFunc(segments[i+1:], isSpoken) {
+			break
+		}
+		parts = append(parts, strings.TrimSpace(FormatMarkup(segments[start:i+1])))
+		start = i + 1
+		spoken = false
+	}
+	if len(parts) == 0 {
+		return []string{text}
+	}
+
+	return append(parts, strings.TrimSpace(FormatMarkup(segments[start:])))
+}
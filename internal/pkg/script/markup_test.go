@@ -0,0 +1,143 @@
+package script
+
+import (
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestParseMarkup(t *testing.T) {
+	t.Run("マークアップを含まないテキストは 1 セグメントになる", func(t *testing.T) {
+		segments, err := ParseMarkup("こんにちは")
+
+		require.NoError(t, err)
+		assert.Equal(t, []MarkupSegment{{Kind: MarkupKindText, Text: "こんにちは"}}, segments)
+	})
+
+	t.Run("ポーズ・強調・効果音をセグメントに分割する", func(t *testing.T) {
+		segments, err := ParseMarkup("それは[pause:800ms][em:本当に]すごい[sfx:door_knock]")
+
+		require.NoError(t, err)
+		assert.Equal(t, []MarkupSegment{
+			{Kind: MarkupKindText, Text: "それは"},
+			{Kind: MarkupKindPause, Duration: 800 * time.Millisecond},
+			{Kind: MarkupKindEmphasis, Text: "本当に"},
+			{Kind: MarkupKindText, Text: "すごい"},
+			{Kind: MarkupKindSFX, SFX: "door_knock"},
+		}, segments)
+	})
+
+	t.Run("ポーズは秒単位でも指定できる", func(t *testing.T) {
+		segments, err := ParseMarkup("[pause:1.5s]")
+
+		require.NoError(t, err)
+		assert.Equal(t, 1500*time.Millisecond, segments[0].Duration)
+	})
+
+	t.Run("マークアップ以外の角括弧はテキストとして扱う", func(t *testing.T) {
+		segments, err := ParseMarkup("[笑] それは面白いね")
+
+		require.NoError(t, err)
+		assert.Equal(t, []MarkupSegment{{Kind: MarkupKindText, Text: "[笑] それは面白いね"}}, segments)
+	})
+
+	t.Run("不正なマークアップはエラーを返す", func(t *testing.T) {
+		tests := []struct {
+			name string
+			text string
+		}{
+			{name: "単位のないポーズ", text: "あ[pause:800]い"},
+			{name: "数値でないポーズ", text: "あ[pause:longms]い"},
+			{name: "短すぎるポーズ", text: "あ[pause:50ms]い"},
+			{name: "長すぎるポーズ", text: "あ[pause:6s]い"},
+			{name: "空の強調", text: "あ[em:]い"},
+			{name: "不正な効果音名", text: "あ[sfx:Door Knock]い"},
+			{name: "閉じられていないタグ", text: "あ[em:いう"},
+			{name: "入れ子のタグ", text: "[em:あ[pause:500ms]い]"},
+		}
+		for _, tt := range tests {
+			t.Run(tt.name, func(t *testing.T) {
+				_, err := ParseMarkup(tt.text)
+				assert.Error(t, err)
+			})
+		}
+	})
+}
+
+func TestPlainText(t *testing.T) {
+	assert.Equal(t, "こんにちは", PlainText("こんにちは"))
+	assert.Equal(t, "それは本当にすごい", PlainText("それは[pause:800ms][em:本当に]すごい[sfx:applause]"))
+	assert.Equal(t, "ドアが 開いた", PlainText("ドアが [sfx:door] 開いた"))
+	// 書式が不正な場合はそのまま返す
+	assert.Equal(t, "あ[pause:1]い", PlainText("あ[pause:1]い"))
+}
+
+func TestMapMarkupText(t *testing.T) {
+	fn := strings.ToUpper
+
+	assert.Equal(t, "ABC", MapMarkupText("abc", fn))
+	assert.Equal(t, "ABC[pause:500ms][em:DEF][sfx:door]", MapMarkupText("abc[pause:0.5s][em:def][sfx:door]", fn))
+}
+
+func TestSplitEdgeMarkup(t *testing.T) {
+	t.Run("先頭・末尾のポーズと効果音を切り出す", func(t *testing.T) {
+		leading, body, trailing := SplitEdgeMarkup("[sfx:door] [pause:1s] こんにちは[pause:300ms]元気？ [pause:2s]")
+
+		assert.Equal(t, []MarkupSegment{
+			{Kind: MarkupKindSFX, SFX: "door"},
+			{Kind: MarkupKindPause, Duration: time.Second},
+		}, leading)
+		assert.Equal(t, "こんにちは[pause:300ms]元気？", body)
+		assert.Equal(t, []MarkupSegment{{Kind: MarkupKindPause, Duration: 2 * time.Second}}, trailing)
+	})
+
+	t.Run("先頭・末尾の強調は本文に残す", func(t *testing.T) {
+		leading, body, trailing := SplitEdgeMarkup("[em:本当に]すごい")
+
+		assert.Empty(t, leading)
+		assert.Equal(t, "[em:本当に]すごい", body)
+		assert.Empty(t, trailing)
+	})
+
+	t.Run("マークアップを含まない場合はそのまま返す", func(t *testing.T) {
+		leading, body, trailing := SplitEdgeMarkup("こんにちは")
+
+		assert.Empty(t, leading)
+		assert.Equal(t, "こんにちは", body)
+		assert.Empty(t, trailing)
+	})
+}
+
+func TestSFXNames(t *testing.T) {
+	t.Run("効果音名を重複を除いて出現順に返す", func(t *testing.T) {
+		assert.Equal(t, []string{"door", "applause"}, SFXNames("[sfx:door] こんにちは[sfx:applause]元気？[sfx:door]"))
+	})
+
+	t.Run("効果音がない場合は nil を返す", func(t *testing.T) {
+		assert.Nil(t, SFXNames("こんにちは[pause:1s]"))
+		assert.Nil(t, SFXNames("こんにちは"))
+	})
+}
+
+func TestSplitAtInlineSFX(t *testing.T) {
+	t.Run("途中の効果音の位置で分割し効果音を直前のセリフの末尾に含める", func(t *testing.T) {
+		parts := SplitAtInlineSFX("[sfx:door] ドアが[pause:500ms][sfx:creak]開いた。[sfx:chime][sfx:bell][em:誰]だろう[sfx:applause]")
+
+		assert.Equal(t, []string{
+			"[sfx:door] ドアが[pause:500ms][sfx:creak]",
+			"開いた。[sfx:chime]",
+			"[sfx:bell][em:誰]だろう[sfx:applause]",
+		}, parts)
+	})
+
+	t.Run("先頭・末尾にのみ効果音がある場合はそのまま返す", func(t *testing.T) {
+		assert.Equal(t, []string{"[sfx:door] こんにちは [sfx:applause]"}, SplitAtInlineSFX("[sfx:door] こんにちは [sfx:applause]"))
+	})
+
+	t.Run("マークアップを含まない場合はそのまま返す", func(t *testing.T) {
+		assert.Equal(t, []string{"こんにちは"}, SplitAtInlineSFX("こんにちは"))
+	})
+}
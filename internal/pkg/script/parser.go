@@ -30,6 +30,25 @@ const MaxImportLines = 200
 // 感情を抽出する正規表現: [感情] パターン
 var emotionRegex = regexp.MustCompile(`^\[([^\]]+)\]\s*`)
 
+// matchEmotion は行頭の感情タグを取り出す
+//
+// 行頭のマークアップタグ（[pause:...] 等）は感情タグとして扱わない
+func matchEmotion(content string) (string, bool) {
+	matches := emotionRegex.FindStringSubmatch(content)
+	if len(matches) < 2 || markupOpenRegex.MatchString(matches[0]) {
+		return "", false
+	}
+	return matches[1], true
+}
+
+// removeEmotion は行頭の感情タグを除去する
+func removeEmotion(content string) string {
+	if _, ok := matchEmotion(content); !ok {
+		return content
+	}
+	return emotionRegex.ReplaceAllString(content, "")
+}
+
 // Parse は台本テキストをパースして ParsedLine のスライスに変換する
 //
 // フォーマット:
@@ -37,6 +56,8 @@ var emotionRegex = regexp.MustCompile(`^\[([^\]]+)\]\s*`)
 //	話者名: [感情] セリフ
 //
 // - 感情は省略可能
+// - セリフにはマークアップ（[pause:800ms] / [em:テキスト] / [sfx:効果音名]）を含められる
+// - マークアップの書式が不正な場合はエラー
 // - allowedSpeakers に含まれない話者名はエラー
 func Parse(text string, allowedSpeakers []string) ParseResult {
 	result := ParseResult{
@@ -103,14 +124,22 @@ func Parse(text string, allowedSpeakers []string) ParseResult {
 		// 感情を抽出
 		var emotion *string
 		text := content
-		if matches := emotionRegex.FindStringSubmatch(content); len(matches) > 1 {
-			e := matches[1]
+		if e, ok := matchEmotion(content); ok {
 			emotion = &e
-			text = strings.TrimSpace(emotionRegex.ReplaceAllString(content, ""))
+			text = strings.TrimSpace(removeEmotion(content))
+		}
+
+		// マークアップの書式が不正
+		if err := ValidateMarkup(text); err != nil {
+			result.Errors = append(result.Errors, ParseError{
+				Line:   lineNum,
+				Reason: err.Error(),
+			})
+			continue
 		}
 
-		// 感情を抽出した後、セリフが空になった場合
-		if text == "" {
+		// 感情・マークアップを除いた後、セリフが空になった場合
+		if strings.TrimSpace(PlainText(text)) == "" {
 			result.Errors = append(result.Errors, ParseError{
 				Line:   lineNum,
 				Reason: "セリフが空です",
@@ -153,7 +182,7 @@ func StripEmotionTags(text string) string {
 		speaker := strings.TrimSpace(trimmed[:colonIdx])
 		content := strings.TrimSpace(trimmed[colonIdx+1:])
 		// 感情タグを除去
-		content = emotionRegex.ReplaceAllString(content, "")
+		content = removeEmotion(content)
 		content = strings.TrimSpace(content)
 		if content == "" {
 			continue
@@ -180,7 +209,7 @@ func CapEmotionTags(text string, maxTags int) string {
 			continue
 		}
 		content := strings.TrimSpace(trimmed[colonIdx+1:])
-		if emotionRegex.MatchString(content) {
+		if _, ok := matchEmotion(content); ok {
 			taggedIndices = append(taggedIndices, i)
 		}
 	}
@@ -203,7 +232,7 @@ func CapEmotionTags(text string, maxTags int) string {
 			colonIdx := strings.Index(trimmed, ":")
 			speaker := strings.TrimSpace(trimmed[:colonIdx])
 			content := strings.TrimSpace(trimmed[colonIdx+1:])
-			content = emotionRegex.ReplaceAllString(content, "")
+			content = removeEmotion(content)
 			content = strings.TrimSpace(content)
 			result[i] = speaker + ": " + content
 		} else {
@@ -65,6 +65,27 @@ func TestParse(t *testing.T) {
 			wantLines:       0,
 			wantErrors:      1,
 		},
+		{
+			name:            "正常系: マークアップ付きのセリフ",
+			text:            "太郎: [excited] それは[pause:500ms][em:本当に]すごい[sfx:applause]",
+			allowedSpeakers: []string{"太郎"},
+			wantLines:       1,
+			wantErrors:      0,
+		},
+		{
+			name:            "エラー: 不正なマークアップ",
+			text:            "太郎: ちょっと待って[pause:10s]",
+			allowedSpeakers: []string{"太郎"},
+			wantLines:       0,
+			wantErrors:      1,
+		},
+		{
+			name:            "エラー: マークアップのみでセリフが空",
+			text:            "太郎: [sfx:door] [pause:1s]",
+			allowedSpeakers: []string{"太郎"},
+			wantLines:       0,
+			wantErrors:      1,
+		},
 		{
 			name:            "複合: 正常行とエラー行が混在",
 			text:            "太郎: こんにちは\n三郎: やあ\n花子: 元気？",
@@ -119,6 +140,22 @@ func TestParse_EmotionExtraction(t *testing.T) {
 	}
 }
 
+func TestParse_MarkupAtLineStart(t *testing.T) {
+	result := Parse("太郎: [pause:1s] こんにちは", []string{"太郎"})
+
+	if len(result.Lines) != 1 {
+		t.Fatalf("expected 1 line, got %d", len(result.Lines))
+	}
+
+	// 行頭のマークアップタグは感情として扱わない
+	if result.Lines[0].Emotion != nil {
+		t.Errorf("expected no emotion, got '%s'", *result.Lines[0].Emotion)
+	}
+	if result.Lines[0].Text != "[pause:1s] こんにちは" {
+		t.Errorf("expected text '[pause:1s] こんにちは', got '%s'", result.Lines[0].Text)
+	}
+}
+
 func TestParse_SpeakerExtraction(t *testing.T) {
 	text := `太郎: こんにちは
 花子: やあ`
@@ -79,10 +79,12 @@ func Validate(lines []ParsedLine, config ValidatorConfig) ValidationResult {
 }
 
 // checkLineLengths は全セリフが6〜120文字以内かチェックする
+//
+// 文字数はマークアップを除いた読み上げテキストで数える
 func checkLineLengths(lines []ParsedLine) []ValidationIssue {
 	var issues []ValidationIssue
 	for i, line := range lines {
-		length := utf8.RuneCountInString(line.Text)
+		length := utf8.RuneCountInString(PlainText(line.Text))
 		if length < 6 {
 			issues = append(issues, ValidationIssue{
 				Check:   "line_length",
@@ -125,7 +127,7 @@ func checkLengthVariance(lines []ParsedLine) []ValidationIssue {
 	lengths := make([]float64, len(lines))
 	var sum float64
 	for i, line := range lines {
-		l := float64(utf8.RuneCountInString(line.Text))
+		l := float64(utf8.RuneCountInString(PlainText(line.Text)))
 		lengths[i] = l
 		sum += l
 	}
@@ -230,7 +232,7 @@ func checkTotalCharacterCount(lines []ParsedLine, durationMinutes int) []Validat
 
 	var totalChars int
 	for _, line := range lines {
-		totalChars += utf8.RuneCountInString(line.Text)
+		totalChars += utf8.RuneCountInString(PlainText(line.Text))
 	}
 
 	target := durationMinutes * CharsPerMinute
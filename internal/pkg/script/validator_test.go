@@ -57,6 +57,23 @@ func TestValidate_LineLengths(t *testing.T) {
 		}
 		assert.True(t, hasLineLengthIssue)
 	})
+
+	t.Run("マークアップを除いた文字数で判定する", func(t *testing.T) {
+		lines := []ParsedLine{
+			{SpeakerName: "太郎", Text: "短い[pause:1500ms][sfx:applause]"},
+		}
+		config := ValidatorConfig{TalkMode: TalkModeMonologue, DurationMinutes: 1}
+		result := Validate(lines, config)
+
+		hasLineLengthIssue := false
+		for _, issue := range result.Issues {
+			if issue.Check == "line_length" {
+				hasLineLengthIssue = true
+			}
+		}
+		assert.True(t, hasLineLengthIssue)
+	})
+
 }
 
 func TestValidate_MinimumLines(t *testing.T) {
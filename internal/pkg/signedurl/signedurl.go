@@ -0,0 +1,74 @@
+package signedurl
+
+import (
+	"crypto/hmac"
+	"crypto/sha256"
+	"encoding/hex"
+	"errors"
+	"net/url"
+	"strconv"
+	"time"
+)
+
+// クエリパラメータ名
+const (
+	QueryExpires   = "expires"
+	QuerySignature = "signature"
+)
+
+var (
+	// ErrInvalidSignature は署名が一致しない、または有効期限の形式が不正な場合のエラー
+	ErrInvalidSignature = errors.New("invalid signature")
+	// ErrExpired は有効期限が切れている場合のエラー
+	ErrExpired = errors.New("signed url expired")
+)
+
+// Signer は HMAC-SHA256 でリソースのパスと有効期限に署名する
+type Signer struct {
+	secret []byte
+}
+
+// NewSigner は署名用の Signer を作成する
+func NewSigner(secret string) *Signer {
+	return &Signer{secret: []byte(secret)}
+}
+
+// Sign は path と有効期限（Unix 秒）に対する署名を 16 進文字列で返す
+func (s *Signer) Sign(path string, expiresAt int64) string {
+	mac := hmac.New(sha256.New, s.secret)
+	mac.Write([]byte(path))
+	mac.Write([]byte{'\n'})
+	mac.Write([]byte(strconv.FormatInt(expiresAt, 10)))
+	return hex.EncodeToString(mac.Sum(nil))
+}
+
+// Query は path の署名付きクエリパラメータ（expires, signature）を返す
+func (s *Signer) Query(path string, expiresAt time.Time) url.Values {
+	expires := expiresAt.Unix()
+
+	q := url.Values{}
+	q.Set(QueryExpires, strconv.FormatInt(expires, 10))
+	q.Set(QuerySignature, s.Sign(path, expires))
+	return q
+}
+
+// Verify は path に対する署名と有効期限を検証する
+//
+// 署名が一致しない場合は ErrInvalidSignature、有効期限が now 以前の場合は ErrExpired を返す
+func (s *Signer) Verify(path, expires, signature string, now time.Time) error {
+	expiresAt, err := strconv.ParseInt(expires, 10, 64)
+	if err != nil {
+		return ErrInvalidSignature
+	}
+
+	expected := s.Sign(path, expiresAt)
+	if !hmac.Equal([]byte(expected), []byte(signature)) {
+		return ErrInvalidSignature
+	}
+
+	if !now.Before(time.Unix(expiresAt, 0)) {
+		return ErrExpired
+	}
+
+	return nil
+}
@@ -0,0 +1,59 @@
+package signedurl
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestSigner(t *testing.T) {
+	signer := NewSigner("secret")
+	now := time.Date(2026, 1, 1, 0, 0, 0, 0, time.UTC)
+
+	t.Run("Query で生成した署名を検証できる", func(t *testing.T) {
+		q := signer.Query("audios/a.mp3", now.Add(time.Hour))
+
+		err := signer.Verify("audios/a.mp3", q.Get(QueryExpires), q.Get(QuerySignature), now)
+
+		assert.NoError(t, err)
+	})
+
+	t.Run("パスが異なる場合は ErrInvalidSignature を返す", func(t *testing.T) {
+		q := signer.Query("audios/a.mp3", now.Add(time.Hour))
+
+		err := signer.Verify("audios/b.mp3", q.Get(QueryExpires), q.Get(QuerySignature), now)
+
+		assert.ErrorIs(t, err, ErrInvalidSignature)
+	})
+
+	t.Run("有効期限を書き換えた場合は ErrInvalidSignature を返す", func(t *testing.T) {
+		q := signer.Query("audios/a.mp3", now.Add(time.Hour))
+
+		err := signer.Verify("audios/a.mp3", "9999999999", q.Get(QuerySignature), now)
+
+		assert.ErrorIs(t, err, ErrInvalidSignature)
+	})
+
+	t.Run("シークレットが異なる場合は ErrInvalidSignature を返す", func(t *testing.T) {
+		q := NewSigner("other").Query("audios/a.mp3", now.Add(time.Hour))
+
+		err := signer.Verify("audios/a.mp3", q.Get(QueryExpires), q.Get(QuerySignature), now)
+
+		assert.ErrorIs(t, err, ErrInvalidSignature)
+	})
+
+	t.Run("有効期限の形式が不正な場合は ErrInvalidSignature を返す", func(t *testing.T) {
+		err := signer.Verify("audios/a.mp3", "abc", "signature", now)
+
+		assert.ErrorIs(t, err, ErrInvalidSignature)
+	})
+
+	t.Run("有効期限が切れている場合は ErrExpired を返す", func(t *testing.T) {
+		q := signer.Query("audios/a.mp3", now)
+
+		err := signer.Verify("audios/a.mp3", q.Get(QueryExpires), q.Get(QuerySignature), now)
+
+		assert.ErrorIs(t, err, ErrExpired)
+	})
+}
@@ -17,6 +17,11 @@ func New() UUID {
 	return googleuuid.New()
 }
 
+// NewSHA1 は名前空間と名前から決定的な UUID（バージョン 5）を生成する
+func NewSHA1(space UUID, data []byte) UUID {
+	return googleuuid.NewSHA1(space, data)
+}
+
 // Parse は文字列を UUID に変換する
 // 無効な形式の場合は apperror.ErrValidation を返す
 func Parse(s string) (UUID, error) {
@@ -63,6 +63,18 @@ func TestParse(t *testing.T) {
 	})
 }
 
+func TestNewSHA1(t *testing.T) {
+	t.Run("同じ名前空間と名前からは同じバージョン 5 の UUID を生成する", func(t *testing.T) {
+		space := MustParse("ead4c236-bf58-58c6-a2c6-a6b28d128cb6")
+
+		id := NewSHA1(space, []byte("example.com/feed.xml"))
+
+		assert.Equal(t, id, NewSHA1(space, []byte("example.com/feed.xml")))
+		assert.NotEqual(t, id, NewSHA1(space, []byte("example.com/other.xml")))
+		assert.Equal(t, 5, int(id.Version()))
+	})
+}
+
 func TestValidate(t *testing.T) {
 	t.Run("有効な UUID は nil を返す", func(t *testing.T) {
 		err := Validate("550e8400-e29b-41d4-a716-446655440000")
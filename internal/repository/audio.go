@@ -15,8 +15,10 @@ import (
 type AudioRepository interface {
 	FindByID(ctx context.Context, id uuid.UUID) (*model.Audio, error)
 	Create(ctx context.Context, audio *model.Audio) error
+	Update(ctx context.Context, audio *model.Audio) error
 	Delete(ctx context.Context, id uuid.UUID) error
 	FindOrphaned(ctx context.Context) ([]model.Audio, error)
+	FindAll(ctx context.Context) ([]model.Audio, error)
 }
 
 type audioRepository struct {
@@ -54,6 +56,16 @@ func (r *audioRepository) Create(ctx context.Context, audio *model.Audio) error
 	return nil
 }
 
+// Update は音声を更新する
+func (r *audioRepository) Update(ctx context.Context, audio *model.Audio) error {
+	if err := r.db.WithContext(ctx).Save(audio).Error; err != nil {
+		logger.FromContext(ctx).Error("failed to update audio", "error", err, "id", audio.ID)
+		return apperror.ErrInternal.WithMessage("音声の更新に失敗しました").WithError(err)
+	}
+
+	return nil
+}
+
 // Delete は音声を削除する
 func (r *audioRepository) Delete(ctx context.Context, id uuid.UUID) error {
 	result := r.db.WithContext(ctx).Delete(&model.Audio{}, "id = ?", id)
@@ -71,7 +83,7 @@ func (r *audioRepository) Delete(ctx context.Context, id uuid.UUID) error {
 
 // FindOrphaned はどのテーブルからも参照されていない孤児レコードを取得する
 //
-// 対象: episodes.full_audio_id, bgms.audio_id, system_bgms.audio_id, audio_jobs.result_audio_id
+// 対象: episodes.full_audio_id, bgms.audio_id, system_bgms.audio_id, sound_effects.audio_id, system_sound_effects.audio_id, channels.intro_audio_id, channels.outro_audio_id, audio_jobs.result_audio_id, episode_audio_renditions.audio_id
 // 条件: created_at から 1 時間以上経過したレコードのみ
 func (r *audioRepository) FindOrphaned(ctx context.Context) ([]model.Audio, error) {
 	var audios []model.Audio
@@ -83,7 +95,11 @@ func (r *audioRepository) FindOrphaned(ctx context.Context) ([]model.Audio, erro
 		AND NOT EXISTS (SELECT 1 FROM episodes e WHERE e.voice_audio_id = a.id)
 		AND NOT EXISTS (SELECT 1 FROM bgms b WHERE b.audio_id = a.id)
 		AND NOT EXISTS (SELECT 1 FROM system_bgms sb WHERE sb.audio_id = a.id)
+		AND NOT EXISTS (SELECT 1 FROM sound_effects se WHERE se.audio_id = a.id)
+		AND NOT EXISTS (SELECT 1 FROM system_sound_effects sse WHERE sse.audio_id = a.id)
+		AND NOT EXISTS (SELECT 1 FROM channels c WHERE c.intro_audio_id = a.id OR c.outro_audio_id = a.id)
 		AND NOT EXISTS (SELECT 1 FROM audio_jobs aj WHERE aj.result_audio_id = a.id)
+		AND NOT EXISTS (SELECT 1 FROM episode_audio_renditions ear WHERE ear.audio_id = a.id)
 		ORDER BY a.created_at DESC
 	`
 
@@ -94,3 +110,15 @@ func (r *audioRepository) FindOrphaned(ctx context.Context) ([]model.Audio, erro
 
 	return audios, nil
 }
+
+// FindAll はすべての音声を作成日時順に取得する（ストレージ間の移行用）
+func (r *audioRepository) FindAll(ctx context.Context) ([]model.Audio, error) {
+	var audios []model.Audio
+
+	if err := r.db.WithContext(ctx).Order("created_at ASC").Find(&audios).Error; err != nil {
+		logger.FromContext(ctx).Error("failed to fetch audios", "error", err)
+		return nil, apperror.ErrInternal.WithMessage("音声一覧の取得に失敗しました").WithError(err)
+	}
+
+	return audios, nil
+}
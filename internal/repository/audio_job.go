@@ -18,7 +18,8 @@ type AudioJobRepository interface {
 	FindByID(ctx context.Context, id uuid.UUID) (*model.AudioJob, error)
 	FindByUserID(ctx context.Context, userID uuid.UUID, filter AudioJobFilter) ([]model.AudioJob, error)
 	FindByEpisodeID(ctx context.Context, episodeID uuid.UUID) ([]model.AudioJob, error)
-	FindPendingByEpisodeID(ctx context.Context, episodeID uuid.UUID) (*model.AudioJob, error)
+	FindPendingByEpisodeID(ctx context.Context, episodeID uuid.UUID, jobTypes []model.AudioJobType) (*model.AudioJob, error)
+	FindUnstartedByEpisodeID(ctx context.Context, episodeID uuid.UUID, jobType model.AudioJobType) (*model.AudioJob, error)
 	Create(ctx context.Context, job *model.AudioJob) error
 	Update(ctx context.Context, job *model.AudioJob) error
 	UpdateProgress(ctx context.Context, id uuid.UUID, progress int) error
@@ -62,10 +63,14 @@ func (r *audioJobRepository) FindByID(ctx context.Context, id uuid.UUID) (*model
 }
 
 // FindByUserID はユーザーの音声ジョブ一覧を取得する
+//
+// システムが内部で作成したジョブ（配信用音声の生成・ID3 タグの書き換え）は含めない
 func (r *audioJobRepository) FindByUserID(ctx context.Context, userID uuid.UUID, filter AudioJobFilter) ([]model.AudioJob, error) {
 	var jobs []model.AudioJob
 
-	tx := r.db.WithContext(ctx).Where("user_id = ?", userID)
+	tx := r.db.WithContext(ctx).
+		Where("user_id = ?", userID).
+		Where("job_type IN ?", model.UserAudioJobTypes)
 
 	// ステータスフィルタ
 	if filter.Status != nil {
@@ -102,20 +107,49 @@ func (r *audioJobRepository) FindByEpisodeID(ctx context.Context, episodeID uuid
 }
 
 // FindPendingByEpisodeID はエピソードの処理待ちジョブを取得する
+// jobTypes を指定した場合はその種別のジョブのみを対象にする（空の場合は全種別）
+// 見つからない場合は nil, nil を返す（エラーではない）
+func (r *audioJobRepository) FindPendingByEpisodeID(ctx context.Context, episodeID uuid.UUID, jobTypes []model.AudioJobType) (*model.AudioJob, error) {
+	var job model.AudioJob
+
+	tx := r.db.WithContext(ctx).
+		Where("episode_id = ?", episodeID).
+		Where("status IN ?", []model.AudioJobStatus{model.AudioJobStatusPending, model.AudioJobStatusProcessing})
+
+	if len(jobTypes) > 0 {
+		tx = tx.Where("job_type IN ?", jobTypes)
+	}
+
+	err := tx.First(&job).Error
+
+	if err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return nil, nil //nolint:nilnil // not found is not an error
+		}
+		logger.FromContext(ctx).Error("failed to find pending job", "error", err, "episode_id", episodeID)
+		return nil, apperror.ErrInternal.WithMessage("処理待ちジョブの確認に失敗しました").WithError(err)
+	}
+
+	return &job, nil
+}
+
+// FindUnstartedByEpisodeID はエピソードの指定した種別のジョブのうち、まだ実行を開始していない（pending の）ものを取得する
 // 見つからない場合は nil, nil を返す（エラーではない）
-func (r *audioJobRepository) FindPendingByEpisodeID(ctx context.Context, episodeID uuid.UUID) (*model.AudioJob, error) {
+func (r *audioJobRepository) FindUnstartedByEpisodeID(ctx context.Context, episodeID uuid.UUID, jobType model.AudioJobType) (*model.AudioJob, error) {
 	var job model.AudioJob
 
 	err := r.db.WithContext(ctx).
 		Where("episode_id = ?", episodeID).
-		Where("status IN ?", []model.AudioJobStatus{model.AudioJobStatusPending, model.AudioJobStatusProcessing}).
+		Where("job_type = ?", jobType).
+		Where("status = ?", model.AudioJobStatusPending).
+		Order("created_at ASC").
 		First(&job).Error
 
 	if err != nil {
 		if errors.Is(err, gorm.ErrRecordNotFound) {
 			return nil, nil //nolint:nilnil // not found is not an error
 		}
-		logger.FromContext(ctx).Error("failed to find pending job", "error", err, "episode_id", episodeID)
+		logger.FromContext(ctx).Error("failed to find unstarted job", "error", err, "episode_id", episodeID, "job_type", jobType)
 		return nil, apperror.ErrInternal.WithMessage("処理待ちジョブの確認に失敗しました").WithError(err)
 	}
 
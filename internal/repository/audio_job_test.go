@@ -0,0 +1,129 @@
+package repository
+
+import (
+	"context"
+	"testing"
+
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/siropaca/anycast-backend/internal/model"
+)
+
+func TestAudioJobRepository_Create(t *testing.T) {
+	t.Run("ダッキング閾値と BGM 音量に上限値 0 を指定した場合は 0 のまま保存する", func(t *testing.T) {
+		db, statements := newDryRunDB(t)
+		repo := NewAudioJobRepository(db)
+
+		job := &model.AudioJob{
+			EpisodeID:          uuid.New(),
+			UserID:             uuid.New(),
+			JobType:            model.AudioJobTypeFull,
+			BgmVolumeDB:        0,
+			FadeOutMs:          0,
+			PaddingStartMs:     0,
+			PaddingEndMs:       0,
+			BgmDucking:         true,
+			DuckingThresholdDB: 0,
+			DuckingRatio:       1,
+			DuckingAttackMs:    1,
+			DuckingReleaseMs:   10,
+			IntroSwellMs:       0,
+		}
+
+		err := repo.Create(context.Background(), job)
+
+		require.NoError(t, err)
+		assert.Equal(t, 0.0, job.DuckingThresholdDB)
+		assert.Equal(t, 0.0, job.BgmVolumeDB)
+		assert.Equal(t, 0.0, statements.inserted()["ducking_threshold_db"])
+		assert.Equal(t, 0.0, statements.inserted()["bgm_volume_db"])
+		assert.Equal(t, 0, statements.inserted()["fade_out_ms"])
+		assert.Equal(t, 0, statements.inserted()["padding_start_ms"])
+		assert.Equal(t, 0, statements.inserted()["padding_end_ms"])
+	})
+
+	t.Run("発音 QA の閾値と再試行回数に 0 を指定した場合は 0 のまま保存する", func(t *testing.T) {
+		db, statements := newDryRunDB(t)
+		repo := NewAudioJobRepository(db)
+
+		job := &model.AudioJob{
+			EpisodeID:             uuid.New(),
+			UserID:                uuid.New(),
+			JobType:               model.AudioJobTypeVoice,
+			QAEnabled:             true,
+			QASimilarityThreshold: 0,
+			QAMaxRetries:          0,
+		}
+
+		err := repo.Create(context.Background(), job)
+
+		require.NoError(t, err)
+		assert.Equal(t, 0.0, job.QASimilarityThreshold)
+		assert.Equal(t, 0, job.QAMaxRetries)
+		assert.Equal(t, 0.0, statements.inserted()["qa_similarity_threshold"])
+		assert.Equal(t, 0, statements.inserted()["qa_max_retries"])
+	})
+}
+
+func TestAudioJobRepository_FindByUserID(t *testing.T) {
+	t.Run("内部ジョブを除いてユーザーのジョブを取得する", func(t *testing.T) {
+		db, statements := newDryRunDB(t)
+		repo := NewAudioJobRepository(db)
+
+		_, err := repo.FindByUserID(context.Background(), uuid.New(), AudioJobFilter{})
+
+		require.NoError(t, err)
+		sql, vars := statements.query()
+		assert.Contains(t, sql, "job_type IN")
+		assert.Contains(t, vars, model.AudioJobTypeVoice)
+		assert.Contains(t, vars, model.AudioJobTypeFull)
+		assert.Contains(t, vars, model.AudioJobTypeRemix)
+		assert.NotContains(t, vars, model.AudioJobTypeRenditions)
+		assert.NotContains(t, vars, model.AudioJobTypeRetag)
+	})
+}
+
+func TestAudioJobRepository_FindPendingByEpisodeID(t *testing.T) {
+	t.Run("種別を指定した場合はその種別のジョブのみを対象にする", func(t *testing.T) {
+		db, statements := newDryRunDB(t)
+		repo := NewAudioJobRepository(db)
+
+		_, err := repo.FindPendingByEpisodeID(context.Background(), uuid.New(), model.UserAudioJobTypes)
+
+		require.NoError(t, err)
+		sql, vars := statements.query()
+		assert.Contains(t, sql, "job_type IN")
+		assert.NotContains(t, vars, model.AudioJobTypeRenditions)
+		assert.NotContains(t, vars, model.AudioJobTypeRetag)
+	})
+
+	t.Run("種別を指定しない場合は全種別を対象にする", func(t *testing.T) {
+		db, statements := newDryRunDB(t)
+		repo := NewAudioJobRepository(db)
+
+		_, err := repo.FindPendingByEpisodeID(context.Background(), uuid.New(), nil)
+
+		require.NoError(t, err)
+		sql, _ := statements.query()
+		assert.NotContains(t, sql, "job_type")
+	})
+}
+
+func TestAudioJobRepository_FindUnstartedByEpisodeID(t *testing.T) {
+	t.Run("指定した種別の実行を開始していないジョブを対象にする", func(t *testing.T) {
+		db, statements := newDryRunDB(t)
+		repo := NewAudioJobRepository(db)
+
+		_, err := repo.FindUnstartedByEpisodeID(context.Background(), uuid.New(), model.AudioJobTypeRetag)
+
+		require.NoError(t, err)
+		sql, vars := statements.query()
+		assert.Contains(t, sql, "job_type =")
+		assert.Contains(t, sql, "status =")
+		assert.Contains(t, vars, model.AudioJobTypeRetag)
+		assert.Contains(t, vars, model.AudioJobStatusPending)
+		assert.NotContains(t, vars, model.AudioJobStatusProcessing)
+	})
+}
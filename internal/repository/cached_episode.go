@@ -117,6 +117,14 @@ func (r *cachedEpisodeRepository) CountByChannelIDBeforeCreatedAt(ctx context.Co
 	return r.repo.CountByChannelIDBeforeCreatedAt(ctx, channelID, createdAt)
 }
 
+func (r *cachedEpisodeRepository) FindSourceGUIDsByChannelID(ctx context.Context, channelID uuid.UUID) ([]string, error) {
+	return r.repo.FindSourceGUIDsByChannelID(ctx, channelID)
+}
+
+func (r *cachedEpisodeRepository) FindByAudioID(ctx context.Context, audioID uuid.UUID) ([]model.Episode, error) {
+	return r.repo.FindByAudioID(ctx, audioID)
+}
+
 func (r *cachedEpisodeRepository) IncrementPlayCount(ctx context.Context, id uuid.UUID) error {
 	return r.repo.IncrementPlayCount(ctx, id)
 }
@@ -87,6 +87,8 @@ func (r *channelRepository) FindByUserID(ctx context.Context, userID uuid.UUID,
 		Preload("DefaultBgm.Audio").
 		Preload("DefaultSystemBgm").
 		Preload("DefaultSystemBgm.Audio").
+		Preload("IntroAudio").
+		Preload("OutroAudio").
 		Preload("ChannelCharacters").
 		Preload("ChannelCharacters.Character").
 		Preload("ChannelCharacters.Character.Avatar").
@@ -132,6 +134,8 @@ func (r *channelRepository) FindByID(ctx context.Context, id uuid.UUID) (*model.
 		Preload("DefaultBgm.Audio").
 		Preload("DefaultSystemBgm").
 		Preload("DefaultSystemBgm.Audio").
+		Preload("IntroAudio").
+		Preload("OutroAudio").
 		Preload("ChannelCharacters").
 		Preload("ChannelCharacters.Character").
 		Preload("ChannelCharacters.Character.Avatar").
@@ -0,0 +1,103 @@
+package repository
+
+import (
+	"context"
+	"errors"
+
+	"gorm.io/gorm"
+
+	"github.com/siropaca/anycast-backend/internal/apperror"
+	"github.com/siropaca/anycast-backend/internal/model"
+	"github.com/siropaca/anycast-backend/internal/pkg/logger"
+	"github.com/siropaca/anycast-backend/internal/pkg/uuid"
+)
+
+// ClipJobRepository はクリップ動画生成ジョブデータへのアクセスインターフェース
+type ClipJobRepository interface {
+	FindByID(ctx context.Context, id uuid.UUID) (*model.ClipJob, error)
+	FindByUserID(ctx context.Context, userID uuid.UUID) ([]model.ClipJob, error)
+	Create(ctx context.Context, job *model.ClipJob) error
+	Update(ctx context.Context, job *model.ClipJob) error
+	UpdateProgress(ctx context.Context, id uuid.UUID, progress int) error
+}
+
+type clipJobRepository struct {
+	db *gorm.DB
+}
+
+// NewClipJobRepository は ClipJobRepository の実装を返す
+func NewClipJobRepository(db *gorm.DB) ClipJobRepository {
+	return &clipJobRepository{db: db}
+}
+
+// FindByID は指定された ID のクリップ動画生成ジョブを取得する
+func (r *clipJobRepository) FindByID(ctx context.Context, id uuid.UUID) (*model.ClipJob, error) {
+	var job model.ClipJob
+
+	if err := r.db.WithContext(ctx).
+		Preload("Episode").
+		Preload("Episode.Channel").
+		Preload("Episode.Channel.Artwork").
+		Preload("Episode.Artwork").
+		Preload("Audio").
+		Preload("ResultVideo").
+		First(&job, "id = ?", id).Error; err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return nil, apperror.ErrNotFound.WithMessage("クリップ動画生成ジョブが見つかりません")
+		}
+
+		logger.FromContext(ctx).Error("failed to fetch clip job", "error", err, "job_id", id)
+		return nil, apperror.ErrInternal.WithMessage("クリップ動画生成ジョブの取得に失敗しました").WithError(err)
+	}
+
+	return &job, nil
+}
+
+// FindByUserID はユーザーのクリップ動画生成ジョブ一覧を取得する
+func (r *clipJobRepository) FindByUserID(ctx context.Context, userID uuid.UUID) ([]model.ClipJob, error) {
+	var jobs []model.ClipJob
+
+	if err := r.db.WithContext(ctx).
+		Preload("Episode").
+		Preload("ResultVideo").
+		Where("user_id = ?", userID).
+		Order("created_at DESC").
+		Find(&jobs).Error; err != nil {
+		logger.FromContext(ctx).Error("failed to fetch clip jobs", "error", err, "user_id", userID)
+		return nil, apperror.ErrInternal.WithMessage("クリップ動画生成ジョブ一覧の取得に失敗しました").WithError(err)
+	}
+
+	return jobs, nil
+}
+
+// Create はクリップ動画生成ジョブを作成する
+func (r *clipJobRepository) Create(ctx context.Context, job *model.ClipJob) error {
+	if err := r.db.WithContext(ctx).Omit("Episode", "Audio", "ResultVideo").Create(job).Error; err != nil {
+		logger.FromContext(ctx).Error("failed to create clip job", "error", err)
+		return apperror.ErrInternal.WithMessage("クリップ動画生成ジョブの作成に失敗しました").WithError(err)
+	}
+
+	return nil
+}
+
+// Update はクリップ動画生成ジョブを更新する
+func (r *clipJobRepository) Update(ctx context.Context, job *model.ClipJob) error {
+	if err := r.db.WithContext(ctx).Omit("Episode", "Audio", "ResultVideo").Save(job).Error; err != nil {
+		logger.FromContext(ctx).Error("failed to update clip job", "error", err, "job_id", job.ID)
+		return apperror.ErrInternal.WithMessage("クリップ動画生成ジョブの更新に失敗しました").WithError(err)
+	}
+
+	return nil
+}
+
+// UpdateProgress はクリップ動画生成ジョブの進捗のみを更新する
+//
+// ステータスなど他のフィールドは変更しない
+func (r *clipJobRepository) UpdateProgress(ctx context.Context, id uuid.UUID, progress int) error {
+	if err := r.db.WithContext(ctx).Model(&model.ClipJob{}).Where("id = ?", id).Update("progress", progress).Error; err != nil {
+		logger.FromContext(ctx).Error("failed to update clip job progress", "error", err, "job_id", id)
+		return apperror.ErrInternal.WithMessage("進捗の更新に失敗しました").WithError(err)
+	}
+
+	return nil
+}
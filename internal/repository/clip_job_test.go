@@ -0,0 +1,52 @@
+package repository
+
+import (
+	"context"
+	"testing"
+
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/siropaca/anycast-backend/internal/model"
+)
+
+func TestClipJobRepository_Create(t *testing.T) {
+	t.Run("字幕なしを指定した場合は false のまま保存する", func(t *testing.T) {
+		db, statements := newDryRunDB(t)
+		repo := NewClipJobRepository(db)
+
+		job := &model.ClipJob{
+			UserID:      uuid.New(),
+			EpisodeID:   uuid.New(),
+			StartMs:     0,
+			EndMs:       30000,
+			AspectRatio: model.ClipAspectRatio("square"),
+			Captions:    false,
+		}
+
+		err := repo.Create(context.Background(), job)
+
+		require.NoError(t, err)
+		assert.False(t, job.Captions)
+		assert.Equal(t, false, statements.inserted()["captions"])
+	})
+
+	t.Run("字幕ありを指定した場合は true で保存する", func(t *testing.T) {
+		db, statements := newDryRunDB(t)
+		repo := NewClipJobRepository(db)
+
+		job := &model.ClipJob{
+			UserID:      uuid.New(),
+			EpisodeID:   uuid.New(),
+			EndMs:       30000,
+			AspectRatio: model.ClipAspectRatio("square"),
+			Captions:    true,
+		}
+
+		err := repo.Create(context.Background(), job)
+
+		require.NoError(t, err)
+		assert.Equal(t, true, statements.inserted()["captions"])
+	})
+}
@@ -20,6 +20,8 @@ type EpisodeRepository interface {
 	Search(ctx context.Context, filter SearchEpisodeFilter) ([]model.Episode, int64, error)
 	CountPublishedByChannelIDs(ctx context.Context, channelIDs []uuid.UUID) (map[uuid.UUID]int, error)
 	CountByChannelIDBeforeCreatedAt(ctx context.Context, channelID uuid.UUID, createdAt time.Time) (int64, error)
+	FindSourceGUIDsByChannelID(ctx context.Context, channelID uuid.UUID) ([]string, error)
+	FindByAudioID(ctx context.Context, audioID uuid.UUID) ([]model.Episode, error)
 	Create(ctx context.Context, episode *model.Episode) error
 	Update(ctx context.Context, episode *model.Episode) error
 	Delete(ctx context.Context, id uuid.UUID) error
@@ -94,6 +96,11 @@ func (r *episodeRepository) FindByChannelID(ctx context.Context, channelID uuid.
 		Preload("Artwork").
 		Preload("VoiceAudio").
 		Preload("FullAudio").
+		Preload("AudioRenditions", func(db *gorm.DB) *gorm.DB {
+			return db.Order("format ASC, bitrate_kbps DESC")
+		}).
+		Preload("AudioRenditions.Audio").
+		Preload("HLSPackage").
 		Preload("Bgm").
 		Preload("Bgm.Audio").
 		Preload("SystemBgm").
@@ -186,6 +193,37 @@ func (r *episodeRepository) CountByChannelIDBeforeCreatedAt(ctx context.Context,
 	return count, nil
 }
 
+// FindSourceGUIDsByChannelID はチャンネル内の外部フィードから取り込んだエピソードの GUID 一覧を取得する
+func (r *episodeRepository) FindSourceGUIDsByChannelID(ctx context.Context, channelID uuid.UUID) ([]string, error) {
+	var guids []string
+	if err := r.db.WithContext(ctx).
+		Model(&model.Episode{}).
+		Where("channel_id = ? AND source_guid IS NOT NULL", channelID).
+		Pluck("source_guid", &guids).Error; err != nil {
+		logger.FromContext(ctx).Error("failed to fetch episode source guids", "error", err, "channel_id", channelID)
+		return nil, apperror.ErrInternal.WithMessage("取り込み済みエピソードの取得に失敗しました").WithError(err)
+	}
+
+	return guids, nil
+}
+
+// FindByAudioID は指定した音声を使用しているエピソード一覧を取得する
+//
+// ボイス音声・結合済み音声・配信用音声（renditions）のいずれかとして参照しているエピソードを対象とする
+func (r *episodeRepository) FindByAudioID(ctx context.Context, audioID uuid.UUID) ([]model.Episode, error) {
+	var episodes []model.Episode
+	if err := r.db.WithContext(ctx).
+		Preload("Channel").
+		Where("voice_audio_id = ? OR full_audio_id = ?", audioID, audioID).
+		Or("id IN (?)", r.db.Model(&model.EpisodeAudioRendition{}).Select("episode_id").Where("audio_id = ?", audioID)).
+		Find(&episodes).Error; err != nil {
+		logger.FromContext(ctx).Error("failed to fetch episodes by audio id", "error", err, "audio_id", audioID)
+		return nil, apperror.ErrInternal.WithMessage("エピソードの取得に失敗しました").WithError(err)
+	}
+
+	return episodes, nil
+}
+
 // Create はエピソードを作成する
 func (r *episodeRepository) Create(ctx context.Context, episode *model.Episode) error {
 	if err := r.db.WithContext(ctx).Create(episode).Error; err != nil {
@@ -202,9 +240,16 @@ func (r *episodeRepository) FindByID(ctx context.Context, id uuid.UUID) (*model.
 
 	if err := r.db.WithContext(ctx).
 		Preload("Channel").
+		Preload("Channel.IntroAudio").
+		Preload("Channel.OutroAudio").
 		Preload("Artwork").
 		Preload("VoiceAudio").
 		Preload("FullAudio").
+		Preload("AudioRenditions", func(db *gorm.DB) *gorm.DB {
+			return db.Order("format ASC, bitrate_kbps DESC")
+		}).
+		Preload("AudioRenditions.Audio").
+		Preload("HLSPackage").
 		Preload("Bgm").
 		Preload("Bgm.Audio").
 		Preload("SystemBgm").
@@ -223,8 +268,10 @@ func (r *episodeRepository) FindByID(ctx context.Context, id uuid.UUID) (*model.
 }
 
 // Update はエピソードを更新する
+//
+// 配信用音声（AudioRenditions）と HLS パッケージ（HLSPackage）は専用のリポジトリで管理するため保存対象外とする
 func (r *episodeRepository) Update(ctx context.Context, episode *model.Episode) error {
-	if err := r.db.WithContext(ctx).Save(episode).Error; err != nil {
+	if err := r.db.WithContext(ctx).Omit("AudioRenditions", "HLSPackage").Save(episode).Error; err != nil {
 		logger.FromContext(ctx).Error("failed to update episode", "error", err, "episode_id", episode.ID)
 		return apperror.ErrInternal.WithMessage("エピソードの更新に失敗しました").WithError(err)
 	}
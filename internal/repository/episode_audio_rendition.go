@@ -0,0 +1,67 @@
+package repository
+
+import (
+	"context"
+
+	"gorm.io/gorm"
+
+	"github.com/siropaca/anycast-backend/internal/apperror"
+	"github.com/siropaca/anycast-backend/internal/model"
+	"github.com/siropaca/anycast-backend/internal/pkg/logger"
+	"github.com/siropaca/anycast-backend/internal/pkg/uuid"
+)
+
+// EpisodeAudioRenditionRepository はエピソードの配信用音声へのアクセスインターフェース
+type EpisodeAudioRenditionRepository interface {
+	FindByEpisodeID(ctx context.Context, episodeID uuid.UUID) ([]model.EpisodeAudioRendition, error)
+	ReplaceByEpisodeID(ctx context.Context, episodeID uuid.UUID, renditions []model.EpisodeAudioRendition) error
+}
+
+type episodeAudioRenditionRepository struct {
+	db *gorm.DB
+}
+
+// NewEpisodeAudioRenditionRepository は EpisodeAudioRenditionRepository の実装を返す
+func NewEpisodeAudioRenditionRepository(db *gorm.DB) EpisodeAudioRenditionRepository {
+	return &episodeAudioRenditionRepository{db: db}
+}
+
+// FindByEpisodeID はエピソードの配信用音声一覧を取得する
+func (r *episodeAudioRenditionRepository) FindByEpisodeID(ctx context.Context, episodeID uuid.UUID) ([]model.EpisodeAudioRendition, error) {
+	var renditions []model.EpisodeAudioRendition
+
+	if err := r.db.WithContext(ctx).
+		Preload("Audio").
+		Where("episode_id = ?", episodeID).
+		Order("format ASC, bitrate_kbps DESC").
+		Find(&renditions).Error; err != nil {
+		logger.FromContext(ctx).Error("failed to fetch episode audio renditions", "error", err, "episode_id", episodeID)
+		return nil, apperror.ErrInternal.WithMessage("配信用音声の取得に失敗しました").WithError(err)
+	}
+
+	return renditions, nil
+}
+
+// ReplaceByEpisodeID はエピソードの配信用音声を置き換える
+//
+// 置き換え前の音声レコードは参照が外れるため、孤児レコードとしてクリーンアップ対象になる
+func (r *episodeAudioRenditionRepository) ReplaceByEpisodeID(ctx context.Context, episodeID uuid.UUID, renditions []model.EpisodeAudioRendition) error {
+	return r.db.WithContext(ctx).Transaction(func(tx *gorm.DB) error {
+		// 既存の配信用音声を削除
+		if err := tx.Where("episode_id = ?", episodeID).Delete(&model.EpisodeAudioRendition{}).Error; err != nil {
+			logger.FromContext(ctx).Error("failed to delete episode audio renditions", "error", err, "episode_id", episodeID)
+			return apperror.ErrInternal.WithMessage("配信用音声の更新に失敗しました").WithError(err)
+		}
+
+		// 新しい配信用音声を作成
+		for i := range renditions {
+			renditions[i].EpisodeID = episodeID
+			if err := tx.Omit("Audio").Create(&renditions[i]).Error; err != nil {
+				logger.FromContext(ctx).Error("failed to create episode audio rendition", "error", err, "episode_id", episodeID, "format", renditions[i].Format)
+				return apperror.ErrInternal.WithMessage("配信用音声の更新に失敗しました").WithError(err)
+			}
+		}
+
+		return nil
+	})
+}
@@ -0,0 +1,69 @@
+package repository
+
+import (
+	"context"
+
+	"gorm.io/gorm"
+
+	"github.com/siropaca/anycast-backend/internal/apperror"
+	"github.com/siropaca/anycast-backend/internal/model"
+	"github.com/siropaca/anycast-backend/internal/pkg/logger"
+	"github.com/siropaca/anycast-backend/internal/pkg/uuid"
+)
+
+// EpisodeHLSPackageRepository はエピソードの HLS パッケージへのアクセスインターフェース
+type EpisodeHLSPackageRepository interface {
+	Replace(ctx context.Context, pkg *model.EpisodeHLSPackage) error
+	DeleteByEpisodeID(ctx context.Context, episodeID uuid.UUID) error
+	FindAll(ctx context.Context) ([]model.EpisodeHLSPackage, error)
+}
+
+type episodeHLSPackageRepository struct {
+	db *gorm.DB
+}
+
+// NewEpisodeHLSPackageRepository は EpisodeHLSPackageRepository の実装を返す
+func NewEpisodeHLSPackageRepository(db *gorm.DB) EpisodeHLSPackageRepository {
+	return &episodeHLSPackageRepository{db: db}
+}
+
+// Replace はエピソードの HLS パッケージを置き換える
+func (r *episodeHLSPackageRepository) Replace(ctx context.Context, pkg *model.EpisodeHLSPackage) error {
+	return r.db.WithContext(ctx).Transaction(func(tx *gorm.DB) error {
+		// 既存のパッケージを削除
+		if err := tx.Where("episode_id = ?", pkg.EpisodeID).Delete(&model.EpisodeHLSPackage{}).Error; err != nil {
+			logger.FromContext(ctx).Error("failed to delete episode hls package", "error", err, "episode_id", pkg.EpisodeID)
+			return apperror.ErrInternal.WithMessage("HLS パッケージの保存に失敗しました").WithError(err)
+		}
+
+		// 新しいパッケージを作成
+		if err := tx.Create(pkg).Error; err != nil {
+			logger.FromContext(ctx).Error("failed to create episode hls package", "error", err, "episode_id", pkg.EpisodeID)
+			return apperror.ErrInternal.WithMessage("HLS パッケージの保存に失敗しました").WithError(err)
+		}
+
+		return nil
+	})
+}
+
+// DeleteByEpisodeID はエピソードの HLS パッケージを削除する
+func (r *episodeHLSPackageRepository) DeleteByEpisodeID(ctx context.Context, episodeID uuid.UUID) error {
+	if err := r.db.WithContext(ctx).Where("episode_id = ?", episodeID).Delete(&model.EpisodeHLSPackage{}).Error; err != nil {
+		logger.FromContext(ctx).Error("failed to delete episode hls package", "error", err, "episode_id", episodeID)
+		return apperror.ErrInternal.WithMessage("HLS パッケージの削除に失敗しました").WithError(err)
+	}
+
+	return nil
+}
+
+// FindAll はすべての HLS パッケージを作成日時順に取得する（ストレージ間の移行用）
+func (r *episodeHLSPackageRepository) FindAll(ctx context.Context) ([]model.EpisodeHLSPackage, error) {
+	var pkgs []model.EpisodeHLSPackage
+
+	if err := r.db.WithContext(ctx).Order("created_at ASC").Find(&pkgs).Error; err != nil {
+		logger.FromContext(ctx).Error("failed to fetch episode hls packages", "error", err)
+		return nil, apperror.ErrInternal.WithMessage("HLS パッケージ一覧の取得に失敗しました").WithError(err)
+	}
+
+	return pkgs, nil
+}
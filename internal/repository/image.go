@@ -18,6 +18,7 @@ type ImageRepository interface {
 	FindByID(ctx context.Context, id uuid.UUID) (*model.Image, error)
 	Delete(ctx context.Context, id uuid.UUID) error
 	FindOrphaned(ctx context.Context) ([]model.Image, error)
+	FindAll(ctx context.Context) ([]model.Image, error)
 }
 
 type imageRepository struct {
@@ -95,3 +96,15 @@ func (r *imageRepository) FindOrphaned(ctx context.Context) ([]model.Image, erro
 
 	return images, nil
 }
+
+// FindAll はすべての画像を作成日時順に取得する（ストレージ間の移行用）
+func (r *imageRepository) FindAll(ctx context.Context) ([]model.Image, error) {
+	var images []model.Image
+
+	if err := r.db.WithContext(ctx).Order("created_at ASC").Find(&images).Error; err != nil {
+		logger.FromContext(ctx).Error("failed to fetch images", "error", err)
+		return nil, apperror.ErrInternal.WithMessage("画像一覧の取得に失敗しました").WithError(err)
+	}
+
+	return images, nil
+}
@@ -0,0 +1,119 @@
+package repository
+
+import (
+	"context"
+	"errors"
+
+	"gorm.io/gorm"
+
+	"github.com/siropaca/anycast-backend/internal/apperror"
+	"github.com/siropaca/anycast-backend/internal/model"
+	"github.com/siropaca/anycast-backend/internal/pkg/logger"
+	"github.com/siropaca/anycast-backend/internal/pkg/uuid"
+)
+
+// ImportJobRepository はポッドキャスト取り込みジョブデータへのアクセスインターフェース
+type ImportJobRepository interface {
+	FindByID(ctx context.Context, id uuid.UUID) (*model.ImportJob, error)
+	FindByUserID(ctx context.Context, userID uuid.UUID) ([]model.ImportJob, error)
+	FindActiveByChannelID(ctx context.Context, channelID uuid.UUID) (*model.ImportJob, error)
+	Create(ctx context.Context, job *model.ImportJob) error
+	Update(ctx context.Context, job *model.ImportJob) error
+	UpdateProgress(ctx context.Context, id uuid.UUID, progress int) error
+}
+
+type importJobRepository struct {
+	db *gorm.DB
+}
+
+// NewImportJobRepository は ImportJobRepository の実装を返す
+func NewImportJobRepository(db *gorm.DB) ImportJobRepository {
+	return &importJobRepository{db: db}
+}
+
+// FindByID は指定された ID の取り込みジョブを取得する
+func (r *importJobRepository) FindByID(ctx context.Context, id uuid.UUID) (*model.ImportJob, error) {
+	var job model.ImportJob
+
+	if err := r.db.WithContext(ctx).
+		Preload("Channel").
+		First(&job, "id = ?", id).Error; err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return nil, apperror.ErrNotFound.WithMessage("取り込みジョブが見つかりません")
+		}
+
+		logger.FromContext(ctx).Error("failed to fetch import job", "error", err, "job_id", id)
+		return nil, apperror.ErrInternal.WithMessage("取り込みジョブの取得に失敗しました").WithError(err)
+	}
+
+	return &job, nil
+}
+
+// FindByUserID はユーザーの取り込みジョブ一覧を取得する
+func (r *importJobRepository) FindByUserID(ctx context.Context, userID uuid.UUID) ([]model.ImportJob, error) {
+	var jobs []model.ImportJob
+
+	if err := r.db.WithContext(ctx).
+		Preload("Channel").
+		Where("user_id = ?", userID).
+		Order("created_at DESC").
+		Find(&jobs).Error; err != nil {
+		logger.FromContext(ctx).Error("failed to fetch import jobs", "error", err, "user_id", userID)
+		return nil, apperror.ErrInternal.WithMessage("取り込みジョブ一覧の取得に失敗しました").WithError(err)
+	}
+
+	return jobs, nil
+}
+
+// FindActiveByChannelID はチャンネルの処理待ち・処理中の取り込みジョブを取得する
+// 見つからない場合は nil, nil を返す（エラーではない）
+func (r *importJobRepository) FindActiveByChannelID(ctx context.Context, channelID uuid.UUID) (*model.ImportJob, error) {
+	var job model.ImportJob
+
+	err := r.db.WithContext(ctx).
+		Where("channel_id = ?", channelID).
+		Where("status IN ?", []model.ImportJobStatus{model.ImportJobStatusPending, model.ImportJobStatusProcessing}).
+		First(&job).Error
+
+	if err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return nil, nil //nolint:nilnil // not found is not an error
+		}
+		logger.FromContext(ctx).Error("failed to find active import job", "error", err, "channel_id", channelID)
+		return nil, apperror.ErrInternal.WithMessage("処理中の取り込みジョブの確認に失敗しました").WithError(err)
+	}
+
+	return &job, nil
+}
+
+// Create は取り込みジョブを作成する
+func (r *importJobRepository) Create(ctx context.Context, job *model.ImportJob) error {
+	if err := r.db.WithContext(ctx).Omit("Channel", "User").Create(job).Error; err != nil {
+		logger.FromContext(ctx).Error("failed to create import job", "error", err)
+		return apperror.ErrInternal.WithMessage("取り込みジョブの作成に失敗しました").WithError(err)
+	}
+
+	return nil
+}
+
+// Update は取り込みジョブを更新する
+func (r *importJobRepository) Update(ctx context.Context, job *model.ImportJob) error {
+	if err := r.db.WithContext(ctx).Omit("Channel", "User").Save(job).Error; err != nil {
+		logger.FromContext(ctx).Error("failed to update import job", "error", err, "job_id", job.ID)
+		return apperror.ErrInternal.WithMessage("取り込みジョブの更新に失敗しました").WithError(err)
+	}
+
+	return nil
+}
+
+// UpdateProgress は取り込みジョブの進捗のみを更新する
+//
+// ステータスなど他のフィールドは変更しない
+func (r *importJobRepository) UpdateProgress(ctx context.Context, id uuid.UUID, progress int) error {
+	if err := r.db.WithContext(ctx).Model(&model.ImportJob{}).Where("id = ?", id).Update("progress", progress).Error; err != nil {
+		logger.FromContext(ctx).Error("failed to update import job progress", "error", err, "job_id", id)
+		return apperror.ErrInternal.WithMessage("進捗の更新に失敗しました").WithError(err)
+	}
+
+	return nil
+}
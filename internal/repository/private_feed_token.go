@@ -0,0 +1,133 @@
+package repository
+
+import (
+	"context"
+	"errors"
+	"time"
+
+	"gorm.io/gorm"
+
+	"github.com/siropaca/anycast-backend/internal/apperror"
+	"github.com/siropaca/anycast-backend/internal/model"
+	"github.com/siropaca/anycast-backend/internal/pkg/logger"
+	"github.com/siropaca/anycast-backend/internal/pkg/uuid"
+)
+
+// PrivateFeedTokenRepository は限定公開フィードのトークンへのアクセスインターフェース
+type PrivateFeedTokenRepository interface {
+	Create(ctx context.Context, token *model.PrivateFeedToken) error
+	FindByID(ctx context.Context, id uuid.UUID) (*model.PrivateFeedToken, error)
+	FindByToken(ctx context.Context, token string) (*model.PrivateFeedToken, error)
+	FindByChannelID(ctx context.Context, channelID uuid.UUID) ([]model.PrivateFeedToken, error)
+	FindByUserID(ctx context.Context, userID uuid.UUID) ([]model.PrivateFeedToken, error)
+	UpdateLastUsedAt(ctx context.Context, id uuid.UUID, lastUsedAt time.Time) error
+	Delete(ctx context.Context, id uuid.UUID) error
+}
+
+type privateFeedTokenRepository struct {
+	db *gorm.DB
+}
+
+// NewPrivateFeedTokenRepository は PrivateFeedTokenRepository の実装を返す
+func NewPrivateFeedTokenRepository(db *gorm.DB) PrivateFeedTokenRepository {
+	return &privateFeedTokenRepository{db: db}
+}
+
+// Create はトークンを作成する
+func (r *privateFeedTokenRepository) Create(ctx context.Context, token *model.PrivateFeedToken) error {
+	if err := r.db.WithContext(ctx).Omit("User", "Channel").Create(token).Error; err != nil {
+		logger.FromContext(ctx).Error("failed to create private feed token", "error", err, "user_id", token.UserID, "channel_id", token.ChannelID)
+		return apperror.ErrInternal.WithMessage("限定公開フィードの作成に失敗しました").WithError(err)
+	}
+
+	return nil
+}
+
+// FindByID は指定された ID のトークンを取得する
+func (r *privateFeedTokenRepository) FindByID(ctx context.Context, id uuid.UUID) (*model.PrivateFeedToken, error) {
+	var token model.PrivateFeedToken
+
+	if err := r.db.WithContext(ctx).
+		Preload("User").
+		Preload("Channel").
+		First(&token, "id = ?", id).Error; err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return nil, apperror.ErrNotFound.WithMessage("限定公開フィードが見つかりません")
+		}
+
+		logger.FromContext(ctx).Error("failed to fetch private feed token", "error", err, "id", id)
+		return nil, apperror.ErrInternal.WithMessage("限定公開フィードの取得に失敗しました").WithError(err)
+	}
+
+	return &token, nil
+}
+
+// FindByToken は指定されたトークン文字列のトークンを取得する
+func (r *privateFeedTokenRepository) FindByToken(ctx context.Context, token string) (*model.PrivateFeedToken, error) {
+	var t model.PrivateFeedToken
+
+	if err := r.db.WithContext(ctx).First(&t, "token = ?", token).Error; err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return nil, apperror.ErrNotFound.WithMessage("限定公開フィードが見つかりません")
+		}
+
+		logger.FromContext(ctx).Error("failed to fetch private feed token by token", "error", err)
+		return nil, apperror.ErrInternal.WithMessage("限定公開フィードの取得に失敗しました").WithError(err)
+	}
+
+	return &t, nil
+}
+
+// FindByChannelID は指定されたチャンネルの全トークンを取得する
+func (r *privateFeedTokenRepository) FindByChannelID(ctx context.Context, channelID uuid.UUID) ([]model.PrivateFeedToken, error) {
+	var tokens []model.PrivateFeedToken
+
+	if err := r.db.WithContext(ctx).
+		Preload("User").
+		Preload("Channel").
+		Where("channel_id = ?", channelID).
+		Order("created_at DESC").
+		Find(&tokens).Error; err != nil {
+		logger.FromContext(ctx).Error("failed to fetch private feed tokens", "error", err, "channel_id", channelID)
+		return nil, apperror.ErrInternal.WithMessage("限定公開フィードの取得に失敗しました").WithError(err)
+	}
+
+	return tokens, nil
+}
+
+// FindByUserID は指定されたユーザーに発行された全トークンを取得する
+func (r *privateFeedTokenRepository) FindByUserID(ctx context.Context, userID uuid.UUID) ([]model.PrivateFeedToken, error) {
+	var tokens []model.PrivateFeedToken
+
+	if err := r.db.WithContext(ctx).
+		Preload("User").
+		Preload("Channel").
+		Where("user_id = ?", userID).
+		Order("created_at DESC").
+		Find(&tokens).Error; err != nil {
+		logger.FromContext(ctx).Error("failed to fetch private feed tokens", "error", err, "user_id", userID)
+		return nil, apperror.ErrInternal.WithMessage("限定公開フィードの取得に失敗しました").WithError(err)
+	}
+
+	return tokens, nil
+}
+
+// UpdateLastUsedAt はトークンの最終使用日時を更新する
+func (r *privateFeedTokenRepository) UpdateLastUsedAt(ctx context.Context, id uuid.UUID, lastUsedAt time.Time) error {
+	if err := r.db.WithContext(ctx).Model(&model.PrivateFeedToken{}).Where("id = ?", id).Update("last_used_at", lastUsedAt).Error; err != nil {
+		logger.FromContext(ctx).Error("failed to update private feed token last_used_at", "error", err, "id", id)
+		return apperror.ErrInternal.WithMessage("限定公開フィードの更新に失敗しました").WithError(err)
+	}
+
+	return nil
+}
+
+// Delete はトークンを削除する
+func (r *privateFeedTokenRepository) Delete(ctx context.Context, id uuid.UUID) error {
+	if err := r.db.WithContext(ctx).Where("id = ?", id).Delete(&model.PrivateFeedToken{}).Error; err != nil {
+		logger.FromContext(ctx).Error("failed to delete private feed token", "error", err, "id", id)
+		return apperror.ErrInternal.WithMessage("限定公開フィードの削除に失敗しました").WithError(err)
+	}
+
+	return nil
+}
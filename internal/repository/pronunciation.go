@@ -0,0 +1,141 @@
+package repository
+
+import (
+	"context"
+	"errors"
+
+	"gorm.io/gorm"
+
+	"github.com/siropaca/anycast-backend/internal/apperror"
+	"github.com/siropaca/anycast-backend/internal/model"
+	"github.com/siropaca/anycast-backend/internal/pkg/logger"
+	"github.com/siropaca/anycast-backend/internal/pkg/uuid"
+)
+
+// PronunciationRepository は発音辞書データへのアクセスインターフェース
+type PronunciationRepository interface {
+	FindByID(ctx context.Context, id uuid.UUID) (*model.Pronunciation, error)
+	FindByUserID(ctx context.Context, userID uuid.UUID) ([]model.Pronunciation, error)
+	FindByChannelID(ctx context.Context, channelID uuid.UUID) ([]model.Pronunciation, error)
+	ExistsBySurface(ctx context.Context, userID uuid.UUID, channelID *uuid.UUID, surface string, excludeID *uuid.UUID) (bool, error)
+	Create(ctx context.Context, pronunciation *model.Pronunciation) error
+	Update(ctx context.Context, pronunciation *model.Pronunciation) error
+	Delete(ctx context.Context, id uuid.UUID) error
+}
+
+type pronunciationRepository struct {
+	db *gorm.DB
+}
+
+// NewPronunciationRepository は PronunciationRepository の実装を返す
+func NewPronunciationRepository(db *gorm.DB) PronunciationRepository {
+	return &pronunciationRepository{db: db}
+}
+
+// FindByID は指定された ID の発音辞書エントリを取得する
+func (r *pronunciationRepository) FindByID(ctx context.Context, id uuid.UUID) (*model.Pronunciation, error) {
+	var pronunciation model.Pronunciation
+
+	if err := r.db.WithContext(ctx).First(&pronunciation, "id = ?", id).Error; err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return nil, apperror.ErrNotFound.WithMessage("発音辞書のエントリが見つかりません")
+		}
+
+		logger.FromContext(ctx).Error("failed to fetch pronunciation", "error", err, "pronunciation_id", id)
+		return nil, apperror.ErrInternal.WithMessage("発音辞書の取得に失敗しました").WithError(err)
+	}
+
+	return &pronunciation, nil
+}
+
+// FindByUserID は指定されたユーザーのユーザー辞書（チャンネルに紐づかないエントリ）を表記順に取得する
+func (r *pronunciationRepository) FindByUserID(ctx context.Context, userID uuid.UUID) ([]model.Pronunciation, error) {
+	var pronunciations []model.Pronunciation
+
+	if err := r.db.WithContext(ctx).
+		Where("user_id = ? AND channel_id IS NULL", userID).
+		Order("surface ASC").
+		Find(&pronunciations).Error; err != nil {
+		logger.FromContext(ctx).Error("failed to fetch user pronunciations", "error", err, "user_id", userID)
+		return nil, apperror.ErrInternal.WithMessage("発音辞書の取得に失敗しました").WithError(err)
+	}
+
+	return pronunciations, nil
+}
+
+// FindByChannelID は指定されたチャンネルのチャンネル辞書を表記順に取得する
+func (r *pronunciationRepository) FindByChannelID(ctx context.Context, channelID uuid.UUID) ([]model.Pronunciation, error) {
+	var pronunciations []model.Pronunciation
+
+	if err := r.db.WithContext(ctx).
+		Where("channel_id = ?", channelID).
+		Order("surface ASC").
+		Find(&pronunciations).Error; err != nil {
+		logger.FromContext(ctx).Error("failed to fetch channel pronunciations", "error", err, "channel_id", channelID)
+		return nil, apperror.ErrInternal.WithMessage("発音辞書の取得に失敗しました").WithError(err)
+	}
+
+	return pronunciations, nil
+}
+
+// ExistsBySurface は同じ辞書内に同じ表記のエントリが存在するかどうかを確認する
+//
+// channelID が nil の場合はユーザー辞書、指定されている場合はチャンネル辞書を対象とする
+func (r *pronunciationRepository) ExistsBySurface(ctx context.Context, userID uuid.UUID, channelID *uuid.UUID, surface string, excludeID *uuid.UUID) (bool, error) {
+	var count int64
+
+	tx := r.db.WithContext(ctx).
+		Model(&model.Pronunciation{}).
+		Where("surface = ?", surface)
+
+	if channelID != nil {
+		tx = tx.Where("channel_id = ?", *channelID)
+	} else {
+		tx = tx.Where("user_id = ? AND channel_id IS NULL", userID)
+	}
+
+	if excludeID != nil {
+		tx = tx.Where("id != ?", *excludeID)
+	}
+
+	if err := tx.Count(&count).Error; err != nil {
+		logger.FromContext(ctx).Error("failed to check pronunciation surface", "error", err, "user_id", userID, "surface", surface)
+		return false, apperror.ErrInternal.WithMessage("発音辞書の確認に失敗しました").WithError(err)
+	}
+
+	return count > 0, nil
+}
+
+// Create は発音辞書エントリを作成する
+func (r *pronunciationRepository) Create(ctx context.Context, pronunciation *model.Pronunciation) error {
+	if err := r.db.WithContext(ctx).Create(pronunciation).Error; err != nil {
+		logger.FromContext(ctx).Error("failed to create pronunciation", "error", err, "user_id", pronunciation.UserID)
+		return apperror.ErrInternal.WithMessage("発音辞書の登録に失敗しました").WithError(err)
+	}
+
+	return nil
+}
+
+// Update は発音辞書エントリを更新する
+func (r *pronunciationRepository) Update(ctx context.Context, pronunciation *model.Pronunciation) error {
+	if err := r.db.WithContext(ctx).Save(pronunciation).Error; err != nil {
+		logger.FromContext(ctx).Error("failed to update pronunciation", "error", err, "pronunciation_id", pronunciation.ID)
+		return apperror.ErrInternal.WithMessage("発音辞書の更新に失敗しました").WithError(err)
+	}
+
+	return nil
+}
+
+// Delete は発音辞書エントリを削除する
+func (r *pronunciationRepository) Delete(ctx context.Context, id uuid.UUID) error {
+	result := r.db.WithContext(ctx).Delete(&model.Pronunciation{}, "id = ?", id)
+	if result.Error != nil {
+		logger.FromContext(ctx).Error("failed to delete pronunciation", "error", result.Error, "pronunciation_id", id)
+		return apperror.ErrInternal.WithMessage("発音辞書の削除に失敗しました").WithError(result.Error)
+	}
+	if result.RowsAffected == 0 {
+		return apperror.ErrNotFound.WithMessage("発音辞書のエントリが見つかりません")
+	}
+
+	return nil
+}
@@ -0,0 +1,73 @@
+package repository
+
+import (
+	"regexp"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+	"gorm.io/driver/postgres"
+	"gorm.io/gorm"
+	"gorm.io/gorm/logger"
+)
+
+var insertColumnsPattern = regexp.MustCompile(`^INSERT INTO "[^"]+" \(([^)]*)\)`)
+
+// dryRunStatements は DryRun モードの DB で組み立てられた直前の SQL を記録する
+type dryRunStatements struct {
+	insertValues map[string]any
+	querySQL     string
+	queryVars    []any
+}
+
+// inserted は直前の INSERT 文をカラム名と値のマップとして返す
+func (s *dryRunStatements) inserted() map[string]any {
+	return s.insertValues
+}
+
+// query は直前の SELECT 文と値を返す
+func (s *dryRunStatements) query() (string, []any) {
+	return s.querySQL, s.queryVars
+}
+
+// newDryRunDB は SQL を実行せずに組み立てた文を記録する DB を返す
+func newDryRunDB(t *testing.T) (*gorm.DB, *dryRunStatements) {
+	t.Helper()
+
+	db, err := gorm.Open(postgres.New(postgres.Config{DSN: "host=localhost"}), &gorm.Config{
+		DryRun:                 true,
+		SkipDefaultTransaction: true,
+		DisableAutomaticPing:   true,
+		Logger:                 logger.Default.LogMode(logger.Silent),
+	})
+	require.NoError(t, err)
+
+	statements := &dryRunStatements{}
+
+	err = db.Callback().Create().After("gorm:create").Register("test:capture_insert", func(tx *gorm.DB) {
+		matches := insertColumnsPattern.FindStringSubmatch(tx.Statement.SQL.String())
+		if matches == nil {
+			return
+		}
+
+		statements.insertValues = make(map[string]any)
+		for i, column := range strings.Split(matches[1], ",") {
+			if i < len(tx.Statement.Vars) {
+				statements.insertValues[strings.Trim(column, `"`)] = tx.Statement.Vars[i]
+			}
+		}
+	})
+	require.NoError(t, err)
+
+	// Preload のクエリで上書きされないよう、最初の SELECT 文のみを記録する
+	err = db.Callback().Query().After("gorm:query").Register("test:capture_query", func(tx *gorm.DB) {
+		if statements.querySQL != "" {
+			return
+		}
+		statements.querySQL = tx.Statement.SQL.String()
+		statements.queryVars = tx.Statement.Vars
+	})
+	require.NoError(t, err)
+
+	return db, statements
+}
@@ -0,0 +1,93 @@
+package repository
+
+import (
+	"context"
+	"errors"
+
+	"gorm.io/gorm"
+
+	"github.com/siropaca/anycast-backend/internal/apperror"
+	"github.com/siropaca/anycast-backend/internal/model"
+	"github.com/siropaca/anycast-backend/internal/pkg/logger"
+	"github.com/siropaca/anycast-backend/internal/pkg/uuid"
+)
+
+// SfxCueRepository は効果音キューデータへのアクセスインターフェース
+type SfxCueRepository interface {
+	FindByID(ctx context.Context, id uuid.UUID) (*model.SfxCue, error)
+	FindByEpisodeID(ctx context.Context, episodeID uuid.UUID) ([]model.SfxCue, error)
+	Create(ctx context.Context, cue *model.SfxCue) error
+	Delete(ctx context.Context, id uuid.UUID) error
+}
+
+type sfxCueRepository struct {
+	db *gorm.DB
+}
+
+// NewSfxCueRepository は SfxCueRepository の実装を返す
+func NewSfxCueRepository(db *gorm.DB) SfxCueRepository {
+	return &sfxCueRepository{db: db}
+}
+
+// FindByID は指定された ID の効果音キューを取得する
+func (r *sfxCueRepository) FindByID(ctx context.Context, id uuid.UUID) (*model.SfxCue, error) {
+	var cue model.SfxCue
+
+	if err := r.db.WithContext(ctx).
+		Preload("ScriptLine").
+		Preload("SoundEffect.Audio").
+		Preload("SystemSoundEffect.Audio").
+		First(&cue, "id = ?", id).Error; err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return nil, apperror.ErrNotFound.WithMessage("効果音キューが見つかりません")
+		}
+
+		logger.FromContext(ctx).Error("failed to fetch sfx cue", "error", err, "sfx_cue_id", id)
+		return nil, apperror.ErrInternal.WithMessage("効果音キューの取得に失敗しました").WithError(err)
+	}
+
+	return &cue, nil
+}
+
+// FindByEpisodeID は指定されたエピソードの台本行に設定された効果音キュー一覧を台本の行順で取得する
+func (r *sfxCueRepository) FindByEpisodeID(ctx context.Context, episodeID uuid.UUID) ([]model.SfxCue, error) {
+	var cues []model.SfxCue
+
+	if err := r.db.WithContext(ctx).
+		Joins("JOIN script_lines ON script_lines.id = sfx_cues.script_line_id").
+		Preload("SoundEffect.Audio").
+		Preload("SystemSoundEffect.Audio").
+		Where("script_lines.episode_id = ?", episodeID).
+		Order("script_lines.line_order ASC").
+		Order("sfx_cues.created_at ASC").
+		Find(&cues).Error; err != nil {
+		logger.FromContext(ctx).Error("failed to fetch sfx cues", "error", err, "episode_id", episodeID)
+		return nil, apperror.ErrInternal.WithMessage("効果音キュー一覧の取得に失敗しました").WithError(err)
+	}
+
+	return cues, nil
+}
+
+// Create は効果音キューを作成する
+func (r *sfxCueRepository) Create(ctx context.Context, cue *model.SfxCue) error {
+	if err := r.db.WithContext(ctx).Create(cue).Error; err != nil {
+		logger.FromContext(ctx).Error("failed to create sfx cue", "error", err)
+		return apperror.ErrInternal.WithMessage("効果音キューの作成に失敗しました").WithError(err)
+	}
+
+	return nil
+}
+
+// Delete は効果音キューを削除する
+func (r *sfxCueRepository) Delete(ctx context.Context, id uuid.UUID) error {
+	result := r.db.WithContext(ctx).Delete(&model.SfxCue{}, "id = ?", id)
+	if result.Error != nil {
+		logger.FromContext(ctx).Error("failed to delete sfx cue", "error", result.Error, "sfx_cue_id", id)
+		return apperror.ErrInternal.WithMessage("効果音キューの削除に失敗しました").WithError(result.Error)
+	}
+	if result.RowsAffected == 0 {
+		return apperror.ErrNotFound.WithMessage("効果音キューが見つかりません")
+	}
+
+	return nil
+}
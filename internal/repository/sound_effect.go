@@ -0,0 +1,174 @@
+package repository
+
+import (
+	"context"
+	"errors"
+
+	"gorm.io/gorm"
+
+	"github.com/siropaca/anycast-backend/internal/apperror"
+	"github.com/siropaca/anycast-backend/internal/model"
+	"github.com/siropaca/anycast-backend/internal/pkg/logger"
+	"github.com/siropaca/anycast-backend/internal/pkg/uuid"
+)
+
+// SoundEffectRepository は効果音データへのアクセスインターフェース
+type SoundEffectRepository interface {
+	FindByID(ctx context.Context, id uuid.UUID) (*model.SoundEffect, error)
+	FindByUserID(ctx context.Context, userID uuid.UUID, filter SoundEffectFilter) ([]model.SoundEffect, int64, error)
+	FindByUserIDAndNames(ctx context.Context, userID uuid.UUID, names []string) ([]model.SoundEffect, error)
+	Create(ctx context.Context, soundEffect *model.SoundEffect) error
+	Update(ctx context.Context, soundEffect *model.SoundEffect) error
+	Delete(ctx context.Context, id uuid.UUID) error
+	IsUsedInAnyCue(ctx context.Context, id uuid.UUID) (bool, error)
+	ExistsByUserIDAndName(ctx context.Context, userID uuid.UUID, name string, excludeID *uuid.UUID) (bool, error)
+}
+
+// SoundEffectFilter は効果音検索のフィルタ条件を表す
+type SoundEffectFilter struct {
+	Limit  int
+	Offset int
+}
+
+type soundEffectRepository struct {
+	db *gorm.DB
+}
+
+// NewSoundEffectRepository は SoundEffectRepository の実装を返す
+func NewSoundEffectRepository(db *gorm.DB) SoundEffectRepository {
+	return &soundEffectRepository{db: db}
+}
+
+// FindByUserID は指定されたユーザーの効果音一覧を取得する
+func (r *soundEffectRepository) FindByUserID(ctx context.Context, userID uuid.UUID, filter SoundEffectFilter) ([]model.SoundEffect, int64, error) {
+	var soundEffects []model.SoundEffect
+	var total int64
+
+	tx := r.db.WithContext(ctx).Model(&model.SoundEffect{}).Where("user_id = ?", userID)
+
+	// 総件数を取得
+	if err := tx.Count(&total).Error; err != nil {
+		logger.FromContext(ctx).Error("failed to count sound effects", "error", err, "user_id", userID)
+		return nil, 0, apperror.ErrInternal.WithMessage("効果音数の取得に失敗しました").WithError(err)
+	}
+
+	// ページネーションとリレーションのプリロード
+	if err := tx.
+		Preload("Audio").
+		Order("created_at DESC").
+		Limit(filter.Limit).
+		Offset(filter.Offset).
+		Find(&soundEffects).Error; err != nil {
+		logger.FromContext(ctx).Error("failed to fetch sound effects", "error", err, "user_id", userID)
+		return nil, 0, apperror.ErrInternal.WithMessage("効果音一覧の取得に失敗しました").WithError(err)
+	}
+
+	return soundEffects, total, nil
+}
+
+// FindByUserIDAndNames は指定されたユーザーの効果音のうち、指定された名前のものを取得する
+func (r *soundEffectRepository) FindByUserIDAndNames(ctx context.Context, userID uuid.UUID, names []string) ([]model.SoundEffect, error) {
+	var soundEffects []model.SoundEffect
+
+	if len(names) == 0 {
+		return soundEffects, nil
+	}
+
+	if err := r.db.WithContext(ctx).
+		Preload("Audio").
+		Where("user_id = ?", userID).
+		Where("name IN ?", names).
+		Find(&soundEffects).Error; err != nil {
+		logger.FromContext(ctx).Error("failed to fetch sound effects by names", "error", err, "user_id", userID)
+		return nil, apperror.ErrInternal.WithMessage("効果音の取得に失敗しました").WithError(err)
+	}
+
+	return soundEffects, nil
+}
+
+// FindByID は指定された ID の効果音を取得する
+func (r *soundEffectRepository) FindByID(ctx context.Context, id uuid.UUID) (*model.SoundEffect, error) {
+	var soundEffect model.SoundEffect
+
+	if err := r.db.WithContext(ctx).
+		Preload("Audio").
+		First(&soundEffect, "id = ?", id).Error; err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return nil, apperror.ErrNotFound.WithMessage("効果音が見つかりません")
+		}
+
+		logger.FromContext(ctx).Error("failed to fetch sound effect", "error", err, "sound_effect_id", id)
+		return nil, apperror.ErrInternal.WithMessage("効果音の取得に失敗しました").WithError(err)
+	}
+
+	return &soundEffect, nil
+}
+
+// Create は効果音を作成する
+func (r *soundEffectRepository) Create(ctx context.Context, soundEffect *model.SoundEffect) error {
+	if err := r.db.WithContext(ctx).Create(soundEffect).Error; err != nil {
+		logger.FromContext(ctx).Error("failed to create sound effect", "error", err)
+		return apperror.ErrInternal.WithMessage("効果音の作成に失敗しました").WithError(err)
+	}
+
+	return nil
+}
+
+// Update は効果音を更新する
+func (r *soundEffectRepository) Update(ctx context.Context, soundEffect *model.SoundEffect) error {
+	if err := r.db.WithContext(ctx).Save(soundEffect).Error; err != nil {
+		logger.FromContext(ctx).Error("failed to update sound effect", "error", err, "sound_effect_id", soundEffect.ID)
+		return apperror.ErrInternal.WithMessage("効果音の更新に失敗しました").WithError(err)
+	}
+
+	return nil
+}
+
+// Delete は効果音を削除する
+func (r *soundEffectRepository) Delete(ctx context.Context, id uuid.UUID) error {
+	result := r.db.WithContext(ctx).Delete(&model.SoundEffect{}, "id = ?", id)
+	if result.Error != nil {
+		logger.FromContext(ctx).Error("failed to delete sound effect", "error", result.Error, "sound_effect_id", id)
+		return apperror.ErrInternal.WithMessage("効果音の削除に失敗しました").WithError(result.Error)
+	}
+	if result.RowsAffected == 0 {
+		return apperror.ErrNotFound.WithMessage("効果音が見つかりません")
+	}
+
+	return nil
+}
+
+// IsUsedInAnyCue は効果音がいずれかの効果音キューで使用中かどうかを確認する
+func (r *soundEffectRepository) IsUsedInAnyCue(ctx context.Context, id uuid.UUID) (bool, error) {
+	var count int64
+
+	if err := r.db.WithContext(ctx).
+		Model(&model.SfxCue{}).
+		Where("sound_effect_id = ?", id).
+		Count(&count).Error; err != nil {
+		logger.FromContext(ctx).Error("failed to check sound effect usage", "error", err, "sound_effect_id", id)
+		return false, apperror.ErrInternal.WithMessage("効果音の使用状況確認に失敗しました").WithError(err)
+	}
+
+	return count > 0, nil
+}
+
+// ExistsByUserIDAndName は同一ユーザー内で同じ名前の効果音が存在するかどうかを確認する
+func (r *soundEffectRepository) ExistsByUserIDAndName(ctx context.Context, userID uuid.UUID, name string, excludeID *uuid.UUID) (bool, error) {
+	var count int64
+
+	tx := r.db.WithContext(ctx).
+		Model(&model.SoundEffect{}).
+		Where("user_id = ? AND name = ?", userID, name)
+
+	if excludeID != nil {
+		tx = tx.Where("id != ?", *excludeID)
+	}
+
+	if err := tx.Count(&count).Error; err != nil {
+		logger.FromContext(ctx).Error("failed to check sound effect name", "error", err, "user_id", userID, "name", name)
+		return false, apperror.ErrInternal.WithMessage("効果音名の確認に失敗しました").WithError(err)
+	}
+
+	return count > 0, nil
+}
@@ -17,6 +17,7 @@ type SystemBgmRepository interface {
 	FindByID(ctx context.Context, id uuid.UUID) (*model.SystemBgm, error)
 	FindActive(ctx context.Context, filter SystemBgmFilter) ([]model.SystemBgm, int64, error)
 	CountActive(ctx context.Context) (int64, error)
+	FindActiveByNames(ctx context.Context, names []string) ([]model.SystemBgm, error)
 }
 
 // SystemBgmFilter はシステム BGM 検索のフィルタ条件を表す
@@ -97,3 +98,22 @@ func (r *systemBgmRepository) FindByID(ctx context.Context, id uuid.UUID) (*mode
 
 	return &bgm, nil
 }
+
+// FindActiveByNames は指定された名前のアクティブなシステム BGM を取得する
+func (r *systemBgmRepository) FindActiveByNames(ctx context.Context, names []string) ([]model.SystemBgm, error) {
+	var items []model.SystemBgm
+
+	if len(names) == 0 {
+		return items, nil
+	}
+
+	if err := r.db.WithContext(ctx).
+		Where("is_active = ?", true).
+		Where("name IN ?", names).
+		Find(&items).Error; err != nil {
+		logger.FromContext(ctx).Error("failed to fetch system bgms by names", "error", err)
+		return nil, apperror.ErrInternal.WithMessage("システム BGMの取得に失敗しました").WithError(err)
+	}
+
+	return items, nil
+}
@@ -0,0 +1,116 @@
+package repository
+
+import (
+	"context"
+	"errors"
+
+	"gorm.io/gorm"
+
+	"github.com/siropaca/anycast-backend/internal/apperror"
+	"github.com/siropaca/anycast-backend/internal/model"
+	"github.com/siropaca/anycast-backend/internal/pkg/logger"
+	"github.com/siropaca/anycast-backend/internal/pkg/uuid"
+)
+
+// SystemSoundEffectRepository はシステム効果音データへのアクセスインターフェース
+type SystemSoundEffectRepository interface {
+	FindByID(ctx context.Context, id uuid.UUID) (*model.SystemSoundEffect, error)
+	FindActive(ctx context.Context, filter SystemSoundEffectFilter) ([]model.SystemSoundEffect, int64, error)
+	CountActive(ctx context.Context) (int64, error)
+	FindActiveByNames(ctx context.Context, names []string) ([]model.SystemSoundEffect, error)
+}
+
+// SystemSoundEffectFilter はシステム効果音検索のフィルタ条件を表す
+type SystemSoundEffectFilter struct {
+	Limit  int
+	Offset int
+}
+
+type systemSoundEffectRepository struct {
+	db *gorm.DB
+}
+
+// NewSystemSoundEffectRepository は SystemSoundEffectRepository の実装を返す
+func NewSystemSoundEffectRepository(db *gorm.DB) SystemSoundEffectRepository {
+	return &systemSoundEffectRepository{db: db}
+}
+
+// FindActive はアクティブなシステム効果音一覧を取得する
+func (r *systemSoundEffectRepository) FindActive(ctx context.Context, filter SystemSoundEffectFilter) ([]model.SystemSoundEffect, int64, error) {
+	var soundEffects []model.SystemSoundEffect
+	var total int64
+
+	tx := r.db.WithContext(ctx).Model(&model.SystemSoundEffect{}).Where("is_active = ?", true)
+
+	// 総件数を取得
+	if err := tx.Count(&total).Error; err != nil {
+		logger.FromContext(ctx).Error("failed to count system sound effects", "error", err)
+		return nil, 0, apperror.ErrInternal.WithMessage("システム効果音数の取得に失敗しました").WithError(err)
+	}
+
+	// ページネーションとリレーションのプリロード
+	if err := tx.
+		Preload("Audio").
+		Order("sort_order ASC").
+		Limit(filter.Limit).
+		Offset(filter.Offset).
+		Find(&soundEffects).Error; err != nil {
+		logger.FromContext(ctx).Error("failed to fetch system sound effects", "error", err)
+		return nil, 0, apperror.ErrInternal.WithMessage("システム効果音一覧の取得に失敗しました").WithError(err)
+	}
+
+	return soundEffects, total, nil
+}
+
+// CountActive はアクティブなシステム効果音の総件数を取得する
+func (r *systemSoundEffectRepository) CountActive(ctx context.Context) (int64, error) {
+	var total int64
+
+	if err := r.db.WithContext(ctx).
+		Model(&model.SystemSoundEffect{}).
+		Where("is_active = ?", true).
+		Count(&total).Error; err != nil {
+		logger.FromContext(ctx).Error("failed to count active system sound effects", "error", err)
+		return 0, apperror.ErrInternal.WithMessage("システム効果音数の取得に失敗しました").WithError(err)
+	}
+
+	return total, nil
+}
+
+// FindByID は指定された ID のシステム効果音を取得する
+func (r *systemSoundEffectRepository) FindByID(ctx context.Context, id uuid.UUID) (*model.SystemSoundEffect, error) {
+	var soundEffect model.SystemSoundEffect
+
+	if err := r.db.WithContext(ctx).
+		Preload("Audio").
+		First(&soundEffect, "id = ?", id).Error; err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return nil, apperror.ErrNotFound.WithMessage("システム効果音が見つかりません")
+		}
+
+		logger.FromContext(ctx).Error("failed to fetch system sound effect", "error", err, "system_sound_effect_id", id)
+		return nil, apperror.ErrInternal.WithMessage("システム効果音の取得に失敗しました").WithError(err)
+	}
+
+	return &soundEffect, nil
+}
+
+// FindActiveByNames は指定された名前のアクティブなシステム効果音を取得する
+func (r *systemSoundEffectRepository) FindActiveByNames(ctx context.Context, names []string) ([]model.SystemSoundEffect, error) {
+	var items []model.SystemSoundEffect
+
+	if len(names) == 0 {
+		return items, nil
+	}
+
+	if err := r.db.WithContext(ctx).
+		Preload("Audio").
+		Where("is_active = ?", true).
+		Where("name IN ?", names).
+		Find(&items).Error; err != nil {
+		logger.FromContext(ctx).Error("failed to fetch system sound effects by names", "error", err)
+		return nil, apperror.ErrInternal.WithMessage("システム効果音の取得に失敗しました").WithError(err)
+	}
+
+	return items, nil
+}
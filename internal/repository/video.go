@@ -0,0 +1,106 @@
+package repository
+
+import (
+	"context"
+	"errors"
+
+	"gorm.io/gorm"
+
+	"github.com/siropaca/anycast-backend/internal/apperror"
+	"github.com/siropaca/anycast-backend/internal/model"
+	"github.com/siropaca/anycast-backend/internal/pkg/logger"
+	"github.com/siropaca/anycast-backend/internal/pkg/uuid"
+)
+
+// VideoRepository は動画データへのアクセスインターフェース
+type VideoRepository interface {
+	Create(ctx context.Context, video *model.Video) error
+	FindByID(ctx context.Context, id uuid.UUID) (*model.Video, error)
+	Delete(ctx context.Context, id uuid.UUID) error
+	FindOrphaned(ctx context.Context) ([]model.Video, error)
+	FindAll(ctx context.Context) ([]model.Video, error)
+}
+
+type videoRepository struct {
+	db *gorm.DB
+}
+
+// NewVideoRepository は VideoRepository の実装を返す
+func NewVideoRepository(db *gorm.DB) VideoRepository {
+	return &videoRepository{db: db}
+}
+
+// Create は動画を作成する
+func (r *videoRepository) Create(ctx context.Context, video *model.Video) error {
+	if err := r.db.WithContext(ctx).Create(video).Error; err != nil {
+		logger.FromContext(ctx).Error("failed to create video", "error", err)
+		return apperror.ErrInternal.WithMessage("動画の作成に失敗しました").WithError(err)
+	}
+
+	return nil
+}
+
+// FindByID は指定された ID の動画を取得する
+func (r *videoRepository) FindByID(ctx context.Context, id uuid.UUID) (*model.Video, error) {
+	var video model.Video
+
+	if err := r.db.WithContext(ctx).First(&video, "id = ?", id).Error; err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return nil, apperror.ErrNotFound.WithMessage("動画が見つかりません")
+		}
+
+		logger.FromContext(ctx).Error("failed to fetch video", "error", err, "video_id", id)
+		return nil, apperror.ErrInternal.WithMessage("動画の取得に失敗しました").WithError(err)
+	}
+
+	return &video, nil
+}
+
+// Delete は動画を削除する
+func (r *videoRepository) Delete(ctx context.Context, id uuid.UUID) error {
+	result := r.db.WithContext(ctx).Delete(&model.Video{}, "id = ?", id)
+	if result.Error != nil {
+		logger.FromContext(ctx).Error("failed to delete video", "error", result.Error, "id", id)
+		return apperror.ErrInternal.WithMessage("動画の削除に失敗しました").WithError(result.Error)
+	}
+
+	if result.RowsAffected == 0 {
+		return apperror.ErrNotFound.WithMessage("動画が見つかりません")
+	}
+
+	return nil
+}
+
+// FindOrphaned はどのテーブルからも参照されていない孤児レコードを取得する
+//
+// 対象: clip_jobs.result_video_id
+// 条件: created_at から 1 時間以上経過したレコードのみ
+func (r *videoRepository) FindOrphaned(ctx context.Context) ([]model.Video, error) {
+	var videos []model.Video
+
+	query := `
+		SELECT v.* FROM videos v
+		WHERE v.created_at < NOW() - INTERVAL '1 hour'
+		AND NOT EXISTS (SELECT 1 FROM clip_jobs cj WHERE cj.result_video_id = v.id)
+		ORDER BY v.created_at DESC
+	`
+
+	if err := r.db.WithContext(ctx).Raw(query).Scan(&videos).Error; err != nil {
+		logger.FromContext(ctx).Error("failed to fetch orphaned videos", "error", err)
+		return nil, apperror.ErrInternal.WithMessage("孤立した動画の取得に失敗しました").WithError(err)
+	}
+
+	return videos, nil
+}
+
+// FindAll はすべての動画を作成日時順に取得する（ストレージ間の移行用）
+func (r *videoRepository) FindAll(ctx context.Context) ([]model.Video, error) {
+	var videos []model.Video
+
+	if err := r.db.WithContext(ctx).Order("created_at ASC").Find(&videos).Error; err != nil {
+		logger.FromContext(ctx).Error("failed to fetch videos", "error", err)
+		return nil, apperror.ErrInternal.WithMessage("動画一覧の取得に失敗しました").WithError(err)
+	}
+
+	return videos, nil
+}
@@ -32,7 +32,7 @@ func Setup(container *di.Container, cfg *config.Config) *gin.Engine {
 		AllowOrigins:     cfg.CORSAllowedOrigins,
 		AllowMethods:     []string{"GET", "POST", "PUT", "PATCH", "DELETE", "OPTIONS"},
 		AllowHeaders:     []string{"Origin", "Content-Type", "Authorization", "X-API-Key"},
-		ExposeHeaders:    []string{"Content-Length"},
+		ExposeHeaders:    []string{"Content-Length", "Retry-After"},
 		AllowCredentials: true,
 	}))
 
@@ -93,14 +93,29 @@ func Setup(container *di.Container, cfg *config.Config) *gin.Engine {
 	authenticated.POST("/me/api-keys", container.APIKeyHandler.CreateAPIKey)
 	authenticated.GET("/me/api-keys", container.APIKeyHandler.ListAPIKeys)
 	authenticated.DELETE("/me/api-keys/:apiKeyId", container.APIKeyHandler.DeleteAPIKey)
+	// Private Feeds（自分に発行された限定公開フィード）
+	authenticated.GET("/me/private-feeds", container.PrivateFeedHandler.ListMyPrivateFeeds)
+	authenticated.DELETE("/me/private-feeds/:privateFeedId", container.PrivateFeedHandler.DeleteMyPrivateFeed)
+	// Pronunciations（ユーザー辞書）
+	authenticated.GET("/me/pronunciations", container.PronunciationHandler.ListMyPronunciations)
+	authenticated.POST("/me/pronunciations", container.PronunciationHandler.CreateMyPronunciation)
+	authenticated.PATCH("/me/pronunciations/:pronunciationId", container.PronunciationHandler.UpdateMyPronunciation)
+	authenticated.DELETE("/me/pronunciations/:pronunciationId", container.PronunciationHandler.DeleteMyPronunciation)
 
 	authenticated.GET("/me/bgms", container.BgmHandler.ListMyBgms)
 	authenticated.POST("/me/bgms", container.BgmHandler.CreateBgm)
 	authenticated.GET("/me/bgms/:bgmId", container.BgmHandler.GetMyBgm)
 	authenticated.PATCH("/me/bgms/:bgmId", container.BgmHandler.UpdateMyBgm)
 	authenticated.DELETE("/me/bgms/:bgmId", container.BgmHandler.DeleteMyBgm)
+	authenticated.GET("/me/sound-effects", container.SoundEffectHandler.ListMySoundEffects)
+	authenticated.POST("/me/sound-effects", container.SoundEffectHandler.CreateSoundEffect)
+	authenticated.GET("/me/sound-effects/:soundEffectId", container.SoundEffectHandler.GetMySoundEffect)
+	authenticated.PATCH("/me/sound-effects/:soundEffectId", container.SoundEffectHandler.UpdateMySoundEffect)
+	authenticated.DELETE("/me/sound-effects/:soundEffectId", container.SoundEffectHandler.DeleteMySoundEffect)
 	authenticated.GET("/me/audio-jobs", container.AudioJobHandler.ListMyAudioJobs)
 	authenticated.GET("/me/script-jobs", container.ScriptJobHandler.ListMyScriptJobs)
+	authenticated.GET("/me/import-jobs", container.ImportJobHandler.ListMyImportJobs)
+	authenticated.GET("/me/clip-jobs", container.ClipJobHandler.ListMyClipJobs)
 
 	// Playlists
 	authenticated.GET("/me/playlists", container.PlaylistHandler.ListPlaylists)
@@ -135,10 +150,28 @@ func Setup(container *di.Container, cfg *config.Config) *gin.Engine {
 	authenticated.PUT("/channels/:channelId/user-prompt", container.ChannelHandler.SetUserPrompt)
 	authenticated.PUT("/channels/:channelId/default-bgm", container.ChannelHandler.SetDefaultBgm)
 	authenticated.DELETE("/channels/:channelId/default-bgm", container.ChannelHandler.DeleteDefaultBgm)
+	authenticated.PUT("/channels/:channelId/intro", container.ChannelHandler.SetIntro)
+	authenticated.DELETE("/channels/:channelId/intro", container.ChannelHandler.DeleteIntro)
+	authenticated.PUT("/channels/:channelId/outro", container.ChannelHandler.SetOutro)
+	authenticated.DELETE("/channels/:channelId/outro", container.ChannelHandler.DeleteOutro)
 	// Channel Characters
 	authenticated.POST("/channels/:channelId/characters", container.ChannelHandler.AddChannelCharacter)
 	authenticated.PUT("/channels/:channelId/characters/:characterId", container.ChannelHandler.ReplaceChannelCharacter)
 	authenticated.DELETE("/channels/:channelId/characters/:characterId", container.ChannelHandler.RemoveChannelCharacter)
+	// Channel Pronunciations（チャンネル辞書）
+	authenticated.GET("/channels/:channelId/pronunciations", container.PronunciationHandler.ListChannelPronunciations)
+	authenticated.POST("/channels/:channelId/pronunciations", container.PronunciationHandler.CreateChannelPronunciation)
+	authenticated.PATCH("/channels/:channelId/pronunciations/:pronunciationId", container.PronunciationHandler.UpdateChannelPronunciation)
+	authenticated.DELETE("/channels/:channelId/pronunciations/:pronunciationId", container.PronunciationHandler.DeleteChannelPronunciation)
+	// Channel Private Feeds（限定公開フィード）
+	authenticated.GET("/channels/:channelId/private-feeds", container.PrivateFeedHandler.ListChannelPrivateFeeds)
+	authenticated.POST("/channels/:channelId/private-feeds", container.PrivateFeedHandler.CreatePrivateFeed)
+	authenticated.DELETE("/channels/:channelId/private-feeds/:privateFeedId", container.PrivateFeedHandler.DeleteChannelPrivateFeed)
+	// Channel Import（外部フィードからの取り込み）
+	authenticated.POST("/channels/:channelId/import", container.ImportJobHandler.CreateImportJob)
+	// Channel Archive（エクスポート・インポートによるバックアップ・移行）
+	authenticated.POST("/channels/:channelId/archive", container.ChannelArchiveHandler.ExportChannel)
+	authenticated.POST("/channel-archives/import", container.ChannelArchiveHandler.ImportChannel)
 	// Episodes
 	authenticated.POST("/channels/:channelId/episodes", container.EpisodeHandler.CreateEpisode)
 	authenticated.PATCH("/channels/:channelId/episodes/:episodeId", container.EpisodeHandler.UpdateEpisode)
@@ -150,6 +183,8 @@ func Setup(container *di.Container, cfg *config.Config) *gin.Engine {
 	authenticated.PUT("/channels/:channelId/episodes/:episodeId/audio", container.EpisodeHandler.UploadAudio)
 	authenticated.DELETE("/channels/:channelId/episodes/:episodeId/audio", container.EpisodeHandler.DeleteAudio)
 	authenticated.POST("/channels/:channelId/episodes/:episodeId/audio/generate-async", container.AudioJobHandler.GenerateAudioAsync)
+	authenticated.POST("/channels/:channelId/episodes/:episodeId/clips", container.ClipJobHandler.CreateClipJob)
+	authenticated.POST("/channels/:channelId/episodes/:episodeId/package", container.EpisodePackageHandler.CreateEpisodePackage)
 	authenticated.POST("/episodes/:episodeId/play", container.EpisodeHandler.IncrementPlayCount)
 	authenticated.PUT("/episodes/:episodeId/playlists", container.PlaylistHandler.UpdateEpisodePlaylists)
 	authenticated.PUT("/episodes/:episodeId/playback", container.PlaybackHistoryHandler.UpdatePlayback)
@@ -166,13 +201,23 @@ func Setup(container *di.Container, cfg *config.Config) *gin.Engine {
 	authenticated.GET("/script-jobs/:jobId", container.ScriptJobHandler.GetScriptJob)
 	authenticated.POST("/script-jobs/:jobId/cancel", container.ScriptJobHandler.CancelScriptJob)
 
+	// Import Jobs
+	authenticated.GET("/import-jobs/:jobId", container.ImportJobHandler.GetImportJob)
+
+	// Clip Jobs
+	authenticated.GET("/clip-jobs/:jobId", container.ClipJobHandler.GetClipJob)
+
 	// Script Lines
 	authenticated.GET("/channels/:channelId/episodes/:episodeId/script/lines", container.ScriptLineHandler.ListScriptLines)
 	authenticated.POST("/channels/:channelId/episodes/:episodeId/script/lines", container.ScriptLineHandler.CreateScriptLine)
 	authenticated.PATCH("/channels/:channelId/episodes/:episodeId/script/lines/:lineId", container.ScriptLineHandler.UpdateScriptLine)
 	authenticated.DELETE("/channels/:channelId/episodes/:episodeId/script/lines", container.ScriptLineHandler.DeleteAllScriptLines)
 	authenticated.DELETE("/channels/:channelId/episodes/:episodeId/script/lines/:lineId", container.ScriptLineHandler.DeleteScriptLine)
+	authenticated.POST("/channels/:channelId/episodes/:episodeId/script/lines/:lineId/preview", middleware.RateLimitByUser(container.TTSPreviewLimiter), container.ScriptLineHandler.PreviewScriptLine)
 	authenticated.POST("/channels/:channelId/episodes/:episodeId/script/reorder", container.ScriptLineHandler.ReorderScriptLines)
+	authenticated.GET("/channels/:channelId/episodes/:episodeId/script/sfx-cues", container.SfxCueHandler.ListSfxCues)
+	authenticated.POST("/channels/:channelId/episodes/:episodeId/script/lines/:lineId/sfx-cues", container.SfxCueHandler.CreateSfxCue)
+	authenticated.DELETE("/channels/:channelId/episodes/:episodeId/script/sfx-cues/:cueId", container.SfxCueHandler.DeleteSfxCue)
 
 	// Script（台本）
 	authenticated.GET("/channels/:channelId/episodes/:episodeId/script-jobs/latest", container.ScriptJobHandler.GetLatestScriptJob)
@@ -183,6 +228,7 @@ func Setup(container *di.Container, cfg *config.Config) *gin.Engine {
 	// Voices
 	authenticated.GET("/voices", container.VoiceHandler.ListVoices)
 	authenticated.GET("/voices/:voiceId", container.VoiceHandler.GetVoice)
+	authenticated.POST("/voices/:voiceId/audition", middleware.RateLimitByUser(container.TTSPreviewLimiter), container.VoiceHandler.AuditionVoice)
 	authenticated.POST("/voices/:voiceId/favorite", container.VoiceHandler.AddFavorite)
 	authenticated.DELETE("/voices/:voiceId/favorite", container.VoiceHandler.RemoveFavorite)
 
@@ -202,6 +248,7 @@ func Setup(container *di.Container, cfg *config.Config) *gin.Engine {
 	optionalAuth.GET("/channels/:channelId", container.ChannelHandler.GetChannel)
 	optionalAuth.GET("/channels/:channelId/episodes", container.EpisodeHandler.ListChannelEpisodes)
 	optionalAuth.GET("/channels/:channelId/episodes/:episodeId", container.EpisodeHandler.GetEpisode)
+	optionalAuth.GET("/channels/:channelId/episodes/:episodeId/hls/playlist.m3u8", container.EpisodeHandler.GetHLSPlaylist)
 	optionalAuth.GET("/recommendations/channels", container.RecommendationHandler.GetRecommendedChannels)
 	optionalAuth.GET("/recommendations/episodes", container.RecommendationHandler.GetRecommendedEpisodes)
 	optionalAuth.GET("/categories", container.CategoryHandler.ListCategories)
@@ -211,6 +258,36 @@ func Setup(container *di.Container, cfg *config.Config) *gin.Engine {
 	optionalAuth.GET("/search/users", container.SearchHandler.SearchUsers)
 	optionalAuth.POST("/contacts", container.ContactHandler.CreateContact)
 
+	// Media（有効期限のない音声の URL。ログイン時はオーナー判定、未ログインは公開済みエピソードの音声のみ）
+	optionalAuth.GET("/media/audio/:audioId", container.MediaHandler.GetAudio)
+	optionalAuth.HEAD("/media/audio/:audioId", container.MediaHandler.GetAudio)
+	optionalAuth.GET("/media/audio/:audioId/waveforms", container.MediaHandler.GetAudioWaveforms)
+
+	// Feeds（認証不要、公開済みのチャンネル・エピソードのみ）
+	api.GET("/channels/:channelId/feed.xml", container.FeedHandler.GetChannelFeed)
+	api.GET("/channels/:channelId/artwork", container.FeedHandler.GetChannelArtwork)
+	api.GET("/channels/:channelId/episodes/:episodeId/enclosure/:filename", container.FeedHandler.GetEpisodeEnclosure)
+	api.GET("/channels/:channelId/episodes/:episodeId/artwork", container.FeedHandler.GetEpisodeArtwork)
+	api.GET("/channels/:channelId/episodes/:episodeId/transcript.txt", container.FeedHandler.GetEpisodeTranscript)
+
+	// Private Feeds（認証不要、パスのトークンで認可。未公開のエピソードも含む）
+	api.GET("/private-feeds/:token/feed.xml", container.FeedHandler.GetPrivateFeed)
+	api.GET("/private-feeds/:token/artwork", container.FeedHandler.GetPrivateChannelArtwork)
+	api.GET("/private-feeds/:token/episodes/:episodeId/enclosure/:filename", container.FeedHandler.GetPrivateEpisodeEnclosure)
+	api.GET("/private-feeds/:token/episodes/:episodeId/artwork", container.FeedHandler.GetPrivateEpisodeArtwork)
+	api.GET("/private-feeds/:token/episodes/:episodeId/transcript.txt", container.FeedHandler.GetPrivateEpisodeTranscript)
+
+	// Share（認証不要、公開済みのチャンネル・エピソードのみ。SNS のリンクプレビュー・埋め込み用）
+	api.GET("/share/channels/:channelId", container.ShareHandler.GetChannelMetadata)
+	api.GET("/share/channels/:channelId/episodes/:episodeId", container.ShareHandler.GetEpisodeMetadata)
+	api.GET("/oembed", container.ShareHandler.GetOEmbed)
+	api.GET("/embed/channels/:channelId/episodes/:episodeId", container.ShareHandler.GetEmbedPlayer)
+
+	// Storage（ローカルストレージ使用時のみ、署名付き URL で認可）
+	if container.StorageHandler != nil {
+		api.GET("/storage/*path", container.StorageHandler.ServeFile)
+	}
+
 	// Admin（認証必須 + 管理者権限必須）
 	admin := r.Group("/admin")
 	admin.Use(middleware.Auth(container.TokenManager))
@@ -222,6 +299,8 @@ func Setup(container *di.Container, cfg *config.Config) *gin.Engine {
 	internal.Use(middleware.CloudTasksAuth(cfg.GoogleCloudTasksWorkerURL, cfg.GoogleCloudTasksServiceAccountEmail))
 	internal.POST("/worker/audio", container.WorkerHandler.ProcessAudioJob)
 	internal.POST("/worker/script", container.WorkerHandler.ProcessScriptJob)
+	internal.POST("/worker/import", container.WorkerHandler.ProcessImportJob)
+	internal.POST("/worker/clip", container.WorkerHandler.ProcessClipJob)
 
 	// Dev（開発環境のみ有効、認証不要）
 	if cfg.AppEnv == config.EnvDevelopment {
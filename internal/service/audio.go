@@ -44,13 +44,15 @@ type AudioService interface {
 type audioService struct {
 	audioRepo     repository.AudioRepository
 	storageClient storage.Client
+	ffmpegService FFmpegService
 }
 
 // NewAudioService は audioService を生成して AudioService として返す
-func NewAudioService(audioRepo repository.AudioRepository, storageClient storage.Client) AudioService {
+func NewAudioService(audioRepo repository.AudioRepository, storageClient storage.Client, ffmpegService FFmpegService) AudioService {
 	return &audioService{
 		audioRepo:     audioRepo,
 		storageClient: storageClient,
+		ffmpegService: ffmpegService,
 	}
 }
 
@@ -64,22 +66,36 @@ func (s *audioService) UploadAudio(ctx context.Context, input UploadAudioInput)
 		return nil, apperror.ErrValidation.WithMessage("無効な音声形式です。使用可能な形式: mp3, wav, ogg, aac, m4a")
 	}
 
-	// ファイルデータの読み込み
-	data, err := io.ReadAll(input.File)
+	// 音声 ID の生成
+	audioID := uuid.New()
+
+	// 音声は大きくなり得るため、メモリに読み込まずに作業ディレクトリのファイルに書き出してから処理する
+	ws, err := newAudioWorkspace(audioID)
+	if err != nil {
+		log.Error("failed to create audio workspace", "error", err)
+		return nil, apperror.ErrInternal.WithMessage("作業ディレクトリの作成に失敗しました").WithError(err)
+	}
+	defer func() {
+		if err := ws.Close(); err != nil {
+			log.Warn("failed to remove audio workspace", "error", err)
+		}
+	}()
+
+	localPath, err := ws.create("upload"+ext, func(w io.Writer) error {
+		_, err := io.Copy(w, input.File)
+		return err
+	})
 	if err != nil {
 		log.Error("failed to read audio data", "error", err)
 		return nil, apperror.ErrInternal.WithMessage("音声データの読み込みに失敗しました").WithError(err)
 	}
 
-	// 音声 ID の生成
-	audioID := uuid.New()
-
-	// 再生時間を取得
-	durationMs := audio.GetDurationMs(data)
+	// 再生時間を取得（取得できない場合は 0）
+	durationMs, _ := audio.GetFileDurationMs(localPath) //nolint:errcheck // 取得できない場合は 0 とする
 
 	// GCS へアップロード
 	path := storage.GenerateAudioPathWithExt(audioID.String(), ext)
-	if _, err := s.storageClient.Upload(ctx, data, path, input.ContentType); err != nil {
+	if _, err := uploadFile(ctx, s.storageClient, localPath, path, input.ContentType); err != nil {
 		return nil, err
 	}
 
@@ -91,6 +107,7 @@ func (s *audioService) UploadAudio(ctx context.Context, input UploadAudioInput)
 		Filename:   input.Filename,
 		FileSize:   input.FileSize,
 		DurationMs: durationMs,
+		Waveforms:  generateFileWaveforms(ctx, s.ffmpegService, localPath),
 	}
 
 	if err := s.audioRepo.Create(ctx, audioModel); err != nil {
@@ -115,6 +132,7 @@ func (s *audioService) UploadAudio(ctx context.Context, input UploadAudioInput)
 			Filename:   filepath.Base(audioModel.Filename),
 			FileSize:   audioModel.FileSize,
 			DurationMs: audioModel.DurationMs,
+			Waveforms:  toAudioWaveformResponses(audioModel.Waveforms),
 		},
 	}, nil
 }
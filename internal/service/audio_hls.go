@@ -0,0 +1,131 @@
+package service
+
+import (
+	"context"
+	"os"
+	"time"
+
+	"github.com/siropaca/anycast-backend/internal/apperror"
+	"github.com/siropaca/anycast-backend/internal/infrastructure/storage"
+	"github.com/siropaca/anycast-backend/internal/model"
+	"github.com/siropaca/anycast-backend/internal/pkg/logger"
+	"github.com/siropaca/anycast-backend/internal/pkg/uuid"
+)
+
+// HLS パッケージングのパラメータ
+const (
+	hlsSegmentDurationSec = 6
+	hlsBitrateKbps        = 64
+)
+
+// HLS のコンテンツタイプ
+const (
+	hlsPlaylistContentType = "application/vnd.apple.mpegurl"
+	hlsSegmentContentType  = "video/mp2t"
+)
+
+// hlsPlaylistCacheMaxAge はプレイリストのキャッシュ期間
+//
+// プレイリスト内のセグメント URL は署名付きのため、有効期限より十分短くする
+const hlsPlaylistCacheMaxAge = 5 * time.Minute
+
+// createHLSPackage は最終音声のファイルから HLS パッケージを生成してストレージにアップロードする
+//
+// アップロード途中で失敗した場合は、アップロード済みのファイルをベストエフォートで削除する
+func (s *audioJobService) createHLSPackage(ctx context.Context, episodeID, sourceAudioID uuid.UUID, sourcePath string, durationMs int) (*model.EpisodeHLSPackage, error) {
+	var output *HLSOutput
+	err := withFile(sourcePath, func(f *os.File) error {
+		var err error
+		output, err = s.ffmpegService.PackageHLS(ctx, f, hlsSegmentDurationSec, hlsBitrateKbps)
+		return err
+	})
+	if err != nil {
+		return nil, err
+	}
+	defer func() {
+		if err := output.Close(); err != nil {
+			logger.FromContext(ctx).Warn("failed to remove hls output files", "error", err)
+		}
+	}()
+
+	pkgID := uuid.New()
+	pkg := &model.EpisodeHLSPackage{
+		ID:                 pkgID,
+		EpisodeID:          episodeID,
+		SourceAudioID:      sourceAudioID,
+		PathPrefix:         storage.GenerateHLSPathPrefix(pkgID.String()),
+		SegmentCount:       len(output.Segments),
+		SegmentDurationSec: hlsSegmentDurationSec,
+		BitrateKbps:        hlsBitrateKbps,
+		DurationMs:         durationMs,
+	}
+
+	var uploaded []string
+	for i, segment := range output.Segments {
+		// 削除時にパスを再現できるよう、セグメント名は連番であることを確認する
+		if segment.Name != model.HLSSegmentName(i) {
+			s.deleteStorageFiles(ctx, uploaded)
+			return nil, apperror.ErrInternal.WithMessage("HLS セグメントの構成が不正です: " + segment.Name)
+		}
+
+		path := pkg.SegmentPath(segment.Name)
+		if _, err := uploadFile(ctx, s.storageClient, segment.Path, path, hlsSegmentContentType); err != nil {
+			s.deleteStorageFiles(ctx, uploaded)
+			return nil, apperror.ErrInternal.WithMessage("HLS セグメントのアップロードに失敗しました").WithError(err)
+		}
+		uploaded = append(uploaded, path)
+	}
+
+	// プレイリストはセグメントをすべてアップロードしてから書き込む
+	if _, err := s.storageClient.Upload(ctx, output.Playlist, pkg.PlaylistPath(), hlsPlaylistContentType); err != nil {
+		s.deleteStorageFiles(ctx, uploaded)
+		return nil, apperror.ErrInternal.WithMessage("HLS プレイリストのアップロードに失敗しました").WithError(err)
+	}
+
+	return pkg, nil
+}
+
+// updateHLSPackage はジョブの設定に応じてエピソードの HLS パッケージを生成・置き換える
+//
+// HLS 出力が無効なジョブの場合、既存のパッケージは新しい音声と内容が異なるため削除する
+// HLS はあくまで補助的な配信形式なので、生成に失敗した場合もログを出してジョブは続行する
+func (s *audioJobService) updateHLSPackage(ctx context.Context, job *model.AudioJob, episode *model.Episode, sourceAudioID uuid.UUID, sourcePath string, durationMs int) {
+	log := logger.FromContext(ctx)
+
+	oldPkg := episode.HLSPackage
+
+	if job.HLSEnabled {
+		pkg, err := s.createHLSPackage(ctx, episode.ID, sourceAudioID, sourcePath, durationMs)
+		if err != nil {
+			log.Warn("failed to create hls package, skipping", "error", err, "episode_id", episode.ID)
+		} else if err := s.hlsRepo.Replace(ctx, pkg); err != nil {
+			log.Warn("failed to save hls package, skipping", "error", err, "episode_id", episode.ID)
+			s.deleteStorageFiles(ctx, pkg.FilePaths())
+		} else {
+			// 置き換え前のパッケージのファイルを削除
+			if oldPkg != nil {
+				s.deleteStorageFiles(ctx, oldPkg.FilePaths())
+			}
+			return
+		}
+	}
+
+	if oldPkg == nil {
+		return
+	}
+
+	if err := s.hlsRepo.DeleteByEpisodeID(ctx, episode.ID); err != nil {
+		log.Warn("failed to delete stale hls package", "error", err, "episode_id", episode.ID)
+		return
+	}
+	s.deleteStorageFiles(ctx, oldPkg.FilePaths())
+}
+
+// deleteStorageFiles はストレージからファイルを削除する（失敗してもログを出すだけで続行）
+func (s *audioJobService) deleteStorageFiles(ctx context.Context, paths []string) {
+	for _, path := range paths {
+		if err := s.storageClient.Delete(ctx, path); err != nil {
+			logger.FromContext(ctx).Warn("failed to delete file from storage", "path", path, "error", err)
+		}
+	}
+}
@@ -0,0 +1,258 @@
+package service
+
+import (
+	"context"
+	"fmt"
+	"io"
+	"os"
+	"path"
+	"strings"
+	"time"
+
+	"github.com/siropaca/anycast-backend/internal/apperror"
+	"github.com/siropaca/anycast-backend/internal/infrastructure/storage"
+	"github.com/siropaca/anycast-backend/internal/model"
+	"github.com/siropaca/anycast-backend/internal/pkg/audio"
+	"github.com/siropaca/anycast-backend/internal/pkg/logger"
+	"github.com/siropaca/anycast-backend/internal/pkg/script"
+	"github.com/siropaca/anycast-backend/internal/pkg/uuid"
+	"github.com/siropaca/anycast-backend/internal/repository"
+)
+
+// mp3MimeType は ID3 タグの埋め込み対象となる音声の MIME タイプ
+const mp3MimeType = "audio/mpeg"
+
+// episodeID3Tagger はエピソード情報から ID3v2 タグを組み立て、MP3 に埋め込む
+//
+// タグはダウンロードした音声をプレイヤーで表示するための付加情報のため、
+// 組み立て・埋め込みに失敗しても呼び出し元の処理は失敗させない
+type episodeID3Tagger struct {
+	episodeRepo    repository.EpisodeRepository
+	channelRepo    repository.ChannelRepository
+	scriptLineRepo repository.ScriptLineRepository
+	audioRepo      repository.AudioRepository
+	storageClient  storage.Client
+	embedLyrics    bool
+}
+
+// buildTag はエピソードの ID3v2 タグを組み立てる
+//
+// アーティスト・アルバムはチャンネル名、トラック番号はチャンネル内で何話目か、
+// 画像はエピソードのアートワーク（未設定の場合はチャンネルのアートワーク）を使用する
+func (t *episodeID3Tagger) buildTag(ctx context.Context, episode *model.Episode) (*audio.ID3Tag, error) {
+	channel, err := t.channelRepo.FindByID(ctx, episode.ChannelID)
+	if err != nil {
+		return nil, err
+	}
+
+	countBefore, err := t.episodeRepo.CountByChannelIDBeforeCreatedAt(ctx, episode.ChannelID, episode.CreatedAt)
+	if err != nil {
+		return nil, err
+	}
+
+	tag := &audio.ID3Tag{
+		Title:       episode.Title,
+		Artist:      channel.Name,
+		Album:       channel.Name,
+		TrackNumber: int(countBefore) + 1,
+		Comment:     episode.Description,
+	}
+
+	artwork := episode.Artwork
+	if artwork == nil {
+		artwork = channel.Artwork
+	}
+	tag.Picture = t.loadPicture(ctx, artwork)
+
+	if t.embedLyrics {
+		scriptLines, err := t.scriptLineRepo.FindByEpisodeID(ctx, episode.ID)
+		if err != nil {
+			return nil, err
+		}
+		tag.Lyrics = formatID3Lyrics(scriptLines)
+	}
+
+	return tag, nil
+}
+
+// loadPicture はアートワーク画像をダウンロードする
+//
+// 外部 URL の画像やダウンロードに失敗した場合は画像なしとする
+func (t *episodeID3Tagger) loadPicture(ctx context.Context, image *model.Image) *audio.ID3Picture {
+	if image == nil || storage.IsExternalURL(image.Path) {
+		return nil
+	}
+
+	data, err := downloadFromStorageClient(ctx, t.storageClient, image.Path)
+	if err != nil {
+		logger.FromContext(ctx).Warn("failed to download artwork for ID3 tag, skipping", "error", err, "path", image.Path)
+		return nil
+	}
+
+	return &audio.ID3Picture{
+		MimeType: image.MimeType,
+		Data:     data,
+	}
+}
+
+// apply は作業ディレクトリの MP3 ファイルにエピソードの ID3v2 タグを埋め込んだファイルを作成し、そのパスを返す
+//
+// ジョブの実行中にタイトルなどが更新されても反映されるよう、タグは最新のエピソード情報から組み立てる。
+// タグの組み立て・書き込みに失敗した場合はログを出して元のファイルのパスと nil を返す
+func (t *episodeID3Tagger) apply(ctx context.Context, ws *audioWorkspace, episode *model.Episode, srcPath string) (string, *audio.ID3Tag) {
+	log := logger.FromContext(ctx)
+
+	latest, err := t.episodeRepo.FindByID(ctx, episode.ID)
+	if err != nil {
+		log.Warn("failed to load episode for ID3 tag, skipping", "error", err, "episode_id", episode.ID)
+		return srcPath, nil
+	}
+
+	tag, err := t.buildTag(ctx, latest)
+	if err != nil {
+		log.Warn("failed to build ID3 tag, skipping", "error", err, "episode_id", episode.ID)
+		return srcPath, nil
+	}
+
+	tagged, err := writeID3File(ws, srcPath, "tagged.mp3", *tag)
+	if err != nil {
+		log.Warn("failed to write ID3 tag, skipping", "error", err, "episode_id", episode.ID)
+		return srcPath, nil
+	}
+
+	return tagged, tag
+}
+
+// refresh はエピソードの既存の MP3（fullAudio と MP3 の配信用音声）の ID3v2 タグを書き換える
+//
+// タグを書き換えたファイルは新しいパスにアップロードし、音声のパスとファイルサイズを更新してから古いファイルを削除する。
+// 同じパスに上書きすると、ETag が変わらないまま内容が変わり、キャッシュや Range リクエストで古い内容と混ざるため
+func (t *episodeID3Tagger) refresh(ctx context.Context, episode *model.Episode) error {
+	log := logger.FromContext(ctx)
+
+	targets := make([]*model.Audio, 0, 1+len(episode.AudioRenditions))
+	if episode.FullAudio != nil && episode.FullAudio.MimeType == mp3MimeType {
+		targets = append(targets, episode.FullAudio)
+	}
+	for i := range episode.AudioRenditions {
+		if episode.AudioRenditions[i].Format == model.AudioFormatMP3 {
+			targets = append(targets, &episode.AudioRenditions[i].Audio)
+		}
+	}
+
+	if len(targets) == 0 {
+		return nil
+	}
+
+	tag, err := t.buildTag(ctx, episode)
+	if err != nil {
+		return err
+	}
+
+	ws, err := newAudioWorkspace(episode.ID)
+	if err != nil {
+		return fmt.Errorf("failed to create workspace: %w", err)
+	}
+	defer ws.Close()
+
+	for _, a := range targets {
+		name := a.ID.String() + ".mp3"
+		srcPath, err := ws.download(ctx, t.storageClient, a.Path, name)
+		if err != nil {
+			return fmt.Errorf("failed to download audio %s: %w", a.ID, err)
+		}
+
+		tagged, err := writeID3File(ws, srcPath, "tagged_"+name, *tag)
+		if err != nil {
+			return fmt.Errorf("failed to write ID3 tag for audio %s: %w", a.ID, err)
+		}
+
+		newPath := storage.GenerateAudioRevisionPath(a.ID.String(), uuid.New().String(), path.Ext(a.Path))
+		fileSize, err := uploadFile(ctx, t.storageClient, tagged, newPath, a.MimeType)
+		if err != nil {
+			return fmt.Errorf("failed to upload audio %s: %w", a.ID, err)
+		}
+
+		oldPath, oldFileSize := a.Path, a.FileSize
+		a.Path = newPath
+		a.FileSize = fileSize
+		if err := t.audioRepo.Update(ctx, a); err != nil {
+			// DB の更新に失敗した場合はアップロードした新しいファイルを削除する
+			a.Path, a.FileSize = oldPath, oldFileSize
+			if deleteErr := t.storageClient.Delete(ctx, newPath); deleteErr != nil {
+				log.Warn("failed to cleanup retagged audio", "error", deleteErr, "path", newPath)
+			}
+			return err
+		}
+
+		// 古いファイルは参照がなくなってから削除する（失敗してもタグの書き換え自体は完了している）
+		if err := t.storageClient.Delete(ctx, oldPath); err != nil {
+			log.Warn("failed to delete previous audio file", "error", err, "audio_id", a.ID, "path", oldPath)
+		}
+	}
+
+	return nil
+}
+
+// executeRetagInternal はエピソードの既存の MP3 の ID3 タグを最新のエピソード情報で書き換える
+func (s *audioJobService) executeRetagInternal(ctx context.Context, job *model.AudioJob) error {
+	log := logger.FromContext(ctx)
+
+	episode, err := s.episodeRepo.FindByID(ctx, job.EpisodeID)
+	if err != nil {
+		return err
+	}
+
+	if episode.FullAudio == nil {
+		return apperror.ErrValidation.WithMessage("音声がありません")
+	}
+
+	// 進捗: 10%
+	s.updateProgress(ctx, job, 10, "ID3 タグを書き換え中...")
+
+	if err := s.id3Tagger.refresh(ctx, episode); err != nil {
+		log.Error("failed to refresh ID3 tags", "error", err, "episode_id", episode.ID)
+		return apperror.ErrInternal.WithMessage("ID3 タグの書き換えに失敗しました").WithError(err)
+	}
+
+	// ジョブを完了状態に更新
+	completedAt := time.Now().UTC()
+	job.Status = model.AudioJobStatusCompleted
+	job.Progress = 100
+	job.CompletedAt = &completedAt
+	job.ResultAudioID = &episode.FullAudio.ID
+
+	if err := s.audioJobRepo.Update(ctx, job); err != nil {
+		return err
+	}
+
+	// WebSocket で完了通知
+	s.notifyCompleted(job.ID.String(), job.UserID.String(), episode.FullAudio)
+
+	log.Info("retag job completed successfully", "job_id", job.ID, "episode_id", episode.ID)
+
+	return nil
+}
+
+// writeID3File は MP3 ファイルの ID3v2 タグを tag に置き換えたファイルを作業ディレクトリの name に書き出し、そのパスを返す
+//
+// 音声部分はストリームでコピーするため、ファイル全体をメモリに読み込まない
+func writeID3File(ws *audioWorkspace, srcPath, name string, tag audio.ID3Tag) (string, error) {
+	return ws.create(name, func(w io.Writer) error {
+		return withFile(srcPath, func(f *os.File) error {
+			_, err := audio.CopyWithID3v2(w, f, tag)
+			return err
+		})
+	})
+}
+
+// formatID3Lyrics は台本を「話者名: セリフ」形式の歌詞テキストに変換する
+//
+// セリフ内マークアップ（ポーズ・強調・効果音）は除去する
+func formatID3Lyrics(scriptLines []model.ScriptLine) string {
+	lines := make([]string, 0, len(scriptLines))
+	for _, sl := range scriptLines {
+		lines = append(lines, sl.Speaker.Name+": "+script.PlainText(sl.Text))
+	}
+	return strings.Join(lines, "\n")
+}
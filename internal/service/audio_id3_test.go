@@ -0,0 +1,230 @@
+package service
+
+import (
+	"bytes"
+	"context"
+	"os"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+
+	"github.com/siropaca/anycast-backend/internal/model"
+	"github.com/siropaca/anycast-backend/internal/pkg/uuid"
+)
+
+func TestEpisodeID3Tagger_BuildTag(t *testing.T) {
+	ctx := context.Background()
+	channelID := uuid.New()
+	createdAt := time.Date(2025, 1, 1, 0, 0, 0, 0, time.UTC)
+
+	newEpisode := func() *model.Episode {
+		return &model.Episode{
+			ID:          uuid.New(),
+			ChannelID:   channelID,
+			Title:       "第3話",
+			Description: "エピソードの説明",
+			CreatedAt:   createdAt,
+		}
+	}
+
+	t.Run("エピソードとチャンネルの情報からタグを組み立てる", func(t *testing.T) {
+		mockChannelRepo := new(mockChannelRepository)
+		mockEpisodeRepo := new(mockEpisodeRepository)
+		mockChannelRepo.On("FindByID", mock.Anything, channelID).Return(&model.Channel{ID: channelID, Name: "テストチャンネル"}, nil)
+		mockEpisodeRepo.On("CountByChannelIDBeforeCreatedAt", mock.Anything, channelID, createdAt).Return(int64(2), nil)
+
+		tagger := &episodeID3Tagger{channelRepo: mockChannelRepo, episodeRepo: mockEpisodeRepo}
+
+		tag, err := tagger.buildTag(ctx, newEpisode())
+
+		require.NoError(t, err)
+		assert.Equal(t, "第3話", tag.Title)
+		assert.Equal(t, "テストチャンネル", tag.Artist)
+		assert.Equal(t, "テストチャンネル", tag.Album)
+		assert.Equal(t, 3, tag.TrackNumber)
+		assert.Equal(t, "エピソードの説明", tag.Comment)
+		assert.Empty(t, tag.Lyrics)
+		assert.Nil(t, tag.Picture)
+	})
+
+	t.Run("歌詞の埋め込みが有効な場合は台本を歌詞にする", func(t *testing.T) {
+		episode := newEpisode()
+		mockChannelRepo := new(mockChannelRepository)
+		mockEpisodeRepo := new(mockEpisodeRepository)
+		mockScriptLineRepo := new(mockScriptLineRepository)
+		mockChannelRepo.On("FindByID", mock.Anything, channelID).Return(&model.Channel{ID: channelID, Name: "テストチャンネル"}, nil)
+		mockEpisodeRepo.On("CountByChannelIDBeforeCreatedAt", mock.Anything, channelID, createdAt).Return(int64(0), nil)
+		mockScriptLineRepo.On("FindByEpisodeID", mock.Anything, episode.ID).Return([]model.ScriptLine{
+			{Speaker: model.Character{Name: "太郎"}, Text: "こんにちは"},
+			{Speaker: model.Character{Name: "花子"}, Text: "やあ"},
+		}, nil)
+
+		tagger := &episodeID3Tagger{
+			channelRepo:    mockChannelRepo,
+			episodeRepo:    mockEpisodeRepo,
+			scriptLineRepo: mockScriptLineRepo,
+			embedLyrics:    true,
+		}
+
+		tag, err := tagger.buildTag(ctx, episode)
+
+		require.NoError(t, err)
+		assert.Equal(t, "太郎: こんにちは\n花子: やあ", tag.Lyrics)
+	})
+
+	t.Run("エピソードのアートワークがない場合はチャンネルのアートワークを使う", func(t *testing.T) {
+		mockChannelRepo := new(mockChannelRepository)
+		mockEpisodeRepo := new(mockEpisodeRepository)
+		mockStorage := new(mockStorageClient)
+		channel := &model.Channel{
+			ID:      channelID,
+			Name:    "テストチャンネル",
+			Artwork: &model.Image{MimeType: "image/png", Path: "images/channel.png"},
+		}
+		mockChannelRepo.On("FindByID", mock.Anything, channelID).Return(channel, nil)
+		mockEpisodeRepo.On("CountByChannelIDBeforeCreatedAt", mock.Anything, channelID, createdAt).Return(int64(0), nil)
+		mockStorage.On("DownloadStream", mock.Anything, "images/channel.png").Return([]byte("png"), nil)
+
+		tagger := &episodeID3Tagger{channelRepo: mockChannelRepo, episodeRepo: mockEpisodeRepo, storageClient: mockStorage}
+
+		tag, err := tagger.buildTag(ctx, newEpisode())
+
+		require.NoError(t, err)
+		require.NotNil(t, tag.Picture)
+		assert.Equal(t, "image/png", tag.Picture.MimeType)
+		assert.Equal(t, []byte("png"), tag.Picture.Data)
+	})
+
+	t.Run("外部 URL のアートワークは埋め込まない", func(t *testing.T) {
+		episode := newEpisode()
+		episode.Artwork = &model.Image{MimeType: "image/png", Path: "https://example.com/artwork.png"}
+		mockChannelRepo := new(mockChannelRepository)
+		mockEpisodeRepo := new(mockEpisodeRepository)
+		mockChannelRepo.On("FindByID", mock.Anything, channelID).Return(&model.Channel{ID: channelID, Name: "テストチャンネル"}, nil)
+		mockEpisodeRepo.On("CountByChannelIDBeforeCreatedAt", mock.Anything, channelID, createdAt).Return(int64(0), nil)
+
+		tagger := &episodeID3Tagger{channelRepo: mockChannelRepo, episodeRepo: mockEpisodeRepo}
+
+		tag, err := tagger.buildTag(ctx, episode)
+
+		require.NoError(t, err)
+		assert.Nil(t, tag.Picture)
+	})
+}
+
+func TestEpisodeID3Tagger_Apply(t *testing.T) {
+	t.Run("タグの組み立てに失敗した場合は元のファイルを返す", func(t *testing.T) {
+		episode := &model.Episode{ID: uuid.New(), ChannelID: uuid.New()}
+		mockChannelRepo := new(mockChannelRepository)
+		mockEpisodeRepo := new(mockEpisodeRepository)
+		mockEpisodeRepo.On("FindByID", mock.Anything, episode.ID).Return(episode, nil)
+		mockChannelRepo.On("FindByID", mock.Anything, episode.ChannelID).Return(nil, assert.AnError)
+
+		tagger := &episodeID3Tagger{channelRepo: mockChannelRepo, episodeRepo: mockEpisodeRepo}
+
+		ws, err := newAudioWorkspace(episode.ID)
+		require.NoError(t, err)
+		defer ws.Close()
+		srcPath := ws.path("final.mp3")
+		require.NoError(t, os.WriteFile(srcPath, []byte("mp3"), 0o644))
+
+		path, tag := tagger.apply(context.Background(), ws, episode, srcPath)
+
+		assert.Equal(t, srcPath, path)
+		assert.Nil(t, tag)
+	})
+	t.Run("タグを埋め込んだファイルを作業ディレクトリに作成する", func(t *testing.T) {
+		episode := &model.Episode{ID: uuid.New(), ChannelID: uuid.New(), Title: "第1話"}
+		mockChannelRepo := new(mockChannelRepository)
+		mockEpisodeRepo := new(mockEpisodeRepository)
+		mockEpisodeRepo.On("FindByID", mock.Anything, episode.ID).Return(episode, nil)
+		mockChannelRepo.On("FindByID", mock.Anything, episode.ChannelID).Return(&model.Channel{ID: episode.ChannelID, Name: "テストチャンネル"}, nil)
+		mockEpisodeRepo.On("CountByChannelIDBeforeCreatedAt", mock.Anything, episode.ChannelID, episode.CreatedAt).Return(int64(0), nil)
+
+		tagger := &episodeID3Tagger{channelRepo: mockChannelRepo, episodeRepo: mockEpisodeRepo}
+
+		ws, err := newAudioWorkspace(episode.ID)
+		require.NoError(t, err)
+		defer ws.Close()
+		srcPath := ws.path("final.mp3")
+		require.NoError(t, os.WriteFile(srcPath, []byte("mp3"), 0o644))
+
+		path, tag := tagger.apply(context.Background(), ws, episode, srcPath)
+
+		require.NotNil(t, tag)
+		assert.NotEqual(t, srcPath, path)
+		data, err := os.ReadFile(path)
+		require.NoError(t, err)
+		assert.True(t, bytes.HasPrefix(data, []byte("ID3")))
+		assert.True(t, bytes.HasSuffix(data, []byte("mp3")))
+	})
+}
+
+func TestEpisodeID3Tagger_Refresh(t *testing.T) {
+	newEpisode := func() *model.Episode {
+		episode := &model.Episode{ID: uuid.New(), ChannelID: uuid.New(), Title: "第1話"}
+		audioID := uuid.New()
+		episode.FullAudio = &model.Audio{ID: audioID, MimeType: mp3MimeType, Path: "audios/" + audioID.String() + ".mp3", FileSize: 3}
+		return episode
+	}
+	newTagger := func(episode *model.Episode, storageClient *mockStorageClient, audioRepo *mockAudioRepository) *episodeID3Tagger {
+		mockChannelRepo := new(mockChannelRepository)
+		mockEpisodeRepo := new(mockEpisodeRepository)
+		mockChannelRepo.On("FindByID", mock.Anything, episode.ChannelID).Return(&model.Channel{ID: episode.ChannelID, Name: "テストチャンネル"}, nil)
+		mockEpisodeRepo.On("CountByChannelIDBeforeCreatedAt", mock.Anything, episode.ChannelID, episode.CreatedAt).Return(int64(0), nil)
+		return &episodeID3Tagger{
+			channelRepo:   mockChannelRepo,
+			episodeRepo:   mockEpisodeRepo,
+			audioRepo:     audioRepo,
+			storageClient: storageClient,
+		}
+	}
+	isRevisionPath := func(a *model.Audio) func(string) bool {
+		return func(p string) bool {
+			return strings.HasPrefix(p, "audios/"+a.ID.String()+"-") && strings.HasSuffix(p, ".mp3")
+		}
+	}
+
+	t.Run("タグを書き換えたファイルを新しいパスに保存してから古いファイルを削除する", func(t *testing.T) {
+		episode := newEpisode()
+		oldPath := episode.FullAudio.Path
+		storageClient := new(mockStorageClient)
+		audioRepo := new(mockAudioRepository)
+		storageClient.On("DownloadStream", mock.Anything, oldPath).Return([]byte("mp3"), nil)
+		storageClient.On("UploadStream", mock.Anything, mock.Anything, mock.MatchedBy(isRevisionPath(episode.FullAudio)), mp3MimeType).Return("", nil)
+		audioRepo.On("Update", mock.Anything, episode.FullAudio).Return(nil)
+		storageClient.On("Delete", mock.Anything, oldPath).Return(nil)
+
+		err := newTagger(episode, storageClient, audioRepo).refresh(context.Background(), episode)
+
+		require.NoError(t, err)
+		assert.NotEqual(t, oldPath, episode.FullAudio.Path)
+		assert.True(t, isRevisionPath(episode.FullAudio)(episode.FullAudio.Path))
+		assert.Greater(t, episode.FullAudio.FileSize, 3)
+		storageClient.AssertExpectations(t)
+		audioRepo.AssertExpectations(t)
+	})
+
+	t.Run("DB の更新に失敗した場合は新しいファイルを削除して古いファイルを残す", func(t *testing.T) {
+		episode := newEpisode()
+		oldPath := episode.FullAudio.Path
+		storageClient := new(mockStorageClient)
+		audioRepo := new(mockAudioRepository)
+		storageClient.On("DownloadStream", mock.Anything, oldPath).Return([]byte("mp3"), nil)
+		storageClient.On("UploadStream", mock.Anything, mock.Anything, mock.MatchedBy(isRevisionPath(episode.FullAudio)), mp3MimeType).Return("", nil)
+		audioRepo.On("Update", mock.Anything, episode.FullAudio).Return(assert.AnError)
+		storageClient.On("Delete", mock.Anything, mock.MatchedBy(isRevisionPath(episode.FullAudio))).Return(nil)
+
+		err := newTagger(episode, storageClient, audioRepo).refresh(context.Background(), episode)
+
+		assert.ErrorIs(t, err, assert.AnError)
+		assert.Equal(t, oldPath, episode.FullAudio.Path)
+		assert.Equal(t, 3, episode.FullAudio.FileSize)
+		storageClient.AssertNotCalled(t, "Delete", mock.Anything, oldPath)
+		storageClient.AssertExpectations(t)
+	})
+}
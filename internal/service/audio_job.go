@@ -1,11 +1,14 @@
 package service
 
 import (
+	"bytes"
 	"context"
 	"errors"
 	"fmt"
+	"io"
 	"os"
 	"path/filepath"
+	"slices"
 	"sort"
 	"strings"
 	"sync"
@@ -25,6 +28,7 @@ import (
 	"github.com/siropaca/anycast-backend/internal/model"
 	"github.com/siropaca/anycast-backend/internal/pkg/audio"
 	"github.com/siropaca/anycast-backend/internal/pkg/logger"
+	"github.com/siropaca/anycast-backend/internal/pkg/script"
 	"github.com/siropaca/anycast-backend/internal/pkg/uuid"
 	"github.com/siropaca/anycast-backend/internal/repository"
 )
@@ -37,6 +41,21 @@ const (
 	defaultPaddingEndMs   = 3000
 )
 
+// BGM ダッキングのデフォルトパラメータ
+const (
+	defaultDuckingThresholdDB = -30.0
+	defaultDuckingRatio       = 8.0
+	defaultDuckingAttackMs    = 20
+	defaultDuckingReleaseMs   = 300
+	defaultIntroSwellMs       = 0
+)
+
+// 発音 QA のデフォルトパラメータ
+const (
+	defaultQASimilarityThreshold = 0.6
+	defaultQAMaxRetries          = 2
+)
+
 // Gemini TTS 再アセンブル用 PCM パラメータ
 const (
 	reassemblySampleRate     = 24000
@@ -49,30 +68,53 @@ const (
 // ダミー末尾行。各話者の最後に追加し、分割後に破棄する。
 const reassemblyDummyTrailingText = "以上です。"
 
+// retagBlockingAudioJobTypes は待機させた ID3 タグの書き換えジョブのエンキューを待つ、処理中のジョブの種別
+var retagBlockingAudioJobTypes = []model.AudioJobType{
+	model.AudioJobTypeVoice,
+	model.AudioJobTypeFull,
+	model.AudioJobTypeRemix,
+	model.AudioJobTypeRenditions,
+}
+
+// reassemblyAlignmentConcurrency は STT アライメントを同時に実行する話者数の上限。
+// アライメント中は話者の PCM 全体をメモリに読み込むため、話者数が多い長いエピソードでも
+// ピークのヒープ使用量がこの数の話者分に収まるよう制限する。
+const reassemblyAlignmentConcurrency = 2
+
 // AudioJobService は非同期音声生成ジョブを管理するインターフェースを表す
 type AudioJobService interface {
 	CreateJob(ctx context.Context, userID, channelID, episodeID string, req request.GenerateAudioAsyncRequest) (*response.AudioJobResponse, error)
 	GetJob(ctx context.Context, userID, jobID string) (*response.AudioJobResponse, error)
 	ListMyJobs(ctx context.Context, userID string, filter repository.AudioJobFilter) (*response.AudioJobListResponse, error)
+	CreateRenditionJob(ctx context.Context, userID, episodeID uuid.UUID) error
+	CreateRetagJob(ctx context.Context, userID, episodeID uuid.UUID) error
 	ExecuteJob(ctx context.Context, jobID string) error
 	CancelJob(ctx context.Context, userID, jobID string) error
 }
 
 type audioJobService struct {
-	audioJobRepo   repository.AudioJobRepository
-	episodeRepo    repository.EpisodeRepository
-	channelRepo    repository.ChannelRepository
-	scriptLineRepo repository.ScriptLineRepository
-	audioRepo      repository.AudioRepository
-	bgmRepo        repository.BgmRepository
-	systemBgmRepo  repository.SystemBgmRepository
-	storageClient  storage.Client
-	ttsRegistry    *tts.Registry
-	sttClient      stt.Client
-	ffmpegService  FFmpegService
-	tasksClient    cloudtasks.Client
-	wsHub          *websocket.Hub
-	slackClient    slack.Client
+	audioJobRepo          repository.AudioJobRepository
+	episodeRepo           repository.EpisodeRepository
+	channelRepo           repository.ChannelRepository
+	scriptLineRepo        repository.ScriptLineRepository
+	audioRepo             repository.AudioRepository
+	bgmRepo               repository.BgmRepository
+	systemBgmRepo         repository.SystemBgmRepository
+	storageClient         storage.Client
+	ttsRegistry           *tts.Registry
+	sttClient             stt.Client
+	ffmpegService         FFmpegService
+	tasksClient           cloudtasks.Client
+	wsHub                 *websocket.Hub
+	slackClient           slack.Client
+	renditionRepo         repository.EpisodeAudioRenditionRepository
+	renditionSpecs        []RenditionSpec
+	hlsRepo               repository.EpisodeHLSPackageRepository
+	id3Tagger             *episodeID3Tagger
+	pronunciationRepo     repository.PronunciationRepository
+	sfxCueRepo            repository.SfxCueRepository
+	soundEffectRepo       repository.SoundEffectRepository
+	systemSoundEffectRepo repository.SystemSoundEffectRepository
 }
 
 // NewAudioJobService は audioJobService を生成して AudioJobService として返す
@@ -91,6 +133,14 @@ func NewAudioJobService(
 	tasksClient cloudtasks.Client,
 	wsHub *websocket.Hub,
 	slackClient slack.Client,
+	renditionRepo repository.EpisodeAudioRenditionRepository,
+	renditionSpecs []RenditionSpec,
+	hlsRepo repository.EpisodeHLSPackageRepository,
+	embedID3Lyrics bool,
+	pronunciationRepo repository.PronunciationRepository,
+	sfxCueRepo repository.SfxCueRepository,
+	soundEffectRepo repository.SoundEffectRepository,
+	systemSoundEffectRepo repository.SystemSoundEffectRepository,
 ) AudioJobService {
 	return &audioJobService{
 		audioJobRepo:   audioJobRepo,
@@ -107,13 +157,26 @@ func NewAudioJobService(
 		tasksClient:    tasksClient,
 		wsHub:          wsHub,
 		slackClient:    slackClient,
+		renditionRepo:  renditionRepo,
+		renditionSpecs: renditionSpecs,
+		hlsRepo:        hlsRepo,
+		id3Tagger: &episodeID3Tagger{
+			episodeRepo:    episodeRepo,
+			channelRepo:    channelRepo,
+			scriptLineRepo: scriptLineRepo,
+			audioRepo:      audioRepo,
+			storageClient:  storageClient,
+			embedLyrics:    embedID3Lyrics,
+		},
+		pronunciationRepo:     pronunciationRepo,
+		sfxCueRepo:            sfxCueRepo,
+		soundEffectRepo:       soundEffectRepo,
+		systemSoundEffectRepo: systemSoundEffectRepo,
 	}
 }
 
 // CreateJob は非同期音声生成ジョブを作成して返す
 func (s *audioJobService) CreateJob(ctx context.Context, userID, channelID, episodeID string, req request.GenerateAudioAsyncRequest) (*response.AudioJobResponse, error) {
-	log := logger.FromContext(ctx)
-
 	uid, err := uuid.Parse(userID)
 	if err != nil {
 		return nil, err
@@ -149,8 +212,8 @@ func (s *audioJobService) CreateJob(ctx context.Context, userID, channelID, epis
 		return nil, apperror.ErrNotFound.WithMessage("このチャンネルにエピソードが見つかりません")
 	}
 
-	// 既存の処理中ジョブを確認
-	pendingJob, err := s.audioJobRepo.FindPendingByEpisodeID(ctx, eid)
+	// 既存の処理中ジョブを確認（配信用音声の生成などの内部ジョブは対象外）
+	pendingJob, err := s.audioJobRepo.FindPendingByEpisodeID(ctx, eid, model.UserAudioJobTypes)
 	if err != nil {
 		return nil, err
 	}
@@ -246,6 +309,45 @@ func (s *audioJobService) CreateJob(ctx context.Context, userID, channelID, epis
 		paddingEndMs = *req.PaddingEndMs
 	}
 
+	bgmDucking := req.BgmDucking != nil && *req.BgmDucking
+
+	duckingThresholdDB := defaultDuckingThresholdDB
+	if req.DuckingThresholdDB != nil {
+		duckingThresholdDB = *req.DuckingThresholdDB
+	}
+
+	duckingRatio := defaultDuckingRatio
+	if req.DuckingRatio != nil {
+		duckingRatio = *req.DuckingRatio
+	}
+
+	duckingAttackMs := defaultDuckingAttackMs
+	if req.DuckingAttackMs != nil {
+		duckingAttackMs = *req.DuckingAttackMs
+	}
+
+	duckingReleaseMs := defaultDuckingReleaseMs
+	if req.DuckingReleaseMs != nil {
+		duckingReleaseMs = *req.DuckingReleaseMs
+	}
+
+	introSwellMs := defaultIntroSwellMs
+	if req.IntroSwellMs != nil {
+		introSwellMs = *req.IntroSwellMs
+	}
+
+	qaEnabled := req.QAEnabled != nil && *req.QAEnabled
+
+	qaSimilarityThreshold := defaultQASimilarityThreshold
+	if req.QASimilarityThreshold != nil {
+		qaSimilarityThreshold = *req.QASimilarityThreshold
+	}
+
+	qaMaxRetries := defaultQAMaxRetries
+	if req.QAMaxRetries != nil {
+		qaMaxRetries = *req.QAMaxRetries
+	}
+
 	// ジョブを作成
 	job := &model.AudioJob{
 		EpisodeID:      eid,
@@ -259,12 +361,134 @@ func (s *audioJobService) CreateJob(ctx context.Context, userID, channelID, epis
 		FadeOutMs:      fadeOutMs,
 		PaddingStartMs: paddingStartMs,
 		PaddingEndMs:   paddingEndMs,
+
+		BgmDucking:         bgmDucking,
+		DuckingThresholdDB: duckingThresholdDB,
+		DuckingRatio:       duckingRatio,
+		DuckingAttackMs:    duckingAttackMs,
+		DuckingReleaseMs:   duckingReleaseMs,
+		IntroSwellMs:       introSwellMs,
+
+		HLSEnabled: req.HLS != nil && *req.HLS,
+
+		QAEnabled:             qaEnabled,
+		QASimilarityThreshold: qaSimilarityThreshold,
+		QAMaxRetries:          qaMaxRetries,
 	}
 
-	if err := s.audioJobRepo.Create(ctx, job); err != nil {
+	if err := s.enqueueJob(ctx, job); err != nil {
 		return nil, err
 	}
 
+	return s.toAudioJobResponse(ctx, job)
+}
+
+// CreateRenditionJob はエピソードの結合済み音声から配信用音声を生成し直すジョブを作成する
+//
+// 音声をアップロードして配信用音声を削除した場合に使用する。配信用音声の生成が無効な場合は何もしない
+func (s *audioJobService) CreateRenditionJob(ctx context.Context, userID, episodeID uuid.UUID) error {
+	if len(s.renditionSpecs) == 0 {
+		return nil
+	}
+
+	job := &model.AudioJob{
+		EpisodeID: episodeID,
+		UserID:    userID,
+		Status:    model.AudioJobStatusPending,
+		JobType:   model.AudioJobTypeRenditions,
+	}
+
+	return s.enqueueJob(ctx, job)
+}
+
+// CreateRetagJob はエピソードの既存の MP3 の ID3 タグを書き換えるジョブを作成する
+//
+// エピソードのタイトルなどを更新した場合に使用する。処理中のジョブは更新前のエピソード情報で
+// タグを埋め込んでいる可能性があるため、その場合はジョブを作成したままエンキューせず、
+// 処理中のジョブの終了時にエンキューする（dispatchDeferredRetagJob）。
+// 未着手の書き換えジョブがある場合は、そのジョブが実行時に最新のエピソード情報を読み込むため作成しない
+func (s *audioJobService) CreateRetagJob(ctx context.Context, userID, episodeID uuid.UUID) error {
+	log := logger.FromContext(ctx)
+
+	queuedJob, err := s.audioJobRepo.FindUnstartedByEpisodeID(ctx, episodeID, model.AudioJobTypeRetag)
+	if err != nil {
+		return err
+	}
+	if queuedJob != nil {
+		log.Info("skipping retag job as another retag job is queued", "episode_id", episodeID, "job_id", queuedJob.ID)
+		return nil
+	}
+
+	pendingJob, err := s.audioJobRepo.FindPendingByEpisodeID(ctx, episodeID, nil)
+	if err != nil {
+		return err
+	}
+
+	job := &model.AudioJob{
+		EpisodeID: episodeID,
+		UserID:    userID,
+		Status:    model.AudioJobStatusPending,
+		JobType:   model.AudioJobTypeRetag,
+	}
+
+	if pendingJob != nil {
+		if err := s.audioJobRepo.Create(ctx, job); err != nil {
+			return err
+		}
+		log.Info("deferring retag job until the running job finishes", "episode_id", episodeID, "job_id", job.ID, "running_job_id", pendingJob.ID)
+		return nil
+	}
+
+	return s.enqueueJob(ctx, job)
+}
+
+// dispatchDeferredRetagJob はジョブの終了時に、待機させていたエピソードの ID3 タグの書き換えジョブをエンキューする
+//
+// 他のジョブがまだ処理中の場合は、そのジョブの終了時にエンキューする。
+// エンキューに失敗してもジョブ自体の結果には影響させない
+func (s *audioJobService) dispatchDeferredRetagJob(ctx context.Context, episodeID uuid.UUID) {
+	log := logger.FromContext(ctx)
+
+	retagJob, err := s.audioJobRepo.FindUnstartedByEpisodeID(ctx, episodeID, model.AudioJobTypeRetag)
+	if err != nil {
+		log.Warn("failed to find deferred retag job", "error", err, "episode_id", episodeID)
+		return
+	}
+	if retagJob == nil {
+		return
+	}
+
+	runningJob, err := s.audioJobRepo.FindPendingByEpisodeID(ctx, episodeID, retagBlockingAudioJobTypes)
+	if err != nil {
+		log.Warn("failed to check running jobs for deferred retag job", "error", err, "episode_id", episodeID)
+		return
+	}
+	if runningJob != nil {
+		return
+	}
+
+	if err := s.dispatchJob(ctx, retagJob); err != nil {
+		log.Warn("failed to dispatch deferred retag job", "error", err, "episode_id", episodeID, "job_id", retagJob.ID)
+	}
+}
+
+// enqueueJob はジョブを作成し、Cloud Tasks にエンキューする
+//
+// Cloud Tasks が設定されていない場合は goroutine で直接実行する
+func (s *audioJobService) enqueueJob(ctx context.Context, job *model.AudioJob) error {
+	if err := s.audioJobRepo.Create(ctx, job); err != nil {
+		return err
+	}
+
+	return s.dispatchJob(ctx, job)
+}
+
+// dispatchJob は作成済みのジョブを Cloud Tasks にエンキューする
+//
+// Cloud Tasks が設定されていない場合は goroutine で直接実行する
+func (s *audioJobService) dispatchJob(ctx context.Context, job *model.AudioJob) error {
+	log := logger.FromContext(ctx)
+
 	// Cloud Tasks が設定されている場合はエンキュー、そうでなければ goroutine で直接実行
 	if s.tasksClient != nil {
 		if err := s.tasksClient.EnqueueAudioJob(ctx, job.ID.String()); err != nil {
@@ -276,12 +500,12 @@ func (s *audioJobService) CreateJob(ctx context.Context, userID, channelID, epis
 			job.ErrorMessage = &errMsg
 			job.ErrorCode = &errCode
 			_ = s.audioJobRepo.Update(ctx, job) //nolint:errcheck // best effort cleanup
-			return nil, apperror.ErrInternal.WithMessage("音声生成タスクの登録に失敗しました").WithError(err)
+			return apperror.ErrInternal.WithMessage("音声生成タスクの登録に失敗しました").WithError(err)
 		}
-		log.Info("audio job created and enqueued", "job_id", job.ID, "episode_id", eid)
+		log.Info("audio job created and enqueued", "job_id", job.ID, "episode_id", job.EpisodeID, "job_type", job.JobType)
 	} else {
 		// ローカル開発モード: goroutine で直接実行
-		log.Info("executing job directly as Cloud Tasks is not configured", "job_id", job.ID, "episode_id", eid)
+		log.Info("executing job directly as Cloud Tasks is not configured", "job_id", job.ID, "episode_id", job.EpisodeID, "job_type", job.JobType)
 		go func() {
 			if err := s.ExecuteJob(context.Background(), job.ID.String()); err != nil {
 				log.Error("failed to execute local job", "error", err, "job_id", job.ID)
@@ -289,7 +513,7 @@ func (s *audioJobService) CreateJob(ctx context.Context, userID, channelID, epis
 		}()
 	}
 
-	return s.toAudioJobResponse(ctx, job)
+	return nil
 }
 
 // GetJob は指定されたジョブの詳細を取得する
@@ -358,6 +582,9 @@ func (s *audioJobService) ExecuteJob(ctx context.Context, jobID string) error {
 		return err
 	}
 
+	// ジョブの終了後に、実行中に待機させた ID3 タグの書き換えジョブをエンキューする
+	defer s.dispatchDeferredRetagJob(ctx, job.EpisodeID)
+
 	// 既に完了、失敗、またはキャンセル済みの場合はスキップ
 	if job.Status == model.AudioJobStatusCompleted ||
 		job.Status == model.AudioJobStatusFailed ||
@@ -395,6 +622,10 @@ func (s *audioJobService) ExecuteJob(ctx context.Context, jobID string) error {
 	switch job.JobType {
 	case model.AudioJobTypeRemix:
 		execErr = s.executeRemixInternal(ctx, job)
+	case model.AudioJobTypeRenditions:
+		execErr = s.executeRenditionsInternal(ctx, job)
+	case model.AudioJobTypeRetag:
+		execErr = s.executeRetagInternal(ctx, job)
 	case model.AudioJobTypeVoice, model.AudioJobTypeFull:
 		execErr = s.executeJobInternal(ctx, job)
 	default:
@@ -438,10 +669,25 @@ func (s *audioJobService) executeJobInternal(ctx context.Context, job *model.Aud
 	// 進捗: 10%
 	s.updateProgress(ctx, job, 10, "台本を読み込み中...")
 
+	// 発音辞書（ユーザー辞書 + チャンネル辞書）を読み込み
+	dict, err := loadPronunciationDictionary(ctx, s.pronunciationRepo, &episode.Channel)
+	if err != nil {
+		return err
+	}
+
+	// 台本行の効果音キューを読み込み
+	sfxCues, err := s.sfxCueRepo.FindByEpisodeID(ctx, job.EpisodeID)
+	if err != nil {
+		return err
+	}
+
 	// TTS 用のデータを構築
+	// セリフ途中の効果音は再アセンブル時に音声として挿入するため、効果音の位置でセリフを分割して複数のターンにする
 	var turns []tts.SpeakerTurn
+	var turnLineIDs []uuid.UUID
+	var sfxNames []string
 	speakerAliasMap := make(map[string]string)
-	voiceConfigMap := make(map[string]string)
+	speakers := make(map[string]model.Character)
 	speakerIndex := 1
 
 	for _, line := range scriptLines {
@@ -453,27 +699,44 @@ func (s *audioJobService) executeJobInternal(ctx context.Context, job *model.Aud
 		if !exists {
 			alias = fmt.Sprintf("speaker%d", speakerIndex)
 			speakerAliasMap[line.Speaker.Name] = alias
-			voiceConfigMap[alias] = line.Speaker.Voice.ProviderVoiceID
+			speakers[alias] = line.Speaker
 			speakerIndex++
 		}
 
-		turns = append(turns, tts.SpeakerTurn{
-			Speaker: alias,
-			Text:    line.Text,
-			Emotion: line.Emotion,
-		})
+		for _, text := range script.SplitAtInlineSFX(line.Text) {
+			turns = append(turns, tts.SpeakerTurn{
+				Speaker:      alias,
+				Text:         script.MapMarkupText(text, dict.Apply),
+				OriginalText: text,
+				Emotion:      line.Emotion,
+			})
+			turnLineIDs = append(turnLineIDs, line.ID)
+		}
+
+		for _, name := range script.SFXNames(line.Text) {
+			if !slices.Contains(sfxNames, name) {
+				sfxNames = append(sfxNames, name)
+			}
+		}
 	}
 
 	if len(turns) == 0 {
 		return apperror.ErrValidation.WithMessage("音声生成に使用できる台本行がありません")
 	}
 
-	voiceConfigs := make([]tts.SpeakerVoiceConfig, 0, len(voiceConfigMap))
-	for alias, voiceID := range voiceConfigMap {
-		voiceConfigs = append(voiceConfigs, tts.SpeakerVoiceConfig{
-			SpeakerAlias: alias,
-			VoiceID:      voiceID,
-		})
+	// セリフ内の効果音を効果音ライブラリの音声に解決する
+	sfxAudios, err := resolveSFXLibrary(ctx, s.soundEffectRepo, s.systemSoundEffectRepo, episode.Channel.UserID, sfxNames)
+	if err != nil {
+		return err
+	}
+
+	// 話者ごとのボイスのプロバイダがすべて利用可能か確認
+	providers := speakerProviders(speakers)
+	for _, provider := range providers {
+		if !s.ttsRegistry.Has(provider) {
+			log.Error("TTS provider not available", "provider", provider)
+			return apperror.ErrValidation.WithMessage(fmt.Sprintf("TTS プロバイダ %q が利用できません", provider))
+		}
 	}
 
 	// 進捗: 20%
@@ -484,21 +747,40 @@ func (s *audioJobService) executeJobInternal(ctx context.Context, job *model.Aud
 		return err
 	}
 
-	// scriptLines の最初の行の Voice.Provider から TTS クライアントを取得
-	provider := tts.Provider(scriptLines[0].Speaker.Voice.Provider)
-	ttsClient, err := s.ttsRegistry.Get(provider)
+	log.Info("generating audio", "total_turns", len(turns), "providers", providers)
+
+	// TTS で音声を生成
+	// 発音 QA は STT を使うため、STT クライアントが設定されている場合のみ実行する
+	runQA := job.QAEnabled && s.sttClient != nil
+
+	// 合成以降の中間データは作業ディレクトリのファイルで受け渡す（ジョブ終了時に削除）
+	ws, err := newAudioWorkspace(job.ID)
 	if err != nil {
-		log.Error("TTS provider not available", "provider", provider)
-		return apperror.ErrValidation.WithMessage(fmt.Sprintf("TTS プロバイダ %q が利用できません", provider)).WithError(err)
+		log.Error("failed to create audio workspace", "error", err)
+		return apperror.ErrInternal.WithMessage("作業ディレクトリの作成に失敗しました").WithError(err)
 	}
+	defer func() {
+		if err := ws.Close(); err != nil {
+			log.Warn("failed to remove audio workspace", "error", err)
+		}
+	}()
 
-	log.Info("generating audio", "total_turns", len(turns), "provider", provider)
-
-	// TTS で音声を生成
 	var result *tts.SynthesisResult
+	// 再アセンブルした場合の PCM ファイルのパス（シングルスピーカー合成の場合は空文字）
+	var reassembledPCMPath string
+	var lineTimings []reassemblyLineTiming
+	var lineQA []reassemblyLineQA
 	switch {
-	case len(voiceConfigs) == 1:
+	case len(speakers) == 1 && len(sfxCues) == 0 && len(sfxAudios) == 0 && !runQA:
 		// シングルスピーカー: 全ターンのテキストを連結して単一話者で合成
+		speaker := speakers[turns[0].Speaker]
+		voice := speaker.Voice
+		var ttsClient tts.Client
+		ttsClient, err = s.ttsRegistry.Get(tts.Provider(voice.Provider))
+		if err != nil {
+			return apperror.ErrValidation.WithMessage(fmt.Sprintf("TTS プロバイダ %q が利用できません", voice.Provider)).WithError(err)
+		}
+
 		var textBuilder strings.Builder
 		for _, turn := range turns {
 			text := turn.Text
@@ -507,29 +789,81 @@ func (s *audioJobService) executeJobInternal(ctx context.Context, job *model.Aud
 			}
 			textBuilder.WriteString(text + "\n")
 		}
-		result, err = ttsClient.Synthesize(ctx, textBuilder.String(), nil, voiceConfigs[0].VoiceID, scriptLines[0].Speaker.Voice.Gender)
+		result, err = ttsClient.Synthesize(ctx, textBuilder.String(), nil, voice.ProviderVoiceID, voice.Gender, toTTSVoiceSettings(speaker.VoiceSettings))
 	default:
-		// 全プロバイダ: 話者別合成 + 再アセンブル
-		result, err = s.synthesizeMultiSpeakerByReassembly(ctx, job, turns, voiceConfigs, ttsClient, scriptLines, provider)
+		// 複数話者: 話者ごとに各自のプロバイダで合成 + 再アセンブル
+		// 効果音キューがある場合は行ごとの再生位置が、セリフ内に効果音がある場合は効果音の挿入が、
+		// 発音 QA を行う場合は行ごとの再合成が必要なため、単一話者でも再アセンブルする
+		reassembledPCMPath, lineTimings, lineQA, err = s.synthesizeMultiSpeakerByReassembly(ctx, job, ws, turns, speakers, sfxAudios, runQA)
 	}
 	if err != nil {
 		log.Error("TTS failed", "error", err)
 		return apperror.ErrGenerationFailed.WithMessage("音声の生成に失敗しました").WithError(err)
 	}
 
+	// 発音 QA の結果をジョブに記録する（完了時に保存）
+	if runQA {
+		job.QAReport = newAudioQAReport(job.QASimilarityThreshold, turns, turnLineIDs, lineQA)
+		log.Info("pronunciation QA finished",
+			"checked_lines", job.QAReport.CheckedLines,
+			"resynthesized_lines", job.QAReport.ResynthesizedLines,
+			"flagged_lines", len(job.QAReport.FlaggedLines),
+		)
+	}
+
+	// 効果音の位置で分割したターンの再生区間を台本行ごとにまとめる
+	lineIDs, lineTimings := mergeSplitLineTimings(turnLineIDs, lineTimings)
+
 	// フォーマットに応じて MP3 に変換
 	s.updateProgress(ctx, job, 45, "音声を変換中...")
-	var voiceAudio []byte
-	if result.Format == "pcm" {
+	var voicePath string
+	switch {
+	case reassembledPCMPath != "":
+		log.Info("audio reassembly succeeded, converting PCM to MP3")
+		voicePath, err = ws.create("voice.mp3", func(w io.Writer) error {
+			return withFile(reassembledPCMPath, func(f *os.File) error {
+				return s.ffmpegService.ConvertToMP3(ctx, f, w, "pcm", reassemblySampleRate)
+			})
+		})
+		if err != nil {
+			log.Error("PCM to MP3 conversion failed", "error", err)
+			return apperror.ErrInternal.WithMessage("音声フォーマットの変換に失敗しました").WithError(err)
+		}
+	case result.Format == "pcm":
 		log.Info("audio synthesis succeeded, converting PCM to MP3", "pcm_size", len(result.Data))
-		voiceAudio, err = s.ffmpegService.ConvertToMP3(ctx, result.Data, "pcm", result.SampleRate)
+		voicePath, err = ws.create("voice.mp3", func(w io.Writer) error {
+			return s.ffmpegService.ConvertToMP3(ctx, bytes.NewReader(result.Data), w, "pcm", result.SampleRate)
+		})
 		if err != nil {
 			log.Error("PCM to MP3 conversion failed", "error", err)
 			return apperror.ErrInternal.WithMessage("音声フォーマットの変換に失敗しました").WithError(err)
 		}
-	} else {
+	default:
 		log.Info("audio synthesis succeeded", "format", result.Format, "size", len(result.Data))
-		voiceAudio = result.Data
+		voicePath, err = ws.create("voice.mp3", func(w io.Writer) error {
+			_, err := w.Write(result.Data)
+			return err
+		})
+		if err != nil {
+			log.Error("failed to write voice audio", "error", err)
+			return apperror.ErrInternal.WithMessage("ボイス音声の書き込みに失敗しました").WithError(err)
+		}
+	}
+
+	// 合成結果は以降使わないため、参照を外して GC で回収できるようにする
+	result = nil
+
+	// 効果音キューを各台本行の前後に重ねる
+	if len(sfxCues) > 0 {
+		s.updateProgress(ctx, job, 48, "効果音をミキシング中...")
+		timingsByLineID := make(map[uuid.UUID]reassemblyLineTiming, len(lineTimings))
+		for i, timing := range lineTimings {
+			timingsByLineID[lineIDs[i]] = timing
+		}
+		voicePath, err = s.mixSFXCues(ctx, ws, voicePath, sfxCues, timingsByLineID)
+		if err != nil {
+			return err
+		}
 	}
 
 	// 進捗: 50%
@@ -540,23 +874,28 @@ func (s *audioJobService) executeJobInternal(ctx context.Context, job *model.Aud
 	voiceAudioID := uuid.New()
 	voiceAudioPath := storage.GenerateAudioPath(voiceAudioID.String())
 
-	if _, err := s.storageClient.Upload(ctx, voiceAudio, voiceAudioPath, "audio/mpeg"); err != nil {
+	voiceFileSize, err := uploadFile(ctx, s.storageClient, voicePath, voiceAudioPath, "audio/mpeg")
+	if err != nil {
 		log.Error("failed to upload voice audio", "error", err)
 		return apperror.ErrInternal.WithMessage("ボイス音声のアップロードに失敗しました").WithError(err)
 	}
 
-	voiceDurationMs, err := audio.GetDurationMsE(voiceAudio)
+	voiceDurationMs, err := audio.GetFileDurationMs(voicePath)
 	if err != nil {
 		log.Warn("failed to get voice audio duration", "error", err)
 	}
 
+	voiceWaveforms := generateFileWaveforms(ctx, s.ffmpegService, voicePath)
+
 	voiceAudioRecord := &model.Audio{
-		ID:         voiceAudioID,
-		MimeType:   "audio/mpeg",
-		Path:       voiceAudioPath,
-		Filename:   voiceAudioID.String() + ".mp3",
-		FileSize:   len(voiceAudio),
-		DurationMs: voiceDurationMs,
+		ID:          voiceAudioID,
+		MimeType:    "audio/mpeg",
+		Path:        voiceAudioPath,
+		Filename:    voiceAudioID.String() + ".mp3",
+		FileSize:    voiceFileSize,
+		DurationMs:  voiceDurationMs,
+		Waveforms:   voiceWaveforms,
+		LineTimings: toAudioLineTimings(lineIDs, lineTimings),
 	}
 
 	if err := s.audioRepo.Create(ctx, voiceAudioRecord); err != nil {
@@ -568,8 +907,24 @@ func (s *audioJobService) executeJobInternal(ctx context.Context, job *model.Aud
 	episode.VoiceAudioID = &voiceAudioID
 	episode.VoiceAudio = nil
 
-	// 最終的な音声データ
-	var finalAudio []byte
+	// チャンネルのイントロ・アウトロをナレーションの前後に結合する
+	// ボイス音声には含めないため、リミックス時に二重に結合されることはない
+	mixInputPath, stitched, err := s.stitchChannelSegments(ctx, job, ws, &episode.Channel, voicePath)
+	if err != nil {
+		return err
+	}
+	mixInputDurationMs := voiceDurationMs
+	if stitched {
+		mixInputDurationMs, err = audio.GetFileDurationMs(mixInputPath)
+		if err != nil {
+			log.Warn("failed to get stitched audio duration", "error", err)
+		}
+	}
+
+	// 最終的な音声ファイル
+	var finalPath string
+	var finalWaveforms model.AudioWaveforms
+	bgmMixed := false
 
 	// キャンセルチェック（BGM ミキシング前）
 	if err := s.checkCanceled(ctx, job); err != nil {
@@ -597,7 +952,7 @@ func (s *audioJobService) executeJobInternal(ctx context.Context, job *model.Aud
 		}
 
 		// GCS から BGM をダウンロード
-		bgmData, err := s.downloadFromStorage(ctx, bgmPath)
+		bgmLocalPath, err := ws.download(ctx, s.storageClient, bgmPath, "bgm.mp3")
 		if err != nil {
 			log.Error("failed to download BGM", "error", err, "path", bgmPath)
 			return apperror.ErrInternal.WithMessage("BGM のダウンロードに失敗しました").WithError(err)
@@ -607,22 +962,21 @@ func (s *audioJobService) executeJobInternal(ctx context.Context, job *model.Aud
 		s.updateProgress(ctx, job, 70, "BGM をミキシング中...")
 
 		// FFmpeg でミキシング
-		finalAudio, err = s.ffmpegService.MixAudioWithBGM(ctx, MixParams{
-			VoiceData:       voiceAudio,
-			BGMData:         bgmData,
-			VoiceDurationMs: voiceDurationMs,
-			BGMVolumeDB:     job.BgmVolumeDB,
-			FadeOutMs:       job.FadeOutMs,
-			PaddingStartMs:  job.PaddingStartMs,
-			PaddingEndMs:    job.PaddingEndMs,
-		})
+		finalPath, err = s.mixBGMFile(ctx, ws, job, mixInputPath, bgmLocalPath, mixInputDurationMs)
 		if err != nil {
 			log.Error("FFmpeg mixing failed", "error", err)
 			return apperror.ErrInternal.WithMessage("BGM のミキシングに失敗しました").WithError(err)
 		}
+		finalWaveforms = generateFileWaveforms(ctx, s.ffmpegService, finalPath)
+		bgmMixed = true
+	} else if stitched {
+		// BGM なしでイントロ・アウトロを結合した場合
+		finalPath = mixInputPath
+		finalWaveforms = generateFileWaveforms(ctx, s.ffmpegService, finalPath)
 	} else {
 		// BGM なしの場合はそのまま
-		finalAudio = voiceAudio
+		finalPath = voicePath
+		finalWaveforms = voiceWaveforms
 	}
 
 	// 進捗: 85%
@@ -633,33 +987,39 @@ func (s *audioJobService) executeJobInternal(ctx context.Context, job *model.Aud
 		return err
 	}
 
+	// ID3 タグ（タイトル・チャンネル名・アートワークなど）を埋め込む
+	finalPath, id3Tag := s.id3Tagger.apply(ctx, ws, episode, finalPath)
+
 	// 新しい Audio ID を生成してアップロード
 	audioID := uuid.New()
 	audioPath := storage.GenerateAudioPath(audioID.String())
 
-	if _, err := s.storageClient.Upload(ctx, finalAudio, audioPath, "audio/mpeg"); err != nil {
+	finalFileSize, err := uploadFile(ctx, s.storageClient, finalPath, audioPath, "audio/mpeg")
+	if err != nil {
 		log.Error("failed to upload audio", "error", err)
 		return apperror.ErrInternal.WithMessage("音声のアップロードに失敗しました").WithError(err)
 	}
 
 	// 最終的な長さを取得（ミキシングした場合は変わる可能性がある）
-	finalDurationMs, err := audio.GetDurationMsE(finalAudio)
+	finalDurationMs, err := audio.GetFileDurationMs(finalPath)
 	if err != nil {
 		log.Warn("failed to get final audio duration, using estimate", "error", err)
-		// ミキシングした場合は計算、そうでなければ voiceDurationMs を使用
-		if voiceDurationMs > 0 {
-			finalDurationMs = job.PaddingStartMs + voiceDurationMs + job.PaddingEndMs
+		// ミキシングした場合は計算、そうでなければ mixInputDurationMs を使用
+		if mixInputDurationMs > 0 {
+			finalDurationMs = job.PaddingStartMs + mixInputDurationMs + job.PaddingEndMs
 		}
 	}
 
 	// Audio レコードを作成
 	audioRecord := &model.Audio{
-		ID:         audioID,
-		MimeType:   "audio/mpeg",
-		Path:       audioPath,
-		Filename:   audioID.String() + ".mp3",
-		FileSize:   len(finalAudio),
-		DurationMs: finalDurationMs,
+		ID:          audioID,
+		MimeType:    "audio/mpeg",
+		Path:        audioPath,
+		Filename:    audioID.String() + ".mp3",
+		FileSize:    finalFileSize,
+		DurationMs:  finalDurationMs,
+		Waveforms:   finalWaveforms,
+		LineTimings: voiceAudioRecord.LineTimings.Shift(voiceOffsetMs(job, &episode.Channel, bgmMixed)),
 	}
 
 	if err := s.audioRepo.Create(ctx, audioRecord); err != nil {
@@ -667,12 +1027,30 @@ func (s *audioJobService) executeJobInternal(ctx context.Context, job *model.Aud
 		return apperror.ErrInternal.WithMessage("音声レコードの保存に失敗しました").WithError(err)
 	}
 
+	// 進捗: 88%
+	s.updateProgress(ctx, job, 88, "配信用フォーマットを生成中...")
+
+	renditions := s.generateRenditions(ctx, ws, finalPath, finalDurationMs, id3Tag)
+
+	// HLS パッケージを生成（HLS 出力が無効な場合は既存のパッケージを削除）
+	if job.HLSEnabled {
+		s.updateProgress(ctx, job, 90, "HLS パッケージを生成中...")
+	}
+	s.updateHLSPackage(ctx, job, episode, audioID, finalPath, finalDurationMs)
+
 	// 進捗: 95%
 	s.updateProgress(ctx, job, 95, "エピソードを更新中...")
 
+	// 配信用音声を新しい音声から生成したものに置き換える
+	if err := s.renditionRepo.ReplaceByEpisodeID(ctx, episode.ID, renditions); err != nil {
+		return err
+	}
+
 	// エピソードを更新
 	episode.FullAudioID = &audioID
 	episode.FullAudio = nil
+	episode.AudioRenditions = nil
+	episode.HLSPackage = nil
 
 	// full の場合は BGM 情報をエピソードに記録
 	if job.JobType == model.AudioJobTypeFull {
@@ -711,83 +1089,99 @@ func (s *audioJobService) executeJobInternal(ctx context.Context, job *model.Aud
 }
 
 // reassemblySegment は再アセンブル用のセグメント情報
+//
+// PCM データはメモリに持たず、作業ディレクトリの PCM ファイル内の範囲として参照する
 type reassemblySegment struct {
 	originalIndex int    // 元の台本での順序
-	pcmData       []byte // 分割された PCM データ
+	path          string // セグメントを含む PCM ファイルのパス
+	offset        int64  // ファイル内の開始位置（バイト）
+	length        int64  // セグメントの長さ（バイト）
 }
 
 // reassemblySpeakerGroup は話者ごとのグループ情報
 type reassemblySpeakerGroup struct {
-	alias           string // 話者エイリアス（speaker1 等）
-	voiceID         string // Voice の ProviderVoiceID
-	gender          model.Gender
-	texts           []string // TTS に送るテキスト一覧（感情指示を含む）
-	spokenTexts     []string // 実際に発話されるテキスト一覧（感情指示を除く、アライメント用）
-	originalIndices []int    // 元の台本でのインデックス一覧
+	alias           string              // 話者エイリアス（speaker1 等）
+	voice           model.Voice         // 話者のボイス（プロバイダ・ProviderVoiceID・性別）
+	voiceSettings   model.VoiceSettings // キャラクターごとの音声合成パラメータ
+	texts           []string            // TTS に送るテキスト一覧（感情指示を含む）
+	spokenTexts     []string            // 実際に発話されるテキスト一覧（感情指示を除く、アライメント用）
+	originalIndices []int               // 元の台本でのインデックス一覧
+}
+
+// reassemblyLineTiming は再アセンブル後の音声における台本行の再生区間 (ms)
+//
+// セリフ先頭・末尾に挿入したポーズ・効果音を含む
+type reassemblyLineTiming struct {
+	startMs int
+	endMs   int
+}
+
+// reassemblyLineEdges はセリフの先頭・末尾から切り出したポーズ・効果音
+//
+// 再アセンブル時に TTS の出力ではなく無音・効果音の音声としてセグメントの前後に挿入する
+type reassemblyLineEdges struct {
+	leading  []script.MarkupSegment
+	trailing []script.MarkupSegment
 }
 
 // synthesizeMultiSpeakerByReassembly は話者別にシングルスピーカー合成し、
 // 無音分割で個別セグメントに分けた後、元の順序に再アセンブルする
+//
+// 話者ごとにボイスのプロバイダの TTS クライアントで合成するため、1 エピソード内で
+// 複数のプロバイダを混在できる。合成結果は再アセンブル前に共通の PCM 形式に揃える。
+// セリフ先頭・末尾の効果音は sfxAudios（効果音名ごとの効果音ライブラリの音声）で解決する。
+// runQA が true の場合は行ごとに STT の認識結果と台本の一致度をチェックし、閾値未満の行を再合成する。
+//
+// 長いエピソードでも PCM のコピーがメモリに積み上がらないよう、話者ごとの PCM と再アセンブル結果は
+// 作業ディレクトリのファイルに書き出し、セグメントはファイル内の範囲として受け渡す。
+// 再アセンブルした PCM（24kHz / 16bit / モノラル）のファイルパスとあわせて、
+// turns と同じ順序で各ターンの再生区間と発音 QA の結果（runQA が false の場合は nil）を返す
 func (s *audioJobService) synthesizeMultiSpeakerByReassembly(
 	ctx context.Context,
 	job *model.AudioJob,
+	ws *audioWorkspace,
 	turns []tts.SpeakerTurn,
-	voiceConfigs []tts.SpeakerVoiceConfig,
-	ttsClient tts.Client,
-	scriptLines []model.ScriptLine,
-	provider tts.Provider,
-) (*tts.SynthesisResult, error) {
+	speakers map[string]model.Character,
+	sfxAudios map[string]model.Audio,
+	runQA bool,
+) (string, []reassemblyLineTiming, []reassemblyLineQA, error) {
 	log := logger.FromContext(ctx)
 
 	if s.sttClient == nil {
-		return nil, fmt.Errorf("STT クライアントが設定されていません（GoogleCloudProjectID を確認してください）")
-	}
-
-	// VoiceConfig のマップを構築
-	voiceConfigMap := make(map[string]tts.SpeakerVoiceConfig)
-	for _, vc := range voiceConfigs {
-		voiceConfigMap[vc.SpeakerAlias] = vc
-	}
-
-	// 話者の Gender マップを構築
-	genderMap := make(map[string]model.Gender)
-	for _, line := range scriptLines {
-		alias := ""
-		for a, vc := range voiceConfigMap {
-			if vc.VoiceID == line.Speaker.Voice.ProviderVoiceID {
-				alias = a
-				break
-			}
-		}
-		if alias != "" {
-			genderMap[alias] = line.Speaker.Voice.Gender
-		}
+		return "", nil, nil, fmt.Errorf("STT クライアントが設定されていません（GoogleCloudProjectID を確認してください）")
 	}
 
 	// Step 1: 話者別にグループ化（元のインデックスを保持）
 	speakerGroups := make(map[string]*reassemblySpeakerGroup)
+	lineEdges := make(map[int]reassemblyLineEdges)
 	for i, turn := range turns {
 		group, exists := speakerGroups[turn.Speaker]
 		if !exists {
-			vc := voiceConfigMap[turn.Speaker]
 			group = &reassemblySpeakerGroup{
-				alias:   turn.Speaker,
-				voiceID: vc.VoiceID,
-				gender:  genderMap[turn.Speaker],
+				alias:         turn.Speaker,
+				voice:         speakers[turn.Speaker].Voice,
+				voiceSettings: speakers[turn.Speaker].VoiceSettings,
 			}
 			speakerGroups[turn.Speaker] = group
 		}
 
-		// 発話テキスト（感情指示を除く）: アライメントに使用
-		spokenText := turn.Text
-		if !strings.HasSuffix(spokenText, "。") {
-			spokenText += "。"
+		// 発話テキスト（感情指示・マークアップ・発音辞書の読みを含まない元のセリフ）: アライメントに使用
+		spokenText := turn.OriginalText
+		if spokenText == "" {
+			spokenText = turn.Text
+		}
+		spokenText = withSentenceEnd(script.PlainText(spokenText))
+
+		// 先頭・末尾のポーズ・効果音は TTS に渡さず、再アセンブル時に音声として挿入する
+		leading, body, trailing := script.SplitEdgeMarkup(turn.Text)
+		if len(leading) > 0 || len(trailing) > 0 {
+			lineEdges[i] = reassemblyLineEdges{leading: leading, trailing: trailing}
 		}
 
-		// TTS 用テキスト（感情指示を含む）
-		ttsText := spokenText
+		// TTS 用テキスト（発音辞書適用後のセリフ + 感情指示）
+		ttsText := withSentenceEnd(body)
 		if turn.Emotion != nil && *turn.Emotion != "" {
-			ttsText = fmt.Sprintf("[%s] %s", *turn.Emotion, spokenText)
+			ttsText = fmt.Sprintf("[%s] %s", *turn.Emotion, ttsText)
 		}
 
 		group.texts = append(group.texts, ttsText)
@@ -810,7 +1204,8 @@ func (s *audioJobService) synthesizeMultiSpeakerByReassembly(
 	// Step 2: 話者ごとにシングルスピーカー合成（並列実行）
 	type speakerResult struct {
 		alias           string
-		pcmData         []byte
+		pcmPath         string
+		pcmSize         int
 		originalIndices []int
 	}
 
@@ -824,6 +1219,12 @@ func (s *audioJobService) synthesizeMultiSpeakerByReassembly(
 	for _, group := range speakerGroups {
 		g := group
 		eg.Go(func() error {
+			provider := tts.Provider(g.voice.Provider)
+			ttsClient, err := s.ttsRegistry.Get(provider)
+			if err != nil {
+				return fmt.Errorf("話者 %s の TTS プロバイダ %q が利用できません: %w", g.alias, provider, err)
+			}
+
 			// テキストを改行区切りで連結（Gemini の場合は音声スタイルプロンプトを先頭に付加）
 			var fullText string
 			if provider == tts.ProviderGoogle {
@@ -834,6 +1235,7 @@ func (s *audioJobService) synthesizeMultiSpeakerByReassembly(
 
 			log.Debug("reassembly: synthesizing speaker",
 				"alias", g.alias,
+				"provider", provider,
 				"line_count", len(g.texts),
 				"text_length", len(fullText),
 			)
@@ -855,7 +1257,7 @@ func (s *audioJobService) synthesizeMultiSpeakerByReassembly(
 					}
 				}
 
-				result, lastErr = ttsClient.Synthesize(egCtx, fullText, nil, g.voiceID, g.gender)
+				result, lastErr = ttsClient.Synthesize(egCtx, fullText, nil, g.voice.ProviderVoiceID, g.voice.Gender, toTTSVoiceSettings(g.voiceSettings))
 				if lastErr == nil {
 					break
 				}
@@ -864,21 +1266,31 @@ func (s *audioJobService) synthesizeMultiSpeakerByReassembly(
 				return fmt.Errorf("話者 %s の合成に失敗しました: %w", g.alias, lastErr)
 			}
 
-			if result.Format != "pcm" {
-				return fmt.Errorf("話者 %s の出力フォーマットが pcm ではありません: %s", g.alias, result.Format)
+			// プロバイダごとに異なるフォーマット・サンプルレートを再アセンブル用の PCM に揃えて作業ディレクトリに書き出す
+			pcmPath, err := s.normalizeReassemblyPCM(egCtx, ws, result, fmt.Sprintf("speaker_%s.pcm", g.alias))
+			if err != nil {
+				return fmt.Errorf("話者 %s の音声の正規化に失敗しました: %w", g.alias, err)
 			}
+			info, err := os.Stat(pcmPath)
+			if err != nil {
+				return fmt.Errorf("話者 %s の音声の読み込みに失敗しました: %w", g.alias, err)
+			}
+			pcmSize := int(info.Size())
 
 			mu.Lock()
 			results[g.alias] = &speakerResult{
 				alias:           g.alias,
-				pcmData:         result.Data,
+				pcmPath:         pcmPath,
+				pcmSize:         pcmSize,
 				originalIndices: g.originalIndices,
 			}
 			mu.Unlock()
 
 			log.Debug("reassembly: speaker synthesis done",
 				"alias", g.alias,
-				"pcm_size", len(result.Data),
+				"format", result.Format,
+				"sample_rate", result.SampleRate,
+				"pcm_size", pcmSize,
 			)
 
 			// デバッグ用: TTS 完了直後にスピーカー別オリジナル音源をファイルに保存（development 環境のみ）
@@ -887,15 +1299,14 @@ func (s *audioJobService) synthesizeMultiSpeakerByReassembly(
 				if mkErr := os.MkdirAll(debugDir, 0o755); mkErr != nil {
 					log.Warn("reassembly: failed to create debug directory", "error", mkErr)
 				} else {
-					wavData := audio.EncodeWAV(result.Data, reassemblySampleRate, reassemblyChannels, reassemblyBytesPerSample)
 					debugPath := filepath.Join(debugDir, fmt.Sprintf("speaker_%s_original.wav", g.alias))
-					if writeErr := os.WriteFile(debugPath, wavData, 0o644); writeErr != nil {
+					if writeErr := writeDebugWAV(debugPath, pcmPath, pcmSize); writeErr != nil {
 						log.Warn("reassembly: failed to write debug audio", "alias", g.alias, "error", writeErr)
 					} else {
 						log.Debug("reassembly: saved original speaker audio",
 							"alias", g.alias,
 							"path", debugPath,
-							"pcm_bytes", len(result.Data),
+							"pcm_bytes", pcmSize,
 						)
 					}
 				}
@@ -906,7 +1317,7 @@ func (s *audioJobService) synthesizeMultiSpeakerByReassembly(
 	}
 
 	if err := eg.Wait(); err != nil {
-		return nil, err
+		return "", nil, nil, err
 	}
 
 	// Step 3: STT アライメント + silencedetect スナップのハイブリッド方式で行境界を特定し分割
@@ -914,15 +1325,27 @@ func (s *audioJobService) synthesizeMultiSpeakerByReassembly(
 	var allSegments []reassemblySegment
 	var segMu sync.Mutex
 
+	var lineQA []reassemblyLineQA
+	if runQA {
+		lineQA = make([]reassemblyLineQA, len(turns))
+	}
+
 	eg2, egCtx2 := errgroup.WithContext(ctx)
+	eg2.SetLimit(reassemblyAlignmentConcurrency)
 
 	for alias, res := range results {
 		alias, res := alias, res
 		group := speakerGroups[alias]
 		eg2.Go(func() error {
+			// STT・silencedetect は PCM 全体を必要とするため、この話者の処理中だけファイルを読み込む
+			pcmData, err := os.ReadFile(res.pcmPath)
+			if err != nil {
+				return fmt.Errorf("話者 %s の音声の読み込みに失敗しました: %w", alias, err)
+			}
+
 			// STT で単語レベルのタイムスタンプを取得
 			log.Debug("reassembly: recognizing speech for alignment", "alias", alias)
-			sttWords, err := s.sttClient.RecognizeWithTimestamps(egCtx2, res.pcmData, reassemblySampleRate)
+			sttWords, err := s.sttClient.RecognizeWithTimestamps(egCtx2, pcmData, reassemblySampleRate)
 			if err != nil {
 				return fmt.Errorf("話者 %s の音声認識に失敗しました: %w", alias, err)
 			}
@@ -942,14 +1365,7 @@ func (s *audioJobService) synthesizeMultiSpeakerByReassembly(
 			}
 
 			// STT の WordTimestamp を audio パッケージの型に変換
-			audioWords := make([]audio.WordTimestamp, len(sttWords))
-			for i, w := range sttWords {
-				audioWords[i] = audio.WordTimestamp{
-					Word:      w.Word,
-					StartTime: w.StartTime,
-					EndTime:   w.EndTime,
-				}
-			}
+			audioWords := toAudioWordTimestamps(sttWords)
 
 			// DP アライメントで行境界を特定
 			boundaries, err := audio.AlignTextToTimestamps(group.spokenTexts, audioWords)
@@ -970,9 +1386,23 @@ func (s *audioJobService) synthesizeMultiSpeakerByReassembly(
 				)
 			}
 
+			// 発音 QA: 行ごとの認識結果と台本の一致度を記録する
+			if runQA {
+				transcripts := audio.TranscribeLines(audioWords, boundaries)
+				segMu.Lock()
+				for i, idx := range res.originalIndices {
+					lineQA[idx] = reassemblyLineQA{
+						transcript: transcripts[i],
+						similarity: audio.TextSimilarity(group.spokenTexts[i], transcripts[i]),
+						attempts:   1,
+					}
+				}
+				segMu.Unlock()
+			}
+
 			// 先頭と末尾の境界を PCM データの実際の範囲に拡張する
 			// STT の単語タイムスタンプは音声の先頭/末尾の余白をカバーしないため
-			pcmDuration := time.Duration(float64(len(res.pcmData)) / float64(reassemblyBytesPerSec) * float64(time.Second))
+			pcmDuration := time.Duration(float64(len(pcmData)) / float64(reassemblyBytesPerSec) * float64(time.Second))
 			boundaries[0].StartTime = 0
 			boundaries[len(boundaries)-1].EndTime = pcmDuration
 
@@ -988,7 +1418,7 @@ func (s *audioJobService) synthesizeMultiSpeakerByReassembly(
 
 			// 行が2行以上の場合、silencedetect で正確なカット位置にスナップする
 			if len(group.spokenTexts) >= 2 {
-				silences, silenceErr := audio.DetectSilenceIntervals(res.pcmData, audio.PCMSplitConfig{
+				silences, silenceErr := audio.DetectSilenceIntervals(pcmData, audio.PCMSplitConfig{
 					SampleRate:     reassemblySampleRate,
 					Channels:       reassemblyChannels,
 					BytesPerSample: reassemblyBytesPerSample,
@@ -1041,43 +1471,31 @@ func (s *audioJobService) synthesizeMultiSpeakerByReassembly(
 			}
 
 			// ダミー末尾行が読み上げられなかった場合の末尾補正
-			// ダミーセグメントの時間が短い場合、実テキスト末尾の音声がダミー側に
-			// 含まれているため、最後の実セグメントの境界を拡張する
-			dummyBoundaryIdx := len(boundaries) - 1
-			lastRealBoundaryIdx := dummyBoundaryIdx - 1
-			if lastRealBoundaryIdx >= 0 {
-				dummyDuration := boundaries[dummyBoundaryIdx].EndTime - boundaries[dummyBoundaryIdx].StartTime
-				// "以上です。" の通常読み上げ時間は約1秒以上
-				// それより短い場合、ダミーは読み上げられておらず残りの音声は実テキスト末尾
-				if dummyDuration < 800*time.Millisecond {
-					log.Debug("reassembly: dummy not spoken, extending last real segment",
-						"alias", alias,
-						"dummy_duration_ms", dummyDuration.Milliseconds(),
-					)
-					boundaries[lastRealBoundaryIdx].EndTime = boundaries[dummyBoundaryIdx].EndTime
-					boundaries[dummyBoundaryIdx].StartTime = boundaries[dummyBoundaryIdx].EndTime
-				}
+			if absorbUnspokenDummyBoundary(boundaries) {
+				log.Debug("reassembly: dummy not spoken, extended last real segment", "alias", alias)
 			}
 
-			// タイムスタンプ境界で PCM を分割
-			segments := audio.SplitPCMByTimestamps(res.pcmData, boundaries, reassemblySampleRate, reassemblyChannels, reassemblyBytesPerSample)
+			// タイムスタンプ境界に対応する PCM ファイル内の範囲を求める
+			segments := audio.PCMRangesByTimestamps(len(pcmData), boundaries, reassemblySampleRate, reassemblyChannels, reassemblyBytesPerSample)
 
 			// ダミー末尾行のセグメントを除外し、実セグメントのみ追加
 			segMu.Lock()
 			for i := 0; i < len(res.originalIndices); i++ {
 				allSegments = append(allSegments, reassemblySegment{
 					originalIndex: res.originalIndices[i],
-					pcmData:       segments[i],
+					path:          res.pcmPath,
+					offset:        int64(segments[i].Offset),
+					length:        int64(segments[i].Length),
 				})
 			}
 			segMu.Unlock()
 
 			if len(segments) > len(res.originalIndices) {
 				dummySeg := segments[len(segments)-1]
-				dummyDuration := time.Duration(float64(len(dummySeg)) / float64(reassemblyBytesPerSec) * float64(time.Second))
+				dummyDuration := time.Duration(float64(dummySeg.Length) / float64(reassemblyBytesPerSec) * float64(time.Second))
 				log.Debug("reassembly: discarded dummy trailing segment",
 					"alias", alias,
-					"dummy_pcm_bytes", len(dummySeg),
+					"dummy_pcm_bytes", dummySeg.Length,
 					"dummy_duration_ms", dummyDuration.Milliseconds(),
 				)
 			}
@@ -1094,51 +1512,534 @@ func (s *audioJobService) synthesizeMultiSpeakerByReassembly(
 	}
 
 	if err := eg2.Wait(); err != nil {
-		return nil, err
+		return "", nil, nil, err
 	}
 
-	// Step 4: 元の順序で再アセンブル（セグメント間に 200ms 無音パディング挿入）
 	sort.Slice(allSegments, func(i, j int) bool {
 		return allSegments[i].originalIndex < allSegments[j].originalIndex
 	})
 
+	// Step 3.5: 発音 QA で一致度が閾値未満の行を再合成し、セグメントを差し替える
+	if runQA {
+		if err := s.resynthesizeFailedLines(ctx, job, ws, speakerGroups, allSegments, lineQA); err != nil {
+			return "", nil, nil, err
+		}
+	}
+
+	// Step 4: 元の順序で再アセンブル（セグメント間に 200ms 無音パディング挿入）
+	pcmPath, lineTimings, err := s.writeReassembledPCM(ctx, ws, allSegments, lineEdges, sfxAudios, len(turns))
+	if err != nil {
+		return "", nil, nil, err
+	}
+
+	return pcmPath, lineTimings, lineQA, nil
+}
+
+// writeReassembledPCM はセグメントを元の順序で連結し、作業ディレクトリの voice.pcm に書き出す
+//
+// セグメント間には 200ms の無音パディングを、セリフ先頭・末尾にはポーズ・効果音を挿入する。
+// セグメントは PCM ファイルから範囲ごとにコピーするため、連結結果全体をメモリに持たない。
+// 書き出したファイルのパスと、lineCount 行分の再生区間（元のインデックス順）を返す
+func (s *audioJobService) writeReassembledPCM(
+	ctx context.Context,
+	ws *audioWorkspace,
+	segments []reassemblySegment,
+	lineEdges map[int]reassemblyLineEdges,
+	sfxAudios map[string]model.Audio,
+	lineCount int,
+) (string, []reassemblyLineTiming, error) {
+	log := logger.FromContext(ctx)
+
 	silencePadding := audio.GenerateSilencePCM(200, reassemblySampleRate, reassemblyChannels, reassemblyBytesPerSample)
+	sfxCache := make(map[string][]byte)
+
+	var files openedFiles
+	defer files.Close()
+	opened := make(map[string]*os.File)
+
+	lineTimings := make([]reassemblyLineTiming, lineCount)
+	var offset int64
+	// セグメントのコピーには 1 つのバッファを使い回す（io.Copy はセグメントごとにバッファを確保するため）
+	copyBuf := make([]byte, 32*1024)
+	pcmPath, err := ws.create("voice.pcm", func(w io.Writer) error {
+		// *os.File の ReadFrom を経由すると copyBuf が使われないため、Write のみの Writer として渡す
+		dst := struct{ io.Writer }{w}
+		writePart := func(part []byte) error {
+			n, err := w.Write(part)
+			offset += int64(n)
+			return err
+		}
+
+		for i, seg := range segments {
+			startMs := int(offset * 1000 / reassemblyBytesPerSec)
+
+			// セリフ先頭・末尾のポーズ・効果音をセグメントの前後に挿入
+			edges := lineEdges[seg.originalIndex]
+			leadingParts, err := s.renderReassemblyEdges(ctx, edges.leading, sfxAudios, sfxCache)
+			if err != nil {
+				return err
+			}
+			trailingParts, err := s.renderReassemblyEdges(ctx, edges.trailing, sfxAudios, sfxCache)
+			if err != nil {
+				return err
+			}
+			for _, part := range leadingParts {
+				if err := writePart(part); err != nil {
+					return err
+				}
+			}
+
+			f, ok := opened[seg.path]
+			if !ok {
+				f, err = files.open(seg.path)
+				if err != nil {
+					return err
+				}
+				opened[seg.path] = f
+			}
+			n, err := io.CopyBuffer(dst, io.NewSectionReader(f, seg.offset, seg.length), copyBuf)
+			offset += n
+			if err != nil {
+				return err
+			}
 
-	pcmParts := make([][]byte, 0, len(allSegments)*2)
-	for i, seg := range allSegments {
-		pcmParts = append(pcmParts, seg.pcmData)
-		// 最後のセグメント以外にはパディングを挿入
-		if i < len(allSegments)-1 {
-			pcmParts = append(pcmParts, silencePadding)
+			for _, part := range trailingParts {
+				if err := writePart(part); err != nil {
+					return err
+				}
+			}
+
+			lineTimings[seg.originalIndex] = reassemblyLineTiming{
+				startMs: startMs,
+				endMs:   int(offset * 1000 / reassemblyBytesPerSec),
+			}
+
+			// 最後のセグメント以外にはパディングを挿入
+			if i < len(segments)-1 {
+				if err := writePart(silencePadding); err != nil {
+					return err
+				}
+			}
 		}
+		return nil
+	})
+	if err != nil {
+		return "", nil, err
 	}
 
-	finalPCM := audio.ConcatPCM(pcmParts)
-
 	log.Info("reassembly: completed",
-		"total_segments", len(allSegments),
-		"final_pcm_size", len(finalPCM),
+		"total_segments", len(segments),
+		"final_pcm_size", offset,
 	)
 
-	return &tts.SynthesisResult{
-		Data:       finalPCM,
-		Format:     "pcm",
-		SampleRate: reassemblySampleRate,
-	}, nil
+	return pcmPath, lineTimings, nil
 }
 
-// downloadFromStorage は指定されたパスのファイルを GCS からダウンロードする
-func (s *audioJobService) downloadFromStorage(ctx context.Context, path string) ([]byte, error) {
-	// storage.Client に Download メソッドがある前提
-	type downloader interface {
-		Download(ctx context.Context, path string) ([]byte, error)
+// writeDebugWAV は PCM ファイルに WAV ヘッダーを付けて debugPath に書き出す
+func writeDebugWAV(debugPath, pcmPath string, pcmSize int) error {
+	out, err := os.Create(debugPath)
+	if err != nil {
+		return err
+	}
+	defer out.Close()
+
+	if _, err := out.Write(audio.WAVHeader(pcmSize, reassemblySampleRate, reassemblyChannels, reassemblyBytesPerSample)); err != nil {
+		return err
+	}
+	return withFile(pcmPath, func(f *os.File) error {
+		_, err := io.Copy(out, f)
+		return err
+	})
+}
+
+// stitchChannelSegments はチャンネルのイントロ・アウトロをナレーションの前後にクロスフェードで結合する
+//
+// イントロ・アウトロのどちらも設定されていない場合はナレーションのファイルをそのまま返す。
+// 結合結果は作業ディレクトリの stitched.mp3 に書き出す。戻り値の bool は結合を行ったかどうかを表す
+func (s *audioJobService) stitchChannelSegments(ctx context.Context, job *model.AudioJob, ws *audioWorkspace, channel *model.Channel, voicePath string) (string, bool, error) {
+	log := logger.FromContext(ctx)
+
+	if channel.IntroAudio == nil && channel.OutroAudio == nil {
+		return voicePath, false, nil
 	}
 
-	if d, ok := s.storageClient.(downloader); ok {
-		return d.Download(ctx, path)
+	s.updateProgress(ctx, job, 53, "イントロ・アウトロを結合中...")
+
+	var files openedFiles
+	defer files.Close()
+
+	voice, err := files.open(voicePath)
+	if err != nil {
+		return "", false, apperror.ErrInternal.WithMessage("ボイス音声の読み込みに失敗しました").WithError(err)
 	}
+	params := StitchParams{Voice: voice}
 
-	return nil, apperror.ErrInternal.WithMessage("ストレージクライアントが Download をサポートしていません")
+	if channel.IntroAudio != nil {
+		introPath, err := ws.download(ctx, s.storageClient, channel.IntroAudio.Path, "intro.mp3")
+		if err != nil {
+			log.Error("failed to download intro", "error", err, "path", channel.IntroAudio.Path)
+			return "", false, apperror.ErrInternal.WithMessage("イントロのダウンロードに失敗しました").WithError(err)
+		}
+		intro, err := files.open(introPath)
+		if err != nil {
+			return "", false, apperror.ErrInternal.WithMessage("イントロの読み込みに失敗しました").WithError(err)
+		}
+		params.Intro = intro
+		params.IntroCrossfadeMs = segmentCrossfadeMs(channel.IntroCrossfadeMs, channel.IntroAudio.DurationMs)
+	}
+
+	if channel.OutroAudio != nil {
+		outroPath, err := ws.download(ctx, s.storageClient, channel.OutroAudio.Path, "outro.mp3")
+		if err != nil {
+			log.Error("failed to download outro", "error", err, "path", channel.OutroAudio.Path)
+			return "", false, apperror.ErrInternal.WithMessage("アウトロのダウンロードに失敗しました").WithError(err)
+		}
+		outro, err := files.open(outroPath)
+		if err != nil {
+			return "", false, apperror.ErrInternal.WithMessage("アウトロの読み込みに失敗しました").WithError(err)
+		}
+		params.Outro = outro
+		params.OutroCrossfadeMs = segmentCrossfadeMs(channel.OutroCrossfadeMs, channel.OutroAudio.DurationMs)
+	}
+
+	stitchedPath, err := ws.create("stitched.mp3", func(w io.Writer) error {
+		return s.ffmpegService.StitchSegments(ctx, params, w)
+	})
+	if err != nil {
+		return "", false, err
+	}
+
+	return stitchedPath, true, nil
+}
+
+// voiceOffsetMs は最終的な音声におけるボイス音声の開始位置 (ms) を返す
+//
+// イントロはクロスフェードの分だけナレーションと重なり、BGM をミキシングした場合は前余白が入る
+func voiceOffsetMs(job *model.AudioJob, channel *model.Channel, bgmMixed bool) int {
+	offsetMs := 0
+	if channel.IntroAudio != nil {
+		intro := channel.IntroAudio
+		offsetMs += max(intro.DurationMs-segmentCrossfadeMs(channel.IntroCrossfadeMs, intro.DurationMs), 0)
+	}
+	if bgmMixed {
+		offsetMs += job.PaddingStartMs
+	}
+	return offsetMs
+}
+
+// toAudioLineTimings は再アセンブル時の台本行の再生区間を音声に記録する形式に変換する
+//
+// 行ごとに合成しなかった場合（lineTimings が nil）は nil を返す。再生区間が空の行は含めない
+func toAudioLineTimings(lineIDs []uuid.UUID, lineTimings []reassemblyLineTiming) model.AudioLineTimings {
+	if lineTimings == nil {
+		return nil
+	}
+	timings := make(model.AudioLineTimings, 0, len(lineTimings))
+	for i, timing := range lineTimings {
+		if timing.endMs <= timing.startMs {
+			continue
+		}
+		timings = append(timings, model.AudioLineTiming{
+			ScriptLineID: lineIDs[i],
+			StartMs:      timing.startMs,
+			EndMs:        timing.endMs,
+		})
+	}
+	return timings
+}
+
+// mergeSplitLineTimings は同じ台本行から分割した連続するターンの再生区間を 1 つにまとめる
+//
+// 台本行 ID と再生区間を台本行ごとに返す。行ごとに合成しなかった場合（lineTimings が nil）は再生区間は nil のまま返す
+func mergeSplitLineTimings(turnLineIDs []uuid.UUID, lineTimings []reassemblyLineTiming) ([]uuid.UUID, []reassemblyLineTiming) {
+	lineIDs := make([]uuid.UUID, 0, len(turnLineIDs))
+	var merged []reassemblyLineTiming
+	if lineTimings != nil {
+		merged = make([]reassemblyLineTiming, 0, len(lineTimings))
+	}
+
+	for i, lineID := range turnLineIDs {
+		if i > 0 && turnLineIDs[i-1] == lineID {
+			if merged != nil {
+				merged[len(merged)-1].endMs = lineTimings[i].endMs
+			}
+			continue
+		}
+		lineIDs = append(lineIDs, lineID)
+		if merged != nil {
+			merged = append(merged, lineTimings[i])
+		}
+	}
+
+	return lineIDs, merged
+}
+
+// segmentCrossfadeMs はイントロ・アウトロのクロスフェード時間をセグメントの長さに収まるように丸める
+//
+// クロスフェードはセグメントより長くできないため。長さが不明（0）の場合は設定値をそのまま使う
+func segmentCrossfadeMs(crossfadeMs, segmentDurationMs int) int {
+	if segmentDurationMs > 0 {
+		return min(crossfadeMs, segmentDurationMs)
+	}
+	return crossfadeMs
+}
+
+// mixSFXCues は台本行の効果音キューをナレーションの該当行の前後に重ね、結果を作業ディレクトリの voice_sfx.mp3 に書き出す
+//
+// 再生区間が特定できない行（空のセリフなど）のキューや、効果音の取得に失敗したキューは
+// 警告ログを出してスキップする（音声生成全体は失敗させない）
+func (s *audioJobService) mixSFXCues(ctx context.Context, ws *audioWorkspace, voicePath string, cues []model.SfxCue, lineTimings map[uuid.UUID]reassemblyLineTiming) (string, error) {
+	log := logger.FromContext(ctx)
+
+	var files openedFiles
+	defer files.Close()
+
+	var params SFXMixParams
+	// 効果音のストレージパスから作業ディレクトリのファイルパスを引く（取得に失敗した効果音は空文字）
+	sfxFiles := make(map[string]string)
+	for _, cue := range cues {
+		timing, ok := lineTimings[cue.ScriptLineID]
+		if !ok {
+			log.Warn("sfx cue line not found in synthesized audio, skipping", "sfx_cue_id", cue.ID, "script_line_id", cue.ScriptLineID)
+			continue
+		}
+		sfxAudio := cue.Audio()
+		if sfxAudio == nil {
+			log.Warn("sfx cue has no sound effect, skipping", "sfx_cue_id", cue.ID)
+			continue
+		}
+
+		localPath, cached := sfxFiles[sfxAudio.Path]
+		if !cached {
+			var err error
+			localPath, err = ws.download(ctx, s.storageClient, sfxAudio.Path, fmt.Sprintf("sfx_%d.mp3", len(sfxFiles)))
+			if err != nil {
+				log.Warn("failed to download sound effect, skipping", "sfx_cue_id", cue.ID, "path", sfxAudio.Path, "error", err)
+			}
+			sfxFiles[sfxAudio.Path] = localPath
+		}
+		if localPath == "" {
+			continue
+		}
+
+		sfx, err := files.open(localPath)
+		if err != nil {
+			log.Warn("failed to open sound effect, skipping", "sfx_cue_id", cue.ID, "error", err)
+			continue
+		}
+
+		params.Cues = append(params.Cues, SFXCueParams{
+			Audio:    sfx,
+			StartMs:  sfxCueStartMs(cue.Position, timing, sfxAudio.DurationMs),
+			VolumeDB: cue.VolumeDB,
+		})
+	}
+
+	if len(params.Cues) == 0 {
+		return voicePath, nil
+	}
+
+	voice, err := files.open(voicePath)
+	if err != nil {
+		return "", apperror.ErrInternal.WithMessage("ボイス音声の読み込みに失敗しました").WithError(err)
+	}
+	params.Voice = voice
+
+	mixedPath, err := ws.create("voice_sfx.mp3", func(w io.Writer) error {
+		return s.ffmpegService.MixSFX(ctx, params, w)
+	})
+	if err != nil {
+		log.Error("FFmpeg sfx mixing failed", "error", err)
+		return "", apperror.ErrInternal.WithMessage("効果音のミキシングに失敗しました").WithError(err)
+	}
+	return mixedPath, nil
+}
+
+// sfxCueStartMs は効果音キューの再生開始位置 (ms) を返す
+//
+// before は効果音が台本行の開始時点で鳴り終わるように、after は台本行の終了時点から鳴らす。
+// before で効果音が音声の先頭より前にはみ出す場合は先頭から鳴らす
+func sfxCueStartMs(position model.SfxCuePosition, timing reassemblyLineTiming, sfxDurationMs int) int {
+	if position == model.SfxCuePositionAfter {
+		return timing.endMs
+	}
+	return max(timing.startMs-sfxDurationMs, 0)
+}
+
+// renderReassemblyEdges はセリフ先頭・末尾のポーズ・効果音を再アセンブル用の PCM に変換する
+//
+// ポーズは指定時間の無音に、効果音は sfxAudios で解決した効果音ライブラリの音声に変換する
+func (s *audioJobService) renderReassemblyEdges(ctx context.Context, segments []script.MarkupSegment, sfxAudios map[string]model.Audio, sfxCache map[string][]byte) ([][]byte, error) {
+	parts := make([][]byte, 0, len(segments))
+	for _, seg := range segments {
+		switch seg.Kind {
+		case script.MarkupKindPause:
+			parts = append(parts, audio.GenerateSilencePCM(int(seg.Duration.Milliseconds()), reassemblySampleRate, reassemblyChannels, reassemblyBytesPerSample))
+		case script.MarkupKindSFX:
+			pcmData, cached := sfxCache[seg.SFX]
+			if !cached {
+				sfxAudio, ok := sfxAudios[seg.SFX]
+				if !ok {
+					return nil, apperror.ErrValidation.WithMessage(fmt.Sprintf("効果音ライブラリに見つからない効果音があります: %s", seg.SFX))
+				}
+				var err error
+				pcmData, err = s.loadSFXPCM(ctx, sfxAudio)
+				if err != nil {
+					return nil, fmt.Errorf("効果音 %s の読み込みに失敗しました: %w", seg.SFX, err)
+				}
+				sfxCache[seg.SFX] = pcmData
+			}
+			parts = append(parts, pcmData)
+		}
+	}
+	return parts, nil
+}
+
+// loadSFXPCM は効果音ライブラリの効果音をダウンロードし、再アセンブル用の PCM にデコードする
+//
+// 効果音は短く、再アセンブル中に繰り返し挿入するためメモリに保持する
+func (s *audioJobService) loadSFXPCM(ctx context.Context, sfxAudio model.Audio) ([]byte, error) {
+	data, err := s.downloadFromStorage(ctx, sfxAudio.Path)
+	if err != nil {
+		return nil, err
+	}
+
+	var buf bytes.Buffer
+	if err := s.ffmpegService.DecodeToPCM(ctx, bytes.NewReader(data), &buf, strings.TrimPrefix(filepath.Ext(sfxAudio.Path), "."), 0, reassemblySampleRate); err != nil {
+		return nil, err
+	}
+	return buf.Bytes(), nil
+}
+
+// normalizeReassemblyPCM は TTS の合成結果を再アセンブル用の PCM（24kHz / 16bit / モノラル）に変換し、作業ディレクトリの name に書き出す
+//
+// 既に同じ形式の PCM の場合はそのまま書き出す。書き出したファイルのパスを返す
+func (s *audioJobService) normalizeReassemblyPCM(ctx context.Context, ws *audioWorkspace, result *tts.SynthesisResult, name string) (string, error) {
+	return ws.create(name, func(w io.Writer) error {
+		if result.Format == "pcm" && result.SampleRate == reassemblySampleRate {
+			_, err := w.Write(result.Data)
+			return err
+		}
+
+		return s.ffmpegService.DecodeToPCM(ctx, bytes.NewReader(result.Data), w, result.Format, result.SampleRate, reassemblySampleRate)
+	})
+}
+
+// absorbUnspokenDummyBoundary はダミー末尾行が読み上げられなかった場合に、最後の実セグメントの境界を末尾まで拡張する
+//
+// ダミーセグメントの時間が短い場合、実テキスト末尾の音声がダミー側に含まれているため。
+// 境界を補正した場合は true を返す
+func absorbUnspokenDummyBoundary(boundaries []audio.LineBoundary) bool {
+	dummyIdx := len(boundaries) - 1
+	lastRealIdx := dummyIdx - 1
+	if lastRealIdx < 0 {
+		return false
+	}
+
+	// "以上です。" の通常読み上げ時間は約1秒以上
+	// それより短い場合、ダミーは読み上げられておらず残りの音声は実テキスト末尾
+	dummyDuration := boundaries[dummyIdx].EndTime - boundaries[dummyIdx].StartTime
+	if dummyDuration >= 800*time.Millisecond {
+		return false
+	}
+
+	boundaries[lastRealIdx].EndTime = boundaries[dummyIdx].EndTime
+	boundaries[dummyIdx].StartTime = boundaries[dummyIdx].EndTime
+	return true
+}
+
+// toAudioWordTimestamps は STT の WordTimestamp を audio パッケージの型に変換する
+func toAudioWordTimestamps(words []stt.WordTimestamp) []audio.WordTimestamp {
+	audioWords := make([]audio.WordTimestamp, len(words))
+	for i, w := range words {
+		audioWords[i] = audio.WordTimestamp{
+			Word:      w.Word,
+			StartTime: w.StartTime,
+			EndTime:   w.EndTime,
+		}
+	}
+	return audioWords
+}
+
+// withSentenceEnd はテキストの末尾が「。」でなければ付加する
+func withSentenceEnd(text string) string {
+	if strings.HasSuffix(text, "。") {
+		return text
+	}
+	return text + "。"
+}
+
+// speakerProviders は話者のボイスが使用する TTS プロバイダの一覧を重複なく返す
+func speakerProviders(speakers map[string]model.Character) []tts.Provider {
+	providers := make([]tts.Provider, 0, len(speakers))
+	for _, speaker := range speakers {
+		provider := tts.Provider(speaker.Voice.Provider)
+		if !slices.Contains(providers, provider) {
+			providers = append(providers, provider)
+		}
+	}
+	slices.Sort(providers)
+	return providers
+}
+
+// mixBGMFile はナレーションと BGM のファイルをジョブの設定でミキシングし、結果を作業ディレクトリの mixed.mp3 に書き出す
+func (s *audioJobService) mixBGMFile(ctx context.Context, ws *audioWorkspace, job *model.AudioJob, voicePath, bgmPath string, voiceDurationMs int) (string, error) {
+	var files openedFiles
+	defer files.Close()
+
+	voice, err := files.open(voicePath)
+	if err != nil {
+		return "", err
+	}
+	bgm, err := files.open(bgmPath)
+	if err != nil {
+		return "", err
+	}
+
+	return ws.create("mixed.mp3", func(w io.Writer) error {
+		return s.ffmpegService.MixAudioWithBGM(ctx, newMixParams(job, voice, bgm, voiceDurationMs), w)
+	})
+}
+
+// newMixParams はジョブのミキシング設定から MixParams を構築する
+func newMixParams(job *model.AudioJob, voice, bgm io.Reader, voiceDurationMs int) MixParams {
+	params := MixParams{
+		Voice:           voice,
+		BGM:             bgm,
+		VoiceDurationMs: voiceDurationMs,
+		BGMVolumeDB:     job.BgmVolumeDB,
+		FadeOutMs:       job.FadeOutMs,
+		PaddingStartMs:  job.PaddingStartMs,
+		PaddingEndMs:    job.PaddingEndMs,
+	}
+
+	if job.BgmDucking {
+		params.Ducking = &DuckingParams{
+			ThresholdDB:  job.DuckingThresholdDB,
+			Ratio:        job.DuckingRatio,
+			AttackMs:     job.DuckingAttackMs,
+			ReleaseMs:    job.DuckingReleaseMs,
+			IntroSwellMs: job.IntroSwellMs,
+		}
+	}
+
+	return params
+}
+
+// downloadFromStorage は指定されたパスのファイルを GCS からダウンロードする
+func (s *audioJobService) downloadFromStorage(ctx context.Context, path string) ([]byte, error) {
+	return downloadFromStorageClient(ctx, s.storageClient, path)
+}
+
+// downloadFromStorageClient は storage.Client からファイルをダウンロードしてメモリ上に読み込む
+//
+// 画像やプレイリストなどの小さいファイル向け。音声は audioWorkspace.download で一時ファイルにダウンロードする
+func downloadFromStorageClient(ctx context.Context, client storage.Client, path string) ([]byte, error) {
+	var buf bytes.Buffer
+	if _, err := client.DownloadStream(ctx, path, &buf); err != nil {
+		return nil, err
+	}
+	return buf.Bytes(), nil
 }
 
 // updateProgress はジョブの進捗を更新し WebSocket で通知する
@@ -1394,26 +2295,44 @@ func (s *audioJobService) executeRemixInternal(ctx context.Context, job *model.A
 	// 進捗: 10%
 	s.updateProgress(ctx, job, 10, "ボイス音声をダウンロード中...")
 
+	// 中間データは作業ディレクトリのファイルで受け渡す（ジョブ終了時に削除）
+	ws, err := newAudioWorkspace(job.ID)
+	if err != nil {
+		log.Error("failed to create audio workspace", "error", err)
+		return apperror.ErrInternal.WithMessage("作業ディレクトリの作成に失敗しました").WithError(err)
+	}
+	defer func() {
+		if err := ws.Close(); err != nil {
+			log.Warn("failed to remove audio workspace", "error", err)
+		}
+	}()
+
 	// ボイス音声を GCS からダウンロード
-	voiceAudioData, err := s.downloadFromStorage(ctx, episode.VoiceAudio.Path)
+	voicePath, err := ws.download(ctx, s.storageClient, episode.VoiceAudio.Path, "voice.mp3")
 	if err != nil {
 		log.Error("failed to download voice audio", "error", err, "path", episode.VoiceAudio.Path)
 		return apperror.ErrInternal.WithMessage("ボイス音声のダウンロードに失敗しました").WithError(err)
 	}
 
-	// ボイス音声の長さを取得
-	voiceDurationMs, err := audio.GetDurationMsE(voiceAudioData)
+	// チャンネルのイントロ・アウトロをナレーションの前後に結合する
+	voicePath, _, err = s.stitchChannelSegments(ctx, job, ws, &episode.Channel, voicePath)
+	if err != nil {
+		return err
+	}
+
+	// ボイス音声（イントロ・アウトロ結合後）の長さを取得
+	voiceDurationMs, err := audio.GetFileDurationMs(voicePath)
 	if err != nil {
 		log.Error("failed to get voice audio duration", "error", err)
 		return apperror.ErrInternal.WithMessage("音声長の取得に失敗しました").WithError(err)
 	}
 
-	var finalAudio []byte
+	var finalPath string
 
 	if job.BgmID == nil && job.SystemBgmID == nil {
 		// BGM なし: ボイス音声をそのまま使用
 		s.updateProgress(ctx, job, 50, "音声を処理中...")
-		finalAudio = voiceAudioData
+		finalPath = voicePath
 	} else {
 		// BGM あり: ダウンロードしてミキシング
 		// 進捗: 30%
@@ -1441,7 +2360,7 @@ func (s *audioJobService) executeRemixInternal(ctx context.Context, job *model.A
 		}
 
 		// GCS から BGM をダウンロード
-		bgmData, err := s.downloadFromStorage(ctx, bgmPath)
+		bgmLocalPath, err := ws.download(ctx, s.storageClient, bgmPath, "bgm.mp3")
 		if err != nil {
 			log.Error("failed to download BGM", "error", err, "path", bgmPath)
 			return apperror.ErrInternal.WithMessage("BGM のダウンロードに失敗しました").WithError(err)
@@ -1456,15 +2375,7 @@ func (s *audioJobService) executeRemixInternal(ctx context.Context, job *model.A
 		}
 
 		// FFmpeg でミキシング
-		finalAudio, err = s.ffmpegService.MixAudioWithBGM(ctx, MixParams{
-			VoiceData:       voiceAudioData,
-			BGMData:         bgmData,
-			VoiceDurationMs: voiceDurationMs,
-			BGMVolumeDB:     job.BgmVolumeDB,
-			FadeOutMs:       job.FadeOutMs,
-			PaddingStartMs:  job.PaddingStartMs,
-			PaddingEndMs:    job.PaddingEndMs,
-		})
+		finalPath, err = s.mixBGMFile(ctx, ws, job, voicePath, bgmLocalPath, voiceDurationMs)
 		if err != nil {
 			log.Error("FFmpeg mixing failed", "error", err)
 			return apperror.ErrInternal.WithMessage("BGM のミキシングに失敗しました").WithError(err)
@@ -1479,17 +2390,21 @@ func (s *audioJobService) executeRemixInternal(ctx context.Context, job *model.A
 		return err
 	}
 
+	// ID3 タグ（タイトル・チャンネル名・アートワークなど）を埋め込む
+	finalPath, id3Tag := s.id3Tagger.apply(ctx, ws, episode, finalPath)
+
 	// 新しい Audio ID を生成してアップロード
 	audioID := uuid.New()
 	audioPath := storage.GenerateAudioPath(audioID.String())
 
-	if _, err := s.storageClient.Upload(ctx, finalAudio, audioPath, "audio/mpeg"); err != nil {
+	finalFileSize, err := uploadFile(ctx, s.storageClient, finalPath, audioPath, "audio/mpeg")
+	if err != nil {
 		log.Error("failed to upload audio", "error", err)
 		return apperror.ErrInternal.WithMessage("音声のアップロードに失敗しました").WithError(err)
 	}
 
 	// 最終的な長さを取得
-	finalDurationMs, err := audio.GetDurationMsE(finalAudio)
+	finalDurationMs, err := audio.GetFileDurationMs(finalPath)
 	if err != nil {
 		log.Warn("failed to get final audio duration, using estimate", "error", err)
 		finalDurationMs = job.PaddingStartMs + voiceDurationMs + job.PaddingEndMs
@@ -1497,12 +2412,14 @@ func (s *audioJobService) executeRemixInternal(ctx context.Context, job *model.A
 
 	// Audio レコードを作成
 	audioRecord := &model.Audio{
-		ID:         audioID,
-		MimeType:   "audio/mpeg",
-		Path:       audioPath,
-		Filename:   audioID.String() + ".mp3",
-		FileSize:   len(finalAudio),
-		DurationMs: finalDurationMs,
+		ID:          audioID,
+		MimeType:    "audio/mpeg",
+		Path:        audioPath,
+		Filename:    audioID.String() + ".mp3",
+		FileSize:    finalFileSize,
+		DurationMs:  finalDurationMs,
+		Waveforms:   generateFileWaveforms(ctx, s.ffmpegService, finalPath),
+		LineTimings: episode.VoiceAudio.LineTimings.Shift(voiceOffsetMs(job, &episode.Channel, job.BgmID != nil || job.SystemBgmID != nil)),
 	}
 
 	if err := s.audioRepo.Create(ctx, audioRecord); err != nil {
@@ -1510,12 +2427,30 @@ func (s *audioJobService) executeRemixInternal(ctx context.Context, job *model.A
 		return apperror.ErrInternal.WithMessage("音声レコードの保存に失敗しました").WithError(err)
 	}
 
+	// 進捗: 88%
+	s.updateProgress(ctx, job, 88, "配信用フォーマットを生成中...")
+
+	renditions := s.generateRenditions(ctx, ws, finalPath, finalDurationMs, id3Tag)
+
+	// HLS パッケージを生成（HLS 出力が無効な場合は既存のパッケージを削除）
+	if job.HLSEnabled {
+		s.updateProgress(ctx, job, 90, "HLS パッケージを生成中...")
+	}
+	s.updateHLSPackage(ctx, job, episode, audioID, finalPath, finalDurationMs)
+
 	// 進捗: 95%
 	s.updateProgress(ctx, job, 95, "エピソードを更新中...")
 
+	// 配信用音声を新しい音声から生成したものに置き換える
+	if err := s.renditionRepo.ReplaceByEpisodeID(ctx, episode.ID, renditions); err != nil {
+		return err
+	}
+
 	// エピソードを更新
 	episode.FullAudioID = &audioID
 	episode.FullAudio = nil
+	episode.AudioRenditions = nil
+	episode.HLSPackage = nil
 	// BGM 情報をエピソードに記録
 	episode.BgmID = job.BgmID
 	episode.SystemBgmID = job.SystemBgmID
@@ -1562,6 +2497,8 @@ func (s *audioJobService) toAudioJobResponse(ctx context.Context, job *model.Aud
 		FadeOutMs:      job.FadeOutMs,
 		PaddingStartMs: job.PaddingStartMs,
 		PaddingEndMs:   job.PaddingEndMs,
+		HLS:            job.HLSEnabled,
+		QAEnabled:      job.QAEnabled,
 		ErrorMessage:   job.ErrorMessage,
 		ErrorCode:      job.ErrorCode,
 		StartedAt:      job.StartedAt,
@@ -1570,6 +2507,37 @@ func (s *audioJobService) toAudioJobResponse(ctx context.Context, job *model.Aud
 		UpdatedAt:      job.UpdatedAt,
 	}
 
+	// BGM ダッキング設定
+	if job.BgmDucking {
+		resp.Ducking = &response.AudioJobDuckingResponse{
+			ThresholdDB:  job.DuckingThresholdDB,
+			Ratio:        job.DuckingRatio,
+			AttackMs:     job.DuckingAttackMs,
+			ReleaseMs:    job.DuckingReleaseMs,
+			IntroSwellMs: job.IntroSwellMs,
+		}
+	}
+
+	// 発音 QA レポート
+	if job.QAReport != nil {
+		flaggedLines := make([]response.AudioJobQAFlaggedLineResponse, len(job.QAReport.FlaggedLines))
+		for i, l := range job.QAReport.FlaggedLines {
+			flaggedLines[i] = response.AudioJobQAFlaggedLineResponse{
+				ScriptLineID: l.ScriptLineID,
+				Text:         l.Text,
+				Transcript:   l.Transcript,
+				Similarity:   l.Similarity,
+				Attempts:     l.Attempts,
+			}
+		}
+		resp.QAReport = &response.AudioJobQAReportResponse{
+			Threshold:          job.QAReport.Threshold,
+			CheckedLines:       job.QAReport.CheckedLines,
+			ResynthesizedLines: job.QAReport.ResynthesizedLines,
+			FlaggedLines:       flaggedLines,
+		}
+	}
+
 	// Episode 情報
 	if job.Episode.ID != uuid.Nil {
 		resp.Episode = &response.AudioJobEpisodeResponse{
@@ -1596,6 +2564,7 @@ func (s *audioJobService) toAudioJobResponse(ctx context.Context, job *model.Aud
 			MimeType:   job.ResultAudio.MimeType,
 			FileSize:   job.ResultAudio.FileSize,
 			DurationMs: job.ResultAudio.DurationMs,
+			Waveform:   toAudioWaveformResponse(job.ResultAudio.Waveforms),
 		}
 	}
 
@@ -1,15 +1,28 @@
 package service
 
 import (
+	"bytes"
 	"context"
 	"errors"
+	"fmt"
+	"io"
+	"os"
+	"runtime"
+	"strings"
+	"sync"
 	"testing"
+	"time"
 
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
 
 	"github.com/siropaca/anycast-backend/internal/apperror"
+	"github.com/siropaca/anycast-backend/internal/infrastructure/stt"
+	"github.com/siropaca/anycast-backend/internal/infrastructure/tts"
 	"github.com/siropaca/anycast-backend/internal/model"
+	"github.com/siropaca/anycast-backend/internal/pkg/audio"
+	"github.com/siropaca/anycast-backend/internal/pkg/script"
 	"github.com/siropaca/anycast-backend/internal/pkg/uuid"
 	"github.com/siropaca/anycast-backend/internal/repository"
 )
@@ -43,8 +56,16 @@ func (m *mockAudioJobRepository) FindByEpisodeID(ctx context.Context, episodeID
 	return args.Get(0).([]model.AudioJob), args.Error(1)
 }
 
-func (m *mockAudioJobRepository) FindPendingByEpisodeID(ctx context.Context, episodeID uuid.UUID) (*model.AudioJob, error) {
-	args := m.Called(ctx, episodeID)
+func (m *mockAudioJobRepository) FindPendingByEpisodeID(ctx context.Context, episodeID uuid.UUID, jobTypes []model.AudioJobType) (*model.AudioJob, error) {
+	args := m.Called(ctx, episodeID, jobTypes)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).(*model.AudioJob), args.Error(1)
+}
+
+func (m *mockAudioJobRepository) FindUnstartedByEpisodeID(ctx context.Context, episodeID uuid.UUID, jobType model.AudioJobType) (*model.AudioJob, error) {
+	args := m.Called(ctx, episodeID, jobType)
 	if args.Get(0) == nil {
 		return nil, args.Error(1)
 	}
@@ -387,6 +408,189 @@ func TestAudioJobService_CancelJob(t *testing.T) {
 	})
 }
 
+func TestAudioJobService_CreateRenditionJob(t *testing.T) {
+	userID := uuid.New()
+	episodeID := uuid.New()
+
+	t.Run("配信用音声を生成し直すジョブを作成してエンキューする", func(t *testing.T) {
+		mockRepo := new(mockAudioJobRepository)
+		mockTasks := new(mockTasksClient)
+		jobID := uuid.New()
+		mockRepo.On("Create", mock.Anything, mock.MatchedBy(func(j *model.AudioJob) bool {
+			return j.JobType == model.AudioJobTypeRenditions &&
+				j.Status == model.AudioJobStatusPending &&
+				j.UserID == userID &&
+				j.EpisodeID == episodeID
+		})).Run(func(args mock.Arguments) {
+			args.Get(1).(*model.AudioJob).ID = jobID
+		}).Return(nil)
+		mockTasks.On("EnqueueAudioJob", mock.Anything, jobID.String()).Return(nil)
+
+		svc := &audioJobService{
+			audioJobRepo:   mockRepo,
+			tasksClient:    mockTasks,
+			renditionSpecs: []RenditionSpec{{Format: model.AudioFormatMP3, BitrateKbps: 128}},
+		}
+		err := svc.CreateRenditionJob(context.Background(), userID, episodeID)
+
+		assert.NoError(t, err)
+		mockRepo.AssertExpectations(t)
+		mockTasks.AssertExpectations(t)
+	})
+
+	t.Run("配信用音声の生成が無効な場合はジョブを作成しない", func(t *testing.T) {
+		mockRepo := new(mockAudioJobRepository)
+
+		svc := &audioJobService{audioJobRepo: mockRepo, renditionSpecs: []RenditionSpec{}}
+		err := svc.CreateRenditionJob(context.Background(), userID, episodeID)
+
+		assert.NoError(t, err)
+		mockRepo.AssertNotCalled(t, "Create", mock.Anything, mock.Anything)
+	})
+
+	t.Run("エンキューに失敗した場合はジョブを失敗状態にしてエラーを返す", func(t *testing.T) {
+		mockRepo := new(mockAudioJobRepository)
+		mockTasks := new(mockTasksClient)
+		mockRepo.On("Create", mock.Anything, mock.Anything).Return(nil)
+		mockRepo.On("Update", mock.Anything, mock.MatchedBy(func(j *model.AudioJob) bool {
+			return j.Status == model.AudioJobStatusFailed
+		})).Return(nil)
+		mockTasks.On("EnqueueAudioJob", mock.Anything, mock.Anything).Return(errors.New("unavailable"))
+
+		svc := &audioJobService{
+			audioJobRepo:   mockRepo,
+			tasksClient:    mockTasks,
+			renditionSpecs: []RenditionSpec{{Format: model.AudioFormatMP3, BitrateKbps: 128}},
+		}
+		err := svc.CreateRenditionJob(context.Background(), userID, episodeID)
+
+		assert.True(t, apperror.IsCode(err, apperror.CodeInternal))
+		mockRepo.AssertExpectations(t)
+	})
+}
+
+func TestAudioJobService_CreateRetagJob(t *testing.T) {
+	userID := uuid.New()
+	episodeID := uuid.New()
+
+	t.Run("ID3 タグを書き換えるジョブを作成してエンキューする", func(t *testing.T) {
+		mockRepo := new(mockAudioJobRepository)
+		mockTasks := new(mockTasksClient)
+		jobID := uuid.New()
+		mockRepo.On("FindUnstartedByEpisodeID", mock.Anything, episodeID, model.AudioJobTypeRetag).Return(nil, nil)
+		mockRepo.On("FindPendingByEpisodeID", mock.Anything, episodeID, []model.AudioJobType(nil)).Return(nil, nil)
+		mockRepo.On("Create", mock.Anything, mock.MatchedBy(func(j *model.AudioJob) bool {
+			return j.JobType == model.AudioJobTypeRetag &&
+				j.Status == model.AudioJobStatusPending &&
+				j.UserID == userID &&
+				j.EpisodeID == episodeID
+		})).Run(func(args mock.Arguments) {
+			args.Get(1).(*model.AudioJob).ID = jobID
+		}).Return(nil)
+		mockTasks.On("EnqueueAudioJob", mock.Anything, jobID.String()).Return(nil)
+
+		svc := &audioJobService{audioJobRepo: mockRepo, tasksClient: mockTasks}
+		err := svc.CreateRetagJob(context.Background(), userID, episodeID)
+
+		assert.NoError(t, err)
+		mockRepo.AssertExpectations(t)
+		mockTasks.AssertExpectations(t)
+	})
+
+	t.Run("実行中のジョブがある場合はジョブを作成してエンキューせずに待機させる", func(t *testing.T) {
+		mockRepo := new(mockAudioJobRepository)
+		mockTasks := new(mockTasksClient)
+		mockRepo.On("FindUnstartedByEpisodeID", mock.Anything, episodeID, model.AudioJobTypeRetag).Return(nil, nil)
+		mockRepo.On("FindPendingByEpisodeID", mock.Anything, episodeID, []model.AudioJobType(nil)).Return(&model.AudioJob{ID: uuid.New(), Status: model.AudioJobStatusProcessing}, nil)
+		mockRepo.On("Create", mock.Anything, mock.MatchedBy(func(j *model.AudioJob) bool {
+			return j.JobType == model.AudioJobTypeRetag && j.Status == model.AudioJobStatusPending
+		})).Return(nil)
+
+		svc := &audioJobService{audioJobRepo: mockRepo, tasksClient: mockTasks}
+		err := svc.CreateRetagJob(context.Background(), userID, episodeID)
+
+		assert.NoError(t, err)
+		mockRepo.AssertExpectations(t)
+		mockTasks.AssertNotCalled(t, "EnqueueAudioJob", mock.Anything, mock.Anything)
+	})
+
+	t.Run("未着手の書き換えジョブがある場合はジョブを作成しない", func(t *testing.T) {
+		mockRepo := new(mockAudioJobRepository)
+		mockRepo.On("FindUnstartedByEpisodeID", mock.Anything, episodeID, model.AudioJobTypeRetag).Return(&model.AudioJob{ID: uuid.New(), Status: model.AudioJobStatusPending}, nil)
+
+		svc := &audioJobService{audioJobRepo: mockRepo}
+		err := svc.CreateRetagJob(context.Background(), userID, episodeID)
+
+		assert.NoError(t, err)
+		mockRepo.AssertNotCalled(t, "Create", mock.Anything, mock.Anything)
+	})
+}
+
+func TestAudioJobService_dispatchDeferredRetagJob(t *testing.T) {
+	episodeID := uuid.New()
+
+	t.Run("待機中の書き換えジョブをエンキューする", func(t *testing.T) {
+		mockRepo := new(mockAudioJobRepository)
+		mockTasks := new(mockTasksClient)
+		retagJob := &model.AudioJob{ID: uuid.New(), EpisodeID: episodeID, Status: model.AudioJobStatusPending, JobType: model.AudioJobTypeRetag}
+		mockRepo.On("FindUnstartedByEpisodeID", mock.Anything, episodeID, model.AudioJobTypeRetag).Return(retagJob, nil)
+		mockRepo.On("FindPendingByEpisodeID", mock.Anything, episodeID, retagBlockingAudioJobTypes).Return(nil, nil)
+		mockTasks.On("EnqueueAudioJob", mock.Anything, retagJob.ID.String()).Return(nil)
+
+		svc := &audioJobService{audioJobRepo: mockRepo, tasksClient: mockTasks}
+		svc.dispatchDeferredRetagJob(context.Background(), episodeID)
+
+		mockRepo.AssertExpectations(t)
+		mockTasks.AssertExpectations(t)
+		mockRepo.AssertNotCalled(t, "Create", mock.Anything, mock.Anything)
+	})
+
+	t.Run("他のジョブがまだ処理中の場合はエンキューしない", func(t *testing.T) {
+		mockRepo := new(mockAudioJobRepository)
+		mockTasks := new(mockTasksClient)
+		retagJob := &model.AudioJob{ID: uuid.New(), EpisodeID: episodeID, Status: model.AudioJobStatusPending, JobType: model.AudioJobTypeRetag}
+		mockRepo.On("FindUnstartedByEpisodeID", mock.Anything, episodeID, model.AudioJobTypeRetag).Return(retagJob, nil)
+		mockRepo.On("FindPendingByEpisodeID", mock.Anything, episodeID, retagBlockingAudioJobTypes).Return(&model.AudioJob{ID: uuid.New(), Status: model.AudioJobStatusProcessing}, nil)
+
+		svc := &audioJobService{audioJobRepo: mockRepo, tasksClient: mockTasks}
+		svc.dispatchDeferredRetagJob(context.Background(), episodeID)
+
+		mockTasks.AssertNotCalled(t, "EnqueueAudioJob", mock.Anything, mock.Anything)
+	})
+
+	t.Run("待機中の書き換えジョブがない場合は何もしない", func(t *testing.T) {
+		mockRepo := new(mockAudioJobRepository)
+		mockTasks := new(mockTasksClient)
+		mockRepo.On("FindUnstartedByEpisodeID", mock.Anything, episodeID, model.AudioJobTypeRetag).Return(nil, nil)
+
+		svc := &audioJobService{audioJobRepo: mockRepo, tasksClient: mockTasks}
+		svc.dispatchDeferredRetagJob(context.Background(), episodeID)
+
+		mockRepo.AssertNotCalled(t, "FindPendingByEpisodeID", mock.Anything, mock.Anything, mock.Anything)
+		mockTasks.AssertNotCalled(t, "EnqueueAudioJob", mock.Anything, mock.Anything)
+	})
+}
+
+func TestAudioJobService_ExecuteJob(t *testing.T) {
+	t.Run("ジョブの終了時に待機中の書き換えジョブをエンキューする", func(t *testing.T) {
+		mockRepo := new(mockAudioJobRepository)
+		mockTasks := new(mockTasksClient)
+		episodeID := uuid.New()
+		job := &model.AudioJob{ID: uuid.New(), EpisodeID: episodeID, Status: model.AudioJobStatusCompleted, JobType: model.AudioJobTypeFull}
+		retagJob := &model.AudioJob{ID: uuid.New(), EpisodeID: episodeID, Status: model.AudioJobStatusPending, JobType: model.AudioJobTypeRetag}
+		mockRepo.On("FindByID", mock.Anything, job.ID).Return(job, nil)
+		mockRepo.On("FindUnstartedByEpisodeID", mock.Anything, episodeID, model.AudioJobTypeRetag).Return(retagJob, nil)
+		mockRepo.On("FindPendingByEpisodeID", mock.Anything, episodeID, retagBlockingAudioJobTypes).Return(nil, nil)
+		mockTasks.On("EnqueueAudioJob", mock.Anything, retagJob.ID.String()).Return(nil)
+
+		svc := &audioJobService{audioJobRepo: mockRepo, tasksClient: mockTasks}
+		err := svc.ExecuteJob(context.Background(), job.ID.String())
+
+		assert.NoError(t, err)
+		mockTasks.AssertExpectations(t)
+	})
+}
+
 func TestAudioJobService_updateProgress(t *testing.T) {
 	jobID := uuid.New()
 	userID := uuid.New()
@@ -461,3 +665,447 @@ func TestAudioJobService_checkCanceled(t *testing.T) {
 		mockRepo.AssertExpectations(t)
 	})
 }
+
+func TestNewMixParams(t *testing.T) {
+	voice := strings.NewReader("voice")
+	bgm := strings.NewReader("bgm")
+
+	t.Run("ダッキング無効の場合は Ducking が nil になる", func(t *testing.T) {
+		job := &model.AudioJob{
+			BgmVolumeDB:    -25.0,
+			FadeOutMs:      3000,
+			PaddingStartMs: 1000,
+			PaddingEndMs:   3000,
+		}
+
+		params := newMixParams(job, voice, bgm, 5000)
+
+		assert.Equal(t, voice, params.Voice)
+		assert.Equal(t, bgm, params.BGM)
+		assert.Equal(t, 5000, params.VoiceDurationMs)
+		assert.Equal(t, -25.0, params.BGMVolumeDB)
+		assert.Equal(t, 3000, params.FadeOutMs)
+		assert.Equal(t, 1000, params.PaddingStartMs)
+		assert.Equal(t, 3000, params.PaddingEndMs)
+		assert.Nil(t, params.Ducking)
+	})
+
+	t.Run("ダッキング有効の場合はジョブのダッキング設定を引き継ぐ", func(t *testing.T) {
+		job := &model.AudioJob{
+			BgmVolumeDB:        -12.0,
+			FadeOutMs:          3000,
+			PaddingStartMs:     2000,
+			PaddingEndMs:       3000,
+			BgmDucking:         true,
+			DuckingThresholdDB: -35.0,
+			DuckingRatio:       6.0,
+			DuckingAttackMs:    30,
+			DuckingReleaseMs:   500,
+			IntroSwellMs:       1500,
+		}
+
+		params := newMixParams(job, voice, bgm, 5000)
+
+		assert.NotNil(t, params.Ducking)
+		assert.Equal(t, -35.0, params.Ducking.ThresholdDB)
+		assert.Equal(t, 6.0, params.Ducking.Ratio)
+		assert.Equal(t, 30, params.Ducking.AttackMs)
+		assert.Equal(t, 500, params.Ducking.ReleaseMs)
+		assert.Equal(t, 1500, params.Ducking.IntroSwellMs)
+	})
+}
+
+// stubDecodeFFmpegService は DecodeToPCM のみを差し替えた FFmpegService
+type stubDecodeFFmpegService struct {
+	FFmpegService
+	calls []string
+}
+
+func (s *stubDecodeFFmpegService) DecodeToPCM(ctx context.Context, r io.Reader, w io.Writer, format string, inputSampleRateHz, outputSampleRateHz int) error {
+	s.calls = append(s.calls, format)
+	_, err := w.Write([]byte("decoded"))
+	return err
+}
+
+func TestAudioJobService_normalizeReassemblyPCM(t *testing.T) {
+	ctx := context.Background()
+
+	t.Run("24kHz の PCM はそのまま書き出す", func(t *testing.T) {
+		ws, err := newAudioWorkspace(uuid.New())
+		require.NoError(t, err)
+		defer ws.Close()
+
+		ffmpeg := &stubDecodeFFmpegService{}
+		svc := &audioJobService{ffmpegService: ffmpeg}
+
+		path, err := svc.normalizeReassemblyPCM(ctx, ws, &tts.SynthesisResult{Data: []byte("pcm"), Format: "pcm", SampleRate: 24000}, "speaker.pcm")
+
+		require.NoError(t, err)
+		data, err := os.ReadFile(path)
+		require.NoError(t, err)
+		assert.Equal(t, []byte("pcm"), data)
+		assert.Empty(t, ffmpeg.calls)
+	})
+
+	t.Run("サンプルレートが異なる PCM はデコードする", func(t *testing.T) {
+		ws, err := newAudioWorkspace(uuid.New())
+		require.NoError(t, err)
+		defer ws.Close()
+
+		ffmpeg := &stubDecodeFFmpegService{}
+		svc := &audioJobService{ffmpegService: ffmpeg}
+
+		path, err := svc.normalizeReassemblyPCM(ctx, ws, &tts.SynthesisResult{Data: []byte("pcm"), Format: "pcm", SampleRate: 44100}, "speaker.pcm")
+
+		require.NoError(t, err)
+		data, err := os.ReadFile(path)
+		require.NoError(t, err)
+		assert.Equal(t, []byte("decoded"), data)
+		assert.Equal(t, []string{"pcm"}, ffmpeg.calls)
+	})
+
+	t.Run("MP3 はデコードする", func(t *testing.T) {
+		ws, err := newAudioWorkspace(uuid.New())
+		require.NoError(t, err)
+		defer ws.Close()
+
+		ffmpeg := &stubDecodeFFmpegService{}
+		svc := &audioJobService{ffmpegService: ffmpeg}
+
+		path, err := svc.normalizeReassemblyPCM(ctx, ws, &tts.SynthesisResult{Data: []byte("mp3"), Format: "mp3"}, "speaker.pcm")
+
+		require.NoError(t, err)
+		data, err := os.ReadFile(path)
+		require.NoError(t, err)
+		assert.Equal(t, []byte("decoded"), data)
+		assert.Equal(t, []string{"mp3"}, ffmpeg.calls)
+	})
+}
+
+func TestAudioJobService_writeReassembledPCM(t *testing.T) {
+	ctx := context.Background()
+
+	t.Run("セグメントを元の順序で無音パディングを挟んで連結する", func(t *testing.T) {
+		ws, err := newAudioWorkspace(uuid.New())
+		require.NoError(t, err)
+		defer ws.Close()
+
+		// 1 秒 = 48000 bytes（24kHz / 16bit / モノラル）
+		speaker1 := bytes.Repeat([]byte{1}, 96000)
+		speaker2 := bytes.Repeat([]byte{2}, 48000)
+		path1, err := ws.create("speaker_speaker1.pcm", func(w io.Writer) error {
+			_, err := w.Write(speaker1)
+			return err
+		})
+		require.NoError(t, err)
+		path2, err := ws.create("speaker_speaker2.pcm", func(w io.Writer) error {
+			_, err := w.Write(speaker2)
+			return err
+		})
+		require.NoError(t, err)
+
+		segments := []reassemblySegment{
+			{originalIndex: 0, path: path1, offset: 0, length: 48000},
+			{originalIndex: 1, path: path2, offset: 0, length: 48000},
+			{originalIndex: 2, path: path1, offset: 48000, length: 48000},
+		}
+		lineEdges := map[int]reassemblyLineEdges{
+			1: {leading: []script.MarkupSegment{{Kind: script.MarkupKindPause, Duration: 500 * time.Millisecond}}},
+		}
+		svc := &audioJobService{}
+
+		pcmPath, lineTimings, err := svc.writeReassembledPCM(ctx, ws, segments, lineEdges, nil, 3)
+
+		require.NoError(t, err)
+		assert.Equal(t, []reassemblyLineTiming{
+			{startMs: 0, endMs: 1000},
+			{startMs: 1200, endMs: 2700},
+			{startMs: 2900, endMs: 3900},
+		}, lineTimings)
+
+		data, err := os.ReadFile(pcmPath)
+		require.NoError(t, err)
+		// 3 秒分のセグメント + 500ms のポーズ + 200ms の無音パディング × 2
+		assert.Len(t, data, 48000*3+24000+9600*2)
+		assert.Equal(t, byte(1), data[0])
+		assert.Equal(t, byte(0), data[48000])
+		assert.Equal(t, byte(2), data[48000+9600+24000])
+		assert.Equal(t, byte(1), data[len(data)-1])
+	})
+
+	t.Run("長いエピソードでも PCM 全体をメモリに読み込まずに連結する", func(t *testing.T) {
+		ws, err := newAudioWorkspace(uuid.New())
+		require.NoError(t, err)
+		defer ws.Close()
+
+		// 話者ごとに 15 分（合計 30 分）の PCM
+		const speakerSize = reassemblyBytesPerSec * 15 * 60
+		const lineCount = 600
+		const segmentSize = speakerSize / (lineCount / 2)
+		var paths []string
+		for _, alias := range []string{"speaker1", "speaker2"} {
+			path, err := ws.create("speaker_"+alias+".pcm", func(w io.Writer) error {
+				_, err := io.CopyN(w, zeroReader{}, speakerSize)
+				return err
+			})
+			require.NoError(t, err)
+			paths = append(paths, path)
+		}
+
+		// 2 人の話者が交互に話す
+		segments := make([]reassemblySegment, lineCount)
+		for i := range segments {
+			segments[i] = reassemblySegment{
+				originalIndex: i,
+				path:          paths[i%2],
+				offset:        int64(i/2) * segmentSize,
+				length:        segmentSize,
+			}
+		}
+		svc := &audioJobService{}
+
+		var before, after runtime.MemStats
+		runtime.GC()
+		runtime.ReadMemStats(&before)
+
+		pcmPath, lineTimings, err := svc.writeReassembledPCM(ctx, ws, segments, nil, nil, lineCount)
+
+		runtime.ReadMemStats(&after)
+
+		require.NoError(t, err)
+		assert.Len(t, lineTimings, lineCount)
+		info, err := os.Stat(pcmPath)
+		require.NoError(t, err)
+		assert.Equal(t, int64(speakerSize*2+9600*(lineCount-1)), info.Size())
+		// コピー用のバッファ程度しか確保しないこと（連結結果のサイズの 1/10 未満）
+		assert.Less(t, after.TotalAlloc-before.TotalAlloc, uint64(info.Size()/10))
+	})
+}
+
+// pcmTTSClient は呼び出しごとに指定サイズの 24kHz PCM を返す tts.Client のスタブ
+type pcmTTSClient struct {
+	size int
+}
+
+func (c *pcmTTSClient) Synthesize(ctx context.Context, text string, emotion *string, voiceID string, gender model.Gender, settings *tts.VoiceSettings) (*tts.SynthesisResult, error) {
+	return &tts.SynthesisResult{Data: make([]byte, c.size), Format: "pcm", SampleRate: reassemblySampleRate}, nil
+}
+
+func (c *pcmTTSClient) SynthesizeMultiSpeaker(ctx context.Context, turns []tts.SpeakerTurn, voiceConfigs []tts.SpeakerVoiceConfig) (*tts.SynthesisResult, error) {
+	return nil, errors.New("not implemented")
+}
+
+// peakHeapSTTClient は呼び出し中のヒープ使用量と同時実行数の最大値を記録する stt.Client のスタブ
+type peakHeapSTTClient struct {
+	text string
+
+	mu          sync.Mutex
+	inFlight    int
+	maxInFlight int
+	peakHeap    uint64
+}
+
+func (c *peakHeapSTTClient) RecognizeWithTimestamps(ctx context.Context, pcmData []byte, sampleRate int) ([]stt.WordTimestamp, error) {
+	c.mu.Lock()
+	c.inFlight++
+	c.maxInFlight = max(c.maxInFlight, c.inFlight)
+	c.mu.Unlock()
+
+	// 他の話者のアライメントと重なるよう、認識に時間がかかる状態を再現する
+	time.Sleep(50 * time.Millisecond)
+
+	runtime.GC()
+	var stats runtime.MemStats
+	runtime.ReadMemStats(&stats)
+
+	c.mu.Lock()
+	c.peakHeap = max(c.peakHeap, stats.HeapAlloc)
+	c.inFlight--
+	c.mu.Unlock()
+
+	duration := time.Duration(len(pcmData)) * time.Second / reassemblyBytesPerSec
+	return []stt.WordTimestamp{
+		{Word: c.text, StartTime: 0, EndTime: duration - 2*time.Second},
+		{Word: reassemblyDummyTrailingText, StartTime: duration - 2*time.Second, EndTime: duration},
+	}, nil
+}
+
+func TestAudioJobService_synthesizeMultiSpeakerByReassembly(t *testing.T) {
+	ctx := context.Background()
+
+	t.Run("話者の多い長いエピソードでもアライメント中に保持する PCM は上限数の話者分に収まる", func(t *testing.T) {
+		// デバッグ用の WAV を書き出さない
+		t.Setenv("APP_ENV", "production")
+
+		ws, err := newAudioWorkspace(uuid.New())
+		require.NoError(t, err)
+		defer ws.Close()
+
+		// 話者 6 人がそれぞれ 5 分（24kHz / 16bit / モノラルで 14.4MB）話すエピソード
+		const speakerCount = 6
+		const speakerSize = 5 * 60 * reassemblyBytesPerSec
+		const text = "こんにちは。"
+
+		registry := tts.NewRegistry()
+		registry.Register(tts.ProviderGoogle, &pcmTTSClient{size: speakerSize})
+		sttClient := &peakHeapSTTClient{text: text}
+		svc := &audioJobService{ttsRegistry: registry, sttClient: sttClient}
+
+		turns := make([]tts.SpeakerTurn, speakerCount)
+		speakers := make(map[string]model.Character, speakerCount)
+		for i := range turns {
+			alias := fmt.Sprintf("speaker%d", i)
+			turns[i] = tts.SpeakerTurn{Speaker: alias, Text: text}
+			speakers[alias] = model.Character{Name: alias, Voice: model.Voice{Provider: string(tts.ProviderGoogle)}}
+		}
+
+		runtime.GC()
+		var before runtime.MemStats
+		runtime.ReadMemStats(&before)
+
+		pcmPath, lineTimings, _, err := svc.synthesizeMultiSpeakerByReassembly(ctx, &model.AudioJob{ID: uuid.New()}, ws, turns, speakers, nil, false)
+
+		require.NoError(t, err)
+		assert.Len(t, lineTimings, speakerCount)
+		_, err = os.Stat(pcmPath)
+		require.NoError(t, err)
+
+		peak := sttClient.peakHeap - min(sttClient.peakHeap, before.HeapAlloc)
+		t.Logf("peak heap during alignment: %.1f MB (%d speakers x %.1f MB, max in flight %d)", float64(peak)/(1<<20), speakerCount, float64(speakerSize)/(1<<20), sttClient.maxInFlight)
+		assert.LessOrEqual(t, sttClient.maxInFlight, reassemblyAlignmentConcurrency)
+		// 上限数の話者分に読み込み途中の 1 話者分の余裕を加えた値（制限しない場合は全話者分の約 82MB になる）
+		assert.Less(t, peak, uint64((reassemblyAlignmentConcurrency+2)*speakerSize))
+	})
+}
+
+func TestSpeakerProviders(t *testing.T) {
+	t.Run("話者のプロバイダを重複なくソートして返す", func(t *testing.T) {
+		speakers := map[string]model.Character{
+			"speaker1": {Voice: model.Voice{Provider: "google"}},
+			"speaker2": {Voice: model.Voice{Provider: "elevenlabs"}},
+			"speaker3": {Voice: model.Voice{Provider: "google"}},
+		}
+
+		assert.Equal(t, []tts.Provider{tts.ProviderElevenLabs, tts.ProviderGoogle}, speakerProviders(speakers))
+	})
+}
+
+func TestSfxCueStartMs(t *testing.T) {
+	timing := reassemblyLineTiming{startMs: 5000, endMs: 8000}
+
+	t.Run("before は台本行の開始時点で鳴り終わる位置から鳴らす", func(t *testing.T) {
+		assert.Equal(t, 3800, sfxCueStartMs(model.SfxCuePositionBefore, timing, 1200))
+	})
+
+	t.Run("before で音声の先頭より前にはみ出す場合は先頭から鳴らす", func(t *testing.T) {
+		assert.Equal(t, 0, sfxCueStartMs(model.SfxCuePositionBefore, reassemblyLineTiming{startMs: 0, endMs: 2000}, 1200))
+	})
+
+	t.Run("after は台本行の終了時点から鳴らす", func(t *testing.T) {
+		assert.Equal(t, 8000, sfxCueStartMs(model.SfxCuePositionAfter, timing, 1200))
+	})
+}
+
+func TestSegmentCrossfadeMs(t *testing.T) {
+	t.Run("セグメントより短いクロスフェードはそのまま使う", func(t *testing.T) {
+		assert.Equal(t, 500, segmentCrossfadeMs(500, 3000))
+	})
+
+	t.Run("セグメントより長いクロスフェードはセグメントの長さに丸める", func(t *testing.T) {
+		assert.Equal(t, 3000, segmentCrossfadeMs(5000, 3000))
+	})
+
+	t.Run("セグメントの長さが不明な場合は設定値を使う", func(t *testing.T) {
+		assert.Equal(t, 5000, segmentCrossfadeMs(5000, 0))
+	})
+}
+
+func TestVoiceOffsetMs(t *testing.T) {
+	job := &model.AudioJob{PaddingStartMs: 1000}
+
+	t.Run("イントロも BGM もない場合は 0", func(t *testing.T) {
+		assert.Equal(t, 0, voiceOffsetMs(job, &model.Channel{}, false))
+	})
+
+	t.Run("イントロはクロスフェードの分だけ重ねた長さをずらす", func(t *testing.T) {
+		channel := &model.Channel{IntroAudio: &model.Audio{DurationMs: 5000}, IntroCrossfadeMs: 800}
+		assert.Equal(t, 4200, voiceOffsetMs(job, channel, false))
+	})
+
+	t.Run("BGM をミキシングした場合は前余白を加える", func(t *testing.T) {
+		channel := &model.Channel{IntroAudio: &model.Audio{DurationMs: 5000}}
+		assert.Equal(t, 6000, voiceOffsetMs(job, channel, true))
+	})
+}
+
+func TestToAudioLineTimings(t *testing.T) {
+	lineIDs := []uuid.UUID{uuid.New(), uuid.New(), uuid.New()}
+
+	t.Run("台本行の再生区間を台本の順に記録する", func(t *testing.T) {
+		timings := toAudioLineTimings(lineIDs, []reassemblyLineTiming{
+			{startMs: 0, endMs: 1500},
+			{startMs: 1700, endMs: 1700},
+			{startMs: 1900, endMs: 4000},
+		})
+
+		assert.Equal(t, model.AudioLineTimings{
+			{ScriptLineID: lineIDs[0], StartMs: 0, EndMs: 1500},
+			{ScriptLineID: lineIDs[2], StartMs: 1900, EndMs: 4000},
+		}, timings)
+	})
+
+	t.Run("行ごとに合成しなかった場合は nil", func(t *testing.T) {
+		assert.Nil(t, toAudioLineTimings(lineIDs, nil))
+	})
+}
+
+func TestMergeSplitLineTimings(t *testing.T) {
+	lineIDs := []uuid.UUID{uuid.New(), uuid.New()}
+
+	t.Run("同じ台本行から分割したターンの再生区間をまとめる", func(t *testing.T) {
+		ids, timings := mergeSplitLineTimings(
+			[]uuid.UUID{lineIDs[0], lineIDs[0], lineIDs[1]},
+			[]reassemblyLineTiming{
+				{startMs: 0, endMs: 1500},
+				{startMs: 1700, endMs: 3000},
+				{startMs: 3200, endMs: 4000},
+			},
+		)
+
+		assert.Equal(t, lineIDs, ids)
+		assert.Equal(t, []reassemblyLineTiming{
+			{startMs: 0, endMs: 3000},
+			{startMs: 3200, endMs: 4000},
+		}, timings)
+	})
+
+	t.Run("行ごとに合成しなかった場合は台本行 ID のみまとめる", func(t *testing.T) {
+		ids, timings := mergeSplitLineTimings([]uuid.UUID{lineIDs[0], lineIDs[1], lineIDs[1]}, nil)
+
+		assert.Equal(t, lineIDs, ids)
+		assert.Nil(t, timings)
+	})
+}
+
+func TestAbsorbUnspokenDummyBoundary(t *testing.T) {
+	t.Run("ダミー行が短い場合は最後の実セグメントを末尾まで拡張する", func(t *testing.T) {
+		boundaries := []audio.LineBoundary{
+			{StartTime: 0, EndTime: 2000 * time.Millisecond},
+			{StartTime: 2000 * time.Millisecond, EndTime: 2500 * time.Millisecond},
+		}
+
+		assert.True(t, absorbUnspokenDummyBoundary(boundaries))
+		assert.Equal(t, 2500*time.Millisecond, boundaries[0].EndTime)
+		assert.Equal(t, 2500*time.Millisecond, boundaries[1].StartTime)
+	})
+
+	t.Run("ダミー行が読み上げられている場合は変更しない", func(t *testing.T) {
+		boundaries := []audio.LineBoundary{
+			{StartTime: 0, EndTime: 2000 * time.Millisecond},
+			{StartTime: 2000 * time.Millisecond, EndTime: 3200 * time.Millisecond},
+		}
+
+		assert.False(t, absorbUnspokenDummyBoundary(boundaries))
+		assert.Equal(t, 2000*time.Millisecond, boundaries[0].EndTime)
+	})
+}
@@ -0,0 +1,198 @@
+package service
+
+import (
+	"context"
+	"fmt"
+	"os"
+	"strings"
+	"time"
+
+	"github.com/siropaca/anycast-backend/internal/infrastructure/tts"
+	"github.com/siropaca/anycast-backend/internal/model"
+	"github.com/siropaca/anycast-backend/internal/pkg/audio"
+	"github.com/siropaca/anycast-backend/internal/pkg/logger"
+	"github.com/siropaca/anycast-backend/internal/pkg/uuid"
+)
+
+// reassemblyLineQA は台本行ごとの発音 QA の結果
+type reassemblyLineQA struct {
+	transcript string  // 採用したセグメントの STT 認識結果
+	similarity float64 // 台本テキストと認識結果の一致度（0〜1）
+	attempts   int     // 合成回数（初回の合成を含む）
+}
+
+// resynthesizeFailedLines は一致度が閾値未満の行を 1 行ずつ再合成し、セグメントを差し替える
+//
+// 行ごとに最大 job.QAMaxRetries 回まで再合成し、最も一致度の高い結果を採用する。
+// 再合成に失敗した行は元のセグメントのまま残し、QA レポートで報告する
+func (s *audioJobService) resynthesizeFailedLines(
+	ctx context.Context,
+	job *model.AudioJob,
+	ws *audioWorkspace,
+	speakerGroups map[string]*reassemblySpeakerGroup,
+	segments []reassemblySegment,
+	lineQA []reassemblyLineQA,
+) error {
+	log := logger.FromContext(ctx)
+
+	if job.QAMaxRetries <= 0 {
+		return nil
+	}
+
+	// 元のインデックスから話者グループ内の位置を引けるようにする
+	type lineRef struct {
+		group *reassemblySpeakerGroup
+		index int
+	}
+	refs := make(map[int]lineRef)
+	for _, group := range speakerGroups {
+		for j, idx := range group.originalIndices {
+			refs[idx] = lineRef{group: group, index: j}
+		}
+	}
+
+	progressReported := false
+	for i := range segments {
+		idx := segments[i].originalIndex
+		if lineQA[idx].similarity >= job.QASimilarityThreshold {
+			continue
+		}
+
+		if !progressReported {
+			s.updateProgress(ctx, job, 40, "発音をチェック中...")
+			progressReported = true
+		}
+
+		// キャンセルチェック（再合成前）
+		if err := s.checkCanceled(ctx, job); err != nil {
+			return err
+		}
+
+		ref := refs[idx]
+		for attempt := 1; attempt <= job.QAMaxRetries; attempt++ {
+			seg, transcript, similarity, err := s.resynthesizeReassemblyLine(ctx, ws, ref.group, ref.index, fmt.Sprintf("resynth_%d_%d.pcm", idx, attempt))
+			if err != nil {
+				log.Warn("pronunciation QA: failed to resynthesize line",
+					"line", idx,
+					"attempt", attempt,
+					"error", err,
+				)
+				break
+			}
+
+			lineQA[idx].attempts++
+			log.Debug("pronunciation QA: resynthesized line",
+				"line", idx,
+				"attempt", attempt,
+				"similarity", similarity,
+				"prev_similarity", lineQA[idx].similarity,
+			)
+
+			if similarity > lineQA[idx].similarity {
+				segments[i].path = seg.path
+				segments[i].offset = seg.offset
+				segments[i].length = seg.length
+				lineQA[idx].transcript = transcript
+				lineQA[idx].similarity = similarity
+			} else {
+				// 採用しなかった再合成結果は不要なので削除する
+				os.Remove(seg.path)
+			}
+			if lineQA[idx].similarity >= job.QASimilarityThreshold {
+				break
+			}
+		}
+	}
+
+	return nil
+}
+
+// resynthesizeReassemblyLine は話者グループ内の 1 行だけを再合成し、その行のセグメントと認識結果・一致度を返す
+//
+// 通常の合成と同様にダミー末尾行を付けて合成し、STT アライメントでダミー行を切り離す。
+// 再合成した PCM は作業ディレクトリの name に書き出し、セグメントはそのファイル内の範囲として返す
+func (s *audioJobService) resynthesizeReassemblyLine(ctx context.Context, ws *audioWorkspace, group *reassemblySpeakerGroup, index int, name string) (reassemblySegment, string, float64, error) {
+	provider := tts.Provider(group.voice.Provider)
+	ttsClient, err := s.ttsRegistry.Get(provider)
+	if err != nil {
+		return reassemblySegment{}, "", 0, fmt.Errorf("TTS プロバイダ %q が利用できません: %w", provider, err)
+	}
+
+	texts := []string{group.texts[index], reassemblyDummyTrailingText}
+	spokenTexts := []string{group.spokenTexts[index], reassemblyDummyTrailingText}
+
+	fullText := strings.Join(texts, "\n")
+	if provider == tts.ProviderGoogle {
+		fullText = tts.DefaultVoiceStyle + "\n\n" + fullText
+	}
+
+	result, err := ttsClient.Synthesize(ctx, fullText, nil, group.voice.ProviderVoiceID, group.voice.Gender, toTTSVoiceSettings(group.voiceSettings))
+	if err != nil {
+		return reassemblySegment{}, "", 0, fmt.Errorf("再合成に失敗しました: %w", err)
+	}
+
+	pcmPath, err := s.normalizeReassemblyPCM(ctx, ws, result, name)
+	if err != nil {
+		return reassemblySegment{}, "", 0, fmt.Errorf("音声の正規化に失敗しました: %w", err)
+	}
+
+	pcmData, err := os.ReadFile(pcmPath)
+	if err != nil {
+		return reassemblySegment{}, "", 0, fmt.Errorf("音声の読み込みに失敗しました: %w", err)
+	}
+
+	sttWords, err := s.sttClient.RecognizeWithTimestamps(ctx, pcmData, reassemblySampleRate)
+	if err != nil {
+		return reassemblySegment{}, "", 0, fmt.Errorf("音声認識に失敗しました: %w", err)
+	}
+	audioWords := toAudioWordTimestamps(sttWords)
+
+	boundaries, err := audio.AlignTextToTimestamps(spokenTexts, audioWords)
+	if err != nil {
+		return reassemblySegment{}, "", 0, fmt.Errorf("テキストアライメントに失敗しました: %w", err)
+	}
+
+	transcript := audio.TranscribeLines(audioWords, boundaries)[0]
+	similarity := audio.TextSimilarity(spokenTexts[0], transcript)
+
+	pcmDuration := time.Duration(float64(len(pcmData)) / float64(reassemblyBytesPerSec) * float64(time.Second))
+	boundaries[0].StartTime = 0
+	boundaries[len(boundaries)-1].EndTime = pcmDuration
+	absorbUnspokenDummyBoundary(boundaries)
+
+	segment := audio.PCMRangesByTimestamps(len(pcmData), boundaries, reassemblySampleRate, reassemblyChannels, reassemblyBytesPerSample)[0]
+
+	return reassemblySegment{
+		path:   pcmPath,
+		offset: int64(segment.Offset),
+		length: int64(segment.Length),
+	}, transcript, similarity, nil
+}
+
+// newAudioQAReport は行ごとの発音 QA の結果からジョブに保存する QA レポートを構築する
+func newAudioQAReport(threshold float64, turns []tts.SpeakerTurn, lineIDs []uuid.UUID, lineQA []reassemblyLineQA) *model.AudioQAReport {
+	report := &model.AudioQAReport{
+		Threshold:    threshold,
+		CheckedLines: len(lineQA),
+		FlaggedLines: []model.AudioQAFlaggedLine{},
+	}
+
+	for i, qa := range lineQA {
+		if qa.attempts > 1 {
+			report.ResynthesizedLines++
+		}
+		if qa.similarity >= threshold {
+			continue
+		}
+
+		report.FlaggedLines = append(report.FlaggedLines, model.AudioQAFlaggedLine{
+			ScriptLineID: lineIDs[i],
+			Text:         turns[i].OriginalText,
+			Transcript:   qa.transcript,
+			Similarity:   qa.similarity,
+			Attempts:     qa.attempts,
+		})
+	}
+
+	return report
+}
@@ -0,0 +1,49 @@
+package service
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+
+	"github.com/siropaca/anycast-backend/internal/infrastructure/tts"
+	"github.com/siropaca/anycast-backend/internal/pkg/uuid"
+)
+
+func TestNewAudioQAReport(t *testing.T) {
+	lineIDs := []uuid.UUID{uuid.New(), uuid.New(), uuid.New()}
+	turns := []tts.SpeakerTurn{
+		{Speaker: "speaker1", Text: "こんにちは。", OriginalText: "こんにちは。"},
+		{Speaker: "speaker2", Text: "えーあいの話です。", OriginalText: "AIの話です。"},
+		{Speaker: "speaker1", Text: "なるほど。", OriginalText: "なるほど。"},
+	}
+
+	t.Run("再合成した行と閾値に届かなかった行を集計する", func(t *testing.T) {
+		report := newAudioQAReport(0.6, turns, lineIDs, []reassemblyLineQA{
+			{transcript: "こんにちは", similarity: 1, attempts: 1},
+			{transcript: "映画の話です", similarity: 0.4, attempts: 3},
+			{transcript: "なるほど", similarity: 0.9, attempts: 2},
+		})
+
+		assert.Equal(t, 0.6, report.Threshold)
+		assert.Equal(t, 3, report.CheckedLines)
+		assert.Equal(t, 2, report.ResynthesizedLines)
+		assert.Len(t, report.FlaggedLines, 1)
+		assert.Equal(t, lineIDs[1], report.FlaggedLines[0].ScriptLineID)
+		assert.Equal(t, "AIの話です。", report.FlaggedLines[0].Text)
+		assert.Equal(t, "映画の話です", report.FlaggedLines[0].Transcript)
+		assert.Equal(t, 0.4, report.FlaggedLines[0].Similarity)
+		assert.Equal(t, 3, report.FlaggedLines[0].Attempts)
+	})
+
+	t.Run("すべての行が閾値以上の場合は空のリストを返す", func(t *testing.T) {
+		report := newAudioQAReport(0.6, turns, lineIDs, []reassemblyLineQA{
+			{similarity: 1, attempts: 1},
+			{similarity: 0.8, attempts: 1},
+			{similarity: 0.6, attempts: 1},
+		})
+
+		assert.Equal(t, 0, report.ResynthesizedLines)
+		assert.NotNil(t, report.FlaggedLines)
+		assert.Empty(t, report.FlaggedLines)
+	})
+}
@@ -0,0 +1,261 @@
+package service
+
+import (
+	"context"
+	"fmt"
+	"io"
+	"os"
+	"path"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/siropaca/anycast-backend/internal/apperror"
+	"github.com/siropaca/anycast-backend/internal/dto/response"
+	"github.com/siropaca/anycast-backend/internal/infrastructure/storage"
+	"github.com/siropaca/anycast-backend/internal/model"
+	"github.com/siropaca/anycast-backend/internal/pkg/audio"
+	"github.com/siropaca/anycast-backend/internal/pkg/logger"
+	"github.com/siropaca/anycast-backend/internal/pkg/uuid"
+)
+
+// renditionSpecsDisabled は配信用音声の生成を無効化する設定値
+const renditionSpecsDisabled = "none"
+
+// RenditionSpec は生成する配信用音声のフォーマットとビットレートを表す
+type RenditionSpec struct {
+	Format      model.AudioFormat
+	BitrateKbps int
+}
+
+// ParseRenditionSpecs は "format:bitrateKbps" 形式の設定値を RenditionSpec のスライスに変換する
+//
+// "none" のみが指定された場合は空のスライスを返し、配信用音声を生成しない
+func ParseRenditionSpecs(values []string) ([]RenditionSpec, error) {
+	if len(values) == 1 && strings.EqualFold(values[0], renditionSpecsDisabled) {
+		return []RenditionSpec{}, nil
+	}
+
+	specs := make([]RenditionSpec, 0, len(values))
+	seen := make(map[RenditionSpec]bool, len(values))
+
+	for _, v := range values {
+		formatStr, bitrateStr, ok := strings.Cut(v, ":")
+		if !ok {
+			return nil, fmt.Errorf("invalid rendition spec %q: expected format:bitrateKbps", v)
+		}
+
+		format := model.AudioFormat(strings.ToLower(strings.TrimSpace(formatStr)))
+		if !format.IsValid() {
+			return nil, fmt.Errorf("invalid rendition spec %q: unsupported format", v)
+		}
+
+		bitrate, err := strconv.Atoi(strings.TrimSpace(bitrateStr))
+		if err != nil || bitrate < 8 || bitrate > 320 {
+			return nil, fmt.Errorf("invalid rendition spec %q: bitrate must be between 8 and 320", v)
+		}
+
+		spec := RenditionSpec{Format: format, BitrateKbps: bitrate}
+		if seen[spec] {
+			continue
+		}
+		seen[spec] = true
+		specs = append(specs, spec)
+	}
+
+	return specs, nil
+}
+
+// generateRenditions は最終音声のファイルから配信用音声を生成してアップロードし、Audio レコードを作成する
+//
+// 配信用音声はあくまで補助的なものなので、個別の変換・保存に失敗した場合はログを出してスキップする。
+// id3Tag が指定された場合は MP3 の配信用音声にも同じタグを埋め込む
+func (s *audioJobService) generateRenditions(ctx context.Context, ws *audioWorkspace, sourcePath string, durationMs int, id3Tag *audio.ID3Tag) []model.EpisodeAudioRendition {
+	log := logger.FromContext(ctx)
+
+	renditions := make([]model.EpisodeAudioRendition, 0, len(s.renditionSpecs))
+
+	for _, spec := range s.renditionSpecs {
+		audioRecord, err := s.createRenditionAudio(ctx, ws, sourcePath, durationMs, spec, id3Tag)
+		if err != nil {
+			log.Warn("failed to generate audio rendition, skipping", "format", spec.Format, "bitrate_kbps", spec.BitrateKbps, "error", err)
+			continue
+		}
+
+		renditions = append(renditions, model.EpisodeAudioRendition{
+			AudioID:     audioRecord.ID,
+			Format:      spec.Format,
+			BitrateKbps: spec.BitrateKbps,
+			Audio:       *audioRecord,
+		})
+	}
+
+	return renditions
+}
+
+// executeRenditionsInternal はエピソードの現在の結合済み音声から配信用音声を生成し直す
+//
+// 音声をアップロードした場合など、音声生成ジョブを経ずに結合済み音声が差し替わった場合に実行する
+func (s *audioJobService) executeRenditionsInternal(ctx context.Context, job *model.AudioJob) error {
+	log := logger.FromContext(ctx)
+
+	episode, err := s.episodeRepo.FindByID(ctx, job.EpisodeID)
+	if err != nil {
+		return err
+	}
+
+	if episode.FullAudio == nil {
+		return apperror.ErrValidation.WithMessage("音声がありません")
+	}
+	fullAudio := episode.FullAudio
+
+	// 進捗: 10%
+	s.updateProgress(ctx, job, 10, "音声を読み込み中...")
+
+	ws, err := newAudioWorkspace(job.ID)
+	if err != nil {
+		return apperror.ErrInternal.WithMessage("作業ディレクトリの作成に失敗しました").WithError(err)
+	}
+	defer ws.Close()
+
+	sourcePath, err := ws.download(ctx, s.storageClient, fullAudio.Path, "source"+path.Ext(fullAudio.Path))
+	if err != nil {
+		log.Error("failed to download full audio", "error", err, "path", fullAudio.Path)
+		return apperror.ErrInternal.WithMessage("音声のダウンロードに失敗しました").WithError(err)
+	}
+
+	// キャンセルチェック（変換前）
+	if err := s.checkCanceled(ctx, job); err != nil {
+		return err
+	}
+
+	// MP3 の配信用音声には ID3 タグを埋め込む（組み立てに失敗した場合はタグなし）
+	id3Tag, err := s.id3Tagger.buildTag(ctx, episode)
+	if err != nil {
+		log.Warn("failed to build ID3 tag, skipping", "error", err, "episode_id", episode.ID)
+		id3Tag = nil
+	}
+
+	// 進捗: 30%
+	s.updateProgress(ctx, job, 30, "配信用フォーマットを生成中...")
+
+	renditions := s.generateRenditions(ctx, ws, sourcePath, fullAudio.DurationMs, id3Tag)
+
+	// キャンセルチェック（置き換え前）
+	if err := s.checkCanceled(ctx, job); err != nil {
+		return err
+	}
+
+	// 変換中に音声が差し替わった場合は古い音声から生成した配信用音声を使わない
+	latest, err := s.episodeRepo.FindByID(ctx, job.EpisodeID)
+	if err != nil {
+		return err
+	}
+	if latest.FullAudioID == nil || *latest.FullAudioID != fullAudio.ID {
+		return apperror.ErrValidation.WithMessage("配信用音声の生成中に音声が差し替えられました")
+	}
+
+	// 進捗: 95%
+	s.updateProgress(ctx, job, 95, "エピソードを更新中...")
+
+	if err := s.renditionRepo.ReplaceByEpisodeID(ctx, episode.ID, renditions); err != nil {
+		return err
+	}
+
+	// ジョブを完了状態に更新
+	completedAt := time.Now().UTC()
+	job.Status = model.AudioJobStatusCompleted
+	job.Progress = 100
+	job.CompletedAt = &completedAt
+	job.ResultAudioID = &fullAudio.ID
+
+	if err := s.audioJobRepo.Update(ctx, job); err != nil {
+		return err
+	}
+
+	// WebSocket で完了通知
+	s.notifyCompleted(job.ID.String(), job.UserID.String(), fullAudio)
+
+	log.Info("rendition job completed successfully", "job_id", job.ID, "episode_id", episode.ID, "renditions", len(renditions))
+
+	return nil
+}
+
+// createRenditionAudio は 1 つの配信用音声を変換・アップロードして Audio レコードを作成する
+//
+// 変換結果は作業ディレクトリの一時ファイルに書き出し、アップロード後に削除する
+func (s *audioJobService) createRenditionAudio(ctx context.Context, ws *audioWorkspace, sourcePath string, durationMs int, spec RenditionSpec, id3Tag *audio.ID3Tag) (*model.Audio, error) {
+	ext := spec.Format.Extension()
+	name := fmt.Sprintf("rendition_%s_%dk%s", spec.Format, spec.BitrateKbps, ext)
+
+	localPath, err := ws.create(name, func(w io.Writer) error {
+		return withFile(sourcePath, func(f *os.File) error {
+			return s.ffmpegService.TranscodeAudio(ctx, f, w, spec.Format, spec.BitrateKbps)
+		})
+	})
+	if err != nil {
+		return nil, err
+	}
+	defer os.Remove(localPath)
+
+	// 変換時にアートワークは引き継がれないため、MP3 はタグを埋め込み直す
+	if spec.Format == model.AudioFormatMP3 && id3Tag != nil {
+		localPath, err = writeID3File(ws, localPath, "tagged_"+name, *id3Tag)
+		if err != nil {
+			return nil, err
+		}
+		defer os.Remove(localPath)
+	}
+
+	audioID := uuid.New()
+	path := storage.GenerateAudioPathWithExt(audioID.String(), ext)
+
+	fileSize, err := uploadFile(ctx, s.storageClient, localPath, path, spec.Format.MimeType())
+	if err != nil {
+		return nil, apperror.ErrInternal.WithMessage("配信用音声のアップロードに失敗しました").WithError(err)
+	}
+
+	audioRecord := &model.Audio{
+		ID:         audioID,
+		MimeType:   spec.Format.MimeType(),
+		Path:       path,
+		Filename:   fmt.Sprintf("%s_%dk%s", audioID.String(), spec.BitrateKbps, ext),
+		FileSize:   fileSize,
+		DurationMs: durationMs,
+	}
+
+	if err := s.audioRepo.Create(ctx, audioRecord); err != nil {
+		if deleteErr := s.storageClient.Delete(ctx, path); deleteErr != nil {
+			logger.FromContext(ctx).Warn("failed to cleanup uploaded rendition", "error", deleteErr, "path", path)
+		}
+		return nil, err
+	}
+
+	return audioRecord, nil
+}
+
+// toAudioRenditionResponses は配信用音声をレスポンス DTO に変換する
+func toAudioRenditionResponses(ctx context.Context, storageClient storage.Client, renditions []model.EpisodeAudioRendition) ([]response.AudioRenditionResponse, error) {
+	result := make([]response.AudioRenditionResponse, 0, len(renditions))
+
+	for _, r := range renditions {
+		signedURL, err := storageClient.GenerateSignedURL(ctx, r.Audio.Path, storage.SignedURLExpirationAudio)
+		if err != nil {
+			return nil, err
+		}
+
+		result = append(result, response.AudioRenditionResponse{
+			Format:      string(r.Format),
+			BitrateKbps: r.BitrateKbps,
+			Audio: response.AudioResponse{
+				ID:         r.Audio.ID,
+				URL:        signedURL,
+				MimeType:   r.Audio.MimeType,
+				FileSize:   r.Audio.FileSize,
+				DurationMs: r.Audio.DurationMs,
+			},
+		})
+	}
+
+	return result, nil
+}
@@ -0,0 +1,122 @@
+package service
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+
+	"github.com/siropaca/anycast-backend/internal/infrastructure/storage"
+	"github.com/siropaca/anycast-backend/internal/model"
+	"github.com/siropaca/anycast-backend/internal/pkg/uuid"
+)
+
+func TestParseRenditionSpecs(t *testing.T) {
+	t.Run("format:bitrate 形式の設定を解析できる", func(t *testing.T) {
+		specs, err := ParseRenditionSpecs([]string{"mp3:128", "AAC:64", " opus : 32 "})
+
+		assert.NoError(t, err)
+		assert.Equal(t, []RenditionSpec{
+			{Format: model.AudioFormatMP3, BitrateKbps: 128},
+			{Format: model.AudioFormatAAC, BitrateKbps: 64},
+			{Format: model.AudioFormatOpus, BitrateKbps: 32},
+		}, specs)
+	})
+
+	t.Run("none を指定した場合は空のスライスを返す", func(t *testing.T) {
+		specs, err := ParseRenditionSpecs([]string{"none"})
+
+		assert.NoError(t, err)
+		assert.Empty(t, specs)
+		assert.NotNil(t, specs)
+	})
+
+	t.Run("重複した設定は 1 つにまとめる", func(t *testing.T) {
+		specs, err := ParseRenditionSpecs([]string{"mp3:128", "mp3:128", "mp3:64"})
+
+		assert.NoError(t, err)
+		assert.Equal(t, []RenditionSpec{
+			{Format: model.AudioFormatMP3, BitrateKbps: 128},
+			{Format: model.AudioFormatMP3, BitrateKbps: 64},
+		}, specs)
+	})
+
+	t.Run("区切り文字がない場合はエラーを返す", func(t *testing.T) {
+		_, err := ParseRenditionSpecs([]string{"mp3"})
+
+		assert.Error(t, err)
+	})
+
+	t.Run("未対応のフォーマットの場合はエラーを返す", func(t *testing.T) {
+		_, err := ParseRenditionSpecs([]string{"flac:128"})
+
+		assert.Error(t, err)
+	})
+
+	t.Run("ビットレートが範囲外の場合はエラーを返す", func(t *testing.T) {
+		_, err := ParseRenditionSpecs([]string{"mp3:0"})
+		assert.Error(t, err)
+
+		_, err = ParseRenditionSpecs([]string{"mp3:512"})
+		assert.Error(t, err)
+
+		_, err = ParseRenditionSpecs([]string{"mp3:abc"})
+		assert.Error(t, err)
+	})
+}
+
+func TestToAudioRenditionResponses(t *testing.T) {
+	ctx := context.Background()
+
+	t.Run("配信用音声を署名付き URL 付きのレスポンスに変換する", func(t *testing.T) {
+		mockStorage := new(mockStorageClient)
+		audioID := uuid.New()
+		renditions := []model.EpisodeAudioRendition{
+			{
+				AudioID:     audioID,
+				Format:      model.AudioFormatAAC,
+				BitrateKbps: 64,
+				Audio: model.Audio{
+					ID:         audioID,
+					MimeType:   "audio/mp4",
+					Path:       "audios/" + audioID.String() + ".m4a",
+					FileSize:   1024,
+					DurationMs: 60000,
+				},
+			},
+		}
+		mockStorage.On("GenerateSignedURL", mock.Anything, "audios/"+audioID.String()+".m4a", storage.SignedURLExpirationAudio).Return("https://example.com/signed", nil)
+
+		result, err := toAudioRenditionResponses(ctx, mockStorage, renditions)
+
+		assert.NoError(t, err)
+		assert.Len(t, result, 1)
+		assert.Equal(t, "aac", result[0].Format)
+		assert.Equal(t, 64, result[0].BitrateKbps)
+		assert.Equal(t, audioID, result[0].Audio.ID)
+		assert.Equal(t, "https://example.com/signed", result[0].Audio.URL)
+		assert.Equal(t, "audio/mp4", result[0].Audio.MimeType)
+		mockStorage.AssertExpectations(t)
+	})
+
+	t.Run("配信用音声がない場合は空のスライスを返す", func(t *testing.T) {
+		result, err := toAudioRenditionResponses(ctx, new(mockStorageClient), nil)
+
+		assert.NoError(t, err)
+		assert.NotNil(t, result)
+		assert.Empty(t, result)
+	})
+
+	t.Run("署名付き URL の生成に失敗した場合はエラーを返す", func(t *testing.T) {
+		mockStorage := new(mockStorageClient)
+		renditions := []model.EpisodeAudioRendition{{Format: model.AudioFormatMP3, BitrateKbps: 128}}
+		mockStorage.On("GenerateSignedURL", mock.Anything, mock.Anything, mock.Anything).Return("", errors.New("sign error"))
+
+		result, err := toAudioRenditionResponses(ctx, mockStorage, renditions)
+
+		assert.Error(t, err)
+		assert.Nil(t, result)
+	})
+}
@@ -31,6 +31,11 @@ func (m *mockAudioRepository) Create(ctx context.Context, audio *model.Audio) er
 	return args.Error(0)
 }
 
+func (m *mockAudioRepository) Update(ctx context.Context, audio *model.Audio) error {
+	args := m.Called(ctx, audio)
+	return args.Error(0)
+}
+
 func (m *mockAudioRepository) Delete(ctx context.Context, id uuid.UUID) error {
 	args := m.Called(ctx, id)
 	return args.Error(0)
@@ -44,6 +49,14 @@ func (m *mockAudioRepository) FindOrphaned(ctx context.Context) ([]model.Audio,
 	return args.Get(0).([]model.Audio), args.Error(1)
 }
 
+func (m *mockAudioRepository) FindAll(ctx context.Context) ([]model.Audio, error) {
+	args := m.Called(ctx)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).([]model.Audio), args.Error(1)
+}
+
 func TestUploadAudio(t *testing.T) {
 	ctx := context.Background()
 
@@ -51,7 +64,7 @@ func TestUploadAudio(t *testing.T) {
 		mockRepo := new(mockAudioRepository)
 		mockStorage := new(mockStorageClient)
 
-		svc := NewAudioService(mockRepo, mockStorage)
+		svc := NewAudioService(mockRepo, mockStorage, nil)
 
 		input := UploadAudioInput{
 			File:        bytes.NewReader([]byte("fake audio data")),
@@ -60,7 +73,7 @@ func TestUploadAudio(t *testing.T) {
 			FileSize:    100,
 		}
 
-		mockStorage.On("Upload", mock.Anything, mock.Anything, mock.Anything, "audio/mpeg").Return("", nil)
+		mockStorage.On("UploadStream", mock.Anything, []byte("fake audio data"), mock.Anything, "audio/mpeg").Return("", nil)
 		mockRepo.On("Create", mock.Anything, mock.AnythingOfType("*model.Audio")).Return(nil)
 		mockStorage.On("GenerateSignedURL", mock.Anything, mock.Anything, mock.Anything).Return("https://signed-url.example.com/audio.mp3", nil)
 
@@ -80,7 +93,7 @@ func TestUploadAudio(t *testing.T) {
 		mockRepo := new(mockAudioRepository)
 		mockStorage := new(mockStorageClient)
 
-		svc := NewAudioService(mockRepo, mockStorage)
+		svc := NewAudioService(mockRepo, mockStorage, nil)
 
 		input := UploadAudioInput{
 			File:        bytes.NewReader([]byte("fake wav data")),
@@ -89,7 +102,7 @@ func TestUploadAudio(t *testing.T) {
 			FileSize:    200,
 		}
 
-		mockStorage.On("Upload", mock.Anything, mock.Anything, mock.Anything, "audio/wav").Return("", nil)
+		mockStorage.On("UploadStream", mock.Anything, mock.Anything, mock.Anything, "audio/wav").Return("", nil)
 		mockRepo.On("Create", mock.Anything, mock.AnythingOfType("*model.Audio")).Return(nil)
 		mockStorage.On("GenerateSignedURL", mock.Anything, mock.Anything, mock.Anything).Return("https://signed-url.example.com/audio.wav", nil)
 
@@ -104,7 +117,7 @@ func TestUploadAudio(t *testing.T) {
 		mockRepo := new(mockAudioRepository)
 		mockStorage := new(mockStorageClient)
 
-		svc := NewAudioService(mockRepo, mockStorage)
+		svc := NewAudioService(mockRepo, mockStorage, nil)
 
 		input := UploadAudioInput{
 			File:        bytes.NewReader([]byte("invalid data")),
@@ -124,7 +137,7 @@ func TestUploadAudio(t *testing.T) {
 		mockRepo := new(mockAudioRepository)
 		mockStorage := new(mockStorageClient)
 
-		svc := NewAudioService(mockRepo, mockStorage)
+		svc := NewAudioService(mockRepo, mockStorage, nil)
 
 		input := UploadAudioInput{
 			File:        bytes.NewReader([]byte("fake audio data")),
@@ -133,7 +146,7 @@ func TestUploadAudio(t *testing.T) {
 			FileSize:    100,
 		}
 
-		mockStorage.On("Upload", mock.Anything, mock.Anything, mock.Anything, "audio/mpeg").Return("", errors.New("upload failed"))
+		mockStorage.On("UploadStream", mock.Anything, mock.Anything, mock.Anything, "audio/mpeg").Return("", errors.New("upload failed"))
 
 		result, err := svc.UploadAudio(ctx, input)
 
@@ -146,7 +159,7 @@ func TestUploadAudio(t *testing.T) {
 		mockRepo := new(mockAudioRepository)
 		mockStorage := new(mockStorageClient)
 
-		svc := NewAudioService(mockRepo, mockStorage)
+		svc := NewAudioService(mockRepo, mockStorage, nil)
 
 		input := UploadAudioInput{
 			File:        bytes.NewReader([]byte("fake audio data")),
@@ -155,7 +168,7 @@ func TestUploadAudio(t *testing.T) {
 			FileSize:    100,
 		}
 
-		mockStorage.On("Upload", mock.Anything, mock.Anything, mock.Anything, "audio/mpeg").Return("", nil)
+		mockStorage.On("UploadStream", mock.Anything, mock.Anything, mock.Anything, "audio/mpeg").Return("", nil)
 		mockRepo.On("Create", mock.Anything, mock.AnythingOfType("*model.Audio")).Return(apperror.ErrInternal)
 		mockStorage.On("Delete", mock.Anything, mock.Anything).Return(nil)
 
@@ -170,7 +183,7 @@ func TestUploadAudio(t *testing.T) {
 		mockRepo := new(mockAudioRepository)
 		mockStorage := new(mockStorageClient)
 
-		svc := NewAudioService(mockRepo, mockStorage)
+		svc := NewAudioService(mockRepo, mockStorage, nil)
 
 		input := UploadAudioInput{
 			File:        bytes.NewReader([]byte("fake audio data")),
@@ -179,7 +192,7 @@ func TestUploadAudio(t *testing.T) {
 			FileSize:    100,
 		}
 
-		mockStorage.On("Upload", mock.Anything, mock.Anything, mock.Anything, "audio/mpeg").Return("", nil)
+		mockStorage.On("UploadStream", mock.Anything, mock.Anything, mock.Anything, "audio/mpeg").Return("", nil)
 		mockRepo.On("Create", mock.Anything, mock.AnythingOfType("*model.Audio")).Return(nil)
 		mockStorage.On("GenerateSignedURL", mock.Anything, mock.Anything, mock.Anything).Return("", errors.New("signed url failed"))
 
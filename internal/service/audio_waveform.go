@@ -0,0 +1,114 @@
+package service
+
+import (
+	"context"
+	"io"
+	"os"
+
+	"github.com/siropaca/anycast-backend/internal/dto/response"
+	"github.com/siropaca/anycast-backend/internal/model"
+	"github.com/siropaca/anycast-backend/internal/pkg/audio"
+	"github.com/siropaca/anycast-backend/internal/pkg/logger"
+)
+
+// 波形ピークデータの生成設定
+const (
+	waveformSampleRateHz = 16000 // デコード時のサンプルレート
+	waveformBlockSize    = 160   // 集計ブロックのサンプル数（10ms）
+	waveformVersion      = 2     // audiowaveform JSON のバージョン
+	waveformBits         = 8     // ピーク値の量子化ビット数
+)
+
+// waveformLengths は生成する波形の最大ピーク数（一覧のサムネイル / プレイヤー / エディタ）
+var waveformLengths = []int{100, 400, 1600}
+
+// generateWaveforms は音声データから解像度別の波形ピークデータを生成する
+//
+// 波形は表示用の付加情報のため、生成に失敗した場合はログを出して nil を返す
+func generateWaveforms(ctx context.Context, ffmpegService FFmpegService, r io.Reader) model.AudioWaveforms {
+	if ffmpegService == nil {
+		return nil
+	}
+
+	peaks, err := ffmpegService.ExtractPeaks(ctx, r, waveformSampleRateHz, waveformBlockSize)
+	if err != nil {
+		logger.FromContext(ctx).Warn("failed to generate waveform, skipping", "error", err)
+		return nil
+	}
+
+	return buildAudioWaveforms(peaks)
+}
+
+// generateFileWaveforms は音声ファイルから解像度別の波形ピークデータを生成する
+//
+// 生成に失敗した場合は generateWaveforms と同様に nil を返す
+func generateFileWaveforms(ctx context.Context, ffmpegService FFmpegService, path string) model.AudioWaveforms {
+	var waveforms model.AudioWaveforms
+	err := withFile(path, func(f *os.File) error {
+		waveforms = generateWaveforms(ctx, ffmpegService, f)
+		return nil
+	})
+	if err != nil {
+		logger.FromContext(ctx).Warn("failed to open audio file for waveform, skipping", "error", err)
+	}
+	return waveforms
+}
+
+// buildAudioWaveforms はブロック単位のピークから audiowaveform 互換の波形を解像度別に生成する
+func buildAudioWaveforms(peaks *audio.PeakBlocks) model.AudioWaveforms {
+	waveforms := make(model.AudioWaveforms, 0, len(waveformLengths))
+
+	for _, maxLength := range waveformLengths {
+		samplesPerPixel, mins, maxs := peaks.Downsample(maxLength)
+
+		data := make([]int, 0, len(mins)*2)
+		for i := range mins {
+			data = append(data, int(mins[i]), int(maxs[i]))
+		}
+
+		waveforms = append(waveforms, model.AudioWaveform{
+			Version:         waveformVersion,
+			Channels:        1,
+			SampleRate:      peaks.SampleRate,
+			SamplesPerPixel: samplesPerPixel,
+			Bits:            waveformBits,
+			Length:          len(mins),
+			Data:            data,
+		})
+	}
+
+	return waveforms
+}
+
+// toAudioWaveformResponse は最も粗い解像度の波形ピークデータをレスポンス DTO に変換する
+//
+// 一覧などのレスポンスが大きくならないよう、音声のレスポンスにはサムネイル用の解像度のみを含める
+func toAudioWaveformResponse(waveforms model.AudioWaveforms) *response.AudioWaveformResponse {
+	if len(waveforms) == 0 {
+		return nil
+	}
+
+	return &toAudioWaveformResponses(waveforms[:1])[0]
+}
+
+// toAudioWaveformResponses は波形ピークデータをレスポンス DTO に変換する
+func toAudioWaveformResponses(waveforms model.AudioWaveforms) []response.AudioWaveformResponse {
+	if len(waveforms) == 0 {
+		return nil
+	}
+
+	result := make([]response.AudioWaveformResponse, 0, len(waveforms))
+	for _, w := range waveforms {
+		result = append(result, response.AudioWaveformResponse{
+			Version:         w.Version,
+			Channels:        w.Channels,
+			SampleRate:      w.SampleRate,
+			SamplesPerPixel: w.SamplesPerPixel,
+			Bits:            w.Bits,
+			Length:          w.Length,
+			Data:            w.Data,
+		})
+	}
+
+	return result
+}
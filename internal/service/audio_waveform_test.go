@@ -0,0 +1,120 @@
+package service
+
+import (
+	"bytes"
+	"context"
+	"io"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/siropaca/anycast-backend/internal/model"
+	"github.com/siropaca/anycast-backend/internal/pkg/audio"
+)
+
+// stubPeaksFFmpegService は ExtractPeaks のみを差し替えた FFmpegService
+type stubPeaksFFmpegService struct {
+	FFmpegService
+	peaks *audio.PeakBlocks
+	err   error
+}
+
+func (s *stubPeaksFFmpegService) ExtractPeaks(ctx context.Context, r io.Reader, sampleRateHz, blockSize int) (*audio.PeakBlocks, error) {
+	return s.peaks, s.err
+}
+
+func TestBuildAudioWaveforms(t *testing.T) {
+	t.Run("解像度ごとに audiowaveform 形式の波形を生成する", func(t *testing.T) {
+		peaks := &audio.PeakBlocks{
+			SampleRate: 16000,
+			BlockSize:  160,
+			Min:        make([]int16, 1000),
+			Max:        make([]int16, 1000),
+		}
+		peaks.Min[0] = -32768
+		peaks.Max[0] = 32767
+
+		result := buildAudioWaveforms(peaks)
+
+		require.Len(t, result, len(waveformLengths))
+
+		assert.Equal(t, 2, result[0].Version)
+		assert.Equal(t, 1, result[0].Channels)
+		assert.Equal(t, 16000, result[0].SampleRate)
+		assert.Equal(t, 8, result[0].Bits)
+		assert.Equal(t, 1600, result[0].SamplesPerPixel)
+		assert.Equal(t, 100, result[0].Length)
+		assert.Len(t, result[0].Data, 200)
+		assert.Equal(t, []int{-128, 127}, result[0].Data[:2])
+
+		// ブロック数が最大長以下の場合はブロック単位のまま
+		assert.Equal(t, 160, result[2].SamplesPerPixel)
+		assert.Equal(t, 1000, result[2].Length)
+	})
+}
+
+func TestGenerateWaveforms(t *testing.T) {
+	ctx := context.Background()
+
+	t.Run("ピークの抽出に失敗した場合は nil を返す", func(t *testing.T) {
+		ffmpeg := &stubPeaksFFmpegService{err: assert.AnError}
+
+		assert.Nil(t, generateWaveforms(ctx, ffmpeg, bytes.NewReader([]byte("audio"))))
+	})
+
+	t.Run("FFmpegService がない場合は nil を返す", func(t *testing.T) {
+		assert.Nil(t, generateWaveforms(ctx, nil, bytes.NewReader([]byte("audio"))))
+	})
+
+	t.Run("抽出したピークから波形を生成する", func(t *testing.T) {
+		ffmpeg := &stubPeaksFFmpegService{peaks: &audio.PeakBlocks{
+			SampleRate: 16000,
+			BlockSize:  160,
+			Min:        []int16{-256},
+			Max:        []int16{512},
+		}}
+
+		result := generateWaveforms(ctx, ffmpeg, bytes.NewReader([]byte("audio")))
+
+		require.Len(t, result, len(waveformLengths))
+		assert.Equal(t, []int{-1, 2}, result[0].Data)
+	})
+}
+
+func TestToAudioWaveformResponses(t *testing.T) {
+	t.Run("未生成の場合は nil を返す", func(t *testing.T) {
+		assert.Nil(t, toAudioWaveformResponses(nil))
+	})
+
+	t.Run("波形をレスポンスに変換する", func(t *testing.T) {
+		waveforms := model.AudioWaveforms{
+			{Version: 2, Channels: 1, SampleRate: 16000, SamplesPerPixel: 160, Bits: 8, Length: 1, Data: []int{-1, 2}},
+		}
+
+		result := toAudioWaveformResponses(waveforms)
+
+		require.Len(t, result, 1)
+		assert.Equal(t, 160, result[0].SamplesPerPixel)
+		assert.Equal(t, []int{-1, 2}, result[0].Data)
+	})
+}
+
+func TestToAudioWaveformResponse(t *testing.T) {
+	t.Run("未生成の場合は nil を返す", func(t *testing.T) {
+		assert.Nil(t, toAudioWaveformResponse(nil))
+	})
+
+	t.Run("最も粗い解像度のみを返す", func(t *testing.T) {
+		waveforms := model.AudioWaveforms{
+			{Version: 2, Channels: 1, SampleRate: 16000, SamplesPerPixel: 640, Bits: 8, Length: 1, Data: []int{-1, 2}},
+			{Version: 2, Channels: 1, SampleRate: 16000, SamplesPerPixel: 160, Bits: 8, Length: 4, Data: []int{-1, 2, -1, 2, -1, 2, -1, 2}},
+		}
+
+		result := toAudioWaveformResponse(waveforms)
+
+		require.NotNil(t, result)
+		assert.Equal(t, 640, result.SamplesPerPixel)
+		assert.Equal(t, []int{-1, 2}, result.Data)
+	})
+}
@@ -0,0 +1,124 @@
+package service
+
+import (
+	"context"
+	"io"
+	"os"
+	"path/filepath"
+
+	"github.com/siropaca/anycast-backend/internal/infrastructure/storage"
+	"github.com/siropaca/anycast-backend/internal/pkg/uuid"
+)
+
+// audioWorkspace は音声生成ジョブの中間ファイルを置く一時ディレクトリを表す
+//
+// 音声を []byte で受け渡すと、長いエピソードでは PCM や MP3 のコピーがいくつもメモリに残るため、
+// ジョブの各工程（変換・効果音・イントロ/アウトロ・BGM・ID3 タグ・アップロード）はこのディレクトリのファイルを介して受け渡す
+type audioWorkspace struct {
+	dir string
+}
+
+// newAudioWorkspace はジョブ（またはエピソード）用の一時ディレクトリを作成する
+func newAudioWorkspace(id uuid.UUID) (*audioWorkspace, error) {
+	dir, err := os.MkdirTemp("", "audio-workspace-"+id.String()+"-*")
+	if err != nil {
+		return nil, err
+	}
+	return &audioWorkspace{dir: dir}, nil
+}
+
+// path は一時ディレクトリ内のファイルパスを返す
+func (w *audioWorkspace) path(name string) string {
+	return filepath.Join(w.dir, name)
+}
+
+// create は name のファイルを作成して write で内容を書き込み、そのパスを返す
+//
+// write が失敗した場合は作成途中のファイルを削除する
+func (w *audioWorkspace) create(name string, write func(io.Writer) error) (string, error) {
+	path := w.path(name)
+
+	f, err := os.Create(path)
+	if err != nil {
+		return "", err
+	}
+
+	if err := write(f); err != nil {
+		f.Close()
+		os.Remove(path)
+		return "", err
+	}
+
+	if err := f.Close(); err != nil {
+		os.Remove(path)
+		return "", err
+	}
+
+	return path, nil
+}
+
+// download はストレージのファイルを name のファイルにダウンロードし、そのパスを返す
+func (w *audioWorkspace) download(ctx context.Context, client storage.Client, storagePath, name string) (string, error) {
+	return w.create(name, func(dst io.Writer) error {
+		_, err := client.DownloadStream(ctx, storagePath, dst)
+		return err
+	})
+}
+
+// Close は一時ディレクトリを中身ごと削除する
+func (w *audioWorkspace) Close() error {
+	return os.RemoveAll(w.dir)
+}
+
+// withFile はファイルを開いて fn に渡し、終了後に閉じる
+func withFile(path string, fn func(*os.File) error) error {
+	f, err := os.Open(path)
+	if err != nil {
+		return err
+	}
+	defer f.Close()
+
+	return fn(f)
+}
+
+// uploadFile はローカルファイルをストレージにストリームでアップロードし、ファイルサイズを返す
+func uploadFile(ctx context.Context, client storage.Client, localPath, path, contentType string) (int, error) {
+	var size int64
+	err := withFile(localPath, func(f *os.File) error {
+		info, err := f.Stat()
+		if err != nil {
+			return err
+		}
+		size = info.Size()
+
+		_, err = client.UploadStream(ctx, f, path, contentType)
+		return err
+	})
+	if err != nil {
+		return 0, err
+	}
+
+	return int(size), nil
+}
+
+// openedFiles は複数の入力ファイルを開いたまま FFmpeg に渡すためのリスト
+//
+// Close でまとめて閉じる
+type openedFiles []*os.File
+
+// open はファイルを開いてリストに追加する
+func (o *openedFiles) open(path string) (*os.File, error) {
+	f, err := os.Open(path)
+	if err != nil {
+		return nil, err
+	}
+	*o = append(*o, f)
+	return f, nil
+}
+
+// Close は開いたファイルをすべて閉じる
+func (o *openedFiles) Close() {
+	for _, f := range *o {
+		f.Close()
+	}
+}
@@ -0,0 +1,119 @@
+package service
+
+import (
+	"context"
+	"io"
+	"os"
+	"runtime"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+
+	"github.com/siropaca/anycast-backend/internal/pkg/uuid"
+)
+
+// discardStorageClient は UploadStream の内容を読み捨てるストレージクライアント
+type discardStorageClient struct {
+	mockStorageClient
+	uploaded int64
+}
+
+func (c *discardStorageClient) UploadStream(ctx context.Context, r io.Reader, path, contentType string) (string, error) {
+	n, err := io.Copy(io.Discard, r)
+	c.uploaded += n
+	return path, err
+}
+
+func TestAudioWorkspace(t *testing.T) {
+	t.Run("create で書き込んだファイルのパスを返し、Close でディレクトリごと削除する", func(t *testing.T) {
+		ws, err := newAudioWorkspace(uuid.New())
+		require.NoError(t, err)
+
+		path, err := ws.create("voice.mp3", func(w io.Writer) error {
+			_, err := w.Write([]byte("voice"))
+			return err
+		})
+		require.NoError(t, err)
+
+		data, err := os.ReadFile(path)
+		require.NoError(t, err)
+		assert.Equal(t, []byte("voice"), data)
+
+		require.NoError(t, ws.Close())
+		_, err = os.Stat(ws.dir)
+		assert.True(t, os.IsNotExist(err))
+	})
+
+	t.Run("create の書き込みが失敗した場合は作成途中のファイルを削除する", func(t *testing.T) {
+		ws, err := newAudioWorkspace(uuid.New())
+		require.NoError(t, err)
+		defer ws.Close()
+
+		_, err = ws.create("voice.mp3", func(w io.Writer) error {
+			return io.ErrUnexpectedEOF
+		})
+
+		assert.ErrorIs(t, err, io.ErrUnexpectedEOF)
+		_, statErr := os.Stat(ws.path("voice.mp3"))
+		assert.True(t, os.IsNotExist(statErr))
+	})
+
+	t.Run("download はストレージのファイルを書き出す", func(t *testing.T) {
+		ws, err := newAudioWorkspace(uuid.New())
+		require.NoError(t, err)
+		defer ws.Close()
+
+		client := new(mockStorageClient)
+		client.On("DownloadStream", mock.Anything, "audios/bgm.mp3").Return([]byte("bgm"), nil)
+
+		path, err := ws.download(context.Background(), client, "audios/bgm.mp3", "bgm.mp3")
+		require.NoError(t, err)
+
+		data, err := os.ReadFile(path)
+		require.NoError(t, err)
+		assert.Equal(t, []byte("bgm"), data)
+		client.AssertExpectations(t)
+	})
+}
+
+func TestUploadFile(t *testing.T) {
+	t.Run("ファイル全体をメモリに読み込まずにアップロードする", func(t *testing.T) {
+		ws, err := newAudioWorkspace(uuid.New())
+		require.NoError(t, err)
+		defer ws.Close()
+
+		// 30 分の 192kbps MP3 に相当するサイズ
+		const fileSize = 192 * 1000 / 8 * 30 * 60
+		path, err := ws.create("final.mp3", func(w io.Writer) error {
+			_, err := io.CopyN(w, zeroReader{}, fileSize)
+			return err
+		})
+		require.NoError(t, err)
+
+		client := &discardStorageClient{}
+
+		var before, after runtime.MemStats
+		runtime.GC()
+		runtime.ReadMemStats(&before)
+
+		size, err := uploadFile(context.Background(), client, path, "audios/final.mp3", "audio/mpeg")
+
+		runtime.ReadMemStats(&after)
+
+		require.NoError(t, err)
+		assert.Equal(t, fileSize, size)
+		assert.Equal(t, int64(fileSize), client.uploaded)
+		// コピー用のバッファ程度しか確保しないこと（ファイルサイズの 1/10 未満）
+		assert.Less(t, after.TotalAlloc-before.TotalAlloc, uint64(fileSize/10))
+	})
+}
+
+// zeroReader は 0 を無限に返す Reader
+type zeroReader struct{}
+
+func (zeroReader) Read(p []byte) (int, error) {
+	clear(p)
+	return len(p), nil
+}
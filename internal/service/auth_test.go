@@ -3,6 +3,7 @@ package service
 import (
 	"context"
 	"errors"
+	"io"
 	"regexp"
 	"testing"
 	"time"
@@ -181,6 +182,11 @@ func (m *mockImageRepositoryForAuth) FindOrphaned(ctx context.Context) ([]model.
 	return args.Get(0).([]model.Image), args.Error(1)
 }
 
+func (m *mockImageRepositoryForAuth) FindAll(ctx context.Context) ([]model.Image, error) {
+	args := m.Called(ctx)
+	return args.Get(0).([]model.Image), args.Error(1)
+}
+
 type mockAudioJobRepositoryForAuth struct {
 	mock.Mock
 }
@@ -203,8 +209,16 @@ func (m *mockAudioJobRepositoryForAuth) FindByEpisodeID(ctx context.Context, epi
 	return args.Get(0).([]model.AudioJob), args.Error(1)
 }
 
-func (m *mockAudioJobRepositoryForAuth) FindPendingByEpisodeID(ctx context.Context, episodeID uuid.UUID) (*model.AudioJob, error) {
-	args := m.Called(ctx, episodeID)
+func (m *mockAudioJobRepositoryForAuth) FindPendingByEpisodeID(ctx context.Context, episodeID uuid.UUID, jobTypes []model.AudioJobType) (*model.AudioJob, error) {
+	args := m.Called(ctx, episodeID, jobTypes)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).(*model.AudioJob), args.Error(1)
+}
+
+func (m *mockAudioJobRepositoryForAuth) FindUnstartedByEpisodeID(ctx context.Context, episodeID uuid.UUID, jobType model.AudioJobType) (*model.AudioJob, error) {
+	args := m.Called(ctx, episodeID, jobType)
 	if args.Get(0) == nil {
 		return nil, args.Error(1)
 	}
@@ -308,6 +322,35 @@ func (m *mockStorageClientForAuth) Upload(ctx context.Context, data []byte, path
 	return args.String(0), args.Error(1)
 }
 
+func (m *mockStorageClientForAuth) UploadStream(ctx context.Context, r io.Reader, path, contentType string) (string, error) {
+	data, err := io.ReadAll(r)
+	if err != nil {
+		return "", err
+	}
+	args := m.Called(ctx, data, path, contentType)
+	return args.String(0), args.Error(1)
+}
+
+func (m *mockStorageClientForAuth) DownloadStream(ctx context.Context, path string, w io.Writer) (int64, error) {
+	args := m.Called(ctx, path)
+	if args.Get(0) == nil {
+		return 0, args.Error(1)
+	}
+	n, err := w.Write(args.Get(0).([]byte))
+	if err != nil {
+		return int64(n), err
+	}
+	return int64(n), args.Error(1)
+}
+
+func (m *mockStorageClientForAuth) OpenRange(ctx context.Context, path string, offset, length int64) (io.ReadCloser, error) {
+	args := m.Called(ctx, path, offset, length)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).(io.ReadCloser), args.Error(1)
+}
+
 func (m *mockStorageClientForAuth) GenerateSignedURL(ctx context.Context, path string, expiration time.Duration) (string, error) {
 	args := m.Called(ctx, path, expiration)
 	return args.String(0), args.Error(1)
@@ -318,6 +361,11 @@ func (m *mockStorageClientForAuth) Delete(ctx context.Context, path string) erro
 	return args.Error(0)
 }
 
+func (m *mockStorageClientForAuth) Exists(ctx context.Context, path string) (bool, error) {
+	args := m.Called(ctx, path)
+	return args.Bool(0), args.Error(1)
+}
+
 func (m *mockStorageClientForAuth) Close() error {
 	args := m.Called()
 	return args.Error(0)
@@ -283,6 +283,7 @@ func (s *bgmService) toAudioResponse(ctx context.Context, audio model.Audio) (re
 		ID:         audio.ID,
 		URL:        url,
 		DurationMs: audio.DurationMs,
+		Waveform:   toAudioWaveformResponse(audio.Waveforms),
 	}, nil
 }
 
@@ -85,6 +85,14 @@ func (m *mockSystemBgmRepository) CountActive(ctx context.Context) (int64, error
 	return args.Get(0).(int64), args.Error(1)
 }
 
+func (m *mockSystemBgmRepository) FindActiveByNames(ctx context.Context, names []string) ([]model.SystemBgm, error) {
+	args := m.Called(ctx, names)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).([]model.SystemBgm), args.Error(1)
+}
+
 func TestListMyBgms(t *testing.T) {
 	ctx := context.Background()
 	userID := uuid.New()
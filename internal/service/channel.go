@@ -11,6 +11,7 @@ import (
 	"github.com/siropaca/anycast-backend/internal/dto/request"
 	"github.com/siropaca/anycast-backend/internal/dto/response"
 	"github.com/siropaca/anycast-backend/internal/infrastructure/storage"
+	"github.com/siropaca/anycast-backend/internal/infrastructure/tts"
 	"github.com/siropaca/anycast-backend/internal/model"
 	"github.com/siropaca/anycast-backend/internal/pkg/logger"
 	"github.com/siropaca/anycast-backend/internal/pkg/uuid"
@@ -38,6 +39,10 @@ type ChannelService interface {
 	SetUserPrompt(ctx context.Context, userID, channelID string, req request.SetUserPromptRequest) (*response.ChannelDataResponse, error)
 	SetDefaultBgm(ctx context.Context, userID, channelID string, req request.SetDefaultBgmRequest) (*response.ChannelDataResponse, error)
 	DeleteDefaultBgm(ctx context.Context, userID, channelID string) (*response.ChannelDataResponse, error)
+	SetIntro(ctx context.Context, userID, channelID string, req request.SetChannelSegmentRequest) (*response.ChannelDataResponse, error)
+	DeleteIntro(ctx context.Context, userID, channelID string) (*response.ChannelDataResponse, error)
+	SetOutro(ctx context.Context, userID, channelID string, req request.SetChannelSegmentRequest) (*response.ChannelDataResponse, error)
+	DeleteOutro(ctx context.Context, userID, channelID string) (*response.ChannelDataResponse, error)
 	AddChannelCharacter(ctx context.Context, userID, channelID string, req request.AddChannelCharacterRequest) (*response.ChannelDataResponse, error)
 	ReplaceChannelCharacter(ctx context.Context, userID, channelID, characterID string, req request.ReplaceChannelCharacterRequest) (*response.ChannelDataResponse, error)
 	RemoveChannelCharacter(ctx context.Context, userID, channelID, characterID string) (*response.ChannelDataResponse, error)
@@ -55,7 +60,11 @@ type channelService struct {
 	bgmRepo             repository.BgmRepository
 	systemBgmRepo       repository.SystemBgmRepository
 	playbackHistoryRepo repository.PlaybackHistoryRepository
+	audioRepo           repository.AudioRepository
+	pronunciationRepo   repository.PronunciationRepository
 	storageClient       storage.Client
+	ffmpegService       FFmpegService
+	ttsPreviewer        *ttsPreviewer
 }
 
 // NewChannelService は channelService を生成して ChannelService として返す
@@ -71,7 +80,11 @@ func NewChannelService(
 	bgmRepo repository.BgmRepository,
 	systemBgmRepo repository.SystemBgmRepository,
 	playbackHistoryRepo repository.PlaybackHistoryRepository,
+	audioRepo repository.AudioRepository,
+	pronunciationRepo repository.PronunciationRepository,
 	storageClient storage.Client,
+	ttsRegistry *tts.Registry,
+	ffmpegService FFmpegService,
 ) ChannelService {
 	return &channelService{
 		db:                  db,
@@ -85,7 +98,15 @@ func NewChannelService(
 		bgmRepo:             bgmRepo,
 		systemBgmRepo:       systemBgmRepo,
 		playbackHistoryRepo: playbackHistoryRepo,
+		audioRepo:           audioRepo,
+		pronunciationRepo:   pronunciationRepo,
 		storageClient:       storageClient,
+		ffmpegService:       ffmpegService,
+		ttsPreviewer: &ttsPreviewer{
+			storageClient: storageClient,
+			ttsRegistry:   ttsRegistry,
+			ffmpegService: ffmpegService,
+		},
 	}
 }
 
@@ -240,6 +261,7 @@ func (s *channelService) CreateChannel(ctx context.Context, userID string, req r
 			Description: req.Description,
 			CategoryID:  categoryID,
 			ArtworkID:   artworkID,
+			Explicit:    req.Explicit,
 		}
 
 		// チャンネルを保存
@@ -300,6 +322,9 @@ func (s *channelService) UpdateChannel(ctx context.Context, userID, channelID st
 	// 各フィールドを更新
 	channel.Name = req.Name
 	channel.Description = req.Description
+	if req.Explicit != nil {
+		channel.Explicit = *req.Explicit
+	}
 
 	// カテゴリの更新
 	categoryID, err := uuid.Parse(req.CategoryID)
@@ -393,6 +418,12 @@ func (s *channelService) DeleteChannel(ctx context.Context, userID, channelID st
 		if episode.FullAudio != nil {
 			filesToDelete = append(filesToDelete, episode.FullAudio.Path)
 		}
+		for _, r := range episode.AudioRenditions {
+			filesToDelete = append(filesToDelete, r.Audio.Path)
+		}
+		if episode.HLSPackage != nil {
+			filesToDelete = append(filesToDelete, episode.HLSPackage.FilePaths()...)
+		}
 		if episode.Bgm != nil && episode.Bgm.Audio.ID != uuid.Nil {
 			filesToDelete = append(filesToDelete, episode.Bgm.Audio.Path)
 		}
@@ -1204,6 +1235,7 @@ func (s *channelService) toChannelResponse(ctx context.Context, c *model.Channel
 			SortOrder: c.Category.SortOrder,
 			IsActive:  c.Category.IsActive,
 		},
+		Explicit:    c.Explicit,
 		Characters:  s.toCharacterResponsesFromChannelCharacters(ctx, c.ChannelCharacters),
 		Episodes:    episodeResponses,
 		PublishedAt: c.PublishedAt,
@@ -1261,6 +1293,14 @@ func (s *channelService) toChannelResponse(ctx context.Context, c *model.Channel
 		}
 	}
 
+	// イントロ・アウトロのレスポンス生成
+	if resp.Intro, err = s.toChannelSegmentResponse(ctx, c.IntroAudio, c.IntroCrossfadeMs); err != nil {
+		return response.ChannelResponse{}, err
+	}
+	if resp.Outro, err = s.toChannelSegmentResponse(ctx, c.OutroAudio, c.OutroCrossfadeMs); err != nil {
+		return response.ChannelResponse{}, err
+	}
+
 	return resp, nil
 }
 
@@ -1381,9 +1421,17 @@ func (s *channelService) toEpisodeResponse(ctx context.Context, e *model.Episode
 			MimeType:   e.FullAudio.MimeType,
 			FileSize:   e.FullAudio.FileSize,
 			DurationMs: e.FullAudio.DurationMs,
+			Waveform:   toAudioWaveformResponse(e.FullAudio.Waveforms),
 		}
 	}
 
+	renditions, err := toAudioRenditionResponses(ctx, s.storageClient, e.AudioRenditions)
+	if err != nil {
+		return response.EpisodeResponse{}, err
+	}
+	resp.Renditions = renditions
+	resp.HLS = toEpisodeHLSResponse(e)
+
 	// Bgm または SystemBgm からレスポンスを構築
 	if e.Bgm != nil && e.Bgm.Audio.ID != uuid.Nil {
 		signedURL, err := s.storageClient.GenerateSignedURL(ctx, e.Bgm.Audio.Path, storage.SignedURLExpirationAudio)
@@ -0,0 +1,1500 @@
+package service
+
+import (
+	"archive/zip"
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"path"
+	"time"
+
+	"gorm.io/gorm"
+
+	"github.com/siropaca/anycast-backend/internal/apperror"
+	"github.com/siropaca/anycast-backend/internal/dto/response"
+	"github.com/siropaca/anycast-backend/internal/infrastructure/storage"
+	"github.com/siropaca/anycast-backend/internal/model"
+	"github.com/siropaca/anycast-backend/internal/pkg/logger"
+	"github.com/siropaca/anycast-backend/internal/pkg/uuid"
+	"github.com/siropaca/anycast-backend/internal/repository"
+)
+
+const (
+	// channelArchiveFormatVersion はチャンネルアーカイブの形式のバージョン
+	//
+	// channel.json の構成を変えた場合は上げる（キャッシュのキーに含めるため、古い形式のキャッシュは使われなくなる）。
+	// 取り込みはこのバージョン以下のアーカイブのみ受け付ける
+	channelArchiveFormatVersion = 1
+	// channelArchiveMimeType はチャンネルアーカイブの MIME タイプ
+	channelArchiveMimeType = "application/zip"
+	// channelArchiveManifestFile はチャンネルアーカイブ内のマニフェストのファイル名
+	channelArchiveManifestFile = "channel.json"
+	// channelArchiveMaxManifestSize はマニフェストの最大サイズ（20MB）
+	channelArchiveMaxManifestSize = 20 << 20
+	// MaxChannelArchiveSize は取り込めるチャンネルアーカイブの最大サイズ（2GB）
+	MaxChannelArchiveSize = 2 << 30
+	// channelArchiveMaxUncompressedSize はチャンネルアーカイブ内のファイルの展開後の合計サイズの上限（4GB）
+	//
+	// 音声・画像はほとんど圧縮されないため、正常なアーカイブは ZIP のサイズと同程度になる。
+	// 展開すると巨大になる ZIP（ZIP 爆弾）を取り込まないようにする
+	channelArchiveMaxUncompressedSize = 4 << 30
+)
+
+// チャンネルアーカイブの取り込み時の競合の種類
+const (
+	channelArchiveConflictCategory          = "category"
+	channelArchiveConflictVoice             = "voice"
+	channelArchiveConflictCharacterName     = "characterName"
+	channelArchiveConflictBgmName           = "bgmName"
+	channelArchiveConflictSoundEffectName   = "soundEffectName"
+	channelArchiveConflictSystemBgm         = "systemBgm"
+	channelArchiveConflictSystemSoundEffect = "systemSoundEffect"
+)
+
+// チャンネルアーカイブの取り込み時の競合の解決方法
+const (
+	// channelArchiveResolutionBlocked は取り込みを中止したことを表す
+	channelArchiveResolutionBlocked = "blocked"
+	// channelArchiveResolutionRenamed は名前を変えて取り込んだことを表す
+	channelArchiveResolutionRenamed = "renamed"
+	// channelArchiveResolutionDropped は設定を外して取り込んだことを表す
+	channelArchiveResolutionDropped = "dropped"
+)
+
+// ChannelArchiveService はチャンネルのエクスポート・インポート（バックアップ・アカウント間の移行）に関するビジネスロジックインターフェースを表す
+type ChannelArchiveService interface {
+	ExportChannel(ctx context.Context, userID, channelID string) (*response.ChannelArchiveDataResponse, error)
+	ImportChannel(ctx context.Context, userID string, input ImportChannelArchiveInput) (*response.ChannelArchiveImportDataResponse, error)
+}
+
+// ImportChannelArchiveInput はチャンネルアーカイブの取り込みの入力を表す
+type ImportChannelArchiveInput struct {
+	File   io.ReaderAt
+	Size   int64
+	DryRun bool
+}
+
+type channelArchiveService struct {
+	db                    *gorm.DB
+	channelRepo           repository.ChannelRepository
+	characterRepo         repository.CharacterRepository
+	episodeRepo           repository.EpisodeRepository
+	scriptLineRepo        repository.ScriptLineRepository
+	sfxCueRepo            repository.SfxCueRepository
+	pronunciationRepo     repository.PronunciationRepository
+	categoryRepo          repository.CategoryRepository
+	voiceRepo             repository.VoiceRepository
+	bgmRepo               repository.BgmRepository
+	systemBgmRepo         repository.SystemBgmRepository
+	soundEffectRepo       repository.SoundEffectRepository
+	systemSoundEffectRepo repository.SystemSoundEffectRepository
+	storageClient         storage.Client
+}
+
+// NewChannelArchiveService は channelArchiveService を生成して ChannelArchiveService として返す
+func NewChannelArchiveService(
+	db *gorm.DB,
+	channelRepo repository.ChannelRepository,
+	characterRepo repository.CharacterRepository,
+	episodeRepo repository.EpisodeRepository,
+	scriptLineRepo repository.ScriptLineRepository,
+	sfxCueRepo repository.SfxCueRepository,
+	pronunciationRepo repository.PronunciationRepository,
+	categoryRepo repository.CategoryRepository,
+	voiceRepo repository.VoiceRepository,
+	bgmRepo repository.BgmRepository,
+	systemBgmRepo repository.SystemBgmRepository,
+	soundEffectRepo repository.SoundEffectRepository,
+	systemSoundEffectRepo repository.SystemSoundEffectRepository,
+	storageClient storage.Client,
+) ChannelArchiveService {
+	return &channelArchiveService{
+		db:                    db,
+		channelRepo:           channelRepo,
+		characterRepo:         characterRepo,
+		episodeRepo:           episodeRepo,
+		scriptLineRepo:        scriptLineRepo,
+		sfxCueRepo:            sfxCueRepo,
+		pronunciationRepo:     pronunciationRepo,
+		categoryRepo:          categoryRepo,
+		voiceRepo:             voiceRepo,
+		bgmRepo:               bgmRepo,
+		systemBgmRepo:         systemBgmRepo,
+		soundEffectRepo:       soundEffectRepo,
+		systemSoundEffectRepo: systemSoundEffectRepo,
+		storageClient:         storageClient,
+	}
+}
+
+// チャンネルアーカイブの channel.json の形式
+//
+// ID はエクスポート元の ID で、アーカイブ内の参照にのみ使う（取り込み時は新しい ID を採番する）
+type channelArchiveManifest struct {
+	FormatVersion  int                           `json:"formatVersion"`
+	Channel        channelArchiveChannel         `json:"channel"`
+	Characters     []channelArchiveCharacter     `json:"characters"`
+	Bgms           []channelArchiveBgm           `json:"bgms"`
+	SoundEffects   []channelArchiveSoundEffect   `json:"soundEffects"`
+	Pronunciations []channelArchivePronunciation `json:"pronunciations"`
+	Episodes       []channelArchiveEpisode       `json:"episodes"`
+}
+
+type channelArchiveChannel struct {
+	Name             string               `json:"name"`
+	Description      string               `json:"description"`
+	UserPrompt       string               `json:"userPrompt"`
+	CategorySlug     string               `json:"categorySlug"`
+	Explicit         bool                 `json:"explicit"`
+	Artwork          *channelArchiveImage `json:"artwork"`
+	CharacterIDs     []uuid.UUID          `json:"characterIds"`
+	DefaultBgmID     *uuid.UUID           `json:"defaultBgmId"`
+	DefaultSystemBgm *string              `json:"defaultSystemBgm"`
+	IntroAudio       *channelArchiveAudio `json:"introAudio"`
+	IntroCrossfadeMs int                  `json:"introCrossfadeMs"`
+	OutroAudio       *channelArchiveAudio `json:"outroAudio"`
+	OutroCrossfadeMs int                  `json:"outroCrossfadeMs"`
+	PublishedAt      *time.Time           `json:"publishedAt"`
+}
+
+type channelArchiveCharacter struct {
+	ID            uuid.UUID            `json:"id"`
+	Name          string               `json:"name"`
+	Persona       string               `json:"persona"`
+	Voice         channelArchiveVoice  `json:"voice"`
+	VoiceSettings model.VoiceSettings  `json:"voiceSettings"`
+	Avatar        *channelArchiveImage `json:"avatar"`
+}
+
+type channelArchiveVoice struct {
+	Provider        string `json:"provider"`
+	ProviderVoiceID string `json:"providerVoiceId"`
+	Name            string `json:"name"`
+}
+
+type channelArchiveBgm struct {
+	ID    uuid.UUID           `json:"id"`
+	Name  string              `json:"name"`
+	Audio channelArchiveAudio `json:"audio"`
+}
+
+type channelArchiveSoundEffect struct {
+	ID    uuid.UUID           `json:"id"`
+	Name  string              `json:"name"`
+	Audio channelArchiveAudio `json:"audio"`
+}
+
+type channelArchivePronunciation struct {
+	Surface string `json:"surface"`
+	Reading string `json:"reading"`
+}
+
+type channelArchiveEpisode struct {
+	ID          uuid.UUID                  `json:"id"`
+	Title       string                     `json:"title"`
+	Description string                     `json:"description"`
+	Artwork     *channelArchiveImage       `json:"artwork"`
+	BgmID       *uuid.UUID                 `json:"bgmId"`
+	SystemBgm   *string                    `json:"systemBgm"`
+	VoiceAudio  *channelArchiveAudio       `json:"voiceAudio"`
+	FullAudio   *channelArchiveAudio       `json:"fullAudio"`
+	PublishedAt *time.Time                 `json:"publishedAt"`
+	CreatedAt   time.Time                  `json:"createdAt"`
+	ScriptLines []channelArchiveScriptLine `json:"scriptLines"`
+}
+
+type channelArchiveScriptLine struct {
+	ID        uuid.UUID              `json:"id"`
+	SpeakerID uuid.UUID              `json:"speakerId"`
+	Text      string                 `json:"text"`
+	Emotion   *string                `json:"emotion"`
+	SfxCues   []channelArchiveSfxCue `json:"sfxCues"`
+}
+
+type channelArchiveSfxCue struct {
+	SoundEffectID     *uuid.UUID           `json:"soundEffectId"`
+	SystemSoundEffect *string              `json:"systemSoundEffect"`
+	Position          model.SfxCuePosition `json:"position"`
+	VolumeDB          float64              `json:"volumeDb"`
+}
+
+// channelArchiveImage はアーカイブ内の画像を表す（外部 URL の画像はファイルを含めず URL のみ記録する）
+type channelArchiveImage struct {
+	File     string `json:"file,omitempty"`
+	URL      string `json:"url,omitempty"`
+	MimeType string `json:"mimeType"`
+	Filename string `json:"filename"`
+}
+
+// channelArchiveAudio はアーカイブ内の音声を表す
+type channelArchiveAudio struct {
+	File        string                 `json:"file"`
+	MimeType    string                 `json:"mimeType"`
+	Filename    string                 `json:"filename"`
+	DurationMs  int                    `json:"durationMs"`
+	Waveforms   model.AudioWaveforms   `json:"waveforms,omitempty"`
+	LineTimings model.AudioLineTimings `json:"lineTimings,omitempty"`
+}
+
+// channelArchiveEpisodeSource はエクスポートするエピソードと台本行・効果音キューを表す
+type channelArchiveEpisodeSource struct {
+	episode model.Episode
+	lines   []model.ScriptLine
+	cues    []model.SfxCue
+}
+
+// ExportChannel はチャンネルの設定・キャラクター・エピソード・台本・BGM・効果音・メディアファイルをまとめた
+// アーカイブ（ZIP）の署名付き URL を返す
+//
+// ZIP は内容から算出したハッシュをキーにストレージへキャッシュし、チャンネルが変更されていなければ作り直さない
+func (s *channelArchiveService) ExportChannel(ctx context.Context, userID, channelID string) (*response.ChannelArchiveDataResponse, error) {
+	uid, err := uuid.Parse(userID)
+	if err != nil {
+		return nil, err
+	}
+
+	cid, err := uuid.Parse(channelID)
+	if err != nil {
+		return nil, err
+	}
+
+	// チャンネルの存在確認とオーナーチェック
+	channel, err := s.channelRepo.FindByID(ctx, cid)
+	if err != nil {
+		return nil, err
+	}
+
+	if channel.UserID != uid {
+		return nil, apperror.ErrForbidden.WithMessage("このチャンネルへのアクセス権限がありません")
+	}
+
+	episodes, _, err := s.episodeRepo.FindByChannelID(ctx, cid, repository.EpisodeFilter{Sort: "createdAt", Order: "asc", Limit: 10000})
+	if err != nil {
+		return nil, err
+	}
+
+	sources := make([]channelArchiveEpisodeSource, len(episodes))
+	for i, episode := range episodes {
+		lines, err := s.scriptLineRepo.FindByEpisodeID(ctx, episode.ID)
+		if err != nil {
+			return nil, err
+		}
+		cues, err := s.sfxCueRepo.FindByEpisodeID(ctx, episode.ID)
+		if err != nil {
+			return nil, err
+		}
+		sources[i] = channelArchiveEpisodeSource{episode: episode, lines: lines, cues: cues}
+	}
+
+	characters, err := s.findArchiveCharacters(ctx, channel, sources)
+	if err != nil {
+		return nil, err
+	}
+
+	pronunciations, err := s.pronunciationRepo.FindByChannelID(ctx, cid)
+	if err != nil {
+		return nil, err
+	}
+
+	contents, err := buildChannelArchiveContents(channel, characters, sources, pronunciations)
+	if err != nil {
+		return nil, err
+	}
+
+	archivePath := storage.GenerateChannelArchivePath(cid.String(), contents.hash(channelArchiveFormatVersion))
+
+	cached, err := s.storageClient.Exists(ctx, archivePath)
+	if err != nil {
+		return nil, err
+	}
+
+	if !cached {
+		if err := s.buildArchive(ctx, cid, contents, archivePath); err != nil {
+			return nil, err
+		}
+	}
+
+	signedURL, err := s.storageClient.GenerateSignedURL(ctx, archivePath, storage.SignedURLExpirationPackage)
+	if err != nil {
+		return nil, err
+	}
+
+	return &response.ChannelArchiveDataResponse{
+		Data: response.ChannelArchiveResponse{
+			URL:       signedURL,
+			Filename:  fmt.Sprintf("channel-%s.zip", cid),
+			MimeType:  channelArchiveMimeType,
+			ExpiresAt: time.Now().UTC().Add(storage.SignedURLExpirationPackage),
+			Cached:    cached,
+		},
+	}, nil
+}
+
+// findArchiveCharacters はチャンネルのキャラクターと、チャンネルから外れた台本行の話者をまとめて返す
+func (s *channelArchiveService) findArchiveCharacters(ctx context.Context, channel *model.Channel, sources []channelArchiveEpisodeSource) ([]model.Character, error) {
+	characters := make([]model.Character, 0, len(channel.ChannelCharacters))
+	seen := make(map[uuid.UUID]bool)
+	for _, cc := range channel.ChannelCharacters {
+		characters = append(characters, cc.Character)
+		seen[cc.CharacterID] = true
+	}
+
+	var missing []uuid.UUID
+	for _, src := range sources {
+		for _, l := range src.lines {
+			if !seen[l.SpeakerID] {
+				seen[l.SpeakerID] = true
+				missing = append(missing, l.SpeakerID)
+			}
+		}
+	}
+
+	if len(missing) > 0 {
+		speakers, err := s.characterRepo.FindByIDs(ctx, missing)
+		if err != nil {
+			return nil, err
+		}
+		characters = append(characters, speakers...)
+	}
+
+	return characters, nil
+}
+
+// buildArchive は ZIP を一時ファイルに書き出してストレージにアップロードする
+func (s *channelArchiveService) buildArchive(ctx context.Context, channelID uuid.UUID, contents *zipArchiveContents, archivePath string) error {
+	log := logger.FromContext(ctx)
+
+	ws, err := newAudioWorkspace(channelID)
+	if err != nil {
+		return apperror.ErrInternal.WithMessage("一時ディレクトリの作成に失敗しました").WithError(err)
+	}
+	defer ws.Close()
+
+	zipPath, err := ws.create("channel.zip", func(w io.Writer) error {
+		return writeZipArchive(ctx, s.storageClient, contents, w)
+	})
+	if err != nil {
+		log.Error("failed to build channel archive", "error", err, "channel_id", channelID)
+		return apperror.ErrInternal.WithMessage("チャンネルアーカイブの作成に失敗しました").WithError(err)
+	}
+
+	if _, err := uploadFile(ctx, s.storageClient, zipPath, archivePath, channelArchiveMimeType); err != nil {
+		log.Error("failed to upload channel archive", "error", err, "channel_id", channelID)
+		return apperror.ErrInternal.WithMessage("チャンネルアーカイブのアップロードに失敗しました").WithError(err)
+	}
+
+	return nil
+}
+
+// channelArchiveBuilder はチャンネルアーカイブのマニフェストと ZIP に含めるメディアファイルを組み立てる
+//
+// 同じ音声・画像を複数箇所で参照している場合も、ZIP には 1 つだけ含める
+type channelArchiveBuilder struct {
+	contents *zipArchiveContents
+	added    map[string]bool
+}
+
+// image は画像をアーカイブに追加し、マニフェストに記録する形式で返す
+func (b *channelArchiveBuilder) image(image *model.Image) *channelArchiveImage {
+	if image == nil {
+		return nil
+	}
+
+	result := &channelArchiveImage{MimeType: image.MimeType, Filename: image.Filename}
+	if storage.IsExternalURL(image.Path) {
+		result.URL = image.Path
+		return result
+	}
+
+	result.File = b.addMedia(fmt.Sprintf("media/images/%s%s", image.ID, path.Ext(image.Path)), image.Path)
+	return result
+}
+
+// audio は音声をアーカイブに追加し、マニフェストに記録する形式で返す
+func (b *channelArchiveBuilder) audio(audio *model.Audio) *channelArchiveAudio {
+	if audio == nil {
+		return nil
+	}
+
+	return &channelArchiveAudio{
+		File:        b.addMedia(fmt.Sprintf("media/audios/%s%s", audio.ID, path.Ext(audio.Path)), audio.Path),
+		MimeType:    audio.MimeType,
+		Filename:    audio.Filename,
+		DurationMs:  audio.DurationMs,
+		Waveforms:   audio.Waveforms,
+		LineTimings: audio.LineTimings,
+	}
+}
+
+func (b *channelArchiveBuilder) addMedia(name, storagePath string) string {
+	if !b.added[name] {
+		b.added[name] = true
+		b.contents.media = append(b.contents.media, zipArchiveMedia{name: name, path: storagePath})
+	}
+	return name
+}
+
+// buildChannelArchiveContents はチャンネルアーカイブに含めるファイルを組み立てる
+//
+//   - チャンネルの設定・キャラクター（ボイスは Provider と ProviderVoiceID で記録）・読み方辞書
+//   - エピソードと台本行・効果音キュー
+//   - チャンネル・エピソードで使っているユーザーの BGM・効果音（システム BGM・システム効果音は名前のみ記録）
+//   - 以上が参照する音声・画像（media/ 以下）と、全体をまとめたマニフェスト（channel.json）
+//
+// 公開日時は記録するが、再生回数・取り込み元の GUID など移行先で意味を持たない値は含めない
+func buildChannelArchiveContents(channel *model.Channel, characters []model.Character, sources []channelArchiveEpisodeSource, pronunciations []model.Pronunciation) (*zipArchiveContents, error) {
+	b := &channelArchiveBuilder{contents: &zipArchiveContents{}, added: make(map[string]bool)}
+	manifest := channelArchiveManifest{
+		FormatVersion: channelArchiveFormatVersion,
+		Channel: channelArchiveChannel{
+			Name:             channel.Name,
+			Description:      channel.Description,
+			UserPrompt:       channel.UserPrompt,
+			CategorySlug:     channel.Category.Slug,
+			Explicit:         channel.Explicit,
+			Artwork:          b.image(channel.Artwork),
+			CharacterIDs:     make([]uuid.UUID, len(channel.ChannelCharacters)),
+			DefaultBgmID:     channel.DefaultBgmID,
+			IntroAudio:       b.audio(channel.IntroAudio),
+			IntroCrossfadeMs: channel.IntroCrossfadeMs,
+			OutroAudio:       b.audio(channel.OutroAudio),
+			OutroCrossfadeMs: channel.OutroCrossfadeMs,
+			PublishedAt:      channel.PublishedAt,
+		},
+		Characters:     make([]channelArchiveCharacter, len(characters)),
+		Bgms:           []channelArchiveBgm{},
+		SoundEffects:   []channelArchiveSoundEffect{},
+		Pronunciations: make([]channelArchivePronunciation, len(pronunciations)),
+		Episodes:       make([]channelArchiveEpisode, len(sources)),
+	}
+
+	for i, cc := range channel.ChannelCharacters {
+		manifest.Channel.CharacterIDs[i] = cc.CharacterID
+	}
+	if channel.DefaultSystemBgm != nil {
+		manifest.Channel.DefaultSystemBgm = &channel.DefaultSystemBgm.Name
+	}
+
+	for i, c := range characters {
+		manifest.Characters[i] = channelArchiveCharacter{
+			ID:      c.ID,
+			Name:    c.Name,
+			Persona: c.Persona,
+			Voice: channelArchiveVoice{
+				Provider:        c.Voice.Provider,
+				ProviderVoiceID: c.Voice.ProviderVoiceID,
+				Name:            c.Voice.Name,
+			},
+			VoiceSettings: c.VoiceSettings,
+			Avatar:        b.image(c.Avatar),
+		}
+	}
+
+	for i, p := range pronunciations {
+		manifest.Pronunciations[i] = channelArchivePronunciation{Surface: p.Surface, Reading: p.Reading}
+	}
+
+	// BGM・効果音は参照されている順に、重複を除いて記録する
+	bgmAdded := make(map[uuid.UUID]bool)
+	addBgm := func(bgm *model.Bgm) {
+		if bgm == nil || bgmAdded[bgm.ID] {
+			return
+		}
+		bgmAdded[bgm.ID] = true
+		manifest.Bgms = append(manifest.Bgms, channelArchiveBgm{ID: bgm.ID, Name: bgm.Name, Audio: *b.audio(&bgm.Audio)})
+	}
+	soundEffectAdded := make(map[uuid.UUID]bool)
+	addSoundEffect := func(se *model.SoundEffect) {
+		if se == nil || soundEffectAdded[se.ID] {
+			return
+		}
+		soundEffectAdded[se.ID] = true
+		manifest.SoundEffects = append(manifest.SoundEffects, channelArchiveSoundEffect{ID: se.ID, Name: se.Name, Audio: *b.audio(&se.Audio)})
+	}
+
+	addBgm(channel.DefaultBgm)
+
+	for i, src := range sources {
+		episode := src.episode
+		addBgm(episode.Bgm)
+
+		cuesByLine := make(map[uuid.UUID][]channelArchiveSfxCue)
+		for _, cue := range src.cues {
+			archiveCue := channelArchiveSfxCue{Position: cue.Position, VolumeDB: cue.VolumeDB}
+			switch {
+			case cue.SoundEffect != nil:
+				addSoundEffect(cue.SoundEffect)
+				archiveCue.SoundEffectID = &cue.SoundEffect.ID
+			case cue.SystemSoundEffect != nil:
+				archiveCue.SystemSoundEffect = &cue.SystemSoundEffect.Name
+			default:
+				continue
+			}
+			cuesByLine[cue.ScriptLineID] = append(cuesByLine[cue.ScriptLineID], archiveCue)
+		}
+
+		lines := make([]channelArchiveScriptLine, len(src.lines))
+		for j, l := range src.lines {
+			lines[j] = channelArchiveScriptLine{
+				ID:        l.ID,
+				SpeakerID: l.SpeakerID,
+				Text:      l.Text,
+				Emotion:   l.Emotion,
+				SfxCues:   cuesByLine[l.ID],
+			}
+		}
+
+		archiveEpisode := channelArchiveEpisode{
+			ID:          episode.ID,
+			Title:       episode.Title,
+			Description: episode.Description,
+			Artwork:     b.image(episode.Artwork),
+			VoiceAudio:  b.audio(episode.VoiceAudio),
+			FullAudio:   b.audio(episode.FullAudio),
+			PublishedAt: episode.PublishedAt,
+			CreatedAt:   episode.CreatedAt,
+			ScriptLines: lines,
+		}
+		if episode.Bgm != nil {
+			archiveEpisode.BgmID = &episode.Bgm.ID
+		}
+		if episode.SystemBgm != nil {
+			archiveEpisode.SystemBgm = &episode.SystemBgm.Name
+		}
+		manifest.Episodes[i] = archiveEpisode
+	}
+
+	manifestJSON, err := json.MarshalIndent(manifest, "", "  ")
+	if err != nil {
+		return nil, err
+	}
+	b.contents.files = []zipArchiveFile{{name: channelArchiveManifestFile, data: manifestJSON}}
+
+	return b.contents, nil
+}
+
+// channelArchiveImportPlan はチャンネルアーカイブの取り込み先での解決結果を表す
+type channelArchiveImportPlan struct {
+	categoryID         uuid.UUID
+	voiceIDs           map[channelArchiveVoice]uuid.UUID
+	characterNames     map[uuid.UUID]string
+	bgmNames           map[uuid.UUID]string
+	soundEffectNames   map[uuid.UUID]string
+	systemBgmIDs       map[string]uuid.UUID
+	systemSoundEffects map[string]uuid.UUID
+	conflicts          []response.ChannelArchiveConflictResponse
+}
+
+// ImportChannel はチャンネルアーカイブからチャンネルを作成する
+//
+// ID はすべて採番し直し、ボイスは Provider と ProviderVoiceID、カテゴリはスラッグで取り込み先のものを探す。
+// ボイス・カテゴリが見つからない場合は何も作成せずに IMPORT_CONFLICT を返す。
+// キャラクター・BGM・効果音の名前が既存のものと重複する場合は名前を変え、
+// 取り込み先にないシステム BGM・システム効果音は設定を外して取り込み、いずれも競合として結果に含める。
+// DryRun の場合は競合の確認のみ行い、何も作成しない
+func (s *channelArchiveService) ImportChannel(ctx context.Context, userID string, input ImportChannelArchiveInput) (*response.ChannelArchiveImportDataResponse, error) {
+	uid, err := uuid.Parse(userID)
+	if err != nil {
+		return nil, err
+	}
+
+	manifest, files, err := readChannelArchive(input.File, input.Size)
+	if err != nil {
+		return nil, err
+	}
+
+	plan, err := s.planImport(ctx, uid, manifest)
+	if err != nil {
+		return nil, err
+	}
+
+	result := response.ChannelArchiveImportResponse{
+		DryRun:    input.DryRun,
+		Counts:    channelArchiveImportCounts(manifest, plan),
+		Conflicts: plan.conflicts,
+	}
+
+	if !input.DryRun {
+		channel, err := s.importArchive(ctx, uid, manifest, files, plan)
+		if err != nil {
+			return nil, err
+		}
+		result.Channel = &response.ChannelArchiveImportChannelResponse{ID: channel.ID, Name: channel.Name}
+	}
+
+	return &response.ChannelArchiveImportDataResponse{Data: result}, nil
+}
+
+// readChannelArchive はチャンネルアーカイブの ZIP を開き、マニフェストを読み込んで検証する
+func readChannelArchive(r io.ReaderAt, size int64) (*channelArchiveManifest, map[string]*zip.File, error) {
+	if size <= 0 {
+		return nil, nil, apperror.ErrValidation.WithMessage("ファイルが空です")
+	}
+	if size > MaxChannelArchiveSize {
+		return nil, nil, apperror.ErrValidation.WithMessage(fmt.Sprintf("ファイルサイズは %dGB 以下にしてください", MaxChannelArchiveSize>>30))
+	}
+
+	zr, err := zip.NewReader(r, size)
+	if err != nil {
+		return nil, nil, apperror.ErrValidation.WithMessage("ZIP ファイルとして読み込めません").WithError(err)
+	}
+
+	// 展開後のサイズはヘッダーの値で判定する（archive/zip はヘッダーの値を超えて展開するとエラーを返すため、偽装できない）
+	files := make(map[string]*zip.File, len(zr.File))
+	var uncompressedSize uint64
+	for _, f := range zr.File {
+		files[f.Name] = f
+		uncompressedSize += f.UncompressedSize64
+		if f.UncompressedSize64 > channelArchiveMaxUncompressedSize || uncompressedSize > channelArchiveMaxUncompressedSize {
+			return nil, nil, apperror.ErrValidation.WithMessage(fmt.Sprintf("展開後のファイルサイズの合計は %dGB 以下にしてください", channelArchiveMaxUncompressedSize>>30))
+		}
+	}
+
+	manifestFile, ok := files[channelArchiveManifestFile]
+	if !ok {
+		return nil, nil, apperror.ErrValidation.WithMessage("チャンネルアーカイブに channel.json が含まれていません")
+	}
+	if manifestFile.UncompressedSize64 > channelArchiveMaxManifestSize {
+		return nil, nil, apperror.ErrValidation.WithMessage("channel.json が大きすぎます")
+	}
+
+	rc, err := manifestFile.Open()
+	if err != nil {
+		return nil, nil, apperror.ErrValidation.WithMessage("channel.json を読み込めません").WithError(err)
+	}
+	defer rc.Close()
+
+	var manifest channelArchiveManifest
+	if err := json.NewDecoder(io.LimitReader(rc, channelArchiveMaxManifestSize)).Decode(&manifest); err != nil {
+		return nil, nil, apperror.ErrValidation.WithMessage("channel.json の形式が正しくありません").WithError(err)
+	}
+
+	if manifest.FormatVersion < 1 || manifest.FormatVersion > channelArchiveFormatVersion {
+		return nil, nil, apperror.ErrValidation.WithMessage(fmt.Sprintf("対応していないチャンネルアーカイブの形式です（formatVersion: %d）", manifest.FormatVersion))
+	}
+
+	if problems := validateChannelArchiveManifest(&manifest, files); len(problems) > 0 {
+		return nil, nil, apperror.ErrValidation.WithMessage("チャンネルアーカイブの内容に誤りがあります").WithDetails(problems)
+	}
+
+	return &manifest, files, nil
+}
+
+// validateChannelArchiveManifest はマニフェスト内の参照と、参照しているメディアファイルが ZIP に含まれていることを検証する
+func validateChannelArchiveManifest(m *channelArchiveManifest, files map[string]*zip.File) []string {
+	problems := []string{}
+
+	checkImage := func(label string, image *channelArchiveImage) {
+		if image == nil {
+			return
+		}
+		if image.URL != "" {
+			if !storage.IsExternalURL(image.URL) {
+				problems = append(problems, fmt.Sprintf("%s: 画像の URL が正しくありません", label))
+			}
+			return
+		}
+		if _, ok := files[image.File]; !ok || image.File == "" {
+			problems = append(problems, fmt.Sprintf("%s: 画像ファイル %q が含まれていません", label, image.File))
+		}
+		if _, ok := allowedImageMimeTypes[image.MimeType]; !ok {
+			problems = append(problems, fmt.Sprintf("%s: 画像の形式 %q には対応していません", label, image.MimeType))
+		}
+	}
+	checkAudio := func(label string, audio *channelArchiveAudio) {
+		if audio == nil {
+			return
+		}
+		if _, ok := files[audio.File]; !ok || audio.File == "" {
+			problems = append(problems, fmt.Sprintf("%s: 音声ファイル %q が含まれていません", label, audio.File))
+		}
+		if _, ok := allowedAudioMimeTypes[audio.MimeType]; !ok {
+			problems = append(problems, fmt.Sprintf("%s: 音声の形式 %q には対応していません", label, audio.MimeType))
+		}
+	}
+
+	if m.Channel.Name == "" {
+		problems = append(problems, "channel.name は必須です")
+	}
+	if m.Channel.CategorySlug == "" {
+		problems = append(problems, "channel.categorySlug は必須です")
+	}
+	checkImage("channel.artwork", m.Channel.Artwork)
+	checkAudio("channel.introAudio", m.Channel.IntroAudio)
+	checkAudio("channel.outroAudio", m.Channel.OutroAudio)
+
+	characters := make(map[uuid.UUID]bool, len(m.Characters))
+	for i, c := range m.Characters {
+		label := fmt.Sprintf("characters[%d]", i)
+		if characters[c.ID] {
+			problems = append(problems, fmt.Sprintf("%s: ID %s が重複しています", label, c.ID))
+		}
+		characters[c.ID] = true
+		if c.Name == "" {
+			problems = append(problems, fmt.Sprintf("%s: name は必須です", label))
+		}
+		if c.Voice.Provider == "" || c.Voice.ProviderVoiceID == "" {
+			problems = append(problems, fmt.Sprintf("%s: voice.provider と voice.providerVoiceId は必須です", label))
+		}
+		checkImage(label+".avatar", c.Avatar)
+	}
+	for _, id := range m.Channel.CharacterIDs {
+		if !characters[id] {
+			problems = append(problems, fmt.Sprintf("channel.characterIds: キャラクター %s が見つかりません", id))
+		}
+	}
+
+	bgms := make(map[uuid.UUID]bool, len(m.Bgms))
+	for i, bgm := range m.Bgms {
+		label := fmt.Sprintf("bgms[%d]", i)
+		bgms[bgm.ID] = true
+		if bgm.Name == "" {
+			problems = append(problems, fmt.Sprintf("%s: name は必須です", label))
+		}
+		checkAudio(label+".audio", &bgm.Audio)
+	}
+	if id := m.Channel.DefaultBgmID; id != nil && !bgms[*id] {
+		problems = append(problems, fmt.Sprintf("channel.defaultBgmId: BGM %s が見つかりません", *id))
+	}
+
+	soundEffects := make(map[uuid.UUID]bool, len(m.SoundEffects))
+	for i, se := range m.SoundEffects {
+		label := fmt.Sprintf("soundEffects[%d]", i)
+		soundEffects[se.ID] = true
+		if se.Name == "" {
+			problems = append(problems, fmt.Sprintf("%s: name は必須です", label))
+		}
+		checkAudio(label+".audio", &se.Audio)
+	}
+
+	lines := make(map[uuid.UUID]bool)
+	for i, e := range m.Episodes {
+		label := fmt.Sprintf("episodes[%d]", i)
+		if e.Title == "" {
+			problems = append(problems, fmt.Sprintf("%s: title は必須です", label))
+		}
+		if e.BgmID != nil && !bgms[*e.BgmID] {
+			problems = append(problems, fmt.Sprintf("%s.bgmId: BGM %s が見つかりません", label, *e.BgmID))
+		}
+		checkImage(label+".artwork", e.Artwork)
+		checkAudio(label+".voiceAudio", e.VoiceAudio)
+		checkAudio(label+".fullAudio", e.FullAudio)
+
+		for j, l := range e.ScriptLines {
+			lineLabel := fmt.Sprintf("%s.scriptLines[%d]", label, j)
+			if lines[l.ID] {
+				problems = append(problems, fmt.Sprintf("%s: ID %s が重複しています", lineLabel, l.ID))
+			}
+			lines[l.ID] = true
+			if !characters[l.SpeakerID] {
+				problems = append(problems, fmt.Sprintf("%s: 話者 %s が見つかりません", lineLabel, l.SpeakerID))
+			}
+			for k, cue := range l.SfxCues {
+				cueLabel := fmt.Sprintf("%s.sfxCues[%d]", lineLabel, k)
+				if (cue.SoundEffectID == nil) == (cue.SystemSoundEffect == nil) {
+					problems = append(problems, fmt.Sprintf("%s: soundEffectId と systemSoundEffect のどちらか一方を指定してください", cueLabel))
+				} else if cue.SoundEffectID != nil && !soundEffects[*cue.SoundEffectID] {
+					problems = append(problems, fmt.Sprintf("%s: 効果音 %s が見つかりません", cueLabel, *cue.SoundEffectID))
+				}
+				if cue.Position != model.SfxCuePositionBefore && cue.Position != model.SfxCuePositionAfter {
+					problems = append(problems, fmt.Sprintf("%s: position は before か after を指定してください", cueLabel))
+				}
+			}
+		}
+	}
+
+	return problems
+}
+
+// planImport はカテゴリ・ボイス・システム BGM・システム効果音を取り込み先で探し、名前の重複を解決する
+//
+// カテゴリ・ボイスが見つからない場合は、見つからなかったものをすべて details に含めた IMPORT_CONFLICT を返す
+func (s *channelArchiveService) planImport(ctx context.Context, userID uuid.UUID, m *channelArchiveManifest) (*channelArchiveImportPlan, error) {
+	plan := &channelArchiveImportPlan{
+		voiceIDs:           make(map[channelArchiveVoice]uuid.UUID),
+		characterNames:     make(map[uuid.UUID]string),
+		bgmNames:           make(map[uuid.UUID]string),
+		soundEffectNames:   make(map[uuid.UUID]string),
+		systemBgmIDs:       make(map[string]uuid.UUID),
+		systemSoundEffects: make(map[string]uuid.UUID),
+		conflicts:          []response.ChannelArchiveConflictResponse{},
+	}
+	var blocking []response.ChannelArchiveConflictResponse
+
+	category, err := s.categoryRepo.FindBySlug(ctx, m.Channel.CategorySlug)
+	switch {
+	case apperror.IsCode(err, apperror.CodeNotFound):
+		blocking = append(blocking, response.ChannelArchiveConflictResponse{
+			Type:       channelArchiveConflictCategory,
+			Name:       m.Channel.CategorySlug,
+			Resolution: channelArchiveResolutionBlocked,
+			Message:    "カテゴリが見つかりません",
+		})
+	case err != nil:
+		return nil, err
+	default:
+		plan.categoryID = category.ID
+	}
+
+	// ボイスはアクティブなものをプロバイダごとに取得して照合する
+	voicesByProvider := make(map[string]map[string]uuid.UUID)
+	for _, c := range m.Characters {
+		key := channelArchiveVoice{Provider: c.Voice.Provider, ProviderVoiceID: c.Voice.ProviderVoiceID}
+		if _, ok := plan.voiceIDs[key]; ok {
+			continue
+		}
+
+		voices, ok := voicesByProvider[key.Provider]
+		if !ok {
+			provider := key.Provider
+			found, err := s.voiceRepo.FindAll(ctx, repository.VoiceFilter{Provider: &provider})
+			if err != nil {
+				return nil, err
+			}
+			voices = make(map[string]uuid.UUID, len(found))
+			for _, v := range found {
+				voices[v.ProviderVoiceID] = v.ID
+			}
+			voicesByProvider[key.Provider] = voices
+		}
+
+		voiceID, ok := voices[key.ProviderVoiceID]
+		if !ok {
+			blocking = append(blocking, response.ChannelArchiveConflictResponse{
+				Type:       channelArchiveConflictVoice,
+				Name:       fmt.Sprintf("%s/%s", key.Provider, key.ProviderVoiceID),
+				Resolution: channelArchiveResolutionBlocked,
+				Message:    fmt.Sprintf("キャラクター「%s」のボイス「%s」が見つからないか、無効です", c.Name, c.Voice.Name),
+			})
+			// 同じボイスを使う他のキャラクターで重複して報告しない
+			plan.voiceIDs[key] = uuid.Nil
+			continue
+		}
+		plan.voiceIDs[key] = voiceID
+	}
+
+	if len(blocking) > 0 {
+		return nil, apperror.ErrImportConflict.WithDetails(blocking)
+	}
+
+	for _, c := range m.Characters {
+		name, err := s.resolveName(ctx, plan, channelArchiveConflictCharacterName, c.Name, plan.characterNames, func(name string) (bool, error) {
+			return s.characterRepo.ExistsByUserIDAndName(ctx, userID, name, nil)
+		})
+		if err != nil {
+			return nil, err
+		}
+		plan.characterNames[c.ID] = name
+	}
+
+	for _, bgm := range m.Bgms {
+		name, err := s.resolveName(ctx, plan, channelArchiveConflictBgmName, bgm.Name, plan.bgmNames, func(name string) (bool, error) {
+			return s.bgmRepo.ExistsByUserIDAndName(ctx, userID, name, nil)
+		})
+		if err != nil {
+			return nil, err
+		}
+		plan.bgmNames[bgm.ID] = name
+	}
+
+	for _, se := range m.SoundEffects {
+		name, err := s.resolveName(ctx, plan, channelArchiveConflictSoundEffectName, se.Name, plan.soundEffectNames, func(name string) (bool, error) {
+			return s.soundEffectRepo.ExistsByUserIDAndName(ctx, userID, name, nil)
+		})
+		if err != nil {
+			return nil, err
+		}
+		plan.soundEffectNames[se.ID] = name
+	}
+
+	if err := s.resolveSystemBgms(ctx, plan, m); err != nil {
+		return nil, err
+	}
+
+	if err := s.resolveSystemSoundEffects(ctx, plan, m); err != nil {
+		return nil, err
+	}
+
+	return plan, nil
+}
+
+// resolveName は名前が既存のものと重複する場合に「名前 (2)」のように番号を付けた名前を返し、競合として記録する
+//
+// used には同じ種類でこれまでに決めた名前を渡し、同じアーカイブ内で番号を付けた名前同士も重複させない
+func (s *channelArchiveService) resolveName(ctx context.Context, plan *channelArchiveImportPlan, conflictType, name string, used map[uuid.UUID]string, exists func(name string) (bool, error)) (string, error) {
+	taken := func(candidate string) (bool, error) {
+		for _, u := range used {
+			if u == candidate {
+				return true, nil
+			}
+		}
+		return exists(candidate)
+	}
+
+	duplicated, err := taken(name)
+	if err != nil {
+		return "", err
+	}
+	if !duplicated {
+		return name, nil
+	}
+
+	for n := 2; ; n++ {
+		if err := ctx.Err(); err != nil {
+			return "", err
+		}
+
+		candidate := fmt.Sprintf("%s (%d)", name, n)
+		duplicated, err := taken(candidate)
+		if err != nil {
+			return "", err
+		}
+		if duplicated {
+			continue
+		}
+
+		plan.conflicts = append(plan.conflicts, response.ChannelArchiveConflictResponse{
+			Type:       conflictType,
+			Name:       name,
+			Resolution: channelArchiveResolutionRenamed,
+			RenamedTo:  &candidate,
+			Message:    fmt.Sprintf("同じ名前がすでに存在するため「%s」として取り込みます", candidate),
+		})
+		return candidate, nil
+	}
+}
+
+// resolveSystemBgms はチャンネル・エピソードに設定されたシステム BGM を名前で探し、見つからないものを競合として記録する
+func (s *channelArchiveService) resolveSystemBgms(ctx context.Context, plan *channelArchiveImportPlan, m *channelArchiveManifest) error {
+	names := uniqueNames(append([]*string{m.Channel.DefaultSystemBgm}, episodeSystemBgms(m)...))
+	if len(names) == 0 {
+		return nil
+	}
+
+	found, err := s.systemBgmRepo.FindActiveByNames(ctx, names)
+	if err != nil {
+		return err
+	}
+	for _, bgm := range found {
+		plan.systemBgmIDs[bgm.Name] = bgm.ID
+	}
+
+	for _, name := range names {
+		if _, ok := plan.systemBgmIDs[name]; ok {
+			continue
+		}
+		plan.conflicts = append(plan.conflicts, response.ChannelArchiveConflictResponse{
+			Type:       channelArchiveConflictSystemBgm,
+			Name:       name,
+			Resolution: channelArchiveResolutionDropped,
+			Message:    "システム BGM が見つからないため、BGM を設定せずに取り込みます",
+		})
+	}
+
+	return nil
+}
+
+// resolveSystemSoundEffects は効果音キューのシステム効果音を名前で探し、見つからないものを競合として記録する
+func (s *channelArchiveService) resolveSystemSoundEffects(ctx context.Context, plan *channelArchiveImportPlan, m *channelArchiveManifest) error {
+	var refs []*string
+	for _, e := range m.Episodes {
+		for _, l := range e.ScriptLines {
+			for _, cue := range l.SfxCues {
+				refs = append(refs, cue.SystemSoundEffect)
+			}
+		}
+	}
+
+	names := uniqueNames(refs)
+	if len(names) == 0 {
+		return nil
+	}
+
+	found, err := s.systemSoundEffectRepo.FindActiveByNames(ctx, names)
+	if err != nil {
+		return err
+	}
+	for _, se := range found {
+		plan.systemSoundEffects[se.Name] = se.ID
+	}
+
+	for _, name := range names {
+		if _, ok := plan.systemSoundEffects[name]; ok {
+			continue
+		}
+		plan.conflicts = append(plan.conflicts, response.ChannelArchiveConflictResponse{
+			Type:       channelArchiveConflictSystemSoundEffect,
+			Name:       name,
+			Resolution: channelArchiveResolutionDropped,
+			Message:    "システム効果音が見つからないため、この効果音のキューを除いて取り込みます",
+		})
+	}
+
+	return nil
+}
+
+// episodeSystemBgms はエピソードに設定されたシステム BGM の名前を返す
+func episodeSystemBgms(m *channelArchiveManifest) []*string {
+	refs := make([]*string, len(m.Episodes))
+	for i, e := range m.Episodes {
+		refs[i] = e.SystemBgm
+	}
+	return refs
+}
+
+// uniqueNames は nil を除いた名前を、重複を除いて出現順に返す
+func uniqueNames(refs []*string) []string {
+	var names []string
+	seen := make(map[string]bool)
+	for _, ref := range refs {
+		if ref == nil || seen[*ref] {
+			continue
+		}
+		seen[*ref] = true
+		names = append(names, *ref)
+	}
+	return names
+}
+
+// channelArchiveImportCounts は取り込むデータの件数を数える（取り込み先にないシステム効果音のキューは除く）
+func channelArchiveImportCounts(m *channelArchiveManifest, plan *channelArchiveImportPlan) response.ChannelArchiveImportCountsResponse {
+	counts := response.ChannelArchiveImportCountsResponse{
+		Characters:     len(m.Characters),
+		Bgms:           len(m.Bgms),
+		SoundEffects:   len(m.SoundEffects),
+		Pronunciations: len(m.Pronunciations),
+		Episodes:       len(m.Episodes),
+	}
+
+	media := make(map[string]bool)
+	addImage := func(image *channelArchiveImage) {
+		if image != nil && image.File != "" {
+			media[image.File] = true
+		}
+	}
+	addAudio := func(audio *channelArchiveAudio) {
+		if audio != nil {
+			media[audio.File] = true
+		}
+	}
+
+	addImage(m.Channel.Artwork)
+	addAudio(m.Channel.IntroAudio)
+	addAudio(m.Channel.OutroAudio)
+	for _, c := range m.Characters {
+		addImage(c.Avatar)
+	}
+	for _, bgm := range m.Bgms {
+		addAudio(&bgm.Audio)
+	}
+	for _, se := range m.SoundEffects {
+		addAudio(&se.Audio)
+	}
+	for _, e := range m.Episodes {
+		addImage(e.Artwork)
+		addAudio(e.VoiceAudio)
+		addAudio(e.FullAudio)
+		counts.ScriptLines += len(e.ScriptLines)
+		for _, l := range e.ScriptLines {
+			for _, cue := range l.SfxCues {
+				if cue.SystemSoundEffect != nil {
+					if _, ok := plan.systemSoundEffects[*cue.SystemSoundEffect]; !ok {
+						continue
+					}
+				}
+				counts.SfxCues++
+			}
+		}
+	}
+	counts.MediaFiles = len(media)
+
+	return counts
+}
+
+// channelArchiveUpload はストレージへアップロードするアーカイブ内のメディアファイルを表す
+type channelArchiveUpload struct {
+	file        *zip.File
+	path        string
+	contentType string
+}
+
+// channelArchiveImporter はマニフェストを ID を採番し直したモデルに変換する
+//
+// アーカイブ内で同じファイルを参照している音声・画像は、取り込み先でも 1 つの Audio・Image にまとめる
+type channelArchiveImporter struct {
+	userID  uuid.UUID
+	files   map[string]*zip.File
+	plan    *channelArchiveImportPlan
+	ids     map[uuid.UUID]uuid.UUID
+	images  map[string]*model.Image
+	audios  map[string]*model.Audio
+	uploads []channelArchiveUpload
+
+	// 作成順に並べたモデル
+	imageList      []*model.Image
+	audioList      []*model.Audio
+	characters     []model.Character
+	bgms           []model.Bgm
+	soundEffects   []model.SoundEffect
+	channel        *model.Channel
+	characterIDs   []uuid.UUID
+	pronunciations []model.Pronunciation
+	episodes       []model.Episode
+	scriptLines    [][]model.ScriptLine
+	sfxCues        []model.SfxCue
+}
+
+// newID はエクスポート元の ID に対応する取り込み先の ID を返す（初めての ID の場合は採番する）
+func (im *channelArchiveImporter) newID(original uuid.UUID) uuid.UUID {
+	if id, ok := im.ids[original]; ok {
+		return id
+	}
+	id := uuid.New()
+	im.ids[original] = id
+	return id
+}
+
+// image はアーカイブ内の画像に対応する Image を用意し、その ID を返す
+func (im *channelArchiveImporter) image(image *channelArchiveImage) *uuid.UUID {
+	if image == nil {
+		return nil
+	}
+
+	key := image.File
+	if image.URL != "" {
+		key = image.URL
+	}
+	if existing, ok := im.images[key]; ok {
+		return &existing.ID
+	}
+
+	created := &model.Image{ID: uuid.New(), MimeType: image.MimeType, Filename: image.Filename, Path: image.URL}
+	if image.URL == "" {
+		file := im.files[image.File]
+		// 拡張子はファイル名ではなく検証済みの MIME タイプから決める
+		created.Path = storage.GenerateImagePath(created.ID.String(), allowedImageMimeTypes[image.MimeType])
+		created.FileSize = int(file.UncompressedSize64)
+		im.uploads = append(im.uploads, channelArchiveUpload{file: file, path: created.Path, contentType: image.MimeType})
+	}
+
+	im.images[key] = created
+	im.imageList = append(im.imageList, created)
+	return &created.ID
+}
+
+// audio はアーカイブ内の音声に対応する Audio を用意し、その ID を返す
+//
+// 台本行の再生区間は採番し直した台本行の ID に置き換える（台本行の ID を先に採番しておく必要がある）
+func (im *channelArchiveImporter) audio(audio *channelArchiveAudio) *uuid.UUID {
+	if audio == nil {
+		return nil
+	}
+
+	if existing, ok := im.audios[audio.File]; ok {
+		return &existing.ID
+	}
+
+	file := im.files[audio.File]
+	created := &model.Audio{
+		ID:         uuid.New(),
+		MimeType:   audio.MimeType,
+		Filename:   audio.Filename,
+		FileSize:   int(file.UncompressedSize64),
+		DurationMs: audio.DurationMs,
+		Waveforms:  audio.Waveforms,
+	}
+	// 拡張子はファイル名ではなく検証済みの MIME タイプから決める
+	created.Path = storage.GenerateAudioPathWithExt(created.ID.String(), allowedAudioMimeTypes[audio.MimeType])
+
+	for _, t := range audio.LineTimings {
+		if id, ok := im.ids[t.ScriptLineID]; ok {
+			created.LineTimings = append(created.LineTimings, model.AudioLineTiming{ScriptLineID: id, StartMs: t.StartMs, EndMs: t.EndMs})
+		}
+	}
+
+	im.uploads = append(im.uploads, channelArchiveUpload{file: file, path: created.Path, contentType: audio.MimeType})
+	im.audios[audio.File] = created
+	im.audioList = append(im.audioList, created)
+	return &created.ID
+}
+
+// newChannelArchiveImporter はマニフェストを取り込み先のモデルに変換する
+//
+// チャンネル・エピソードは非公開の状態で作成する（移行先で内容を確認してから公開する）
+func newChannelArchiveImporter(userID uuid.UUID, m *channelArchiveManifest, files map[string]*zip.File, plan *channelArchiveImportPlan) *channelArchiveImporter {
+	im := &channelArchiveImporter{
+		userID: userID,
+		files:  files,
+		plan:   plan,
+		ids:    make(map[uuid.UUID]uuid.UUID),
+		images: make(map[string]*model.Image),
+		audios: make(map[string]*model.Audio),
+	}
+
+	// 音声の再生区間を置き換えられるよう、台本行の ID を先に採番する
+	for _, e := range m.Episodes {
+		for _, l := range e.ScriptLines {
+			im.newID(l.ID)
+		}
+	}
+
+	for _, c := range m.Characters {
+		im.characters = append(im.characters, model.Character{
+			ID:            im.newID(c.ID),
+			UserID:        userID,
+			Name:          plan.characterNames[c.ID],
+			Persona:       c.Persona,
+			AvatarID:      im.image(c.Avatar),
+			VoiceID:       plan.voiceIDs[channelArchiveVoice{Provider: c.Voice.Provider, ProviderVoiceID: c.Voice.ProviderVoiceID}],
+			VoiceSettings: c.VoiceSettings,
+		})
+	}
+
+	for _, bgm := range m.Bgms {
+		im.bgms = append(im.bgms, model.Bgm{
+			ID:      im.newID(bgm.ID),
+			UserID:  userID,
+			AudioID: *im.audio(&bgm.Audio),
+			Name:    plan.bgmNames[bgm.ID],
+		})
+	}
+
+	for _, se := range m.SoundEffects {
+		im.soundEffects = append(im.soundEffects, model.SoundEffect{
+			ID:      im.newID(se.ID),
+			UserID:  userID,
+			AudioID: *im.audio(&se.Audio),
+			Name:    plan.soundEffectNames[se.ID],
+		})
+	}
+
+	im.channel = &model.Channel{
+		ID:               uuid.New(),
+		UserID:           userID,
+		Name:             m.Channel.Name,
+		Description:      m.Channel.Description,
+		UserPrompt:       m.Channel.UserPrompt,
+		CategoryID:       plan.categoryID,
+		ArtworkID:        im.image(m.Channel.Artwork),
+		IntroAudioID:     im.audio(m.Channel.IntroAudio),
+		IntroCrossfadeMs: m.Channel.IntroCrossfadeMs,
+		OutroAudioID:     im.audio(m.Channel.OutroAudio),
+		OutroCrossfadeMs: m.Channel.OutroCrossfadeMs,
+		Explicit:         m.Channel.Explicit,
+	}
+	if m.Channel.DefaultBgmID != nil {
+		id := im.newID(*m.Channel.DefaultBgmID)
+		im.channel.DefaultBgmID = &id
+	} else {
+		im.channel.DefaultSystemBgmID = im.systemBgmID(m.Channel.DefaultSystemBgm)
+	}
+
+	for _, id := range m.Channel.CharacterIDs {
+		im.characterIDs = append(im.characterIDs, im.newID(id))
+	}
+
+	for _, p := range m.Pronunciations {
+		im.pronunciations = append(im.pronunciations, model.Pronunciation{
+			ID:        uuid.New(),
+			UserID:    userID,
+			ChannelID: &im.channel.ID,
+			Surface:   p.Surface,
+			Reading:   p.Reading,
+		})
+	}
+
+	for _, e := range m.Episodes {
+		episode := model.Episode{
+			ID:           im.newID(e.ID),
+			ChannelID:    im.channel.ID,
+			Title:        e.Title,
+			Description:  e.Description,
+			ArtworkID:    im.image(e.Artwork),
+			VoiceAudioID: im.audio(e.VoiceAudio),
+			FullAudioID:  im.audio(e.FullAudio),
+			CreatedAt:    e.CreatedAt,
+		}
+		if e.BgmID != nil {
+			id := im.newID(*e.BgmID)
+			episode.BgmID = &id
+		} else {
+			episode.SystemBgmID = im.systemBgmID(e.SystemBgm)
+		}
+		im.episodes = append(im.episodes, episode)
+
+		lines := make([]model.ScriptLine, len(e.ScriptLines))
+		for i, l := range e.ScriptLines {
+			lines[i] = model.ScriptLine{
+				ID:        im.newID(l.ID),
+				EpisodeID: episode.ID,
+				LineOrder: i,
+				SpeakerID: im.newID(l.SpeakerID),
+				Text:      l.Text,
+				Emotion:   l.Emotion,
+			}
+
+			for _, cue := range l.SfxCues {
+				sfxCue := model.SfxCue{
+					ID:           uuid.New(),
+					ScriptLineID: lines[i].ID,
+					Position:     cue.Position,
+					VolumeDB:     cue.VolumeDB,
+				}
+				if cue.SoundEffectID != nil {
+					id := im.newID(*cue.SoundEffectID)
+					sfxCue.SoundEffectID = &id
+				} else if sfxCue.SystemSoundEffectID = im.systemSoundEffectID(cue.SystemSoundEffect); sfxCue.SystemSoundEffectID == nil {
+					continue
+				}
+				im.sfxCues = append(im.sfxCues, sfxCue)
+			}
+		}
+		im.scriptLines = append(im.scriptLines, lines)
+	}
+
+	return im
+}
+
+func (im *channelArchiveImporter) systemBgmID(name *string) *uuid.UUID {
+	if name == nil {
+		return nil
+	}
+	if id, ok := im.plan.systemBgmIDs[*name]; ok {
+		return &id
+	}
+	return nil
+}
+
+func (im *channelArchiveImporter) systemSoundEffectID(name *string) *uuid.UUID {
+	if name == nil {
+		return nil
+	}
+	if id, ok := im.plan.systemSoundEffects[*name]; ok {
+		return &id
+	}
+	return nil
+}
+
+// importArchive はメディアファイルをストレージにアップロードし、トランザクション内でチャンネル一式を作成する
+//
+// 作成に失敗した場合はアップロードしたメディアファイルを削除する
+func (s *channelArchiveService) importArchive(ctx context.Context, userID uuid.UUID, m *channelArchiveManifest, files map[string]*zip.File, plan *channelArchiveImportPlan) (*model.Channel, error) {
+	log := logger.FromContext(ctx)
+	im := newChannelArchiveImporter(userID, m, files, plan)
+
+	var uploaded []string
+	cleanup := func() {
+		for _, p := range uploaded {
+			if err := s.storageClient.Delete(ctx, p); err != nil {
+				log.Warn("failed to delete imported media", "error", err, "path", p)
+			}
+		}
+	}
+
+	for _, u := range im.uploads {
+		if err := s.uploadArchiveMedia(ctx, u); err != nil {
+			cleanup()
+			log.Error("failed to upload channel archive media", "error", err, "file", u.file.Name)
+			var appErr *apperror.AppError
+			if errors.As(err, &appErr) {
+				return nil, err
+			}
+			return nil, apperror.ErrMediaUploadFailed.WithMessage(fmt.Sprintf("%s のアップロードに失敗しました", u.file.Name)).WithError(err)
+		}
+		uploaded = append(uploaded, u.path)
+	}
+
+	err := s.db.Transaction(func(tx *gorm.DB) error {
+		return im.create(ctx, tx)
+	})
+	if err != nil {
+		cleanup()
+		return nil, err
+	}
+
+	log.Info("channel archive imported", "channel_id", im.channel.ID, "episodes", len(im.episodes), "media_files", len(im.uploads))
+	return im.channel, nil
+}
+
+// uploadArchiveMedia はアーカイブ内のメディアファイルを展開しながらストレージにアップロードする
+func (s *channelArchiveService) uploadArchiveMedia(ctx context.Context, u channelArchiveUpload) error {
+	rc, err := u.file.Open()
+	if err != nil {
+		return apperror.ErrValidation.WithMessage(fmt.Sprintf("%s を読み込めません", u.file.Name)).WithError(err)
+	}
+	defer rc.Close()
+
+	_, err = s.storageClient.UploadStream(ctx, rc, u.path, u.contentType)
+	return err
+}
+
+// create はトランザクション内で、参照される側から順にモデルを作成する
+func (im *channelArchiveImporter) create(ctx context.Context, tx *gorm.DB) error {
+	imageRepo := repository.NewImageRepository(tx)
+	audioRepo := repository.NewAudioRepository(tx)
+	characterRepo := repository.NewCharacterRepository(tx)
+	bgmRepo := repository.NewBgmRepository(tx)
+	soundEffectRepo := repository.NewSoundEffectRepository(tx)
+	channelRepo := repository.NewChannelRepository(tx)
+	pronunciationRepo := repository.NewPronunciationRepository(tx)
+	episodeRepo := repository.NewEpisodeRepository(tx)
+	scriptLineRepo := repository.NewScriptLineRepository(tx)
+	sfxCueRepo := repository.NewSfxCueRepository(tx)
+
+	for _, image := range im.imageList {
+		if err := imageRepo.Create(ctx, image); err != nil {
+			return err
+		}
+	}
+	for _, audio := range im.audioList {
+		if err := audioRepo.Create(ctx, audio); err != nil {
+			return err
+		}
+	}
+	for i := range im.characters {
+		if err := characterRepo.Create(ctx, &im.characters[i]); err != nil {
+			return err
+		}
+	}
+	for i := range im.bgms {
+		if err := bgmRepo.Create(ctx, &im.bgms[i]); err != nil {
+			return err
+		}
+	}
+	for i := range im.soundEffects {
+		if err := soundEffectRepo.Create(ctx, &im.soundEffects[i]); err != nil {
+			return err
+		}
+	}
+
+	if err := channelRepo.Create(ctx, im.channel); err != nil {
+		return err
+	}
+	if err := channelRepo.ReplaceChannelCharacters(ctx, im.channel.ID, im.characterIDs); err != nil {
+		return err
+	}
+
+	for i := range im.pronunciations {
+		if err := pronunciationRepo.Create(ctx, &im.pronunciations[i]); err != nil {
+			return err
+		}
+	}
+
+	for i := range im.episodes {
+		if err := episodeRepo.Create(ctx, &im.episodes[i]); err != nil {
+			return err
+		}
+		if _, err := scriptLineRepo.CreateBatch(ctx, im.scriptLines[i]); err != nil {
+			return err
+		}
+	}
+
+	for i := range im.sfxCues {
+		if err := sfxCueRepo.Create(ctx, &im.sfxCues[i]); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
@@ -0,0 +1,595 @@
+package service
+
+import (
+	"archive/zip"
+	"bytes"
+	"context"
+	"encoding/json"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+
+	"github.com/siropaca/anycast-backend/internal/apperror"
+	"github.com/siropaca/anycast-backend/internal/dto/response"
+	"github.com/siropaca/anycast-backend/internal/infrastructure/storage"
+	"github.com/siropaca/anycast-backend/internal/model"
+	"github.com/siropaca/anycast-backend/internal/pkg/uuid"
+	"github.com/siropaca/anycast-backend/internal/repository"
+)
+
+// CharacterRepository のモック
+type mockCharacterRepository struct {
+	mock.Mock
+}
+
+func (m *mockCharacterRepository) FindByID(ctx context.Context, id uuid.UUID) (*model.Character, error) {
+	args := m.Called(ctx, id)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).(*model.Character), args.Error(1)
+}
+
+func (m *mockCharacterRepository) FindByUserID(ctx context.Context, userID uuid.UUID, filter repository.CharacterFilter) ([]model.Character, int64, error) {
+	args := m.Called(ctx, userID, filter)
+	if args.Get(0) == nil {
+		return nil, 0, args.Error(2)
+	}
+	return args.Get(0).([]model.Character), args.Get(1).(int64), args.Error(2)
+}
+
+func (m *mockCharacterRepository) FindByIDs(ctx context.Context, ids []uuid.UUID) ([]model.Character, error) {
+	args := m.Called(ctx, ids)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).([]model.Character), args.Error(1)
+}
+
+func (m *mockCharacterRepository) Create(ctx context.Context, character *model.Character) error {
+	args := m.Called(ctx, character)
+	return args.Error(0)
+}
+
+func (m *mockCharacterRepository) Update(ctx context.Context, character *model.Character) error {
+	args := m.Called(ctx, character)
+	return args.Error(0)
+}
+
+func (m *mockCharacterRepository) Delete(ctx context.Context, id uuid.UUID) error {
+	args := m.Called(ctx, id)
+	return args.Error(0)
+}
+
+func (m *mockCharacterRepository) IsUsedInAnyChannel(ctx context.Context, id uuid.UUID) (bool, error) {
+	args := m.Called(ctx, id)
+	return args.Bool(0), args.Error(1)
+}
+
+func (m *mockCharacterRepository) ExistsByUserIDAndName(ctx context.Context, userID uuid.UUID, name string, excludeID *uuid.UUID) (bool, error) {
+	args := m.Called(ctx, userID, name, excludeID)
+	return args.Bool(0), args.Error(1)
+}
+
+// writeTestChannelArchive はマニフェストとメディアファイルから ZIP を作成する
+func writeTestChannelArchive(t *testing.T, manifest any, media map[string]string) *bytes.Reader {
+	t.Helper()
+
+	var buf bytes.Buffer
+	zw := zip.NewWriter(&buf)
+
+	if manifest != nil {
+		data, err := json.Marshal(manifest)
+		require.NoError(t, err)
+		w, err := zw.Create(channelArchiveManifestFile)
+		require.NoError(t, err)
+		_, err = w.Write(data)
+		require.NoError(t, err)
+	}
+
+	for name, content := range media {
+		w, err := zw.Create(name)
+		require.NoError(t, err)
+		_, err = w.Write([]byte(content))
+		require.NoError(t, err)
+	}
+
+	require.NoError(t, zw.Close())
+	return bytes.NewReader(buf.Bytes())
+}
+
+// newTestChannelArchiveManifest は取り込みのテストに使うマニフェストを返す
+func newTestChannelArchiveManifest() (*channelArchiveManifest, map[string]string) {
+	characterID := uuid.New()
+	bgmID := uuid.New()
+	soundEffectID := uuid.New()
+	lineID := uuid.New()
+	systemSoundEffect := "拍手"
+	missingSystemSoundEffect := "ドラムロール"
+
+	manifest := &channelArchiveManifest{
+		FormatVersion: channelArchiveFormatVersion,
+		Channel: channelArchiveChannel{
+			Name:         "テックトーク",
+			CategorySlug: "technology",
+			Artwork:      &channelArchiveImage{URL: "https://example.com/artwork.png", MimeType: "image/png"},
+			CharacterIDs: []uuid.UUID{characterID},
+			DefaultBgmID: &bgmID,
+		},
+		Characters: []channelArchiveCharacter{
+			{ID: characterID, Name: "太郎", Voice: channelArchiveVoice{Provider: "google", ProviderVoiceID: "ja-JP-Voice-A", Name: "Voice A"}},
+		},
+		Bgms: []channelArchiveBgm{
+			{ID: bgmID, Name: "オープニング", Audio: channelArchiveAudio{File: "media/audios/bgm.mp3", MimeType: "audio/mpeg"}},
+		},
+		SoundEffects: []channelArchiveSoundEffect{
+			{ID: soundEffectID, Name: "チャイム", Audio: channelArchiveAudio{File: "media/audios/chime.mp3", MimeType: "audio/mpeg"}},
+		},
+		Pronunciations: []channelArchivePronunciation{{Surface: "API", Reading: "エーピーアイ"}},
+		Episodes: []channelArchiveEpisode{
+			{
+				ID:    uuid.New(),
+				Title: "第1回",
+				FullAudio: &channelArchiveAudio{
+					File:        "media/audios/full.mp3",
+					MimeType:    "audio/mpeg",
+					DurationMs:  5000,
+					LineTimings: model.AudioLineTimings{{ScriptLineID: lineID, StartMs: 0, EndMs: 5000}},
+				},
+				ScriptLines: []channelArchiveScriptLine{
+					{
+						ID:        lineID,
+						SpeakerID: characterID,
+						Text:      "こんにちは",
+						SfxCues: []channelArchiveSfxCue{
+							{SoundEffectID: &soundEffectID, Position: model.SfxCuePositionBefore},
+							{SystemSoundEffect: &systemSoundEffect, Position: model.SfxCuePositionAfter},
+							{SystemSoundEffect: &missingSystemSoundEffect, Position: model.SfxCuePositionAfter},
+						},
+					},
+				},
+			},
+		},
+	}
+	media := map[string]string{
+		"media/audios/bgm.mp3":   "bgm",
+		"media/audios/chime.mp3": "chime",
+		"media/audios/full.mp3":  "full",
+	}
+	return manifest, media
+}
+
+func TestBuildChannelArchiveContents(t *testing.T) {
+	speakerID := uuid.New()
+	bgm := &model.Bgm{ID: uuid.New(), Name: "オープニング", Audio: model.Audio{ID: uuid.New(), Path: "audios/bgm.mp3", MimeType: "audio/mpeg"}}
+	chime := &model.SoundEffect{ID: uuid.New(), Name: "チャイム", Audio: model.Audio{ID: uuid.New(), Path: "audios/chime.mp3"}}
+	uploaded := &model.Audio{ID: uuid.New(), Path: "audios/upload.wav", MimeType: "audio/wav", DurationMs: 3000}
+	artwork := &model.Image{ID: uuid.New(), Path: "images/artwork.png", MimeType: "image/png"}
+	speaker := model.Character{
+		ID:      speakerID,
+		Name:    "太郎",
+		Persona: "明るい",
+		Voice:   model.Voice{Provider: "google", ProviderVoiceID: "ja-JP-Voice-A", Name: "Voice A"},
+		Avatar:  &model.Image{ID: uuid.New(), Path: "https://example.com/avatar.png", MimeType: "image/png"},
+	}
+	channel := &model.Channel{
+		ID:                uuid.New(),
+		Name:              "テックトーク",
+		Category:          model.Category{Slug: "technology"},
+		Artwork:           artwork,
+		DefaultBgmID:      &bgm.ID,
+		DefaultBgm:        bgm,
+		ChannelCharacters: []model.ChannelCharacter{{CharacterID: speakerID, Character: speaker}},
+	}
+	lineID := uuid.New()
+	sources := []channelArchiveEpisodeSource{
+		{
+			episode: model.Episode{
+				ID:         uuid.New(),
+				Title:      "第1回",
+				Artwork:    artwork,
+				Bgm:        bgm,
+				VoiceAudio: uploaded,
+				FullAudio:  uploaded,
+			},
+			lines: []model.ScriptLine{{ID: lineID, SpeakerID: speakerID, Text: "こんにちは"}},
+			cues: []model.SfxCue{
+				{ScriptLineID: lineID, SoundEffect: chime, Position: model.SfxCuePositionBefore},
+				{ScriptLineID: lineID, SystemSoundEffect: &model.SystemSoundEffect{Name: "拍手"}, Position: model.SfxCuePositionAfter, VolumeDB: -3},
+			},
+		},
+	}
+	pronunciations := []model.Pronunciation{{Surface: "API", Reading: "エーピーアイ"}}
+
+	contents, err := buildChannelArchiveContents(channel, []model.Character{speaker}, sources, pronunciations)
+	require.NoError(t, err)
+
+	t.Run("同じ音声・画像は 1 つだけ含め、外部 URL の画像は含めない", func(t *testing.T) {
+		assert.ElementsMatch(t, []zipArchiveMedia{
+			{name: "media/images/" + artwork.ID.String() + ".png", path: "images/artwork.png"},
+			{name: "media/audios/" + bgm.Audio.ID.String() + ".mp3", path: "audios/bgm.mp3"},
+			{name: "media/audios/" + uploaded.ID.String() + ".wav", path: "audios/upload.wav"},
+			{name: "media/audios/" + chime.Audio.ID.String() + ".mp3", path: "audios/chime.mp3"},
+		}, contents.media)
+	})
+
+	t.Run("マニフェストに設定・キャラクター・エピソード・台本・効果音キューを含める", func(t *testing.T) {
+		require.Len(t, contents.files, 1)
+		assert.Equal(t, channelArchiveManifestFile, contents.files[0].name)
+
+		var manifest channelArchiveManifest
+		require.NoError(t, json.Unmarshal(contents.files[0].data, &manifest))
+
+		assert.Equal(t, channelArchiveFormatVersion, manifest.FormatVersion)
+		assert.Equal(t, "technology", manifest.Channel.CategorySlug)
+		assert.Equal(t, []uuid.UUID{speakerID}, manifest.Channel.CharacterIDs)
+		assert.Equal(t, &bgm.ID, manifest.Channel.DefaultBgmID)
+
+		require.Len(t, manifest.Characters, 1)
+		assert.Equal(t, channelArchiveVoice{Provider: "google", ProviderVoiceID: "ja-JP-Voice-A", Name: "Voice A"}, manifest.Characters[0].Voice)
+		assert.Equal(t, "https://example.com/avatar.png", manifest.Characters[0].Avatar.URL)
+		assert.Empty(t, manifest.Characters[0].Avatar.File)
+
+		require.Len(t, manifest.Bgms, 1)
+		require.Len(t, manifest.SoundEffects, 1)
+		assert.Equal(t, "チャイム", manifest.SoundEffects[0].Name)
+		assert.Equal(t, []channelArchivePronunciation{{Surface: "API", Reading: "エーピーアイ"}}, manifest.Pronunciations)
+
+		require.Len(t, manifest.Episodes, 1)
+		episode := manifest.Episodes[0]
+		assert.Equal(t, episode.VoiceAudio.File, episode.FullAudio.File)
+		require.Len(t, episode.ScriptLines, 1)
+		cues := episode.ScriptLines[0].SfxCues
+		require.Len(t, cues, 2)
+		assert.Equal(t, &chime.ID, cues[0].SoundEffectID)
+		assert.Equal(t, "拍手", *cues[1].SystemSoundEffect)
+		assert.Equal(t, -3.0, cues[1].VolumeDB)
+	})
+
+	t.Run("同じ内容なら同じハッシュになる", func(t *testing.T) {
+		again, err := buildChannelArchiveContents(channel, []model.Character{speaker}, sources, pronunciations)
+		require.NoError(t, err)
+		assert.Equal(t, contents.hash(channelArchiveFormatVersion), again.hash(channelArchiveFormatVersion))
+	})
+}
+
+func TestReadChannelArchive(t *testing.T) {
+	t.Run("マニフェストを読み込む", func(t *testing.T) {
+		manifest, media := newTestChannelArchiveManifest()
+		r := writeTestChannelArchive(t, manifest, media)
+
+		got, files, err := readChannelArchive(r, r.Size())
+
+		require.NoError(t, err)
+		assert.Equal(t, "テックトーク", got.Channel.Name)
+		assert.Contains(t, files, "media/audios/full.mp3")
+	})
+
+	t.Run("ZIP でない場合はバリデーションエラーを返す", func(t *testing.T) {
+		r := bytes.NewReader([]byte("not a zip"))
+
+		_, _, err := readChannelArchive(r, r.Size())
+
+		assert.True(t, apperror.IsCode(err, apperror.CodeValidation))
+	})
+
+	t.Run("channel.json がない場合はバリデーションエラーを返す", func(t *testing.T) {
+		r := writeTestChannelArchive(t, nil, map[string]string{"media/audios/full.mp3": "full"})
+
+		_, _, err := readChannelArchive(r, r.Size())
+
+		assert.True(t, apperror.IsCode(err, apperror.CodeValidation))
+	})
+
+	t.Run("新しい形式のアーカイブはバリデーションエラーを返す", func(t *testing.T) {
+		manifest, media := newTestChannelArchiveManifest()
+		manifest.FormatVersion = channelArchiveFormatVersion + 1
+		r := writeTestChannelArchive(t, manifest, media)
+
+		_, _, err := readChannelArchive(r, r.Size())
+
+		assert.True(t, apperror.IsCode(err, apperror.CodeValidation))
+	})
+
+	t.Run("参照先が見つからない場合は問題をすべて details に含める", func(t *testing.T) {
+		manifest, media := newTestChannelArchiveManifest()
+		manifest.Episodes[0].ScriptLines[0].SpeakerID = uuid.New()
+		delete(media, "media/audios/full.mp3")
+		r := writeTestChannelArchive(t, manifest, media)
+
+		_, _, err := readChannelArchive(r, r.Size())
+
+		var appErr *apperror.AppError
+		require.ErrorAs(t, err, &appErr)
+		assert.Equal(t, apperror.CodeValidation, appErr.Code)
+		assert.Len(t, appErr.Details, 2)
+	})
+
+	t.Run("対応していない形式の音声・画像はバリデーションエラーを返す", func(t *testing.T) {
+		manifest, media := newTestChannelArchiveManifest()
+		manifest.Bgms[0].Audio.MimeType = "text/html"
+		manifest.Characters[0].Avatar = &channelArchiveImage{File: "media/images/avatar.svg", MimeType: "image/svg+xml"}
+		media["media/images/avatar.svg"] = "<svg/>"
+		r := writeTestChannelArchive(t, manifest, media)
+
+		_, _, err := readChannelArchive(r, r.Size())
+
+		var appErr *apperror.AppError
+		require.ErrorAs(t, err, &appErr)
+		assert.Equal(t, apperror.CodeValidation, appErr.Code)
+		assert.Len(t, appErr.Details, 2)
+	})
+
+	t.Run("展開後の合計サイズが上限を超える場合はバリデーションエラーを返す", func(t *testing.T) {
+		manifest, _ := newTestChannelArchiveManifest()
+		data, err := json.Marshal(manifest)
+		require.NoError(t, err)
+
+		var buf bytes.Buffer
+		zw := zip.NewWriter(&buf)
+		w, err := zw.Create(channelArchiveManifestFile)
+		require.NoError(t, err)
+		_, err = w.Write(data)
+		require.NoError(t, err)
+		// ヘッダー上の展開後のサイズが上限を超えるファイル（内容は読まれない）
+		for _, name := range []string{"media/audios/a.mp3", "media/audios/b.mp3"} {
+			w, err := zw.CreateRaw(&zip.FileHeader{
+				Name:               name,
+				Method:             zip.Deflate,
+				CompressedSize64:   1,
+				UncompressedSize64: channelArchiveMaxUncompressedSize/2 + 1,
+			})
+			require.NoError(t, err)
+			_, err = w.Write([]byte{0})
+			require.NoError(t, err)
+		}
+		require.NoError(t, zw.Close())
+		r := bytes.NewReader(buf.Bytes())
+
+		_, _, err = readChannelArchive(r, r.Size())
+
+		var appErr *apperror.AppError
+		require.ErrorAs(t, err, &appErr)
+		assert.Equal(t, apperror.CodeValidation, appErr.Code)
+		assert.Contains(t, appErr.Message, "展開後のファイルサイズ")
+	})
+}
+
+// newTestChannelArchiveService は取り込みの競合確認までに使うリポジトリのモックでサービスを作成する
+func newTestChannelArchiveService(
+	characterRepo *mockCharacterRepository,
+	categoryRepo *mockCategoryRepository,
+	voiceRepo *mockVoiceRepository,
+	bgmRepo *mockBgmRepository,
+	soundEffectRepo *mockSoundEffectRepository,
+	systemSoundEffectRepo *mockSystemSoundEffectRepository,
+) ChannelArchiveService {
+	return NewChannelArchiveService(
+		nil,
+		new(mockChannelRepository),
+		characterRepo,
+		new(mockEpisodeRepository),
+		new(mockScriptLineRepository),
+		new(mockSfxCueRepository),
+		new(mockPronunciationRepository),
+		categoryRepo,
+		voiceRepo,
+		bgmRepo,
+		new(mockSystemBgmRepository),
+		soundEffectRepo,
+		systemSoundEffectRepo,
+		new(mockStorageClient),
+	)
+}
+
+func TestChannelArchiveService_ImportChannel(t *testing.T) {
+	userID := uuid.New()
+	google := "google"
+
+	t.Run("dryRun の場合は競合と件数を返し、何も作成しない", func(t *testing.T) {
+		characterRepo := new(mockCharacterRepository)
+		categoryRepo := new(mockCategoryRepository)
+		voiceRepo := new(mockVoiceRepository)
+		bgmRepo := new(mockBgmRepository)
+		soundEffectRepo := new(mockSoundEffectRepository)
+		systemSoundEffectRepo := new(mockSystemSoundEffectRepository)
+
+		categoryRepo.On("FindBySlug", mock.Anything, "technology").Return(&model.Category{ID: uuid.New(), Slug: "technology"}, nil)
+		voiceRepo.On("FindAll", mock.Anything, repository.VoiceFilter{Provider: &google}).Return([]model.Voice{{ID: uuid.New(), Provider: "google", ProviderVoiceID: "ja-JP-Voice-A"}}, nil)
+		characterRepo.On("ExistsByUserIDAndName", mock.Anything, userID, "太郎", (*uuid.UUID)(nil)).Return(true, nil)
+		characterRepo.On("ExistsByUserIDAndName", mock.Anything, userID, "太郎 (2)", (*uuid.UUID)(nil)).Return(true, nil)
+		characterRepo.On("ExistsByUserIDAndName", mock.Anything, userID, "太郎 (3)", (*uuid.UUID)(nil)).Return(false, nil)
+		bgmRepo.On("ExistsByUserIDAndName", mock.Anything, userID, "オープニング", (*uuid.UUID)(nil)).Return(false, nil)
+		soundEffectRepo.On("ExistsByUserIDAndName", mock.Anything, userID, "チャイム", (*uuid.UUID)(nil)).Return(false, nil)
+		systemSoundEffectRepo.On("FindActiveByNames", mock.Anything, []string{"拍手", "ドラムロール"}).Return([]model.SystemSoundEffect{{ID: uuid.New(), Name: "拍手"}}, nil)
+
+		manifest, media := newTestChannelArchiveManifest()
+		r := writeTestChannelArchive(t, manifest, media)
+		svc := newTestChannelArchiveService(characterRepo, categoryRepo, voiceRepo, bgmRepo, soundEffectRepo, systemSoundEffectRepo)
+
+		result, err := svc.ImportChannel(context.Background(), userID.String(), ImportChannelArchiveInput{File: r, Size: r.Size(), DryRun: true})
+
+		require.NoError(t, err)
+		assert.True(t, result.Data.DryRun)
+		assert.Nil(t, result.Data.Channel)
+		assert.Equal(t, response.ChannelArchiveImportCountsResponse{
+			Characters:     1,
+			Bgms:           1,
+			SoundEffects:   1,
+			Pronunciations: 1,
+			Episodes:       1,
+			ScriptLines:    1,
+			SfxCues:        2,
+			MediaFiles:     3,
+		}, result.Data.Counts)
+
+		require.Len(t, result.Data.Conflicts, 2)
+		assert.Equal(t, channelArchiveConflictCharacterName, result.Data.Conflicts[0].Type)
+		assert.Equal(t, channelArchiveResolutionRenamed, result.Data.Conflicts[0].Resolution)
+		assert.Equal(t, "太郎 (3)", *result.Data.Conflicts[0].RenamedTo)
+		assert.Equal(t, channelArchiveConflictSystemSoundEffect, result.Data.Conflicts[1].Type)
+		assert.Equal(t, "ドラムロール", result.Data.Conflicts[1].Name)
+		assert.Equal(t, channelArchiveResolutionDropped, result.Data.Conflicts[1].Resolution)
+	})
+
+	t.Run("ボイス・カテゴリが見つからない場合は IMPORT_CONFLICT を返す", func(t *testing.T) {
+		categoryRepo := new(mockCategoryRepository)
+		voiceRepo := new(mockVoiceRepository)
+
+		categoryRepo.On("FindBySlug", mock.Anything, "technology").Return(nil, apperror.ErrNotFound.WithMessage("カテゴリが見つかりません"))
+		voiceRepo.On("FindAll", mock.Anything, repository.VoiceFilter{Provider: &google}).Return([]model.Voice{}, nil)
+
+		manifest, media := newTestChannelArchiveManifest()
+		r := writeTestChannelArchive(t, manifest, media)
+		svc := newTestChannelArchiveService(new(mockCharacterRepository), categoryRepo, voiceRepo, new(mockBgmRepository), new(mockSoundEffectRepository), new(mockSystemSoundEffectRepository))
+
+		_, err := svc.ImportChannel(context.Background(), userID.String(), ImportChannelArchiveInput{File: r, Size: r.Size()})
+
+		var appErr *apperror.AppError
+		require.ErrorAs(t, err, &appErr)
+		assert.Equal(t, apperror.CodeImportConflict, appErr.Code)
+		conflicts, ok := appErr.Details.([]response.ChannelArchiveConflictResponse)
+		require.True(t, ok)
+		require.Len(t, conflicts, 2)
+		assert.Equal(t, channelArchiveConflictCategory, conflicts[0].Type)
+		assert.Equal(t, channelArchiveConflictVoice, conflicts[1].Type)
+		assert.Equal(t, "google/ja-JP-Voice-A", conflicts[1].Name)
+	})
+}
+
+func TestNewChannelArchiveImporter(t *testing.T) {
+	userID := uuid.New()
+	manifest, media := newTestChannelArchiveManifest()
+	r := writeTestChannelArchive(t, manifest, media)
+	_, files, err := readChannelArchive(r, r.Size())
+	require.NoError(t, err)
+
+	characterID := manifest.Characters[0].ID
+	plan := &channelArchiveImportPlan{
+		categoryID:         uuid.New(),
+		voiceIDs:           map[channelArchiveVoice]uuid.UUID{{Provider: "google", ProviderVoiceID: "ja-JP-Voice-A"}: uuid.New()},
+		characterNames:     map[uuid.UUID]string{characterID: "太郎 (2)"},
+		bgmNames:           map[uuid.UUID]string{manifest.Bgms[0].ID: "オープニング"},
+		soundEffectNames:   map[uuid.UUID]string{manifest.SoundEffects[0].ID: "チャイム"},
+		systemBgmIDs:       map[string]uuid.UUID{},
+		systemSoundEffects: map[string]uuid.UUID{"拍手": uuid.New()},
+	}
+
+	im := newChannelArchiveImporter(userID, manifest, files, plan)
+
+	t.Run("ID を採番し直し、参照を新しい ID に置き換える", func(t *testing.T) {
+		require.Len(t, im.characters, 1)
+		character := im.characters[0]
+		assert.NotEqual(t, characterID, character.ID)
+		assert.Equal(t, "太郎 (2)", character.Name)
+		assert.Equal(t, plan.voiceIDs[channelArchiveVoice{Provider: "google", ProviderVoiceID: "ja-JP-Voice-A"}], character.VoiceID)
+		assert.Equal(t, []uuid.UUID{character.ID}, im.characterIDs)
+
+		assert.Equal(t, userID, im.channel.UserID)
+		assert.Equal(t, plan.categoryID, im.channel.CategoryID)
+		assert.Equal(t, im.bgms[0].ID, *im.channel.DefaultBgmID)
+		assert.Nil(t, im.channel.PublishedAt)
+
+		require.Len(t, im.scriptLines, 1)
+		line := im.scriptLines[0][0]
+		assert.Equal(t, im.episodes[0].ID, line.EpisodeID)
+		assert.Equal(t, character.ID, line.SpeakerID)
+	})
+
+	t.Run("音声の台本行の再生区間を新しい台本行の ID に置き換える", func(t *testing.T) {
+		line := im.scriptLines[0][0]
+		var full *model.Audio
+		for _, a := range im.audioList {
+			if a.ID == *im.episodes[0].FullAudioID {
+				full = a
+			}
+		}
+		require.NotNil(t, full)
+		assert.Equal(t, model.AudioLineTimings{{ScriptLineID: line.ID, StartMs: 0, EndMs: 5000}}, full.LineTimings)
+	})
+
+	t.Run("取り込み先にないシステム効果音のキューは除く", func(t *testing.T) {
+		require.Len(t, im.sfxCues, 2)
+		assert.Equal(t, im.soundEffects[0].ID, *im.sfxCues[0].SoundEffectID)
+		assert.Equal(t, plan.systemSoundEffects["拍手"], *im.sfxCues[1].SystemSoundEffectID)
+	})
+
+	t.Run("ZIP 内のメディアファイルを新しいパスにアップロードし、外部 URL の画像はそのまま参照する", func(t *testing.T) {
+		assert.Len(t, im.uploads, 3)
+		for _, u := range im.uploads {
+			assert.Regexp(t, `^audios/[0-9a-f-]+\.mp3$`, u.path)
+		}
+		require.Len(t, im.imageList, 1)
+		assert.Equal(t, "https://example.com/artwork.png", im.imageList[0].Path)
+	})
+}
+
+func TestChannelArchiveImporter_MediaPath(t *testing.T) {
+	files := map[string]*zip.File{
+		"media/audios/full.html": {FileHeader: zip.FileHeader{Name: "media/audios/full.html"}},
+		"media/images/cover.exe": {FileHeader: zip.FileHeader{Name: "media/images/cover.exe"}},
+	}
+	im := &channelArchiveImporter{
+		files:  files,
+		ids:    make(map[uuid.UUID]uuid.UUID),
+		images: make(map[string]*model.Image),
+		audios: make(map[string]*model.Audio),
+	}
+
+	t.Run("音声の拡張子はファイル名ではなく MIME タイプから決める", func(t *testing.T) {
+		im.audio(&channelArchiveAudio{File: "media/audios/full.html", MimeType: "audio/mp4"})
+
+		require.Len(t, im.audioList, 1)
+		assert.Regexp(t, `^audios/[0-9a-f-]+\.m4a$`, im.audioList[0].Path)
+	})
+
+	t.Run("画像の拡張子はファイル名ではなく MIME タイプから決める", func(t *testing.T) {
+		im.image(&channelArchiveImage{File: "media/images/cover.exe", MimeType: "image/jpeg"})
+
+		require.Len(t, im.imageList, 1)
+		assert.Regexp(t, `^images/[0-9a-f-]+\.jpg$`, im.imageList[0].Path)
+	})
+}
+
+func TestChannelArchiveService_ExportChannel(t *testing.T) {
+	userID := uuid.New()
+	channelID := uuid.New()
+
+	t.Run("作成済みのアーカイブがある場合は作り直さない", func(t *testing.T) {
+		channelRepo := new(mockChannelRepository)
+		episodeRepo := new(mockEpisodeRepository)
+		pronunciationRepo := new(mockPronunciationRepository)
+		storageClient := new(mockStorageClient)
+
+		channelRepo.On("FindByID", mock.Anything, channelID).Return(&model.Channel{ID: channelID, UserID: userID, Name: "テックトーク"}, nil)
+		episodeRepo.On("FindByChannelID", mock.Anything, channelID, mock.AnythingOfType("repository.EpisodeFilter")).Return([]model.Episode{}, int64(0), nil)
+		pronunciationRepo.On("FindByChannelID", mock.Anything, channelID).Return([]model.Pronunciation{}, nil)
+		storageClient.On("Exists", mock.Anything, mock.MatchedBy(func(p string) bool {
+			return strings.HasPrefix(p, "downloads/channels/"+channelID.String()+"/")
+		})).Return(true, nil)
+		storageClient.On("GenerateSignedURL", mock.Anything, mock.AnythingOfType("string"), storage.SignedURLExpirationPackage).Return("https://storage.example.com/channel.zip", nil)
+
+		svc := NewChannelArchiveService(nil, channelRepo, new(mockCharacterRepository), episodeRepo, new(mockScriptLineRepository), new(mockSfxCueRepository), pronunciationRepo,
+			new(mockCategoryRepository), new(mockVoiceRepository), new(mockBgmRepository), new(mockSystemBgmRepository), new(mockSoundEffectRepository), new(mockSystemSoundEffectRepository), storageClient)
+		result, err := svc.ExportChannel(context.Background(), userID.String(), channelID.String())
+
+		require.NoError(t, err)
+		assert.True(t, result.Data.Cached)
+		assert.Equal(t, "channel-"+channelID.String()+".zip", result.Data.Filename)
+		storageClient.AssertNotCalled(t, "UploadStream", mock.Anything, mock.Anything, mock.Anything, mock.Anything)
+	})
+
+	t.Run("オーナーでない場合は Forbidden を返す", func(t *testing.T) {
+		channelRepo := new(mockChannelRepository)
+		channelRepo.On("FindByID", mock.Anything, channelID).Return(&model.Channel{ID: channelID, UserID: uuid.New()}, nil)
+
+		svc := NewChannelArchiveService(nil, channelRepo, new(mockCharacterRepository), new(mockEpisodeRepository), new(mockScriptLineRepository), new(mockSfxCueRepository), new(mockPronunciationRepository),
+			new(mockCategoryRepository), new(mockVoiceRepository), new(mockBgmRepository), new(mockSystemBgmRepository), new(mockSoundEffectRepository), new(mockSystemSoundEffectRepository), new(mockStorageClient))
+		_, err := svc.ExportChannel(context.Background(), userID.String(), channelID.String())
+
+		assert.True(t, apperror.IsCode(err, apperror.CodeForbidden))
+	})
+}
@@ -0,0 +1,257 @@
+package service
+
+import (
+	"bytes"
+	"context"
+
+	"github.com/siropaca/anycast-backend/internal/apperror"
+	"github.com/siropaca/anycast-backend/internal/dto/request"
+	"github.com/siropaca/anycast-backend/internal/dto/response"
+	"github.com/siropaca/anycast-backend/internal/infrastructure/storage"
+	"github.com/siropaca/anycast-backend/internal/model"
+	"github.com/siropaca/anycast-backend/internal/pkg/audio"
+	"github.com/siropaca/anycast-backend/internal/pkg/logger"
+	"github.com/siropaca/anycast-backend/internal/pkg/uuid"
+)
+
+// channelSegment はチャンネルのイントロ・アウトロのどちらを操作するかを表す
+type channelSegment string
+
+const (
+	channelSegmentIntro channelSegment = "intro"
+	channelSegmentOutro channelSegment = "outro"
+)
+
+// SetIntro は指定されたチャンネルにイントロを設定する
+func (s *channelService) SetIntro(ctx context.Context, userID, channelID string, req request.SetChannelSegmentRequest) (*response.ChannelDataResponse, error) {
+	return s.setSegment(ctx, userID, channelID, channelSegmentIntro, req)
+}
+
+// DeleteIntro は指定されたチャンネルのイントロを削除する
+func (s *channelService) DeleteIntro(ctx context.Context, userID, channelID string) (*response.ChannelDataResponse, error) {
+	return s.deleteSegment(ctx, userID, channelID, channelSegmentIntro)
+}
+
+// SetOutro は指定されたチャンネルにアウトロを設定する
+func (s *channelService) SetOutro(ctx context.Context, userID, channelID string, req request.SetChannelSegmentRequest) (*response.ChannelDataResponse, error) {
+	return s.setSegment(ctx, userID, channelID, channelSegmentOutro, req)
+}
+
+// DeleteOutro は指定されたチャンネルのアウトロを削除する
+func (s *channelService) DeleteOutro(ctx context.Context, userID, channelID string) (*response.ChannelDataResponse, error) {
+	return s.deleteSegment(ctx, userID, channelID, channelSegmentOutro)
+}
+
+// setSegment はチャンネルにイントロまたはアウトロを設定する
+//
+// audioId を指定した場合はアップロード済みの音声をそのまま使い、
+// tts を指定した場合はキャラクターのボイスで読み上げた音声をこの時点で 1 度だけ生成して保存する
+func (s *channelService) setSegment(ctx context.Context, userID, channelID string, segment channelSegment, req request.SetChannelSegmentRequest) (*response.ChannelDataResponse, error) {
+	uid, err := uuid.Parse(userID)
+	if err != nil {
+		return nil, err
+	}
+
+	cid, err := uuid.Parse(channelID)
+	if err != nil {
+		return nil, err
+	}
+
+	// audioId と tts の同時指定チェック
+	if req.AudioID != nil && req.TTS != nil {
+		return nil, apperror.ErrValidation.WithMessage("audioId と tts は同時に指定できません")
+	}
+
+	// どちらも指定されていない場合
+	if req.AudioID == nil && req.TTS == nil {
+		return nil, apperror.ErrValidation.WithMessage("audioId または tts のいずれかを指定してください")
+	}
+
+	// チャンネルの存在確認とオーナーチェック
+	channel, err := s.channelRepo.FindByID(ctx, cid)
+	if err != nil {
+		return nil, err
+	}
+
+	if channel.UserID != uid {
+		return nil, apperror.ErrForbidden.WithMessage("このチャンネルのイントロ・アウトロ設定権限がありません")
+	}
+
+	var audioID uuid.UUID
+	if req.AudioID != nil {
+		audioID, err = uuid.Parse(*req.AudioID)
+		if err != nil {
+			return nil, apperror.ErrValidation.WithMessage("無効な audioId です")
+		}
+
+		// 音声の存在確認
+		if _, err := s.audioRepo.FindByID(ctx, audioID); err != nil {
+			return nil, err
+		}
+	} else {
+		audioID, err = s.synthesizeSegment(ctx, uid, channel, *req.TTS)
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	crossfadeMs := 0
+	if req.CrossfadeMs != nil {
+		crossfadeMs = *req.CrossfadeMs
+	}
+
+	switch segment {
+	case channelSegmentIntro:
+		channel.IntroAudioID = &audioID
+		channel.IntroAudio = nil
+		channel.IntroCrossfadeMs = crossfadeMs
+	case channelSegmentOutro:
+		channel.OutroAudioID = &audioID
+		channel.OutroAudio = nil
+		channel.OutroCrossfadeMs = crossfadeMs
+	}
+
+	return s.saveSegment(ctx, channel)
+}
+
+// deleteSegment はチャンネルのイントロまたはアウトロを削除する
+func (s *channelService) deleteSegment(ctx context.Context, userID, channelID string, segment channelSegment) (*response.ChannelDataResponse, error) {
+	uid, err := uuid.Parse(userID)
+	if err != nil {
+		return nil, err
+	}
+
+	cid, err := uuid.Parse(channelID)
+	if err != nil {
+		return nil, err
+	}
+
+	// チャンネルの存在確認とオーナーチェック
+	channel, err := s.channelRepo.FindByID(ctx, cid)
+	if err != nil {
+		return nil, err
+	}
+
+	if channel.UserID != uid {
+		return nil, apperror.ErrForbidden.WithMessage("このチャンネルのイントロ・アウトロ削除権限がありません")
+	}
+
+	switch segment {
+	case channelSegmentIntro:
+		channel.IntroAudioID = nil
+		channel.IntroAudio = nil
+		channel.IntroCrossfadeMs = 0
+	case channelSegmentOutro:
+		channel.OutroAudioID = nil
+		channel.OutroAudio = nil
+		channel.OutroCrossfadeMs = 0
+	}
+
+	return s.saveSegment(ctx, channel)
+}
+
+// saveSegment はイントロ・アウトロを変更したチャンネルを保存し、レスポンスを返す
+func (s *channelService) saveSegment(ctx context.Context, channel *model.Channel) (*response.ChannelDataResponse, error) {
+	// チャンネルを更新
+	if err := s.channelRepo.Update(ctx, channel); err != nil {
+		return nil, err
+	}
+
+	// リレーションをプリロードして取得
+	updated, err := s.channelRepo.FindByID(ctx, channel.ID)
+	if err != nil {
+		return nil, err
+	}
+
+	resp, err := s.toChannelResponse(ctx, updated, true, uuid.Nil)
+	if err != nil {
+		return nil, err
+	}
+
+	return &response.ChannelDataResponse{
+		Data: resp,
+	}, nil
+}
+
+// synthesizeSegment はキャラクターのボイスでイントロ・アウトロを読み上げ、音声として保存してその ID を返す
+//
+// キャラクターの音声合成パラメータと、チャンネルの発音辞書を適用する
+func (s *channelService) synthesizeSegment(ctx context.Context, userID uuid.UUID, channel *model.Channel, input request.ChannelSegmentTTSInput) (uuid.UUID, error) {
+	log := logger.FromContext(ctx)
+
+	characterID, err := uuid.Parse(input.CharacterID)
+	if err != nil {
+		return uuid.Nil, apperror.ErrValidation.WithMessage("無効な characterId です")
+	}
+
+	// キャラクターの存在確認とオーナーチェック
+	character, err := s.characterRepo.FindByID(ctx, characterID)
+	if err != nil {
+		return uuid.Nil, err
+	}
+	if character.UserID != userID {
+		return uuid.Nil, apperror.ErrForbidden.WithMessage("指定されたキャラクターの所有権がありません")
+	}
+
+	dict, err := loadPronunciationDictionary(ctx, s.pronunciationRepo, channel)
+	if err != nil {
+		return uuid.Nil, err
+	}
+
+	data, err := s.ttsPreviewer.synthesize(ctx, character.Voice, character.VoiceSettings, dict.Apply(input.Text), input.Emotion)
+	if err != nil {
+		return uuid.Nil, err
+	}
+
+	audioID := uuid.New()
+	path := storage.GenerateAudioPath(audioID.String())
+
+	if _, err := s.storageClient.Upload(ctx, data, path, mp3MimeType); err != nil {
+		log.Error("failed to upload channel segment audio", "error", err, "channel_id", channel.ID)
+		return uuid.Nil, apperror.ErrInternal.WithMessage("音声のアップロードに失敗しました").WithError(err)
+	}
+
+	durationMs, err := audio.GetDurationMsE(data)
+	if err != nil {
+		log.Warn("failed to get channel segment audio duration", "error", err)
+	}
+
+	record := &model.Audio{
+		ID:         audioID,
+		MimeType:   mp3MimeType,
+		Path:       path,
+		Filename:   audioID.String() + ".mp3",
+		FileSize:   len(data),
+		DurationMs: durationMs,
+		Waveforms:  generateWaveforms(ctx, s.ffmpegService, bytes.NewReader(data)),
+	}
+
+	if err := s.audioRepo.Create(ctx, record); err != nil {
+		return uuid.Nil, err
+	}
+
+	return audioID, nil
+}
+
+// toChannelSegmentResponse はイントロ・アウトロの音声をレスポンス DTO に変換する
+//
+// 音声が設定されていない場合は nil を返す
+func (s *channelService) toChannelSegmentResponse(ctx context.Context, a *model.Audio, crossfadeMs int) (*response.ChannelSegmentResponse, error) {
+	if a == nil || a.ID == uuid.Nil {
+		return nil, nil
+	}
+
+	signedURL, err := s.storageClient.GenerateSignedURL(ctx, a.Path, storage.SignedURLExpirationAudio)
+	if err != nil {
+		return nil, err
+	}
+
+	return &response.ChannelSegmentResponse{
+		Audio: response.ChannelSegmentAudioResponse{
+			ID:         a.ID,
+			URL:        signedURL,
+			DurationMs: a.DurationMs,
+		},
+		CrossfadeMs: crossfadeMs,
+	}, nil
+}
@@ -0,0 +1,141 @@
+package service
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+
+	"github.com/siropaca/anycast-backend/internal/apperror"
+	"github.com/siropaca/anycast-backend/internal/dto/request"
+	"github.com/siropaca/anycast-backend/internal/infrastructure/storage"
+	"github.com/siropaca/anycast-backend/internal/model"
+	"github.com/siropaca/anycast-backend/internal/pkg/uuid"
+)
+
+func TestChannelService_SetIntro(t *testing.T) {
+	ctx := context.Background()
+	userID := uuid.New()
+	channelID := uuid.New()
+	audioID := uuid.New()
+
+	t.Run("アップロード済みの音声をイントロに設定できる", func(t *testing.T) {
+		mockChannelRepo := new(mockChannelRepository)
+		mockAudioRepo := new(mockAudioRepository)
+		mockEpisodeRepo := new(mockEpisodeRepositoryForChannel)
+		mockStorage := new(mockStorageClient)
+
+		mockChannelRepo.On("FindByID", ctx, channelID).Return(&model.Channel{ID: channelID, UserID: userID}, nil).Once()
+		mockAudioRepo.On("FindByID", ctx, audioID).Return(&model.Audio{ID: audioID}, nil)
+		mockChannelRepo.On("Update", ctx, mock.MatchedBy(func(c *model.Channel) bool {
+			return c.IntroAudioID != nil && *c.IntroAudioID == audioID && c.IntroCrossfadeMs == 800
+		})).Return(nil)
+		mockChannelRepo.On("FindByID", ctx, channelID).Return(&model.Channel{
+			ID:               channelID,
+			UserID:           userID,
+			IntroAudioID:     &audioID,
+			IntroCrossfadeMs: 800,
+			IntroAudio:       &model.Audio{ID: audioID, Path: "audios/intro.mp3", DurationMs: 5000},
+		}, nil).Once()
+		mockEpisodeRepo.On("FindByChannelID", mock.Anything, channelID, mock.Anything).Return([]model.Episode{}, int64(0), nil)
+		mockStorage.On("GenerateSignedURL", mock.Anything, "audios/intro.mp3", storage.SignedURLExpirationAudio).Return("https://signed-url.example.com/intro.mp3", nil)
+
+		svc := &channelService{
+			channelRepo:   mockChannelRepo,
+			audioRepo:     mockAudioRepo,
+			episodeRepo:   mockEpisodeRepo,
+			storageClient: mockStorage,
+		}
+
+		audioIDStr := audioID.String()
+		crossfadeMs := 800
+		result, err := svc.SetIntro(ctx, userID.String(), channelID.String(), request.SetChannelSegmentRequest{
+			AudioID:     &audioIDStr,
+			CrossfadeMs: &crossfadeMs,
+		})
+
+		assert.NoError(t, err)
+		assert.NotNil(t, result.Data.Intro)
+		assert.Equal(t, audioID, result.Data.Intro.Audio.ID)
+		assert.Equal(t, "https://signed-url.example.com/intro.mp3", result.Data.Intro.Audio.URL)
+		assert.Equal(t, 5000, result.Data.Intro.Audio.DurationMs)
+		assert.Equal(t, 800, result.Data.Intro.CrossfadeMs)
+		assert.Nil(t, result.Data.Outro)
+		mockChannelRepo.AssertExpectations(t)
+	})
+
+	t.Run("audioId と tts を両方指定するとエラー", func(t *testing.T) {
+		svc := &channelService{}
+
+		audioIDStr := audioID.String()
+		_, err := svc.SetIntro(ctx, userID.String(), channelID.String(), request.SetChannelSegmentRequest{
+			AudioID: &audioIDStr,
+			TTS:     &request.ChannelSegmentTTSInput{CharacterID: uuid.New().String(), Text: "こんにちは"},
+		})
+
+		assert.True(t, apperror.IsCode(err, apperror.CodeValidation))
+	})
+
+	t.Run("audioId と tts のどちらも指定しないとエラー", func(t *testing.T) {
+		svc := &channelService{}
+
+		_, err := svc.SetIntro(ctx, userID.String(), channelID.String(), request.SetChannelSegmentRequest{})
+
+		assert.True(t, apperror.IsCode(err, apperror.CodeValidation))
+	})
+
+	t.Run("他のユーザーのチャンネルには設定できない", func(t *testing.T) {
+		mockChannelRepo := new(mockChannelRepository)
+		mockChannelRepo.On("FindByID", ctx, channelID).Return(&model.Channel{ID: channelID, UserID: uuid.New()}, nil)
+
+		svc := &channelService{channelRepo: mockChannelRepo}
+
+		audioIDStr := audioID.String()
+		_, err := svc.SetIntro(ctx, userID.String(), channelID.String(), request.SetChannelSegmentRequest{
+			AudioID: &audioIDStr,
+		})
+
+		assert.True(t, apperror.IsCode(err, apperror.CodeForbidden))
+		mockChannelRepo.AssertNotCalled(t, "Update", mock.Anything, mock.Anything)
+	})
+}
+
+func TestChannelService_DeleteOutro(t *testing.T) {
+	ctx := context.Background()
+	userID := uuid.New()
+	channelID := uuid.New()
+	introAudioID := uuid.New()
+	outroAudioID := uuid.New()
+
+	t.Run("アウトロのみを削除する", func(t *testing.T) {
+		mockChannelRepo := new(mockChannelRepository)
+		mockEpisodeRepo := new(mockEpisodeRepositoryForChannel)
+		mockStorage := new(mockStorageClient)
+
+		mockChannelRepo.On("FindByID", ctx, channelID).Return(&model.Channel{
+			ID:               channelID,
+			UserID:           userID,
+			IntroAudioID:     &introAudioID,
+			IntroCrossfadeMs: 500,
+			OutroAudioID:     &outroAudioID,
+			OutroCrossfadeMs: 1000,
+		}, nil)
+		mockChannelRepo.On("Update", ctx, mock.MatchedBy(func(c *model.Channel) bool {
+			return c.OutroAudioID == nil && c.OutroCrossfadeMs == 0 &&
+				c.IntroAudioID != nil && *c.IntroAudioID == introAudioID && c.IntroCrossfadeMs == 500
+		})).Return(nil)
+		mockEpisodeRepo.On("FindByChannelID", mock.Anything, channelID, mock.Anything).Return([]model.Episode{}, int64(0), nil)
+
+		svc := &channelService{
+			channelRepo:   mockChannelRepo,
+			episodeRepo:   mockEpisodeRepo,
+			storageClient: mockStorage,
+		}
+
+		_, err := svc.DeleteOutro(ctx, userID.String(), channelID.String())
+
+		assert.NoError(t, err)
+		mockChannelRepo.AssertExpectations(t)
+	})
+}
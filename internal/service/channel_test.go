@@ -2,6 +2,7 @@ package service
 
 import (
 	"context"
+	"io"
 	"testing"
 	"time"
 
@@ -25,6 +26,35 @@ func (m *mockStorageClient) Upload(ctx context.Context, data []byte, path, conte
 	return args.String(0), args.Error(1)
 }
 
+func (m *mockStorageClient) UploadStream(ctx context.Context, r io.Reader, path, contentType string) (string, error) {
+	data, err := io.ReadAll(r)
+	if err != nil {
+		return "", err
+	}
+	args := m.Called(ctx, data, path, contentType)
+	return args.String(0), args.Error(1)
+}
+
+func (m *mockStorageClient) DownloadStream(ctx context.Context, path string, w io.Writer) (int64, error) {
+	args := m.Called(ctx, path)
+	if args.Get(0) == nil {
+		return 0, args.Error(1)
+	}
+	n, err := w.Write(args.Get(0).([]byte))
+	if err != nil {
+		return int64(n), err
+	}
+	return int64(n), args.Error(1)
+}
+
+func (m *mockStorageClient) OpenRange(ctx context.Context, path string, offset, length int64) (io.ReadCloser, error) {
+	args := m.Called(ctx, path, offset, length)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).(io.ReadCloser), args.Error(1)
+}
+
 func (m *mockStorageClient) GenerateSignedURL(ctx context.Context, path string, expiration time.Duration) (string, error) {
 	args := m.Called(ctx, path, expiration)
 	return args.String(0), args.Error(1)
@@ -35,6 +65,11 @@ func (m *mockStorageClient) Delete(ctx context.Context, path string) error {
 	return args.Error(0)
 }
 
+func (m *mockStorageClient) Exists(ctx context.Context, path string) (bool, error) {
+	args := m.Called(ctx, path)
+	return args.Bool(0), args.Error(1)
+}
+
 func (m *mockStorageClient) Close() error {
 	args := m.Called()
 	return args.Error(0)
@@ -91,6 +126,22 @@ func (m *mockEpisodeRepositoryForChannel) CountByChannelIDBeforeCreatedAt(ctx co
 	return args.Get(0).(int64), args.Error(1)
 }
 
+func (m *mockEpisodeRepositoryForChannel) FindSourceGUIDsByChannelID(ctx context.Context, channelID uuid.UUID) ([]string, error) {
+	args := m.Called(ctx, channelID)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).([]string), args.Error(1)
+}
+
+func (m *mockEpisodeRepositoryForChannel) FindByAudioID(ctx context.Context, audioID uuid.UUID) ([]model.Episode, error) {
+	args := m.Called(ctx, audioID)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).([]model.Episode), args.Error(1)
+}
+
 func (m *mockEpisodeRepositoryForChannel) IncrementPlayCount(ctx context.Context, id uuid.UUID) error {
 	args := m.Called(ctx, id)
 	return args.Error(0)
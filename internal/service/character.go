@@ -152,9 +152,10 @@ func (s *characterService) toCharacterWithChannelsResponse(ctx context.Context,
 			Provider: c.Voice.Provider,
 			Gender:   string(c.Voice.Gender),
 		},
-		Channels:  channels,
-		CreatedAt: c.CreatedAt,
-		UpdatedAt: c.UpdatedAt,
+		VoiceSettings: toVoiceSettingsResponse(c.VoiceSettings),
+		Channels:      channels,
+		CreatedAt:     c.CreatedAt,
+		UpdatedAt:     c.UpdatedAt,
 	}, nil
 }
 
@@ -180,6 +181,12 @@ func (s *characterService) CreateCharacter(ctx context.Context, userID string, r
 		return nil, err
 	}
 
+	// 音声合成パラメータがボイスのプロバイダで対応している範囲内か確認
+	voiceSettings := toVoiceSettingsModel(req.VoiceSettings)
+	if err := validateVoiceSettings(voice, voiceSettings); err != nil {
+		return nil, err
+	}
+
 	// アバター画像の存在確認（指定された場合のみ）
 	var avatar *model.Image
 	var avatarID *uuid.UUID
@@ -197,12 +204,13 @@ func (s *characterService) CreateCharacter(ctx context.Context, userID string, r
 
 	// キャラクターを作成
 	character := &model.Character{
-		ID:       uuid.New(),
-		UserID:   uid,
-		Name:     req.Name,
-		Persona:  req.Persona,
-		AvatarID: avatarID,
-		VoiceID:  voice.ID,
+		ID:            uuid.New(),
+		UserID:        uid,
+		Name:          req.Name,
+		Persona:       req.Persona,
+		AvatarID:      avatarID,
+		VoiceID:       voice.ID,
+		VoiceSettings: voiceSettings,
 	}
 
 	if err := s.characterRepo.Create(ctx, character); err != nil {
@@ -274,6 +282,19 @@ func (s *characterService) UpdateCharacter(ctx context.Context, userID, characte
 		character.Voice = *voice
 	}
 
+	// 音声合成パラメータの更新
+	if req.VoiceSettings.IsSet {
+		// null の場合はプロバイダのデフォルト値に戻す
+		character.VoiceSettings = toVoiceSettingsModel(req.VoiceSettings.Value)
+	}
+
+	// ボイスまたはパラメータを変更した場合は、変更後の組み合わせが有効か確認
+	if req.VoiceID != nil || req.VoiceSettings.IsSet {
+		if err := validateVoiceSettings(&character.Voice, character.VoiceSettings); err != nil {
+			return nil, err
+		}
+	}
+
 	// アバター画像の更新
 	if req.AvatarID.IsSet {
 		if req.AvatarID.Value == nil {
@@ -22,13 +22,18 @@ func TestToCharacterWithChannelsResponses(t *testing.T) {
 	channelID1 := uuid.New()
 	channelID2 := uuid.New()
 
+	speakingRate := 1.1
+
 	characters := []model.Character{
 		{
-			ID:        charID1,
-			UserID:    userID,
-			Name:      "太郎",
-			Persona:   "明るい性格",
-			VoiceID:   voiceID1,
+			ID:      charID1,
+			UserID:  userID,
+			Name:    "太郎",
+			Persona: "明るい性格",
+			VoiceID: voiceID1,
+			VoiceSettings: model.VoiceSettings{
+				SpeakingRate: &speakingRate,
+			},
 			CreatedAt: now,
 			UpdatedAt: now,
 			Voice: model.Voice{
@@ -92,6 +97,8 @@ func TestToCharacterWithChannelsResponses(t *testing.T) {
 		assert.Equal(t, "ja-JP-Wavenet-C", result[0].Voice.Name)
 		assert.Equal(t, "google", result[0].Voice.Provider)
 		assert.Equal(t, "male", result[0].Voice.Gender)
+		assert.Equal(t, &speakingRate, result[0].VoiceSettings.SpeakingRate)
+		assert.Nil(t, result[0].VoiceSettings.Pitch)
 		assert.Equal(t, now, result[0].CreatedAt)
 		assert.Equal(t, now, result[0].UpdatedAt)
 
@@ -13,10 +13,13 @@ import (
 type CleanupResult struct {
 	OrphanedAudios    []model.Audio
 	OrphanedImages    []model.Image
+	OrphanedVideos    []model.Video
 	DeletedAudioCount int
 	DeletedImageCount int
+	DeletedVideoCount int
 	FailedAudioCount  int
 	FailedImageCount  int
+	FailedVideoCount  int
 }
 
 // CleanupService はクリーンアップサービスのインターフェースを表す
@@ -27,6 +30,7 @@ type CleanupService interface {
 type cleanupService struct {
 	audioRepo     repository.AudioRepository
 	imageRepo     repository.ImageRepository
+	videoRepo     repository.VideoRepository
 	storageClient storage.Client
 }
 
@@ -34,11 +38,13 @@ type cleanupService struct {
 func NewCleanupService(
 	audioRepo repository.AudioRepository,
 	imageRepo repository.ImageRepository,
+	videoRepo repository.VideoRepository,
 	storageClient storage.Client,
 ) CleanupService {
 	return &cleanupService{
 		audioRepo:     audioRepo,
 		imageRepo:     imageRepo,
+		videoRepo:     videoRepo,
 		storageClient: storageClient,
 	}
 }
@@ -62,9 +68,17 @@ func (s *cleanupService) CleanupOrphanedMedia(ctx context.Context, dryRun bool)
 	}
 	result.OrphanedImages = orphanedImages
 
+	// 孤児 Video を取得
+	orphanedVideos, err := s.videoRepo.FindOrphaned(ctx)
+	if err != nil {
+		return nil, err
+	}
+	result.OrphanedVideos = orphanedVideos
+
 	log.Info("orphaned media detected",
 		"orphaned_audios", len(orphanedAudios),
 		"orphaned_images", len(orphanedImages),
+		"orphaned_videos", len(orphanedVideos),
 		"dry_run", dryRun,
 	)
 
@@ -117,11 +131,35 @@ func (s *cleanupService) CleanupOrphanedMedia(ctx context.Context, dryRun bool)
 		log.Debug("orphaned image deleted", "image_id", image.ID, "path", image.Path)
 	}
 
+	// Video を削除
+	for _, video := range orphanedVideos {
+		// GCS から削除
+		if s.storageClient != nil {
+			if err := s.storageClient.Delete(ctx, video.Path); err != nil {
+				log.Warn("failed to delete video from GCS", "video_id", video.ID, "path", video.Path, "error", err)
+				result.FailedVideoCount++
+				continue
+			}
+		}
+
+		// DB から削除
+		if err := s.videoRepo.Delete(ctx, video.ID); err != nil {
+			log.Warn("failed to delete video from DB", "video_id", video.ID, "error", err)
+			result.FailedVideoCount++
+			continue
+		}
+
+		result.DeletedVideoCount++
+		log.Debug("orphaned video deleted", "video_id", video.ID, "path", video.Path)
+	}
+
 	log.Info("orphaned media cleanup completed",
 		"deleted_audios", result.DeletedAudioCount,
 		"deleted_images", result.DeletedImageCount,
+		"deleted_videos", result.DeletedVideoCount,
 		"failed_audios", result.FailedAudioCount,
 		"failed_images", result.FailedImageCount,
+		"failed_videos", result.FailedVideoCount,
 	)
 
 	return result, nil
@@ -18,9 +18,10 @@ func TestCleanupOrphanedMedia(t *testing.T) {
 	t.Run("正常系: dry-run で孤児メディアを検出する", func(t *testing.T) {
 		mockAudioRepo := new(mockAudioRepository)
 		mockImageRepo := new(mockImageRepository)
+		mockVideoRepo := new(mockVideoRepository)
 		mockStorage := new(mockStorageClient)
 
-		svc := NewCleanupService(mockAudioRepo, mockImageRepo, mockStorage)
+		svc := NewCleanupService(mockAudioRepo, mockImageRepo, mockVideoRepo, mockStorage)
 
 		orphanedAudios := []model.Audio{
 			{ID: uuid.New(), Path: "audios/orphan1.mp3"},
@@ -29,9 +30,13 @@ func TestCleanupOrphanedMedia(t *testing.T) {
 		orphanedImages := []model.Image{
 			{ID: uuid.New(), Path: "images/orphan1.png"},
 		}
+		orphanedVideos := []model.Video{
+			{ID: uuid.New(), Path: "videos/orphan1.mp4"},
+		}
 
 		mockAudioRepo.On("FindOrphaned", mock.Anything).Return(orphanedAudios, nil)
 		mockImageRepo.On("FindOrphaned", mock.Anything).Return(orphanedImages, nil)
+		mockVideoRepo.On("FindOrphaned", mock.Anything).Return(orphanedVideos, nil)
 
 		result, err := svc.CleanupOrphanedMedia(ctx, true)
 
@@ -39,23 +44,28 @@ func TestCleanupOrphanedMedia(t *testing.T) {
 		assert.NotNil(t, result)
 		assert.Len(t, result.OrphanedAudios, 2)
 		assert.Len(t, result.OrphanedImages, 1)
+		assert.Len(t, result.OrphanedVideos, 1)
 		assert.Equal(t, 0, result.DeletedAudioCount)
 		assert.Equal(t, 0, result.DeletedImageCount)
+		assert.Equal(t, 0, result.DeletedVideoCount)
 		mockAudioRepo.AssertExpectations(t)
 		mockImageRepo.AssertExpectations(t)
+		mockVideoRepo.AssertExpectations(t)
 		mockStorage.AssertNotCalled(t, "Delete")
 	})
 
 	t.Run("正常系: 孤児メディアを削除する", func(t *testing.T) {
 		mockAudioRepo := new(mockAudioRepository)
 		mockImageRepo := new(mockImageRepository)
+		mockVideoRepo := new(mockVideoRepository)
 		mockStorage := new(mockStorageClient)
 
-		svc := NewCleanupService(mockAudioRepo, mockImageRepo, mockStorage)
+		svc := NewCleanupService(mockAudioRepo, mockImageRepo, mockVideoRepo, mockStorage)
 
 		audioID1 := uuid.New()
 		audioID2 := uuid.New()
 		imageID1 := uuid.New()
+		videoID1 := uuid.New()
 
 		orphanedAudios := []model.Audio{
 			{ID: audioID1, Path: "audios/orphan1.mp3"},
@@ -64,15 +74,21 @@ func TestCleanupOrphanedMedia(t *testing.T) {
 		orphanedImages := []model.Image{
 			{ID: imageID1, Path: "images/orphan1.png"},
 		}
+		orphanedVideos := []model.Video{
+			{ID: videoID1, Path: "videos/orphan1.mp4"},
+		}
 
 		mockAudioRepo.On("FindOrphaned", mock.Anything).Return(orphanedAudios, nil)
 		mockImageRepo.On("FindOrphaned", mock.Anything).Return(orphanedImages, nil)
+		mockVideoRepo.On("FindOrphaned", mock.Anything).Return(orphanedVideos, nil)
 		mockStorage.On("Delete", mock.Anything, "audios/orphan1.mp3").Return(nil)
 		mockStorage.On("Delete", mock.Anything, "audios/orphan2.mp3").Return(nil)
 		mockStorage.On("Delete", mock.Anything, "images/orphan1.png").Return(nil)
+		mockStorage.On("Delete", mock.Anything, "videos/orphan1.mp4").Return(nil)
 		mockAudioRepo.On("Delete", mock.Anything, audioID1).Return(nil)
 		mockAudioRepo.On("Delete", mock.Anything, audioID2).Return(nil)
 		mockImageRepo.On("Delete", mock.Anything, imageID1).Return(nil)
+		mockVideoRepo.On("Delete", mock.Anything, videoID1).Return(nil)
 
 		result, err := svc.CleanupOrphanedMedia(ctx, false)
 
@@ -80,22 +96,27 @@ func TestCleanupOrphanedMedia(t *testing.T) {
 		assert.NotNil(t, result)
 		assert.Equal(t, 2, result.DeletedAudioCount)
 		assert.Equal(t, 1, result.DeletedImageCount)
+		assert.Equal(t, 1, result.DeletedVideoCount)
 		assert.Equal(t, 0, result.FailedAudioCount)
 		assert.Equal(t, 0, result.FailedImageCount)
+		assert.Equal(t, 0, result.FailedVideoCount)
 		mockAudioRepo.AssertExpectations(t)
 		mockImageRepo.AssertExpectations(t)
+		mockVideoRepo.AssertExpectations(t)
 		mockStorage.AssertExpectations(t)
 	})
 
 	t.Run("正常系: 孤児メディアがない場合", func(t *testing.T) {
 		mockAudioRepo := new(mockAudioRepository)
 		mockImageRepo := new(mockImageRepository)
+		mockVideoRepo := new(mockVideoRepository)
 		mockStorage := new(mockStorageClient)
 
-		svc := NewCleanupService(mockAudioRepo, mockImageRepo, mockStorage)
+		svc := NewCleanupService(mockAudioRepo, mockImageRepo, mockVideoRepo, mockStorage)
 
 		mockAudioRepo.On("FindOrphaned", mock.Anything).Return([]model.Audio{}, nil)
 		mockImageRepo.On("FindOrphaned", mock.Anything).Return([]model.Image{}, nil)
+		mockVideoRepo.On("FindOrphaned", mock.Anything).Return([]model.Video{}, nil)
 
 		result, err := svc.CleanupOrphanedMedia(ctx, false)
 
@@ -110,9 +131,10 @@ func TestCleanupOrphanedMedia(t *testing.T) {
 	t.Run("異常系: 孤児 Audio の取得に失敗する", func(t *testing.T) {
 		mockAudioRepo := new(mockAudioRepository)
 		mockImageRepo := new(mockImageRepository)
+		mockVideoRepo := new(mockVideoRepository)
 		mockStorage := new(mockStorageClient)
 
-		svc := NewCleanupService(mockAudioRepo, mockImageRepo, mockStorage)
+		svc := NewCleanupService(mockAudioRepo, mockImageRepo, mockVideoRepo, mockStorage)
 
 		mockAudioRepo.On("FindOrphaned", mock.Anything).Return(nil, errors.New("db error"))
 
@@ -125,9 +147,10 @@ func TestCleanupOrphanedMedia(t *testing.T) {
 	t.Run("異常系: 孤児 Image の取得に失敗する", func(t *testing.T) {
 		mockAudioRepo := new(mockAudioRepository)
 		mockImageRepo := new(mockImageRepository)
+		mockVideoRepo := new(mockVideoRepository)
 		mockStorage := new(mockStorageClient)
 
-		svc := NewCleanupService(mockAudioRepo, mockImageRepo, mockStorage)
+		svc := NewCleanupService(mockAudioRepo, mockImageRepo, mockVideoRepo, mockStorage)
 
 		mockAudioRepo.On("FindOrphaned", mock.Anything).Return([]model.Audio{}, nil)
 		mockImageRepo.On("FindOrphaned", mock.Anything).Return(nil, errors.New("db error"))
@@ -138,12 +161,31 @@ func TestCleanupOrphanedMedia(t *testing.T) {
 		assert.Error(t, err)
 	})
 
+	t.Run("異常系: 孤児 Video の取得に失敗する", func(t *testing.T) {
+		mockAudioRepo := new(mockAudioRepository)
+		mockImageRepo := new(mockImageRepository)
+		mockVideoRepo := new(mockVideoRepository)
+		mockStorage := new(mockStorageClient)
+
+		svc := NewCleanupService(mockAudioRepo, mockImageRepo, mockVideoRepo, mockStorage)
+
+		mockAudioRepo.On("FindOrphaned", mock.Anything).Return([]model.Audio{}, nil)
+		mockImageRepo.On("FindOrphaned", mock.Anything).Return([]model.Image{}, nil)
+		mockVideoRepo.On("FindOrphaned", mock.Anything).Return(nil, errors.New("db error"))
+
+		result, err := svc.CleanupOrphanedMedia(ctx, false)
+
+		assert.Nil(t, result)
+		assert.Error(t, err)
+	})
+
 	t.Run("異常系: GCS からの削除に失敗した場合はスキップして続行する", func(t *testing.T) {
 		mockAudioRepo := new(mockAudioRepository)
 		mockImageRepo := new(mockImageRepository)
+		mockVideoRepo := new(mockVideoRepository)
 		mockStorage := new(mockStorageClient)
 
-		svc := NewCleanupService(mockAudioRepo, mockImageRepo, mockStorage)
+		svc := NewCleanupService(mockAudioRepo, mockImageRepo, mockVideoRepo, mockStorage)
 
 		audioID1 := uuid.New()
 		audioID2 := uuid.New()
@@ -155,6 +197,7 @@ func TestCleanupOrphanedMedia(t *testing.T) {
 
 		mockAudioRepo.On("FindOrphaned", mock.Anything).Return(orphanedAudios, nil)
 		mockImageRepo.On("FindOrphaned", mock.Anything).Return([]model.Image{}, nil)
+		mockVideoRepo.On("FindOrphaned", mock.Anything).Return([]model.Video{}, nil)
 		mockStorage.On("Delete", mock.Anything, "audios/orphan1.mp3").Return(errors.New("gcs error"))
 		mockStorage.On("Delete", mock.Anything, "audios/orphan2.mp3").Return(nil)
 		mockAudioRepo.On("Delete", mock.Anything, audioID2).Return(nil)
@@ -170,9 +213,10 @@ func TestCleanupOrphanedMedia(t *testing.T) {
 	t.Run("異常系: DB からの削除に失敗した場合はスキップして続行する", func(t *testing.T) {
 		mockAudioRepo := new(mockAudioRepository)
 		mockImageRepo := new(mockImageRepository)
+		mockVideoRepo := new(mockVideoRepository)
 		mockStorage := new(mockStorageClient)
 
-		svc := NewCleanupService(mockAudioRepo, mockImageRepo, mockStorage)
+		svc := NewCleanupService(mockAudioRepo, mockImageRepo, mockVideoRepo, mockStorage)
 
 		audioID1 := uuid.New()
 		audioID2 := uuid.New()
@@ -184,6 +228,7 @@ func TestCleanupOrphanedMedia(t *testing.T) {
 
 		mockAudioRepo.On("FindOrphaned", mock.Anything).Return(orphanedAudios, nil)
 		mockImageRepo.On("FindOrphaned", mock.Anything).Return([]model.Image{}, nil)
+		mockVideoRepo.On("FindOrphaned", mock.Anything).Return([]model.Video{}, nil)
 		mockStorage.On("Delete", mock.Anything, "audios/orphan1.mp3").Return(nil)
 		mockStorage.On("Delete", mock.Anything, "audios/orphan2.mp3").Return(nil)
 		mockAudioRepo.On("Delete", mock.Anything, audioID1).Return(errors.New("db error"))
@@ -200,8 +245,9 @@ func TestCleanupOrphanedMedia(t *testing.T) {
 	t.Run("正常系: storageClient が nil の場合は GCS 削除をスキップする", func(t *testing.T) {
 		mockAudioRepo := new(mockAudioRepository)
 		mockImageRepo := new(mockImageRepository)
+		mockVideoRepo := new(mockVideoRepository)
 
-		svc := NewCleanupService(mockAudioRepo, mockImageRepo, nil)
+		svc := NewCleanupService(mockAudioRepo, mockImageRepo, mockVideoRepo, nil)
 
 		audioID1 := uuid.New()
 
@@ -211,6 +257,7 @@ func TestCleanupOrphanedMedia(t *testing.T) {
 
 		mockAudioRepo.On("FindOrphaned", mock.Anything).Return(orphanedAudios, nil)
 		mockImageRepo.On("FindOrphaned", mock.Anything).Return([]model.Image{}, nil)
+		mockVideoRepo.On("FindOrphaned", mock.Anything).Return([]model.Video{}, nil)
 		mockAudioRepo.On("Delete", mock.Anything, audioID1).Return(nil)
 
 		result, err := svc.CleanupOrphanedMedia(ctx, false)
@@ -0,0 +1,638 @@
+package service
+
+import (
+	"bytes"
+	"context"
+	"errors"
+	"fmt"
+	"io"
+	"os"
+	"time"
+
+	"github.com/siropaca/anycast-backend/internal/apperror"
+	"github.com/siropaca/anycast-backend/internal/dto/request"
+	"github.com/siropaca/anycast-backend/internal/dto/response"
+	"github.com/siropaca/anycast-backend/internal/infrastructure/cloudtasks"
+	"github.com/siropaca/anycast-backend/internal/infrastructure/slack"
+	"github.com/siropaca/anycast-backend/internal/infrastructure/storage"
+	"github.com/siropaca/anycast-backend/internal/infrastructure/websocket"
+	"github.com/siropaca/anycast-backend/internal/model"
+	"github.com/siropaca/anycast-backend/internal/pkg/logger"
+	"github.com/siropaca/anycast-backend/internal/pkg/script"
+	"github.com/siropaca/anycast-backend/internal/pkg/uuid"
+	"github.com/siropaca/anycast-backend/internal/repository"
+)
+
+const (
+	// minClipDurationMs はクリップ動画の最短の長さ（1 秒）
+	minClipDurationMs = 1000
+	// maxClipDurationMs はクリップ動画の最長の長さ（90 秒）
+	//
+	// SNS の短尺動画の上限に合わせ、レンダリング時間とファイルサイズを抑える
+	maxClipDurationMs = 90 * 1000
+	// clipVideoMimeType はクリップ動画の MIME タイプ
+	clipVideoMimeType = "video/mp4"
+)
+
+// ClipJobService はエピソードのクリップ動画（オーディオグラム）生成ジョブを管理するインターフェースを表す
+type ClipJobService interface {
+	CreateJob(ctx context.Context, userID, channelID, episodeID string, req request.CreateClipJobRequest) (*response.ClipJobResponse, error)
+	GetJob(ctx context.Context, userID, jobID string) (*response.ClipJobResponse, error)
+	ListMyJobs(ctx context.Context, userID string) (*response.ClipJobListResponse, error)
+	ExecuteJob(ctx context.Context, jobID string) error
+}
+
+type clipJobService struct {
+	clipJobRepo    repository.ClipJobRepository
+	episodeRepo    repository.EpisodeRepository
+	scriptLineRepo repository.ScriptLineRepository
+	videoRepo      repository.VideoRepository
+	storageClient  storage.Client
+	ffmpegService  FFmpegService
+	tasksClient    cloudtasks.Client
+	wsHub          *websocket.Hub
+	slackClient    slack.Client
+}
+
+// NewClipJobService は clipJobService を生成して ClipJobService として返す
+func NewClipJobService(
+	clipJobRepo repository.ClipJobRepository,
+	episodeRepo repository.EpisodeRepository,
+	scriptLineRepo repository.ScriptLineRepository,
+	videoRepo repository.VideoRepository,
+	storageClient storage.Client,
+	ffmpegService FFmpegService,
+	tasksClient cloudtasks.Client,
+	wsHub *websocket.Hub,
+	slackClient slack.Client,
+) ClipJobService {
+	return &clipJobService{
+		clipJobRepo:    clipJobRepo,
+		episodeRepo:    episodeRepo,
+		scriptLineRepo: scriptLineRepo,
+		videoRepo:      videoRepo,
+		storageClient:  storageClient,
+		ffmpegService:  ffmpegService,
+		tasksClient:    tasksClient,
+		wsHub:          wsHub,
+		slackClient:    slackClient,
+	}
+}
+
+// CreateJob はエピソードのクリップ動画生成ジョブを作成して返す
+//
+// 台本行で範囲を指定した場合は、エピソードの音声に記録された各行の再生区間から切り出す範囲を求める
+func (s *clipJobService) CreateJob(ctx context.Context, userID, channelID, episodeID string, req request.CreateClipJobRequest) (*response.ClipJobResponse, error) {
+	log := logger.FromContext(ctx)
+
+	uid, err := uuid.Parse(userID)
+	if err != nil {
+		return nil, err
+	}
+
+	cid, err := uuid.Parse(channelID)
+	if err != nil {
+		return nil, err
+	}
+
+	eid, err := uuid.Parse(episodeID)
+	if err != nil {
+		return nil, err
+	}
+
+	// エピソードの存在確認とオーナーチェック
+	episode, err := s.episodeRepo.FindByID(ctx, eid)
+	if err != nil {
+		return nil, err
+	}
+
+	if episode.ChannelID != cid {
+		return nil, apperror.ErrNotFound.WithMessage("エピソードが見つかりません")
+	}
+
+	if episode.Channel.UserID != uid {
+		return nil, apperror.ErrForbidden.WithMessage("このエピソードのクリップを作成する権限がありません")
+	}
+
+	if episode.FullAudio == nil {
+		return nil, apperror.ErrValidation.WithMessage("音声が生成されていないエピソードはクリップを作成できません")
+	}
+
+	job := &model.ClipJob{
+		UserID:      uid,
+		EpisodeID:   eid,
+		AudioID:     &episode.FullAudio.ID,
+		AspectRatio: model.ClipAspectRatioSquare,
+		Captions:    true,
+		Status:      model.ClipJobStatusPending,
+	}
+	if req.AspectRatio != nil {
+		job.AspectRatio = model.ClipAspectRatio(*req.AspectRatio)
+	}
+	if req.Captions != nil {
+		job.Captions = *req.Captions
+	}
+
+	if err := resolveClipRange(job, req, episode.FullAudio); err != nil {
+		return nil, err
+	}
+
+	if err := s.clipJobRepo.Create(ctx, job); err != nil {
+		return nil, err
+	}
+	job.Episode = *episode
+
+	// Cloud Tasks が設定されている場合はエンキュー、そうでなければ goroutine で直接実行
+	if s.tasksClient != nil {
+		if err := s.tasksClient.EnqueueClipJob(ctx, job.ID.String()); err != nil {
+			log.Error("failed to enqueue clip job", "error", err, "job_id", job.ID)
+			// エンキュー失敗時はジョブを失敗状態に更新（ベストエフォート）
+			job.Status = model.ClipJobStatusFailed
+			errMsg := "タスクのエンキューに失敗しました"
+			errCode := "ENQUEUE_FAILED"
+			job.ErrorMessage = &errMsg
+			job.ErrorCode = &errCode
+			_ = s.clipJobRepo.Update(ctx, job) //nolint:errcheck // best effort cleanup
+			return nil, apperror.ErrInternal.WithMessage("クリップ動画生成タスクの登録に失敗しました").WithError(err)
+		}
+		log.Info("clip job created and enqueued", "job_id", job.ID, "episode_id", eid)
+	} else {
+		// ローカル開発モード: goroutine で直接実行
+		log.Info("executing clip job directly as Cloud Tasks is not configured", "job_id", job.ID, "episode_id", eid)
+		go func() {
+			if err := s.ExecuteJob(context.Background(), job.ID.String()); err != nil {
+				log.Error("failed to execute local clip job", "error", err, "job_id", job.ID)
+			}
+		}()
+	}
+
+	return s.toClipJobResponse(ctx, job)
+}
+
+// resolveClipRange はリクエストの範囲指定を検証し、切り出す範囲をジョブに設定する
+//
+// 範囲はミリ秒（startMs・endMs）か台本行（startScriptLineId・endScriptLineId）のどちらか一方で指定する。
+// 台本行で指定した場合は開始行の再生開始位置から終了行の再生終了位置までを切り出す
+func resolveClipRange(job *model.ClipJob, req request.CreateClipJobRequest, audio *model.Audio) error {
+	byTime := req.StartMs != nil || req.EndMs != nil
+	byLine := req.StartScriptLineID != nil || req.EndScriptLineID != nil
+
+	switch {
+	case byTime && byLine:
+		return apperror.ErrValidation.WithMessage("範囲は startMs・endMs か startScriptLineId・endScriptLineId のどちらか一方で指定してください")
+	case byTime:
+		if req.StartMs == nil || req.EndMs == nil {
+			return apperror.ErrValidation.WithMessage("startMs と endMs は両方指定してください")
+		}
+		job.StartMs = *req.StartMs
+		job.EndMs = *req.EndMs
+	case byLine:
+		if req.StartScriptLineID == nil || req.EndScriptLineID == nil {
+			return apperror.ErrValidation.WithMessage("startScriptLineId と endScriptLineId は両方指定してください")
+		}
+		if len(audio.LineTimings) == 0 {
+			return apperror.ErrValidation.WithMessage("このエピソードの音声には台本行の再生区間が記録されていないため、台本行で範囲を指定できません")
+		}
+
+		startLineID, err := uuid.Parse(*req.StartScriptLineID)
+		if err != nil {
+			return err
+		}
+		endLineID, err := uuid.Parse(*req.EndScriptLineID)
+		if err != nil {
+			return err
+		}
+
+		start, ok := audio.LineTimings.Find(startLineID)
+		if !ok {
+			return apperror.ErrValidation.WithMessage("開始行の再生区間が見つかりません")
+		}
+		end, ok := audio.LineTimings.Find(endLineID)
+		if !ok {
+			return apperror.ErrValidation.WithMessage("終了行の再生区間が見つかりません")
+		}
+
+		job.StartMs = start.StartMs
+		job.EndMs = end.EndMs
+		job.StartScriptLineID = &startLineID
+		job.EndScriptLineID = &endLineID
+	default:
+		return apperror.ErrValidation.WithMessage("切り出す範囲を指定してください")
+	}
+
+	if job.EndMs <= job.StartMs {
+		return apperror.ErrValidation.WithMessage("終了位置は開始位置より後を指定してください")
+	}
+	if job.EndMs > audio.DurationMs {
+		return apperror.ErrValidation.WithMessage("終了位置が音声の長さを超えています")
+	}
+
+	duration := job.EndMs - job.StartMs
+	if duration < minClipDurationMs || duration > maxClipDurationMs {
+		return apperror.ErrValidation.WithMessage(fmt.Sprintf("クリップの長さは %d 秒以上 %d 秒以下で指定してください", minClipDurationMs/1000, maxClipDurationMs/1000))
+	}
+
+	return nil
+}
+
+// GetJob は指定されたジョブの詳細を取得する
+func (s *clipJobService) GetJob(ctx context.Context, userID, jobID string) (*response.ClipJobResponse, error) {
+	uid, err := uuid.Parse(userID)
+	if err != nil {
+		return nil, err
+	}
+
+	jid, err := uuid.Parse(jobID)
+	if err != nil {
+		return nil, err
+	}
+
+	job, err := s.clipJobRepo.FindByID(ctx, jid)
+	if err != nil {
+		return nil, err
+	}
+
+	// オーナーチェック
+	if job.UserID != uid {
+		return nil, apperror.ErrForbidden.WithMessage("このジョブへのアクセス権限がありません")
+	}
+
+	return s.toClipJobResponse(ctx, job)
+}
+
+// ListMyJobs は指定されたユーザーのジョブ一覧を取得する
+func (s *clipJobService) ListMyJobs(ctx context.Context, userID string) (*response.ClipJobListResponse, error) {
+	uid, err := uuid.Parse(userID)
+	if err != nil {
+		return nil, err
+	}
+
+	jobs, err := s.clipJobRepo.FindByUserID(ctx, uid)
+	if err != nil {
+		return nil, err
+	}
+
+	responses := make([]response.ClipJobResponse, len(jobs))
+	for i := range jobs {
+		resp, err := s.toClipJobResponse(ctx, &jobs[i])
+		if err != nil {
+			return nil, err
+		}
+		responses[i] = *resp
+	}
+
+	return &response.ClipJobListResponse{
+		Data: responses,
+	}, nil
+}
+
+// ExecuteJob は指定されたジョブを実行する（Cloud Tasks ワーカーから呼び出される）
+func (s *clipJobService) ExecuteJob(ctx context.Context, jobID string) error {
+	log := logger.FromContext(ctx)
+
+	jid, err := uuid.Parse(jobID)
+	if err != nil {
+		return err
+	}
+
+	job, err := s.clipJobRepo.FindByID(ctx, jid)
+	if err != nil {
+		return err
+	}
+
+	// 既に完了または失敗している場合はスキップ
+	if job.Status == model.ClipJobStatusCompleted || job.Status == model.ClipJobStatusFailed {
+		log.Info("skipping clip job as it is already completed", "job_id", jobID, "status", job.Status)
+		return nil
+	}
+
+	// 処理開始
+	now := time.Now().UTC()
+	job.Status = model.ClipJobStatusProcessing
+	job.StartedAt = &now
+	job.Progress = 0
+	if err := s.clipJobRepo.Update(ctx, job); err != nil {
+		return err
+	}
+
+	s.notifyProgress(job, "素材を準備しています...")
+
+	video, err := s.executeJobInternal(ctx, job)
+	if err != nil {
+		log.Error("failed to execute clip job", "error", err, "job_id", jobID)
+		s.failJob(ctx, job, err)
+		return err
+	}
+
+	completedAt := time.Now().UTC()
+	job.Status = model.ClipJobStatusCompleted
+	job.Progress = 100
+	job.ResultVideoID = &video.ID
+	job.ResultVideo = video
+	job.CompletedAt = &completedAt
+	if err := s.clipJobRepo.Update(ctx, job); err != nil {
+		return err
+	}
+
+	s.notifyCompleted(job)
+
+	log.Info("clip job completed successfully", "job_id", job.ID, "video_id", video.ID, "duration_ms", video.DurationMs)
+
+	return nil
+}
+
+// executeJobInternal は音声とアートワークから動画を生成してアップロードし、動画のレコードを返す
+func (s *clipJobService) executeJobInternal(ctx context.Context, job *model.ClipJob) (*model.Video, error) {
+	log := logger.FromContext(ctx)
+
+	if job.Audio == nil {
+		return nil, apperror.ErrNotFound.WithMessage("切り出し元の音声が見つかりません")
+	}
+
+	ws, err := newAudioWorkspace(job.ID)
+	if err != nil {
+		log.Error("failed to create workspace", "error", err, "job_id", job.ID)
+		return nil, apperror.ErrInternal.WithMessage("一時ディレクトリの作成に失敗しました").WithError(err)
+	}
+	defer ws.Close()
+
+	audioPath, err := ws.download(ctx, s.storageClient, job.Audio.Path, "audio")
+	if err != nil {
+		return nil, apperror.ErrInternal.WithMessage("音声のダウンロードに失敗しました").WithError(err)
+	}
+
+	// アートワークはエピソード、なければチャンネルのものを使う（取得できない場合は単色の背景にする）
+	var artwork []byte
+	if image := clipArtwork(&job.Episode); image != nil {
+		artwork, err = downloadFromStorageClient(ctx, s.storageClient, image.Path)
+		if err != nil {
+			log.Warn("failed to download artwork, falling back to plain background", "error", err, "job_id", job.ID)
+			artwork = nil
+		}
+	}
+
+	var captions []AudiogramCaption
+	if job.Captions {
+		captions, err = s.buildCaptions(ctx, job)
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	s.updateProgress(ctx, job, 20, "動画を生成しています...")
+
+	width, height := job.AspectRatio.Size()
+	durationMs := job.EndMs - job.StartMs
+	videoPath, err := ws.create("clip.mp4", func(w io.Writer) error {
+		return withFile(audioPath, func(f *os.File) error {
+			params := AudiogramParams{
+				Audio:      f,
+				StartMs:    job.StartMs,
+				DurationMs: durationMs,
+				Width:      width,
+				Height:     height,
+				Captions:   captions,
+			}
+			if artwork != nil {
+				params.Artwork = bytes.NewReader(artwork)
+			}
+			return s.ffmpegService.RenderAudiogram(ctx, params, w)
+		})
+	})
+	if err != nil {
+		var appErr *apperror.AppError
+		if errors.As(err, &appErr) {
+			return nil, err
+		}
+		return nil, apperror.ErrInternal.WithMessage("クリップ動画の生成に失敗しました").WithError(err)
+	}
+
+	s.updateProgress(ctx, job, 80, "動画をアップロードしています...")
+
+	videoID := uuid.New()
+	path := storage.GenerateVideoPath(videoID.String())
+	fileSize, err := uploadFile(ctx, s.storageClient, videoPath, path, clipVideoMimeType)
+	if err != nil {
+		log.Error("failed to upload clip video", "error", err, "job_id", job.ID)
+		return nil, apperror.ErrInternal.WithMessage("動画のアップロードに失敗しました").WithError(err)
+	}
+
+	video := &model.Video{
+		ID:         videoID,
+		MimeType:   clipVideoMimeType,
+		Path:       path,
+		Filename:   fmt.Sprintf("clip-%s.mp4", job.ID),
+		FileSize:   fileSize,
+		DurationMs: durationMs,
+		Width:      width,
+		Height:     height,
+	}
+	if err := s.videoRepo.Create(ctx, video); err != nil {
+		return nil, err
+	}
+
+	return video, nil
+}
+
+// buildCaptions は切り出す範囲に再生区間が重なる台本行から字幕を組み立てる
+//
+// 台本行の再生区間が記録されていない音声（単一話者の生成やアップロードした音声など）は字幕なしとする
+func (s *clipJobService) buildCaptions(ctx context.Context, job *model.ClipJob) ([]AudiogramCaption, error) {
+	if len(job.Audio.LineTimings) == 0 {
+		return nil, nil
+	}
+
+	lines, err := s.scriptLineRepo.FindByEpisodeID(ctx, job.EpisodeID)
+	if err != nil {
+		return nil, err
+	}
+
+	texts := make(map[uuid.UUID]string, len(lines))
+	for _, l := range lines {
+		texts[l.ID] = script.PlainText(l.Text)
+	}
+
+	return clipCaptions(job.Audio.LineTimings, texts, job.StartMs, job.EndMs), nil
+}
+
+// clipCaptions は [startMs, endMs) と重なる再生区間を、切り出し開始位置からの相対位置の字幕に変換する
+//
+// 範囲の境界をまたぐ区間は範囲内に切り詰め、テキストのない行（台本から削除された行など）は除外する
+func clipCaptions(timings model.AudioLineTimings, texts map[uuid.UUID]string, startMs, endMs int) []AudiogramCaption {
+	var captions []AudiogramCaption
+	for _, t := range timings {
+		if t.EndMs <= startMs || t.StartMs >= endMs {
+			continue
+		}
+		text, ok := texts[t.ScriptLineID]
+		if !ok || text == "" {
+			continue
+		}
+		captions = append(captions, AudiogramCaption{
+			StartMs: max(t.StartMs, startMs) - startMs,
+			EndMs:   min(t.EndMs, endMs) - startMs,
+			Text:    text,
+		})
+	}
+	return captions
+}
+
+// clipArtwork はクリップ動画に使うアートワークを返す（エピソード、チャンネルの順に探す）
+//
+// 外部 URL の画像はストレージから取得できないため使わない
+func clipArtwork(episode *model.Episode) *model.Image {
+	for _, image := range []*model.Image{episode.Artwork, episode.Channel.Artwork} {
+		if image != nil && !storage.IsExternalURL(image.Path) {
+			return image
+		}
+	}
+	return nil
+}
+
+// updateProgress はジョブの進捗を更新し WebSocket で通知する
+//
+// 進捗のみを更新し、ステータスなど他のフィールドは変更しない
+func (s *clipJobService) updateProgress(ctx context.Context, job *model.ClipJob, progress int, message string) {
+	job.Progress = progress
+	_ = s.clipJobRepo.UpdateProgress(ctx, job.ID, progress) //nolint:errcheck // progress update is best effort
+	s.notifyProgress(job, message)
+}
+
+// failJob はジョブを失敗状態に更新し、WebSocket と Slack で通知する
+func (s *clipJobService) failJob(ctx context.Context, job *model.ClipJob, err error) {
+	log := logger.FromContext(ctx)
+	completedAt := time.Now().UTC()
+	job.Status = model.ClipJobStatusFailed
+	job.CompletedAt = &completedAt
+
+	var appErr *apperror.AppError
+	if ok := errors.As(err, &appErr); ok {
+		errCode := string(appErr.Code)
+		job.ErrorCode = &errCode
+		job.ErrorMessage = &appErr.Message
+	} else {
+		errCode := "INTERNAL_ERROR"
+		errMsg := "内部エラーが発生しました"
+		job.ErrorCode = &errCode
+		job.ErrorMessage = &errMsg
+	}
+
+	_ = s.clipJobRepo.Update(ctx, job) //nolint:errcheck // fail update is best effort
+	s.notifyFailed(job)
+
+	// Slack アラート通知（ベストエフォート）
+	if s.slackClient != nil {
+		if alertErr := s.slackClient.SendAlert(ctx, slack.AlertNotification{
+			JobID:        job.ID.String(),
+			JobType:      "クリップ動画生成 (Clip)",
+			ErrorCode:    *job.ErrorCode,
+			ErrorMessage: *job.ErrorMessage,
+			OccurredAt:   completedAt,
+		}); alertErr != nil {
+			log.Warn("failed to send slack alert for clip job", "error", alertErr, "job_id", job.ID)
+		}
+	}
+}
+
+// notifyProgress は進捗を WebSocket で通知する
+func (s *clipJobService) notifyProgress(job *model.ClipJob, message string) {
+	if s.wsHub == nil {
+		return
+	}
+	s.wsHub.SendToUser(job.UserID.String(), websocket.Message{
+		Type: "clip_progress",
+		Payload: map[string]any{
+			"jobId":    job.ID.String(),
+			"progress": job.Progress,
+			"message":  message,
+		},
+	})
+}
+
+// notifyCompleted はジョブの完了を WebSocket で通知する
+func (s *clipJobService) notifyCompleted(job *model.ClipJob) {
+	if s.wsHub == nil || job.ResultVideo == nil {
+		return
+	}
+	s.wsHub.SendToUser(job.UserID.String(), websocket.Message{
+		Type: "clip_completed",
+		Payload: map[string]any{
+			"jobId":     job.ID.String(),
+			"episodeId": job.EpisodeID.String(),
+			"video": map[string]any{
+				"id":         job.ResultVideo.ID.String(),
+				"durationMs": job.ResultVideo.DurationMs,
+				"width":      job.ResultVideo.Width,
+				"height":     job.ResultVideo.Height,
+			},
+		},
+	})
+}
+
+// notifyFailed はジョブの失敗を WebSocket で通知する
+func (s *clipJobService) notifyFailed(job *model.ClipJob) {
+	if s.wsHub == nil {
+		return
+	}
+	code := ""
+	msg := ""
+	if job.ErrorCode != nil {
+		code = *job.ErrorCode
+	}
+	if job.ErrorMessage != nil {
+		msg = *job.ErrorMessage
+	}
+	s.wsHub.SendToUser(job.UserID.String(), websocket.Message{
+		Type: "clip_failed",
+		Payload: map[string]any{
+			"jobId":        job.ID.String(),
+			"errorCode":    code,
+			"errorMessage": msg,
+		},
+	})
+}
+
+// toClipJobResponse はクリップ動画生成ジョブをレスポンスに変換する
+//
+// 生成済みの動画には署名付き URL を付ける
+func (s *clipJobService) toClipJobResponse(ctx context.Context, job *model.ClipJob) (*response.ClipJobResponse, error) {
+	resp := &response.ClipJobResponse{
+		ID: job.ID,
+		Episode: response.ClipJobEpisodeResponse{
+			ID:    job.EpisodeID,
+			Title: job.Episode.Title,
+		},
+		StartMs:           job.StartMs,
+		EndMs:             job.EndMs,
+		StartScriptLineID: job.StartScriptLineID,
+		EndScriptLineID:   job.EndScriptLineID,
+		AspectRatio:       string(job.AspectRatio),
+		Captions:          job.Captions,
+		Status:            string(job.Status),
+		Progress:          job.Progress,
+		ErrorMessage:      job.ErrorMessage,
+		ErrorCode:         job.ErrorCode,
+		StartedAt:         job.StartedAt,
+		CompletedAt:       job.CompletedAt,
+		CreatedAt:         job.CreatedAt,
+		UpdatedAt:         job.UpdatedAt,
+	}
+
+	if job.ResultVideo != nil {
+		signedURL, err := s.storageClient.GenerateSignedURL(ctx, job.ResultVideo.Path, storage.SignedURLExpirationVideo)
+		if err != nil {
+			return nil, err
+		}
+		resp.Video = &response.VideoResponse{
+			ID:         job.ResultVideo.ID,
+			URL:        signedURL,
+			MimeType:   job.ResultVideo.MimeType,
+			FileSize:   job.ResultVideo.FileSize,
+			DurationMs: job.ResultVideo.DurationMs,
+			Width:      job.ResultVideo.Width,
+			Height:     job.ResultVideo.Height,
+		}
+	}
+
+	return resp, nil
+}
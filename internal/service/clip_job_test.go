@@ -0,0 +1,511 @@
+package service
+
+import (
+	"context"
+	"io"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+
+	"github.com/siropaca/anycast-backend/internal/apperror"
+	"github.com/siropaca/anycast-backend/internal/dto/request"
+	"github.com/siropaca/anycast-backend/internal/infrastructure/storage"
+	"github.com/siropaca/anycast-backend/internal/model"
+	"github.com/siropaca/anycast-backend/internal/pkg/uuid"
+)
+
+// ClipJobRepository のモック
+type mockClipJobRepository struct {
+	mock.Mock
+}
+
+func (m *mockClipJobRepository) FindByID(ctx context.Context, id uuid.UUID) (*model.ClipJob, error) {
+	args := m.Called(ctx, id)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).(*model.ClipJob), args.Error(1)
+}
+
+func (m *mockClipJobRepository) FindByUserID(ctx context.Context, userID uuid.UUID) ([]model.ClipJob, error) {
+	args := m.Called(ctx, userID)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).([]model.ClipJob), args.Error(1)
+}
+
+func (m *mockClipJobRepository) Create(ctx context.Context, job *model.ClipJob) error {
+	args := m.Called(ctx, job)
+	return args.Error(0)
+}
+
+func (m *mockClipJobRepository) Update(ctx context.Context, job *model.ClipJob) error {
+	args := m.Called(ctx, job)
+	return args.Error(0)
+}
+
+func (m *mockClipJobRepository) UpdateProgress(ctx context.Context, id uuid.UUID, progress int) error {
+	args := m.Called(ctx, id, progress)
+	return args.Error(0)
+}
+
+// VideoRepository のモック
+type mockVideoRepository struct {
+	mock.Mock
+}
+
+func (m *mockVideoRepository) Create(ctx context.Context, video *model.Video) error {
+	args := m.Called(ctx, video)
+	return args.Error(0)
+}
+
+func (m *mockVideoRepository) FindByID(ctx context.Context, id uuid.UUID) (*model.Video, error) {
+	args := m.Called(ctx, id)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).(*model.Video), args.Error(1)
+}
+
+func (m *mockVideoRepository) Delete(ctx context.Context, id uuid.UUID) error {
+	args := m.Called(ctx, id)
+	return args.Error(0)
+}
+
+func (m *mockVideoRepository) FindOrphaned(ctx context.Context) ([]model.Video, error) {
+	args := m.Called(ctx)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).([]model.Video), args.Error(1)
+}
+
+func (m *mockVideoRepository) FindAll(ctx context.Context) ([]model.Video, error) {
+	args := m.Called(ctx)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).([]model.Video), args.Error(1)
+}
+
+// stubAudiogramFFmpegService は RenderAudiogram のみを差し替えた FFmpegService
+type stubAudiogramFFmpegService struct {
+	FFmpegService
+	params AudiogramParams
+	audio  string
+	err    error
+}
+
+func (s *stubAudiogramFFmpegService) RenderAudiogram(ctx context.Context, params AudiogramParams, w io.Writer) error {
+	if s.err != nil {
+		return s.err
+	}
+	data, err := io.ReadAll(params.Audio)
+	if err != nil {
+		return err
+	}
+	s.params = params
+	s.audio = string(data)
+	_, err = io.WriteString(w, "mp4")
+	return err
+}
+
+type clipJobTestDeps struct {
+	jobRepo        *mockClipJobRepository
+	episodeRepo    *mockEpisodeRepository
+	scriptLineRepo *mockScriptLineRepository
+	videoRepo      *mockVideoRepository
+	storageClient  *mockStorageClient
+	ffmpeg         *stubAudiogramFFmpegService
+	tasksClient    *mockTasksClient
+}
+
+func newClipJobTestDeps() *clipJobTestDeps {
+	return &clipJobTestDeps{
+		jobRepo:        new(mockClipJobRepository),
+		episodeRepo:    new(mockEpisodeRepository),
+		scriptLineRepo: new(mockScriptLineRepository),
+		videoRepo:      new(mockVideoRepository),
+		storageClient:  new(mockStorageClient),
+		ffmpeg:         &stubAudiogramFFmpegService{},
+		tasksClient:    new(mockTasksClient),
+	}
+}
+
+func (d *clipJobTestDeps) service() ClipJobService {
+	return NewClipJobService(d.jobRepo, d.episodeRepo, d.scriptLineRepo, d.videoRepo, d.storageClient, d.ffmpeg, d.tasksClient, nil, nil)
+}
+
+func intPtr(v int) *int {
+	return &v
+}
+
+func TestResolveClipRange(t *testing.T) {
+	line1, line2 := uuid.New(), uuid.New()
+	audio := &model.Audio{
+		DurationMs: 120000,
+		LineTimings: model.AudioLineTimings{
+			{ScriptLineID: line1, StartMs: 5000, EndMs: 9000},
+			{ScriptLineID: line2, StartMs: 9500, EndMs: 20000},
+		},
+	}
+
+	t.Run("ミリ秒で指定した範囲をそのまま設定する", func(t *testing.T) {
+		job := &model.ClipJob{}
+
+		err := resolveClipRange(job, request.CreateClipJobRequest{StartMs: intPtr(1000), EndMs: intPtr(31000)}, audio)
+
+		require.NoError(t, err)
+		assert.Equal(t, 1000, job.StartMs)
+		assert.Equal(t, 31000, job.EndMs)
+		assert.Nil(t, job.StartScriptLineID)
+	})
+
+	t.Run("台本行で指定した場合は開始行の先頭から終了行の末尾までを設定する", func(t *testing.T) {
+		job := &model.ClipJob{}
+		start, end := line1.String(), line2.String()
+
+		err := resolveClipRange(job, request.CreateClipJobRequest{StartScriptLineID: &start, EndScriptLineID: &end}, audio)
+
+		require.NoError(t, err)
+		assert.Equal(t, 5000, job.StartMs)
+		assert.Equal(t, 20000, job.EndMs)
+		assert.Equal(t, line1, *job.StartScriptLineID)
+		assert.Equal(t, line2, *job.EndScriptLineID)
+	})
+
+	t.Run("再生区間が記録されていない音声は台本行で指定できない", func(t *testing.T) {
+		job := &model.ClipJob{}
+		start, end := line1.String(), line2.String()
+
+		err := resolveClipRange(job, request.CreateClipJobRequest{StartScriptLineID: &start, EndScriptLineID: &end}, &model.Audio{DurationMs: 120000})
+
+		assert.True(t, apperror.IsCode(err, apperror.CodeValidation))
+	})
+
+	t.Run("再生区間のない台本行はバリデーションエラーを返す", func(t *testing.T) {
+		job := &model.ClipJob{}
+		start, end := line1.String(), uuid.New().String()
+
+		err := resolveClipRange(job, request.CreateClipJobRequest{StartScriptLineID: &start, EndScriptLineID: &end}, audio)
+
+		assert.True(t, apperror.IsCode(err, apperror.CodeValidation))
+	})
+
+	t.Run("終了行が開始行より前の場合はバリデーションエラーを返す", func(t *testing.T) {
+		job := &model.ClipJob{}
+		start, end := line2.String(), line1.String()
+
+		err := resolveClipRange(job, request.CreateClipJobRequest{StartScriptLineID: &start, EndScriptLineID: &end}, audio)
+
+		assert.True(t, apperror.IsCode(err, apperror.CodeValidation))
+	})
+
+	tests := []struct {
+		name string
+		req  request.CreateClipJobRequest
+	}{
+		{name: "範囲の指定がない", req: request.CreateClipJobRequest{}},
+		{name: "ミリ秒と台本行の両方を指定した", req: request.CreateClipJobRequest{StartMs: intPtr(0), EndMs: intPtr(10000), StartScriptLineID: new(string)}},
+		{name: "endMs がない", req: request.CreateClipJobRequest{StartMs: intPtr(0)}},
+		{name: "1 秒未満", req: request.CreateClipJobRequest{StartMs: intPtr(1000), EndMs: intPtr(1500)}},
+		{name: "90 秒を超える", req: request.CreateClipJobRequest{StartMs: intPtr(0), EndMs: intPtr(90001)}},
+		{name: "音声の長さを超える", req: request.CreateClipJobRequest{StartMs: intPtr(100000), EndMs: intPtr(130000)}},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name+"場合はバリデーションエラーを返す", func(t *testing.T) {
+			err := resolveClipRange(&model.ClipJob{}, tt.req, audio)
+
+			assert.True(t, apperror.IsCode(err, apperror.CodeValidation))
+		})
+	}
+}
+
+func TestClipCaptions(t *testing.T) {
+	line1, line2, line3, deleted := uuid.New(), uuid.New(), uuid.New(), uuid.New()
+	timings := model.AudioLineTimings{
+		{ScriptLineID: line1, StartMs: 0, EndMs: 4000},
+		{ScriptLineID: deleted, StartMs: 4000, EndMs: 6000},
+		{ScriptLineID: line2, StartMs: 6000, EndMs: 12000},
+		{ScriptLineID: line3, StartMs: 12000, EndMs: 15000},
+	}
+	texts := map[uuid.UUID]string{
+		line1: "こんにちは",
+		line2: "今日のテーマは音声です",
+		line3: "それでは",
+	}
+
+	t.Run("範囲と重なる行を切り出し開始位置からの相対位置に変換する", func(t *testing.T) {
+		captions := clipCaptions(timings, texts, 2000, 10000)
+
+		assert.Equal(t, []AudiogramCaption{
+			{StartMs: 0, EndMs: 2000, Text: "こんにちは"},
+			{StartMs: 4000, EndMs: 8000, Text: "今日のテーマは音声です"},
+		}, captions)
+	})
+
+	t.Run("範囲の境界に接するだけの行は含めない", func(t *testing.T) {
+		captions := clipCaptions(timings, texts, 4000, 6000)
+
+		assert.Empty(t, captions)
+	})
+}
+
+func TestClipJobService_CreateJob(t *testing.T) {
+	ctx := context.Background()
+	ownerID := uuid.New()
+	channelID := uuid.New()
+	audio := &model.Audio{ID: uuid.New(), Path: "audios/full.mp3", DurationMs: 60000}
+	episode := &model.Episode{
+		ID:        uuid.New(),
+		ChannelID: channelID,
+		Title:     "第1回",
+		Channel:   model.Channel{ID: channelID, UserID: ownerID},
+		FullAudio: audio,
+	}
+	req := request.CreateClipJobRequest{StartMs: intPtr(1000), EndMs: intPtr(31000)}
+
+	t.Run("ジョブを作成してキューに追加する", func(t *testing.T) {
+		d := newClipJobTestDeps()
+		d.episodeRepo.On("FindByID", mock.Anything, episode.ID).Return(episode, nil)
+		d.jobRepo.On("Create", mock.Anything, mock.MatchedBy(func(j *model.ClipJob) bool {
+			return j.UserID == ownerID && j.EpisodeID == episode.ID && *j.AudioID == audio.ID &&
+				j.StartMs == 1000 && j.EndMs == 31000 &&
+				j.AspectRatio == model.ClipAspectRatioSquare && j.Captions &&
+				j.Status == model.ClipJobStatusPending
+		})).Run(func(args mock.Arguments) {
+			args.Get(1).(*model.ClipJob).ID = uuid.New()
+		}).Return(nil)
+		d.tasksClient.On("EnqueueClipJob", mock.Anything, mock.AnythingOfType("string")).Return(nil)
+
+		resp, err := d.service().CreateJob(ctx, ownerID.String(), channelID.String(), episode.ID.String(), req)
+
+		require.NoError(t, err)
+		assert.Equal(t, "pending", resp.Status)
+		assert.Equal(t, "第1回", resp.Episode.Title)
+		assert.Equal(t, "square", resp.AspectRatio)
+		assert.Nil(t, resp.Video)
+		d.tasksClient.AssertExpectations(t)
+	})
+
+	t.Run("アスペクト比と字幕の有無を指定できる", func(t *testing.T) {
+		d := newClipJobTestDeps()
+		d.episodeRepo.On("FindByID", mock.Anything, episode.ID).Return(episode, nil)
+		d.jobRepo.On("Create", mock.Anything, mock.MatchedBy(func(j *model.ClipJob) bool {
+			return j.AspectRatio == model.ClipAspectRatioPortrait && !j.Captions
+		})).Return(nil)
+		d.tasksClient.On("EnqueueClipJob", mock.Anything, mock.AnythingOfType("string")).Return(nil)
+		portrait := "portrait"
+		captions := false
+
+		_, err := d.service().CreateJob(ctx, ownerID.String(), channelID.String(), episode.ID.String(), request.CreateClipJobRequest{
+			StartMs:     req.StartMs,
+			EndMs:       req.EndMs,
+			AspectRatio: &portrait,
+			Captions:    &captions,
+		})
+
+		require.NoError(t, err)
+		d.jobRepo.AssertExpectations(t)
+	})
+
+	t.Run("オーナー以外は作成できない", func(t *testing.T) {
+		d := newClipJobTestDeps()
+		d.episodeRepo.On("FindByID", mock.Anything, episode.ID).Return(episode, nil)
+
+		_, err := d.service().CreateJob(ctx, uuid.New().String(), channelID.String(), episode.ID.String(), req)
+
+		assert.True(t, apperror.IsCode(err, apperror.CodeForbidden))
+		d.jobRepo.AssertNotCalled(t, "Create", mock.Anything, mock.Anything)
+	})
+
+	t.Run("別のチャンネルのエピソードは 404 を返す", func(t *testing.T) {
+		d := newClipJobTestDeps()
+		d.episodeRepo.On("FindByID", mock.Anything, episode.ID).Return(episode, nil)
+
+		_, err := d.service().CreateJob(ctx, ownerID.String(), uuid.New().String(), episode.ID.String(), req)
+
+		assert.True(t, apperror.IsCode(err, apperror.CodeNotFound))
+	})
+
+	t.Run("音声のないエピソードはバリデーションエラーを返す", func(t *testing.T) {
+		d := newClipJobTestDeps()
+		noAudio := *episode
+		noAudio.FullAudio = nil
+		d.episodeRepo.On("FindByID", mock.Anything, episode.ID).Return(&noAudio, nil)
+
+		_, err := d.service().CreateJob(ctx, ownerID.String(), channelID.String(), episode.ID.String(), req)
+
+		assert.True(t, apperror.IsCode(err, apperror.CodeValidation))
+	})
+
+	t.Run("エンキューに失敗した場合はジョブを失敗状態にする", func(t *testing.T) {
+		d := newClipJobTestDeps()
+		d.episodeRepo.On("FindByID", mock.Anything, episode.ID).Return(episode, nil)
+		d.jobRepo.On("Create", mock.Anything, mock.Anything).Return(nil)
+		d.jobRepo.On("Update", mock.Anything, mock.MatchedBy(func(j *model.ClipJob) bool {
+			return j.Status == model.ClipJobStatusFailed && *j.ErrorCode == "ENQUEUE_FAILED"
+		})).Return(nil)
+		d.tasksClient.On("EnqueueClipJob", mock.Anything, mock.Anything).Return(assert.AnError)
+
+		_, err := d.service().CreateJob(ctx, ownerID.String(), channelID.String(), episode.ID.String(), req)
+
+		assert.True(t, apperror.IsCode(err, apperror.CodeInternal))
+		d.jobRepo.AssertExpectations(t)
+	})
+}
+
+func TestClipJobService_GetJob(t *testing.T) {
+	ctx := context.Background()
+	ownerID := uuid.New()
+
+	t.Run("完了したジョブは動画の署名付き URL を返す", func(t *testing.T) {
+		d := newClipJobTestDeps()
+		video := &model.Video{ID: uuid.New(), Path: "videos/clip.mp4", MimeType: "video/mp4", DurationMs: 30000, Width: 1080, Height: 1920}
+		job := &model.ClipJob{
+			ID:          uuid.New(),
+			UserID:      ownerID,
+			AspectRatio: model.ClipAspectRatioPortrait,
+			Status:      model.ClipJobStatusCompleted,
+			ResultVideo: video,
+		}
+		d.jobRepo.On("FindByID", mock.Anything, job.ID).Return(job, nil)
+		d.storageClient.On("GenerateSignedURL", mock.Anything, "videos/clip.mp4", storage.SignedURLExpirationVideo).Return("https://storage.example.com/videos/clip.mp4", nil)
+
+		resp, err := d.service().GetJob(ctx, ownerID.String(), job.ID.String())
+
+		require.NoError(t, err)
+		require.NotNil(t, resp.Video)
+		assert.Equal(t, "https://storage.example.com/videos/clip.mp4", resp.Video.URL)
+		assert.Equal(t, 1920, resp.Video.Height)
+	})
+
+	t.Run("他のユーザーのジョブは取得できない", func(t *testing.T) {
+		d := newClipJobTestDeps()
+		job := &model.ClipJob{ID: uuid.New(), UserID: ownerID}
+		d.jobRepo.On("FindByID", mock.Anything, job.ID).Return(job, nil)
+
+		_, err := d.service().GetJob(ctx, uuid.New().String(), job.ID.String())
+
+		assert.True(t, apperror.IsCode(err, apperror.CodeForbidden))
+	})
+}
+
+func TestClipJobService_ExecuteJob(t *testing.T) {
+	ctx := context.Background()
+	line1, line2 := uuid.New(), uuid.New()
+
+	newJob := func() *model.ClipJob {
+		episodeID := uuid.New()
+		return &model.ClipJob{
+			ID:          uuid.New(),
+			UserID:      uuid.New(),
+			EpisodeID:   episodeID,
+			StartMs:     2000,
+			EndMs:       12000,
+			AspectRatio: model.ClipAspectRatioPortrait,
+			Captions:    true,
+			Status:      model.ClipJobStatusPending,
+			Episode: model.Episode{
+				ID:      episodeID,
+				Channel: model.Channel{Artwork: &model.Image{Path: "images/channel.png"}},
+			},
+			Audio: &model.Audio{
+				Path: "audios/full.mp3",
+				LineTimings: model.AudioLineTimings{
+					{ScriptLineID: line1, StartMs: 1000, EndMs: 5000},
+					{ScriptLineID: line2, StartMs: 5000, EndMs: 15000},
+				},
+			},
+		}
+	}
+
+	t.Run("動画を生成してアップロードし、ジョブを完了にする", func(t *testing.T) {
+		d := newClipJobTestDeps()
+		job := newJob()
+		d.jobRepo.On("FindByID", mock.Anything, job.ID).Return(job, nil)
+		d.jobRepo.On("Update", mock.Anything, mock.Anything).Return(nil)
+		d.jobRepo.On("UpdateProgress", mock.Anything, job.ID, mock.Anything).Return(nil)
+		d.storageClient.On("DownloadStream", mock.Anything, "audios/full.mp3").Return([]byte("audio"), nil)
+		d.storageClient.On("DownloadStream", mock.Anything, "images/channel.png").Return([]byte("png"), nil)
+		d.scriptLineRepo.On("FindByEpisodeID", mock.Anything, job.EpisodeID).Return([]model.ScriptLine{
+			{ID: line1, Text: "こんにちは"},
+			{ID: line2, Text: "今日は[pause:500ms]音声の[em:話]です"},
+		}, nil)
+		d.storageClient.On("UploadStream", mock.Anything, []byte("mp4"), mock.MatchedBy(func(p string) bool {
+			return len(p) > len("videos/") && p[:len("videos/")] == "videos/"
+		}), "video/mp4").Return("", nil)
+		d.videoRepo.On("Create", mock.Anything, mock.MatchedBy(func(v *model.Video) bool {
+			return v.MimeType == "video/mp4" && v.FileSize == 3 && v.DurationMs == 10000 && v.Width == 1080 && v.Height == 1920
+		})).Return(nil)
+
+		err := d.service().ExecuteJob(ctx, job.ID.String())
+
+		require.NoError(t, err)
+		assert.Equal(t, model.ClipJobStatusCompleted, job.Status)
+		assert.Equal(t, 100, job.Progress)
+		require.NotNil(t, job.ResultVideoID)
+		assert.Equal(t, "audio", d.ffmpeg.audio)
+		assert.Equal(t, 2000, d.ffmpeg.params.StartMs)
+		assert.Equal(t, 10000, d.ffmpeg.params.DurationMs)
+		assert.NotNil(t, d.ffmpeg.params.Artwork)
+		assert.Equal(t, []AudiogramCaption{
+			{StartMs: 0, EndMs: 3000, Text: "こんにちは"},
+			{StartMs: 3000, EndMs: 10000, Text: "今日は音声の話です"},
+		}, d.ffmpeg.params.Captions)
+		d.videoRepo.AssertExpectations(t)
+	})
+
+	t.Run("字幕なしの場合は台本を読み込まない", func(t *testing.T) {
+		d := newClipJobTestDeps()
+		job := newJob()
+		job.Captions = false
+		job.Episode.Channel.Artwork = nil
+		d.jobRepo.On("FindByID", mock.Anything, job.ID).Return(job, nil)
+		d.jobRepo.On("Update", mock.Anything, mock.Anything).Return(nil)
+		d.jobRepo.On("UpdateProgress", mock.Anything, job.ID, mock.Anything).Return(nil)
+		d.storageClient.On("DownloadStream", mock.Anything, "audios/full.mp3").Return([]byte("audio"), nil)
+		d.storageClient.On("UploadStream", mock.Anything, mock.Anything, mock.Anything, "video/mp4").Return("", nil)
+		d.videoRepo.On("Create", mock.Anything, mock.Anything).Return(nil)
+
+		err := d.service().ExecuteJob(ctx, job.ID.String())
+
+		require.NoError(t, err)
+		assert.Nil(t, d.ffmpeg.params.Artwork)
+		assert.Empty(t, d.ffmpeg.params.Captions)
+		d.scriptLineRepo.AssertNotCalled(t, "FindByEpisodeID", mock.Anything, mock.Anything)
+	})
+
+	t.Run("動画の生成に失敗した場合はジョブを失敗状態にする", func(t *testing.T) {
+		d := newClipJobTestDeps()
+		d.ffmpeg.err = apperror.ErrInternal.WithMessage("クリップ動画の生成に失敗しました")
+		job := newJob()
+		job.Captions = false
+		d.jobRepo.On("FindByID", mock.Anything, job.ID).Return(job, nil)
+		d.jobRepo.On("Update", mock.Anything, mock.Anything).Return(nil)
+		d.jobRepo.On("UpdateProgress", mock.Anything, job.ID, mock.Anything).Return(nil)
+		d.storageClient.On("DownloadStream", mock.Anything, mock.Anything).Return([]byte("data"), nil)
+
+		err := d.service().ExecuteJob(ctx, job.ID.String())
+
+		require.Error(t, err)
+		assert.Equal(t, model.ClipJobStatusFailed, job.Status)
+		assert.Equal(t, "クリップ動画の生成に失敗しました", *job.ErrorMessage)
+		d.videoRepo.AssertNotCalled(t, "Create", mock.Anything, mock.Anything)
+	})
+
+	t.Run("完了済みのジョブはスキップする", func(t *testing.T) {
+		d := newClipJobTestDeps()
+		job := newJob()
+		job.Status = model.ClipJobStatusCompleted
+		d.jobRepo.On("FindByID", mock.Anything, job.ID).Return(job, nil)
+
+		err := d.service().ExecuteJob(ctx, job.ID.String())
+
+		require.NoError(t, err)
+		d.jobRepo.AssertNotCalled(t, "Update", mock.Anything, mock.Anything)
+	})
+}
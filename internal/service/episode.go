@@ -1,6 +1,7 @@
 package service
 
 import (
+	"bytes"
 	"context"
 	"io"
 	"time"
@@ -33,6 +34,7 @@ type EpisodeService interface {
 	DeleteBgm(ctx context.Context, userID, channelID, episodeID string) (*response.EpisodeDataResponse, error)
 	DeleteAudio(ctx context.Context, userID, channelID, episodeID string) error
 	UploadAudio(ctx context.Context, userID, channelID, episodeID string, input UploadAudioInput) (*response.EpisodeDataResponse, error)
+	GetHLSPlaylist(ctx context.Context, userID, channelID, episodeID string) (*HLSPlaylist, error)
 }
 
 type episodeService struct {
@@ -45,8 +47,13 @@ type episodeService struct {
 	systemBgmRepo       repository.SystemBgmRepository
 	playbackHistoryRepo repository.PlaybackHistoryRepository
 	playlistRepo        repository.PlaylistRepository
+	hlsRepo             repository.EpisodeHLSPackageRepository
+	audioJobRepo        repository.AudioJobRepository
 	storageClient       storage.Client
 	ttsRegistry         *tts.Registry
+	ffmpegService       FFmpegService
+	audioJobService     AudioJobService
+	id3Tagger           *episodeID3Tagger
 }
 
 // NewEpisodeService は episodeService を生成して EpisodeService として返す
@@ -60,8 +67,13 @@ func NewEpisodeService(
 	systemBgmRepo repository.SystemBgmRepository,
 	playbackHistoryRepo repository.PlaybackHistoryRepository,
 	playlistRepo repository.PlaylistRepository,
+	hlsRepo repository.EpisodeHLSPackageRepository,
+	audioJobRepo repository.AudioJobRepository,
 	storageClient storage.Client,
 	ttsRegistry *tts.Registry,
+	ffmpegService FFmpegService,
+	audioJobService AudioJobService,
+	embedID3Lyrics bool,
 ) EpisodeService {
 	return &episodeService{
 		episodeRepo:         episodeRepo,
@@ -73,8 +85,20 @@ func NewEpisodeService(
 		systemBgmRepo:       systemBgmRepo,
 		playbackHistoryRepo: playbackHistoryRepo,
 		playlistRepo:        playlistRepo,
+		hlsRepo:             hlsRepo,
+		audioJobRepo:        audioJobRepo,
 		storageClient:       storageClient,
 		ttsRegistry:         ttsRegistry,
+		ffmpegService:       ffmpegService,
+		audioJobService:     audioJobService,
+		id3Tagger: &episodeID3Tagger{
+			episodeRepo:    episodeRepo,
+			channelRepo:    channelRepo,
+			scriptLineRepo: scriptLineRepo,
+			audioRepo:      audioRepo,
+			storageClient:  storageClient,
+			embedLyrics:    embedID3Lyrics,
+		},
 	}
 }
 
@@ -395,6 +419,7 @@ func (s *episodeService) CreateEpisode(ctx context.Context, userID, channelID, t
 		ID:          episode.ID,
 		Title:       episode.Title,
 		Description: episode.Description,
+		Renditions:  []response.AudioRenditionResponse{},
 		PublishedAt: episode.PublishedAt,
 		CreatedAt:   episode.CreatedAt,
 		UpdatedAt:   episode.UpdatedAt,
@@ -438,6 +463,9 @@ func (s *episodeService) UpdateEpisode(ctx context.Context, userID, channelID, e
 		return nil, apperror.ErrNotFound.WithMessage("このチャンネルにエピソードが見つかりません")
 	}
 
+	// ID3 タグに影響する項目が変更されたか
+	id3Changed := episode.Title != req.Title || episode.Description != req.Description || req.ArtworkImageID.IsSet
+
 	// 各フィールドを更新
 	episode.Title = req.Title
 	episode.Description = req.Description
@@ -477,6 +505,14 @@ func (s *episodeService) UpdateEpisode(ctx context.Context, userID, channelID, e
 		return nil, err
 	}
 
+	// 既存の MP3 の ID3 タグの書き換えは音声生成ジョブとして実行し、他のジョブと同時に音声を書き換えないようにする
+	// ジョブの登録に失敗しても更新自体は成功とする
+	if id3Changed && updated.FullAudio != nil {
+		if err := s.audioJobService.CreateRetagJob(ctx, uid, eid); err != nil {
+			logger.FromContext(ctx).Warn("failed to create retag job", "error", err, "episode_id", eid)
+		}
+	}
+
 	return &response.EpisodeDataResponse{
 		Data: resp,
 	}, nil
@@ -530,6 +566,12 @@ func (s *episodeService) DeleteEpisode(ctx context.Context, userID, channelID, e
 	if episode.FullAudio != nil {
 		filesToDelete = append(filesToDelete, episode.FullAudio.Path)
 	}
+	for _, r := range episode.AudioRenditions {
+		filesToDelete = append(filesToDelete, r.Audio.Path)
+	}
+	if episode.HLSPackage != nil {
+		filesToDelete = append(filesToDelete, episode.HLSPackage.FilePaths()...)
+	}
 	if episode.Bgm != nil && episode.Bgm.Audio.ID != uuid.Nil {
 		filesToDelete = append(filesToDelete, episode.Bgm.Audio.Path)
 	}
@@ -935,12 +977,23 @@ func (s *episodeService) DeleteAudio(ctx context.Context, userID, channelID, epi
 		filesToDelete = append(filesToDelete, episode.FullAudio.Path)
 		audioIDsToDelete = append(audioIDsToDelete, episode.FullAudio.ID)
 	}
+	// 配信用音声は audios の削除に連動して紐づけも削除される
+	for _, r := range episode.AudioRenditions {
+		filesToDelete = append(filesToDelete, r.Audio.Path)
+		audioIDsToDelete = append(audioIDsToDelete, r.AudioID)
+	}
+	// HLS パッケージは FullAudio の削除に連動してレコードも削除される
+	if episode.HLSPackage != nil {
+		filesToDelete = append(filesToDelete, episode.HLSPackage.FilePaths()...)
+	}
 
 	// エピソードの音声参照を NULL に更新
 	episode.VoiceAudioID = nil
 	episode.FullAudioID = nil
 	episode.VoiceAudio = nil
 	episode.FullAudio = nil
+	episode.AudioRenditions = nil
+	episode.HLSPackage = nil
 
 	if err := s.episodeRepo.Update(ctx, episode); err != nil {
 		return err
@@ -1005,6 +1058,16 @@ func (s *episodeService) UploadAudio(ctx context.Context, userID, channelID, epi
 		return nil, apperror.ErrNotFound.WithMessage("このチャンネルにエピソードが見つかりません")
 	}
 
+	// 音声生成中のジョブが完了時に音声を上書きしないよう、処理中のジョブがある場合はアップロードできない
+	// 配信用音声の生成などの内部ジョブは、完了前に音声が差し替わったことを検知して結果を破棄するため対象外
+	pendingJob, err := s.audioJobRepo.FindPendingByEpisodeID(ctx, eid, model.UserAudioJobTypes)
+	if err != nil {
+		return nil, err
+	}
+	if pendingJob != nil {
+		return nil, apperror.ErrValidation.WithMessage("このエピソードは既に音声生成中です")
+	}
+
 	// MIME タイプのバリデーション
 	ext, ok := allowedAudioMimeTypes[input.ContentType]
 	if !ok {
@@ -1023,8 +1086,9 @@ func (s *episodeService) UploadAudio(ctx context.Context, userID, channelID, epi
 		return nil, apperror.ErrInternal.WithMessage("音声データの読み込みに失敗しました").WithError(err)
 	}
 
-	// 再生時間を取得
+	// 再生時間と波形を取得（voiceAudio / fullAudio で共有する）
 	durationMs := audio.GetDurationMs(data)
+	waveforms := generateWaveforms(ctx, s.ffmpegService, bytes.NewReader(data))
 
 	// voiceAudio 用 Audio レコード作成 + GCS アップロード
 	voiceAudioID := uuid.New()
@@ -1040,6 +1104,7 @@ func (s *episodeService) UploadAudio(ctx context.Context, userID, channelID, epi
 		Filename:   input.Filename,
 		FileSize:   input.FileSize,
 		DurationMs: durationMs,
+		Waveforms:  waveforms,
 	}
 	if err := s.audioRepo.Create(ctx, voiceAudio); err != nil {
 		if deleteErr := s.storageClient.Delete(ctx, voicePath); deleteErr != nil {
@@ -1062,6 +1127,7 @@ func (s *episodeService) UploadAudio(ctx context.Context, userID, channelID, epi
 		Filename:   input.Filename,
 		FileSize:   input.FileSize,
 		DurationMs: durationMs,
+		Waveforms:  waveforms,
 	}
 	if err := s.audioRepo.Create(ctx, fullAudio); err != nil {
 		if deleteErr := s.storageClient.Delete(ctx, fullPath); deleteErr != nil {
@@ -1082,16 +1148,46 @@ func (s *episodeService) UploadAudio(ctx context.Context, userID, channelID, epi
 		}
 	}
 
+	// 古い配信用音声はアップロードした音声と内容が異なるため削除する（ベストエフォート）
+	// audios の削除に連動して紐づけも削除される。新しい配信用音声はアップロード後にジョブで生成し直す
+	for _, r := range episode.AudioRenditions {
+		if err := s.audioRepo.Delete(ctx, r.AudioID); err != nil {
+			log.Warn("failed to delete old audio rendition record", "audio_id", r.AudioID, "error", err)
+		}
+		if err := s.storageClient.Delete(ctx, r.Audio.Path); err != nil {
+			log.Warn("failed to delete old audio rendition from storage", "path", r.Audio.Path, "error", err)
+		}
+	}
+
+	// 古い HLS パッケージも同様に削除する（ベストエフォート）
+	if episode.HLSPackage != nil {
+		if err := s.hlsRepo.DeleteByEpisodeID(ctx, episode.ID); err != nil {
+			log.Warn("failed to delete old hls package record", "episode_id", episode.ID, "error", err)
+		}
+		for _, path := range episode.HLSPackage.FilePaths() {
+			if err := s.storageClient.Delete(ctx, path); err != nil {
+				log.Warn("failed to delete old hls file from storage", "path", path, "error", err)
+			}
+		}
+	}
+
 	// エピソードの voiceAudioID / fullAudioID を更新
 	episode.VoiceAudioID = &voiceAudioID
 	episode.FullAudioID = &fullAudioID
 	episode.VoiceAudio = nil
 	episode.FullAudio = nil
+	episode.AudioRenditions = nil
+	episode.HLSPackage = nil
 
 	if err := s.episodeRepo.Update(ctx, episode); err != nil {
 		return nil, err
 	}
 
+	// アップロードした音声から配信用音声を生成し直す（ジョブの登録に失敗してもアップロード自体は成功とする）
+	if err := s.audioJobService.CreateRenditionJob(ctx, uid, eid); err != nil {
+		log.Warn("failed to create rendition job", "error", err, "episode_id", eid)
+	}
+
 	// リレーションをプリロードして取得
 	updated, err := s.episodeRepo.FindByID(ctx, episode.ID)
 	if err != nil {
@@ -1209,6 +1305,7 @@ func (s *episodeService) toEpisodeResponse(ctx context.Context, e *model.Episode
 			MimeType:   e.VoiceAudio.MimeType,
 			FileSize:   e.VoiceAudio.FileSize,
 			DurationMs: e.VoiceAudio.DurationMs,
+			Waveform:   toAudioWaveformResponse(e.VoiceAudio.Waveforms),
 		}
 	}
 
@@ -1223,9 +1320,17 @@ func (s *episodeService) toEpisodeResponse(ctx context.Context, e *model.Episode
 			MimeType:   e.FullAudio.MimeType,
 			FileSize:   e.FullAudio.FileSize,
 			DurationMs: e.FullAudio.DurationMs,
+			Waveform:   toAudioWaveformResponse(e.FullAudio.Waveforms),
 		}
 	}
 
+	renditions, err := toAudioRenditionResponses(ctx, s.storageClient, e.AudioRenditions)
+	if err != nil {
+		return response.EpisodeResponse{}, err
+	}
+	resp.Renditions = renditions
+	resp.HLS = toEpisodeHLSResponse(e)
+
 	// Bgm または SystemBgm からレスポンスを構築
 	if e.Bgm != nil && e.Bgm.Audio.ID != uuid.Nil {
 		signedURL, err := s.storageClient.GenerateSignedURL(ctx, e.Bgm.Audio.Path, storage.SignedURLExpirationAudio)
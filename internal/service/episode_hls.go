@@ -0,0 +1,127 @@
+package service
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/siropaca/anycast-backend/internal/apperror"
+	"github.com/siropaca/anycast-backend/internal/dto/response"
+	"github.com/siropaca/anycast-backend/internal/infrastructure/storage"
+	"github.com/siropaca/anycast-backend/internal/model"
+	"github.com/siropaca/anycast-backend/internal/pkg/uuid"
+)
+
+// hlsPlaylistURLFormat は HLS プレイリスト取得エンドポイントのパス
+const hlsPlaylistURLFormat = "/api/v1/channels/%s/episodes/%s/hls/" + model.HLSPlaylistFilename
+
+// HLSPlaylist は署名付き URL に書き換えた HLS プレイリストを表す
+type HLSPlaylist struct {
+	Data        []byte
+	ContentType string
+	MaxAge      time.Duration
+}
+
+// GetHLSPlaylist はエピソードの HLS プレイリストを取得し、セグメント URI を署名付き URL に書き換えて返す
+//
+// 閲覧可否は GetEpisode と同じく、公開済みのエピソードまたはオーナーのみ
+func (s *episodeService) GetHLSPlaylist(ctx context.Context, userID, channelID, episodeID string) (*HLSPlaylist, error) {
+	episode, err := s.findViewableEpisode(ctx, userID, channelID, episodeID)
+	if err != nil {
+		return nil, err
+	}
+
+	pkg := episode.HLSPackage
+	if pkg == nil {
+		return nil, apperror.ErrNotFound.WithMessage("HLS パッケージが見つかりません")
+	}
+
+	playlist, err := downloadFromStorageClient(ctx, s.storageClient, pkg.PlaylistPath())
+	if err != nil {
+		return nil, err
+	}
+
+	// プレイリストのキャッシュ期間内に URL が失効しないよう、余裕を持った有効期限で署名する
+	rewritten, err := rewriteHLSPlaylist(playlist, func(uri string) (string, error) {
+		return s.storageClient.GenerateSignedURL(ctx, pkg.SegmentPath(uri), storage.SignedURLExpirationAudio)
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return &HLSPlaylist{
+		Data:        rewritten,
+		ContentType: hlsPlaylistContentType,
+		MaxAge:      hlsPlaylistCacheMaxAge,
+	}, nil
+}
+
+// findViewableEpisode はユーザーが閲覧可能なエピソードを取得する
+//
+// オーナーは非公開でも取得でき、それ以外は公開済みのチャンネル・エピソードのみ取得できる
+func (s *episodeService) findViewableEpisode(ctx context.Context, userID, channelID, episodeID string) (*model.Episode, error) {
+	var uid uuid.UUID
+	if userID != "" {
+		var err error
+		uid, err = uuid.Parse(userID)
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	cid, err := uuid.Parse(channelID)
+	if err != nil {
+		return nil, err
+	}
+
+	eid, err := uuid.Parse(episodeID)
+	if err != nil {
+		return nil, err
+	}
+
+	channel, err := s.channelRepo.FindByID(ctx, cid)
+	if err != nil {
+		return nil, err
+	}
+
+	now := time.Now()
+	isOwner := userID != "" && channel.UserID == uid
+	isChannelPublished := channel.PublishedAt != nil && !channel.PublishedAt.After(now)
+
+	if !isOwner && !isChannelPublished {
+		return nil, apperror.ErrNotFound.WithMessage("チャンネルが見つかりません")
+	}
+
+	episode, err := s.episodeRepo.FindByID(ctx, eid)
+	if err != nil {
+		return nil, err
+	}
+
+	if episode.ChannelID != cid {
+		return nil, apperror.ErrNotFound.WithMessage("このチャンネルにエピソードが見つかりません")
+	}
+
+	if !isOwner {
+		isEpisodePublished := episode.PublishedAt != nil && !episode.PublishedAt.After(now)
+		if !isEpisodePublished {
+			return nil, apperror.ErrNotFound.WithMessage("エピソードが見つかりません")
+		}
+	}
+
+	return episode, nil
+}
+
+// toEpisodeHLSResponse はエピソードの HLS パッケージをレスポンス DTO に変換する
+func toEpisodeHLSResponse(e *model.Episode) *response.EpisodeHLSResponse {
+	if e.HLSPackage == nil {
+		return nil
+	}
+
+	return &response.EpisodeHLSResponse{
+		PlaylistURL:        fmt.Sprintf(hlsPlaylistURLFormat, e.ChannelID, e.ID),
+		SegmentCount:       e.HLSPackage.SegmentCount,
+		SegmentDurationSec: e.HLSPackage.SegmentDurationSec,
+		BitrateKbps:        e.HLSPackage.BitrateKbps,
+		DurationMs:         e.HLSPackage.DurationMs,
+	}
+}
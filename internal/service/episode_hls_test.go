@@ -0,0 +1,111 @@
+package service
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+
+	"github.com/siropaca/anycast-backend/internal/apperror"
+	"github.com/siropaca/anycast-backend/internal/infrastructure/storage"
+	"github.com/siropaca/anycast-backend/internal/model"
+	"github.com/siropaca/anycast-backend/internal/pkg/uuid"
+)
+
+func TestEpisodeService_GetHLSPlaylist(t *testing.T) {
+	ctx := context.Background()
+	ownerID := uuid.New()
+	otherUserID := uuid.New()
+	channelID := uuid.New()
+	episodeID := uuid.New()
+	past := time.Now().Add(-time.Hour)
+
+	pkg := &model.EpisodeHLSPackage{
+		ID:           uuid.New(),
+		EpisodeID:    episodeID,
+		PathPrefix:   "hls/pkg",
+		SegmentCount: 2,
+	}
+	playlist := []byte("#EXTM3U\n#EXTINF:6.000000,\nsegment_00000.ts\n#EXTINF:6.000000,\nsegment_00001.ts\n#EXT-X-ENDLIST\n")
+
+	t.Run("公開済みエピソードのセグメント URI を署名付き URL に書き換えて返す", func(t *testing.T) {
+		mockChannelRepo := new(mockChannelRepository)
+		mockEpisodeRepo := new(mockEpisodeRepository)
+		mockStorage := new(mockStorageClient)
+
+		mockChannelRepo.On("FindByID", mock.Anything, channelID).Return(&model.Channel{ID: channelID, UserID: ownerID, PublishedAt: &past}, nil)
+		mockEpisodeRepo.On("FindByID", mock.Anything, episodeID).Return(&model.Episode{ID: episodeID, ChannelID: channelID, PublishedAt: &past, HLSPackage: pkg}, nil)
+		mockStorage.On("DownloadStream", mock.Anything, "hls/pkg/playlist.m3u8").Return(playlist, nil)
+		mockStorage.On("GenerateSignedURL", mock.Anything, "hls/pkg/segment_00000.ts", storage.SignedURLExpirationAudio).Return("https://example.com/0?sig", nil)
+		mockStorage.On("GenerateSignedURL", mock.Anything, "hls/pkg/segment_00001.ts", storage.SignedURLExpirationAudio).Return("https://example.com/1?sig", nil)
+
+		svc := &episodeService{channelRepo: mockChannelRepo, episodeRepo: mockEpisodeRepo, storageClient: mockStorage}
+
+		result, err := svc.GetHLSPlaylist(ctx, "", channelID.String(), episodeID.String())
+
+		assert.NoError(t, err)
+		assert.Equal(t, "#EXTM3U\n#EXTINF:6.000000,\nhttps://example.com/0?sig\n#EXTINF:6.000000,\nhttps://example.com/1?sig\n#EXT-X-ENDLIST\n", string(result.Data))
+		assert.Equal(t, "application/vnd.apple.mpegurl", result.ContentType)
+		mockStorage.AssertExpectations(t)
+	})
+
+	t.Run("非公開エピソードはオーナー以外には 404 を返す", func(t *testing.T) {
+		mockChannelRepo := new(mockChannelRepository)
+		mockEpisodeRepo := new(mockEpisodeRepository)
+
+		mockChannelRepo.On("FindByID", mock.Anything, channelID).Return(&model.Channel{ID: channelID, UserID: ownerID, PublishedAt: &past}, nil)
+		mockEpisodeRepo.On("FindByID", mock.Anything, episodeID).Return(&model.Episode{ID: episodeID, ChannelID: channelID, HLSPackage: pkg}, nil)
+
+		svc := &episodeService{channelRepo: mockChannelRepo, episodeRepo: mockEpisodeRepo}
+
+		_, err := svc.GetHLSPlaylist(ctx, otherUserID.String(), channelID.String(), episodeID.String())
+
+		var appErr *apperror.AppError
+		assert.ErrorAs(t, err, &appErr)
+		assert.Equal(t, apperror.CodeNotFound, appErr.Code)
+	})
+
+	t.Run("HLS パッケージがない場合は 404 を返す", func(t *testing.T) {
+		mockChannelRepo := new(mockChannelRepository)
+		mockEpisodeRepo := new(mockEpisodeRepository)
+
+		mockChannelRepo.On("FindByID", mock.Anything, channelID).Return(&model.Channel{ID: channelID, UserID: ownerID}, nil)
+		mockEpisodeRepo.On("FindByID", mock.Anything, episodeID).Return(&model.Episode{ID: episodeID, ChannelID: channelID}, nil)
+
+		svc := &episodeService{channelRepo: mockChannelRepo, episodeRepo: mockEpisodeRepo}
+
+		_, err := svc.GetHLSPlaylist(ctx, ownerID.String(), channelID.String(), episodeID.String())
+
+		var appErr *apperror.AppError
+		assert.ErrorAs(t, err, &appErr)
+		assert.Equal(t, apperror.CodeNotFound, appErr.Code)
+	})
+}
+
+func TestToEpisodeHLSResponse(t *testing.T) {
+	channelID := uuid.New()
+	episodeID := uuid.New()
+
+	t.Run("HLS パッケージがない場合は nil を返す", func(t *testing.T) {
+		assert.Nil(t, toEpisodeHLSResponse(&model.Episode{ID: episodeID, ChannelID: channelID}))
+	})
+
+	t.Run("プレイリスト取得エンドポイントのパスを返す", func(t *testing.T) {
+		resp := toEpisodeHLSResponse(&model.Episode{
+			ID:        episodeID,
+			ChannelID: channelID,
+			HLSPackage: &model.EpisodeHLSPackage{
+				SegmentCount:       300,
+				SegmentDurationSec: 6,
+				BitrateKbps:        64,
+				DurationMs:         1800000,
+			},
+		})
+
+		assert.Equal(t, "/api/v1/channels/"+channelID.String()+"/episodes/"+episodeID.String()+"/hls/playlist.m3u8", resp.PlaylistURL)
+		assert.Equal(t, 300, resp.SegmentCount)
+		assert.Equal(t, 6, resp.SegmentDurationSec)
+	})
+}
@@ -0,0 +1,441 @@
+package service
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"io"
+	"path"
+	"strings"
+	"time"
+
+	"github.com/siropaca/anycast-backend/internal/apperror"
+	"github.com/siropaca/anycast-backend/internal/dto/response"
+	"github.com/siropaca/anycast-backend/internal/infrastructure/storage"
+	"github.com/siropaca/anycast-backend/internal/model"
+	"github.com/siropaca/anycast-backend/internal/pkg/logger"
+	"github.com/siropaca/anycast-backend/internal/pkg/script"
+	"github.com/siropaca/anycast-backend/internal/pkg/uuid"
+	"github.com/siropaca/anycast-backend/internal/repository"
+)
+
+const (
+	// episodePackageFormatVersion はダウンロードパッケージの形式のバージョン
+	//
+	// パッケージの構成を変えた場合は上げる（キャッシュのキーに含めるため、古い形式のキャッシュは使われなくなる）
+	episodePackageFormatVersion = 1
+	// episodePackageMimeType はダウンロードパッケージの MIME タイプ
+	episodePackageMimeType = "application/zip"
+)
+
+// ダウンロードパッケージ内のファイル名
+const (
+	episodePackageMetadataFile   = "metadata.json"
+	episodePackageScriptTextFile = "script.txt"
+	episodePackageScriptJSONFile = "script.json"
+	episodePackageCaptionsSRT    = "captions.srt"
+	episodePackageCaptionsVTT    = "captions.vtt"
+	episodePackageFullAudioName  = "audio/full"
+	episodePackageVoiceAudioName = "audio/voice"
+	episodePackageArtworkName    = "artwork"
+)
+
+// EpisodePackageService はエピソードのダウンロードパッケージ（ZIP）に関するビジネスロジックインターフェースを表す
+type EpisodePackageService interface {
+	CreatePackage(ctx context.Context, userID, channelID, episodeID string) (*response.EpisodePackageDataResponse, error)
+}
+
+type episodePackageService struct {
+	channelRepo    repository.ChannelRepository
+	episodeRepo    repository.EpisodeRepository
+	scriptLineRepo repository.ScriptLineRepository
+	storageClient  storage.Client
+}
+
+// NewEpisodePackageService は episodePackageService を生成して EpisodePackageService として返す
+func NewEpisodePackageService(
+	channelRepo repository.ChannelRepository,
+	episodeRepo repository.EpisodeRepository,
+	scriptLineRepo repository.ScriptLineRepository,
+	storageClient storage.Client,
+) EpisodePackageService {
+	return &episodePackageService{
+		channelRepo:    channelRepo,
+		episodeRepo:    episodeRepo,
+		scriptLineRepo: scriptLineRepo,
+		storageClient:  storageClient,
+	}
+}
+
+// ダウンロードパッケージの metadata.json の形式
+type episodePackageMetadata struct {
+	FormatVersion int                         `json:"formatVersion"`
+	Channel       episodePackageChannel       `json:"channel"`
+	Episode       episodePackageEpisode       `json:"episode"`
+	Speakers      []episodePackageSpeaker     `json:"speakers"`
+	Files         episodePackageMetadataFiles `json:"files"`
+}
+
+type episodePackageChannel struct {
+	ID   uuid.UUID `json:"id"`
+	Name string    `json:"name"`
+}
+
+type episodePackageEpisode struct {
+	ID          uuid.UUID  `json:"id"`
+	Title       string     `json:"title"`
+	Description string     `json:"description"`
+	PublishedAt *time.Time `json:"publishedAt"`
+	CreatedAt   time.Time  `json:"createdAt"`
+}
+
+type episodePackageSpeaker struct {
+	Name  string              `json:"name"`
+	Voice episodePackageVoice `json:"voice"`
+}
+
+type episodePackageVoice struct {
+	Provider        string `json:"provider"`
+	ProviderVoiceID string `json:"providerVoiceId"`
+	Name            string `json:"name"`
+}
+
+type episodePackageMetadataFiles struct {
+	FullAudio  *episodePackageAudioFile `json:"fullAudio"`
+	VoiceAudio *episodePackageAudioFile `json:"voiceAudio"`
+	Artwork    *string                  `json:"artwork"`
+	Script     *episodePackageScript    `json:"script"`
+	Captions   *episodePackageCaptions  `json:"captions"`
+}
+
+type episodePackageAudioFile struct {
+	Path       string `json:"path"`
+	MimeType   string `json:"mimeType"`
+	DurationMs int    `json:"durationMs"`
+}
+
+type episodePackageScript struct {
+	Text      string `json:"text"`
+	JSON      string `json:"json"`
+	LineCount int    `json:"lineCount"`
+}
+
+type episodePackageCaptions struct {
+	SRT string `json:"srt"`
+	VTT string `json:"vtt"`
+}
+
+// ダウンロードパッケージの script.json の形式
+type episodePackageScriptJSON struct {
+	Lines []episodePackageScriptLine `json:"lines"`
+}
+
+type episodePackageScriptLine struct {
+	ID        uuid.UUID `json:"id"`
+	LineOrder int       `json:"lineOrder"`
+	Speaker   string    `json:"speaker"`
+	Text      string    `json:"text"`
+	Emotion   *string   `json:"emotion"`
+	StartMs   *int      `json:"startMs"`
+	EndMs     *int      `json:"endMs"`
+}
+
+// CreatePackage はエピソードの音声・台本・字幕・アートワーク・メタデータをまとめた ZIP の署名付き URL を返す
+//
+// ZIP は内容から算出したハッシュをキーにストレージへキャッシュし、エピソードが変更されていなければ作り直さない
+func (s *episodePackageService) CreatePackage(ctx context.Context, userID, channelID, episodeID string) (*response.EpisodePackageDataResponse, error) {
+	uid, err := uuid.Parse(userID)
+	if err != nil {
+		return nil, err
+	}
+
+	cid, err := uuid.Parse(channelID)
+	if err != nil {
+		return nil, err
+	}
+
+	eid, err := uuid.Parse(episodeID)
+	if err != nil {
+		return nil, err
+	}
+
+	// チャンネルの存在確認とオーナーチェック
+	channel, err := s.channelRepo.FindByID(ctx, cid)
+	if err != nil {
+		return nil, err
+	}
+
+	if channel.UserID != uid {
+		return nil, apperror.ErrForbidden.WithMessage("このチャンネルへのアクセス権限がありません")
+	}
+
+	// エピソードの存在確認とチャンネルの一致チェック
+	episode, err := s.episodeRepo.FindByID(ctx, eid)
+	if err != nil {
+		return nil, err
+	}
+
+	if episode.ChannelID != cid {
+		return nil, apperror.ErrNotFound.WithMessage("このチャンネルにエピソードが見つかりません")
+	}
+
+	lines, err := s.scriptLineRepo.FindByEpisodeID(ctx, eid)
+	if err != nil {
+		return nil, err
+	}
+
+	if episode.FullAudio == nil && episode.VoiceAudio == nil && len(lines) == 0 {
+		return nil, apperror.ErrValidation.WithMessage("音声も台本もないエピソードはダウンロードできません")
+	}
+
+	contents, err := buildEpisodePackageContents(channel, episode, lines)
+	if err != nil {
+		return nil, err
+	}
+
+	packagePath := storage.GenerateEpisodePackagePath(eid.String(), contents.hash(episodePackageFormatVersion))
+
+	cached, err := s.storageClient.Exists(ctx, packagePath)
+	if err != nil {
+		return nil, err
+	}
+
+	if !cached {
+		if err := s.buildPackage(ctx, eid, contents, packagePath); err != nil {
+			return nil, err
+		}
+	}
+
+	signedURL, err := s.storageClient.GenerateSignedURL(ctx, packagePath, storage.SignedURLExpirationPackage)
+	if err != nil {
+		return nil, err
+	}
+
+	return &response.EpisodePackageDataResponse{
+		Data: response.EpisodePackageResponse{
+			URL:       signedURL,
+			Filename:  fmt.Sprintf("episode-%s.zip", eid),
+			MimeType:  episodePackageMimeType,
+			ExpiresAt: time.Now().UTC().Add(storage.SignedURLExpirationPackage),
+			Cached:    cached,
+		},
+	}, nil
+}
+
+// buildPackage は ZIP を一時ファイルに書き出してストレージにアップロードする
+//
+// メディアファイルはストレージから ZIP へ直接書き込み、ファイル全体をメモリに保持しない
+func (s *episodePackageService) buildPackage(ctx context.Context, episodeID uuid.UUID, contents *zipArchiveContents, packagePath string) error {
+	log := logger.FromContext(ctx)
+
+	ws, err := newAudioWorkspace(episodeID)
+	if err != nil {
+		return apperror.ErrInternal.WithMessage("一時ディレクトリの作成に失敗しました").WithError(err)
+	}
+	defer ws.Close()
+
+	zipPath, err := ws.create("package.zip", func(w io.Writer) error {
+		return writeZipArchive(ctx, s.storageClient, contents, w)
+	})
+	if err != nil {
+		log.Error("failed to build episode package", "error", err, "episode_id", episodeID)
+		return apperror.ErrInternal.WithMessage("ダウンロードパッケージの作成に失敗しました").WithError(err)
+	}
+
+	if _, err := uploadFile(ctx, s.storageClient, zipPath, packagePath, episodePackageMimeType); err != nil {
+		log.Error("failed to upload episode package", "error", err, "episode_id", episodeID)
+		return apperror.ErrInternal.WithMessage("ダウンロードパッケージのアップロードに失敗しました").WithError(err)
+	}
+
+	return nil
+}
+
+// buildEpisodePackageContents はダウンロードパッケージに含めるファイルを組み立てる
+//
+//   - 完成版の音声（audio/full）と、完成版と異なる場合はボイスのみの音声（audio/voice）
+//   - 台本行がある場合は script.Format 形式のテキスト（script.txt）と JSON（script.json）
+//   - 完成版の音声に台本行の再生区間が記録されている場合は字幕（captions.srt / captions.vtt）
+//   - エピソード、なければチャンネルのアートワーク（外部 URL の画像は含めない）
+//   - 以上の一覧とエピソードの情報をまとめたメタデータ（metadata.json）
+func buildEpisodePackageContents(channel *model.Channel, episode *model.Episode, lines []model.ScriptLine) (*zipArchiveContents, error) {
+	contents := &zipArchiveContents{}
+	metadata := episodePackageMetadata{
+		FormatVersion: episodePackageFormatVersion,
+		Channel: episodePackageChannel{
+			ID:   channel.ID,
+			Name: channel.Name,
+		},
+		Episode: episodePackageEpisode{
+			ID:          episode.ID,
+			Title:       episode.Title,
+			Description: episode.Description,
+			PublishedAt: episode.PublishedAt,
+			CreatedAt:   episode.CreatedAt,
+		},
+		Speakers: episodePackageSpeakers(lines),
+	}
+
+	if audio := episode.FullAudio; audio != nil {
+		name := episodePackageFullAudioName + path.Ext(audio.Path)
+		contents.media = append(contents.media, zipArchiveMedia{name: name, path: audio.Path})
+		metadata.Files.FullAudio = &episodePackageAudioFile{Path: name, MimeType: audio.MimeType, DurationMs: audio.DurationMs}
+	}
+
+	// 音声をアップロードしたエピソードはボイスのみの音声と完成版が同じファイルのため、重複して含めない
+	if audio := episode.VoiceAudio; audio != nil && (episode.FullAudio == nil || audio.ID != episode.FullAudio.ID) {
+		name := episodePackageVoiceAudioName + path.Ext(audio.Path)
+		contents.media = append(contents.media, zipArchiveMedia{name: name, path: audio.Path})
+		metadata.Files.VoiceAudio = &episodePackageAudioFile{Path: name, MimeType: audio.MimeType, DurationMs: audio.DurationMs}
+	}
+
+	if artwork := episodePackageArtwork(channel, episode); artwork != nil {
+		name := episodePackageArtworkName + path.Ext(artwork.Path)
+		contents.media = append(contents.media, zipArchiveMedia{name: name, path: artwork.Path})
+		metadata.Files.Artwork = &name
+	}
+
+	if len(lines) > 0 {
+		var timings model.AudioLineTimings
+		if episode.FullAudio != nil {
+			timings = episode.FullAudio.LineTimings
+		}
+
+		scriptJSON, err := json.MarshalIndent(episodePackageScriptLines(lines, timings), "", "  ")
+		if err != nil {
+			return nil, err
+		}
+
+		contents.files = append(contents.files,
+			zipArchiveFile{name: episodePackageScriptTextFile, data: []byte(episodePackageScriptText(lines))},
+			zipArchiveFile{name: episodePackageScriptJSONFile, data: scriptJSON},
+		)
+		metadata.Files.Script = &episodePackageScript{
+			Text:      episodePackageScriptTextFile,
+			JSON:      episodePackageScriptJSONFile,
+			LineCount: len(lines),
+		}
+
+		if captions := episodePackageCaptionsFor(lines, episode.FullAudio); len(captions) > 0 {
+			contents.files = append(contents.files,
+				zipArchiveFile{name: episodePackageCaptionsSRT, data: []byte(buildSRT(captions))},
+				zipArchiveFile{name: episodePackageCaptionsVTT, data: []byte(buildWebVTT(captions))},
+			)
+			metadata.Files.Captions = &episodePackageCaptions{
+				SRT: episodePackageCaptionsSRT,
+				VTT: episodePackageCaptionsVTT,
+			}
+		}
+	}
+
+	metadataJSON, err := json.MarshalIndent(metadata, "", "  ")
+	if err != nil {
+		return nil, err
+	}
+	contents.files = append([]zipArchiveFile{{name: episodePackageMetadataFile, data: metadataJSON}}, contents.files...)
+
+	return contents, nil
+}
+
+// episodePackageArtwork はダウンロードパッケージに含めるアートワークを返す（エピソード、チャンネルの順に探す）
+//
+// 外部 URL の画像はストレージから取得できないため含めない
+func episodePackageArtwork(channel *model.Channel, episode *model.Episode) *model.Image {
+	for _, image := range []*model.Image{episode.Artwork, channel.Artwork} {
+		if image != nil && !storage.IsExternalURL(image.Path) {
+			return image
+		}
+	}
+	return nil
+}
+
+// episodePackageSpeakers は台本に登場する話者を登場順に返す
+func episodePackageSpeakers(lines []model.ScriptLine) []episodePackageSpeaker {
+	speakers := []episodePackageSpeaker{}
+	seen := make(map[uuid.UUID]bool)
+	for _, l := range lines {
+		if seen[l.SpeakerID] {
+			continue
+		}
+		seen[l.SpeakerID] = true
+		speakers = append(speakers, episodePackageSpeaker{
+			Name: l.Speaker.Name,
+			Voice: episodePackageVoice{
+				Provider:        l.Speaker.Voice.Provider,
+				ProviderVoiceID: l.Speaker.Voice.ProviderVoiceID,
+				Name:            l.Speaker.Voice.Name,
+			},
+		})
+	}
+	return speakers
+}
+
+// episodePackageScriptText は台本を台本テキスト取り込みと同じ形式（script.Format）で返す
+//
+// 感情・マークアップを含めたまま出力するため、そのまま別のエピソードへ取り込める
+func episodePackageScriptText(lines []model.ScriptLine) string {
+	formatLines := make([]script.FormatLine, len(lines))
+	for i, l := range lines {
+		formatLines[i] = script.FormatLine{
+			SpeakerName: l.Speaker.Name,
+			Text:        l.Text,
+			Emotion:     l.Emotion,
+		}
+	}
+	return script.Format(formatLines) + "\n"
+}
+
+// episodePackageScriptLines は台本行を script.json の形式に変換する
+//
+// 完成版の音声に再生区間が記録されている行には、音声内での開始・終了位置を含める
+func episodePackageScriptLines(lines []model.ScriptLine, timings model.AudioLineTimings) episodePackageScriptJSON {
+	result := episodePackageScriptJSON{Lines: make([]episodePackageScriptLine, len(lines))}
+	for i, l := range lines {
+		line := episodePackageScriptLine{
+			ID:        l.ID,
+			LineOrder: l.LineOrder,
+			Speaker:   l.Speaker.Name,
+			Text:      l.Text,
+			Emotion:   l.Emotion,
+		}
+		if t, ok := timings.Find(l.ID); ok {
+			line.StartMs = &t.StartMs
+			line.EndMs = &t.EndMs
+		}
+		result.Lines[i] = line
+	}
+	return result
+}
+
+// episodePackageCaptionsFor は完成版の音声に記録された台本行の再生区間から字幕を組み立てる
+//
+// 再生区間が記録されていない音声（単一話者の生成やアップロードした音声など）の場合は nil を返す
+func episodePackageCaptionsFor(lines []model.ScriptLine, audio *model.Audio) []AudiogramCaption {
+	if audio == nil || len(audio.LineTimings) == 0 {
+		return nil
+	}
+
+	texts := make(map[uuid.UUID]string, len(lines))
+	for _, l := range lines {
+		texts[l.ID] = script.PlainText(l.Text)
+	}
+
+	return clipCaptions(audio.LineTimings, texts, 0, audio.DurationMs)
+}
+
+// buildWebVTT は字幕を WebVTT 形式に変換する（空の字幕・長さのない字幕は除外する）
+func buildWebVTT(captions []AudiogramCaption) string {
+	var b strings.Builder
+	b.WriteString("WEBVTT\n\n")
+	for _, c := range captions {
+		if c.EndMs <= c.StartMs || strings.TrimSpace(c.Text) == "" {
+			continue
+		}
+		fmt.Fprintf(&b, "%s --> %s\n%s\n\n", formatVTTTime(c.StartMs), formatVTTTime(c.EndMs), strings.TrimSpace(c.Text))
+	}
+	return b.String()
+}
+
+// formatVTTTime はミリ秒を WebVTT のタイムスタンプ（HH:MM:SS.mmm）に変換する
+func formatVTTTime(ms int) string {
+	return strings.Replace(formatSRTTime(ms), ",", ".", 1)
+}
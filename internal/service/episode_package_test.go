@@ -0,0 +1,281 @@
+package service
+
+import (
+	"archive/zip"
+	"bytes"
+	"context"
+	"encoding/json"
+	"io"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+
+	"github.com/siropaca/anycast-backend/internal/apperror"
+	"github.com/siropaca/anycast-backend/internal/infrastructure/storage"
+	"github.com/siropaca/anycast-backend/internal/model"
+	"github.com/siropaca/anycast-backend/internal/pkg/uuid"
+)
+
+// readZipEntries は ZIP の各ファイルの内容をファイル名をキーにして返す
+func readZipEntries(t *testing.T, data []byte) map[string]string {
+	t.Helper()
+
+	zr, err := zip.NewReader(bytes.NewReader(data), int64(len(data)))
+	require.NoError(t, err)
+
+	entries := make(map[string]string, len(zr.File))
+	for _, f := range zr.File {
+		rc, err := f.Open()
+		require.NoError(t, err)
+		content, err := io.ReadAll(rc)
+		require.NoError(t, err)
+		rc.Close()
+		entries[f.Name] = string(content)
+	}
+	return entries
+}
+
+func TestBuildEpisodePackageContents(t *testing.T) {
+	speakerID := uuid.New()
+	line1, line2 := uuid.New(), uuid.New()
+	emotion := "楽しそうに"
+	channel := &model.Channel{ID: uuid.New(), Name: "テックトーク"}
+	speaker := model.Character{ID: speakerID, Name: "太郎", Voice: model.Voice{Provider: "google", ProviderVoiceID: "ja-JP-Voice-A", Name: "Voice A"}}
+	lines := []model.ScriptLine{
+		{ID: line1, LineOrder: 0, SpeakerID: speakerID, Speaker: speaker, Text: "こんにちは", Emotion: &emotion},
+		{ID: line2, LineOrder: 1, SpeakerID: speakerID, Speaker: speaker, Text: "今日は[pause:500ms]音声の話です"},
+	}
+
+	t.Run("音声・台本・字幕・アートワーク・メタデータを含める", func(t *testing.T) {
+		episode := &model.Episode{
+			ID:    uuid.New(),
+			Title: "第1回",
+			FullAudio: &model.Audio{
+				ID: uuid.New(), Path: "audios/full.mp3", MimeType: "audio/mpeg", DurationMs: 10000,
+				LineTimings: model.AudioLineTimings{
+					{ScriptLineID: line1, StartMs: 1000, EndMs: 3000},
+					{ScriptLineID: line2, StartMs: 3000, EndMs: 8000},
+				},
+			},
+			VoiceAudio: &model.Audio{ID: uuid.New(), Path: "audios/voice.mp3", MimeType: "audio/mpeg", DurationMs: 7000},
+			Artwork:    &model.Image{Path: "images/artwork.png"},
+		}
+
+		contents, err := buildEpisodePackageContents(channel, episode, lines)
+
+		require.NoError(t, err)
+		names := make([]string, len(contents.files))
+		for i, f := range contents.files {
+			names[i] = f.name
+		}
+		assert.Equal(t, []string{"metadata.json", "script.txt", "script.json", "captions.srt", "captions.vtt"}, names)
+		assert.Equal(t, []zipArchiveMedia{
+			{name: "audio/full.mp3", path: "audios/full.mp3"},
+			{name: "audio/voice.mp3", path: "audios/voice.mp3"},
+			{name: "artwork.png", path: "images/artwork.png"},
+		}, contents.media)
+
+		assert.Equal(t, "太郎: [楽しそうに] こんにちは\n太郎: 今日は[pause:500ms]音声の話です\n", string(contents.files[1].data))
+		assert.Contains(t, string(contents.files[3].data), "00:00:03,000 --> 00:00:08,000\n今日は音声の話です")
+		assert.Contains(t, string(contents.files[4].data), "WEBVTT\n\n00:00:01.000 --> 00:00:03.000\nこんにちは")
+
+		var scriptJSON episodePackageScriptJSON
+		require.NoError(t, json.Unmarshal(contents.files[2].data, &scriptJSON))
+		require.Len(t, scriptJSON.Lines, 2)
+		assert.Equal(t, 1000, *scriptJSON.Lines[0].StartMs)
+		assert.Equal(t, 8000, *scriptJSON.Lines[1].EndMs)
+
+		var metadata episodePackageMetadata
+		require.NoError(t, json.Unmarshal(contents.files[0].data, &metadata))
+		assert.Equal(t, episodePackageFormatVersion, metadata.FormatVersion)
+		assert.Equal(t, "第1回", metadata.Episode.Title)
+		assert.Equal(t, []episodePackageSpeaker{{Name: "太郎", Voice: episodePackageVoice{Provider: "google", ProviderVoiceID: "ja-JP-Voice-A", Name: "Voice A"}}}, metadata.Speakers)
+		assert.Equal(t, "audio/full.mp3", metadata.Files.FullAudio.Path)
+		assert.Equal(t, "audio/voice.mp3", metadata.Files.VoiceAudio.Path)
+		assert.Equal(t, "artwork.png", *metadata.Files.Artwork)
+		assert.Equal(t, 2, metadata.Files.Script.LineCount)
+		assert.Equal(t, "captions.srt", metadata.Files.Captions.SRT)
+	})
+
+	t.Run("再生区間が記録されていない場合は字幕を含めない", func(t *testing.T) {
+		audio := &model.Audio{ID: uuid.New(), Path: "audios/upload.wav", MimeType: "audio/wav", DurationMs: 10000}
+		episode := &model.Episode{ID: uuid.New(), FullAudio: audio, VoiceAudio: audio}
+
+		contents, err := buildEpisodePackageContents(channel, episode, lines)
+
+		require.NoError(t, err)
+		assert.Len(t, contents.files, 3)
+		assert.Equal(t, []zipArchiveMedia{{name: "audio/full.wav", path: "audios/upload.wav"}}, contents.media)
+
+		var metadata episodePackageMetadata
+		require.NoError(t, json.Unmarshal(contents.files[0].data, &metadata))
+		assert.Nil(t, metadata.Files.VoiceAudio)
+		assert.Nil(t, metadata.Files.Captions)
+	})
+
+	t.Run("エピソードのアートワークがない場合はチャンネルのアートワークを含め、外部 URL は含めない", func(t *testing.T) {
+		withChannelArtwork := &model.Channel{ID: channel.ID, Artwork: &model.Image{Path: "images/channel.jpg"}}
+		contents, err := buildEpisodePackageContents(withChannelArtwork, &model.Episode{ID: uuid.New()}, lines)
+		require.NoError(t, err)
+		assert.Equal(t, []zipArchiveMedia{{name: "artwork.jpg", path: "images/channel.jpg"}}, contents.media)
+
+		external := &model.Episode{ID: uuid.New(), Artwork: &model.Image{Path: "https://example.com/a.png"}}
+		contents, err = buildEpisodePackageContents(channel, external, lines)
+		require.NoError(t, err)
+		assert.Empty(t, contents.media)
+	})
+}
+
+func TestEpisodePackageContents_hash(t *testing.T) {
+	channel := &model.Channel{ID: uuid.New(), Name: "テックトーク"}
+	episode := &model.Episode{ID: uuid.New(), Title: "第1回", FullAudio: &model.Audio{Path: "audios/a.mp3"}}
+
+	hash := func(e *model.Episode) string {
+		contents, err := buildEpisodePackageContents(channel, e, nil)
+		require.NoError(t, err)
+		return contents.hash(episodePackageFormatVersion)
+	}
+
+	t.Run("同じ内容なら同じハッシュになる", func(t *testing.T) {
+		assert.Equal(t, hash(episode), hash(episode))
+	})
+
+	t.Run("タイトルが変わるとハッシュが変わる", func(t *testing.T) {
+		renamed := *episode
+		renamed.Title = "第2回"
+		assert.NotEqual(t, hash(episode), hash(&renamed))
+	})
+
+	t.Run("音声が変わるとハッシュが変わる", func(t *testing.T) {
+		regenerated := *episode
+		regenerated.FullAudio = &model.Audio{Path: "audios/b.mp3"}
+		assert.NotEqual(t, hash(episode), hash(&regenerated))
+	})
+}
+
+func TestFormatVTTTime(t *testing.T) {
+	assert.Equal(t, "00:00:00.000", formatVTTTime(0))
+	assert.Equal(t, "01:02:03.456", formatVTTTime(3723456))
+}
+
+func TestEpisodePackageService_CreatePackage(t *testing.T) {
+	userID := uuid.New()
+	channelID := uuid.New()
+	episodeID := uuid.New()
+	speakerID := uuid.New()
+
+	newEpisode := func() *model.Episode {
+		return &model.Episode{
+			ID:        episodeID,
+			ChannelID: channelID,
+			Title:     "第1回",
+			FullAudio: &model.Audio{ID: uuid.New(), Path: "audios/full.mp3", MimeType: "audio/mpeg", DurationMs: 10000},
+		}
+	}
+	lines := []model.ScriptLine{
+		{ID: uuid.New(), SpeakerID: speakerID, Speaker: model.Character{ID: speakerID, Name: "太郎"}, Text: "こんにちは"},
+	}
+
+	t.Run("ZIP を作成してアップロードし、署名付き URL を返す", func(t *testing.T) {
+		channelRepo := new(mockChannelRepository)
+		episodeRepo := new(mockEpisodeRepository)
+		scriptLineRepo := new(mockScriptLineRepository)
+		storageClient := new(mockStorageClient)
+
+		channelRepo.On("FindByID", mock.Anything, channelID).Return(&model.Channel{ID: channelID, UserID: userID, Name: "テックトーク"}, nil)
+		episodeRepo.On("FindByID", mock.Anything, episodeID).Return(newEpisode(), nil)
+		scriptLineRepo.On("FindByEpisodeID", mock.Anything, episodeID).Return(lines, nil)
+		storageClient.On("Exists", mock.Anything, mock.AnythingOfType("string")).Return(false, nil)
+		storageClient.On("DownloadStream", mock.Anything, "audios/full.mp3").Return([]byte("mp3-data"), nil)
+
+		var uploaded []byte
+		var uploadedPath string
+		storageClient.On("UploadStream", mock.Anything, mock.Anything, mock.AnythingOfType("string"), "application/zip").
+			Run(func(args mock.Arguments) {
+				uploaded = args.Get(1).([]byte)
+				uploadedPath = args.String(2)
+			}).Return("", nil)
+		storageClient.On("GenerateSignedURL", mock.Anything, mock.AnythingOfType("string"), storage.SignedURLExpirationPackage).Return("https://storage.example.com/package.zip", nil)
+
+		svc := NewEpisodePackageService(channelRepo, episodeRepo, scriptLineRepo, storageClient)
+		before := time.Now().UTC()
+		result, err := svc.CreatePackage(context.Background(), userID.String(), channelID.String(), episodeID.String())
+
+		require.NoError(t, err)
+		assert.Equal(t, "https://storage.example.com/package.zip", result.Data.URL)
+		assert.Equal(t, "episode-"+episodeID.String()+".zip", result.Data.Filename)
+		assert.Equal(t, "application/zip", result.Data.MimeType)
+		assert.False(t, result.Data.Cached)
+		assert.True(t, result.Data.ExpiresAt.After(before))
+		assert.Contains(t, uploadedPath, "downloads/episodes/"+episodeID.String()+"/")
+
+		entries := readZipEntries(t, uploaded)
+		assert.Equal(t, "mp3-data", entries["audio/full.mp3"])
+		assert.Equal(t, "太郎: こんにちは\n", entries["script.txt"])
+		assert.Contains(t, entries, "metadata.json")
+		assert.Contains(t, entries, "script.json")
+		storageClient.AssertExpectations(t)
+	})
+
+	t.Run("作成済みの ZIP がある場合は作り直さない", func(t *testing.T) {
+		channelRepo := new(mockChannelRepository)
+		episodeRepo := new(mockEpisodeRepository)
+		scriptLineRepo := new(mockScriptLineRepository)
+		storageClient := new(mockStorageClient)
+
+		channelRepo.On("FindByID", mock.Anything, channelID).Return(&model.Channel{ID: channelID, UserID: userID}, nil)
+		episodeRepo.On("FindByID", mock.Anything, episodeID).Return(newEpisode(), nil)
+		scriptLineRepo.On("FindByEpisodeID", mock.Anything, episodeID).Return(lines, nil)
+		storageClient.On("Exists", mock.Anything, mock.AnythingOfType("string")).Return(true, nil)
+		storageClient.On("GenerateSignedURL", mock.Anything, mock.AnythingOfType("string"), storage.SignedURLExpirationPackage).Return("https://storage.example.com/package.zip", nil)
+
+		svc := NewEpisodePackageService(channelRepo, episodeRepo, scriptLineRepo, storageClient)
+		result, err := svc.CreatePackage(context.Background(), userID.String(), channelID.String(), episodeID.String())
+
+		require.NoError(t, err)
+		assert.True(t, result.Data.Cached)
+		storageClient.AssertNotCalled(t, "DownloadStream", mock.Anything, mock.Anything)
+		storageClient.AssertNotCalled(t, "UploadStream", mock.Anything, mock.Anything, mock.Anything, mock.Anything)
+	})
+
+	t.Run("オーナーでない場合は Forbidden を返す", func(t *testing.T) {
+		channelRepo := new(mockChannelRepository)
+		channelRepo.On("FindByID", mock.Anything, channelID).Return(&model.Channel{ID: channelID, UserID: uuid.New()}, nil)
+
+		svc := NewEpisodePackageService(channelRepo, new(mockEpisodeRepository), new(mockScriptLineRepository), new(mockStorageClient))
+		_, err := svc.CreatePackage(context.Background(), userID.String(), channelID.String(), episodeID.String())
+
+		assert.True(t, apperror.IsCode(err, apperror.CodeForbidden))
+	})
+
+	t.Run("別のチャンネルのエピソードは NotFound を返す", func(t *testing.T) {
+		channelRepo := new(mockChannelRepository)
+		episodeRepo := new(mockEpisodeRepository)
+		channelRepo.On("FindByID", mock.Anything, channelID).Return(&model.Channel{ID: channelID, UserID: userID}, nil)
+		episode := newEpisode()
+		episode.ChannelID = uuid.New()
+		episodeRepo.On("FindByID", mock.Anything, episodeID).Return(episode, nil)
+
+		svc := NewEpisodePackageService(channelRepo, episodeRepo, new(mockScriptLineRepository), new(mockStorageClient))
+		_, err := svc.CreatePackage(context.Background(), userID.String(), channelID.String(), episodeID.String())
+
+		assert.True(t, apperror.IsCode(err, apperror.CodeNotFound))
+	})
+
+	t.Run("音声も台本もない場合はバリデーションエラーを返す", func(t *testing.T) {
+		channelRepo := new(mockChannelRepository)
+		episodeRepo := new(mockEpisodeRepository)
+		scriptLineRepo := new(mockScriptLineRepository)
+		channelRepo.On("FindByID", mock.Anything, channelID).Return(&model.Channel{ID: channelID, UserID: userID}, nil)
+		episodeRepo.On("FindByID", mock.Anything, episodeID).Return(&model.Episode{ID: episodeID, ChannelID: channelID}, nil)
+		scriptLineRepo.On("FindByEpisodeID", mock.Anything, episodeID).Return([]model.ScriptLine{}, nil)
+
+		svc := NewEpisodePackageService(channelRepo, episodeRepo, scriptLineRepo, new(mockStorageClient))
+		_, err := svc.CreatePackage(context.Background(), userID.String(), channelID.String(), episodeID.String())
+
+		assert.True(t, apperror.IsCode(err, apperror.CodeValidation))
+	})
+}
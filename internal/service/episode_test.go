@@ -4,6 +4,7 @@ import (
 	"context"
 	"errors"
 	"fmt"
+	"strings"
 	"testing"
 	"time"
 
@@ -472,3 +473,38 @@ func TestEpisodeService_DeleteAudio(t *testing.T) {
 		mockStorageClient.AssertExpectations(t)
 	})
 }
+
+func TestEpisodeService_UploadAudio(t *testing.T) {
+	ctx := context.Background()
+	ownerID := uuid.New()
+	channelID := uuid.New()
+	episodeID := uuid.New()
+
+	t.Run("音声生成中のジョブがある場合はアップロードできない", func(t *testing.T) {
+		mockChannelRepo := new(mockChannelRepository)
+		mockEpisodeRepo := new(mockEpisodeRepository)
+		mockAudioJobRepo := new(mockAudioJobRepository)
+		mockStorageClient := new(mockStorageClient)
+
+		mockChannelRepo.On("FindByID", mock.Anything, channelID).Return(&model.Channel{ID: channelID, UserID: ownerID}, nil)
+		mockEpisodeRepo.On("FindByID", mock.Anything, episodeID).Return(&model.Episode{ID: episodeID, ChannelID: channelID}, nil)
+		mockAudioJobRepo.On("FindPendingByEpisodeID", mock.Anything, episodeID, model.UserAudioJobTypes).Return(&model.AudioJob{ID: uuid.New(), Status: model.AudioJobStatusProcessing}, nil)
+
+		svc := &episodeService{
+			channelRepo:   mockChannelRepo,
+			episodeRepo:   mockEpisodeRepo,
+			audioJobRepo:  mockAudioJobRepo,
+			storageClient: mockStorageClient,
+		}
+
+		_, err := svc.UploadAudio(ctx, ownerID.String(), channelID.String(), episodeID.String(), UploadAudioInput{
+			File:        strings.NewReader("audio"),
+			Filename:    "episode.mp3",
+			ContentType: "audio/mpeg",
+			FileSize:    5,
+		})
+
+		assert.True(t, apperror.IsCode(err, apperror.CodeValidation))
+		mockStorageClient.AssertNotCalled(t, "Upload", mock.Anything, mock.Anything, mock.Anything, mock.Anything)
+	})
+}
@@ -0,0 +1,457 @@
+package service
+
+import (
+	"context"
+	"fmt"
+	"path"
+	"sort"
+	"strings"
+	"time"
+
+	"github.com/siropaca/anycast-backend/internal/apperror"
+	"github.com/siropaca/anycast-backend/internal/infrastructure/storage"
+	"github.com/siropaca/anycast-backend/internal/model"
+	"github.com/siropaca/anycast-backend/internal/pkg/logger"
+	"github.com/siropaca/anycast-backend/internal/pkg/podcastfeed"
+	"github.com/siropaca/anycast-backend/internal/pkg/script"
+	"github.com/siropaca/anycast-backend/internal/pkg/uuid"
+	"github.com/siropaca/anycast-backend/internal/repository"
+)
+
+// フィードから参照するエンドポイントのパス（フィードのベース URL からの相対）
+//
+// 公開フィードは /channels/{channelId}、限定公開フィードは /private-feeds/{token} をベースとし、
+// 配下のパスは共通にする
+const (
+	feedPath                  = "/feed.xml"
+	feedArtworkPath           = "/artwork"
+	feedEnclosurePathFormat   = "/episodes/%s/enclosure/%s"
+	feedEpisodeArtworkFormat  = "/episodes/%s/artwork"
+	feedTranscriptPathFormat  = "/episodes/%s/transcript.txt"
+	publicFeedBasePathFormat  = "/channels/%s"
+	privateFeedBasePathFormat = "/private-feeds/%s"
+)
+
+// feedLanguage はフィードの言語（台本・音声は日本語で生成する）
+const feedLanguage = "ja"
+
+// FeedService はポッドキャストの RSS フィード関連のビジネスロジックインターフェースを表す
+type FeedService interface {
+	GetChannelFeed(ctx context.Context, channelID string) ([]byte, error)
+	GetEpisodeEnclosureURL(ctx context.Context, channelID, episodeID string) (string, error)
+	GetArtworkURL(ctx context.Context, channelID, episodeID string) (string, error)
+	GetEpisodeTranscript(ctx context.Context, channelID, episodeID string) (string, error)
+	GetPrivateFeed(ctx context.Context, token string) ([]byte, error)
+	GetPrivateEpisodeEnclosureURL(ctx context.Context, token, episodeID string) (string, error)
+	GetPrivateArtworkURL(ctx context.Context, token, episodeID string) (string, error)
+	GetPrivateEpisodeTranscript(ctx context.Context, token, episodeID string) (string, error)
+}
+
+type feedService struct {
+	channelRepo          repository.ChannelRepository
+	episodeRepo          repository.EpisodeRepository
+	scriptLineRepo       repository.ScriptLineRepository
+	privateFeedTokenRepo repository.PrivateFeedTokenRepository
+	storageClient        storage.Client
+	apiBaseURL           string
+	webBaseURL           string
+}
+
+// feedScope はフィードの対象チャンネルと、フィード内の URL の起点・含めるエピソードの範囲を表す
+type feedScope struct {
+	channel *model.Channel
+	// baseURL はフィード配下のエンドポイントのベース URL
+	baseURL string
+	// private は限定公開フィードかどうか（未公開のエピソードも含め、ディレクトリへの掲載を拒否する）
+	private bool
+}
+
+// NewFeedService は feedService を生成して FeedService として返す
+//
+// apiBaseURL はフィードに埋め込む API の公開ベース URL（例: https://api.example.com/api/v1）、
+// webBaseURL は番組ページのリンクに使う Web フロントエンドのベース URL
+func NewFeedService(
+	channelRepo repository.ChannelRepository,
+	episodeRepo repository.EpisodeRepository,
+	scriptLineRepo repository.ScriptLineRepository,
+	privateFeedTokenRepo repository.PrivateFeedTokenRepository,
+	storageClient storage.Client,
+	apiBaseURL string,
+	webBaseURL string,
+) FeedService {
+	return &feedService{
+		channelRepo:          channelRepo,
+		episodeRepo:          episodeRepo,
+		scriptLineRepo:       scriptLineRepo,
+		privateFeedTokenRepo: privateFeedTokenRepo,
+		storageClient:        storageClient,
+		apiBaseURL:           strings.TrimSuffix(apiBaseURL, "/"),
+		webBaseURL:           strings.TrimSuffix(webBaseURL, "/"),
+	}
+}
+
+// GetChannelFeed は公開済みチャンネルの RSS フィードを生成する
+//
+// 公開済みで音声のあるエピソードのみを公開日時の新しい順に含める。
+// 音声・アートワークの URL は有効期限のない公開エンドポイントを指し、アクセス時に署名付き URL へリダイレクトする
+func (s *feedService) GetChannelFeed(ctx context.Context, channelID string) ([]byte, error) {
+	scope, err := s.publicScope(ctx, channelID)
+	if err != nil {
+		return nil, err
+	}
+
+	return s.buildFeed(ctx, scope)
+}
+
+// GetPrivateFeed はトークンに対応するチャンネルの限定公開 RSS フィードを生成する
+//
+// 未公開・予約公開のエピソードも音声があれば含める。エンクロージャーなどの URL もトークン配下を指す
+func (s *feedService) GetPrivateFeed(ctx context.Context, token string) ([]byte, error) {
+	scope, t, err := s.privateScope(ctx, token)
+	if err != nil {
+		return nil, err
+	}
+
+	// 非同期で lastUsedAt を更新（購読アプリの取得状況をオーナーが確認できるようにする）
+	go func() {
+		bgCtx := context.Background()
+		if err := s.privateFeedTokenRepo.UpdateLastUsedAt(bgCtx, t.ID, time.Now().UTC()); err != nil {
+			logger.FromContext(bgCtx).Error("failed to update private feed token last_used_at", "error", err, "id", t.ID)
+		}
+	}()
+
+	return s.buildFeed(ctx, scope)
+}
+
+// buildFeed はスコープのチャンネルの RSS フィードを生成する
+func (s *feedService) buildFeed(ctx context.Context, scope *feedScope) ([]byte, error) {
+	channel := scope.channel
+
+	filter := repository.EpisodeFilter{Limit: 10000}
+	if !scope.private {
+		published := "published"
+		filter.Status = &published
+	}
+	episodes, _, err := s.episodeRepo.FindByChannelID(ctx, channel.ID, filter)
+	if err != nil {
+		return nil, err
+	}
+
+	// エンクロージャーのないエピソードはフィードに含められない
+	playable := make([]model.Episode, 0, len(episodes))
+	for _, e := range episodes {
+		if e.FullAudio != nil {
+			playable = append(playable, e)
+		}
+	}
+	sort.SliceStable(playable, func(i, j int) bool {
+		return feedPubDate(&playable[i]).After(feedPubDate(&playable[j]))
+	})
+
+	episodeIDs := make([]uuid.UUID, len(playable))
+	for i, e := range playable {
+		episodeIDs[i] = e.ID
+	}
+	lineCounts := map[uuid.UUID]int{}
+	if len(episodeIDs) > 0 {
+		lineCounts, err = s.scriptLineRepo.CountByEpisodeIDs(ctx, episodeIDs)
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	feed := &podcastfeed.Feed{
+		Title:         channel.Name,
+		Link:          s.channelPageURL(channel.ID),
+		Description:   channel.Description,
+		Language:      feedLanguage,
+		Author:        channel.User.DisplayName,
+		SelfURL:       scope.baseURL + feedPath,
+		Explicit:      channel.Explicit,
+		Block:         scope.private,
+		LastBuildDate: channel.UpdatedAt,
+		Items:         make([]podcastfeed.Item, len(playable)),
+	}
+
+	if channel.ArtworkID != nil {
+		feed.ImageURL = scope.baseURL + feedArtworkPath
+	}
+
+	if category, ok := podcastfeed.CategoryFromSlug(channel.Category.Slug); ok {
+		feed.Category = &category
+	}
+
+	for i := range playable {
+		feed.Items[i] = s.toFeedItem(scope, &playable[i], lineCounts[playable[i].ID] > 0)
+		if feed.Items[i].PubDate.After(feed.LastBuildDate) {
+			feed.LastBuildDate = feed.Items[i].PubDate
+		}
+	}
+
+	return feed.Marshal()
+}
+
+// toFeedItem はエピソードをフィードの item に変換する
+func (s *feedService) toFeedItem(scope *feedScope, e *model.Episode, hasScript bool) podcastfeed.Item {
+	channel := scope.channel
+	filename := e.ID.String() + path.Ext(e.FullAudio.Path)
+
+	item := podcastfeed.Item{
+		GUID:        e.ID.String(),
+		Title:       e.Title,
+		Description: e.Description,
+		Link:        s.episodePageURL(channel.ID, e.ID),
+		PubDate:     feedPubDate(e),
+		Enclosure: podcastfeed.Enclosure{
+			URL:    scope.baseURL + fmt.Sprintf(feedEnclosurePathFormat, e.ID, filename),
+			Length: int64(e.FullAudio.FileSize),
+			Type:   e.FullAudio.MimeType,
+		},
+		DurationMs: e.FullAudio.DurationMs,
+		Explicit:   channel.Explicit,
+	}
+
+	if e.ArtworkID != nil {
+		item.ImageURL = scope.baseURL + fmt.Sprintf(feedEpisodeArtworkFormat, e.ID)
+	}
+
+	if hasScript {
+		item.Transcript = &podcastfeed.Transcript{
+			URL:      scope.baseURL + fmt.Sprintf(feedTranscriptPathFormat, e.ID),
+			Type:     "text/plain",
+			Language: feedLanguage,
+		}
+	}
+
+	return item
+}
+
+// GetEpisodeEnclosureURL は公開済みエピソードの音声の署名付き URL を返す
+func (s *feedService) GetEpisodeEnclosureURL(ctx context.Context, channelID, episodeID string) (string, error) {
+	scope, err := s.publicScope(ctx, channelID)
+	if err != nil {
+		return "", err
+	}
+
+	return s.episodeEnclosureURL(ctx, scope, episodeID)
+}
+
+// GetPrivateEpisodeEnclosureURL はトークンで閲覧できるエピソードの音声の署名付き URL を返す
+func (s *feedService) GetPrivateEpisodeEnclosureURL(ctx context.Context, token, episodeID string) (string, error) {
+	scope, _, err := s.privateScope(ctx, token)
+	if err != nil {
+		return "", err
+	}
+
+	return s.episodeEnclosureURL(ctx, scope, episodeID)
+}
+
+// GetArtworkURL は公開済みチャンネル（episodeID が空の場合）またはエピソードのアートワークの URL を返す
+//
+// エピソードにアートワークがない場合はチャンネルのアートワークを返す
+func (s *feedService) GetArtworkURL(ctx context.Context, channelID, episodeID string) (string, error) {
+	scope, err := s.publicScope(ctx, channelID)
+	if err != nil {
+		return "", err
+	}
+
+	return s.artworkURL(ctx, scope, episodeID)
+}
+
+// GetPrivateArtworkURL はトークンに対応するチャンネル（episodeID が空の場合）またはエピソードのアートワークの URL を返す
+func (s *feedService) GetPrivateArtworkURL(ctx context.Context, token, episodeID string) (string, error) {
+	scope, _, err := s.privateScope(ctx, token)
+	if err != nil {
+		return "", err
+	}
+
+	return s.artworkURL(ctx, scope, episodeID)
+}
+
+// GetEpisodeTranscript は公開済みエピソードの台本を「話者名: セリフ」形式のテキストで返す
+//
+// セリフ内のマークアップ（ポーズ・効果音など）は除去する
+func (s *feedService) GetEpisodeTranscript(ctx context.Context, channelID, episodeID string) (string, error) {
+	scope, err := s.publicScope(ctx, channelID)
+	if err != nil {
+		return "", err
+	}
+
+	return s.episodeTranscript(ctx, scope, episodeID)
+}
+
+// GetPrivateEpisodeTranscript はトークンで閲覧できるエピソードの台本をテキストで返す
+func (s *feedService) GetPrivateEpisodeTranscript(ctx context.Context, token, episodeID string) (string, error) {
+	scope, _, err := s.privateScope(ctx, token)
+	if err != nil {
+		return "", err
+	}
+
+	return s.episodeTranscript(ctx, scope, episodeID)
+}
+
+// episodeEnclosureURL はスコープ内のエピソードの音声の署名付き URL を返す
+func (s *feedService) episodeEnclosureURL(ctx context.Context, scope *feedScope, episodeID string) (string, error) {
+	episode, err := s.findScopedEpisode(ctx, scope, episodeID)
+	if err != nil {
+		return "", err
+	}
+
+	if episode.FullAudio == nil {
+		return "", apperror.ErrNotFound.WithMessage("エピソードの音声が見つかりません")
+	}
+
+	return s.storageClient.GenerateSignedURL(ctx, episode.FullAudio.Path, storage.SignedURLExpirationAudio)
+}
+
+// artworkURL はスコープのチャンネル（episodeID が空の場合）またはエピソードのアートワークの URL を返す
+func (s *feedService) artworkURL(ctx context.Context, scope *feedScope, episodeID string) (string, error) {
+	var artwork *model.Image
+
+	if episodeID != "" {
+		episode, err := s.findScopedEpisode(ctx, scope, episodeID)
+		if err != nil {
+			return "", err
+		}
+		artwork = episode.Artwork
+	}
+
+	if artwork == nil {
+		artwork = scope.channel.Artwork
+	}
+	if artwork == nil {
+		return "", apperror.ErrNotFound.WithMessage("アートワークが見つかりません")
+	}
+
+	if storage.IsExternalURL(artwork.Path) {
+		return artwork.Path, nil
+	}
+
+	return s.storageClient.GenerateSignedURL(ctx, artwork.Path, storage.SignedURLExpirationImage)
+}
+
+// episodeTranscript はスコープ内のエピソードの台本を「話者名: セリフ」形式のテキストで返す
+func (s *feedService) episodeTranscript(ctx context.Context, scope *feedScope, episodeID string) (string, error) {
+	episode, err := s.findScopedEpisode(ctx, scope, episodeID)
+	if err != nil {
+		return "", err
+	}
+
+	lines, err := s.scriptLineRepo.FindByEpisodeID(ctx, episode.ID)
+	if err != nil {
+		return "", err
+	}
+
+	if len(lines) == 0 {
+		return "", apperror.ErrNotFound.WithMessage("文字起こしが見つかりません")
+	}
+
+	formatLines := make([]script.FormatLine, len(lines))
+	for i, l := range lines {
+		formatLines[i] = script.FormatLine{
+			SpeakerName: l.Speaker.Name,
+			Text:        script.PlainText(l.Text),
+		}
+	}
+
+	return script.Format(formatLines) + "\n", nil
+}
+
+// publicScope は公開済みのチャンネルの公開フィードのスコープを返す（未公開の場合は NotFound）
+func (s *feedService) publicScope(ctx context.Context, channelID string) (*feedScope, error) {
+	cid, err := uuid.Parse(channelID)
+	if err != nil {
+		return nil, err
+	}
+
+	channel, err := s.channelRepo.FindByID(ctx, cid)
+	if err != nil {
+		return nil, err
+	}
+
+	if !isPublishedAt(channel.PublishedAt) {
+		return nil, apperror.ErrNotFound.WithMessage("チャンネルが見つかりません")
+	}
+
+	return &feedScope{
+		channel: channel,
+		baseURL: s.apiBaseURL + fmt.Sprintf(publicFeedBasePathFormat, channel.ID),
+	}, nil
+}
+
+// privateScope はトークンに対応する限定公開フィードのスコープを返す
+//
+// 存在しない・有効期限切れのトークンは区別せず NotFound を返す
+func (s *feedService) privateScope(ctx context.Context, token string) (*feedScope, *model.PrivateFeedToken, error) {
+	t, err := s.privateFeedTokenRepo.FindByToken(ctx, token)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	if t.IsExpired(time.Now()) {
+		return nil, nil, apperror.ErrNotFound.WithMessage("限定公開フィードが見つかりません")
+	}
+
+	channel, err := s.channelRepo.FindByID(ctx, t.ChannelID)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	return &feedScope{
+		channel: channel,
+		baseURL: privateFeedURLBase(s.apiBaseURL, t.Token),
+		private: true,
+	}, t, nil
+}
+
+// findScopedEpisode はスコープのチャンネルに属し、スコープで閲覧できるエピソードを取得する
+func (s *feedService) findScopedEpisode(ctx context.Context, scope *feedScope, episodeID string) (*model.Episode, error) {
+	eid, err := uuid.Parse(episodeID)
+	if err != nil {
+		return nil, err
+	}
+
+	episode, err := s.episodeRepo.FindByID(ctx, eid)
+	if err != nil {
+		return nil, err
+	}
+
+	if episode.ChannelID != scope.channel.ID || (!scope.private && !isPublishedAt(episode.PublishedAt)) {
+		return nil, apperror.ErrNotFound.WithMessage("エピソードが見つかりません")
+	}
+
+	return episode, nil
+}
+
+// channelPageURL はチャンネルの Web ページの URL を返す
+func (s *feedService) channelPageURL(channelID uuid.UUID) string {
+	return fmt.Sprintf("%s/channels/%s", s.webBaseURL, channelID)
+}
+
+// episodePageURL はエピソードの Web ページの URL を返す
+func (s *feedService) episodePageURL(channelID, episodeID uuid.UUID) string {
+	return fmt.Sprintf("%s/channels/%s/episodes/%s", s.webBaseURL, channelID, episodeID)
+}
+
+// privateFeedURLBase は限定公開フィード配下のエンドポイントのベース URL を返す
+func privateFeedURLBase(apiBaseURL, token string) string {
+	return apiBaseURL + fmt.Sprintf(privateFeedBasePathFormat, token)
+}
+
+// privateFeedURL は限定公開フィードの URL を返す
+func privateFeedURL(apiBaseURL, token string) string {
+	return privateFeedURLBase(apiBaseURL, token) + feedPath
+}
+
+// feedPubDate はフィードの pubDate に使う日時を返す（未公開のエピソードは作成日時）
+func feedPubDate(e *model.Episode) time.Time {
+	if e.PublishedAt != nil {
+		return *e.PublishedAt
+	}
+	return e.CreatedAt
+}
+
+// isPublishedAt は公開日時が設定済みかつ現在以前かどうかを返す
+func isPublishedAt(publishedAt *time.Time) bool {
+	return publishedAt != nil && !publishedAt.After(time.Now())
+}
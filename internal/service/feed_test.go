@@ -0,0 +1,353 @@
+package service
+
+import (
+	"context"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+
+	"github.com/siropaca/anycast-backend/internal/apperror"
+	"github.com/siropaca/anycast-backend/internal/infrastructure/storage"
+	"github.com/siropaca/anycast-backend/internal/model"
+	"github.com/siropaca/anycast-backend/internal/pkg/uuid"
+	"github.com/siropaca/anycast-backend/internal/repository"
+)
+
+func newTestFeedService(channelRepo *mockChannelRepository, episodeRepo *mockEpisodeRepository, scriptLineRepo *mockScriptLineRepository, storageClient *mockStorageClient) FeedService {
+	return newTestFeedServiceWithPrivate(channelRepo, episodeRepo, scriptLineRepo, new(mockPrivateFeedTokenRepository), storageClient)
+}
+
+func newTestFeedServiceWithPrivate(channelRepo *mockChannelRepository, episodeRepo *mockEpisodeRepository, scriptLineRepo *mockScriptLineRepository, privateFeedTokenRepo *mockPrivateFeedTokenRepository, storageClient *mockStorageClient) FeedService {
+	return NewFeedService(channelRepo, episodeRepo, scriptLineRepo, privateFeedTokenRepo, storageClient, "https://api.example.com/api/v1/", "https://anycast.example.com")
+}
+
+func newTestPublishedChannel() *model.Channel {
+	published := time.Now().Add(-24 * time.Hour)
+	artworkID := uuid.New()
+	return &model.Channel{
+		ID:          uuid.New(),
+		Name:        "テックトーク",
+		Description: "最新のテクノロジーニュース",
+		ArtworkID:   &artworkID,
+		Artwork:     &model.Image{ID: artworkID, Path: "images/channel.png"},
+		Explicit:    true,
+		PublishedAt: &published,
+		User:        model.User{DisplayName: "山田太郎"},
+		Category:    model.Category{Slug: "technology"},
+	}
+}
+
+func TestFeedService_GetChannelFeed(t *testing.T) {
+	ctx := context.Background()
+
+	t.Run("公開済みで音声のあるエピソードを新しい順に含める", func(t *testing.T) {
+		channel := newTestPublishedChannel()
+		older := time.Now().Add(-2 * time.Hour)
+		newer := time.Now().Add(-1 * time.Hour)
+		olderEpisode := model.Episode{
+			ID:          uuid.New(),
+			ChannelID:   channel.ID,
+			Title:       "第1回",
+			PublishedAt: &older,
+			FullAudio:   &model.Audio{Path: "audios/a.mp3", MimeType: "audio/mpeg", FileSize: 1000, DurationMs: 60000},
+		}
+		newerEpisode := model.Episode{
+			ID:          uuid.New(),
+			ChannelID:   channel.ID,
+			Title:       "第2回",
+			PublishedAt: &newer,
+			FullAudio:   &model.Audio{Path: "audios/b.wav", MimeType: "audio/wav", FileSize: 2000, DurationMs: 120000},
+		}
+		noAudioEpisode := model.Episode{ID: uuid.New(), ChannelID: channel.ID, Title: "音声なし", PublishedAt: &newer}
+
+		channelRepo := new(mockChannelRepository)
+		episodeRepo := new(mockEpisodeRepository)
+		scriptLineRepo := new(mockScriptLineRepository)
+		channelRepo.On("FindByID", mock.Anything, channel.ID).Return(channel, nil)
+		episodeRepo.On("FindByChannelID", mock.Anything, channel.ID, mock.MatchedBy(func(f repository.EpisodeFilter) bool {
+			return f.Status != nil && *f.Status == "published"
+		})).Return([]model.Episode{olderEpisode, noAudioEpisode, newerEpisode}, int64(3), nil)
+		scriptLineRepo.On("CountByEpisodeIDs", mock.Anything, []uuid.UUID{newerEpisode.ID, olderEpisode.ID}).
+			Return(map[uuid.UUID]int{olderEpisode.ID: 3}, nil)
+
+		svc := newTestFeedService(channelRepo, episodeRepo, scriptLineRepo, new(mockStorageClient))
+
+		data, err := svc.GetChannelFeed(ctx, channel.ID.String())
+
+		require.NoError(t, err)
+		out := string(data)
+		api := "https://api.example.com/api/v1"
+		assert.Contains(t, out, `<atom:link href="`+api+`/channels/`+channel.ID.String()+`/feed.xml"`)
+		assert.Contains(t, out, `<link>https://anycast.example.com/channels/`+channel.ID.String()+`</link>`)
+		assert.Contains(t, out, `<itunes:author>山田太郎</itunes:author>`)
+		assert.Contains(t, out, `<itunes:image href="`+api+`/channels/`+channel.ID.String()+`/artwork">`)
+		assert.Contains(t, out, `<itunes:category text="Technology">`)
+		assert.Contains(t, out, `<itunes:explicit>true</itunes:explicit>`)
+		assert.Contains(t, out, `<enclosure url="`+api+`/channels/`+channel.ID.String()+`/episodes/`+newerEpisode.ID.String()+`/enclosure/`+newerEpisode.ID.String()+`.wav" length="2000" type="audio/wav">`)
+		assert.Contains(t, out, `<itunes:duration>120</itunes:duration>`)
+		assert.Contains(t, out, `<podcast:transcript url="`+api+`/channels/`+channel.ID.String()+`/episodes/`+olderEpisode.ID.String()+`/transcript.txt" type="text/plain" language="ja">`)
+		assert.NotContains(t, out, "音声なし")
+		assert.Equal(t, 1, strings.Count(out, "<podcast:transcript"))
+		assert.Less(t, strings.Index(out, "第2回"), strings.Index(out, "第1回"))
+	})
+
+	t.Run("未公開のチャンネルは NotFound を返す", func(t *testing.T) {
+		channel := newTestPublishedChannel()
+		channel.PublishedAt = nil
+
+		channelRepo := new(mockChannelRepository)
+		channelRepo.On("FindByID", mock.Anything, channel.ID).Return(channel, nil)
+
+		svc := newTestFeedService(channelRepo, new(mockEpisodeRepository), new(mockScriptLineRepository), new(mockStorageClient))
+
+		_, err := svc.GetChannelFeed(ctx, channel.ID.String())
+
+		assert.True(t, apperror.IsCode(err, apperror.CodeNotFound))
+	})
+}
+
+func TestFeedService_GetEpisodeEnclosureURL(t *testing.T) {
+	ctx := context.Background()
+
+	t.Run("公開済みエピソードの音声の署名付き URL を返す", func(t *testing.T) {
+		channel := newTestPublishedChannel()
+		published := time.Now().Add(-time.Hour)
+		episode := &model.Episode{ID: uuid.New(), ChannelID: channel.ID, PublishedAt: &published, FullAudio: &model.Audio{Path: "audios/a.mp3"}}
+
+		channelRepo := new(mockChannelRepository)
+		episodeRepo := new(mockEpisodeRepository)
+		storageClient := new(mockStorageClient)
+		channelRepo.On("FindByID", mock.Anything, channel.ID).Return(channel, nil)
+		episodeRepo.On("FindByID", mock.Anything, episode.ID).Return(episode, nil)
+		storageClient.On("GenerateSignedURL", mock.Anything, "audios/a.mp3", storage.SignedURLExpirationAudio).Return("https://signed/a.mp3", nil)
+
+		svc := newTestFeedService(channelRepo, episodeRepo, new(mockScriptLineRepository), storageClient)
+
+		url, err := svc.GetEpisodeEnclosureURL(ctx, channel.ID.String(), episode.ID.String())
+
+		require.NoError(t, err)
+		assert.Equal(t, "https://signed/a.mp3", url)
+	})
+
+	t.Run("未公開のエピソードは NotFound を返す", func(t *testing.T) {
+		channel := newTestPublishedChannel()
+		episode := &model.Episode{ID: uuid.New(), ChannelID: channel.ID, FullAudio: &model.Audio{Path: "audios/a.mp3"}}
+
+		channelRepo := new(mockChannelRepository)
+		episodeRepo := new(mockEpisodeRepository)
+		channelRepo.On("FindByID", mock.Anything, channel.ID).Return(channel, nil)
+		episodeRepo.On("FindByID", mock.Anything, episode.ID).Return(episode, nil)
+
+		svc := newTestFeedService(channelRepo, episodeRepo, new(mockScriptLineRepository), new(mockStorageClient))
+
+		_, err := svc.GetEpisodeEnclosureURL(ctx, channel.ID.String(), episode.ID.String())
+
+		assert.True(t, apperror.IsCode(err, apperror.CodeNotFound))
+	})
+
+	t.Run("別のチャンネルのエピソードは NotFound を返す", func(t *testing.T) {
+		channel := newTestPublishedChannel()
+		published := time.Now().Add(-time.Hour)
+		episode := &model.Episode{ID: uuid.New(), ChannelID: uuid.New(), PublishedAt: &published, FullAudio: &model.Audio{Path: "audios/a.mp3"}}
+
+		channelRepo := new(mockChannelRepository)
+		episodeRepo := new(mockEpisodeRepository)
+		channelRepo.On("FindByID", mock.Anything, channel.ID).Return(channel, nil)
+		episodeRepo.On("FindByID", mock.Anything, episode.ID).Return(episode, nil)
+
+		svc := newTestFeedService(channelRepo, episodeRepo, new(mockScriptLineRepository), new(mockStorageClient))
+
+		_, err := svc.GetEpisodeEnclosureURL(ctx, channel.ID.String(), episode.ID.String())
+
+		assert.True(t, apperror.IsCode(err, apperror.CodeNotFound))
+	})
+}
+
+func TestFeedService_GetArtworkURL(t *testing.T) {
+	ctx := context.Background()
+
+	t.Run("エピソードにアートワークがない場合はチャンネルのアートワークを返す", func(t *testing.T) {
+		channel := newTestPublishedChannel()
+		published := time.Now().Add(-time.Hour)
+		episode := &model.Episode{ID: uuid.New(), ChannelID: channel.ID, PublishedAt: &published}
+
+		channelRepo := new(mockChannelRepository)
+		episodeRepo := new(mockEpisodeRepository)
+		storageClient := new(mockStorageClient)
+		channelRepo.On("FindByID", mock.Anything, channel.ID).Return(channel, nil)
+		episodeRepo.On("FindByID", mock.Anything, episode.ID).Return(episode, nil)
+		storageClient.On("GenerateSignedURL", mock.Anything, "images/channel.png", storage.SignedURLExpirationImage).Return("https://signed/channel.png", nil)
+
+		svc := newTestFeedService(channelRepo, episodeRepo, new(mockScriptLineRepository), storageClient)
+
+		url, err := svc.GetArtworkURL(ctx, channel.ID.String(), episode.ID.String())
+
+		require.NoError(t, err)
+		assert.Equal(t, "https://signed/channel.png", url)
+	})
+
+	t.Run("外部 URL のアートワークはそのまま返す", func(t *testing.T) {
+		channel := newTestPublishedChannel()
+		channel.Artwork.Path = "https://images.example.com/a.jpg"
+
+		channelRepo := new(mockChannelRepository)
+		channelRepo.On("FindByID", mock.Anything, channel.ID).Return(channel, nil)
+
+		svc := newTestFeedService(channelRepo, new(mockEpisodeRepository), new(mockScriptLineRepository), new(mockStorageClient))
+
+		url, err := svc.GetArtworkURL(ctx, channel.ID.String(), "")
+
+		require.NoError(t, err)
+		assert.Equal(t, "https://images.example.com/a.jpg", url)
+	})
+}
+
+func TestFeedService_GetEpisodeTranscript(t *testing.T) {
+	ctx := context.Background()
+
+	t.Run("マークアップを除いた台本をテキストで返す", func(t *testing.T) {
+		channel := newTestPublishedChannel()
+		published := time.Now().Add(-time.Hour)
+		episode := &model.Episode{ID: uuid.New(), ChannelID: channel.ID, PublishedAt: &published}
+
+		channelRepo := new(mockChannelRepository)
+		episodeRepo := new(mockEpisodeRepository)
+		scriptLineRepo := new(mockScriptLineRepository)
+		channelRepo.On("FindByID", mock.Anything, channel.ID).Return(channel, nil)
+		episodeRepo.On("FindByID", mock.Anything, episode.ID).Return(episode, nil)
+		scriptLineRepo.On("FindByEpisodeID", mock.Anything, episode.ID).Return([]model.ScriptLine{
+			{Text: "こんにちは[pause:500ms]今日は[em:大事な]話です", Speaker: model.Character{Name: "太郎"}},
+			{Text: "よろしく[sfx:chime]", Speaker: model.Character{Name: "花子"}},
+		}, nil)
+
+		svc := newTestFeedService(channelRepo, episodeRepo, scriptLineRepo, new(mockStorageClient))
+
+		transcript, err := svc.GetEpisodeTranscript(ctx, channel.ID.String(), episode.ID.String())
+
+		require.NoError(t, err)
+		assert.Equal(t, "太郎: こんにちは今日は大事な話です\n花子: よろしく\n", transcript)
+	})
+
+	t.Run("台本がない場合は NotFound を返す", func(t *testing.T) {
+		channel := newTestPublishedChannel()
+		published := time.Now().Add(-time.Hour)
+		episode := &model.Episode{ID: uuid.New(), ChannelID: channel.ID, PublishedAt: &published}
+
+		channelRepo := new(mockChannelRepository)
+		episodeRepo := new(mockEpisodeRepository)
+		scriptLineRepo := new(mockScriptLineRepository)
+		channelRepo.On("FindByID", mock.Anything, channel.ID).Return(channel, nil)
+		episodeRepo.On("FindByID", mock.Anything, episode.ID).Return(episode, nil)
+		scriptLineRepo.On("FindByEpisodeID", mock.Anything, episode.ID).Return([]model.ScriptLine{}, nil)
+
+		svc := newTestFeedService(channelRepo, episodeRepo, scriptLineRepo, new(mockStorageClient))
+
+		_, err := svc.GetEpisodeTranscript(ctx, channel.ID.String(), episode.ID.String())
+
+		assert.True(t, apperror.IsCode(err, apperror.CodeNotFound))
+	})
+}
+
+func TestFeedService_GetPrivateFeed(t *testing.T) {
+	ctx := context.Background()
+
+	t.Run("未公開のエピソードも含めたフィードをトークン配下の URL で返す", func(t *testing.T) {
+		channel := newTestPublishedChannel()
+		channel.PublishedAt = nil
+		pt := &model.PrivateFeedToken{ID: uuid.New(), ChannelID: channel.ID, Token: "secret-token"}
+		draft := model.Episode{
+			ID:        uuid.New(),
+			ChannelID: channel.ID,
+			Title:     "下書き回",
+			CreatedAt: time.Now().Add(-time.Hour),
+			FullAudio: &model.Audio{Path: "audios/draft.mp3", MimeType: "audio/mpeg", FileSize: 100, DurationMs: 1000},
+		}
+
+		channelRepo := new(mockChannelRepository)
+		episodeRepo := new(mockEpisodeRepository)
+		scriptLineRepo := new(mockScriptLineRepository)
+		tokenRepo := new(mockPrivateFeedTokenRepository)
+		tokenRepo.On("FindByToken", mock.Anything, "secret-token").Return(pt, nil)
+		tokenRepo.On("UpdateLastUsedAt", mock.Anything, pt.ID, mock.Anything).Return(nil).Maybe()
+		channelRepo.On("FindByID", mock.Anything, channel.ID).Return(channel, nil)
+		episodeRepo.On("FindByChannelID", mock.Anything, channel.ID, mock.MatchedBy(func(f repository.EpisodeFilter) bool {
+			return f.Status == nil
+		})).Return([]model.Episode{draft}, int64(1), nil)
+		scriptLineRepo.On("CountByEpisodeIDs", mock.Anything, []uuid.UUID{draft.ID}).Return(map[uuid.UUID]int{}, nil)
+
+		svc := newTestFeedServiceWithPrivate(channelRepo, episodeRepo, scriptLineRepo, tokenRepo, new(mockStorageClient))
+
+		data, err := svc.GetPrivateFeed(ctx, "secret-token")
+
+		require.NoError(t, err)
+		out := string(data)
+		base := "https://api.example.com/api/v1/private-feeds/secret-token"
+		assert.Contains(t, out, "下書き回")
+		assert.Contains(t, out, `<atom:link href="`+base+`/feed.xml"`)
+		assert.Contains(t, out, `<enclosure url="`+base+`/episodes/`+draft.ID.String()+`/enclosure/`+draft.ID.String()+`.mp3"`)
+		assert.Contains(t, out, `<itunes:block>Yes</itunes:block>`)
+	})
+
+	t.Run("有効期限切れのトークンは NotFound を返す", func(t *testing.T) {
+		expired := time.Now().Add(-time.Minute)
+		pt := &model.PrivateFeedToken{ID: uuid.New(), ChannelID: uuid.New(), Token: "expired", ExpiresAt: &expired}
+
+		tokenRepo := new(mockPrivateFeedTokenRepository)
+		tokenRepo.On("FindByToken", mock.Anything, "expired").Return(pt, nil)
+
+		svc := newTestFeedServiceWithPrivate(new(mockChannelRepository), new(mockEpisodeRepository), new(mockScriptLineRepository), tokenRepo, new(mockStorageClient))
+
+		_, err := svc.GetPrivateFeed(ctx, "expired")
+
+		assert.True(t, apperror.IsCode(err, apperror.CodeNotFound))
+	})
+}
+
+func TestFeedService_GetPrivateEpisodeEnclosureURL(t *testing.T) {
+	ctx := context.Background()
+
+	t.Run("未公開のエピソードの音声の署名付き URL を返す", func(t *testing.T) {
+		channel := newTestPublishedChannel()
+		pt := &model.PrivateFeedToken{ID: uuid.New(), ChannelID: channel.ID, Token: "secret-token"}
+		episode := &model.Episode{ID: uuid.New(), ChannelID: channel.ID, FullAudio: &model.Audio{Path: "audios/draft.mp3"}}
+
+		channelRepo := new(mockChannelRepository)
+		episodeRepo := new(mockEpisodeRepository)
+		tokenRepo := new(mockPrivateFeedTokenRepository)
+		storageClient := new(mockStorageClient)
+		tokenRepo.On("FindByToken", mock.Anything, "secret-token").Return(pt, nil)
+		channelRepo.On("FindByID", mock.Anything, channel.ID).Return(channel, nil)
+		episodeRepo.On("FindByID", mock.Anything, episode.ID).Return(episode, nil)
+		storageClient.On("GenerateSignedURL", mock.Anything, "audios/draft.mp3", storage.SignedURLExpirationAudio).Return("https://signed/draft.mp3", nil)
+
+		svc := newTestFeedServiceWithPrivate(channelRepo, episodeRepo, new(mockScriptLineRepository), tokenRepo, storageClient)
+
+		url, err := svc.GetPrivateEpisodeEnclosureURL(ctx, "secret-token", episode.ID.String())
+
+		require.NoError(t, err)
+		assert.Equal(t, "https://signed/draft.mp3", url)
+	})
+
+	t.Run("トークンのチャンネル以外のエピソードは NotFound を返す", func(t *testing.T) {
+		channel := newTestPublishedChannel()
+		pt := &model.PrivateFeedToken{ID: uuid.New(), ChannelID: channel.ID, Token: "secret-token"}
+		episode := &model.Episode{ID: uuid.New(), ChannelID: uuid.New(), FullAudio: &model.Audio{Path: "audios/other.mp3"}}
+
+		channelRepo := new(mockChannelRepository)
+		episodeRepo := new(mockEpisodeRepository)
+		tokenRepo := new(mockPrivateFeedTokenRepository)
+		tokenRepo.On("FindByToken", mock.Anything, "secret-token").Return(pt, nil)
+		channelRepo.On("FindByID", mock.Anything, channel.ID).Return(channel, nil)
+		episodeRepo.On("FindByID", mock.Anything, episode.ID).Return(episode, nil)
+
+		svc := newTestFeedServiceWithPrivate(channelRepo, episodeRepo, new(mockScriptLineRepository), tokenRepo, new(mockStorageClient))
+
+		_, err := svc.GetPrivateEpisodeEnclosureURL(ctx, "secret-token", episode.ID.String())
+
+		assert.True(t, apperror.IsCode(err, apperror.CodeNotFound))
+	})
+}
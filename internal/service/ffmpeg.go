@@ -4,36 +4,153 @@ import (
 	"bytes"
 	"context"
 	"fmt"
+	"io"
+	"math"
 	"os"
 	"os/exec"
 	"path/filepath"
 	"strconv"
+	"strings"
 
 	"github.com/siropaca/anycast-backend/internal/apperror"
+	"github.com/siropaca/anycast-backend/internal/model"
 	"github.com/siropaca/anycast-backend/internal/pkg/audio"
 	"github.com/siropaca/anycast-backend/internal/pkg/logger"
 )
 
 // FFmpegService は FFmpeg を使用した音声処理サービスのインターフェースを表す
 type FFmpegService interface {
-	MixAudioWithBGM(ctx context.Context, params MixParams) ([]byte, error)
-	ConcatAudio(ctx context.Context, audioChunks [][]byte) ([]byte, error)
-	// ConvertToMP3 は音声データを MP3 に変換する
+	// MixAudioWithBGM はナレーションと BGM をミキシングして w に書き込む
+	MixAudioWithBGM(ctx context.Context, params MixParams, w io.Writer) error
+	// MixSFX はナレーションに効果音を指定した位置で重ねて w に書き込む
+	MixSFX(ctx context.Context, params SFXMixParams, w io.Writer) error
+	// StitchSegments はナレーションの前後にイントロ・アウトロをクロスフェードでつないで w に書き込む
+	StitchSegments(ctx context.Context, params StitchParams, w io.Writer) error
+	// ConcatAudio は複数の音声データを連結して w に書き込む
+	ConcatAudio(ctx context.Context, audioChunks []io.Reader, w io.Writer) error
+	// ConvertToMP3 は音声データを MP3 に変換して w に書き込む
 	// format: 入力形式（"pcm" または "ogg"）
-	ConvertToMP3(ctx context.Context, audioData []byte, format string, sampleRateHz int) ([]byte, error)
+	ConvertToMP3(ctx context.Context, r io.Reader, w io.Writer, format string, sampleRateHz int) error
+	// DecodeToPCM は r の音声データを指定したサンプルレートの s16le モノラル PCM にデコードして w に書き込む
+	// format: 入力形式（"pcm" の場合は inputSampleRateHz の s16le モノラルとして扱い、それ以外は自動判別）
+	DecodeToPCM(ctx context.Context, r io.Reader, w io.Writer, format string, inputSampleRateHz, outputSampleRateHz int) error
+	// TranscodeAudio は音声データを配信用フォーマット・ビットレートに変換して w に書き込む
+	TranscodeAudio(ctx context.Context, r io.Reader, w io.Writer, format model.AudioFormat, bitrateKbps int) error
+	// PackageHLS は音声データを AAC セグメントと m3u8 プレイリストに分割する
+	// セグメントは一時ファイルに出力されるため、呼び出し元は使用後に HLSOutput.Close を呼ぶこと
+	PackageHLS(ctx context.Context, r io.Reader, segmentDurationSec, bitrateKbps int) (*HLSOutput, error)
+	// ExtractPeaks は音声データをモノラル PCM にデコードし、ブロックごとの最小値・最大値を集計する
+	ExtractPeaks(ctx context.Context, r io.Reader, sampleRateHz, blockSize int) (*audio.PeakBlocks, error)
+	// RenderAudiogram は音声の一部を切り出し、アートワーク・波形アニメーション・字幕を重ねた MP4 動画を w に書き込む
+	RenderAudiogram(ctx context.Context, params AudiogramParams, w io.Writer) error
+}
+
+// HLSOutput は HLS パッケージングの出力を表す
+type HLSOutput struct {
+	Playlist []byte       // m3u8 プレイリスト（セグメント URI はファイル名のみ）
+	Segments []HLSSegment // プレイリストに記載された順のセグメント
+
+	dir string // セグメントを出力した一時ディレクトリ
+}
+
+// Close はセグメントを出力した一時ディレクトリを削除する
+func (o *HLSOutput) Close() error {
+	if o.dir == "" {
+		return nil
+	}
+	return os.RemoveAll(o.dir)
+}
+
+// HLSSegment は HLS のセグメントファイルを表す
+type HLSSegment struct {
+	Name string // ファイル名（例: segment_00000.ts）
+	Path string // 一時ファイルのパス
 }
 
 // MixParams は音声ミキシングのパラメータを表す
 type MixParams struct {
-	VoiceData       []byte  // ナレーション音声データ
-	BGMData         []byte  // BGM 音声データ
-	VoiceDurationMs int     // ナレーション長 (ms)
-	BGMVolumeDB     float64 // BGM 音量 (dB)
-	FadeOutMs       int     // フェードアウト時間 (ms)
-	PaddingStartMs  int     // 音声開始前の余白 (ms)
-	PaddingEndMs    int     // 音声終了後の余白 (ms)
+	Voice           io.Reader // ナレーション音声
+	BGM             io.Reader // BGM 音声
+	VoiceDurationMs int       // ナレーション長 (ms)
+	BGMVolumeDB     float64   // BGM 音量 (dB)
+	FadeOutMs       int       // フェードアウト時間 (ms)
+	PaddingStartMs  int       // 音声開始前の余白 (ms)
+	PaddingEndMs    int       // 音声終了後の余白 (ms)
+
+	// Ducking はサイドチェインダッキングの設定（nil の場合は BGMVolumeDB の固定音量でミキシング）
+	Ducking *DuckingParams
+}
+
+// DuckingParams は BGM のサイドチェインダッキングのパラメータを表す
+//
+// ナレーションをサイドチェイン入力として BGM にコンプレッサーをかけ、
+// 発話中のみ BGM の音量を下げる
+type DuckingParams struct {
+	ThresholdDB  float64 // コンプレッサーが効き始めるしきい値 (dB)
+	Ratio        float64 // 圧縮比（1 〜 20）
+	AttackMs     int     // 発話開始から BGM が下がりきるまでの時間 (ms)
+	ReleaseMs    int     // 発話終了から BGM が戻るまでの時間 (ms)
+	IntroSwellMs int     // 冒頭で BGM を無音から立ち上げる時間 (ms)、0 の場合は立ち上げなし
+}
+
+// SFXMixParams は効果音ミキシングのパラメータを表す
+type SFXMixParams struct {
+	Voice io.Reader      // ナレーション音声
+	Cues  []SFXCueParams // 重ねる効果音
+}
+
+// SFXCueParams はナレーションに重ねる効果音 1 つ分のパラメータを表す
+type SFXCueParams struct {
+	Audio    io.Reader // 効果音
+	StartMs  int       // ナレーション先頭からの再生開始位置 (ms)
+	VolumeDB float64   // 効果音の音量調整 (dB)
+}
+
+// StitchParams はイントロ・アウトロ結合のパラメータを表す
+type StitchParams struct {
+	Intro            io.Reader // イントロ音声（nil の場合はイントロなし）
+	Voice            io.Reader // ナレーション音声
+	Outro            io.Reader // アウトロ音声（nil の場合はアウトロなし）
+	IntroCrossfadeMs int       // イントロとナレーションのクロスフェード時間 (ms)、0 の場合は単純連結
+	OutroCrossfadeMs int       // ナレーションとアウトロのクロスフェード時間 (ms)、0 の場合は単純連結
+}
+
+// AudiogramParams はオーディオグラム（クリップ動画）生成のパラメータを表す
+type AudiogramParams struct {
+	Audio      io.Reader          // 切り出し元の音声
+	Artwork    io.Reader          // アートワーク画像（nil の場合は単色の背景）
+	StartMs    int                // 切り出し開始位置 (ms)
+	DurationMs int                // 切り出す長さ (ms)
+	Width      int                // 動画の幅 (px)
+	Height     int                // 動画の高さ (px)
+	Captions   []AudiogramCaption // 焼き込む字幕（空の場合は字幕なし）
 }
 
+// AudiogramCaption はクリップ動画に焼き込む字幕 1 つ分を表す
+type AudiogramCaption struct {
+	StartMs int    // 切り出し開始位置からの表示開始位置 (ms)
+	EndMs   int    // 切り出し開始位置からの表示終了位置 (ms)
+	Text    string // 字幕のテキスト
+}
+
+const (
+	// audiogramFrameRate はクリップ動画のフレームレート
+	audiogramFrameRate = 30
+	// audiogramBackgroundColor はアートワークがない場合の背景色
+	audiogramBackgroundColor = "0x111827"
+	// audiogramWaveColor は波形の色
+	audiogramWaveColor = "white"
+	// audiogramCaptionFile は一時ディレクトリに書き出す字幕ファイル名
+	audiogramCaptionFile = "captions.srt"
+	// audiogramCaptionFont は字幕のフォント（Docker イメージの fonts-noto-cjk で提供される）
+	audiogramCaptionFont = "Noto Sans CJK JP"
+	// audiogramCaptionStyle は libass に渡す字幕のスタイル（サイズ・余白は SRT の既定の解像度 384x288 基準）
+	audiogramCaptionStyle = "FontName=" + audiogramCaptionFont + ",FontSize=16,PrimaryColour=&H00FFFFFF,OutlineColour=&H80000000,BorderStyle=3,Outline=2,Shadow=0,Alignment=2,MarginV=12"
+)
+
+// stitchSampleFormat は結合前に各入力を揃える音声フォーマット
+const stitchSampleFormat = "aformat=sample_fmts=fltp:sample_rates=44100:channel_layouts=stereo"
+
 type ffmpegService struct{}
 
 // NewFFmpegService は ffmpegService を生成して FFmpegService として返す
@@ -41,66 +158,37 @@ func NewFFmpegService() FFmpegService {
 	return &ffmpegService{}
 }
 
-// MixAudioWithBGM はナレーションと BGM をミキシングする
-//
-// フィルタグラフ:
-// [BGM] → aloop(無限ループ) → volume(-15dB) → afade(フェードアウト) → atrim(カット)
+// MixAudioWithBGM はナレーションと BGM をミキシングして w に書き込む
 //
-//	↓
-//
-// [Voice] → adelay(前余白) ──────────────────────────────────────────→ amix → [Output]
-func (s *ffmpegService) MixAudioWithBGM(ctx context.Context, params MixParams) ([]byte, error) {
+// 入力は一時ファイルに書き出してから FFmpeg に渡し、出力もファイル経由で w にコピーするため、
+// 音声全体をメモリに保持しない。フィルタグラフの詳細は buildMixFilterComplex を参照
+func (s *ffmpegService) MixAudioWithBGM(ctx context.Context, params MixParams, w io.Writer) error {
 	log := logger.FromContext(ctx)
 
 	// 一時ディレクトリを作成
 	tmpDir, err := os.MkdirTemp("", "ffmpeg-mix-*")
 	if err != nil {
 		log.Error("failed to create temp directory", "error", err)
-		return nil, apperror.ErrInternal.WithMessage("一時ディレクトリの作成に失敗しました").WithError(err)
+		return apperror.ErrInternal.WithMessage("一時ディレクトリの作成に失敗しました").WithError(err)
 	}
 	defer os.RemoveAll(tmpDir)
 
 	// 一時ファイルに書き込み
-	voicePath := filepath.Join(tmpDir, "voice.mp3")
-	bgmPath := filepath.Join(tmpDir, "bgm.mp3")
 	outputPath := filepath.Join(tmpDir, "output.mp3")
 
-	if err := os.WriteFile(voicePath, params.VoiceData, 0o644); err != nil {
+	voicePath, err := writeTempInput(tmpDir, "voice.mp3", params.Voice)
+	if err != nil {
 		log.Error("failed to write voice file", "error", err)
-		return nil, apperror.ErrInternal.WithMessage("音声ファイルの書き込みに失敗しました").WithError(err)
+		return apperror.ErrInternal.WithMessage("音声ファイルの書き込みに失敗しました").WithError(err)
 	}
 
-	if err := os.WriteFile(bgmPath, params.BGMData, 0o644); err != nil {
+	bgmPath, err := writeTempInput(tmpDir, "bgm.mp3", params.BGM)
+	if err != nil {
 		log.Error("failed to write BGM file", "error", err)
-		return nil, apperror.ErrInternal.WithMessage("BGM ファイルの書き込みに失敗しました").WithError(err)
-	}
-
-	// 総出力時間を計算（単位: 秒）
-	totalDurationMs := params.PaddingStartMs + params.VoiceDurationMs + params.PaddingEndMs
-	totalDurationSec := float64(totalDurationMs) / 1000.0
-
-	// フェードアウト開始時間を計算（単位: 秒）
-	fadeStartSec := totalDurationSec - float64(params.FadeOutMs)/1000.0
-	if fadeStartSec < 0 {
-		fadeStartSec = 0
+		return apperror.ErrInternal.WithMessage("BGM ファイルの書き込みに失敗しました").WithError(err)
 	}
 
-	// フィルタグラフを構築
-	// [0:a] = voice, [1:a] = bgm
-	filterComplex := fmt.Sprintf(
-		// BGM: ループ → 音量調整 → フェードアウト → 長さカット
-		"[1:a]aloop=loop=-1:size=2e+09,volume=%sdB,afade=t=out:st=%s:d=%s,atrim=0:%s[bgm];"+
-			// Voice: 前余白を追加
-			"[0:a]adelay=%d|%d[voice];"+
-			// ミックス (BGM は voice に合わせて調整)
-			"[bgm][voice]amix=inputs=2:duration=first:dropout_transition=0[out]",
-		formatFloat(params.BGMVolumeDB),
-		formatFloat(fadeStartSec),
-		formatFloat(float64(params.FadeOutMs)/1000.0),
-		formatFloat(totalDurationSec),
-		params.PaddingStartMs,
-		params.PaddingStartMs,
-	)
+	filterComplex := buildMixFilterComplex(params)
 
 	// FFmpeg コマンドを実行
 	args := []string{
@@ -122,35 +210,285 @@ func (s *ffmpegService) MixAudioWithBGM(ctx context.Context, params MixParams) (
 
 	if err := cmd.Run(); err != nil {
 		log.Error("FFmpeg failed", "error", err, "stderr", stderr.String())
-		return nil, apperror.ErrInternal.WithMessage("音声ミキシングに失敗しました").WithError(err)
+		return apperror.ErrInternal.WithMessage("音声ミキシングに失敗しました").WithError(err)
+	}
+
+	return copyOutputFile(ctx, outputPath, w)
+}
+
+// MixSFX はナレーションに効果音を指定した位置で重ねて w に書き込む
+//
+// 出力の長さはナレーションに合わせる。フィルタグラフの詳細は buildSFXFilterComplex を参照
+func (s *ffmpegService) MixSFX(ctx context.Context, params SFXMixParams, w io.Writer) error {
+	log := logger.FromContext(ctx)
+
+	if len(params.Cues) == 0 {
+		return copyInput(ctx, params.Voice, w)
+	}
+
+	// 一時ディレクトリを作成
+	tmpDir, err := os.MkdirTemp("", "ffmpeg-sfx-*")
+	if err != nil {
+		log.Error("failed to create temp directory", "error", err)
+		return apperror.ErrInternal.WithMessage("一時ディレクトリの作成に失敗しました").WithError(err)
 	}
+	defer os.RemoveAll(tmpDir)
+
+	outputPath := filepath.Join(tmpDir, "output.mp3")
 
-	// 出力ファイルを読み込み
-	outputData, err := os.ReadFile(outputPath)
+	voicePath, err := writeTempInput(tmpDir, "voice.mp3", params.Voice)
 	if err != nil {
-		log.Error("failed to read output file", "error", err)
-		return nil, apperror.ErrInternal.WithMessage("出力ファイルの読み込みに失敗しました").WithError(err)
+		log.Error("failed to write voice file", "error", err)
+		return apperror.ErrInternal.WithMessage("音声ファイルの書き込みに失敗しました").WithError(err)
+	}
+
+	args := []string{"-i", voicePath}
+	for i, cue := range params.Cues {
+		sfxPath, err := writeTempInput(tmpDir, fmt.Sprintf("sfx_%d.mp3", i), cue.Audio)
+		if err != nil {
+			log.Error("failed to write SFX file", "error", err)
+			return apperror.ErrInternal.WithMessage("効果音ファイルの書き込みに失敗しました").WithError(err)
+		}
+		args = append(args, "-i", sfxPath)
+	}
+
+	args = append(args,
+		"-filter_complex", buildSFXFilterComplex(params.Cues),
+		"-map", "[out]",
+		"-c:a", "libmp3lame",
+		"-b:a", "192k",
+		"-y",
+		outputPath,
+	)
+
+	cmd := exec.CommandContext(ctx, "ffmpeg", args...)
+	var stderr bytes.Buffer
+	cmd.Stderr = &stderr
+
+	log.Info("running FFmpeg sfx mix", "cues", len(params.Cues))
+
+	if err := cmd.Run(); err != nil {
+		log.Error("FFmpeg failed", "error", err, "stderr", stderr.String())
+		return apperror.ErrInternal.WithMessage("効果音のミキシングに失敗しました").WithError(err)
 	}
 
-	return outputData, nil
+	return copyOutputFile(ctx, outputPath, w)
 }
 
-// ConcatAudio は複数の音声データを連結する
+// StitchSegments はナレーションの前後にイントロ・アウトロをクロスフェードでつないで w に書き込む
+//
+// イントロ・アウトロのどちらもない場合はナレーションをそのまま書き込む。フィルタグラフの詳細は buildStitchFilterComplex を参照
+func (s *ffmpegService) StitchSegments(ctx context.Context, params StitchParams, w io.Writer) error {
+	log := logger.FromContext(ctx)
+
+	if params.Intro == nil && params.Outro == nil {
+		return copyInput(ctx, params.Voice, w)
+	}
+
+	// 一時ディレクトリを作成
+	tmpDir, err := os.MkdirTemp("", "ffmpeg-stitch-*")
+	if err != nil {
+		log.Error("failed to create temp directory", "error", err)
+		return apperror.ErrInternal.WithMessage("一時ディレクトリの作成に失敗しました").WithError(err)
+	}
+	defer os.RemoveAll(tmpDir)
+
+	outputPath := filepath.Join(tmpDir, "output.mp3")
+
+	// 入力はイントロ・ナレーション・アウトロの順（存在するもののみ）
+	var args []string
+	for _, input := range []struct {
+		name string
+		r    io.Reader
+	}{
+		{name: "intro.mp3", r: params.Intro},
+		{name: "voice.mp3", r: params.Voice},
+		{name: "outro.mp3", r: params.Outro},
+	} {
+		if input.r == nil {
+			continue
+		}
+		inputPath, err := writeTempInput(tmpDir, input.name, input.r)
+		if err != nil {
+			log.Error("failed to write input file", "error", err, "name", input.name)
+			return apperror.ErrInternal.WithMessage("音声ファイルの書き込みに失敗しました").WithError(err)
+		}
+		args = append(args, "-i", inputPath)
+	}
+
+	args = append(args,
+		"-filter_complex", buildStitchFilterComplex(params),
+		"-map", "[out]",
+		"-c:a", "libmp3lame",
+		"-b:a", "192k",
+		"-y",
+		outputPath,
+	)
+
+	cmd := exec.CommandContext(ctx, "ffmpeg", args...)
+	var stderr bytes.Buffer
+	cmd.Stderr = &stderr
+
+	log.Info("running FFmpeg stitch",
+		"has_intro", params.Intro != nil,
+		"has_outro", params.Outro != nil,
+		"intro_crossfade_ms", params.IntroCrossfadeMs,
+		"outro_crossfade_ms", params.OutroCrossfadeMs,
+	)
+
+	if err := cmd.Run(); err != nil {
+		log.Error("FFmpeg failed", "error", err, "stderr", stderr.String())
+		return apperror.ErrInternal.WithMessage("イントロ・アウトロの結合に失敗しました").WithError(err)
+	}
+
+	return copyOutputFile(ctx, outputPath, w)
+}
+
+// ConcatAudio は複数の音声データを連結して w に書き込む
 // audioChunks に連結する音声データの配列を渡す。
-func (s *ffmpegService) ConcatAudio(ctx context.Context, audioChunks [][]byte) ([]byte, error) {
+func (s *ffmpegService) ConcatAudio(ctx context.Context, audioChunks []io.Reader, w io.Writer) error {
 	log := logger.FromContext(ctx)
 
 	log.Info("running FFmpeg concat", "chunks", len(audioChunks))
 
-	outputData, err := audio.Concat(audioChunks)
-	if err != nil {
+	if err := audio.Concat(w, audioChunks); err != nil {
 		log.Error("FFmpeg concat failed", "error", err)
-		return nil, apperror.ErrInternal.WithMessage("音声の結合に失敗しました").WithError(err)
+		return apperror.ErrInternal.WithMessage("音声の結合に失敗しました").WithError(err)
+	}
+
+	log.Info("audio concat completed", "chunks", len(audioChunks))
+
+	return nil
+}
+
+// buildMixFilterComplex は BGM ミキシング用の FFmpeg フィルタグラフを構築する
+//
+// 入力は [0:a] = voice, [1:a] = bgm とする
+//
+// 固定音量モード（Ducking が nil）:
+//
+//	[BGM] → aloop(無限ループ) → volume → afade(フェードアウト) → atrim(カット) ──┐
+//	[Voice] → adelay(前余白) ──────────────────────────────────────────────→ amix → [Output]
+//
+// ダッキングモード:
+//
+//	[BGM] → aloop → volume → afade(イントロスウェル) → afade(フェードアウト) → atrim → [bgm]
+//	[Voice] → adelay(前余白) → asplit → [voice] / [sc] → apad → [scpad]
+//	[bgm][scpad] → sidechaincompress → [ducked]
+//	[ducked][voice] → amix → [Output]
+func buildMixFilterComplex(params MixParams) string {
+	// 総出力時間を計算（単位: 秒）
+	totalDurationMs := params.PaddingStartMs + params.VoiceDurationMs + params.PaddingEndMs
+	totalDurationSec := float64(totalDurationMs) / 1000.0
+
+	// フェードアウト開始時間を計算（単位: 秒）
+	fadeStartSec := totalDurationSec - float64(params.FadeOutMs)/1000.0
+	if fadeStartSec < 0 {
+		fadeStartSec = 0
+	}
+
+	// BGM: ループ → 音量調整
+	bgmChain := fmt.Sprintf("[1:a]aloop=loop=-1:size=2e+09,volume=%sdB", formatFloat(params.BGMVolumeDB))
+
+	// イントロスウェル: 冒頭で BGM を無音から立ち上げる（ダッキング時のみ）
+	if params.Ducking != nil && params.Ducking.IntroSwellMs > 0 {
+		bgmChain += fmt.Sprintf(",afade=t=in:st=0:d=%s", formatFloat(float64(params.Ducking.IntroSwellMs)/1000.0))
+	}
+
+	// BGM: フェードアウト → 長さカット
+	bgmChain += fmt.Sprintf(",afade=t=out:st=%s:d=%s,atrim=0:%s[bgm]",
+		formatFloat(fadeStartSec),
+		formatFloat(float64(params.FadeOutMs)/1000.0),
+		formatFloat(totalDurationSec),
+	)
+
+	// Voice: 前余白を追加
+	voiceChain := fmt.Sprintf("[0:a]adelay=%d|%d", params.PaddingStartMs, params.PaddingStartMs)
+
+	if params.Ducking == nil {
+		// ミックス (BGM は voice に合わせて調整)
+		return bgmChain + ";" +
+			voiceChain + "[voice];" +
+			"[bgm][voice]amix=inputs=2:duration=first:dropout_transition=0[out]"
 	}
 
-	log.Info("audio concat completed", "chunks", len(audioChunks), "output_size", len(outputData))
+	// ダッキング: 前余白を付けた voice をサイドチェイン入力として BGM を圧縮する
+	// サイドチェイン側は apad で無限に延長し、BGM より先に終端して出力が途切れないようにする
+	return bgmChain + ";" +
+		voiceChain + ",asplit=2[voice][sc];" +
+		"[sc]apad[scpad];" +
+		fmt.Sprintf("[bgm][scpad]sidechaincompress=threshold=%.6f:ratio=%s:attack=%s:release=%s[ducked];",
+			dbToLinear(params.Ducking.ThresholdDB),
+			formatFloat(params.Ducking.Ratio),
+			formatFloat(float64(params.Ducking.AttackMs)),
+			formatFloat(float64(params.Ducking.ReleaseMs)),
+		) +
+		"[ducked][voice]amix=inputs=2:duration=first:dropout_transition=0[out]"
+}
 
-	return outputData, nil
+// buildSFXFilterComplex は効果音ミキシング用の FFmpeg フィルタグラフを構築する
+//
+// 入力は [0:a] = voice, [1:a] 以降 = 効果音（cues の順）とする
+//
+//	[SFX n] → volume → adelay(再生開始位置) → [sN] ──┐
+//	[Voice] ──────────────────────────────────→ amix(正規化なし) → [Output]
+//
+// amix の正規化を無効にし、効果音を重ねてもナレーションの音量が下がらないようにする
+func buildSFXFilterComplex(cues []SFXCueParams) string {
+	var b strings.Builder
+	inputs := "[0:a]"
+	for i, cue := range cues {
+		startMs := max(cue.StartMs, 0)
+		label := fmt.Sprintf("[s%d]", i+1)
+		fmt.Fprintf(&b, "[%d:a]volume=%sdB,adelay=%d|%d%s;", i+1, formatFloat(cue.VolumeDB), startMs, startMs, label)
+		inputs += label
+	}
+	fmt.Fprintf(&b, "%samix=inputs=%d:duration=first:dropout_transition=0:normalize=0[out]", inputs, len(cues)+1)
+	return b.String()
+}
+
+// buildStitchFilterComplex はイントロ・ナレーション・アウトロを結合するフィルタグラフを構築する
+//
+// 入力は StitchSegments と同じくイントロ・ナレーション・アウトロの順（存在するもののみ）で、
+// 各入力のサンプルフォーマットを揃えてから、クロスフェード時間が 1ms 以上なら acrossfade、
+// 0 なら concat で前から順につなぐ
+func buildStitchFilterComplex(params StitchParams) string {
+	// joins[i] は i 番目と i+1 番目の入力のつなぎ目のクロスフェード時間 (ms)
+	var joins []int
+	if params.Intro != nil {
+		joins = append(joins, params.IntroCrossfadeMs)
+	}
+	if params.Outro != nil {
+		joins = append(joins, params.OutroCrossfadeMs)
+	}
+
+	var b strings.Builder
+	for i := 0; i <= len(joins); i++ {
+		fmt.Fprintf(&b, "[%d:a]%s[a%d];", i, stitchSampleFormat, i)
+	}
+
+	current := "[a0]"
+	for i, crossfadeMs := range joins {
+		label := fmt.Sprintf("[j%d]", i+1)
+		if i == len(joins)-1 {
+			label = "[out]"
+		}
+		if crossfadeMs > 0 {
+			fmt.Fprintf(&b, "%s[a%d]acrossfade=d=%s:c1=tri:c2=tri%s", current, i+1, formatFloat(float64(crossfadeMs)/1000), label)
+		} else {
+			fmt.Fprintf(&b, "%s[a%d]concat=n=2:v=0:a=1%s", current, i+1, label)
+		}
+		if i < len(joins)-1 {
+			b.WriteString(";")
+		}
+		current = label
+	}
+	return b.String()
+}
+
+// dbToLinear は dB 値を線形の振幅比に変換する
+func dbToLinear(db float64) float64 {
+	return math.Pow(10, db/20)
 }
 
 // formatFloat は float64 を文字列に変換する（小数点以下 3 桁）
@@ -158,69 +496,625 @@ func formatFloat(f float64) string {
 	return strconv.FormatFloat(f, 'f', 3, 64)
 }
 
-// ConvertToMP3 は音声データを MP3 に変換する
+// ConvertToMP3 は音声データを MP3 に変換して w に書き込む
 // format: 入力形式（"pcm" または "ogg"）
-func (s *ffmpegService) ConvertToMP3(ctx context.Context, audioData []byte, format string, sampleRateHz int) ([]byte, error) {
+func (s *ffmpegService) ConvertToMP3(ctx context.Context, r io.Reader, w io.Writer, format string, sampleRateHz int) error {
 	log := logger.FromContext(ctx)
 
 	// 一時ディレクトリを作成
 	tmpDir, err := os.MkdirTemp("", "ffmpeg-convert-*")
 	if err != nil {
 		log.Error("failed to create temp directory", "error", err)
-		return nil, apperror.ErrInternal.WithMessage("一時ディレクトリの作成に失敗しました").WithError(err)
+		return apperror.ErrInternal.WithMessage("一時ディレクトリの作成に失敗しました").WithError(err)
 	}
 	defer os.RemoveAll(tmpDir)
 
 	// 入力ファイル名と FFmpeg 引数を format に応じて決定
-	var inputPath string
+	var inputName string
 	var inputArgs []string
 
 	switch format {
 	case "pcm":
-		inputPath = filepath.Join(tmpDir, "input.pcm")
+		inputName = "input.pcm"
 		// LINEAR16 PCM は signed 16-bit little-endian、mono
 		inputArgs = []string{
 			"-f", "s16le",
 			"-ar", strconv.Itoa(sampleRateHz),
 			"-ac", "1",
-			"-i", inputPath,
 		}
 	case "ogg":
-		inputPath = filepath.Join(tmpDir, "input.ogg")
-		inputArgs = []string{"-i", inputPath}
+		inputName = "input.ogg"
 	default:
-		return nil, apperror.ErrValidation.WithMessage("サポートされていない音声フォーマットです: " + format)
+		return apperror.ErrValidation.WithMessage("サポートされていない音声フォーマットです: " + format)
+	}
+
+	inputPath, err := writeTempInput(tmpDir, inputName, r)
+	if err != nil {
+		log.Error("failed to write input file", "error", err)
+		return apperror.ErrInternal.WithMessage("入力ファイルの書き込みに失敗しました").WithError(err)
 	}
 
 	outputPath := filepath.Join(tmpDir, "output.mp3")
 
-	if err := os.WriteFile(inputPath, audioData, 0o644); err != nil {
+	// FFmpeg コマンドを実行
+	inputArgs = append(inputArgs, "-i", inputPath, "-c:a", "libmp3lame", "-b:a", "192k", "-y", outputPath)
+
+	cmd := exec.CommandContext(ctx, "ffmpeg", inputArgs...)
+	var stderr bytes.Buffer
+	cmd.Stderr = &stderr
+
+	log.Info("running FFmpeg conversion", "format", format)
+
+	if err := cmd.Run(); err != nil {
+		log.Error("FFmpeg conversion failed", "error", err, "stderr", stderr.String())
+		return apperror.ErrInternal.WithMessage(format + " から MP3 への変換に失敗しました").WithError(err)
+	}
+
+	if err := copyOutputFile(ctx, outputPath, w); err != nil {
+		return err
+	}
+
+	log.Info("audio conversion completed", "format", format)
+
+	return nil
+}
+
+// DecodeToPCM は r の音声データを指定したサンプルレートの s16le モノラル PCM にデコードして w に書き込む
+// format: 入力形式（"pcm" の場合は inputSampleRateHz の s16le モノラルとして扱い、それ以外は自動判別）
+//
+// デコード結果はメモリに保持せず、FFmpeg の標準出力から w に直接書き込む
+func (s *ffmpegService) DecodeToPCM(ctx context.Context, r io.Reader, w io.Writer, format string, inputSampleRateHz, outputSampleRateHz int) error {
+	log := logger.FromContext(ctx)
+
+	// 一時ディレクトリを作成
+	tmpDir, err := os.MkdirTemp("", "ffmpeg-decode-*")
+	if err != nil {
+		log.Error("failed to create temp directory", "error", err)
+		return apperror.ErrInternal.WithMessage("一時ディレクトリの作成に失敗しました").WithError(err)
+	}
+	defer os.RemoveAll(tmpDir)
+
+	inputPath, err := writeTempInput(tmpDir, "input", r)
+	if err != nil {
+		log.Error("failed to write input file", "error", err)
+		return apperror.ErrInternal.WithMessage("入力ファイルの書き込みに失敗しました").WithError(err)
+	}
+
+	var args []string
+	if format == "pcm" {
+		args = append(args, "-f", "s16le", "-ar", strconv.Itoa(inputSampleRateHz), "-ac", "1")
+	}
+	args = append(args,
+		"-i", inputPath,
+		"-vn",
+		"-ac", "1",
+		"-ar", strconv.Itoa(outputSampleRateHz),
+		"-f", "s16le",
+		"-",
+	)
+
+	cmd := exec.CommandContext(ctx, "ffmpeg", args...)
+	var stderr bytes.Buffer
+	cmd.Stdout = w
+	cmd.Stderr = &stderr
+
+	log.Info("running FFmpeg decode", "format", format, "output_sample_rate", outputSampleRateHz)
+
+	if err := cmd.Run(); err != nil {
+		log.Error("FFmpeg decode failed", "error", err, "stderr", stderr.String())
+		return apperror.ErrInternal.WithMessage(format + " から PCM へのデコードに失敗しました").WithError(err)
+	}
+
+	return nil
+}
+
+// TranscodeAudio は音声データを配信用フォーマット・ビットレートに変換して w に書き込む
+//
+// AAC は faststart のためにシーク可能な出力が必要なので、一時ファイルに出力してから w にコピーする
+func (s *ffmpegService) TranscodeAudio(ctx context.Context, r io.Reader, w io.Writer, format model.AudioFormat, bitrateKbps int) error {
+	log := logger.FromContext(ctx)
+
+	encoderArgs, err := buildTranscodeArgs(format, bitrateKbps)
+	if err != nil {
+		return err
+	}
+
+	// 一時ディレクトリを作成
+	tmpDir, err := os.MkdirTemp("", "ffmpeg-transcode-*")
+	if err != nil {
+		log.Error("failed to create temp directory", "error", err)
+		return apperror.ErrInternal.WithMessage("一時ディレクトリの作成に失敗しました").WithError(err)
+	}
+	defer os.RemoveAll(tmpDir)
+
+	outputPath := filepath.Join(tmpDir, "output"+format.Extension())
+
+	inputPath, err := writeTempInput(tmpDir, "input", r)
+	if err != nil {
+		log.Error("failed to write input file", "error", err)
+		return apperror.ErrInternal.WithMessage("入力ファイルの書き込みに失敗しました").WithError(err)
+	}
+
+	// FFmpeg コマンドを実行（映像・メタデータストリームは除外する）
+	args := append([]string{"-i", inputPath, "-vn", "-map", "0:a:0"}, encoderArgs...)
+	args = append(args, "-y", outputPath)
+
+	cmd := exec.CommandContext(ctx, "ffmpeg", args...)
+	var stderr bytes.Buffer
+	cmd.Stderr = &stderr
+
+	log.Info("running FFmpeg transcode", "format", format, "bitrate_kbps", bitrateKbps)
+
+	if err := cmd.Run(); err != nil {
+		log.Error("FFmpeg transcode failed", "error", err, "stderr", stderr.String())
+		return apperror.ErrInternal.WithMessage(string(format) + " への変換に失敗しました").WithError(err)
+	}
+
+	if err := copyOutputFile(ctx, outputPath, w); err != nil {
+		return err
+	}
+
+	log.Info("audio transcode completed", "format", format, "bitrate_kbps", bitrateKbps)
+
+	return nil
+}
+
+// buildTranscodeArgs は配信用フォーマットに応じた FFmpeg のエンコーダ引数を構築する
+//
+// AAC は MP4 コンテナ（.m4a）に faststart で格納し、ダウンロード完了前に再生を開始できるようにする
+func buildTranscodeArgs(format model.AudioFormat, bitrateKbps int) ([]string, error) {
+	if bitrateKbps <= 0 {
+		return nil, apperror.ErrValidation.WithMessage("ビットレートは 1 以上を指定してください")
+	}
+
+	bitrate := strconv.Itoa(bitrateKbps) + "k"
+
+	switch format {
+	case model.AudioFormatMP3:
+		return []string{"-c:a", "libmp3lame", "-b:a", bitrate, "-f", "mp3"}, nil
+	case model.AudioFormatAAC:
+		return []string{"-c:a", "aac", "-b:a", bitrate, "-movflags", "+faststart", "-f", "ipod"}, nil
+	case model.AudioFormatOpus:
+		return []string{"-c:a", "libopus", "-b:a", bitrate, "-f", "ogg"}, nil
+	default:
+		return nil, apperror.ErrValidation.WithMessage("サポートされていない音声フォーマットです: " + string(format))
+	}
+}
+
+// PackageHLS は音声データを AAC セグメント（MPEG-TS）と VOD 用の m3u8 プレイリストに分割する
+//
+// セグメントは一時ディレクトリに出力したまま返すため、呼び出し元は使用後に HLSOutput.Close を呼ぶこと
+func (s *ffmpegService) PackageHLS(ctx context.Context, r io.Reader, segmentDurationSec, bitrateKbps int) (*HLSOutput, error) {
+	log := logger.FromContext(ctx)
+
+	// 一時ディレクトリを作成
+	tmpDir, err := os.MkdirTemp("", "ffmpeg-hls-*")
+	if err != nil {
+		log.Error("failed to create temp directory", "error", err)
+		return nil, apperror.ErrInternal.WithMessage("一時ディレクトリの作成に失敗しました").WithError(err)
+	}
+	output := &HLSOutput{dir: tmpDir}
+
+	playlistPath := filepath.Join(tmpDir, model.HLSPlaylistFilename)
+
+	inputPath, err := writeTempInput(tmpDir, "input", r)
+	if err != nil {
+		output.Close()
 		log.Error("failed to write input file", "error", err)
 		return nil, apperror.ErrInternal.WithMessage("入力ファイルの書き込みに失敗しました").WithError(err)
 	}
 
 	// FFmpeg コマンドを実行
-	inputArgs = append(inputArgs, "-c:a", "libmp3lame", "-b:a", "192k", "-y", outputPath)
+	args := []string{
+		"-i", inputPath,
+		"-vn",
+		"-map", "0:a:0",
+		"-c:a", "aac",
+		"-b:a", strconv.Itoa(bitrateKbps) + "k",
+		"-f", "hls",
+		"-hls_time", strconv.Itoa(segmentDurationSec),
+		"-hls_playlist_type", "vod",
+		"-hls_list_size", "0",
+		"-hls_segment_type", "mpegts",
+		"-hls_segment_filename", filepath.Join(tmpDir, "segment_%05d.ts"),
+		"-y",
+		playlistPath,
+	}
 
-	cmd := exec.CommandContext(ctx, "ffmpeg", inputArgs...)
+	cmd := exec.CommandContext(ctx, "ffmpeg", args...)
 	var stderr bytes.Buffer
 	cmd.Stderr = &stderr
 
-	log.Info("running FFmpeg conversion", "format", format, "input_size", len(audioData))
+	log.Info("running FFmpeg HLS packaging", "segment_duration_sec", segmentDurationSec, "bitrate_kbps", bitrateKbps)
 
 	if err := cmd.Run(); err != nil {
-		log.Error("FFmpeg conversion failed", "error", err, "stderr", stderr.String())
-		return nil, apperror.ErrInternal.WithMessage(format + " から MP3 への変換に失敗しました").WithError(err)
+		output.Close()
+		log.Error("FFmpeg HLS packaging failed", "error", err, "stderr", stderr.String())
+		return nil, apperror.ErrInternal.WithMessage("HLS パッケージの生成に失敗しました").WithError(err)
 	}
 
-	// 出力ファイルを読み込み
-	outputData, err := os.ReadFile(outputPath)
+	playlist, err := os.ReadFile(playlistPath)
 	if err != nil {
-		log.Error("failed to read output file", "error", err)
+		output.Close()
+		log.Error("failed to read playlist file", "error", err)
 		return nil, apperror.ErrInternal.WithMessage("出力ファイルの読み込みに失敗しました").WithError(err)
 	}
 
-	log.Info("audio conversion completed", "format", format, "output_size", len(outputData))
+	// プレイリストに記載された順にセグメントのパスを確認
+	names := hlsSegmentURIs(playlist)
+	segments := make([]HLSSegment, 0, len(names))
+	for _, name := range names {
+		path := filepath.Join(tmpDir, filepath.Base(name))
+		if _, err := os.Stat(path); err != nil {
+			output.Close()
+			log.Error("failed to stat segment file", "error", err, "segment", name)
+			return nil, apperror.ErrInternal.WithMessage("出力ファイルの読み込みに失敗しました").WithError(err)
+		}
+		segments = append(segments, HLSSegment{Name: name, Path: path})
+	}
+
+	log.Info("HLS packaging completed", "segments", len(segments))
+
+	output.Playlist = playlist
+	output.Segments = segments
+	return output, nil
+}
+
+// ExtractPeaks は音声データをモノラル PCM にデコードし、ブロックごとの最小値・最大値を集計する
+//
+// 入力は一時ファイルに書き出し、デコード結果は標準出力から逐次集計するため、PCM 全体をメモリに保持しない
+func (s *ffmpegService) ExtractPeaks(ctx context.Context, r io.Reader, sampleRateHz, blockSize int) (*audio.PeakBlocks, error) {
+	log := logger.FromContext(ctx)
+
+	// 一時ディレクトリを作成
+	tmpDir, err := os.MkdirTemp("", "ffmpeg-peaks-*")
+	if err != nil {
+		log.Error("failed to create temp directory", "error", err)
+		return nil, apperror.ErrInternal.WithMessage("一時ディレクトリの作成に失敗しました").WithError(err)
+	}
+	defer os.RemoveAll(tmpDir)
+
+	inputPath, err := writeTempInput(tmpDir, "input", r)
+	if err != nil {
+		log.Error("failed to write input file", "error", err)
+		return nil, apperror.ErrInternal.WithMessage("入力ファイルの書き込みに失敗しました").WithError(err)
+	}
+
+	args := []string{
+		"-i", inputPath,
+		"-vn", "-map", "0:a:0",
+		"-ac", "1",
+		"-ar", strconv.Itoa(sampleRateHz),
+		"-f", "s16le",
+		"-",
+	}
+
+	cmd := exec.CommandContext(ctx, "ffmpeg", args...)
+	var stderr bytes.Buffer
+	cmd.Stderr = &stderr
+
+	stdout, err := cmd.StdoutPipe()
+	if err != nil {
+		log.Error("failed to open FFmpeg stdout", "error", err)
+		return nil, apperror.ErrInternal.WithMessage("波形データの生成に失敗しました").WithError(err)
+	}
+
+	if err := cmd.Start(); err != nil {
+		log.Error("failed to start FFmpeg", "error", err)
+		return nil, apperror.ErrInternal.WithMessage("波形データの生成に失敗しました").WithError(err)
+	}
+
+	peaks, readErr := audio.ReadPeakBlocks(stdout, sampleRateHz, blockSize)
+	if readErr != nil {
+		// 読み込みを中断した場合でもプロセスを終了させる
+		_, _ = io.Copy(io.Discard, stdout)
+	}
+
+	if err := cmd.Wait(); err != nil {
+		log.Error("FFmpeg peak extraction failed", "error", err, "stderr", stderr.String())
+		return nil, apperror.ErrInternal.WithMessage("波形データの生成に失敗しました").WithError(err)
+	}
+	if readErr != nil {
+		log.Error("failed to read decoded PCM", "error", readErr)
+		return nil, apperror.ErrInternal.WithMessage("波形データの生成に失敗しました").WithError(readErr)
+	}
+
+	return peaks, nil
+}
+
+// RenderAudiogram は音声の一部を切り出し、アートワーク・波形アニメーション・字幕を重ねた MP4 動画を w に書き込む
+//
+// 字幕は libass で焼き込むため、実行環境に日本語フォント（audiogramCaptionFont）が必要。
+// MP4 は faststart のためにシーク可能な出力が必要なので、一時ファイルに出力してから w にコピーする。
+// フィルタグラフの詳細は buildAudiogramFilterComplex を参照
+func (s *ffmpegService) RenderAudiogram(ctx context.Context, params AudiogramParams, w io.Writer) error {
+	log := logger.FromContext(ctx)
+
+	if params.DurationMs <= 0 {
+		return apperror.ErrValidation.WithMessage("切り出す長さは 1 以上を指定してください")
+	}
+
+	// 一時ディレクトリを作成
+	tmpDir, err := os.MkdirTemp("", "ffmpeg-audiogram-*")
+	if err != nil {
+		log.Error("failed to create temp directory", "error", err)
+		return apperror.ErrInternal.WithMessage("一時ディレクトリの作成に失敗しました").WithError(err)
+	}
+	defer os.RemoveAll(tmpDir)
+
+	outputPath := filepath.Join(tmpDir, "output.mp4")
+
+	// 入力は背景（アートワークまたは単色）・音声の順
+	var args []string
+	if params.Artwork != nil {
+		artworkPath, err := writeTempInput(tmpDir, "artwork", params.Artwork)
+		if err != nil {
+			log.Error("failed to write artwork file", "error", err)
+			return apperror.ErrInternal.WithMessage("アートワークの書き込みに失敗しました").WithError(err)
+		}
+		args = append(args, "-loop", "1", "-framerate", strconv.Itoa(audiogramFrameRate), "-i", artworkPath)
+	} else {
+		args = append(args,
+			"-f", "lavfi",
+			"-i", fmt.Sprintf("color=c=%s:s=%dx%d:r=%d", audiogramBackgroundColor, params.Width, params.Height, audiogramFrameRate),
+		)
+	}
+
+	audioPath, err := writeTempInput(tmpDir, "audio", params.Audio)
+	if err != nil {
+		log.Error("failed to write audio file", "error", err)
+		return apperror.ErrInternal.WithMessage("音声ファイルの書き込みに失敗しました").WithError(err)
+	}
+	duration := formatFloat(float64(params.DurationMs) / 1000)
+	args = append(args,
+		"-ss", formatFloat(float64(params.StartMs)/1000),
+		"-t", duration,
+		"-i", audioPath,
+	)
+
+	// 字幕は SRT ファイルとして渡す（作業ディレクトリからの相対パスで参照し、フィルタ引数のエスケープを避ける）
+	if len(params.Captions) > 0 {
+		if err := os.WriteFile(filepath.Join(tmpDir, audiogramCaptionFile), []byte(buildSRT(params.Captions)), 0o600); err != nil {
+			log.Error("failed to write caption file", "error", err)
+			return apperror.ErrInternal.WithMessage("字幕ファイルの書き込みに失敗しました").WithError(err)
+		}
+	}
+
+	args = append(args,
+		"-filter_complex", buildAudiogramFilterComplex(params),
+		"-map", "[vout]",
+		"-map", "[aout]",
+		"-c:v", "libx264",
+		"-preset", "veryfast",
+		"-pix_fmt", "yuv420p",
+		"-r", strconv.Itoa(audiogramFrameRate),
+		"-c:a", "aac",
+		"-b:a", "128k",
+		"-movflags", "+faststart",
+		"-t", duration,
+		"-shortest",
+		"-y",
+		outputPath,
+	)
+
+	cmd := exec.CommandContext(ctx, "ffmpeg", args...)
+	cmd.Dir = tmpDir
+	var stderr bytes.Buffer
+	cmd.Stderr = &stderr
+
+	log.Info("running FFmpeg audiogram",
+		"start_ms", params.StartMs,
+		"duration_ms", params.DurationMs,
+		"width", params.Width,
+		"height", params.Height,
+		"has_artwork", params.Artwork != nil,
+		"captions", len(params.Captions),
+	)
+
+	if err := cmd.Run(); err != nil {
+		log.Error("FFmpeg audiogram failed", "error", err, "stderr", stderr.String())
+		return apperror.ErrInternal.WithMessage("クリップ動画の生成に失敗しました").WithError(err)
+	}
+
+	if err := copyOutputFile(ctx, outputPath, w); err != nil {
+		return err
+	}
+
+	log.Info("audiogram render completed", "duration_ms", params.DurationMs)
+
+	return nil
+}
+
+// audiogramLayout はオーディオグラムの各要素の配置を表す（単位: px）
+type audiogramLayout struct {
+	ArtworkSize int // 中央に置くアートワークの一辺
+	ArtworkY    int // アートワークの上端
+	WaveWidth   int // 波形の幅
+	WaveHeight  int // 波形の高さ
+	WaveY       int // 波形の上端
+}
+
+// calcAudiogramLayout は動画の解像度からアートワークと波形の配置を求める
+//
+// 上から余白・アートワーク・波形・字幕の順に縦に並べ、字幕は画面下端に寄せる。
+// libx264 の yuv420p に合わせて各値は偶数に丸める
+func calcAudiogramLayout(width, height int) audiogramLayout {
+	artworkSize := even(min(width, height) * 3 / 5)
+	waveWidth := even(width * 4 / 5)
+	waveHeight := even(height / 8)
+	artworkY := even(max(height-artworkSize-waveHeight, 0) / 4)
+
+	return audiogramLayout{
+		ArtworkSize: artworkSize,
+		ArtworkY:    artworkY,
+		WaveWidth:   waveWidth,
+		WaveHeight:  waveHeight,
+		WaveY:       even(artworkY + artworkSize + height/40),
+	}
+}
+
+// even は n を偶数に切り捨てる
+func even(n int) int {
+	return n - n%2
+}
+
+// buildAudiogramFilterComplex はオーディオグラム用の FFmpeg フィルタグラフを構築する
+//
+// 入力は [0:v] = 背景（アートワークの静止画ループまたは単色）, [1:a] = 切り出し済みの音声とする
+//
+// アートワークあり:
+//
+//	[0:v] → split ─→ scale(画面を覆う) → crop → boxblur → eq(暗く) → [bg]
+//	               └→ scale(アートワーク) ─────────────────────────→ [art]
+//	[bg][art] → overlay(中央上) → [base]
+//
+// アートワークなし:
+//
+//	[0:v] → null → [base]
+//
+// 共通:
+//
+//	[1:a] → asplit ─→ [aout]
+//	               └→ showwaves → [wave]
+//	[base][wave] → overlay(中央下) → subtitles(字幕がある場合) → [vout]
+func buildAudiogramFilterComplex(params AudiogramParams) string {
+	layout := calcAudiogramLayout(params.Width, params.Height)
+
+	var filters []string
+
+	if params.Artwork != nil {
+		filters = append(filters,
+			"[0:v]split=2[bgsrc][artsrc]",
+			fmt.Sprintf("[bgsrc]scale=%d:%d:force_original_aspect_ratio=increase,crop=%d:%d,boxblur=20:2,eq=brightness=-0.25,setsar=1[bg]",
+				params.Width, params.Height, params.Width, params.Height),
+			fmt.Sprintf("[artsrc]scale=%d:%d,setsar=1[art]", layout.ArtworkSize, layout.ArtworkSize),
+			fmt.Sprintf("[bg][art]overlay=(W-w)/2:%d[base]", layout.ArtworkY),
+		)
+	} else {
+		filters = append(filters, "[0:v]null[base]")
+	}
+
+	filters = append(filters,
+		"[1:a]asplit=2[aout][awave]",
+		fmt.Sprintf("[awave]showwaves=s=%dx%d:mode=cline:rate=%d:colors=%s,format=rgba[wave]",
+			layout.WaveWidth, layout.WaveHeight, audiogramFrameRate, audiogramWaveColor),
+	)
+
+	if len(params.Captions) == 0 {
+		filters = append(filters, fmt.Sprintf("[base][wave]overlay=(W-w)/2:%d:shortest=1[vout]", layout.WaveY))
+	} else {
+		filters = append(filters,
+			fmt.Sprintf("[base][wave]overlay=(W-w)/2:%d:shortest=1[waved]", layout.WaveY),
+			fmt.Sprintf("[waved]subtitles=%s:force_style='%s'[vout]", audiogramCaptionFile, audiogramCaptionStyle),
+		)
+	}
+
+	return strings.Join(filters, ";")
+}
+
+// buildSRT は字幕を SRT 形式の文字列に変換する
+//
+// 表示区間が空の字幕は除外する
+func buildSRT(captions []AudiogramCaption) string {
+	var b strings.Builder
+	index := 0
+	for _, c := range captions {
+		if c.EndMs <= c.StartMs || strings.TrimSpace(c.Text) == "" {
+			continue
+		}
+		index++
+		fmt.Fprintf(&b, "%d\n%s --> %s\n%s\n\n", index, formatSRTTime(c.StartMs), formatSRTTime(c.EndMs), strings.TrimSpace(c.Text))
+	}
+	return b.String()
+}
+
+// formatSRTTime はミリ秒を SRT のタイムスタンプ（HH:MM:SS,mmm）に変換する
+func formatSRTTime(ms int) string {
+	if ms < 0 {
+		ms = 0
+	}
+	return fmt.Sprintf("%02d:%02d:%02d,%03d", ms/3600000, ms/60000%60, ms/1000%60, ms%1000)
+}
+
+// writeTempInput は r の内容を一時ディレクトリ内の name のファイルに書き出し、そのパスを返す
+//
+// FFmpeg はコンテナによって入力のシークを必要とするため、パイプではなくファイルで渡す
+func writeTempInput(tmpDir, name string, r io.Reader) (string, error) {
+	path := filepath.Join(tmpDir, name)
+
+	f, err := os.Create(path)
+	if err != nil {
+		return "", err
+	}
+
+	if _, err := io.Copy(f, r); err != nil {
+		f.Close()
+		return "", err
+	}
+
+	if err := f.Close(); err != nil {
+		return "", err
+	}
+
+	return path, nil
+}
+
+// copyOutputFile は FFmpeg の出力ファイルの内容を w にコピーする
+func copyOutputFile(ctx context.Context, path string, w io.Writer) error {
+	f, err := os.Open(path)
+	if err != nil {
+		logger.FromContext(ctx).Error("failed to open output file", "error", err)
+		return apperror.ErrInternal.WithMessage("出力ファイルの読み込みに失敗しました").WithError(err)
+	}
+	defer f.Close()
+
+	if _, err := io.Copy(w, f); err != nil {
+		logger.FromContext(ctx).Error("failed to copy output file", "error", err)
+		return apperror.ErrInternal.WithMessage("出力ファイルの書き込みに失敗しました").WithError(err)
+	}
+
+	return nil
+}
+
+// copyInput は処理が不要な入力をそのまま w にコピーする
+func copyInput(ctx context.Context, r io.Reader, w io.Writer) error {
+	if _, err := io.Copy(w, r); err != nil {
+		logger.FromContext(ctx).Error("failed to copy input", "error", err)
+		return apperror.ErrInternal.WithMessage("音声データのコピーに失敗しました").WithError(err)
+	}
+	return nil
+}
+
+// hlsSegmentURIs は m3u8 プレイリストからセグメント URI（タグ・空行以外の行）を記載順に抽出する
+func hlsSegmentURIs(playlist []byte) []string {
+	var uris []string
+	for _, line := range strings.Split(string(playlist), "\n") {
+		line = strings.TrimSpace(line)
+		if line == "" || strings.HasPrefix(line, "#") {
+			continue
+		}
+		uris = append(uris, line)
+	}
+	return uris
+}
+
+// rewriteHLSPlaylist は m3u8 プレイリストのセグメント URI を resolve の結果に置き換える
+//
+// タグ行・空行はそのまま維持する
+func rewriteHLSPlaylist(playlist []byte, resolve func(uri string) (string, error)) ([]byte, error) {
+	lines := strings.Split(string(playlist), "\n")
+	for i, line := range lines {
+		trimmed := strings.TrimSpace(line)
+		if trimmed == "" || strings.HasPrefix(trimmed, "#") {
+			continue
+		}
+
+		resolved, err := resolve(trimmed)
+		if err != nil {
+			return nil, err
+		}
+		lines[i] = resolved
+	}
 
-	return outputData, nil
+	return []byte(strings.Join(lines, "\n")), nil
 }
@@ -1,11 +1,16 @@
 package service
 
 import (
+	"bytes"
 	"context"
+	"io"
 	"os/exec"
+	"strings"
 	"testing"
 
 	"github.com/stretchr/testify/assert"
+
+	"github.com/siropaca/anycast-backend/internal/model"
 )
 
 func TestFormatFloat(t *testing.T) {
@@ -55,6 +60,366 @@ func TestFormatFloat(t *testing.T) {
 	}
 }
 
+func TestDbToLinear(t *testing.T) {
+	t.Run("0dB は 1.0 になる", func(t *testing.T) {
+		assert.InDelta(t, 1.0, dbToLinear(0), 1e-9)
+	})
+
+	t.Run("-20dB は 0.1 になる", func(t *testing.T) {
+		assert.InDelta(t, 0.1, dbToLinear(-20), 1e-9)
+	})
+
+	t.Run("-60dB は 0.001 になる", func(t *testing.T) {
+		assert.InDelta(t, 0.001, dbToLinear(-60), 1e-9)
+	})
+}
+
+func TestBuildMixFilterComplex(t *testing.T) {
+	baseParams := MixParams{
+		VoiceDurationMs: 10000,
+		BGMVolumeDB:     -25.0,
+		FadeOutMs:       3000,
+		PaddingStartMs:  1000,
+		PaddingEndMs:    3000,
+	}
+
+	t.Run("ダッキングなしの場合は固定音量でミキシングする", func(t *testing.T) {
+		result := buildMixFilterComplex(baseParams)
+
+		assert.Equal(t,
+			"[1:a]aloop=loop=-1:size=2e+09,volume=-25.000dB,afade=t=out:st=11.000:d=3.000,atrim=0:14.000[bgm];"+
+				"[0:a]adelay=1000|1000[voice];"+
+				"[bgm][voice]amix=inputs=2:duration=first:dropout_transition=0[out]",
+			result,
+		)
+	})
+
+	t.Run("ダッキングありの場合はサイドチェインコンプレッションを適用する", func(t *testing.T) {
+		params := baseParams
+		params.BGMVolumeDB = -12.0
+		params.Ducking = &DuckingParams{
+			ThresholdDB: -20,
+			Ratio:       8,
+			AttackMs:    20,
+			ReleaseMs:   300,
+		}
+
+		result := buildMixFilterComplex(params)
+
+		assert.Equal(t,
+			"[1:a]aloop=loop=-1:size=2e+09,volume=-12.000dB,afade=t=out:st=11.000:d=3.000,atrim=0:14.000[bgm];"+
+				"[0:a]adelay=1000|1000,asplit=2[voice][sc];"+
+				"[sc]apad[scpad];"+
+				"[bgm][scpad]sidechaincompress=threshold=0.100000:ratio=8.000:attack=20.000:release=300.000[ducked];"+
+				"[ducked][voice]amix=inputs=2:duration=first:dropout_transition=0[out]",
+			result,
+		)
+	})
+
+	t.Run("イントロスウェルを指定するとフェードインを追加する", func(t *testing.T) {
+		params := baseParams
+		params.Ducking = &DuckingParams{
+			ThresholdDB:  -30,
+			Ratio:        4,
+			AttackMs:     20,
+			ReleaseMs:    300,
+			IntroSwellMs: 800,
+		}
+
+		result := buildMixFilterComplex(params)
+
+		assert.Contains(t, result, "volume=-25.000dB,afade=t=in:st=0:d=0.800,afade=t=out:st=11.000:d=3.000")
+	})
+
+	t.Run("ダッキングなしの場合はイントロスウェルを適用しない", func(t *testing.T) {
+		result := buildMixFilterComplex(baseParams)
+
+		assert.NotContains(t, result, "afade=t=in")
+		assert.NotContains(t, result, "sidechaincompress")
+	})
+
+	t.Run("フェードアウトが総時間より長い場合は 0 秒から開始する", func(t *testing.T) {
+		params := baseParams
+		params.VoiceDurationMs = 0
+		params.PaddingStartMs = 0
+		params.PaddingEndMs = 1000
+
+		result := buildMixFilterComplex(params)
+
+		assert.Contains(t, result, "afade=t=out:st=0.000:d=3.000,atrim=0:1.000")
+	})
+}
+
+func TestBuildSFXFilterComplex(t *testing.T) {
+	t.Run("効果音ごとに音量と開始位置を指定してナレーションに重ねる", func(t *testing.T) {
+		result := buildSFXFilterComplex([]SFXCueParams{
+			{StartMs: 0, VolumeDB: 0},
+			{StartMs: 12500, VolumeDB: -6},
+		})
+
+		assert.Equal(t,
+			"[1:a]volume=0.000dB,adelay=0|0[s1];"+
+				"[2:a]volume=-6.000dB,adelay=12500|12500[s2];"+
+				"[0:a][s1][s2]amix=inputs=3:duration=first:dropout_transition=0:normalize=0[out]",
+			result,
+		)
+	})
+
+	t.Run("負の開始位置は 0 に丸める", func(t *testing.T) {
+		result := buildSFXFilterComplex([]SFXCueParams{{StartMs: -300}})
+
+		assert.Contains(t, result, "adelay=0|0[s1]")
+	})
+}
+
+func TestBuildStitchFilterComplex(t *testing.T) {
+	t.Run("イントロとアウトロをクロスフェードでつなぐ", func(t *testing.T) {
+		result := buildStitchFilterComplex(StitchParams{
+			Intro:            strings.NewReader("intro"),
+			Voice:            strings.NewReader("voice"),
+			Outro:            strings.NewReader("outro"),
+			IntroCrossfadeMs: 500,
+			OutroCrossfadeMs: 1500,
+		})
+
+		assert.Equal(t,
+			"[0:a]"+stitchSampleFormat+"[a0];"+
+				"[1:a]"+stitchSampleFormat+"[a1];"+
+				"[2:a]"+stitchSampleFormat+"[a2];"+
+				"[a0][a1]acrossfade=d=0.500:c1=tri:c2=tri[j1];"+
+				"[j1][a2]acrossfade=d=1.500:c1=tri:c2=tri[out]",
+			result,
+		)
+	})
+
+	t.Run("クロスフェード時間が 0 の場合は単純に連結する", func(t *testing.T) {
+		result := buildStitchFilterComplex(StitchParams{
+			Intro: strings.NewReader("intro"),
+			Voice: strings.NewReader("voice"),
+		})
+
+		assert.Equal(t,
+			"[0:a]"+stitchSampleFormat+"[a0];"+
+				"[1:a]"+stitchSampleFormat+"[a1];"+
+				"[a0][a1]concat=n=2:v=0:a=1[out]",
+			result,
+		)
+	})
+
+	t.Run("アウトロのみの場合はナレーションが先頭の入力になる", func(t *testing.T) {
+		result := buildStitchFilterComplex(StitchParams{
+			Voice:            strings.NewReader("voice"),
+			Outro:            strings.NewReader("outro"),
+			IntroCrossfadeMs: 500,
+			OutroCrossfadeMs: 800,
+		})
+
+		assert.Equal(t,
+			"[0:a]"+stitchSampleFormat+"[a0];"+
+				"[1:a]"+stitchSampleFormat+"[a1];"+
+				"[a0][a1]acrossfade=d=0.800:c1=tri:c2=tri[out]",
+			result,
+		)
+	})
+}
+
+func TestBuildTranscodeArgs(t *testing.T) {
+	t.Run("MP3 は libmp3lame でエンコードする", func(t *testing.T) {
+		args, err := buildTranscodeArgs(model.AudioFormatMP3, 128)
+
+		assert.NoError(t, err)
+		assert.Equal(t, []string{"-c:a", "libmp3lame", "-b:a", "128k", "-f", "mp3"}, args)
+	})
+
+	t.Run("AAC は faststart 付きの MP4 コンテナに格納する", func(t *testing.T) {
+		args, err := buildTranscodeArgs(model.AudioFormatAAC, 64)
+
+		assert.NoError(t, err)
+		assert.Equal(t, []string{"-c:a", "aac", "-b:a", "64k", "-movflags", "+faststart", "-f", "ipod"}, args)
+	})
+
+	t.Run("Opus は Ogg コンテナに格納する", func(t *testing.T) {
+		args, err := buildTranscodeArgs(model.AudioFormatOpus, 32)
+
+		assert.NoError(t, err)
+		assert.Equal(t, []string{"-c:a", "libopus", "-b:a", "32k", "-f", "ogg"}, args)
+	})
+
+	t.Run("未対応のフォーマットの場合はエラーを返す", func(t *testing.T) {
+		_, err := buildTranscodeArgs(model.AudioFormat("flac"), 128)
+
+		assert.Error(t, err)
+	})
+
+	t.Run("ビットレートが 0 以下の場合はエラーを返す", func(t *testing.T) {
+		_, err := buildTranscodeArgs(model.AudioFormatMP3, 0)
+
+		assert.Error(t, err)
+	})
+}
+
+func TestHLSSegmentURIs(t *testing.T) {
+	t.Run("タグ行と空行を除いたセグメント URI を記載順に返す", func(t *testing.T) {
+		playlist := []byte("#EXTM3U\n#EXT-X-VERSION:3\n#EXT-X-TARGETDURATION:6\n#EXTINF:6.000000,\nsegment_00000.ts\n#EXTINF:2.500000,\nsegment_00001.ts\n#EXT-X-ENDLIST\n")
+
+		assert.Equal(t, []string{"segment_00000.ts", "segment_00001.ts"}, hlsSegmentURIs(playlist))
+	})
+
+	t.Run("セグメントがない場合は nil を返す", func(t *testing.T) {
+		assert.Nil(t, hlsSegmentURIs([]byte("#EXTM3U\n#EXT-X-ENDLIST\n")))
+	})
+}
+
+func TestRewriteHLSPlaylist(t *testing.T) {
+	t.Run("セグメント URI のみを置き換える", func(t *testing.T) {
+		playlist := []byte("#EXTM3U\n#EXTINF:6.000000,\nsegment_00000.ts\n#EXTINF:6.000000,\nsegment_00001.ts\n#EXT-X-ENDLIST\n")
+
+		result, err := rewriteHLSPlaylist(playlist, func(uri string) (string, error) {
+			return "https://example.com/hls/" + uri + "?sig=abc", nil
+		})
+
+		assert.NoError(t, err)
+		assert.Equal(t, "#EXTM3U\n#EXTINF:6.000000,\nhttps://example.com/hls/segment_00000.ts?sig=abc\n#EXTINF:6.000000,\nhttps://example.com/hls/segment_00001.ts?sig=abc\n#EXT-X-ENDLIST\n", string(result))
+	})
+
+	t.Run("置き換えに失敗した場合はエラーを返す", func(t *testing.T) {
+		playlist := []byte("#EXTM3U\nsegment_00000.ts\n")
+
+		result, err := rewriteHLSPlaylist(playlist, func(uri string) (string, error) {
+			return "", assert.AnError
+		})
+
+		assert.ErrorIs(t, err, assert.AnError)
+		assert.Nil(t, result)
+	})
+}
+
+func TestCalcAudiogramLayout(t *testing.T) {
+	t.Run("正方形の動画ではアートワークの下に波形を配置する", func(t *testing.T) {
+		layout := calcAudiogramLayout(1080, 1080)
+
+		assert.Equal(t, audiogramLayout{
+			ArtworkSize: 648,
+			ArtworkY:    74,
+			WaveWidth:   864,
+			WaveHeight:  134,
+			WaveY:       748,
+		}, layout)
+	})
+
+	t.Run("すべての値が偶数になる", func(t *testing.T) {
+		for _, ratio := range []model.ClipAspectRatio{model.ClipAspectRatioSquare, model.ClipAspectRatioPortrait, model.ClipAspectRatioLandscape} {
+			layout := calcAudiogramLayout(ratio.Size())
+
+			for _, v := range []int{layout.ArtworkSize, layout.ArtworkY, layout.WaveWidth, layout.WaveHeight, layout.WaveY} {
+				assert.Zero(t, v%2, "ratio=%s", ratio)
+			}
+		}
+	})
+
+	t.Run("波形が画面内に収まる", func(t *testing.T) {
+		for _, ratio := range []model.ClipAspectRatio{model.ClipAspectRatioSquare, model.ClipAspectRatioPortrait, model.ClipAspectRatioLandscape} {
+			width, height := ratio.Size()
+			layout := calcAudiogramLayout(width, height)
+
+			assert.LessOrEqual(t, layout.WaveY+layout.WaveHeight, height, "ratio=%s", ratio)
+			assert.LessOrEqual(t, layout.WaveWidth, width, "ratio=%s", ratio)
+		}
+	})
+}
+
+func TestBuildAudiogramFilterComplex(t *testing.T) {
+	t.Run("アートワークがある場合はぼかした背景の上にアートワークと波形を重ねる", func(t *testing.T) {
+		params := AudiogramParams{
+			Artwork: strings.NewReader("image"),
+			Width:   1080,
+			Height:  1080,
+		}
+
+		result := buildAudiogramFilterComplex(params)
+
+		assert.Equal(t,
+			"[0:v]split=2[bgsrc][artsrc];"+
+				"[bgsrc]scale=1080:1080:force_original_aspect_ratio=increase,crop=1080:1080,boxblur=20:2,eq=brightness=-0.25,setsar=1[bg];"+
+				"[artsrc]scale=648:648,setsar=1[art];"+
+				"[bg][art]overlay=(W-w)/2:74[base];"+
+				"[1:a]asplit=2[aout][awave];"+
+				"[awave]showwaves=s=864x134:mode=cline:rate=30:colors=white,format=rgba[wave];"+
+				"[base][wave]overlay=(W-w)/2:748:shortest=1[vout]",
+			result,
+		)
+	})
+
+	t.Run("アートワークがない場合は背景をそのまま使う", func(t *testing.T) {
+		params := AudiogramParams{Width: 1080, Height: 1080}
+
+		result := buildAudiogramFilterComplex(params)
+
+		assert.True(t, strings.HasPrefix(result, "[0:v]null[base];"))
+		assert.NotContains(t, result, "[artsrc]")
+	})
+
+	t.Run("字幕がある場合は波形の後に字幕を焼き込む", func(t *testing.T) {
+		params := AudiogramParams{
+			Width:    1080,
+			Height:   1920,
+			Captions: []AudiogramCaption{{StartMs: 0, EndMs: 1000, Text: "こんにちは"}},
+		}
+
+		result := buildAudiogramFilterComplex(params)
+
+		assert.Contains(t, result, "[waved]subtitles=captions.srt:force_style='FontName=Noto Sans CJK JP,")
+		assert.True(t, strings.HasSuffix(result, "'[vout]"))
+	})
+}
+
+func TestBuildSRT(t *testing.T) {
+	t.Run("字幕を連番付きの SRT に変換する", func(t *testing.T) {
+		captions := []AudiogramCaption{
+			{StartMs: 0, EndMs: 1500, Text: "こんにちは"},
+			{StartMs: 1500, EndMs: 62250, Text: " 今日のテーマは\n音声です "},
+		}
+
+		result := buildSRT(captions)
+
+		assert.Equal(t,
+			"1\n00:00:00,000 --> 00:00:01,500\nこんにちは\n\n"+
+				"2\n00:00:01,500 --> 00:01:02,250\n今日のテーマは\n音声です\n\n",
+			result,
+		)
+	})
+
+	t.Run("表示区間やテキストが空の字幕は除外して連番を詰める", func(t *testing.T) {
+		captions := []AudiogramCaption{
+			{StartMs: 1000, EndMs: 1000, Text: "ゼロ長"},
+			{StartMs: 1000, EndMs: 2000, Text: "  "},
+			{StartMs: 2000, EndMs: 3000, Text: "有効"},
+		}
+
+		result := buildSRT(captions)
+
+		assert.Equal(t, "1\n00:00:02,000 --> 00:00:03,000\n有効\n\n", result)
+	})
+}
+
+func TestFormatSRTTime(t *testing.T) {
+	tests := []struct {
+		ms       int
+		expected string
+	}{
+		{0, "00:00:00,000"},
+		{1234, "00:00:01,234"},
+		{3723004, "01:02:03,004"},
+		{-10, "00:00:00,000"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.expected, func(t *testing.T) {
+			assert.Equal(t, tt.expected, formatSRTTime(tt.ms))
+		})
+	}
+}
+
 func TestNewFFmpegService(t *testing.T) {
 	t.Run("FFmpegService を作成できる", func(t *testing.T) {
 		service := NewFFmpegService()
@@ -74,8 +439,8 @@ func TestFFmpegService_MixAudioWithBGM(t *testing.T) {
 
 	t.Run("空の音声データの場合はエラーを返す", func(t *testing.T) {
 		params := MixParams{
-			VoiceData:       []byte{},
-			BGMData:         []byte{},
+			Voice:           bytes.NewReader(nil),
+			BGM:             bytes.NewReader(nil),
 			VoiceDurationMs: 1000,
 			BGMVolumeDB:     -20.0,
 			FadeOutMs:       3000,
@@ -83,15 +448,15 @@ func TestFFmpegService_MixAudioWithBGM(t *testing.T) {
 			PaddingEndMs:    3000,
 		}
 
-		_, err := service.MixAudioWithBGM(ctx, params)
+		err := service.MixAudioWithBGM(ctx, params, io.Discard)
 
 		assert.Error(t, err)
 	})
 
 	t.Run("無効な音声データの場合はエラーを返す", func(t *testing.T) {
 		params := MixParams{
-			VoiceData:       []byte("invalid audio data"),
-			BGMData:         []byte("invalid bgm data"),
+			Voice:           strings.NewReader("invalid audio data"),
+			BGM:             strings.NewReader("invalid bgm data"),
 			VoiceDurationMs: 1000,
 			BGMVolumeDB:     -20.0,
 			FadeOutMs:       3000,
@@ -99,7 +464,7 @@ func TestFFmpegService_MixAudioWithBGM(t *testing.T) {
 			PaddingEndMs:    3000,
 		}
 
-		_, err := service.MixAudioWithBGM(ctx, params)
+		err := service.MixAudioWithBGM(ctx, params, io.Discard)
 
 		assert.Error(t, err)
 	})
@@ -108,8 +473,8 @@ func TestFFmpegService_MixAudioWithBGM(t *testing.T) {
 func TestMixParams(t *testing.T) {
 	t.Run("MixParams にデフォルト値を設定できる", func(t *testing.T) {
 		params := MixParams{
-			VoiceData:       []byte("voice"),
-			BGMData:         []byte("bgm"),
+			Voice:           strings.NewReader("voice"),
+			BGM:             strings.NewReader("bgm"),
 			VoiceDurationMs: 5000,
 			BGMVolumeDB:     -20.0,
 			FadeOutMs:       3000,
@@ -44,6 +44,14 @@ func (m *mockImageRepository) FindOrphaned(ctx context.Context) ([]model.Image,
 	return args.Get(0).([]model.Image), args.Error(1)
 }
 
+func (m *mockImageRepository) FindAll(ctx context.Context) ([]model.Image, error) {
+	args := m.Called(ctx)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).([]model.Image), args.Error(1)
+}
+
 func TestUploadImage(t *testing.T) {
 	ctx := context.Background()
 
@@ -0,0 +1,785 @@
+package service
+
+import (
+	"bytes"
+	"context"
+	"crypto/sha256"
+	"encoding/hex"
+	"errors"
+	"fmt"
+	"html"
+	"io"
+	"mime"
+	"net/http"
+	"net/url"
+	"os"
+	"path"
+	"regexp"
+	"strings"
+	"time"
+	"unicode/utf8"
+
+	"github.com/siropaca/anycast-backend/internal/apperror"
+	"github.com/siropaca/anycast-backend/internal/dto/request"
+	"github.com/siropaca/anycast-backend/internal/dto/response"
+	"github.com/siropaca/anycast-backend/internal/infrastructure/cloudtasks"
+	"github.com/siropaca/anycast-backend/internal/infrastructure/slack"
+	"github.com/siropaca/anycast-backend/internal/infrastructure/websocket"
+	"github.com/siropaca/anycast-backend/internal/model"
+	"github.com/siropaca/anycast-backend/internal/pkg/logger"
+	"github.com/siropaca/anycast-backend/internal/pkg/podcastfeed"
+	"github.com/siropaca/anycast-backend/internal/pkg/uuid"
+	"github.com/siropaca/anycast-backend/internal/repository"
+)
+
+const (
+	// maxImportFeedSize は取り込むフィード XML の最大サイズ（10MB）
+	maxImportFeedSize = 10 * 1024 * 1024
+	// maxImportEnclosureSize は取り込む音声ファイルの最大サイズ（200MB）
+	//
+	// 長尺の番組も取り込めるよう、通常のアップロード上限（maxAudioFileSize）より大きくしている
+	maxImportEnclosureSize = 200 * 1024 * 1024
+	// maxImportArtworkSize は取り込むアートワークの最大サイズ（10MB）
+	maxImportArtworkSize = 10 * 1024 * 1024
+	// maxImportSourceGUIDLength は episodes.source_guid に保存できる GUID の最大バイト数
+	maxImportSourceGUIDLength = 512
+	// maxImportDescriptionLength はエピソードの説明の最大文字数（エピソード作成 API と同じ）
+	maxImportDescriptionLength = 2000
+	// maxImportTitleLength はエピソードのタイトルの最大文字数
+	maxImportTitleLength = 255
+	// defaultImportEpisodeTitle はタイトルのない item に付けるタイトル
+	defaultImportEpisodeTitle = "無題のエピソード"
+)
+
+// importAudioMimeTypesByExt は Content-Type が不明な場合に拡張子から推定する音声の MIME タイプ
+var importAudioMimeTypesByExt = map[string]string{
+	".mp3": "audio/mpeg",
+	".m4a": "audio/mp4",
+	".mp4": "audio/mp4",
+	".aac": "audio/aac",
+	".wav": "audio/wav",
+	".ogg": "audio/ogg",
+}
+
+// importImageMimeTypesByExt は Content-Type が不明な場合に拡張子から推定する画像の MIME タイプ
+var importImageMimeTypesByExt = map[string]string{
+	".png":  "image/png",
+	".jpg":  "image/jpeg",
+	".jpeg": "image/jpeg",
+	".gif":  "image/gif",
+	".webp": "image/webp",
+}
+
+var (
+	htmlLineBreakPattern = regexp.MustCompile(`(?i)<br\s*/?>|</p>|</li>|</div>`)
+	htmlTagPattern       = regexp.MustCompile(`<[^>]*>`)
+	blankLinesPattern    = regexp.MustCompile(`\n{3,}`)
+)
+
+// ImportJobService は外部フィードからのポッドキャスト取り込みジョブを管理するインターフェースを表す
+type ImportJobService interface {
+	CreateJob(ctx context.Context, userID, channelID string, req request.CreateImportJobRequest) (*response.ImportJobResponse, error)
+	GetJob(ctx context.Context, userID, jobID string) (*response.ImportJobResponse, error)
+	ListMyJobs(ctx context.Context, userID string) (*response.ImportJobListResponse, error)
+	ExecuteJob(ctx context.Context, jobID string) error
+}
+
+type importJobService struct {
+	importJobRepo   repository.ImportJobRepository
+	channelRepo     repository.ChannelRepository
+	episodeRepo     repository.EpisodeRepository
+	audioService    AudioService
+	imageService    ImageService
+	audioJobService AudioJobService
+	httpClient      *http.Client
+	tasksClient     cloudtasks.Client
+	wsHub           *websocket.Hub
+	slackClient     slack.Client
+}
+
+// NewImportJobService は importJobService を生成して ImportJobService として返す
+//
+// httpClient はフィード・音声・アートワークの取得に使う。ユーザーが指定した URL に
+// アクセスするため、safehttp.NewClient で作成した SSRF 対策済みのクライアントを渡す
+func NewImportJobService(
+	importJobRepo repository.ImportJobRepository,
+	channelRepo repository.ChannelRepository,
+	episodeRepo repository.EpisodeRepository,
+	audioService AudioService,
+	imageService ImageService,
+	audioJobService AudioJobService,
+	httpClient *http.Client,
+	tasksClient cloudtasks.Client,
+	wsHub *websocket.Hub,
+	slackClient slack.Client,
+) ImportJobService {
+	return &importJobService{
+		importJobRepo:   importJobRepo,
+		channelRepo:     channelRepo,
+		episodeRepo:     episodeRepo,
+		audioService:    audioService,
+		imageService:    imageService,
+		audioJobService: audioJobService,
+		httpClient:      httpClient,
+		tasksClient:     tasksClient,
+		wsHub:           wsHub,
+		slackClient:     slackClient,
+	}
+}
+
+// CreateJob はチャンネルへの取り込みジョブを作成して返す
+func (s *importJobService) CreateJob(ctx context.Context, userID, channelID string, req request.CreateImportJobRequest) (*response.ImportJobResponse, error) {
+	log := logger.FromContext(ctx)
+
+	uid, err := uuid.Parse(userID)
+	if err != nil {
+		return nil, err
+	}
+
+	cid, err := uuid.Parse(channelID)
+	if err != nil {
+		return nil, err
+	}
+
+	feedURL, err := url.Parse(req.FeedURL)
+	if err != nil || (feedURL.Scheme != "http" && feedURL.Scheme != "https") || feedURL.Host == "" {
+		return nil, apperror.ErrValidation.WithMessage("フィードの URL は http または https で指定してください")
+	}
+
+	// チャンネルの存在確認とオーナーチェック
+	channel, err := s.channelRepo.FindByID(ctx, cid)
+	if err != nil {
+		return nil, err
+	}
+
+	if channel.UserID != uid {
+		return nil, apperror.ErrForbidden.WithMessage("このチャンネルへの取り込み権限がありません")
+	}
+
+	// 既存の処理中ジョブを確認
+	activeJob, err := s.importJobRepo.FindActiveByChannelID(ctx, cid)
+	if err != nil {
+		return nil, err
+	}
+	if activeJob != nil {
+		return nil, apperror.ErrValidation.WithMessage("このチャンネルは既に取り込み中です")
+	}
+
+	job := &model.ImportJob{
+		UserID:    uid,
+		ChannelID: cid,
+		FeedURL:   feedURL.String(),
+		Status:    model.ImportJobStatusPending,
+	}
+
+	if err := s.importJobRepo.Create(ctx, job); err != nil {
+		return nil, err
+	}
+	job.Channel = *channel
+
+	// Cloud Tasks が設定されている場合はエンキュー、そうでなければ goroutine で直接実行
+	if s.tasksClient != nil {
+		if err := s.tasksClient.EnqueueImportJob(ctx, job.ID.String()); err != nil {
+			log.Error("failed to enqueue import job", "error", err, "job_id", job.ID)
+			// エンキュー失敗時はジョブを失敗状態に更新（ベストエフォート）
+			job.Status = model.ImportJobStatusFailed
+			errMsg := "タスクのエンキューに失敗しました"
+			errCode := "ENQUEUE_FAILED"
+			job.ErrorMessage = &errMsg
+			job.ErrorCode = &errCode
+			_ = s.importJobRepo.Update(ctx, job) //nolint:errcheck // best effort cleanup
+			return nil, apperror.ErrInternal.WithMessage("取り込みタスクの登録に失敗しました").WithError(err)
+		}
+		log.Info("import job created and enqueued", "job_id", job.ID, "channel_id", cid)
+	} else {
+		// ローカル開発モード: goroutine で直接実行
+		log.Info("executing import job directly as Cloud Tasks is not configured", "job_id", job.ID, "channel_id", cid)
+		go func() {
+			if err := s.ExecuteJob(context.Background(), job.ID.String()); err != nil {
+				log.Error("failed to execute local import job", "error", err, "job_id", job.ID)
+			}
+		}()
+	}
+
+	resp := toImportJobResponse(job)
+	return &resp, nil
+}
+
+// GetJob は指定されたジョブの詳細を取得する
+func (s *importJobService) GetJob(ctx context.Context, userID, jobID string) (*response.ImportJobResponse, error) {
+	uid, err := uuid.Parse(userID)
+	if err != nil {
+		return nil, err
+	}
+
+	jid, err := uuid.Parse(jobID)
+	if err != nil {
+		return nil, err
+	}
+
+	job, err := s.importJobRepo.FindByID(ctx, jid)
+	if err != nil {
+		return nil, err
+	}
+
+	// オーナーチェック
+	if job.UserID != uid {
+		return nil, apperror.ErrForbidden.WithMessage("このジョブへのアクセス権限がありません")
+	}
+
+	resp := toImportJobResponse(job)
+	return &resp, nil
+}
+
+// ListMyJobs は指定されたユーザーのジョブ一覧を取得する
+func (s *importJobService) ListMyJobs(ctx context.Context, userID string) (*response.ImportJobListResponse, error) {
+	uid, err := uuid.Parse(userID)
+	if err != nil {
+		return nil, err
+	}
+
+	jobs, err := s.importJobRepo.FindByUserID(ctx, uid)
+	if err != nil {
+		return nil, err
+	}
+
+	responses := make([]response.ImportJobResponse, len(jobs))
+	for i := range jobs {
+		responses[i] = toImportJobResponse(&jobs[i])
+	}
+
+	return &response.ImportJobListResponse{
+		Data: responses,
+	}, nil
+}
+
+// ExecuteJob は指定されたジョブを実行する（Cloud Tasks ワーカーから呼び出される）
+//
+// 取り込み済みの item は episodes.source_guid で判定してスキップするため、
+// 途中で中断されたジョブを再実行しても同じエピソードが重複して作成されることはない
+func (s *importJobService) ExecuteJob(ctx context.Context, jobID string) error {
+	log := logger.FromContext(ctx)
+
+	jid, err := uuid.Parse(jobID)
+	if err != nil {
+		return err
+	}
+
+	job, err := s.importJobRepo.FindByID(ctx, jid)
+	if err != nil {
+		return err
+	}
+
+	// 既に完了または失敗している場合はスキップ
+	if job.Status == model.ImportJobStatusCompleted || job.Status == model.ImportJobStatusFailed {
+		log.Info("skipping import job as it is already completed", "job_id", jobID, "status", job.Status)
+		return nil
+	}
+
+	// 処理開始（リトライ時は開始日時と件数を引き継がずにやり直す）
+	now := time.Now().UTC()
+	job.Status = model.ImportJobStatusProcessing
+	job.StartedAt = &now
+	job.Progress = 0
+	job.TotalEpisodes = 0
+	job.ImportedEpisodes = 0
+	job.SkippedEpisodes = 0
+	job.FailedEpisodes = 0
+	if err := s.importJobRepo.Update(ctx, job); err != nil {
+		return err
+	}
+
+	s.notifyProgress(job, "フィードを取得しています...")
+
+	if err := s.executeJobInternal(ctx, job); err != nil {
+		log.Error("failed to execute import job", "error", err, "job_id", jobID)
+		s.failJob(ctx, job, err)
+		return err
+	}
+
+	completedAt := time.Now().UTC()
+	job.Status = model.ImportJobStatusCompleted
+	job.Progress = 100
+	job.CompletedAt = &completedAt
+	if err := s.importJobRepo.Update(ctx, job); err != nil {
+		return err
+	}
+
+	s.notifyCompleted(job)
+
+	log.Info("import job completed successfully",
+		"job_id", job.ID,
+		"total", job.TotalEpisodes,
+		"imported", job.ImportedEpisodes,
+		"skipped", job.SkippedEpisodes,
+		"failed", job.FailedEpisodes,
+	)
+
+	return nil
+}
+
+// executeJobInternal はフィードを取得してエピソードを取り込む
+//
+// フィードの取得・解析に失敗した場合はエラーを返す。個々の item の取り込みに失敗した場合は
+// 失敗件数に数えて次の item に進む
+func (s *importJobService) executeJobInternal(ctx context.Context, job *model.ImportJob) error {
+	log := logger.FromContext(ctx)
+
+	body, _, err := s.download(ctx, job.FeedURL, maxImportFeedSize)
+	if err != nil {
+		return apperror.ErrValidation.WithMessage("フィードを取得できませんでした").WithError(err)
+	}
+
+	feed, err := podcastfeed.Parse(bytes.NewReader(body))
+	if err != nil {
+		return apperror.ErrValidation.WithMessage("フィードを解析できませんでした。RSS 2.0 または Atom 形式のフィードを指定してください").WithError(err)
+	}
+
+	job.TotalEpisodes = len(feed.Items)
+	s.updateProgress(ctx, job, 5, "フィードを取得しました")
+
+	// チャンネルにアートワークがない場合はフィードのアートワークを設定する
+	s.importChannelArtwork(ctx, job, feed.ImageURL)
+
+	existing, err := s.episodeRepo.FindSourceGUIDsByChannelID(ctx, job.ChannelID)
+	if err != nil {
+		return err
+	}
+	imported := make(map[string]bool, len(existing))
+	for _, guid := range existing {
+		imported[guid] = true
+	}
+
+	// フィードは新しい順に並んでいることが多いため、古い順に取り込んで作成日時の順序を揃える
+	for i := len(feed.Items) - 1; i >= 0; i-- {
+		if err := ctx.Err(); err != nil {
+			return apperror.ErrCanceled.WithError(err)
+		}
+
+		item := feed.Items[i]
+		done := len(feed.Items) - i
+		guid := importSourceGUID(item.GUID)
+
+		switch {
+		case item.Enclosure.URL == "":
+			// 音声のない item は取り込まない
+			job.SkippedEpisodes++
+		case imported[guid]:
+			job.SkippedEpisodes++
+		default:
+			if err := s.importItem(ctx, job.UserID, job.ChannelID, guid, item, feed.ImageURL); err != nil {
+				log.Warn("failed to import feed item", "error", err, "job_id", job.ID, "guid", item.GUID)
+				job.FailedEpisodes++
+			} else {
+				imported[guid] = true
+				job.ImportedEpisodes++
+			}
+		}
+
+		progress := 5 + done*90/len(feed.Items)
+		s.updateProgress(ctx, job, progress, fmt.Sprintf("エピソードを取り込んでいます（%d / %d）", done, len(feed.Items)))
+	}
+
+	return nil
+}
+
+// importItem はフィードの item を 1 件エピソードとして取り込む
+//
+// 音声をアップロードした場合と同様に、取り込んだ音声から配信用音声を生成し、
+// エピソード情報で ID3 タグを書き換えるジョブを登録する
+func (s *importJobService) importItem(ctx context.Context, userID, channelID uuid.UUID, guid string, item podcastfeed.Item, feedImageURL string) error {
+	log := logger.FromContext(ctx)
+
+	// 音声は最大 200MB あるため、メモリに読み込まずに作業ディレクトリのファイルにダウンロードしてからアップロードする
+	ws, err := newAudioWorkspace(channelID)
+	if err != nil {
+		return err
+	}
+	defer func() {
+		if err := ws.Close(); err != nil {
+			log.Warn("failed to remove import workspace", "error", err)
+		}
+	}()
+
+	var contentType string
+	enclosurePath, err := ws.create("enclosure", func(w io.Writer) error {
+		var err error
+		contentType, err = s.downloadTo(ctx, item.Enclosure.URL, maxImportEnclosureSize, w)
+		return err
+	})
+	if err != nil {
+		return err
+	}
+
+	filename := importFilename(item.Enclosure.URL)
+	audioType := resolveImportMimeType(importAudioMimeTypesByExt, allowedAudioMimeTypes, filename, item.Enclosure.Type, contentType)
+	var audioResp *response.AudioUploadDataResponse
+	err = withFile(enclosurePath, func(f *os.File) error {
+		info, err := f.Stat()
+		if err != nil {
+			return err
+		}
+
+		audioResp, err = s.audioService.UploadAudio(ctx, UploadAudioInput{
+			File:        f,
+			Filename:    filename,
+			ContentType: audioType,
+			FileSize:    int(info.Size()),
+		})
+		return err
+	})
+	if err != nil {
+		return err
+	}
+	audioID := audioResp.Data.ID
+
+	// チャンネルと同じアートワークはエピソードに設定しない
+	var artworkID *uuid.UUID
+	if item.ImageURL != "" && item.ImageURL != feedImageURL {
+		artworkID = s.importImage(ctx, item.ImageURL)
+	}
+
+	episode := &model.Episode{
+		ChannelID:   channelID,
+		Title:       importTitle(item.Title),
+		Description: importDescription(item.Description),
+		ArtworkID:   artworkID,
+		FullAudioID: &audioID,
+		SourceGUID:  &guid,
+	}
+	if !item.PubDate.IsZero() {
+		publishedAt := item.PubDate
+		episode.PublishedAt = &publishedAt
+	}
+
+	if err := s.episodeRepo.Create(ctx, episode); err != nil {
+		return err
+	}
+
+	// 配信用音声の生成・ID3 タグの書き換えは内部ジョブで行う（ジョブの登録に失敗しても取り込み自体は成功とする）
+	// タグの書き換えジョブは配信用音声の生成ジョブの終了後に実行され、フィードの元のタグを置き換える
+	if err := s.audioJobService.CreateRenditionJob(ctx, userID, episode.ID); err != nil {
+		log.Warn("failed to create rendition job for imported episode", "error", err, "episode_id", episode.ID)
+	}
+	if err := s.audioJobService.CreateRetagJob(ctx, userID, episode.ID); err != nil {
+		log.Warn("failed to create retag job for imported episode", "error", err, "episode_id", episode.ID)
+	}
+
+	return nil
+}
+
+// importChannelArtwork はチャンネルにアートワークが設定されていない場合にフィードのアートワークを設定する
+//
+// 失敗しても取り込み自体は続行する
+func (s *importJobService) importChannelArtwork(ctx context.Context, job *model.ImportJob, imageURL string) {
+	if imageURL == "" {
+		return
+	}
+
+	channel, err := s.channelRepo.FindByID(ctx, job.ChannelID)
+	if err != nil || channel.ArtworkID != nil {
+		return
+	}
+
+	artworkID := s.importImage(ctx, imageURL)
+	if artworkID == nil {
+		return
+	}
+
+	channel.ArtworkID = artworkID
+	channel.Artwork = nil
+	if err := s.channelRepo.Update(ctx, channel); err != nil {
+		logger.FromContext(ctx).Warn("failed to set imported channel artwork", "error", err, "channel_id", channel.ID)
+	}
+}
+
+// importImage は画像をダウンロードしてアップロードし、画像 ID を返す
+//
+// 失敗した場合は nil を返す（アートワークは取り込めなくてもエピソードの取り込みは続行する）
+func (s *importJobService) importImage(ctx context.Context, imageURL string) *uuid.UUID {
+	log := logger.FromContext(ctx)
+
+	data, contentType, err := s.download(ctx, imageURL, maxImportArtworkSize)
+	if err != nil {
+		log.Warn("failed to download artwork", "error", err, "url", imageURL)
+		return nil
+	}
+
+	filename := importFilename(imageURL)
+	imageResp, err := s.imageService.UploadImage(ctx, UploadImageInput{
+		File:        bytes.NewReader(data),
+		Filename:    filename,
+		ContentType: resolveImportMimeType(importImageMimeTypesByExt, allowedImageMimeTypes, filename, contentType),
+		FileSize:    len(data),
+	})
+	if err != nil {
+		log.Warn("failed to upload artwork", "error", err, "url", imageURL)
+		return nil
+	}
+
+	return &imageResp.Data.ID
+}
+
+// download は URL の内容を maxSize バイトまで取得し、本文と Content-Type を返す
+func (s *importJobService) download(ctx context.Context, rawURL string, maxSize int64) ([]byte, string, error) {
+	var buf bytes.Buffer
+	contentType, err := s.downloadTo(ctx, rawURL, maxSize, &buf)
+	if err != nil {
+		return nil, "", err
+	}
+
+	return buf.Bytes(), contentType, nil
+}
+
+// downloadTo は URL の内容を maxSize バイトまで w にストリームで書き込み、Content-Type を返す
+//
+// maxSize を超えた場合はエラーを返す（w には途中までの内容が書き込まれている）
+func (s *importJobService) downloadTo(ctx context.Context, rawURL string, maxSize int64, w io.Writer) (string, error) {
+	u, err := url.Parse(rawURL)
+	if err != nil || (u.Scheme != "http" && u.Scheme != "https") {
+		return "", fmt.Errorf("unsupported url: %s", rawURL)
+	}
+
+	req, err := http.NewRequestWithContext(ctx, http.MethodGet, u.String(), http.NoBody)
+	if err != nil {
+		return "", err
+	}
+	req.Header.Set("User-Agent", "Anycast-Importer/1.0")
+
+	resp, err := s.httpClient.Do(req)
+	if err != nil {
+		return "", err
+	}
+	defer func() { _ = resp.Body.Close() }()
+
+	if resp.StatusCode < 200 || resp.StatusCode >= 300 {
+		return "", fmt.Errorf("unexpected status %d from %s", resp.StatusCode, u.Redacted())
+	}
+
+	if resp.ContentLength > maxSize {
+		return "", fmt.Errorf("content too large: %d bytes", resp.ContentLength)
+	}
+
+	n, err := io.Copy(w, io.LimitReader(resp.Body, maxSize+1))
+	if err != nil {
+		return "", err
+	}
+	if n > maxSize {
+		return "", fmt.Errorf("content too large: exceeds %d bytes", maxSize)
+	}
+
+	return resp.Header.Get("Content-Type"), nil
+}
+
+// updateProgress は進捗と件数を保存して WebSocket で通知する
+func (s *importJobService) updateProgress(ctx context.Context, job *model.ImportJob, progress int, message string) {
+	job.Progress = progress
+	if err := s.importJobRepo.Update(ctx, job); err != nil {
+		logger.FromContext(ctx).Warn("failed to update import job progress", "error", err, "job_id", job.ID)
+	}
+	s.notifyProgress(job, message)
+}
+
+// failJob はジョブを失敗状態に更新する
+func (s *importJobService) failJob(ctx context.Context, job *model.ImportJob, err error) {
+	log := logger.FromContext(ctx)
+	completedAt := time.Now().UTC()
+	job.Status = model.ImportJobStatusFailed
+	job.CompletedAt = &completedAt
+
+	var appErr *apperror.AppError
+	if ok := errors.As(err, &appErr); ok {
+		errCode := string(appErr.Code)
+		job.ErrorCode = &errCode
+		job.ErrorMessage = &appErr.Message
+	} else {
+		errCode := "INTERNAL_ERROR"
+		errMsg := "内部エラーが発生しました"
+		job.ErrorCode = &errCode
+		job.ErrorMessage = &errMsg
+	}
+
+	_ = s.importJobRepo.Update(ctx, job) //nolint:errcheck // fail update is best effort
+	s.notifyFailed(job)
+
+	// Slack アラート通知（ベストエフォート）
+	if s.slackClient != nil {
+		if alertErr := s.slackClient.SendAlert(ctx, slack.AlertNotification{
+			JobID:        job.ID.String(),
+			JobType:      "ポッドキャスト取り込み (Import)",
+			ErrorCode:    *job.ErrorCode,
+			ErrorMessage: *job.ErrorMessage,
+			OccurredAt:   completedAt,
+		}); alertErr != nil {
+			log.Warn("failed to send slack alert for import job", "error", alertErr, "job_id", job.ID)
+		}
+	}
+}
+
+// notifyProgress は進捗を WebSocket で通知する
+func (s *importJobService) notifyProgress(job *model.ImportJob, message string) {
+	if s.wsHub == nil {
+		return
+	}
+	s.wsHub.SendToUser(job.UserID.String(), websocket.Message{
+		Type: "import_progress",
+		Payload: map[string]any{
+			"jobId":            job.ID.String(),
+			"progress":         job.Progress,
+			"message":          message,
+			"totalEpisodes":    job.TotalEpisodes,
+			"importedEpisodes": job.ImportedEpisodes,
+		},
+	})
+}
+
+// notifyCompleted はジョブの完了を WebSocket で通知する
+func (s *importJobService) notifyCompleted(job *model.ImportJob) {
+	if s.wsHub == nil {
+		return
+	}
+	s.wsHub.SendToUser(job.UserID.String(), websocket.Message{
+		Type: "import_completed",
+		Payload: map[string]any{
+			"jobId":            job.ID.String(),
+			"channelId":        job.ChannelID.String(),
+			"totalEpisodes":    job.TotalEpisodes,
+			"importedEpisodes": job.ImportedEpisodes,
+			"skippedEpisodes":  job.SkippedEpisodes,
+			"failedEpisodes":   job.FailedEpisodes,
+		},
+	})
+}
+
+// notifyFailed はジョブの失敗を WebSocket で通知する
+func (s *importJobService) notifyFailed(job *model.ImportJob) {
+	if s.wsHub == nil {
+		return
+	}
+	code := ""
+	msg := ""
+	if job.ErrorCode != nil {
+		code = *job.ErrorCode
+	}
+	if job.ErrorMessage != nil {
+		msg = *job.ErrorMessage
+	}
+	s.wsHub.SendToUser(job.UserID.String(), websocket.Message{
+		Type: "import_failed",
+		Payload: map[string]any{
+			"jobId":        job.ID.String(),
+			"errorCode":    code,
+			"errorMessage": msg,
+		},
+	})
+}
+
+// toImportJobResponse は取り込みジョブをレスポンスに変換する
+func toImportJobResponse(job *model.ImportJob) response.ImportJobResponse {
+	return response.ImportJobResponse{
+		ID: job.ID,
+		Channel: response.ImportJobChannelResponse{
+			ID:   job.ChannelID,
+			Name: job.Channel.Name,
+		},
+		FeedURL:          job.FeedURL,
+		Status:           string(job.Status),
+		Progress:         job.Progress,
+		TotalEpisodes:    job.TotalEpisodes,
+		ImportedEpisodes: job.ImportedEpisodes,
+		SkippedEpisodes:  job.SkippedEpisodes,
+		FailedEpisodes:   job.FailedEpisodes,
+		ErrorMessage:     job.ErrorMessage,
+		ErrorCode:        job.ErrorCode,
+		StartedAt:        job.StartedAt,
+		CompletedAt:      job.CompletedAt,
+		CreatedAt:        job.CreatedAt,
+		UpdatedAt:        job.UpdatedAt,
+	}
+}
+
+// importSourceGUID は item の GUID を episodes.source_guid に保存する値に変換する
+//
+// 列の長さを超える GUID は SHA-256 のハッシュ値で代用する
+func importSourceGUID(guid string) string {
+	if len(guid) <= maxImportSourceGUIDLength {
+		return guid
+	}
+	sum := sha256.Sum256([]byte(guid))
+	return "sha256:" + hex.EncodeToString(sum[:])
+}
+
+// importTitle は item のタイトルをエピソードのタイトルに変換する
+func importTitle(title string) string {
+	title = strings.TrimSpace(html.UnescapeString(title))
+	if title == "" {
+		return defaultImportEpisodeTitle
+	}
+	return truncateRunes(title, maxImportTitleLength)
+}
+
+// importDescription は item の説明（HTML を含むことがある）をプレーンテキストに変換する
+func importDescription(description string) string {
+	s := htmlLineBreakPattern.ReplaceAllString(description, "\n")
+	s = htmlTagPattern.ReplaceAllString(s, "")
+	s = html.UnescapeString(s)
+	s = strings.ReplaceAll(s, "\u00a0", " ")
+	s = strings.ReplaceAll(s, "\r\n", "\n")
+
+	lines := strings.Split(s, "\n")
+	for i, line := range lines {
+		lines[i] = strings.TrimSpace(line)
+	}
+	s = strings.Join(lines, "\n")
+	s = blankLinesPattern.ReplaceAllString(s, "\n\n")
+
+	return truncateRunes(strings.TrimSpace(s), maxImportDescriptionLength)
+}
+
+// truncateRunes は文字列を最大 n 文字に切り詰める
+func truncateRunes(s string, n int) string {
+	if utf8.RuneCountInString(s) <= n {
+		return s
+	}
+	return string([]rune(s)[:n])
+}
+
+// importFilename は URL のパスからファイル名を取り出す
+func importFilename(rawURL string) string {
+	u, err := url.Parse(rawURL)
+	if err != nil {
+		return ""
+	}
+	name := path.Base(u.Path)
+	if name == "." || name == "/" {
+		return ""
+	}
+	return name
+}
+
+// resolveImportMimeType は候補の Content-Type のうち許可されているものを返す
+//
+// いずれも許可されていない場合はファイル名の拡張子から推定する。推定できない場合は最初の候補を返し、
+// アップロード時のバリデーションでエラーにする
+func resolveImportMimeType(byExt, allowed map[string]string, filename string, candidates ...string) string {
+	var first string
+	for _, c := range candidates {
+		mediaType, _, err := mime.ParseMediaType(c)
+		if err != nil {
+			continue
+		}
+		mediaType = strings.ToLower(mediaType)
+		if first == "" {
+			first = mediaType
+		}
+		if _, ok := allowed[mediaType]; ok {
+			return mediaType
+		}
+	}
+
+	if mt, ok := byExt[strings.ToLower(path.Ext(filename))]; ok {
+		return mt
+	}
+
+	return first
+}
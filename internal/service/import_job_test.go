@@ -0,0 +1,505 @@
+package service
+
+import (
+	"bytes"
+	"context"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+
+	"github.com/siropaca/anycast-backend/internal/apperror"
+	"github.com/siropaca/anycast-backend/internal/dto/request"
+	"github.com/siropaca/anycast-backend/internal/dto/response"
+	"github.com/siropaca/anycast-backend/internal/model"
+	"github.com/siropaca/anycast-backend/internal/pkg/uuid"
+	"github.com/siropaca/anycast-backend/internal/repository"
+)
+
+// ImportJobRepository のモック
+type mockImportJobRepository struct {
+	mock.Mock
+}
+
+func (m *mockImportJobRepository) FindByID(ctx context.Context, id uuid.UUID) (*model.ImportJob, error) {
+	args := m.Called(ctx, id)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).(*model.ImportJob), args.Error(1)
+}
+
+func (m *mockImportJobRepository) FindByUserID(ctx context.Context, userID uuid.UUID) ([]model.ImportJob, error) {
+	args := m.Called(ctx, userID)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).([]model.ImportJob), args.Error(1)
+}
+
+func (m *mockImportJobRepository) FindActiveByChannelID(ctx context.Context, channelID uuid.UUID) (*model.ImportJob, error) {
+	args := m.Called(ctx, channelID)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).(*model.ImportJob), args.Error(1)
+}
+
+func (m *mockImportJobRepository) Create(ctx context.Context, job *model.ImportJob) error {
+	args := m.Called(ctx, job)
+	return args.Error(0)
+}
+
+func (m *mockImportJobRepository) Update(ctx context.Context, job *model.ImportJob) error {
+	args := m.Called(ctx, job)
+	return args.Error(0)
+}
+
+func (m *mockImportJobRepository) UpdateProgress(ctx context.Context, id uuid.UUID, progress int) error {
+	args := m.Called(ctx, id, progress)
+	return args.Error(0)
+}
+
+// AudioService のモック
+type mockAudioService struct {
+	mock.Mock
+}
+
+func (m *mockAudioService) UploadAudio(ctx context.Context, input UploadAudioInput) (*response.AudioUploadDataResponse, error) {
+	data, _ := io.ReadAll(input.File) //nolint:errcheck // テスト用
+	args := m.Called(ctx, input.Filename, input.ContentType, string(data))
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).(*response.AudioUploadDataResponse), args.Error(1)
+}
+
+// AudioJobService のモック
+type mockAudioJobService struct {
+	mock.Mock
+}
+
+func (m *mockAudioJobService) CreateJob(ctx context.Context, userID, channelID, episodeID string, req request.GenerateAudioAsyncRequest) (*response.AudioJobResponse, error) {
+	args := m.Called(ctx, userID, channelID, episodeID, req)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).(*response.AudioJobResponse), args.Error(1)
+}
+
+func (m *mockAudioJobService) GetJob(ctx context.Context, userID, jobID string) (*response.AudioJobResponse, error) {
+	args := m.Called(ctx, userID, jobID)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).(*response.AudioJobResponse), args.Error(1)
+}
+
+func (m *mockAudioJobService) ListMyJobs(ctx context.Context, userID string, filter repository.AudioJobFilter) (*response.AudioJobListResponse, error) {
+	args := m.Called(ctx, userID, filter)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).(*response.AudioJobListResponse), args.Error(1)
+}
+
+func (m *mockAudioJobService) CreateRenditionJob(ctx context.Context, userID, episodeID uuid.UUID) error {
+	args := m.Called(ctx, userID, episodeID)
+	return args.Error(0)
+}
+
+func (m *mockAudioJobService) CreateRetagJob(ctx context.Context, userID, episodeID uuid.UUID) error {
+	args := m.Called(ctx, userID, episodeID)
+	return args.Error(0)
+}
+
+func (m *mockAudioJobService) ExecuteJob(ctx context.Context, jobID string) error {
+	args := m.Called(ctx, jobID)
+	return args.Error(0)
+}
+
+func (m *mockAudioJobService) CancelJob(ctx context.Context, userID, jobID string) error {
+	args := m.Called(ctx, userID, jobID)
+	return args.Error(0)
+}
+
+// ImageService のモック
+type mockImageService struct {
+	mock.Mock
+}
+
+func (m *mockImageService) UploadImage(ctx context.Context, input UploadImageInput) (*response.ImageUploadDataResponse, error) {
+	data, _ := io.ReadAll(input.File) //nolint:errcheck // テスト用
+	args := m.Called(ctx, input.Filename, input.ContentType, string(data))
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).(*response.ImageUploadDataResponse), args.Error(1)
+}
+
+func (m *mockImageService) GenerateImage(ctx context.Context, prompt string) (*response.ImageUploadDataResponse, error) {
+	args := m.Called(ctx, prompt)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).(*response.ImageUploadDataResponse), args.Error(1)
+}
+
+// cloudtasks.Client のモック
+type mockTasksClient struct {
+	mock.Mock
+}
+
+func (m *mockTasksClient) EnqueueAudioJob(ctx context.Context, jobID string) error {
+	args := m.Called(ctx, jobID)
+	return args.Error(0)
+}
+
+func (m *mockTasksClient) EnqueueScriptJob(ctx context.Context, jobID string) error {
+	args := m.Called(ctx, jobID)
+	return args.Error(0)
+}
+
+func (m *mockTasksClient) EnqueueImportJob(ctx context.Context, jobID string) error {
+	args := m.Called(ctx, jobID)
+	return args.Error(0)
+}
+
+func (m *mockTasksClient) EnqueueClipJob(ctx context.Context, jobID string) error {
+	args := m.Called(ctx, jobID)
+	return args.Error(0)
+}
+
+func (m *mockTasksClient) Close() error {
+	return m.Called().Error(0)
+}
+
+type importJobTestDeps struct {
+	jobRepo      *mockImportJobRepository
+	channelRepo  *mockChannelRepository
+	episodeRepo  *mockEpisodeRepository
+	audioService *mockAudioService
+	imageService *mockImageService
+	audioJobSvc  *mockAudioJobService
+	tasksClient  *mockTasksClient
+}
+
+func newImportJobTestDeps() *importJobTestDeps {
+	return &importJobTestDeps{
+		jobRepo:      new(mockImportJobRepository),
+		channelRepo:  new(mockChannelRepository),
+		episodeRepo:  new(mockEpisodeRepository),
+		audioService: new(mockAudioService),
+		imageService: new(mockImageService),
+		audioJobSvc:  new(mockAudioJobService),
+		tasksClient:  new(mockTasksClient),
+	}
+}
+
+func (d *importJobTestDeps) service() ImportJobService {
+	return NewImportJobService(d.jobRepo, d.channelRepo, d.episodeRepo, d.audioService, d.imageService, d.audioJobSvc, http.DefaultClient, d.tasksClient, nil, nil)
+}
+
+// newImportFeedServer はテスト用のフィードと音声・画像を配信するサーバーを返す
+func newImportFeedServer(t *testing.T) *httptest.Server {
+	t.Helper()
+
+	mux := http.NewServeMux()
+	var server *httptest.Server
+	mux.HandleFunc("/feed.xml", func(w http.ResponseWriter, _ *http.Request) {
+		w.Header().Set("Content-Type", "application/rss+xml")
+		_, _ = io.WriteString(w, strings.ReplaceAll(`<?xml version="1.0" encoding="UTF-8"?>
+<rss version="2.0" xmlns:itunes="http://www.itunes.com/dtds/podcast-1.0.dtd">
+  <channel>
+    <title>外部番組</title>
+    <description>説明</description>
+    <itunes:image href="{base}/cover.png"/>
+    <item>
+      <title>第3回 &amp; 特別編</title>
+      <guid>ep-3</guid>
+      <description><![CDATA[<p>一段落目</p><p>二段落目&nbsp;です</p>]]></description>
+      <pubDate>Tue, 03 Feb 2026 10:00:00 +0900</pubDate>
+      <enclosure url="{base}/ep3.mp3" length="5" type="audio/mpeg"/>
+      <itunes:image href="{base}/ep3.jpg"/>
+    </item>
+    <item>
+      <title>お知らせ</title>
+      <guid>notice</guid>
+    </item>
+    <item>
+      <title>第2回</title>
+      <guid>ep-2</guid>
+      <enclosure url="{base}/missing.mp3" type="audio/mpeg"/>
+    </item>
+    <item>
+      <title>第1回</title>
+      <guid>ep-1</guid>
+      <enclosure url="{base}/ep1.mp3" type="audio/mpeg"/>
+    </item>
+  </channel>
+</rss>`, "{base}", server.URL))
+	})
+	mux.HandleFunc("/ep3.mp3", func(w http.ResponseWriter, _ *http.Request) {
+		w.Header().Set("Content-Type", "application/octet-stream")
+		_, _ = io.WriteString(w, "audio")
+	})
+	mux.HandleFunc("/cover.png", func(w http.ResponseWriter, _ *http.Request) {
+		w.Header().Set("Content-Type", "image/png")
+		_, _ = io.WriteString(w, "cover")
+	})
+	mux.HandleFunc("/ep3.jpg", func(w http.ResponseWriter, _ *http.Request) {
+		w.Header().Set("Content-Type", "image/jpeg")
+		_, _ = io.WriteString(w, "art")
+	})
+
+	server = httptest.NewServer(mux)
+	t.Cleanup(server.Close)
+
+	return server
+}
+
+func TestImportJobService_CreateJob(t *testing.T) {
+	ctx := context.Background()
+	ownerID := uuid.New()
+	channel := &model.Channel{ID: uuid.New(), UserID: ownerID, Name: "テックトーク"}
+	req := request.CreateImportJobRequest{FeedURL: "https://example.com/feed.xml"}
+
+	t.Run("ジョブを作成してキューに追加する", func(t *testing.T) {
+		d := newImportJobTestDeps()
+		d.channelRepo.On("FindByID", mock.Anything, channel.ID).Return(channel, nil)
+		d.jobRepo.On("FindActiveByChannelID", mock.Anything, channel.ID).Return(nil, nil)
+		d.jobRepo.On("Create", mock.Anything, mock.MatchedBy(func(j *model.ImportJob) bool {
+			return j.UserID == ownerID && j.ChannelID == channel.ID && j.FeedURL == req.FeedURL && j.Status == model.ImportJobStatusPending
+		})).Run(func(args mock.Arguments) {
+			args.Get(1).(*model.ImportJob).ID = uuid.New()
+		}).Return(nil)
+		d.tasksClient.On("EnqueueImportJob", mock.Anything, mock.AnythingOfType("string")).Return(nil)
+
+		resp, err := d.service().CreateJob(ctx, ownerID.String(), channel.ID.String(), req)
+
+		require.NoError(t, err)
+		assert.Equal(t, "pending", resp.Status)
+		assert.Equal(t, "テックトーク", resp.Channel.Name)
+		d.tasksClient.AssertExpectations(t)
+	})
+
+	t.Run("http / https 以外の URL はバリデーションエラーを返す", func(t *testing.T) {
+		d := newImportJobTestDeps()
+
+		_, err := d.service().CreateJob(ctx, ownerID.String(), channel.ID.String(), request.CreateImportJobRequest{FeedURL: "file:///etc/passwd"})
+
+		assert.True(t, apperror.IsCode(err, apperror.CodeValidation))
+	})
+
+	t.Run("オーナー以外は取り込めない", func(t *testing.T) {
+		d := newImportJobTestDeps()
+		d.channelRepo.On("FindByID", mock.Anything, channel.ID).Return(channel, nil)
+
+		_, err := d.service().CreateJob(ctx, uuid.New().String(), channel.ID.String(), req)
+
+		assert.True(t, apperror.IsCode(err, apperror.CodeForbidden))
+	})
+
+	t.Run("取り込み中のジョブがある場合はバリデーションエラーを返す", func(t *testing.T) {
+		d := newImportJobTestDeps()
+		d.channelRepo.On("FindByID", mock.Anything, channel.ID).Return(channel, nil)
+		d.jobRepo.On("FindActiveByChannelID", mock.Anything, channel.ID).Return(&model.ImportJob{ID: uuid.New()}, nil)
+
+		_, err := d.service().CreateJob(ctx, ownerID.String(), channel.ID.String(), req)
+
+		assert.True(t, apperror.IsCode(err, apperror.CodeValidation))
+		d.jobRepo.AssertNotCalled(t, "Create", mock.Anything, mock.Anything)
+	})
+}
+
+func TestImportJobService_ExecuteJob(t *testing.T) {
+	ctx := context.Background()
+
+	t.Run("フィードのエピソードを取り込む", func(t *testing.T) {
+		server := newImportFeedServer(t)
+		channel := &model.Channel{ID: uuid.New(), UserID: uuid.New()}
+		job := &model.ImportJob{ID: uuid.New(), UserID: channel.UserID, ChannelID: channel.ID, FeedURL: server.URL + "/feed.xml", Status: model.ImportJobStatusPending}
+		audioID := uuid.New()
+		coverID := uuid.New()
+		artID := uuid.New()
+
+		d := newImportJobTestDeps()
+		d.jobRepo.On("FindByID", mock.Anything, job.ID).Return(job, nil)
+		d.jobRepo.On("Update", mock.Anything, job).Return(nil)
+		d.channelRepo.On("FindByID", mock.Anything, channel.ID).Return(channel, nil)
+		d.imageService.On("UploadImage", mock.Anything, "cover.png", "image/png", "cover").
+			Return(&response.ImageUploadDataResponse{Data: response.ImageUploadResponse{ID: coverID}}, nil)
+		d.channelRepo.On("Update", mock.Anything, mock.MatchedBy(func(c *model.Channel) bool {
+			return c.ArtworkID != nil && *c.ArtworkID == coverID
+		})).Return(nil)
+		// ep-1 は取り込み済み
+		d.episodeRepo.On("FindSourceGUIDsByChannelID", mock.Anything, channel.ID).Return([]string{"ep-1"}, nil)
+		// Content-Type が application/octet-stream でも enclosure の type を使う
+		d.audioService.On("UploadAudio", mock.Anything, "ep3.mp3", "audio/mpeg", "audio").
+			Return(&response.AudioUploadDataResponse{Data: response.AudioUploadResponse{ID: audioID}}, nil)
+		d.imageService.On("UploadImage", mock.Anything, "ep3.jpg", "image/jpeg", "art").
+			Return(&response.ImageUploadDataResponse{Data: response.ImageUploadResponse{ID: artID}}, nil)
+
+		var created *model.Episode
+		episodeID := uuid.New()
+		d.episodeRepo.On("Create", mock.Anything, mock.AnythingOfType("*model.Episode")).Run(func(args mock.Arguments) {
+			created = args.Get(1).(*model.Episode)
+			created.ID = episodeID
+		}).Return(nil)
+		// 取り込んだ音声から配信用音声を生成し、ID3 タグを書き換える
+		d.audioJobSvc.On("CreateRenditionJob", mock.Anything, channel.UserID, episodeID).Return(nil)
+		d.audioJobSvc.On("CreateRetagJob", mock.Anything, channel.UserID, episodeID).Return(nil)
+
+		err := d.service().ExecuteJob(ctx, job.ID.String())
+
+		require.NoError(t, err)
+		assert.Equal(t, model.ImportJobStatusCompleted, job.Status)
+		assert.Equal(t, 100, job.Progress)
+		assert.Equal(t, 4, job.TotalEpisodes)
+		assert.Equal(t, 1, job.ImportedEpisodes)
+		assert.Equal(t, 2, job.SkippedEpisodes)
+		assert.Equal(t, 1, job.FailedEpisodes)
+		assert.NotNil(t, job.CompletedAt)
+
+		require.NotNil(t, created)
+		assert.Equal(t, channel.ID, created.ChannelID)
+		assert.Equal(t, "第3回 & 特別編", created.Title)
+		assert.Equal(t, "一段落目\n二段落目 です", created.Description)
+		require.NotNil(t, created.PublishedAt)
+		assert.Equal(t, time.Date(2026, 2, 3, 1, 0, 0, 0, time.UTC), *created.PublishedAt)
+		assert.Equal(t, audioID, *created.FullAudioID)
+		assert.Equal(t, artID, *created.ArtworkID)
+		assert.Equal(t, "ep-3", *created.SourceGUID)
+		d.episodeRepo.AssertNumberOfCalls(t, "Create", 1)
+		d.audioJobSvc.AssertExpectations(t)
+	})
+
+	t.Run("フィードを取得できない場合はジョブを失敗にする", func(t *testing.T) {
+		server := newImportFeedServer(t)
+		job := &model.ImportJob{ID: uuid.New(), UserID: uuid.New(), ChannelID: uuid.New(), FeedURL: server.URL + "/not-found.xml", Status: model.ImportJobStatusPending}
+
+		d := newImportJobTestDeps()
+		d.jobRepo.On("FindByID", mock.Anything, job.ID).Return(job, nil)
+		d.jobRepo.On("Update", mock.Anything, job).Return(nil)
+
+		err := d.service().ExecuteJob(ctx, job.ID.String())
+
+		require.Error(t, err)
+		assert.False(t, apperror.IsRetryable(err))
+		assert.Equal(t, model.ImportJobStatusFailed, job.Status)
+		require.NotNil(t, job.ErrorCode)
+		assert.Equal(t, string(apperror.CodeValidation), *job.ErrorCode)
+	})
+
+	t.Run("フィードでない内容の場合はジョブを失敗にする", func(t *testing.T) {
+		server := newImportFeedServer(t)
+		job := &model.ImportJob{ID: uuid.New(), UserID: uuid.New(), ChannelID: uuid.New(), FeedURL: server.URL + "/cover.png", Status: model.ImportJobStatusPending}
+
+		d := newImportJobTestDeps()
+		d.jobRepo.On("FindByID", mock.Anything, job.ID).Return(job, nil)
+		d.jobRepo.On("Update", mock.Anything, job).Return(nil)
+
+		err := d.service().ExecuteJob(ctx, job.ID.String())
+
+		require.Error(t, err)
+		assert.Equal(t, model.ImportJobStatusFailed, job.Status)
+	})
+
+	t.Run("完了済みのジョブはスキップする", func(t *testing.T) {
+		job := &model.ImportJob{ID: uuid.New(), Status: model.ImportJobStatusCompleted}
+
+		d := newImportJobTestDeps()
+		d.jobRepo.On("FindByID", mock.Anything, job.ID).Return(job, nil)
+
+		err := d.service().ExecuteJob(ctx, job.ID.String())
+
+		require.NoError(t, err)
+		d.jobRepo.AssertNotCalled(t, "Update", mock.Anything, mock.Anything)
+	})
+}
+
+func TestImportJobService_downloadTo(t *testing.T) {
+	ctx := context.Background()
+
+	// Content-Length を付けずに size バイトを返すサーバー
+	newServer := func(size int64) *httptest.Server {
+		return httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, _ *http.Request) {
+			w.Header().Set("Content-Type", "audio/mpeg")
+			w.(http.Flusher).Flush()
+			_, _ = io.CopyN(w, strings.NewReader(strings.Repeat("a", int(size))), size)
+		}))
+	}
+
+	t.Run("上限以内の内容を w に書き込む", func(t *testing.T) {
+		server := newServer(1024)
+		defer server.Close()
+		svc := &importJobService{httpClient: http.DefaultClient}
+
+		var buf bytes.Buffer
+		contentType, err := svc.downloadTo(ctx, server.URL+"/ep.mp3", 1024, &buf)
+
+		require.NoError(t, err)
+		assert.Equal(t, "audio/mpeg", contentType)
+		assert.Equal(t, 1024, buf.Len())
+	})
+
+	t.Run("上限を超える場合は上限 + 1 バイトまでで打ち切ってエラーを返す", func(t *testing.T) {
+		server := newServer(4096)
+		defer server.Close()
+		svc := &importJobService{httpClient: http.DefaultClient}
+
+		var buf bytes.Buffer
+		_, err := svc.downloadTo(ctx, server.URL+"/ep.mp3", 1024, &buf)
+
+		assert.ErrorContains(t, err, "content too large")
+		assert.Equal(t, 1025, buf.Len())
+	})
+}
+
+func TestImportJobService_GetJob(t *testing.T) {
+	ctx := context.Background()
+
+	t.Run("他のユーザーのジョブは取得できない", func(t *testing.T) {
+		job := &model.ImportJob{ID: uuid.New(), UserID: uuid.New()}
+
+		d := newImportJobTestDeps()
+		d.jobRepo.On("FindByID", mock.Anything, job.ID).Return(job, nil)
+
+		_, err := d.service().GetJob(ctx, uuid.New().String(), job.ID.String())
+
+		assert.True(t, apperror.IsCode(err, apperror.CodeForbidden))
+	})
+}
+
+func TestImportSourceGUID(t *testing.T) {
+	t.Run("列に収まる GUID はそのまま返す", func(t *testing.T) {
+		assert.Equal(t, "ep-1", importSourceGUID("ep-1"))
+	})
+
+	t.Run("長すぎる GUID はハッシュ値で代用する", func(t *testing.T) {
+		guid := importSourceGUID(strings.Repeat("a", maxImportSourceGUIDLength+1))
+
+		assert.True(t, strings.HasPrefix(guid, "sha256:"))
+		assert.LessOrEqual(t, len(guid), maxImportSourceGUIDLength)
+	})
+}
+
+func TestResolveImportMimeType(t *testing.T) {
+	t.Run("許可された候補を優先する", func(t *testing.T) {
+		assert.Equal(t, "audio/mpeg", resolveImportMimeType(importAudioMimeTypesByExt, allowedAudioMimeTypes, "a.mp3", "application/octet-stream", "audio/mpeg; charset=binary"))
+	})
+
+	t.Run("候補が許可されていない場合は拡張子から推定する", func(t *testing.T) {
+		assert.Equal(t, "audio/mp4", resolveImportMimeType(importAudioMimeTypesByExt, allowedAudioMimeTypes, "a.M4A", "application/octet-stream"))
+	})
+
+	t.Run("推定できない場合は最初の候補を返す", func(t *testing.T) {
+		assert.Equal(t, "video/mp4", resolveImportMimeType(importAudioMimeTypesByExt, allowedAudioMimeTypes, "a.bin", "video/mp4"))
+	})
+}
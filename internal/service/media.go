@@ -0,0 +1,163 @@
+package service
+
+import (
+	"context"
+	"crypto/sha256"
+	"encoding/hex"
+	"fmt"
+	"io"
+
+	"github.com/siropaca/anycast-backend/internal/apperror"
+	"github.com/siropaca/anycast-backend/internal/dto/response"
+	"github.com/siropaca/anycast-backend/internal/infrastructure/storage"
+	"github.com/siropaca/anycast-backend/internal/model"
+	"github.com/siropaca/anycast-backend/internal/pkg/uuid"
+	"github.com/siropaca/anycast-backend/internal/repository"
+)
+
+// mediaAudioPathFormat は有効期限のない音声の URL のパス（API のベース URL からの相対）
+const mediaAudioPathFormat = "/media/audio/%s"
+
+// MediaAudio は安定 URL で配信する音声を表す
+//
+// 署名付き URL へリダイレクトする場合は RedirectURL、ストレージから読み込んで配信する場合は Content が設定される
+type MediaAudio struct {
+	Audio *model.Audio
+	// 公開済みエピソードの音声か（共有キャッシュに保存してよいか）
+	Public bool
+	// 署名付き URL（redirect の場合のみ）
+	RedirectURL string
+	// 音声の内容（proxy の場合のみ）。必要な範囲だけストレージから読み込む
+	Content io.ReadSeekCloser
+}
+
+// ETag は音声の ETag を返す
+//
+// ID3 タグの書き換えなどでファイルを書き換える場合は新しいパスに保存するため、ストレージのパスのハッシュを含める
+// （ファイルサイズは書き換え前後で同じになり得るため使わない）
+func (m *MediaAudio) ETag() string {
+	sum := sha256.Sum256([]byte(m.Audio.Path))
+	return fmt.Sprintf(`"%s-%s"`, m.Audio.ID, hex.EncodeToString(sum[:8]))
+}
+
+// MediaService は安定 URL でのメディア配信に関するビジネスロジックインターフェースを表す
+type MediaService interface {
+	GetAudio(ctx context.Context, userID, audioID string) (*MediaAudio, error)
+	GetAudioWaveforms(ctx context.Context, userID, audioID string) (*response.AudioWaveformsDataResponse, error)
+}
+
+type mediaService struct {
+	episodeRepo   repository.EpisodeRepository
+	audioRepo     repository.AudioRepository
+	storageClient storage.Client
+	// proxy は署名付き URL へリダイレクトせず、ストレージから読み込んで配信するか
+	proxy bool
+}
+
+// NewMediaService は mediaService を生成して MediaService として返す
+func NewMediaService(
+	episodeRepo repository.EpisodeRepository,
+	audioRepo repository.AudioRepository,
+	storageClient storage.Client,
+	proxy bool,
+) MediaService {
+	return &mediaService{
+		episodeRepo:   episodeRepo,
+		audioRepo:     audioRepo,
+		storageClient: storageClient,
+		proxy:         proxy,
+	}
+}
+
+// GetAudio は閲覧可能な音声を取得する
+//
+// エピソードの音声（ボイス音声・結合済み音声・配信用音声）のみが対象で、閲覧できるのは
+// チャンネルのオーナーと、公開済みのチャンネル・エピソードの結合済み音声・配信用音声のみ。
+// 閲覧できない場合は存在を明かさないよう 404 を返す
+func (s *mediaService) GetAudio(ctx context.Context, userID, audioID string) (*MediaAudio, error) {
+	audio, public, err := s.findViewableAudio(ctx, userID, audioID)
+	if err != nil {
+		return nil, err
+	}
+
+	result := &MediaAudio{Audio: audio, Public: public}
+
+	if s.proxy {
+		result.Content = storage.NewRangeReadSeeker(ctx, s.storageClient, audio.Path, int64(audio.FileSize))
+		return result, nil
+	}
+
+	signedURL, err := s.storageClient.GenerateSignedURL(ctx, audio.Path, storage.SignedURLExpirationAudio)
+	if err != nil {
+		return nil, err
+	}
+	result.RedirectURL = signedURL
+
+	return result, nil
+}
+
+// GetAudioWaveforms は閲覧可能な音声の解像度別の波形ピークデータを取得する
+//
+// 音声のレスポンスにはサムネイル用の解像度のみを含めるため、プレイヤー・エディタ用の解像度はこちらから取得する。
+// 閲覧できる音声は GetAudio と同じ
+func (s *mediaService) GetAudioWaveforms(ctx context.Context, userID, audioID string) (*response.AudioWaveformsDataResponse, error) {
+	audio, _, err := s.findViewableAudio(ctx, userID, audioID)
+	if err != nil {
+		return nil, err
+	}
+
+	waveforms := toAudioWaveformResponses(audio.Waveforms)
+	if waveforms == nil {
+		waveforms = []response.AudioWaveformResponse{}
+	}
+
+	return &response.AudioWaveformsDataResponse{
+		Data: waveforms,
+	}, nil
+}
+
+// findViewableAudio は閲覧可能な音声と、公開済みエピソードの音声か（共有キャッシュに保存してよいか）を返す
+func (s *mediaService) findViewableAudio(ctx context.Context, userID, audioID string) (*model.Audio, bool, error) {
+	var uid uuid.UUID
+	if userID != "" {
+		var err error
+		uid, err = uuid.Parse(userID)
+		if err != nil {
+			return nil, false, err
+		}
+	}
+
+	aid, err := uuid.Parse(audioID)
+	if err != nil {
+		return nil, false, err
+	}
+
+	episodes, err := s.episodeRepo.FindByAudioID(ctx, aid)
+	if err != nil {
+		return nil, false, err
+	}
+
+	viewable, public := false, false
+	for i := range episodes {
+		e := &episodes[i]
+		if userID != "" && e.Channel.UserID == uid {
+			viewable = true
+			continue
+		}
+		// ボイス単体の音声（BGM なし）は制作途中の素材のため、オーナー以外には公開しない
+		isVoiceOnly := e.VoiceAudioID != nil && *e.VoiceAudioID == aid && (e.FullAudioID == nil || *e.FullAudioID != aid)
+		if !isVoiceOnly && isPublishedAt(e.Channel.PublishedAt) && isPublishedAt(e.PublishedAt) {
+			viewable, public = true, true
+		}
+	}
+	if !viewable {
+		return nil, false, apperror.ErrNotFound.WithMessage("音声が見つかりません")
+	}
+
+	audio, err := s.audioRepo.FindByID(ctx, aid)
+	if err != nil {
+		return nil, false, err
+	}
+
+	return audio, public, nil
+}
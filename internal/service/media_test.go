@@ -0,0 +1,218 @@
+package service
+
+import (
+	"context"
+	"io"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+
+	"github.com/siropaca/anycast-backend/internal/apperror"
+	"github.com/siropaca/anycast-backend/internal/infrastructure/storage"
+	"github.com/siropaca/anycast-backend/internal/model"
+	"github.com/siropaca/anycast-backend/internal/pkg/uuid"
+)
+
+func TestMediaService_GetAudio(t *testing.T) {
+	ctx := context.Background()
+	ownerID := uuid.New()
+	past := time.Now().Add(-time.Hour)
+	future := time.Now().Add(time.Hour)
+
+	audio := &model.Audio{ID: uuid.New(), Path: "audios/full.mp3", MimeType: "audio/mpeg", FileSize: 10}
+	voiceAudioID := uuid.New()
+
+	newEpisode := func(channelPublishedAt, episodePublishedAt *time.Time) model.Episode {
+		return model.Episode{
+			ID:           uuid.New(),
+			Channel:      model.Channel{ID: uuid.New(), UserID: ownerID, PublishedAt: channelPublishedAt},
+			PublishedAt:  episodePublishedAt,
+			FullAudioID:  &audio.ID,
+			VoiceAudioID: &voiceAudioID,
+		}
+	}
+
+	t.Run("公開済みエピソードの音声は未ログインでも署名付き URL を返す", func(t *testing.T) {
+		episodeRepo := new(mockEpisodeRepository)
+		audioRepo := new(mockAudioRepository)
+		storageClient := new(mockStorageClient)
+		episodeRepo.On("FindByAudioID", mock.Anything, audio.ID).Return([]model.Episode{newEpisode(&past, &past)}, nil)
+		audioRepo.On("FindByID", mock.Anything, audio.ID).Return(audio, nil)
+		storageClient.On("GenerateSignedURL", mock.Anything, audio.Path, storage.SignedURLExpirationAudio).Return("https://storage.example.com/signed", nil)
+
+		svc := NewMediaService(episodeRepo, audioRepo, storageClient, false)
+		result, err := svc.GetAudio(ctx, "", audio.ID.String())
+
+		require.NoError(t, err)
+		assert.True(t, result.Public)
+		assert.Equal(t, "https://storage.example.com/signed", result.RedirectURL)
+		assert.Nil(t, result.Content)
+	})
+
+	t.Run("proxy の場合は必要な範囲だけストレージから読み込む", func(t *testing.T) {
+		episodeRepo := new(mockEpisodeRepository)
+		audioRepo := new(mockAudioRepository)
+		storageClient := new(mockStorageClient)
+		episodeRepo.On("FindByAudioID", mock.Anything, audio.ID).Return([]model.Episode{newEpisode(&past, &past)}, nil)
+		audioRepo.On("FindByID", mock.Anything, audio.ID).Return(audio, nil)
+		storageClient.On("OpenRange", mock.Anything, audio.Path, int64(4), int64(-1)).Return(io.NopCloser(strings.NewReader("456789")), nil)
+
+		svc := NewMediaService(episodeRepo, audioRepo, storageClient, true)
+		result, err := svc.GetAudio(ctx, "", audio.ID.String())
+		require.NoError(t, err)
+		defer result.Content.Close()
+
+		assert.Empty(t, result.RedirectURL)
+		size, err := result.Content.Seek(0, io.SeekEnd)
+		require.NoError(t, err)
+		assert.Equal(t, int64(10), size)
+
+		_, err = result.Content.Seek(4, io.SeekStart)
+		require.NoError(t, err)
+		data, err := io.ReadAll(result.Content)
+		require.NoError(t, err)
+		assert.Equal(t, "456789", string(data))
+		storageClient.AssertNotCalled(t, "GenerateSignedURL", mock.Anything, mock.Anything, mock.Anything)
+	})
+
+	t.Run("未公開エピソードの音声はオーナーのみ取得できる", func(t *testing.T) {
+		episodeRepo := new(mockEpisodeRepository)
+		audioRepo := new(mockAudioRepository)
+		storageClient := new(mockStorageClient)
+		episodeRepo.On("FindByAudioID", mock.Anything, audio.ID).Return([]model.Episode{newEpisode(&past, &future)}, nil)
+		audioRepo.On("FindByID", mock.Anything, audio.ID).Return(audio, nil)
+		storageClient.On("GenerateSignedURL", mock.Anything, audio.Path, storage.SignedURLExpirationAudio).Return("https://storage.example.com/signed", nil)
+
+		svc := NewMediaService(episodeRepo, audioRepo, storageClient, false)
+
+		result, err := svc.GetAudio(ctx, ownerID.String(), audio.ID.String())
+		require.NoError(t, err)
+		assert.False(t, result.Public)
+
+		_, err = svc.GetAudio(ctx, uuid.New().String(), audio.ID.String())
+		assert.True(t, apperror.IsCode(err, apperror.CodeNotFound))
+
+		_, err = svc.GetAudio(ctx, "", audio.ID.String())
+		assert.True(t, apperror.IsCode(err, apperror.CodeNotFound))
+	})
+
+	t.Run("非公開チャンネルのエピソードの音声はオーナー以外には返さない", func(t *testing.T) {
+		episodeRepo := new(mockEpisodeRepository)
+		episodeRepo.On("FindByAudioID", mock.Anything, audio.ID).Return([]model.Episode{newEpisode(nil, &past)}, nil)
+
+		svc := NewMediaService(episodeRepo, new(mockAudioRepository), new(mockStorageClient), false)
+		_, err := svc.GetAudio(ctx, "", audio.ID.String())
+
+		assert.True(t, apperror.IsCode(err, apperror.CodeNotFound))
+	})
+
+	t.Run("ボイス単体の音声は公開済みでもオーナー以外には返さない", func(t *testing.T) {
+		episodeRepo := new(mockEpisodeRepository)
+		episodeRepo.On("FindByAudioID", mock.Anything, voiceAudioID).Return([]model.Episode{newEpisode(&past, &past)}, nil)
+
+		svc := NewMediaService(episodeRepo, new(mockAudioRepository), new(mockStorageClient), false)
+		_, err := svc.GetAudio(ctx, "", voiceAudioID.String())
+
+		assert.True(t, apperror.IsCode(err, apperror.CodeNotFound))
+	})
+
+	t.Run("エピソードで使われていない音声は NotFound を返す", func(t *testing.T) {
+		episodeRepo := new(mockEpisodeRepository)
+		episodeRepo.On("FindByAudioID", mock.Anything, audio.ID).Return([]model.Episode{}, nil)
+
+		svc := NewMediaService(episodeRepo, new(mockAudioRepository), new(mockStorageClient), false)
+		_, err := svc.GetAudio(ctx, ownerID.String(), audio.ID.String())
+
+		assert.True(t, apperror.IsCode(err, apperror.CodeNotFound))
+	})
+}
+
+func TestMediaService_GetAudioWaveforms(t *testing.T) {
+	ctx := context.Background()
+	ownerID := uuid.New()
+	past := time.Now().Add(-time.Hour)
+
+	audio := &model.Audio{
+		ID:   uuid.New(),
+		Path: "audios/full.mp3",
+		Waveforms: model.AudioWaveforms{
+			{Version: 2, Channels: 1, SampleRate: 16000, SamplesPerPixel: 640, Bits: 8, Length: 1, Data: []int{-1, 2}},
+			{Version: 2, Channels: 1, SampleRate: 16000, SamplesPerPixel: 160, Bits: 8, Length: 2, Data: []int{-1, 2, -3, 4}},
+		},
+	}
+
+	t.Run("公開済みエピソードの音声はすべての解像度を返す", func(t *testing.T) {
+		episodeRepo := new(mockEpisodeRepository)
+		audioRepo := new(mockAudioRepository)
+		episodeRepo.On("FindByAudioID", mock.Anything, audio.ID).Return([]model.Episode{{
+			ID:          uuid.New(),
+			Channel:     model.Channel{ID: uuid.New(), UserID: ownerID, PublishedAt: &past},
+			PublishedAt: &past,
+			FullAudioID: &audio.ID,
+		}}, nil)
+		audioRepo.On("FindByID", mock.Anything, audio.ID).Return(audio, nil)
+
+		svc := NewMediaService(episodeRepo, audioRepo, new(mockStorageClient), false)
+		result, err := svc.GetAudioWaveforms(ctx, "", audio.ID.String())
+
+		require.NoError(t, err)
+		require.Len(t, result.Data, 2)
+		assert.Equal(t, 160, result.Data[1].SamplesPerPixel)
+	})
+
+	t.Run("波形が未生成の場合は空配列を返す", func(t *testing.T) {
+		episodeRepo := new(mockEpisodeRepository)
+		audioRepo := new(mockAudioRepository)
+		noWaveform := &model.Audio{ID: audio.ID, Path: audio.Path}
+		episodeRepo.On("FindByAudioID", mock.Anything, audio.ID).Return([]model.Episode{{
+			ID:          uuid.New(),
+			Channel:     model.Channel{ID: uuid.New(), UserID: ownerID},
+			FullAudioID: &audio.ID,
+		}}, nil)
+		audioRepo.On("FindByID", mock.Anything, audio.ID).Return(noWaveform, nil)
+
+		svc := NewMediaService(episodeRepo, audioRepo, new(mockStorageClient), false)
+		result, err := svc.GetAudioWaveforms(ctx, ownerID.String(), audio.ID.String())
+
+		require.NoError(t, err)
+		assert.Empty(t, result.Data)
+		assert.NotNil(t, result.Data)
+	})
+
+	t.Run("閲覧できない音声は NotFound を返す", func(t *testing.T) {
+		episodeRepo := new(mockEpisodeRepository)
+		episodeRepo.On("FindByAudioID", mock.Anything, audio.ID).Return([]model.Episode{{
+			ID:          uuid.New(),
+			Channel:     model.Channel{ID: uuid.New(), UserID: ownerID},
+			FullAudioID: &audio.ID,
+		}}, nil)
+
+		svc := NewMediaService(episodeRepo, new(mockAudioRepository), new(mockStorageClient), false)
+		_, err := svc.GetAudioWaveforms(ctx, "", audio.ID.String())
+
+		assert.True(t, apperror.IsCode(err, apperror.CodeNotFound))
+	})
+}
+
+func TestMediaAudio_ETag(t *testing.T) {
+	audioID := uuid.New()
+
+	t.Run("同じパスの音声は同じ ETag を返す", func(t *testing.T) {
+		a := &MediaAudio{Audio: &model.Audio{ID: audioID, Path: "audios/a.mp3", FileSize: 10}}
+		b := &MediaAudio{Audio: &model.Audio{ID: audioID, Path: "audios/a.mp3", FileSize: 10}}
+
+		assert.Equal(t, a.ETag(), b.ETag())
+		assert.True(t, strings.HasPrefix(a.ETag(), `"`+audioID.String()+"-"))
+	})
+
+	t.Run("ファイルサイズが同じでもパスが変わると ETag が変わる", func(t *testing.T) {
+		before := &MediaAudio{Audio: &model.Audio{ID: audioID, Path: "audios/a.mp3", FileSize: 10}}
+		after := &MediaAudio{Audio: &model.Audio{ID: audioID, Path: storage.GenerateAudioRevisionPath(audioID.String(), "rev", ".mp3"), FileSize: 10}}
+
+		assert.NotEqual(t, before.ETag(), after.ETag())
+	})
+}
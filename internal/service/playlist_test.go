@@ -171,6 +171,22 @@ func (m *mockEpisodeRepositoryForPlaylist) CountByChannelIDBeforeCreatedAt(ctx c
 	return args.Get(0).(int64), args.Error(1)
 }
 
+func (m *mockEpisodeRepositoryForPlaylist) FindSourceGUIDsByChannelID(ctx context.Context, channelID uuid.UUID) ([]string, error) {
+	args := m.Called(ctx, channelID)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).([]string), args.Error(1)
+}
+
+func (m *mockEpisodeRepositoryForPlaylist) FindByAudioID(ctx context.Context, audioID uuid.UUID) ([]model.Episode, error) {
+	args := m.Called(ctx, audioID)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).([]model.Episode), args.Error(1)
+}
+
 func (m *mockEpisodeRepositoryForPlaylist) IncrementPlayCount(ctx context.Context, id uuid.UUID) error {
 	args := m.Called(ctx, id)
 	return args.Error(0)
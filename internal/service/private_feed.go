@@ -0,0 +1,237 @@
+package service
+
+import (
+	"context"
+	"strings"
+	"time"
+
+	"github.com/siropaca/anycast-backend/internal/apperror"
+	"github.com/siropaca/anycast-backend/internal/dto/request"
+	"github.com/siropaca/anycast-backend/internal/dto/response"
+	"github.com/siropaca/anycast-backend/internal/model"
+	"github.com/siropaca/anycast-backend/internal/pkg/logger"
+	"github.com/siropaca/anycast-backend/internal/pkg/token"
+	"github.com/siropaca/anycast-backend/internal/pkg/uuid"
+	"github.com/siropaca/anycast-backend/internal/repository"
+)
+
+// PrivateFeedService は限定公開 RSS フィードのトークン管理のサービスインターフェース
+type PrivateFeedService interface {
+	Create(ctx context.Context, userID, channelID string, req request.CreatePrivateFeedRequest) (*response.PrivateFeedResponse, error)
+	ListByChannel(ctx context.Context, userID, channelID string) ([]response.PrivateFeedResponse, error)
+	ListMine(ctx context.Context, userID string) ([]response.PrivateFeedResponse, error)
+	DeleteByChannel(ctx context.Context, userID, channelID, privateFeedID string) error
+	DeleteMine(ctx context.Context, userID, privateFeedID string) error
+}
+
+type privateFeedService struct {
+	privateFeedTokenRepo repository.PrivateFeedTokenRepository
+	channelRepo          repository.ChannelRepository
+	userRepo             repository.UserRepository
+	apiBaseURL           string
+}
+
+// NewPrivateFeedService は PrivateFeedService の実装を返す
+//
+// apiBaseURL はフィード URL の組み立てに使う API の公開ベース URL
+func NewPrivateFeedService(
+	privateFeedTokenRepo repository.PrivateFeedTokenRepository,
+	channelRepo repository.ChannelRepository,
+	userRepo repository.UserRepository,
+	apiBaseURL string,
+) PrivateFeedService {
+	return &privateFeedService{
+		privateFeedTokenRepo: privateFeedTokenRepo,
+		channelRepo:          channelRepo,
+		userRepo:             userRepo,
+		apiBaseURL:           strings.TrimSuffix(apiBaseURL, "/"),
+	}
+}
+
+// Create はチャンネルの限定公開フィードのトークンを発行する
+//
+// 発行できるのはチャンネルのオーナーのみ。username を指定すると他のユーザーに発行する
+func (s *privateFeedService) Create(ctx context.Context, userID, channelID string, req request.CreatePrivateFeedRequest) (*response.PrivateFeedResponse, error) {
+	channel, err := s.findOwnedChannel(ctx, userID, channelID)
+	if err != nil {
+		return nil, err
+	}
+
+	var expiresAt *time.Time
+	if req.ExpiresAt != nil {
+		t, err := time.Parse(time.RFC3339, *req.ExpiresAt)
+		if err != nil {
+			return nil, apperror.ErrValidation.WithMessage("有効期限の形式が無効です。RFC3339 形式で指定してください")
+		}
+		if !t.After(time.Now()) {
+			return nil, apperror.ErrValidation.WithMessage("有効期限には未来の日時を指定してください")
+		}
+		t = t.UTC()
+		expiresAt = &t
+	}
+
+	var user *model.User
+	if req.Username != nil {
+		user, err = s.userRepo.FindByUsernameWithAvatar(ctx, *req.Username)
+	} else {
+		user, err = s.userRepo.FindByID(ctx, channel.UserID)
+	}
+	if err != nil {
+		return nil, err
+	}
+
+	tokenStr, err := token.Generate()
+	if err != nil {
+		logger.FromContext(ctx).Error("failed to generate private feed token", "error", err)
+		return nil, apperror.ErrInternal.WithMessage("限定公開フィードの作成に失敗しました").WithError(err)
+	}
+
+	t := &model.PrivateFeedToken{
+		UserID:    user.ID,
+		ChannelID: channel.ID,
+		Token:     tokenStr,
+		ExpiresAt: expiresAt,
+	}
+
+	if err := s.privateFeedTokenRepo.Create(ctx, t); err != nil {
+		return nil, err
+	}
+
+	t.User = *user
+	t.Channel = *channel
+	resp := s.toPrivateFeedResponse(t)
+
+	return &resp, nil
+}
+
+// ListByChannel はチャンネルに発行された全トークンを取得する（オーナーのみ）
+func (s *privateFeedService) ListByChannel(ctx context.Context, userID, channelID string) ([]response.PrivateFeedResponse, error) {
+	channel, err := s.findOwnedChannel(ctx, userID, channelID)
+	if err != nil {
+		return nil, err
+	}
+
+	tokens, err := s.privateFeedTokenRepo.FindByChannelID(ctx, channel.ID)
+	if err != nil {
+		return nil, err
+	}
+
+	return s.toPrivateFeedResponses(tokens), nil
+}
+
+// ListMine は自分に発行された全トークンを取得する
+func (s *privateFeedService) ListMine(ctx context.Context, userID string) ([]response.PrivateFeedResponse, error) {
+	uid, err := uuid.Parse(userID)
+	if err != nil {
+		return nil, err
+	}
+
+	tokens, err := s.privateFeedTokenRepo.FindByUserID(ctx, uid)
+	if err != nil {
+		return nil, err
+	}
+
+	return s.toPrivateFeedResponses(tokens), nil
+}
+
+// DeleteByChannel はチャンネルに発行されたトークンを失効させる（オーナーのみ）
+func (s *privateFeedService) DeleteByChannel(ctx context.Context, userID, channelID, privateFeedID string) error {
+	channel, err := s.findOwnedChannel(ctx, userID, channelID)
+	if err != nil {
+		return err
+	}
+
+	pfid, err := uuid.Parse(privateFeedID)
+	if err != nil {
+		return err
+	}
+
+	t, err := s.privateFeedTokenRepo.FindByID(ctx, pfid)
+	if err != nil {
+		return err
+	}
+
+	if t.ChannelID != channel.ID {
+		return apperror.ErrNotFound.WithMessage("限定公開フィードが見つかりません")
+	}
+
+	return s.privateFeedTokenRepo.Delete(ctx, t.ID)
+}
+
+// DeleteMine は自分に発行されたトークンを失効させる
+func (s *privateFeedService) DeleteMine(ctx context.Context, userID, privateFeedID string) error {
+	uid, err := uuid.Parse(userID)
+	if err != nil {
+		return err
+	}
+
+	pfid, err := uuid.Parse(privateFeedID)
+	if err != nil {
+		return err
+	}
+
+	t, err := s.privateFeedTokenRepo.FindByID(ctx, pfid)
+	if err != nil {
+		return err
+	}
+
+	if t.UserID != uid {
+		return apperror.ErrNotFound.WithMessage("限定公開フィードが見つかりません")
+	}
+
+	return s.privateFeedTokenRepo.Delete(ctx, t.ID)
+}
+
+// findOwnedChannel はユーザーがオーナーのチャンネルを取得する
+func (s *privateFeedService) findOwnedChannel(ctx context.Context, userID, channelID string) (*model.Channel, error) {
+	uid, err := uuid.Parse(userID)
+	if err != nil {
+		return nil, err
+	}
+
+	cid, err := uuid.Parse(channelID)
+	if err != nil {
+		return nil, err
+	}
+
+	channel, err := s.channelRepo.FindByID(ctx, cid)
+	if err != nil {
+		return nil, err
+	}
+
+	if channel.UserID != uid {
+		return nil, apperror.ErrForbidden.WithMessage("このチャンネルの限定公開フィードを管理する権限がありません")
+	}
+
+	return channel, nil
+}
+
+// toPrivateFeedResponses はトークンのスライスをレスポンスに変換する
+func (s *privateFeedService) toPrivateFeedResponses(tokens []model.PrivateFeedToken) []response.PrivateFeedResponse {
+	responses := make([]response.PrivateFeedResponse, len(tokens))
+	for i := range tokens {
+		responses[i] = s.toPrivateFeedResponse(&tokens[i])
+	}
+
+	return responses
+}
+
+// toPrivateFeedResponse はトークンをレスポンスに変換する
+func (s *privateFeedService) toPrivateFeedResponse(t *model.PrivateFeedToken) response.PrivateFeedResponse {
+	return response.PrivateFeedResponse{
+		ID: t.ID,
+		Channel: response.PrivateFeedChannelResponse{
+			ID:   t.Channel.ID,
+			Name: t.Channel.Name,
+		},
+		User: response.PrivateFeedUserResponse{
+			ID:          t.User.ID,
+			Username:    t.User.Username,
+			DisplayName: t.User.DisplayName,
+		},
+		FeedURL:    privateFeedURL(s.apiBaseURL, t.Token),
+		ExpiresAt:  t.ExpiresAt,
+		LastUsedAt: t.LastUsedAt,
+		CreatedAt:  t.CreatedAt,
+	}
+}
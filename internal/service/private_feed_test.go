@@ -0,0 +1,219 @@
+package service
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+
+	"github.com/siropaca/anycast-backend/internal/apperror"
+	"github.com/siropaca/anycast-backend/internal/dto/request"
+	"github.com/siropaca/anycast-backend/internal/model"
+	"github.com/siropaca/anycast-backend/internal/pkg/uuid"
+)
+
+// PrivateFeedTokenRepository のモック
+type mockPrivateFeedTokenRepository struct {
+	mock.Mock
+}
+
+func (m *mockPrivateFeedTokenRepository) Create(ctx context.Context, token *model.PrivateFeedToken) error {
+	args := m.Called(ctx, token)
+	return args.Error(0)
+}
+
+func (m *mockPrivateFeedTokenRepository) FindByID(ctx context.Context, id uuid.UUID) (*model.PrivateFeedToken, error) {
+	args := m.Called(ctx, id)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).(*model.PrivateFeedToken), args.Error(1)
+}
+
+func (m *mockPrivateFeedTokenRepository) FindByToken(ctx context.Context, token string) (*model.PrivateFeedToken, error) {
+	args := m.Called(ctx, token)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).(*model.PrivateFeedToken), args.Error(1)
+}
+
+func (m *mockPrivateFeedTokenRepository) FindByChannelID(ctx context.Context, channelID uuid.UUID) ([]model.PrivateFeedToken, error) {
+	args := m.Called(ctx, channelID)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).([]model.PrivateFeedToken), args.Error(1)
+}
+
+func (m *mockPrivateFeedTokenRepository) FindByUserID(ctx context.Context, userID uuid.UUID) ([]model.PrivateFeedToken, error) {
+	args := m.Called(ctx, userID)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).([]model.PrivateFeedToken), args.Error(1)
+}
+
+func (m *mockPrivateFeedTokenRepository) UpdateLastUsedAt(ctx context.Context, id uuid.UUID, lastUsedAt time.Time) error {
+	args := m.Called(ctx, id, lastUsedAt)
+	return args.Error(0)
+}
+
+func (m *mockPrivateFeedTokenRepository) Delete(ctx context.Context, id uuid.UUID) error {
+	args := m.Called(ctx, id)
+	return args.Error(0)
+}
+
+func newTestPrivateFeedService(tokenRepo *mockPrivateFeedTokenRepository, channelRepo *mockChannelRepository, userRepo *mockUserRepository) PrivateFeedService {
+	return NewPrivateFeedService(tokenRepo, channelRepo, userRepo, "https://api.example.com/api/v1")
+}
+
+func TestPrivateFeedService_Create(t *testing.T) {
+	ctx := context.Background()
+	owner := &model.User{ID: uuid.New(), Username: "owner", DisplayName: "オーナー"}
+	channel := &model.Channel{ID: uuid.New(), UserID: owner.ID, Name: "テックトーク"}
+
+	t.Run("オーナー自身にトークンを発行する", func(t *testing.T) {
+		tokenRepo := new(mockPrivateFeedTokenRepository)
+		channelRepo := new(mockChannelRepository)
+		userRepo := new(mockUserRepository)
+		channelRepo.On("FindByID", mock.Anything, channel.ID).Return(channel, nil)
+		userRepo.On("FindByID", mock.Anything, owner.ID).Return(owner, nil)
+		tokenRepo.On("Create", mock.Anything, mock.MatchedBy(func(pt *model.PrivateFeedToken) bool {
+			return pt.UserID == owner.ID && pt.ChannelID == channel.ID && pt.Token != "" && pt.ExpiresAt == nil
+		})).Return(nil)
+
+		svc := newTestPrivateFeedService(tokenRepo, channelRepo, userRepo)
+
+		resp, err := svc.Create(ctx, owner.ID.String(), channel.ID.String(), request.CreatePrivateFeedRequest{})
+
+		require.NoError(t, err)
+		assert.Equal(t, owner.ID, resp.User.ID)
+		assert.Equal(t, "テックトーク", resp.Channel.Name)
+		assert.Regexp(t, `^https://api\.example\.com/api/v1/private-feeds/[A-Za-z0-9_-]+/feed\.xml$`, resp.FeedURL)
+		tokenRepo.AssertExpectations(t)
+	})
+
+	t.Run("ユーザー名を指定すると他のユーザーに有効期限付きで発行する", func(t *testing.T) {
+		listener := &model.User{ID: uuid.New(), Username: "listener", DisplayName: "リスナー"}
+		expiresAt := time.Now().Add(24 * time.Hour).UTC().Truncate(time.Second)
+		expiresAtStr := expiresAt.Format(time.RFC3339)
+		username := "listener"
+
+		tokenRepo := new(mockPrivateFeedTokenRepository)
+		channelRepo := new(mockChannelRepository)
+		userRepo := new(mockUserRepository)
+		channelRepo.On("FindByID", mock.Anything, channel.ID).Return(channel, nil)
+		userRepo.On("FindByUsernameWithAvatar", mock.Anything, username).Return(listener, nil)
+		tokenRepo.On("Create", mock.Anything, mock.MatchedBy(func(pt *model.PrivateFeedToken) bool {
+			return pt.UserID == listener.ID && pt.ExpiresAt != nil && pt.ExpiresAt.Equal(expiresAt)
+		})).Return(nil)
+
+		svc := newTestPrivateFeedService(tokenRepo, channelRepo, userRepo)
+
+		resp, err := svc.Create(ctx, owner.ID.String(), channel.ID.String(), request.CreatePrivateFeedRequest{Username: &username, ExpiresAt: &expiresAtStr})
+
+		require.NoError(t, err)
+		assert.Equal(t, "listener", resp.User.Username)
+		tokenRepo.AssertExpectations(t)
+	})
+
+	t.Run("過去の有効期限はバリデーションエラーを返す", func(t *testing.T) {
+		past := time.Now().Add(-time.Hour).Format(time.RFC3339)
+
+		channelRepo := new(mockChannelRepository)
+		channelRepo.On("FindByID", mock.Anything, channel.ID).Return(channel, nil)
+
+		svc := newTestPrivateFeedService(new(mockPrivateFeedTokenRepository), channelRepo, new(mockUserRepository))
+
+		_, err := svc.Create(ctx, owner.ID.String(), channel.ID.String(), request.CreatePrivateFeedRequest{ExpiresAt: &past})
+
+		assert.True(t, apperror.IsCode(err, apperror.CodeValidation))
+	})
+
+	t.Run("オーナー以外は発行できない", func(t *testing.T) {
+		channelRepo := new(mockChannelRepository)
+		channelRepo.On("FindByID", mock.Anything, channel.ID).Return(channel, nil)
+
+		svc := newTestPrivateFeedService(new(mockPrivateFeedTokenRepository), channelRepo, new(mockUserRepository))
+
+		_, err := svc.Create(ctx, uuid.New().String(), channel.ID.String(), request.CreatePrivateFeedRequest{})
+
+		assert.True(t, apperror.IsCode(err, apperror.CodeForbidden))
+	})
+}
+
+func TestPrivateFeedService_DeleteByChannel(t *testing.T) {
+	ctx := context.Background()
+	ownerID := uuid.New()
+	channel := &model.Channel{ID: uuid.New(), UserID: ownerID}
+
+	t.Run("チャンネルのトークンを失効させる", func(t *testing.T) {
+		pt := &model.PrivateFeedToken{ID: uuid.New(), ChannelID: channel.ID, UserID: uuid.New()}
+
+		tokenRepo := new(mockPrivateFeedTokenRepository)
+		channelRepo := new(mockChannelRepository)
+		channelRepo.On("FindByID", mock.Anything, channel.ID).Return(channel, nil)
+		tokenRepo.On("FindByID", mock.Anything, pt.ID).Return(pt, nil)
+		tokenRepo.On("Delete", mock.Anything, pt.ID).Return(nil)
+
+		svc := newTestPrivateFeedService(tokenRepo, channelRepo, new(mockUserRepository))
+
+		err := svc.DeleteByChannel(ctx, ownerID.String(), channel.ID.String(), pt.ID.String())
+
+		require.NoError(t, err)
+		tokenRepo.AssertExpectations(t)
+	})
+
+	t.Run("別のチャンネルのトークンは NotFound を返す", func(t *testing.T) {
+		pt := &model.PrivateFeedToken{ID: uuid.New(), ChannelID: uuid.New()}
+
+		tokenRepo := new(mockPrivateFeedTokenRepository)
+		channelRepo := new(mockChannelRepository)
+		channelRepo.On("FindByID", mock.Anything, channel.ID).Return(channel, nil)
+		tokenRepo.On("FindByID", mock.Anything, pt.ID).Return(pt, nil)
+
+		svc := newTestPrivateFeedService(tokenRepo, channelRepo, new(mockUserRepository))
+
+		err := svc.DeleteByChannel(ctx, ownerID.String(), channel.ID.String(), pt.ID.String())
+
+		assert.True(t, apperror.IsCode(err, apperror.CodeNotFound))
+		tokenRepo.AssertNotCalled(t, "Delete", mock.Anything, mock.Anything)
+	})
+}
+
+func TestPrivateFeedService_DeleteMine(t *testing.T) {
+	ctx := context.Background()
+	userID := uuid.New()
+
+	t.Run("自分に発行されたトークンを失効させる", func(t *testing.T) {
+		pt := &model.PrivateFeedToken{ID: uuid.New(), UserID: userID}
+
+		tokenRepo := new(mockPrivateFeedTokenRepository)
+		tokenRepo.On("FindByID", mock.Anything, pt.ID).Return(pt, nil)
+		tokenRepo.On("Delete", mock.Anything, pt.ID).Return(nil)
+
+		svc := newTestPrivateFeedService(tokenRepo, new(mockChannelRepository), new(mockUserRepository))
+
+		err := svc.DeleteMine(ctx, userID.String(), pt.ID.String())
+
+		require.NoError(t, err)
+		tokenRepo.AssertExpectations(t)
+	})
+
+	t.Run("他のユーザーのトークンは NotFound を返す", func(t *testing.T) {
+		pt := &model.PrivateFeedToken{ID: uuid.New(), UserID: uuid.New()}
+
+		tokenRepo := new(mockPrivateFeedTokenRepository)
+		tokenRepo.On("FindByID", mock.Anything, pt.ID).Return(pt, nil)
+
+		svc := newTestPrivateFeedService(tokenRepo, new(mockChannelRepository), new(mockUserRepository))
+
+		err := svc.DeleteMine(ctx, userID.String(), pt.ID.String())
+
+		assert.True(t, apperror.IsCode(err, apperror.CodeNotFound))
+	})
+}
@@ -0,0 +1,341 @@
+package service
+
+import (
+	"context"
+	"strings"
+
+	"github.com/siropaca/anycast-backend/internal/apperror"
+	"github.com/siropaca/anycast-backend/internal/dto/request"
+	"github.com/siropaca/anycast-backend/internal/dto/response"
+	"github.com/siropaca/anycast-backend/internal/model"
+	"github.com/siropaca/anycast-backend/internal/pkg/pronunciation"
+	"github.com/siropaca/anycast-backend/internal/pkg/uuid"
+	"github.com/siropaca/anycast-backend/internal/repository"
+)
+
+// PronunciationService は発音辞書関連のビジネスロジックインターフェースを表す
+type PronunciationService interface {
+	ListMyPronunciations(ctx context.Context, userID string) (*response.PronunciationListDataResponse, error)
+	CreateMyPronunciation(ctx context.Context, userID string, req request.CreatePronunciationRequest) (*response.PronunciationDataResponse, error)
+	UpdateMyPronunciation(ctx context.Context, userID, pronunciationID string, req request.UpdatePronunciationRequest) (*response.PronunciationDataResponse, error)
+	DeleteMyPronunciation(ctx context.Context, userID, pronunciationID string) error
+	ListChannelPronunciations(ctx context.Context, userID, channelID string) (*response.PronunciationListDataResponse, error)
+	CreateChannelPronunciation(ctx context.Context, userID, channelID string, req request.CreatePronunciationRequest) (*response.PronunciationDataResponse, error)
+	UpdateChannelPronunciation(ctx context.Context, userID, channelID, pronunciationID string, req request.UpdatePronunciationRequest) (*response.PronunciationDataResponse, error)
+	DeleteChannelPronunciation(ctx context.Context, userID, channelID, pronunciationID string) error
+}
+
+type pronunciationService struct {
+	pronunciationRepo repository.PronunciationRepository
+	channelRepo       repository.ChannelRepository
+}
+
+// NewPronunciationService は pronunciationService を生成して PronunciationService として返す
+func NewPronunciationService(
+	pronunciationRepo repository.PronunciationRepository,
+	channelRepo repository.ChannelRepository,
+) PronunciationService {
+	return &pronunciationService{
+		pronunciationRepo: pronunciationRepo,
+		channelRepo:       channelRepo,
+	}
+}
+
+// pronunciationScope は操作対象の辞書（ユーザー辞書またはチャンネル辞書）を表す
+type pronunciationScope struct {
+	userID    uuid.UUID
+	channelID *uuid.UUID
+}
+
+// ListMyPronunciations はユーザー辞書のエントリ一覧を取得する
+func (s *pronunciationService) ListMyPronunciations(ctx context.Context, userID string) (*response.PronunciationListDataResponse, error) {
+	scope, err := s.userScope(userID)
+	if err != nil {
+		return nil, err
+	}
+
+	return s.list(ctx, scope)
+}
+
+// CreateMyPronunciation はユーザー辞書にエントリを登録する
+func (s *pronunciationService) CreateMyPronunciation(ctx context.Context, userID string, req request.CreatePronunciationRequest) (*response.PronunciationDataResponse, error) {
+	scope, err := s.userScope(userID)
+	if err != nil {
+		return nil, err
+	}
+
+	return s.create(ctx, scope, req)
+}
+
+// UpdateMyPronunciation はユーザー辞書のエントリを更新する
+func (s *pronunciationService) UpdateMyPronunciation(ctx context.Context, userID, pronunciationID string, req request.UpdatePronunciationRequest) (*response.PronunciationDataResponse, error) {
+	scope, err := s.userScope(userID)
+	if err != nil {
+		return nil, err
+	}
+
+	return s.update(ctx, scope, pronunciationID, req)
+}
+
+// DeleteMyPronunciation はユーザー辞書のエントリを削除する
+func (s *pronunciationService) DeleteMyPronunciation(ctx context.Context, userID, pronunciationID string) error {
+	scope, err := s.userScope(userID)
+	if err != nil {
+		return err
+	}
+
+	return s.delete(ctx, scope, pronunciationID)
+}
+
+// ListChannelPronunciations はチャンネル辞書のエントリ一覧を取得する
+func (s *pronunciationService) ListChannelPronunciations(ctx context.Context, userID, channelID string) (*response.PronunciationListDataResponse, error) {
+	scope, err := s.channelScope(ctx, userID, channelID)
+	if err != nil {
+		return nil, err
+	}
+
+	return s.list(ctx, scope)
+}
+
+// CreateChannelPronunciation はチャンネル辞書にエントリを登録する
+func (s *pronunciationService) CreateChannelPronunciation(ctx context.Context, userID, channelID string, req request.CreatePronunciationRequest) (*response.PronunciationDataResponse, error) {
+	scope, err := s.channelScope(ctx, userID, channelID)
+	if err != nil {
+		return nil, err
+	}
+
+	return s.create(ctx, scope, req)
+}
+
+// UpdateChannelPronunciation はチャンネル辞書のエントリを更新する
+func (s *pronunciationService) UpdateChannelPronunciation(ctx context.Context, userID, channelID, pronunciationID string, req request.UpdatePronunciationRequest) (*response.PronunciationDataResponse, error) {
+	scope, err := s.channelScope(ctx, userID, channelID)
+	if err != nil {
+		return nil, err
+	}
+
+	return s.update(ctx, scope, pronunciationID, req)
+}
+
+// DeleteChannelPronunciation はチャンネル辞書のエントリを削除する
+func (s *pronunciationService) DeleteChannelPronunciation(ctx context.Context, userID, channelID, pronunciationID string) error {
+	scope, err := s.channelScope(ctx, userID, channelID)
+	if err != nil {
+		return err
+	}
+
+	return s.delete(ctx, scope, pronunciationID)
+}
+
+// userScope はユーザー辞書のスコープを返す
+func (s *pronunciationService) userScope(userID string) (pronunciationScope, error) {
+	uid, err := uuid.Parse(userID)
+	if err != nil {
+		return pronunciationScope{}, err
+	}
+
+	return pronunciationScope{userID: uid}, nil
+}
+
+// channelScope はチャンネルのオーナーチェックを行い、チャンネル辞書のスコープを返す
+func (s *pronunciationService) channelScope(ctx context.Context, userID, channelID string) (pronunciationScope, error) {
+	uid, err := uuid.Parse(userID)
+	if err != nil {
+		return pronunciationScope{}, err
+	}
+
+	cid, err := uuid.Parse(channelID)
+	if err != nil {
+		return pronunciationScope{}, err
+	}
+
+	channel, err := s.channelRepo.FindByID(ctx, cid)
+	if err != nil {
+		return pronunciationScope{}, err
+	}
+
+	if channel.UserID != uid {
+		return pronunciationScope{}, apperror.ErrForbidden.WithMessage("このチャンネルへのアクセス権限がありません")
+	}
+
+	return pronunciationScope{userID: uid, channelID: &cid}, nil
+}
+
+func (s *pronunciationService) list(ctx context.Context, scope pronunciationScope) (*response.PronunciationListDataResponse, error) {
+	var pronunciations []model.Pronunciation
+	var err error
+	if scope.channelID != nil {
+		pronunciations, err = s.pronunciationRepo.FindByChannelID(ctx, *scope.channelID)
+	} else {
+		pronunciations, err = s.pronunciationRepo.FindByUserID(ctx, scope.userID)
+	}
+	if err != nil {
+		return nil, err
+	}
+
+	data := make([]response.PronunciationResponse, len(pronunciations))
+	for i, p := range pronunciations {
+		data[i] = toPronunciationResponse(p)
+	}
+
+	return &response.PronunciationListDataResponse{Data: data}, nil
+}
+
+func (s *pronunciationService) create(ctx context.Context, scope pronunciationScope, req request.CreatePronunciationRequest) (*response.PronunciationDataResponse, error) {
+	surface, reading, err := normalizePronunciation(req.Surface, req.Reading)
+	if err != nil {
+		return nil, err
+	}
+
+	if err := s.checkDuplicateSurface(ctx, scope, surface, nil); err != nil {
+		return nil, err
+	}
+
+	p := &model.Pronunciation{
+		ID:        uuid.New(),
+		UserID:    scope.userID,
+		ChannelID: scope.channelID,
+		Surface:   surface,
+		Reading:   reading,
+	}
+
+	if err := s.pronunciationRepo.Create(ctx, p); err != nil {
+		return nil, err
+	}
+
+	return &response.PronunciationDataResponse{Data: toPronunciationResponse(*p)}, nil
+}
+
+func (s *pronunciationService) update(ctx context.Context, scope pronunciationScope, pronunciationID string, req request.UpdatePronunciationRequest) (*response.PronunciationDataResponse, error) {
+	p, err := s.findInScope(ctx, scope, pronunciationID)
+	if err != nil {
+		return nil, err
+	}
+
+	surface, reading := p.Surface, p.Reading
+	if req.Surface != nil {
+		surface = *req.Surface
+	}
+	if req.Reading != nil {
+		reading = *req.Reading
+	}
+
+	surface, reading, err = normalizePronunciation(surface, reading)
+	if err != nil {
+		return nil, err
+	}
+
+	if surface != p.Surface {
+		if err := s.checkDuplicateSurface(ctx, scope, surface, &p.ID); err != nil {
+			return nil, err
+		}
+	}
+
+	p.Surface = surface
+	p.Reading = reading
+
+	if err := s.pronunciationRepo.Update(ctx, p); err != nil {
+		return nil, err
+	}
+
+	return &response.PronunciationDataResponse{Data: toPronunciationResponse(*p)}, nil
+}
+
+func (s *pronunciationService) delete(ctx context.Context, scope pronunciationScope, pronunciationID string) error {
+	p, err := s.findInScope(ctx, scope, pronunciationID)
+	if err != nil {
+		return err
+	}
+
+	return s.pronunciationRepo.Delete(ctx, p.ID)
+}
+
+// findInScope は指定された辞書に属するエントリを取得する（他の辞書のエントリは存在しないものとして扱う）
+func (s *pronunciationService) findInScope(ctx context.Context, scope pronunciationScope, pronunciationID string) (*model.Pronunciation, error) {
+	pid, err := uuid.Parse(pronunciationID)
+	if err != nil {
+		return nil, err
+	}
+
+	p, err := s.pronunciationRepo.FindByID(ctx, pid)
+	if err != nil {
+		return nil, err
+	}
+
+	if p.UserID != scope.userID || !sameChannelID(p.ChannelID, scope.channelID) {
+		return nil, apperror.ErrNotFound.WithMessage("発音辞書のエントリが見つかりません")
+	}
+
+	return p, nil
+}
+
+func (s *pronunciationService) checkDuplicateSurface(ctx context.Context, scope pronunciationScope, surface string, excludeID *uuid.UUID) error {
+	exists, err := s.pronunciationRepo.ExistsBySurface(ctx, scope.userID, scope.channelID, surface, excludeID)
+	if err != nil {
+		return err
+	}
+	if exists {
+		return apperror.ErrDuplicateName.WithMessage("同じ表記のエントリが既に登録されています")
+	}
+
+	return nil
+}
+
+// normalizePronunciation は表記と読みの前後の空白を除去し、空でないことを確認する
+func normalizePronunciation(surface, reading string) (string, string, error) {
+	surface = strings.TrimSpace(surface)
+	reading = strings.TrimSpace(reading)
+
+	if surface == "" {
+		return "", "", apperror.ErrValidation.WithMessage("表記を入力してください")
+	}
+	if reading == "" {
+		return "", "", apperror.ErrValidation.WithMessage("読みを入力してください")
+	}
+
+	return surface, reading, nil
+}
+
+// sameChannelID は 2 つのチャンネル ID（nil はユーザー辞書）が同じ辞書を指すかを返す
+func sameChannelID(a, b *uuid.UUID) bool {
+	if a == nil || b == nil {
+		return a == nil && b == nil
+	}
+	return *a == *b
+}
+
+// toPronunciationResponse は発音辞書エントリをレスポンス DTO に変換する
+func toPronunciationResponse(p model.Pronunciation) response.PronunciationResponse {
+	return response.PronunciationResponse{
+		ID:        p.ID,
+		Surface:   p.Surface,
+		Reading:   p.Reading,
+		CreatedAt: p.CreatedAt,
+		UpdatedAt: p.UpdatedAt,
+	}
+}
+
+// loadPronunciationDictionary はチャンネルで使用する発音辞書を構築する
+//
+// チャンネルオーナーのユーザー辞書とチャンネル辞書を合わせ、同じ表記はチャンネル辞書を優先する
+func loadPronunciationDictionary(ctx context.Context, repo repository.PronunciationRepository, channel *model.Channel) (*pronunciation.Dictionary, error) {
+	userEntries, err := repo.FindByUserID(ctx, channel.UserID)
+	if err != nil {
+		return nil, err
+	}
+
+	channelEntries, err := repo.FindByChannelID(ctx, channel.ID)
+	if err != nil {
+		return nil, err
+	}
+
+	entries := make([]pronunciation.Entry, 0, len(userEntries)+len(channelEntries))
+	for _, p := range userEntries {
+		entries = append(entries, pronunciation.Entry{Surface: p.Surface, Reading: p.Reading})
+	}
+	for _, p := range channelEntries {
+		entries = append(entries, pronunciation.Entry{Surface: p.Surface, Reading: p.Reading})
+	}
+
+	return pronunciation.NewDictionary(entries), nil
+}
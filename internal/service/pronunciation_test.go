@@ -0,0 +1,204 @@
+package service
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+
+	"github.com/siropaca/anycast-backend/internal/apperror"
+	"github.com/siropaca/anycast-backend/internal/dto/request"
+	"github.com/siropaca/anycast-backend/internal/model"
+	"github.com/siropaca/anycast-backend/internal/pkg/uuid"
+)
+
+// mockPronunciationRepository は PronunciationRepository のモック
+type mockPronunciationRepository struct {
+	mock.Mock
+}
+
+func (m *mockPronunciationRepository) FindByID(ctx context.Context, id uuid.UUID) (*model.Pronunciation, error) {
+	args := m.Called(ctx, id)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).(*model.Pronunciation), args.Error(1)
+}
+
+func (m *mockPronunciationRepository) FindByUserID(ctx context.Context, userID uuid.UUID) ([]model.Pronunciation, error) {
+	args := m.Called(ctx, userID)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).([]model.Pronunciation), args.Error(1)
+}
+
+func (m *mockPronunciationRepository) FindByChannelID(ctx context.Context, channelID uuid.UUID) ([]model.Pronunciation, error) {
+	args := m.Called(ctx, channelID)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).([]model.Pronunciation), args.Error(1)
+}
+
+func (m *mockPronunciationRepository) ExistsBySurface(ctx context.Context, userID uuid.UUID, channelID *uuid.UUID, surface string, excludeID *uuid.UUID) (bool, error) {
+	args := m.Called(ctx, userID, channelID, surface, excludeID)
+	return args.Bool(0), args.Error(1)
+}
+
+func (m *mockPronunciationRepository) Create(ctx context.Context, pronunciation *model.Pronunciation) error {
+	args := m.Called(ctx, pronunciation)
+	return args.Error(0)
+}
+
+func (m *mockPronunciationRepository) Update(ctx context.Context, pronunciation *model.Pronunciation) error {
+	args := m.Called(ctx, pronunciation)
+	return args.Error(0)
+}
+
+func (m *mockPronunciationRepository) Delete(ctx context.Context, id uuid.UUID) error {
+	args := m.Called(ctx, id)
+	return args.Error(0)
+}
+
+func TestPronunciationService_CreateMyPronunciation(t *testing.T) {
+	ctx := context.Background()
+	userID := uuid.New()
+
+	t.Run("前後の空白を除去してユーザー辞書に登録する", func(t *testing.T) {
+		mockRepo := new(mockPronunciationRepository)
+		mockRepo.On("ExistsBySurface", ctx, userID, (*uuid.UUID)(nil), "API", (*uuid.UUID)(nil)).Return(false, nil)
+		mockRepo.On("Create", ctx, mock.MatchedBy(func(p *model.Pronunciation) bool {
+			return p.UserID == userID && p.ChannelID == nil && p.Surface == "API" && p.Reading == "エーピーアイ"
+		})).Return(nil)
+
+		svc := &pronunciationService{pronunciationRepo: mockRepo}
+
+		result, err := svc.CreateMyPronunciation(ctx, userID.String(), request.CreatePronunciationRequest{Surface: " API ", Reading: "エーピーアイ\n"})
+
+		require.NoError(t, err)
+		assert.Equal(t, "API", result.Data.Surface)
+		assert.Equal(t, "エーピーアイ", result.Data.Reading)
+		mockRepo.AssertExpectations(t)
+	})
+
+	t.Run("同じ表記が登録済みの場合は DUPLICATE_NAME を返す", func(t *testing.T) {
+		mockRepo := new(mockPronunciationRepository)
+		mockRepo.On("ExistsBySurface", ctx, userID, (*uuid.UUID)(nil), "API", (*uuid.UUID)(nil)).Return(true, nil)
+
+		svc := &pronunciationService{pronunciationRepo: mockRepo}
+
+		_, err := svc.CreateMyPronunciation(ctx, userID.String(), request.CreatePronunciationRequest{Surface: "API", Reading: "エーピーアイ"})
+
+		var appErr *apperror.AppError
+		require.ErrorAs(t, err, &appErr)
+		assert.Equal(t, apperror.CodeDuplicateName, appErr.Code)
+		mockRepo.AssertNotCalled(t, "Create", mock.Anything, mock.Anything)
+	})
+
+	t.Run("空白のみの読みはバリデーションエラー", func(t *testing.T) {
+		svc := &pronunciationService{}
+
+		_, err := svc.CreateMyPronunciation(ctx, userID.String(), request.CreatePronunciationRequest{Surface: "API", Reading: "  "})
+
+		var appErr *apperror.AppError
+		require.ErrorAs(t, err, &appErr)
+		assert.Equal(t, apperror.CodeValidation, appErr.Code)
+	})
+}
+
+func TestPronunciationService_CreateChannelPronunciation(t *testing.T) {
+	ctx := context.Background()
+	userID := uuid.New()
+	channelID := uuid.New()
+
+	t.Run("チャンネル辞書に登録する", func(t *testing.T) {
+		mockChannelRepo := new(mockChannelRepository)
+		mockRepo := new(mockPronunciationRepository)
+		mockChannelRepo.On("FindByID", ctx, channelID).Return(&model.Channel{ID: channelID, UserID: userID}, nil)
+		mockRepo.On("ExistsBySurface", ctx, userID, &channelID, "杏寿郎", (*uuid.UUID)(nil)).Return(false, nil)
+		mockRepo.On("Create", ctx, mock.MatchedBy(func(p *model.Pronunciation) bool {
+			return p.ChannelID != nil && *p.ChannelID == channelID
+		})).Return(nil)
+
+		svc := &pronunciationService{pronunciationRepo: mockRepo, channelRepo: mockChannelRepo}
+
+		_, err := svc.CreateChannelPronunciation(ctx, userID.String(), channelID.String(), request.CreatePronunciationRequest{Surface: "杏寿郎", Reading: "きょうじゅろう"})
+
+		require.NoError(t, err)
+		mockRepo.AssertExpectations(t)
+	})
+
+	t.Run("他人のチャンネルの場合は FORBIDDEN を返す", func(t *testing.T) {
+		mockChannelRepo := new(mockChannelRepository)
+		mockChannelRepo.On("FindByID", ctx, channelID).Return(&model.Channel{ID: channelID, UserID: uuid.New()}, nil)
+
+		svc := &pronunciationService{channelRepo: mockChannelRepo}
+
+		_, err := svc.CreateChannelPronunciation(ctx, userID.String(), channelID.String(), request.CreatePronunciationRequest{Surface: "杏寿郎", Reading: "きょうじゅろう"})
+
+		var appErr *apperror.AppError
+		require.ErrorAs(t, err, &appErr)
+		assert.Equal(t, apperror.CodeForbidden, appErr.Code)
+	})
+}
+
+func TestPronunciationService_UpdateMyPronunciation(t *testing.T) {
+	ctx := context.Background()
+	userID := uuid.New()
+	pronunciationID := uuid.New()
+
+	t.Run("読みのみ更新する場合は重複チェックしない", func(t *testing.T) {
+		mockRepo := new(mockPronunciationRepository)
+		mockRepo.On("FindByID", ctx, pronunciationID).Return(&model.Pronunciation{ID: pronunciationID, UserID: userID, Surface: "AI", Reading: "エーアイ"}, nil)
+		mockRepo.On("Update", ctx, mock.Anything).Return(nil)
+
+		svc := &pronunciationService{pronunciationRepo: mockRepo}
+
+		reading := "アイ"
+		result, err := svc.UpdateMyPronunciation(ctx, userID.String(), pronunciationID.String(), request.UpdatePronunciationRequest{Reading: &reading})
+
+		require.NoError(t, err)
+		assert.Equal(t, "AI", result.Data.Surface)
+		assert.Equal(t, "アイ", result.Data.Reading)
+		mockRepo.AssertNotCalled(t, "ExistsBySurface", mock.Anything, mock.Anything, mock.Anything, mock.Anything, mock.Anything)
+	})
+
+	t.Run("チャンネル辞書のエントリはユーザー辞書として更新できない", func(t *testing.T) {
+		channelID := uuid.New()
+		mockRepo := new(mockPronunciationRepository)
+		mockRepo.On("FindByID", ctx, pronunciationID).Return(&model.Pronunciation{ID: pronunciationID, UserID: userID, ChannelID: &channelID}, nil)
+
+		svc := &pronunciationService{pronunciationRepo: mockRepo}
+
+		reading := "アイ"
+		_, err := svc.UpdateMyPronunciation(ctx, userID.String(), pronunciationID.String(), request.UpdatePronunciationRequest{Reading: &reading})
+
+		var appErr *apperror.AppError
+		require.ErrorAs(t, err, &appErr)
+		assert.Equal(t, apperror.CodeNotFound, appErr.Code)
+	})
+}
+
+func TestLoadPronunciationDictionary(t *testing.T) {
+	ctx := context.Background()
+	channel := &model.Channel{ID: uuid.New(), UserID: uuid.New()}
+
+	t.Run("同じ表記はチャンネル辞書を優先する", func(t *testing.T) {
+		mockRepo := new(mockPronunciationRepository)
+		mockRepo.On("FindByUserID", ctx, channel.UserID).Return([]model.Pronunciation{
+			{Surface: "AI", Reading: "エーアイ"},
+			{Surface: "API", Reading: "エーピーアイ"},
+		}, nil)
+		mockRepo.On("FindByChannelID", ctx, channel.ID).Return([]model.Pronunciation{
+			{Surface: "AI", Reading: "アイ"},
+		}, nil)
+
+		dict, err := loadPronunciationDictionary(ctx, mockRepo, channel)
+
+		require.NoError(t, err)
+		assert.Equal(t, "アイとエーピーアイ", dict.Apply("AIとAPI"))
+	})
+}
@@ -2,6 +2,7 @@ package service
 
 import (
 	"context"
+	"slices"
 
 	"gorm.io/gorm"
 
@@ -30,11 +31,13 @@ type ScriptService interface {
 }
 
 type scriptService struct {
-	db             *gorm.DB
-	channelRepo    repository.ChannelRepository
-	episodeRepo    repository.EpisodeRepository
-	scriptLineRepo repository.ScriptLineRepository
-	storageClient  storage.Client
+	db                    *gorm.DB
+	channelRepo           repository.ChannelRepository
+	episodeRepo           repository.EpisodeRepository
+	scriptLineRepo        repository.ScriptLineRepository
+	soundEffectRepo       repository.SoundEffectRepository
+	systemSoundEffectRepo repository.SystemSoundEffectRepository
+	storageClient         storage.Client
 }
 
 // NewScriptService は scriptService を生成して ScriptService として返す
@@ -43,14 +46,18 @@ func NewScriptService(
 	channelRepo repository.ChannelRepository,
 	episodeRepo repository.EpisodeRepository,
 	scriptLineRepo repository.ScriptLineRepository,
+	soundEffectRepo repository.SoundEffectRepository,
+	systemSoundEffectRepo repository.SystemSoundEffectRepository,
 	storageClient storage.Client,
 ) ScriptService {
 	return &scriptService{
-		db:             db,
-		channelRepo:    channelRepo,
-		episodeRepo:    episodeRepo,
-		scriptLineRepo: scriptLineRepo,
-		storageClient:  storageClient,
+		db:                    db,
+		channelRepo:           channelRepo,
+		episodeRepo:           episodeRepo,
+		scriptLineRepo:        scriptLineRepo,
+		soundEffectRepo:       soundEffectRepo,
+		systemSoundEffectRepo: systemSoundEffectRepo,
+		storageClient:         storageClient,
 	}
 }
 
@@ -161,6 +168,19 @@ func (s *scriptService) ImportScript(ctx context.Context, userID, channelID, epi
 		return nil, apperror.ErrScriptParse.WithMessage("台本のパースに失敗しました").WithDetails(details)
 	}
 
+	// 効果音が効果音ライブラリに存在するかチェック
+	var sfxNames []string
+	for _, line := range parseResult.Lines {
+		for _, name := range script.SFXNames(line.Text) {
+			if !slices.Contains(sfxNames, name) {
+				sfxNames = append(sfxNames, name)
+			}
+		}
+	}
+	if _, err := resolveSFXLibrary(ctx, s.soundEffectRepo, s.systemSoundEffectRepo, channel.UserID, sfxNames); err != nil {
+		return nil, err
+	}
+
 	// ScriptLine モデルに変換
 	scriptLines := make([]model.ScriptLine, len(parseResult.Lines))
 	for i, line := range parseResult.Lines {
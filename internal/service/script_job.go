@@ -1268,11 +1268,11 @@ func countEmotionTags(lines []script.ParsedLine) int {
 	return count
 }
 
-// countTotalChars は台本全行の合計文字数を返す
+// countTotalChars は台本全行の合計文字数（マークアップを除く）を返す
 func countTotalChars(lines []script.ParsedLine) int {
 	var total int
 	for _, line := range lines {
-		total += utf8.RuneCountInString(line.Text)
+		total += utf8.RuneCountInString(script.PlainText(line.Text))
 	}
 	return total
 }
@@ -8,7 +8,10 @@ import (
 	"github.com/siropaca/anycast-backend/internal/apperror"
 	"github.com/siropaca/anycast-backend/internal/dto/request"
 	"github.com/siropaca/anycast-backend/internal/dto/response"
+	"github.com/siropaca/anycast-backend/internal/infrastructure/storage"
+	"github.com/siropaca/anycast-backend/internal/infrastructure/tts"
 	"github.com/siropaca/anycast-backend/internal/model"
+	"github.com/siropaca/anycast-backend/internal/pkg/script"
 	"github.com/siropaca/anycast-backend/internal/pkg/uuid"
 	"github.com/siropaca/anycast-backend/internal/repository"
 )
@@ -21,13 +24,18 @@ type ScriptLineService interface {
 	Delete(ctx context.Context, userID, channelID, episodeID, lineID string) error
 	DeleteAll(ctx context.Context, userID, channelID, episodeID string) error
 	Reorder(ctx context.Context, userID, channelID, episodeID string, req request.ReorderScriptLinesRequest) (*response.ScriptLineListResponse, error)
+	Preview(ctx context.Context, userID, channelID, episodeID, lineID string) (*response.ScriptLinePreviewDataResponse, error)
 }
 
 type scriptLineService struct {
-	db             *gorm.DB
-	scriptLineRepo repository.ScriptLineRepository
-	episodeRepo    repository.EpisodeRepository
-	channelRepo    repository.ChannelRepository
+	db                    *gorm.DB
+	scriptLineRepo        repository.ScriptLineRepository
+	episodeRepo           repository.EpisodeRepository
+	channelRepo           repository.ChannelRepository
+	pronunciationRepo     repository.PronunciationRepository
+	soundEffectRepo       repository.SoundEffectRepository
+	systemSoundEffectRepo repository.SystemSoundEffectRepository
+	ttsPreviewer          *ttsPreviewer
 }
 
 // NewScriptLineService は scriptLineService を生成して ScriptLineService として返す
@@ -36,12 +44,26 @@ func NewScriptLineService(
 	scriptLineRepo repository.ScriptLineRepository,
 	episodeRepo repository.EpisodeRepository,
 	channelRepo repository.ChannelRepository,
+	pronunciationRepo repository.PronunciationRepository,
+	soundEffectRepo repository.SoundEffectRepository,
+	systemSoundEffectRepo repository.SystemSoundEffectRepository,
+	storageClient storage.Client,
+	ttsRegistry *tts.Registry,
+	ffmpegService FFmpegService,
 ) ScriptLineService {
 	return &scriptLineService{
-		db:             db,
-		scriptLineRepo: scriptLineRepo,
-		episodeRepo:    episodeRepo,
-		channelRepo:    channelRepo,
+		db:                    db,
+		scriptLineRepo:        scriptLineRepo,
+		episodeRepo:           episodeRepo,
+		channelRepo:           channelRepo,
+		pronunciationRepo:     pronunciationRepo,
+		soundEffectRepo:       soundEffectRepo,
+		systemSoundEffectRepo: systemSoundEffectRepo,
+		ttsPreviewer: &ttsPreviewer{
+			storageClient: storageClient,
+			ttsRegistry:   ttsRegistry,
+			ffmpegService: ffmpegService,
+		},
 	}
 }
 
@@ -118,6 +140,11 @@ func (s *scriptLineService) Create(ctx context.Context, userID, channelID, episo
 		return nil, apperror.ErrValidation.WithMessage("speakerId の形式が無効です")
 	}
 
+	// セリフ内マークアップの書式チェック
+	if err := script.ValidateMarkup(req.Text); err != nil {
+		return nil, apperror.ErrValidation.WithMessage(err.Error())
+	}
+
 	// チャンネルの存在確認とオーナーチェック
 	channel, err := s.channelRepo.FindByID(ctx, cid)
 	if err != nil {
@@ -128,6 +155,11 @@ func (s *scriptLineService) Create(ctx context.Context, userID, channelID, episo
 		return nil, apperror.ErrForbidden.WithMessage("このチャンネルへのアクセス権限がありません")
 	}
 
+	// 効果音が効果音ライブラリに存在するかチェック
+	if _, err := resolveSFXLibrary(ctx, s.soundEffectRepo, s.systemSoundEffectRepo, channel.UserID, script.SFXNames(req.Text)); err != nil {
+		return nil, err
+	}
+
 	// エピソードの存在確認とチャンネルの一致チェック
 	episode, err := s.episodeRepo.FindByID(ctx, eid)
 	if err != nil {
@@ -293,6 +325,14 @@ func (s *scriptLineService) Update(ctx context.Context, userID, channelID, episo
 	}
 
 	if req.Text != nil {
+		// セリフ内マークアップの書式チェック
+		if err := script.ValidateMarkup(*req.Text); err != nil {
+			return nil, apperror.ErrValidation.WithMessage(err.Error())
+		}
+		// 効果音が効果音ライブラリに存在するかチェック
+		if _, err := resolveSFXLibrary(ctx, s.soundEffectRepo, s.systemSoundEffectRepo, channel.UserID, script.SFXNames(*req.Text)); err != nil {
+			return nil, err
+		}
 		scriptLine.Text = *req.Text
 	}
 
@@ -0,0 +1,82 @@
+package service
+
+import (
+	"context"
+
+	"github.com/siropaca/anycast-backend/internal/apperror"
+	"github.com/siropaca/anycast-backend/internal/dto/response"
+	"github.com/siropaca/anycast-backend/internal/pkg/script"
+	"github.com/siropaca/anycast-backend/internal/pkg/uuid"
+)
+
+// Preview は台本行 1 行分の音声を話者の Voice・感情で合成し、プレビュー用の署名付き URL を返す
+func (s *scriptLineService) Preview(ctx context.Context, userID, channelID, episodeID, lineID string) (*response.ScriptLinePreviewDataResponse, error) {
+	uid, err := uuid.Parse(userID)
+	if err != nil {
+		return nil, err
+	}
+
+	cid, err := uuid.Parse(channelID)
+	if err != nil {
+		return nil, err
+	}
+
+	eid, err := uuid.Parse(episodeID)
+	if err != nil {
+		return nil, err
+	}
+
+	lid, err := uuid.Parse(lineID)
+	if err != nil {
+		return nil, err
+	}
+
+	// チャンネルの存在確認とオーナーチェック
+	channel, err := s.channelRepo.FindByID(ctx, cid)
+	if err != nil {
+		return nil, err
+	}
+
+	if channel.UserID != uid {
+		return nil, apperror.ErrForbidden.WithMessage("このチャンネルへのアクセス権限がありません")
+	}
+
+	// エピソードの存在確認とチャンネルの一致チェック
+	episode, err := s.episodeRepo.FindByID(ctx, eid)
+	if err != nil {
+		return nil, err
+	}
+
+	if episode.ChannelID != cid {
+		return nil, apperror.ErrNotFound.WithMessage("このチャンネルにエピソードが見つかりません")
+	}
+
+	// 台本行の存在確認とエピソードの一致チェック
+	scriptLine, err := s.scriptLineRepo.FindByID(ctx, lid)
+	if err != nil {
+		return nil, err
+	}
+
+	if scriptLine.EpisodeID != eid {
+		return nil, apperror.ErrNotFound.WithMessage("このエピソードに台本行が見つかりません")
+	}
+
+	// 音声生成と同じく発音辞書を適用した読みで合成する
+	dict, err := loadPronunciationDictionary(ctx, s.pronunciationRepo, channel)
+	if err != nil {
+		return nil, err
+	}
+
+	url, cached, err := s.ttsPreviewer.preview(ctx, scriptLine.Speaker.Voice, scriptLine.Speaker.VoiceSettings, script.MapMarkupText(scriptLine.Text, dict.Apply), scriptLine.Emotion)
+	if err != nil {
+		return nil, err
+	}
+
+	return &response.ScriptLinePreviewDataResponse{
+		Data: response.ScriptLinePreviewResponse{
+			URL:      url,
+			MimeType: mp3MimeType,
+			Cached:   cached,
+		},
+	}, nil
+}
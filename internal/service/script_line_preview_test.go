@@ -0,0 +1,162 @@
+package service
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+
+	"github.com/siropaca/anycast-backend/internal/infrastructure/storage"
+	"github.com/siropaca/anycast-backend/internal/infrastructure/tts"
+	"github.com/siropaca/anycast-backend/internal/model"
+	"github.com/siropaca/anycast-backend/internal/pkg/uuid"
+)
+
+func TestScriptLineService_Preview(t *testing.T) {
+	ctx := context.Background()
+	userID := uuid.New()
+	channelID := uuid.New()
+	episodeID := uuid.New()
+	lineID := uuid.New()
+	emotion := "嬉しそうに"
+	voice := model.Voice{
+		ID:              uuid.New(),
+		Provider:        string(tts.ProviderElevenLabs),
+		ProviderVoiceID: "voice-1",
+		Gender:          model.GenderFemale,
+	}
+
+	setupRepos := func() (*mockChannelRepository, *mockEpisodeRepository, *mockScriptLineRepository, *mockPronunciationRepository) {
+		mockChannelRepo := new(mockChannelRepository)
+		mockEpisodeRepo := new(mockEpisodeRepository)
+		mockScriptLineRepo := new(mockScriptLineRepository)
+		mockPronunciationRepo := new(mockPronunciationRepository)
+
+		mockChannelRepo.On("FindByID", ctx, channelID).Return(&model.Channel{ID: channelID, UserID: userID}, nil)
+		mockEpisodeRepo.On("FindByID", ctx, episodeID).Return(&model.Episode{ID: episodeID, ChannelID: channelID}, nil)
+		mockScriptLineRepo.On("FindByID", ctx, lineID).Return(&model.ScriptLine{
+			ID:        lineID,
+			EpisodeID: episodeID,
+			Text:      "こんにちは",
+			Emotion:   &emotion,
+			Speaker:   model.Character{Voice: voice},
+		}, nil)
+		mockPronunciationRepo.On("FindByChannelID", ctx, channelID).Return([]model.Pronunciation{}, nil)
+
+		return mockChannelRepo, mockEpisodeRepo, mockScriptLineRepo, mockPronunciationRepo
+	}
+
+	path := storage.GenerateTTSPreviewPath(ttsPreviewHash(voice, model.VoiceSettings{}, "こんにちは", &emotion))
+
+	t.Run("キャッシュがない場合は合成してアップロードする", func(t *testing.T) {
+		mockChannelRepo, mockEpisodeRepo, mockScriptLineRepo, mockPronunciationRepo := setupRepos()
+		mockPronunciationRepo.On("FindByUserID", ctx, userID).Return([]model.Pronunciation{}, nil)
+		mockStorage := new(mockStorageClient)
+		mockTTS := new(mockTTSClient)
+		registry := tts.NewRegistry()
+		registry.Register(tts.ProviderElevenLabs, mockTTS)
+
+		mockStorage.On("Exists", ctx, path).Return(false, nil)
+		mockTTS.On("Synthesize", ctx, "こんにちは", &emotion, "voice-1", model.GenderFemale, mock.Anything).
+			Return(&tts.SynthesisResult{Data: []byte("mp3"), Format: "mp3"}, nil)
+		mockStorage.On("Upload", ctx, []byte("mp3"), path, "audio/mpeg").Return(path, nil)
+		mockStorage.On("GenerateSignedURL", ctx, path, storage.SignedURLExpirationAudio).Return("https://example.com/preview.mp3", nil)
+
+		svc := &scriptLineService{
+			channelRepo:       mockChannelRepo,
+			episodeRepo:       mockEpisodeRepo,
+			scriptLineRepo:    mockScriptLineRepo,
+			pronunciationRepo: mockPronunciationRepo,
+			ttsPreviewer:      &ttsPreviewer{storageClient: mockStorage, ttsRegistry: registry},
+		}
+
+		result, err := svc.Preview(ctx, userID.String(), channelID.String(), episodeID.String(), lineID.String())
+
+		require.NoError(t, err)
+		assert.Equal(t, "https://example.com/preview.mp3", result.Data.URL)
+		assert.Equal(t, "audio/mpeg", result.Data.MimeType)
+		assert.False(t, result.Data.Cached)
+		mockStorage.AssertExpectations(t)
+		mockTTS.AssertExpectations(t)
+	})
+
+	t.Run("キャッシュがある場合は合成しない", func(t *testing.T) {
+		mockChannelRepo, mockEpisodeRepo, mockScriptLineRepo, mockPronunciationRepo := setupRepos()
+		mockPronunciationRepo.On("FindByUserID", ctx, userID).Return([]model.Pronunciation{}, nil)
+		mockStorage := new(mockStorageClient)
+		mockTTS := new(mockTTSClient)
+		registry := tts.NewRegistry()
+		registry.Register(tts.ProviderElevenLabs, mockTTS)
+
+		mockStorage.On("Exists", ctx, path).Return(true, nil)
+		mockStorage.On("GenerateSignedURL", ctx, path, storage.SignedURLExpirationAudio).Return("https://example.com/preview.mp3", nil)
+
+		svc := &scriptLineService{
+			channelRepo:       mockChannelRepo,
+			episodeRepo:       mockEpisodeRepo,
+			scriptLineRepo:    mockScriptLineRepo,
+			pronunciationRepo: mockPronunciationRepo,
+			ttsPreviewer:      &ttsPreviewer{storageClient: mockStorage, ttsRegistry: registry},
+		}
+
+		result, err := svc.Preview(ctx, userID.String(), channelID.String(), episodeID.String(), lineID.String())
+
+		require.NoError(t, err)
+		assert.True(t, result.Data.Cached)
+		mockStorage.AssertExpectations(t)
+		mockTTS.AssertNotCalled(t, "Synthesize", mock.Anything, mock.Anything, mock.Anything, mock.Anything, mock.Anything, mock.Anything)
+	})
+
+	t.Run("発音辞書を適用したテキストで合成する", func(t *testing.T) {
+		mockChannelRepo, mockEpisodeRepo, mockScriptLineRepo, mockPronunciationRepo := setupRepos()
+		mockPronunciationRepo.On("FindByUserID", ctx, userID).Return([]model.Pronunciation{
+			{Surface: "こんにちは", Reading: "コンニチワ"},
+		}, nil)
+		mockStorage := new(mockStorageClient)
+		mockTTS := new(mockTTSClient)
+		registry := tts.NewRegistry()
+		registry.Register(tts.ProviderElevenLabs, mockTTS)
+
+		readingPath := storage.GenerateTTSPreviewPath(ttsPreviewHash(voice, model.VoiceSettings{}, "コンニチワ", &emotion))
+		mockStorage.On("Exists", ctx, readingPath).Return(false, nil)
+		mockTTS.On("Synthesize", ctx, "コンニチワ", &emotion, "voice-1", model.GenderFemale, mock.Anything).
+			Return(&tts.SynthesisResult{Data: []byte("mp3"), Format: "mp3"}, nil)
+		mockStorage.On("Upload", ctx, []byte("mp3"), readingPath, "audio/mpeg").Return(readingPath, nil)
+		mockStorage.On("GenerateSignedURL", ctx, readingPath, storage.SignedURLExpirationAudio).Return("https://example.com/preview.mp3", nil)
+
+		svc := &scriptLineService{
+			channelRepo:       mockChannelRepo,
+			episodeRepo:       mockEpisodeRepo,
+			scriptLineRepo:    mockScriptLineRepo,
+			pronunciationRepo: mockPronunciationRepo,
+			ttsPreviewer:      &ttsPreviewer{storageClient: mockStorage, ttsRegistry: registry},
+		}
+
+		_, err := svc.Preview(ctx, userID.String(), channelID.String(), episodeID.String(), lineID.String())
+
+		require.NoError(t, err)
+		mockTTS.AssertExpectations(t)
+	})
+
+	t.Run("他のエピソードの台本行の場合は 404 を返す", func(t *testing.T) {
+		mockChannelRepo := new(mockChannelRepository)
+		mockEpisodeRepo := new(mockEpisodeRepository)
+		mockScriptLineRepo := new(mockScriptLineRepository)
+
+		mockChannelRepo.On("FindByID", ctx, channelID).Return(&model.Channel{ID: channelID, UserID: userID}, nil)
+		mockEpisodeRepo.On("FindByID", ctx, episodeID).Return(&model.Episode{ID: episodeID, ChannelID: channelID}, nil)
+		mockScriptLineRepo.On("FindByID", ctx, lineID).Return(&model.ScriptLine{ID: lineID, EpisodeID: uuid.New()}, nil)
+
+		svc := &scriptLineService{
+			channelRepo:    mockChannelRepo,
+			episodeRepo:    mockEpisodeRepo,
+			scriptLineRepo: mockScriptLineRepo,
+		}
+
+		_, err := svc.Preview(ctx, userID.String(), channelID.String(), episodeID.String(), lineID.String())
+
+		assert.Error(t, err)
+	})
+}
@@ -10,6 +10,7 @@ import (
 	"github.com/stretchr/testify/mock"
 
 	"github.com/siropaca/anycast-backend/internal/apperror"
+	"github.com/siropaca/anycast-backend/internal/dto/request"
 	"github.com/siropaca/anycast-backend/internal/model"
 	"github.com/siropaca/anycast-backend/internal/pkg/uuid"
 )
@@ -227,6 +228,50 @@ func TestToScriptLineResponses(t *testing.T) {
 	})
 }
 
+func TestScriptLineService_Create(t *testing.T) {
+	ctx := context.Background()
+	userID := uuid.New()
+	channelID := uuid.New()
+	episodeID := uuid.New()
+
+	t.Run("セリフ内マークアップの書式が不正な場合はバリデーションエラー", func(t *testing.T) {
+		svc := &scriptLineService{}
+
+		_, err := svc.Create(ctx, userID.String(), channelID.String(), episodeID.String(), request.CreateScriptLineRequest{
+			SpeakerID: uuid.New().String(),
+			Text:      "ちょっと待って[pause:10s]",
+		})
+
+		var appErr *apperror.AppError
+		assert.True(t, errors.As(err, &appErr))
+		assert.Equal(t, apperror.CodeValidation, appErr.Code)
+	})
+
+	t.Run("効果音ライブラリにない効果音を指定した場合はバリデーションエラー", func(t *testing.T) {
+		mockChannelRepo := new(mockChannelRepository)
+		mockSoundEffectRepo := new(mockSoundEffectRepository)
+		mockSystemSoundEffectRepo := new(mockSystemSoundEffectRepository)
+		mockChannelRepo.On("FindByID", ctx, channelID).Return(&model.Channel{ID: channelID, UserID: userID}, nil)
+		mockSoundEffectRepo.On("FindByUserIDAndNames", ctx, userID, []string{"unknown"}).Return([]model.SoundEffect{}, nil)
+		mockSystemSoundEffectRepo.On("FindActiveByNames", ctx, []string{"unknown"}).Return([]model.SystemSoundEffect{}, nil)
+
+		svc := &scriptLineService{
+			channelRepo:           mockChannelRepo,
+			soundEffectRepo:       mockSoundEffectRepo,
+			systemSoundEffectRepo: mockSystemSoundEffectRepo,
+		}
+
+		_, err := svc.Create(ctx, userID.String(), channelID.String(), episodeID.String(), request.CreateScriptLineRequest{
+			SpeakerID: uuid.New().String(),
+			Text:      "こんにちは[sfx:unknown]",
+		})
+
+		assert.True(t, apperror.IsCode(err, apperror.CodeValidation))
+		mockSoundEffectRepo.AssertExpectations(t)
+		mockSystemSoundEffectRepo.AssertExpectations(t)
+	})
+}
+
 func TestScriptLineService_Delete(t *testing.T) {
 	ctx := context.Background()
 	userID := uuid.New()
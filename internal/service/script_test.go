@@ -138,6 +138,22 @@ func (m *mockEpisodeRepository) CountByChannelIDBeforeCreatedAt(ctx context.Cont
 	return args.Get(0).(int64), args.Error(1)
 }
 
+func (m *mockEpisodeRepository) FindSourceGUIDsByChannelID(ctx context.Context, channelID uuid.UUID) ([]string, error) {
+	args := m.Called(ctx, channelID)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).([]string), args.Error(1)
+}
+
+func (m *mockEpisodeRepository) FindByAudioID(ctx context.Context, audioID uuid.UUID) ([]model.Episode, error) {
+	args := m.Called(ctx, audioID)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).([]model.Episode), args.Error(1)
+}
+
 func (m *mockEpisodeRepository) IncrementPlayCount(ctx context.Context, id uuid.UUID) error {
 	args := m.Called(ctx, id)
 	return args.Error(0)
@@ -0,0 +1,243 @@
+package service
+
+import (
+	"context"
+
+	"github.com/siropaca/anycast-backend/internal/apperror"
+	"github.com/siropaca/anycast-backend/internal/dto/request"
+	"github.com/siropaca/anycast-backend/internal/dto/response"
+	"github.com/siropaca/anycast-backend/internal/infrastructure/storage"
+	"github.com/siropaca/anycast-backend/internal/model"
+	"github.com/siropaca/anycast-backend/internal/pkg/uuid"
+	"github.com/siropaca/anycast-backend/internal/repository"
+)
+
+// SfxCueService は台本の効果音キュー関連のビジネスロジックインターフェースを表す
+type SfxCueService interface {
+	ListByEpisodeID(ctx context.Context, userID, channelID, episodeID string) (*response.SfxCueListResponse, error)
+	Create(ctx context.Context, userID, channelID, episodeID, lineID string, req request.CreateSfxCueRequest) (*response.SfxCueDataResponse, error)
+	Delete(ctx context.Context, userID, channelID, episodeID, cueID string) error
+}
+
+type sfxCueService struct {
+	channelRepo           repository.ChannelRepository
+	episodeRepo           repository.EpisodeRepository
+	scriptLineRepo        repository.ScriptLineRepository
+	sfxCueRepo            repository.SfxCueRepository
+	soundEffectRepo       repository.SoundEffectRepository
+	systemSoundEffectRepo repository.SystemSoundEffectRepository
+	storageClient         storage.Client
+}
+
+// NewSfxCueService は sfxCueService を生成して SfxCueService として返す
+func NewSfxCueService(
+	channelRepo repository.ChannelRepository,
+	episodeRepo repository.EpisodeRepository,
+	scriptLineRepo repository.ScriptLineRepository,
+	sfxCueRepo repository.SfxCueRepository,
+	soundEffectRepo repository.SoundEffectRepository,
+	systemSoundEffectRepo repository.SystemSoundEffectRepository,
+	storageClient storage.Client,
+) SfxCueService {
+	return &sfxCueService{
+		channelRepo:           channelRepo,
+		episodeRepo:           episodeRepo,
+		scriptLineRepo:        scriptLineRepo,
+		sfxCueRepo:            sfxCueRepo,
+		soundEffectRepo:       soundEffectRepo,
+		systemSoundEffectRepo: systemSoundEffectRepo,
+		storageClient:         storageClient,
+	}
+}
+
+// ListByEpisodeID は指定されたエピソードの効果音キュー一覧を台本の行順で取得する
+func (s *sfxCueService) ListByEpisodeID(ctx context.Context, userID, channelID, episodeID string) (*response.SfxCueListResponse, error) {
+	_, eid, err := s.authorizeEpisode(ctx, userID, channelID, episodeID)
+	if err != nil {
+		return nil, err
+	}
+
+	cues, err := s.sfxCueRepo.FindByEpisodeID(ctx, eid)
+	if err != nil {
+		return nil, err
+	}
+
+	responses := make([]response.SfxCueResponse, len(cues))
+	for i, cue := range cues {
+		responses[i] = s.toSfxCueResponse(ctx, cue)
+	}
+
+	return &response.SfxCueListResponse{Data: responses}, nil
+}
+
+// Create は台本行に効果音キューを追加する
+func (s *sfxCueService) Create(ctx context.Context, userID, channelID, episodeID, lineID string, req request.CreateSfxCueRequest) (*response.SfxCueDataResponse, error) {
+	// soundEffectId と systemSoundEffectId はどちらか一方のみ指定できる
+	if req.SoundEffectID != nil && req.SystemSoundEffectID != nil {
+		return nil, apperror.ErrValidation.WithMessage("soundEffectId と systemSoundEffectId は同時に指定できません")
+	}
+	if req.SoundEffectID == nil && req.SystemSoundEffectID == nil {
+		return nil, apperror.ErrValidation.WithMessage("soundEffectId または systemSoundEffectId のいずれかを指定してください")
+	}
+
+	lid, err := uuid.Parse(lineID)
+	if err != nil {
+		return nil, err
+	}
+
+	uid, eid, err := s.authorizeEpisode(ctx, userID, channelID, episodeID)
+	if err != nil {
+		return nil, err
+	}
+
+	// 台本行の存在確認とエピソードの一致チェック
+	scriptLine, err := s.scriptLineRepo.FindByID(ctx, lid)
+	if err != nil {
+		return nil, err
+	}
+	if scriptLine.EpisodeID != eid {
+		return nil, apperror.ErrNotFound.WithMessage("このエピソードに台本行が見つかりません")
+	}
+
+	cue := &model.SfxCue{
+		ID:           uuid.New(),
+		ScriptLineID: lid,
+		Position:     model.SfxCuePosition(req.Position),
+	}
+	if req.VolumeDB != nil {
+		cue.VolumeDB = *req.VolumeDB
+	}
+
+	var soundEffect *model.SoundEffect
+	var systemSoundEffect *model.SystemSoundEffect
+
+	// ユーザー効果音を設定
+	if req.SoundEffectID != nil {
+		soundEffectID, err := uuid.Parse(*req.SoundEffectID)
+		if err != nil {
+			return nil, apperror.ErrValidation.WithMessage("無効な soundEffectId です")
+		}
+
+		// 効果音の存在確認とオーナーチェック
+		soundEffect, err = s.soundEffectRepo.FindByID(ctx, soundEffectID)
+		if err != nil {
+			return nil, err
+		}
+		if soundEffect.UserID != uid {
+			return nil, apperror.ErrForbidden.WithMessage("この効果音へのアクセス権限がありません")
+		}
+
+		cue.SoundEffectID = &soundEffectID
+	}
+
+	// システム効果音を設定
+	if req.SystemSoundEffectID != nil {
+		systemSoundEffectID, err := uuid.Parse(*req.SystemSoundEffectID)
+		if err != nil {
+			return nil, apperror.ErrValidation.WithMessage("無効な systemSoundEffectId です")
+		}
+
+		// システム効果音の存在確認とアクティブチェック
+		systemSoundEffect, err = s.systemSoundEffectRepo.FindByID(ctx, systemSoundEffectID)
+		if err != nil {
+			return nil, err
+		}
+		if !systemSoundEffect.IsActive {
+			return nil, apperror.ErrNotFound.WithMessage("このシステム効果音は利用できません")
+		}
+
+		cue.SystemSoundEffectID = &systemSoundEffectID
+	}
+
+	if err := s.sfxCueRepo.Create(ctx, cue); err != nil {
+		return nil, err
+	}
+
+	// レスポンス用にリレーションを設定
+	cue.SoundEffect = soundEffect
+	cue.SystemSoundEffect = systemSoundEffect
+
+	return &response.SfxCueDataResponse{Data: s.toSfxCueResponse(ctx, *cue)}, nil
+}
+
+// Delete は効果音キューを削除する
+func (s *sfxCueService) Delete(ctx context.Context, userID, channelID, episodeID, cueID string) error {
+	cid, err := uuid.Parse(cueID)
+	if err != nil {
+		return err
+	}
+
+	_, eid, err := s.authorizeEpisode(ctx, userID, channelID, episodeID)
+	if err != nil {
+		return err
+	}
+
+	// 効果音キューの存在確認とエピソードの一致チェック
+	cue, err := s.sfxCueRepo.FindByID(ctx, cid)
+	if err != nil {
+		return err
+	}
+	if cue.ScriptLine.EpisodeID != eid {
+		return apperror.ErrNotFound.WithMessage("このエピソードに効果音キューが見つかりません")
+	}
+
+	return s.sfxCueRepo.Delete(ctx, cid)
+}
+
+// authorizeEpisode はチャンネルのオーナーチェックとエピソードの一致チェックを行い、ユーザー ID とエピソード ID を返す
+func (s *sfxCueService) authorizeEpisode(ctx context.Context, userID, channelID, episodeID string) (uuid.UUID, uuid.UUID, error) {
+	uid, err := uuid.Parse(userID)
+	if err != nil {
+		return uuid.Nil, uuid.Nil, err
+	}
+
+	cid, err := uuid.Parse(channelID)
+	if err != nil {
+		return uuid.Nil, uuid.Nil, err
+	}
+
+	eid, err := uuid.Parse(episodeID)
+	if err != nil {
+		return uuid.Nil, uuid.Nil, err
+	}
+
+	// チャンネルの存在確認とオーナーチェック
+	channel, err := s.channelRepo.FindByID(ctx, cid)
+	if err != nil {
+		return uuid.Nil, uuid.Nil, err
+	}
+	if channel.UserID != uid {
+		return uuid.Nil, uuid.Nil, apperror.ErrForbidden.WithMessage("このチャンネルへのアクセス権限がありません")
+	}
+
+	// エピソードの存在確認とチャンネルの一致チェック
+	episode, err := s.episodeRepo.FindByID(ctx, eid)
+	if err != nil {
+		return uuid.Nil, uuid.Nil, err
+	}
+	if episode.ChannelID != cid {
+		return uuid.Nil, uuid.Nil, apperror.ErrNotFound.WithMessage("このチャンネルにエピソードが見つかりません")
+	}
+
+	return uid, eid, nil
+}
+
+// toSfxCueResponse は SfxCue をレスポンス DTO に変換する
+func (s *sfxCueService) toSfxCueResponse(ctx context.Context, cue model.SfxCue) response.SfxCueResponse {
+	var soundEffect response.SoundEffectResponse
+	switch {
+	case cue.SoundEffect != nil:
+		soundEffect = toSoundEffectResponse(ctx, s.storageClient, *cue.SoundEffect)
+	case cue.SystemSoundEffect != nil:
+		soundEffect = toSystemSoundEffectResponse(ctx, s.storageClient, *cue.SystemSoundEffect)
+	}
+
+	return response.SfxCueResponse{
+		ID:           cue.ID,
+		ScriptLineID: cue.ScriptLineID,
+		Position:     string(cue.Position),
+		VolumeDB:     cue.VolumeDB,
+		SoundEffect:  soundEffect,
+		CreatedAt:    cue.CreatedAt,
+	}
+}
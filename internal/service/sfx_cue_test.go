@@ -0,0 +1,263 @@
+package service
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+
+	"github.com/siropaca/anycast-backend/internal/apperror"
+	"github.com/siropaca/anycast-backend/internal/dto/request"
+	"github.com/siropaca/anycast-backend/internal/model"
+	"github.com/siropaca/anycast-backend/internal/pkg/uuid"
+)
+
+type mockSfxCueRepository struct {
+	mock.Mock
+}
+
+func (m *mockSfxCueRepository) FindByID(ctx context.Context, id uuid.UUID) (*model.SfxCue, error) {
+	args := m.Called(ctx, id)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).(*model.SfxCue), args.Error(1)
+}
+
+func (m *mockSfxCueRepository) FindByEpisodeID(ctx context.Context, episodeID uuid.UUID) ([]model.SfxCue, error) {
+	args := m.Called(ctx, episodeID)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).([]model.SfxCue), args.Error(1)
+}
+
+func (m *mockSfxCueRepository) Create(ctx context.Context, cue *model.SfxCue) error {
+	args := m.Called(ctx, cue)
+	return args.Error(0)
+}
+
+func (m *mockSfxCueRepository) Delete(ctx context.Context, id uuid.UUID) error {
+	args := m.Called(ctx, id)
+	return args.Error(0)
+}
+
+func TestSfxCueService_Create(t *testing.T) {
+	ctx := context.Background()
+	userID := uuid.New()
+	channelID := uuid.New()
+	episodeID := uuid.New()
+	lineID := uuid.New()
+	soundEffectID := uuid.New()
+	systemSoundEffectID := uuid.New()
+
+	setupEpisode := func(mockChannelRepo *mockChannelRepository, mockEpisodeRepo *mockEpisodeRepository, mockScriptLineRepo *mockScriptLineRepository) {
+		mockChannelRepo.On("FindByID", ctx, channelID).Return(&model.Channel{ID: channelID, UserID: userID}, nil)
+		mockEpisodeRepo.On("FindByID", ctx, episodeID).Return(&model.Episode{ID: episodeID, ChannelID: channelID}, nil)
+		mockScriptLineRepo.On("FindByID", ctx, lineID).Return(&model.ScriptLine{ID: lineID, EpisodeID: episodeID}, nil)
+	}
+
+	t.Run("ユーザー効果音のキューを作成できる", func(t *testing.T) {
+		mockChannelRepo := new(mockChannelRepository)
+		mockEpisodeRepo := new(mockEpisodeRepository)
+		mockScriptLineRepo := new(mockScriptLineRepository)
+		mockSfxCueRepo := new(mockSfxCueRepository)
+		mockSoundEffectRepo := new(mockSoundEffectRepository)
+		setupEpisode(mockChannelRepo, mockEpisodeRepo, mockScriptLineRepo)
+
+		mockSoundEffectRepo.On("FindByID", ctx, soundEffectID).Return(&model.SoundEffect{
+			ID:     soundEffectID,
+			UserID: userID,
+			Name:   "door_knock",
+		}, nil)
+		mockSfxCueRepo.On("Create", ctx, mock.MatchedBy(func(cue *model.SfxCue) bool {
+			return cue.ScriptLineID == lineID &&
+				cue.SoundEffectID != nil && *cue.SoundEffectID == soundEffectID &&
+				cue.SystemSoundEffectID == nil &&
+				cue.Position == model.SfxCuePositionAfter &&
+				cue.VolumeDB == -6
+		})).Return(nil)
+
+		svc := &sfxCueService{
+			channelRepo:     mockChannelRepo,
+			episodeRepo:     mockEpisodeRepo,
+			scriptLineRepo:  mockScriptLineRepo,
+			sfxCueRepo:      mockSfxCueRepo,
+			soundEffectRepo: mockSoundEffectRepo,
+		}
+
+		soundEffectIDStr := soundEffectID.String()
+		volumeDB := -6.0
+		result, err := svc.Create(ctx, userID.String(), channelID.String(), episodeID.String(), lineID.String(), request.CreateSfxCueRequest{
+			SoundEffectID: &soundEffectIDStr,
+			Position:      "after",
+			VolumeDB:      &volumeDB,
+		})
+
+		assert.NoError(t, err)
+		assert.Equal(t, "after", result.Data.Position)
+		assert.Equal(t, "door_knock", result.Data.SoundEffect.Name)
+		assert.False(t, result.Data.SoundEffect.IsSystem)
+		mockSfxCueRepo.AssertExpectations(t)
+	})
+
+	t.Run("soundEffectId と systemSoundEffectId を両方指定するとエラー", func(t *testing.T) {
+		svc := &sfxCueService{}
+
+		soundEffectIDStr := soundEffectID.String()
+		systemSoundEffectIDStr := systemSoundEffectID.String()
+		_, err := svc.Create(ctx, userID.String(), channelID.String(), episodeID.String(), lineID.String(), request.CreateSfxCueRequest{
+			SoundEffectID:       &soundEffectIDStr,
+			SystemSoundEffectID: &systemSoundEffectIDStr,
+			Position:            "before",
+		})
+
+		assert.True(t, apperror.IsCode(err, apperror.CodeValidation))
+	})
+
+	t.Run("効果音を指定しないとエラー", func(t *testing.T) {
+		svc := &sfxCueService{}
+
+		_, err := svc.Create(ctx, userID.String(), channelID.String(), episodeID.String(), lineID.String(), request.CreateSfxCueRequest{
+			Position: "before",
+		})
+
+		assert.True(t, apperror.IsCode(err, apperror.CodeValidation))
+	})
+
+	t.Run("他のユーザーの効果音を指定するとエラー", func(t *testing.T) {
+		mockChannelRepo := new(mockChannelRepository)
+		mockEpisodeRepo := new(mockEpisodeRepository)
+		mockScriptLineRepo := new(mockScriptLineRepository)
+		mockSfxCueRepo := new(mockSfxCueRepository)
+		mockSoundEffectRepo := new(mockSoundEffectRepository)
+		setupEpisode(mockChannelRepo, mockEpisodeRepo, mockScriptLineRepo)
+
+		mockSoundEffectRepo.On("FindByID", ctx, soundEffectID).Return(&model.SoundEffect{
+			ID:     soundEffectID,
+			UserID: uuid.New(),
+		}, nil)
+
+		svc := &sfxCueService{
+			channelRepo:     mockChannelRepo,
+			episodeRepo:     mockEpisodeRepo,
+			scriptLineRepo:  mockScriptLineRepo,
+			sfxCueRepo:      mockSfxCueRepo,
+			soundEffectRepo: mockSoundEffectRepo,
+		}
+
+		soundEffectIDStr := soundEffectID.String()
+		_, err := svc.Create(ctx, userID.String(), channelID.String(), episodeID.String(), lineID.String(), request.CreateSfxCueRequest{
+			SoundEffectID: &soundEffectIDStr,
+			Position:      "before",
+		})
+
+		assert.True(t, apperror.IsCode(err, apperror.CodeForbidden))
+		mockSfxCueRepo.AssertNotCalled(t, "Create", mock.Anything, mock.Anything)
+	})
+
+	t.Run("無効化されたシステム効果音を指定するとエラー", func(t *testing.T) {
+		mockChannelRepo := new(mockChannelRepository)
+		mockEpisodeRepo := new(mockEpisodeRepository)
+		mockScriptLineRepo := new(mockScriptLineRepository)
+		mockSfxCueRepo := new(mockSfxCueRepository)
+		mockSystemSoundEffectRepo := new(mockSystemSoundEffectRepository)
+		setupEpisode(mockChannelRepo, mockEpisodeRepo, mockScriptLineRepo)
+
+		mockSystemSoundEffectRepo.On("FindByID", ctx, systemSoundEffectID).Return(&model.SystemSoundEffect{
+			ID:       systemSoundEffectID,
+			IsActive: false,
+		}, nil)
+
+		svc := &sfxCueService{
+			channelRepo:           mockChannelRepo,
+			episodeRepo:           mockEpisodeRepo,
+			scriptLineRepo:        mockScriptLineRepo,
+			sfxCueRepo:            mockSfxCueRepo,
+			systemSoundEffectRepo: mockSystemSoundEffectRepo,
+		}
+
+		systemSoundEffectIDStr := systemSoundEffectID.String()
+		_, err := svc.Create(ctx, userID.String(), channelID.String(), episodeID.String(), lineID.String(), request.CreateSfxCueRequest{
+			SystemSoundEffectID: &systemSoundEffectIDStr,
+			Position:            "before",
+		})
+
+		assert.True(t, apperror.IsCode(err, apperror.CodeNotFound))
+		mockSfxCueRepo.AssertNotCalled(t, "Create", mock.Anything, mock.Anything)
+	})
+
+	t.Run("別エピソードの台本行を指定するとエラー", func(t *testing.T) {
+		mockChannelRepo := new(mockChannelRepository)
+		mockEpisodeRepo := new(mockEpisodeRepository)
+		mockScriptLineRepo := new(mockScriptLineRepository)
+
+		mockChannelRepo.On("FindByID", ctx, channelID).Return(&model.Channel{ID: channelID, UserID: userID}, nil)
+		mockEpisodeRepo.On("FindByID", ctx, episodeID).Return(&model.Episode{ID: episodeID, ChannelID: channelID}, nil)
+		mockScriptLineRepo.On("FindByID", ctx, lineID).Return(&model.ScriptLine{ID: lineID, EpisodeID: uuid.New()}, nil)
+
+		svc := &sfxCueService{
+			channelRepo:    mockChannelRepo,
+			episodeRepo:    mockEpisodeRepo,
+			scriptLineRepo: mockScriptLineRepo,
+		}
+
+		systemSoundEffectIDStr := systemSoundEffectID.String()
+		_, err := svc.Create(ctx, userID.String(), channelID.String(), episodeID.String(), lineID.String(), request.CreateSfxCueRequest{
+			SystemSoundEffectID: &systemSoundEffectIDStr,
+			Position:            "before",
+		})
+
+		assert.True(t, apperror.IsCode(err, apperror.CodeNotFound))
+	})
+}
+
+func TestSfxCueService_Delete(t *testing.T) {
+	ctx := context.Background()
+	userID := uuid.New()
+	channelID := uuid.New()
+	episodeID := uuid.New()
+	cueID := uuid.New()
+
+	newService := func(cue *model.SfxCue) (*sfxCueService, *mockSfxCueRepository) {
+		mockChannelRepo := new(mockChannelRepository)
+		mockEpisodeRepo := new(mockEpisodeRepository)
+		mockSfxCueRepo := new(mockSfxCueRepository)
+
+		mockChannelRepo.On("FindByID", ctx, channelID).Return(&model.Channel{ID: channelID, UserID: userID}, nil)
+		mockEpisodeRepo.On("FindByID", ctx, episodeID).Return(&model.Episode{ID: episodeID, ChannelID: channelID}, nil)
+		mockSfxCueRepo.On("FindByID", ctx, cueID).Return(cue, nil)
+
+		return &sfxCueService{
+			channelRepo: mockChannelRepo,
+			episodeRepo: mockEpisodeRepo,
+			sfxCueRepo:  mockSfxCueRepo,
+		}, mockSfxCueRepo
+	}
+
+	t.Run("正常に削除できる", func(t *testing.T) {
+		svc, mockSfxCueRepo := newService(&model.SfxCue{
+			ID:         cueID,
+			ScriptLine: model.ScriptLine{EpisodeID: episodeID},
+		})
+		mockSfxCueRepo.On("Delete", ctx, cueID).Return(nil)
+
+		err := svc.Delete(ctx, userID.String(), channelID.String(), episodeID.String(), cueID.String())
+
+		assert.NoError(t, err)
+		mockSfxCueRepo.AssertExpectations(t)
+	})
+
+	t.Run("別エピソードの効果音キューは削除できない", func(t *testing.T) {
+		svc, mockSfxCueRepo := newService(&model.SfxCue{
+			ID:         cueID,
+			ScriptLine: model.ScriptLine{EpisodeID: uuid.New()},
+		})
+
+		err := svc.Delete(ctx, userID.String(), channelID.String(), episodeID.String(), cueID.String())
+
+		assert.True(t, apperror.IsCode(err, apperror.CodeNotFound))
+		mockSfxCueRepo.AssertNotCalled(t, "Delete", mock.Anything, mock.Anything)
+	})
+}
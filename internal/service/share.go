@@ -0,0 +1,385 @@
+package service
+
+import (
+	"context"
+	"fmt"
+	"html"
+	"net/url"
+	"strconv"
+	"strings"
+	"time"
+	"unicode/utf8"
+
+	"github.com/siropaca/anycast-backend/internal/apperror"
+	"github.com/siropaca/anycast-backend/internal/dto/request"
+	"github.com/siropaca/anycast-backend/internal/dto/response"
+	"github.com/siropaca/anycast-backend/internal/model"
+	"github.com/siropaca/anycast-backend/internal/pkg/embedplayer"
+	"github.com/siropaca/anycast-backend/internal/pkg/uuid"
+	"github.com/siropaca/anycast-backend/internal/repository"
+)
+
+// 共有用のエンドポイントのパス（API のベース URL からの相対）
+const (
+	embedPlayerPathFormat = "/embed/channels/%s/episodes/%s"
+	oEmbedPath            = "/oembed"
+)
+
+const (
+	// shareSiteName は og:site_name・oEmbed の provider_name に使うサービス名
+	shareSiteName = "Anycast"
+	// shareLocale は og:locale（台本・音声は日本語で生成する）
+	shareLocale = "ja_JP"
+	// shareDescriptionMaxLength は共有用の説明文の最大文字数（超える場合は省略する）
+	shareDescriptionMaxLength = 200
+	// shareCacheAge は oEmbed の cache_age
+	shareCacheAge = 5 * time.Minute
+)
+
+// ShareService はチャンネル・エピソードの共有（OG メタデータ・oEmbed・埋め込みプレイヤー）に関するビジネスロジックインターフェースを表す
+type ShareService interface {
+	GetChannelMetadata(ctx context.Context, channelID string) (*response.ShareMetadataDataResponse, error)
+	GetEpisodeMetadata(ctx context.Context, channelID, episodeID string) (*response.ShareMetadataDataResponse, error)
+	GetOEmbed(ctx context.Context, req request.OEmbedRequest) (*response.OEmbedResponse, error)
+	GetEmbedPlayer(ctx context.Context, channelID, episodeID string, startSeconds int) ([]byte, error)
+}
+
+type shareService struct {
+	channelRepo repository.ChannelRepository
+	episodeRepo repository.EpisodeRepository
+	apiBaseURL  string
+	webBaseURL  string
+}
+
+// NewShareService は shareService を生成して ShareService として返す
+//
+// apiBaseURL は埋め込みプレイヤー・音声・アートワークの URL に使う API の公開ベース URL、
+// webBaseURL は共有する Web ページ（oEmbed の対象 URL）のベース URL
+func NewShareService(
+	channelRepo repository.ChannelRepository,
+	episodeRepo repository.EpisodeRepository,
+	apiBaseURL string,
+	webBaseURL string,
+) ShareService {
+	return &shareService{
+		channelRepo: channelRepo,
+		episodeRepo: episodeRepo,
+		apiBaseURL:  strings.TrimSuffix(apiBaseURL, "/"),
+		webBaseURL:  strings.TrimSuffix(webBaseURL, "/"),
+	}
+}
+
+// GetChannelMetadata は公開済みチャンネルの共有用メタデータを返す
+func (s *shareService) GetChannelMetadata(ctx context.Context, channelID string) (*response.ShareMetadataDataResponse, error) {
+	channel, err := s.findPublicChannel(ctx, channelID)
+	if err != nil {
+		return nil, err
+	}
+
+	pageURL := s.channelPageURL(channel.ID)
+	meta := &response.ShareMetadataResponse{
+		Title:       channel.Name,
+		Description: truncateShareDescription(channel.Description),
+		URL:         pageURL,
+		OEmbedURL:   s.oEmbedURL(pageURL),
+	}
+	if channel.ArtworkID != nil {
+		imageURL := s.apiBaseURL + fmt.Sprintf(publicFeedBasePathFormat, channel.ID) + feedArtworkPath
+		meta.ImageURL = &imageURL
+	}
+
+	s.fillMetaTags(meta, nil)
+
+	return &response.ShareMetadataDataResponse{Data: *meta}, nil
+}
+
+// GetEpisodeMetadata は公開済みエピソードの共有用メタデータを返す
+//
+// 音声のあるエピソードは Twitter のプレイヤーカードとして埋め込みプレイヤーを指定する
+func (s *shareService) GetEpisodeMetadata(ctx context.Context, channelID, episodeID string) (*response.ShareMetadataDataResponse, error) {
+	channel, episode, err := s.findPublicEpisode(ctx, channelID, episodeID)
+	if err != nil {
+		return nil, err
+	}
+
+	description := episode.Description
+	if description == "" {
+		description = channel.Description
+	}
+
+	pageURL := s.episodePageURL(channel.ID, episode.ID)
+	meta := &response.ShareMetadataResponse{
+		Title:       episode.Title,
+		Description: truncateShareDescription(description),
+		URL:         pageURL,
+		OEmbedURL:   s.oEmbedURL(pageURL),
+	}
+	if imageURL := s.episodeImageURL(channel, episode); imageURL != "" {
+		meta.ImageURL = &imageURL
+	}
+	if episode.FullAudio != nil {
+		audioURL := s.apiBaseURL + fmt.Sprintf(mediaAudioPathFormat, episode.FullAudio.ID)
+		embedURL := s.embedPlayerURL(channel.ID, episode.ID, 0)
+		meta.AudioURL = &audioURL
+		meta.EmbedURL = &embedURL
+	}
+
+	s.fillMetaTags(meta, episode.FullAudio)
+
+	return &response.ShareMetadataDataResponse{Data: *meta}, nil
+}
+
+// GetOEmbed は Web ページの URL に対する oEmbed のレスポンスを返す
+//
+// 音声のあるエピソードのページは埋め込みプレイヤー（rich）、それ以外はリンク（link）として返す。
+// エピソードのページの URL に t（秒）がある場合は埋め込みプレイヤーの再生開始位置として引き継ぐ
+func (s *shareService) GetOEmbed(ctx context.Context, req request.OEmbedRequest) (*response.OEmbedResponse, error) {
+	channelID, episodeID, startSeconds, ok := s.parsePageURL(req.URL)
+	if !ok {
+		return nil, apperror.ErrNotFound.WithMessage("埋め込みに対応していない URL です")
+	}
+
+	channel, err := s.findPublicChannel(ctx, channelID)
+	if err != nil {
+		return nil, err
+	}
+
+	res := &response.OEmbedResponse{
+		Type:         "link",
+		Version:      "1.0",
+		Title:        channel.Name,
+		AuthorName:   channel.Name,
+		AuthorURL:    s.channelPageURL(channel.ID),
+		ProviderName: shareSiteName,
+		ProviderURL:  s.webBaseURL,
+		CacheAge:     int(shareCacheAge.Seconds()),
+	}
+
+	if episodeID == "" {
+		return res, nil
+	}
+
+	_, episode, err := s.findPublicEpisode(ctx, channelID, episodeID)
+	if err != nil {
+		return nil, err
+	}
+	res.Title = episode.Title
+
+	if episode.FullAudio == nil {
+		return res, nil
+	}
+
+	width, height := embedplayer.DefaultWidth, embedplayer.DefaultHeight
+	if req.MaxWidth > 0 && req.MaxWidth < width {
+		width = req.MaxWidth
+	}
+	if req.MaxHeight > 0 && req.MaxHeight < height {
+		height = req.MaxHeight
+	}
+
+	embedURL := s.embedPlayerURL(channel.ID, episode.ID, clampStartSeconds(episode.FullAudio, startSeconds))
+	iframe := fmt.Sprintf(
+		`<iframe src="%s" width="%d" height="%d" frameborder="0" loading="lazy" title="%s"></iframe>`,
+		html.EscapeString(embedURL), width, height, html.EscapeString(episode.Title),
+	)
+
+	res.Type = "rich"
+	res.HTML = &iframe
+	res.Width = &width
+	res.Height = &height
+
+	return res, nil
+}
+
+// GetEmbedPlayer は公開済みエピソードの埋め込みプレイヤーの HTML を返す
+//
+// startSeconds が音声の長さ以上の場合は先頭から再生する
+func (s *shareService) GetEmbedPlayer(ctx context.Context, channelID, episodeID string, startSeconds int) ([]byte, error) {
+	channel, episode, err := s.findPublicEpisode(ctx, channelID, episodeID)
+	if err != nil {
+		return nil, err
+	}
+
+	if episode.FullAudio == nil {
+		return nil, apperror.ErrNotFound.WithMessage("エピソードの音声が見つかりません")
+	}
+
+	player := &embedplayer.Player{
+		Title:        episode.Title,
+		ChannelName:  channel.Name,
+		PageURL:      s.episodePageURL(channel.ID, episode.ID),
+		ImageURL:     s.episodeImageURL(channel, episode),
+		AudioURL:     s.apiBaseURL + fmt.Sprintf(mediaAudioPathFormat, episode.FullAudio.ID),
+		AudioType:    episode.FullAudio.MimeType,
+		StartSeconds: clampStartSeconds(episode.FullAudio, startSeconds),
+	}
+
+	return player.Render()
+}
+
+// fillMetaTags はメタデータから Open Graph・Twitter カードのタグを生成する
+func (s *shareService) fillMetaTags(meta *response.ShareMetadataResponse, audio *model.Audio) {
+	og := []response.ShareMetaTagResponse{
+		{Name: "og:site_name", Content: shareSiteName},
+		{Name: "og:type", Content: "website"},
+		{Name: "og:locale", Content: shareLocale},
+		{Name: "og:title", Content: meta.Title},
+		{Name: "og:description", Content: meta.Description},
+		{Name: "og:url", Content: meta.URL},
+	}
+	twitter := []response.ShareMetaTagResponse{
+		{Name: "twitter:card", Content: "summary"},
+		{Name: "twitter:title", Content: meta.Title},
+		{Name: "twitter:description", Content: meta.Description},
+	}
+
+	if meta.ImageURL != nil {
+		og = append(og, response.ShareMetaTagResponse{Name: "og:image", Content: *meta.ImageURL})
+		twitter = append(twitter, response.ShareMetaTagResponse{Name: "twitter:image", Content: *meta.ImageURL})
+	}
+
+	if audio != nil && meta.AudioURL != nil && meta.EmbedURL != nil {
+		og = append(og,
+			response.ShareMetaTagResponse{Name: "og:audio", Content: *meta.AudioURL},
+			response.ShareMetaTagResponse{Name: "og:audio:type", Content: audio.MimeType},
+		)
+		twitter[0].Content = "player"
+		twitter = append(twitter,
+			response.ShareMetaTagResponse{Name: "twitter:player", Content: *meta.EmbedURL},
+			response.ShareMetaTagResponse{Name: "twitter:player:width", Content: strconv.Itoa(embedplayer.DefaultWidth)},
+			response.ShareMetaTagResponse{Name: "twitter:player:height", Content: strconv.Itoa(embedplayer.DefaultHeight)},
+			response.ShareMetaTagResponse{Name: "twitter:player:stream", Content: *meta.AudioURL},
+			response.ShareMetaTagResponse{Name: "twitter:player:stream:content_type", Content: audio.MimeType},
+		)
+	}
+
+	meta.OpenGraph = og
+	meta.Twitter = twitter
+}
+
+// findPublicChannel は公開済みのチャンネルを取得する（未公開の場合は NotFound）
+func (s *shareService) findPublicChannel(ctx context.Context, channelID string) (*model.Channel, error) {
+	cid, err := uuid.Parse(channelID)
+	if err != nil {
+		return nil, err
+	}
+
+	channel, err := s.channelRepo.FindByID(ctx, cid)
+	if err != nil {
+		return nil, err
+	}
+
+	if !isPublishedAt(channel.PublishedAt) {
+		return nil, apperror.ErrNotFound.WithMessage("チャンネルが見つかりません")
+	}
+
+	return channel, nil
+}
+
+// findPublicEpisode は公開済みのチャンネルに属する公開済みのエピソードを取得する（未公開の場合は NotFound）
+func (s *shareService) findPublicEpisode(ctx context.Context, channelID, episodeID string) (*model.Channel, *model.Episode, error) {
+	channel, err := s.findPublicChannel(ctx, channelID)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	eid, err := uuid.Parse(episodeID)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	episode, err := s.episodeRepo.FindByID(ctx, eid)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	if episode.ChannelID != channel.ID || !isPublishedAt(episode.PublishedAt) {
+		return nil, nil, apperror.ErrNotFound.WithMessage("エピソードが見つかりません")
+	}
+
+	return channel, episode, nil
+}
+
+// parsePageURL は Web ページの URL からチャンネル ID・エピソード ID・再生開始位置を取り出す
+//
+// 対応する URL は {webBaseURL}/channels/{channelId} と {webBaseURL}/channels/{channelId}/episodes/{episodeId}
+func (s *shareService) parsePageURL(rawURL string) (channelID, episodeID string, startSeconds int, ok bool) {
+	base, err := url.Parse(s.webBaseURL)
+	if err != nil {
+		return "", "", 0, false
+	}
+
+	u, err := url.Parse(rawURL)
+	if err != nil || (u.Scheme != "http" && u.Scheme != "https") || !strings.EqualFold(u.Host, base.Host) {
+		return "", "", 0, false
+	}
+
+	rest, found := strings.CutPrefix(u.Path, base.Path+"/")
+	if !found {
+		return "", "", 0, false
+	}
+
+	segments := strings.Split(strings.TrimSuffix(rest, "/"), "/")
+	switch {
+	case len(segments) == 2 && segments[0] == "channels":
+		return segments[1], "", 0, true
+	case len(segments) == 4 && segments[0] == "channels" && segments[2] == "episodes":
+		if t, err := strconv.Atoi(u.Query().Get("t")); err == nil && t > 0 {
+			startSeconds = t
+		}
+		return segments[1], segments[3], startSeconds, true
+	default:
+		return "", "", 0, false
+	}
+}
+
+// episodeImageURL はエピソードのアートワークの URL を返す（エピソード・チャンネルともにない場合は空）
+//
+// アートワークのエンドポイントはエピソードにアートワークがない場合にチャンネルのアートワークへリダイレクトする
+func (s *shareService) episodeImageURL(channel *model.Channel, episode *model.Episode) string {
+	if episode.ArtworkID == nil && channel.ArtworkID == nil {
+		return ""
+	}
+	return s.apiBaseURL + fmt.Sprintf(publicFeedBasePathFormat, channel.ID) + fmt.Sprintf(feedEpisodeArtworkFormat, episode.ID)
+}
+
+// embedPlayerURL は埋め込みプレイヤーの URL を返す
+func (s *shareService) embedPlayerURL(channelID, episodeID uuid.UUID, startSeconds int) string {
+	u := s.apiBaseURL + fmt.Sprintf(embedPlayerPathFormat, channelID, episodeID)
+	if startSeconds > 0 {
+		u += "?t=" + strconv.Itoa(startSeconds)
+	}
+	return u
+}
+
+// oEmbedURL は Web ページの URL に対する oEmbed エンドポイントの URL を返す
+func (s *shareService) oEmbedURL(pageURL string) string {
+	return s.apiBaseURL + oEmbedPath + "?format=json&url=" + url.QueryEscape(pageURL)
+}
+
+// channelPageURL はチャンネルの Web ページの URL を返す
+func (s *shareService) channelPageURL(channelID uuid.UUID) string {
+	return fmt.Sprintf("%s/channels/%s", s.webBaseURL, channelID)
+}
+
+// episodePageURL はエピソードの Web ページの URL を返す
+func (s *shareService) episodePageURL(channelID, episodeID uuid.UUID) string {
+	return fmt.Sprintf("%s/channels/%s/episodes/%s", s.webBaseURL, channelID, episodeID)
+}
+
+// clampStartSeconds は再生開始位置が音声の長さ以上の場合に 0 を返す
+func clampStartSeconds(audio *model.Audio, startSeconds int) int {
+	if startSeconds <= 0 || int64(startSeconds)*1000 >= int64(audio.DurationMs) {
+		return 0
+	}
+	return startSeconds
+}
+
+// truncateShareDescription は共有用の説明文を最大文字数で省略する
+func truncateShareDescription(s string) string {
+	s = strings.TrimSpace(s)
+	if utf8.RuneCountInString(s) <= shareDescriptionMaxLength {
+		return s
+	}
+	return string([]rune(s)[:shareDescriptionMaxLength-1]) + "…"
+}
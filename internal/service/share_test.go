@@ -0,0 +1,296 @@
+package service
+
+import (
+	"context"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+
+	"github.com/siropaca/anycast-backend/internal/apperror"
+	"github.com/siropaca/anycast-backend/internal/dto/request"
+	"github.com/siropaca/anycast-backend/internal/dto/response"
+	"github.com/siropaca/anycast-backend/internal/model"
+	"github.com/siropaca/anycast-backend/internal/pkg/uuid"
+)
+
+func newTestShareService(channelRepo *mockChannelRepository, episodeRepo *mockEpisodeRepository) ShareService {
+	return NewShareService(channelRepo, episodeRepo, "https://api.example.com/api/v1/", "https://anycast.example.com")
+}
+
+func newTestPublishedEpisode(channel *model.Channel) *model.Episode {
+	published := time.Now().Add(-time.Hour)
+	return &model.Episode{
+		ID:          uuid.New(),
+		ChannelID:   channel.ID,
+		Title:       "第1回",
+		Description: "初回の配信です",
+		PublishedAt: &published,
+		FullAudio:   &model.Audio{ID: uuid.New(), MimeType: "audio/mpeg", DurationMs: 600000},
+	}
+}
+
+// metaTagContent は name の meta タグの content を返す（存在しない場合は空）
+func metaTagContent(tags []response.ShareMetaTagResponse, name string) string {
+	for _, tag := range tags {
+		if tag.Name == name {
+			return tag.Content
+		}
+	}
+	return ""
+}
+
+func TestShareService_GetChannelMetadata(t *testing.T) {
+	ctx := context.Background()
+
+	t.Run("公開済みチャンネルのメタデータを返す", func(t *testing.T) {
+		channel := newTestPublishedChannel()
+		channelRepo := new(mockChannelRepository)
+		channelRepo.On("FindByID", mock.Anything, channel.ID).Return(channel, nil)
+
+		svc := newTestShareService(channelRepo, new(mockEpisodeRepository))
+		result, err := svc.GetChannelMetadata(ctx, channel.ID.String())
+		require.NoError(t, err)
+
+		meta := result.Data
+		pageURL := "https://anycast.example.com/channels/" + channel.ID.String()
+		artworkURL := "https://api.example.com/api/v1/channels/" + channel.ID.String() + "/artwork"
+		assert.Equal(t, "テックトーク", meta.Title)
+		assert.Equal(t, pageURL, meta.URL)
+		require.NotNil(t, meta.ImageURL)
+		assert.Equal(t, artworkURL, *meta.ImageURL)
+		assert.Nil(t, meta.AudioURL)
+		assert.Nil(t, meta.EmbedURL)
+		assert.Equal(t, "https://api.example.com/api/v1/oembed?format=json&url=https%3A%2F%2Fanycast.example.com%2Fchannels%2F"+channel.ID.String(), meta.OEmbedURL)
+		assert.Equal(t, pageURL, metaTagContent(meta.OpenGraph, "og:url"))
+		assert.Equal(t, artworkURL, metaTagContent(meta.OpenGraph, "og:image"))
+		assert.Equal(t, "summary", metaTagContent(meta.Twitter, "twitter:card"))
+	})
+
+	t.Run("未公開のチャンネルは NotFound を返す", func(t *testing.T) {
+		channel := newTestPublishedChannel()
+		channel.PublishedAt = nil
+		channelRepo := new(mockChannelRepository)
+		channelRepo.On("FindByID", mock.Anything, channel.ID).Return(channel, nil)
+
+		svc := newTestShareService(channelRepo, new(mockEpisodeRepository))
+		_, err := svc.GetChannelMetadata(ctx, channel.ID.String())
+
+		assert.True(t, apperror.IsCode(err, apperror.CodeNotFound))
+	})
+
+	t.Run("長い説明文は省略する", func(t *testing.T) {
+		channel := newTestPublishedChannel()
+		channel.Description = strings.Repeat("あ", 300)
+		channelRepo := new(mockChannelRepository)
+		channelRepo.On("FindByID", mock.Anything, channel.ID).Return(channel, nil)
+
+		svc := newTestShareService(channelRepo, new(mockEpisodeRepository))
+		result, err := svc.GetChannelMetadata(ctx, channel.ID.String())
+		require.NoError(t, err)
+
+		assert.Equal(t, strings.Repeat("あ", 199)+"…", result.Data.Description)
+	})
+}
+
+func TestShareService_GetEpisodeMetadata(t *testing.T) {
+	ctx := context.Background()
+
+	t.Run("音声のあるエピソードはプレイヤーカードを指定する", func(t *testing.T) {
+		channel := newTestPublishedChannel()
+		episode := newTestPublishedEpisode(channel)
+		channelRepo := new(mockChannelRepository)
+		episodeRepo := new(mockEpisodeRepository)
+		channelRepo.On("FindByID", mock.Anything, channel.ID).Return(channel, nil)
+		episodeRepo.On("FindByID", mock.Anything, episode.ID).Return(episode, nil)
+
+		svc := newTestShareService(channelRepo, episodeRepo)
+		result, err := svc.GetEpisodeMetadata(ctx, channel.ID.String(), episode.ID.String())
+		require.NoError(t, err)
+
+		meta := result.Data
+		audioURL := "https://api.example.com/api/v1/media/audio/" + episode.FullAudio.ID.String()
+		embedURL := "https://api.example.com/api/v1/embed/channels/" + channel.ID.String() + "/episodes/" + episode.ID.String()
+		assert.Equal(t, "第1回", meta.Title)
+		assert.Equal(t, "初回の配信です", meta.Description)
+		require.NotNil(t, meta.AudioURL)
+		assert.Equal(t, audioURL, *meta.AudioURL)
+		require.NotNil(t, meta.EmbedURL)
+		assert.Equal(t, embedURL, *meta.EmbedURL)
+		require.NotNil(t, meta.ImageURL)
+		assert.Equal(t, "https://api.example.com/api/v1/channels/"+channel.ID.String()+"/episodes/"+episode.ID.String()+"/artwork", *meta.ImageURL)
+		assert.Equal(t, audioURL, metaTagContent(meta.OpenGraph, "og:audio"))
+		assert.Equal(t, "audio/mpeg", metaTagContent(meta.OpenGraph, "og:audio:type"))
+		assert.Equal(t, "player", metaTagContent(meta.Twitter, "twitter:card"))
+		assert.Equal(t, embedURL, metaTagContent(meta.Twitter, "twitter:player"))
+		assert.Equal(t, audioURL, metaTagContent(meta.Twitter, "twitter:player:stream"))
+	})
+
+	t.Run("音声のないエピソードは summary カードにする", func(t *testing.T) {
+		channel := newTestPublishedChannel()
+		episode := newTestPublishedEpisode(channel)
+		episode.FullAudio = nil
+		episode.Description = ""
+		channelRepo := new(mockChannelRepository)
+		episodeRepo := new(mockEpisodeRepository)
+		channelRepo.On("FindByID", mock.Anything, channel.ID).Return(channel, nil)
+		episodeRepo.On("FindByID", mock.Anything, episode.ID).Return(episode, nil)
+
+		svc := newTestShareService(channelRepo, episodeRepo)
+		result, err := svc.GetEpisodeMetadata(ctx, channel.ID.String(), episode.ID.String())
+		require.NoError(t, err)
+
+		assert.Equal(t, "最新のテクノロジーニュース", result.Data.Description)
+		assert.Nil(t, result.Data.EmbedURL)
+		assert.Equal(t, "summary", metaTagContent(result.Data.Twitter, "twitter:card"))
+		assert.Empty(t, metaTagContent(result.Data.OpenGraph, "og:audio"))
+	})
+
+	t.Run("予約公開のエピソードは NotFound を返す", func(t *testing.T) {
+		channel := newTestPublishedChannel()
+		episode := newTestPublishedEpisode(channel)
+		future := time.Now().Add(time.Hour)
+		episode.PublishedAt = &future
+		channelRepo := new(mockChannelRepository)
+		episodeRepo := new(mockEpisodeRepository)
+		channelRepo.On("FindByID", mock.Anything, channel.ID).Return(channel, nil)
+		episodeRepo.On("FindByID", mock.Anything, episode.ID).Return(episode, nil)
+
+		svc := newTestShareService(channelRepo, episodeRepo)
+		_, err := svc.GetEpisodeMetadata(ctx, channel.ID.String(), episode.ID.String())
+
+		assert.True(t, apperror.IsCode(err, apperror.CodeNotFound))
+	})
+
+	t.Run("別のチャンネルのエピソードは NotFound を返す", func(t *testing.T) {
+		channel := newTestPublishedChannel()
+		episode := newTestPublishedEpisode(channel)
+		episode.ChannelID = uuid.New()
+		channelRepo := new(mockChannelRepository)
+		episodeRepo := new(mockEpisodeRepository)
+		channelRepo.On("FindByID", mock.Anything, channel.ID).Return(channel, nil)
+		episodeRepo.On("FindByID", mock.Anything, episode.ID).Return(episode, nil)
+
+		svc := newTestShareService(channelRepo, episodeRepo)
+		_, err := svc.GetEpisodeMetadata(ctx, channel.ID.String(), episode.ID.String())
+
+		assert.True(t, apperror.IsCode(err, apperror.CodeNotFound))
+	})
+}
+
+func TestShareService_GetOEmbed(t *testing.T) {
+	ctx := context.Background()
+
+	t.Run("エピソードのページは埋め込みプレイヤーの iframe を返す", func(t *testing.T) {
+		channel := newTestPublishedChannel()
+		episode := newTestPublishedEpisode(channel)
+		channelRepo := new(mockChannelRepository)
+		episodeRepo := new(mockEpisodeRepository)
+		channelRepo.On("FindByID", mock.Anything, channel.ID).Return(channel, nil)
+		episodeRepo.On("FindByID", mock.Anything, episode.ID).Return(episode, nil)
+
+		svc := newTestShareService(channelRepo, episodeRepo)
+		result, err := svc.GetOEmbed(ctx, request.OEmbedRequest{
+			URL:      "https://anycast.example.com/channels/" + channel.ID.String() + "/episodes/" + episode.ID.String() + "?t=90",
+			MaxWidth: 320,
+		})
+		require.NoError(t, err)
+
+		assert.Equal(t, "rich", result.Type)
+		assert.Equal(t, "1.0", result.Version)
+		assert.Equal(t, "第1回", result.Title)
+		assert.Equal(t, "テックトーク", result.AuthorName)
+		assert.Equal(t, "Anycast", result.ProviderName)
+		require.NotNil(t, result.Width)
+		assert.Equal(t, 320, *result.Width)
+		require.NotNil(t, result.Height)
+		assert.Equal(t, 160, *result.Height)
+		require.NotNil(t, result.HTML)
+		assert.Contains(t, *result.HTML, `src="https://api.example.com/api/v1/embed/channels/`+channel.ID.String()+`/episodes/`+episode.ID.String()+`?t=90"`)
+		assert.Contains(t, *result.HTML, `width="320"`)
+	})
+
+	t.Run("チャンネルのページはリンクとして返す", func(t *testing.T) {
+		channel := newTestPublishedChannel()
+		channelRepo := new(mockChannelRepository)
+		channelRepo.On("FindByID", mock.Anything, channel.ID).Return(channel, nil)
+
+		svc := newTestShareService(channelRepo, new(mockEpisodeRepository))
+		result, err := svc.GetOEmbed(ctx, request.OEmbedRequest{URL: "https://anycast.example.com/channels/" + channel.ID.String()})
+		require.NoError(t, err)
+
+		assert.Equal(t, "link", result.Type)
+		assert.Equal(t, "テックトーク", result.Title)
+		assert.Nil(t, result.HTML)
+	})
+
+	t.Run("再生開始位置が音声の長さ以上の場合は先頭から再生する", func(t *testing.T) {
+		channel := newTestPublishedChannel()
+		episode := newTestPublishedEpisode(channel)
+		channelRepo := new(mockChannelRepository)
+		episodeRepo := new(mockEpisodeRepository)
+		channelRepo.On("FindByID", mock.Anything, channel.ID).Return(channel, nil)
+		episodeRepo.On("FindByID", mock.Anything, episode.ID).Return(episode, nil)
+
+		svc := newTestShareService(channelRepo, episodeRepo)
+		result, err := svc.GetOEmbed(ctx, request.OEmbedRequest{
+			URL: "https://anycast.example.com/channels/" + channel.ID.String() + "/episodes/" + episode.ID.String() + "?t=600",
+		})
+		require.NoError(t, err)
+
+		require.NotNil(t, result.HTML)
+		assert.NotContains(t, *result.HTML, "?t=")
+	})
+
+	t.Run("対応していない URL は NotFound を返す", func(t *testing.T) {
+		svc := newTestShareService(new(mockChannelRepository), new(mockEpisodeRepository))
+
+		for _, u := range []string{
+			"https://evil.example.com/channels/" + uuid.New().String(),
+			"https://anycast.example.com/users/" + uuid.New().String(),
+			"https://anycast.example.com/channels/" + uuid.New().String() + "/episodes",
+			"ftp://anycast.example.com/channels/" + uuid.New().String(),
+		} {
+			_, err := svc.GetOEmbed(ctx, request.OEmbedRequest{URL: u})
+			assert.True(t, apperror.IsCode(err, apperror.CodeNotFound), u)
+		}
+	})
+}
+
+func TestShareService_GetEmbedPlayer(t *testing.T) {
+	ctx := context.Background()
+
+	t.Run("公開済みエピソードのプレイヤーを返す", func(t *testing.T) {
+		channel := newTestPublishedChannel()
+		episode := newTestPublishedEpisode(channel)
+		channelRepo := new(mockChannelRepository)
+		episodeRepo := new(mockEpisodeRepository)
+		channelRepo.On("FindByID", mock.Anything, channel.ID).Return(channel, nil)
+		episodeRepo.On("FindByID", mock.Anything, episode.ID).Return(episode, nil)
+
+		svc := newTestShareService(channelRepo, episodeRepo)
+		data, err := svc.GetEmbedPlayer(ctx, channel.ID.String(), episode.ID.String(), 30)
+		require.NoError(t, err)
+
+		assert.Contains(t, string(data), `src="https://api.example.com/api/v1/media/audio/`+episode.FullAudio.ID.String()+`#t=30"`)
+		assert.Contains(t, string(data), "テックトーク")
+	})
+
+	t.Run("音声のないエピソードは NotFound を返す", func(t *testing.T) {
+		channel := newTestPublishedChannel()
+		episode := newTestPublishedEpisode(channel)
+		episode.FullAudio = nil
+		channelRepo := new(mockChannelRepository)
+		episodeRepo := new(mockEpisodeRepository)
+		channelRepo.On("FindByID", mock.Anything, channel.ID).Return(channel, nil)
+		episodeRepo.On("FindByID", mock.Anything, episode.ID).Return(episode, nil)
+
+		svc := newTestShareService(channelRepo, episodeRepo)
+		_, err := svc.GetEmbedPlayer(ctx, channel.ID.String(), episode.ID.String(), 0)
+
+		assert.True(t, apperror.IsCode(err, apperror.CodeNotFound))
+	})
+}
@@ -0,0 +1,345 @@
+package service
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	"github.com/siropaca/anycast-backend/internal/apperror"
+	"github.com/siropaca/anycast-backend/internal/dto/request"
+	"github.com/siropaca/anycast-backend/internal/dto/response"
+	"github.com/siropaca/anycast-backend/internal/infrastructure/storage"
+	"github.com/siropaca/anycast-backend/internal/model"
+	"github.com/siropaca/anycast-backend/internal/pkg/uuid"
+	"github.com/siropaca/anycast-backend/internal/repository"
+)
+
+// SoundEffectService は効果音関連のビジネスロジックインターフェースを表す
+type SoundEffectService interface {
+	ListMySoundEffects(ctx context.Context, userID string, req request.ListMySoundEffectsRequest) (*response.SoundEffectListWithPaginationResponse, error)
+	GetMySoundEffect(ctx context.Context, userID, soundEffectID string) (*response.SoundEffectDataResponse, error)
+	CreateSoundEffect(ctx context.Context, userID string, req request.CreateSoundEffectRequest) (*response.SoundEffectDataResponse, error)
+	UpdateMySoundEffect(ctx context.Context, userID, soundEffectID string, req request.UpdateSoundEffectRequest) (*response.SoundEffectDataResponse, error)
+	DeleteMySoundEffect(ctx context.Context, userID, soundEffectID string) error
+}
+
+type soundEffectService struct {
+	soundEffectRepo       repository.SoundEffectRepository
+	systemSoundEffectRepo repository.SystemSoundEffectRepository
+	audioRepo             repository.AudioRepository
+	storageClient         storage.Client
+}
+
+// NewSoundEffectService は soundEffectService を生成して SoundEffectService として返す
+func NewSoundEffectService(
+	soundEffectRepo repository.SoundEffectRepository,
+	systemSoundEffectRepo repository.SystemSoundEffectRepository,
+	audioRepo repository.AudioRepository,
+	storageClient storage.Client,
+) SoundEffectService {
+	return &soundEffectService{
+		soundEffectRepo:       soundEffectRepo,
+		systemSoundEffectRepo: systemSoundEffectRepo,
+		audioRepo:             audioRepo,
+		storageClient:         storageClient,
+	}
+}
+
+// ListMySoundEffects は自分の効果音一覧を取得する
+func (s *soundEffectService) ListMySoundEffects(ctx context.Context, userID string, req request.ListMySoundEffectsRequest) (*response.SoundEffectListWithPaginationResponse, error) {
+	uid, err := uuid.Parse(userID)
+	if err != nil {
+		return nil, err
+	}
+
+	if req.IncludeSystem {
+		return s.listSoundEffectsWithSystem(ctx, uid, req)
+	}
+
+	soundEffects, total, err := s.soundEffectRepo.FindByUserID(ctx, uid, repository.SoundEffectFilter{
+		Limit:  req.Limit,
+		Offset: req.Offset,
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	responses := make([]response.SoundEffectResponse, len(soundEffects))
+	for i, se := range soundEffects {
+		responses[i] = toSoundEffectResponse(ctx, s.storageClient, se)
+	}
+
+	return &response.SoundEffectListWithPaginationResponse{
+		Data:       responses,
+		Pagination: response.PaginationResponse{Total: total, Limit: req.Limit, Offset: req.Offset},
+	}, nil
+}
+
+// listSoundEffectsWithSystem はユーザー効果音とシステム効果音を結合して取得する
+// ユーザー効果音 → システム効果音の順に返す
+func (s *soundEffectService) listSoundEffectsWithSystem(ctx context.Context, userID uuid.UUID, req request.ListMySoundEffectsRequest) (*response.SoundEffectListWithPaginationResponse, error) {
+	// ユーザー効果音の総件数を取得
+	_, userTotal, err := s.soundEffectRepo.FindByUserID(ctx, userID, repository.SoundEffectFilter{Limit: 0, Offset: 0})
+	if err != nil {
+		return nil, err
+	}
+
+	// システム効果音の総件数を取得
+	systemTotal, err := s.systemSoundEffectRepo.CountActive(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	responses := make([]response.SoundEffectResponse, 0)
+	systemFilter := repository.SystemSoundEffectFilter{Limit: req.Limit}
+
+	if int64(req.Offset) < userTotal {
+		// オフセットがユーザー効果音の範囲内の場合はユーザー効果音から取得
+		userSoundEffects, _, err := s.soundEffectRepo.FindByUserID(ctx, userID, repository.SoundEffectFilter{
+			Limit:  req.Limit,
+			Offset: req.Offset,
+		})
+		if err != nil {
+			return nil, err
+		}
+		for _, se := range userSoundEffects {
+			responses = append(responses, toSoundEffectResponse(ctx, s.storageClient, se))
+		}
+
+		// ユーザー効果音だけで limit を満たさない場合、残りをシステム効果音の先頭から取得
+		systemFilter.Limit = req.Limit - len(responses)
+	} else {
+		// オフセットがユーザー効果音の範囲外の場合、システム効果音のみ取得
+		systemFilter.Offset = req.Offset - int(userTotal)
+	}
+
+	if systemFilter.Limit > 0 {
+		systemSoundEffects, _, err := s.systemSoundEffectRepo.FindActive(ctx, systemFilter)
+		if err != nil {
+			return nil, err
+		}
+		for _, se := range systemSoundEffects {
+			responses = append(responses, toSystemSoundEffectResponse(ctx, s.storageClient, se))
+		}
+	}
+
+	return &response.SoundEffectListWithPaginationResponse{
+		Data:       responses,
+		Pagination: response.PaginationResponse{Total: userTotal + systemTotal, Limit: req.Limit, Offset: req.Offset},
+	}, nil
+}
+
+// CreateSoundEffect は新しい効果音を作成する
+func (s *soundEffectService) CreateSoundEffect(ctx context.Context, userID string, req request.CreateSoundEffectRequest) (*response.SoundEffectDataResponse, error) {
+	uid, err := uuid.Parse(userID)
+	if err != nil {
+		return nil, err
+	}
+
+	// 同一ユーザー内で同じ名前の効果音が存在するかチェック
+	exists, err := s.soundEffectRepo.ExistsByUserIDAndName(ctx, uid, req.Name, nil)
+	if err != nil {
+		return nil, err
+	}
+	if exists {
+		return nil, apperror.ErrDuplicateName.WithMessage("同じ名前の効果音が既に存在します")
+	}
+
+	// 音声ファイルの存在確認
+	audioID, err := uuid.Parse(req.AudioID)
+	if err != nil {
+		return nil, err
+	}
+	audio, err := s.audioRepo.FindByID(ctx, audioID)
+	if err != nil {
+		return nil, err
+	}
+
+	soundEffect := &model.SoundEffect{
+		ID:      uuid.New(),
+		UserID:  uid,
+		AudioID: audioID,
+		Name:    req.Name,
+	}
+
+	if err := s.soundEffectRepo.Create(ctx, soundEffect); err != nil {
+		return nil, err
+	}
+
+	// レスポンス用にリレーションを設定
+	soundEffect.Audio = *audio
+
+	return &response.SoundEffectDataResponse{Data: toSoundEffectResponse(ctx, s.storageClient, *soundEffect)}, nil
+}
+
+// GetMySoundEffect は自分の効果音を取得する
+func (s *soundEffectService) GetMySoundEffect(ctx context.Context, userID, soundEffectID string) (*response.SoundEffectDataResponse, error) {
+	soundEffect, err := s.findMySoundEffect(ctx, userID, soundEffectID)
+	if err != nil {
+		return nil, err
+	}
+
+	return &response.SoundEffectDataResponse{Data: toSoundEffectResponse(ctx, s.storageClient, *soundEffect)}, nil
+}
+
+// UpdateMySoundEffect は自分の効果音を更新する
+func (s *soundEffectService) UpdateMySoundEffect(ctx context.Context, userID, soundEffectID string, req request.UpdateSoundEffectRequest) (*response.SoundEffectDataResponse, error) {
+	soundEffect, err := s.findMySoundEffect(ctx, userID, soundEffectID)
+	if err != nil {
+		return nil, err
+	}
+
+	if req.Name != nil {
+		// 同一ユーザー内で同じ名前の効果音が存在するかチェック（自分自身を除く）
+		exists, err := s.soundEffectRepo.ExistsByUserIDAndName(ctx, soundEffect.UserID, *req.Name, &soundEffect.ID)
+		if err != nil {
+			return nil, err
+		}
+		if exists {
+			return nil, apperror.ErrDuplicateName.WithMessage("同じ名前の効果音が既に存在します")
+		}
+		soundEffect.Name = *req.Name
+	}
+
+	if err := s.soundEffectRepo.Update(ctx, soundEffect); err != nil {
+		return nil, err
+	}
+
+	return &response.SoundEffectDataResponse{Data: toSoundEffectResponse(ctx, s.storageClient, *soundEffect)}, nil
+}
+
+// DeleteMySoundEffect は自分の効果音を削除する
+func (s *soundEffectService) DeleteMySoundEffect(ctx context.Context, userID, soundEffectID string) error {
+	soundEffect, err := s.findMySoundEffect(ctx, userID, soundEffectID)
+	if err != nil {
+		return err
+	}
+
+	// 使用中チェック
+	isUsed, err := s.soundEffectRepo.IsUsedInAnyCue(ctx, soundEffect.ID)
+	if err != nil {
+		return err
+	}
+	if isUsed {
+		return apperror.ErrSoundEffectInUse.WithMessage("この効果音は台本の効果音キューで使用中のため削除できません")
+	}
+
+	return s.soundEffectRepo.Delete(ctx, soundEffect.ID)
+}
+
+// findMySoundEffect は自分が所有する効果音を取得する
+//
+// 他のユーザーの効果音の場合は存在しないものとして扱う
+func (s *soundEffectService) findMySoundEffect(ctx context.Context, userID, soundEffectID string) (*model.SoundEffect, error) {
+	uid, err := uuid.Parse(userID)
+	if err != nil {
+		return nil, err
+	}
+
+	sid, err := uuid.Parse(soundEffectID)
+	if err != nil {
+		return nil, err
+	}
+
+	soundEffect, err := s.soundEffectRepo.FindByID(ctx, sid)
+	if err != nil {
+		return nil, err
+	}
+
+	// 所有者チェック
+	if soundEffect.UserID != uid {
+		return nil, apperror.ErrNotFound.WithMessage("効果音が見つかりません")
+	}
+
+	return soundEffect, nil
+}
+
+// resolveSFXLibrary はセリフ内マークアップの効果音名をユーザーの効果音・システム効果音の音声に解決する
+//
+// 同じ名前の場合はユーザーの効果音を優先する。どちらにも見つからない効果音名がある場合はバリデーションエラーを返す
+func resolveSFXLibrary(ctx context.Context, soundEffectRepo repository.SoundEffectRepository, systemSoundEffectRepo repository.SystemSoundEffectRepository, userID uuid.UUID, names []string) (map[string]model.Audio, error) {
+	audios := make(map[string]model.Audio, len(names))
+	if len(names) == 0 {
+		return audios, nil
+	}
+
+	soundEffects, err := soundEffectRepo.FindByUserIDAndNames(ctx, userID, names)
+	if err != nil {
+		return nil, err
+	}
+	for _, se := range soundEffects {
+		audios[se.Name] = se.Audio
+	}
+
+	var rest []string
+	for _, name := range names {
+		if _, ok := audios[name]; !ok {
+			rest = append(rest, name)
+		}
+	}
+	if len(rest) == 0 {
+		return audios, nil
+	}
+
+	systemSoundEffects, err := systemSoundEffectRepo.FindActiveByNames(ctx, rest)
+	if err != nil {
+		return nil, err
+	}
+	for _, se := range systemSoundEffects {
+		audios[se.Name] = se.Audio
+	}
+
+	var missing []string
+	for _, name := range rest {
+		if _, ok := audios[name]; !ok {
+			missing = append(missing, name)
+		}
+	}
+	if len(missing) > 0 {
+		return nil, apperror.ErrValidation.WithMessage(fmt.Sprintf("効果音ライブラリに見つからない効果音があります: %s", strings.Join(missing, ", ")))
+	}
+
+	return audios, nil
+}
+
+// toSoundEffectResponse は SoundEffect をレスポンス DTO に変換する
+func toSoundEffectResponse(ctx context.Context, storageClient storage.Client, se model.SoundEffect) response.SoundEffectResponse {
+	return response.SoundEffectResponse{
+		ID:        se.ID,
+		Name:      se.Name,
+		IsSystem:  false,
+		Audio:     toSoundEffectAudioResponse(ctx, storageClient, se.Audio),
+		CreatedAt: se.CreatedAt,
+		UpdatedAt: se.UpdatedAt,
+	}
+}
+
+// toSystemSoundEffectResponse は SystemSoundEffect をレスポンス DTO に変換する
+func toSystemSoundEffectResponse(ctx context.Context, storageClient storage.Client, se model.SystemSoundEffect) response.SoundEffectResponse {
+	return response.SoundEffectResponse{
+		ID:        se.ID,
+		Name:      se.Name,
+		IsSystem:  true,
+		Audio:     toSoundEffectAudioResponse(ctx, storageClient, se.Audio),
+		CreatedAt: se.CreatedAt,
+		UpdatedAt: se.UpdatedAt,
+	}
+}
+
+// toSoundEffectAudioResponse は効果音の Audio をレスポンス DTO に変換する
+func toSoundEffectAudioResponse(ctx context.Context, storageClient storage.Client, audio model.Audio) response.SoundEffectAudioResponse {
+	var url string
+	if storageClient != nil {
+		signedURL, err := storageClient.GenerateSignedURL(ctx, audio.Path, storage.SignedURLExpirationAudio)
+		if err == nil {
+			url = signedURL
+		}
+		// URL 生成に失敗した場合は空文字のまま
+	}
+
+	return response.SoundEffectAudioResponse{
+		ID:         audio.ID,
+		URL:        url,
+		DurationMs: audio.DurationMs,
+	}
+}
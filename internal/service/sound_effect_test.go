@@ -0,0 +1,316 @@
+package service
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+
+	"github.com/siropaca/anycast-backend/internal/apperror"
+	"github.com/siropaca/anycast-backend/internal/dto/request"
+	"github.com/siropaca/anycast-backend/internal/model"
+	"github.com/siropaca/anycast-backend/internal/pkg/uuid"
+	"github.com/siropaca/anycast-backend/internal/repository"
+)
+
+type mockSoundEffectRepository struct {
+	mock.Mock
+}
+
+func (m *mockSoundEffectRepository) FindByID(ctx context.Context, id uuid.UUID) (*model.SoundEffect, error) {
+	args := m.Called(ctx, id)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).(*model.SoundEffect), args.Error(1)
+}
+
+func (m *mockSoundEffectRepository) FindByUserID(ctx context.Context, userID uuid.UUID, filter repository.SoundEffectFilter) ([]model.SoundEffect, int64, error) {
+	args := m.Called(ctx, userID, filter)
+	if args.Get(0) == nil {
+		return nil, 0, args.Error(2)
+	}
+	return args.Get(0).([]model.SoundEffect), args.Get(1).(int64), args.Error(2)
+}
+
+func (m *mockSoundEffectRepository) FindByUserIDAndNames(ctx context.Context, userID uuid.UUID, names []string) ([]model.SoundEffect, error) {
+	args := m.Called(ctx, userID, names)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).([]model.SoundEffect), args.Error(1)
+}
+
+func (m *mockSoundEffectRepository) Create(ctx context.Context, soundEffect *model.SoundEffect) error {
+	args := m.Called(ctx, soundEffect)
+	return args.Error(0)
+}
+
+func (m *mockSoundEffectRepository) Update(ctx context.Context, soundEffect *model.SoundEffect) error {
+	args := m.Called(ctx, soundEffect)
+	return args.Error(0)
+}
+
+func (m *mockSoundEffectRepository) Delete(ctx context.Context, id uuid.UUID) error {
+	args := m.Called(ctx, id)
+	return args.Error(0)
+}
+
+func (m *mockSoundEffectRepository) IsUsedInAnyCue(ctx context.Context, id uuid.UUID) (bool, error) {
+	args := m.Called(ctx, id)
+	return args.Bool(0), args.Error(1)
+}
+
+func (m *mockSoundEffectRepository) ExistsByUserIDAndName(ctx context.Context, userID uuid.UUID, name string, excludeID *uuid.UUID) (bool, error) {
+	args := m.Called(ctx, userID, name, excludeID)
+	return args.Bool(0), args.Error(1)
+}
+
+type mockSystemSoundEffectRepository struct {
+	mock.Mock
+}
+
+func (m *mockSystemSoundEffectRepository) FindByID(ctx context.Context, id uuid.UUID) (*model.SystemSoundEffect, error) {
+	args := m.Called(ctx, id)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).(*model.SystemSoundEffect), args.Error(1)
+}
+
+func (m *mockSystemSoundEffectRepository) FindActive(ctx context.Context, filter repository.SystemSoundEffectFilter) ([]model.SystemSoundEffect, int64, error) {
+	args := m.Called(ctx, filter)
+	if args.Get(0) == nil {
+		return nil, 0, args.Error(2)
+	}
+	return args.Get(0).([]model.SystemSoundEffect), args.Get(1).(int64), args.Error(2)
+}
+
+func (m *mockSystemSoundEffectRepository) CountActive(ctx context.Context) (int64, error) {
+	args := m.Called(ctx)
+	return args.Get(0).(int64), args.Error(1)
+}
+
+func (m *mockSystemSoundEffectRepository) FindActiveByNames(ctx context.Context, names []string) ([]model.SystemSoundEffect, error) {
+	args := m.Called(ctx, names)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).([]model.SystemSoundEffect), args.Error(1)
+}
+
+func TestListMySoundEffects(t *testing.T) {
+	ctx := context.Background()
+	userID := uuid.New()
+	now := time.Now()
+
+	t.Run("正常系: 自分の効果音一覧を取得する", func(t *testing.T) {
+		mockSoundEffectRepo := new(mockSoundEffectRepository)
+		mockSystemSoundEffectRepo := new(mockSystemSoundEffectRepository)
+		mockAudioRepo := new(mockAudioRepository)
+		mockStorage := new(mockStorageClient)
+
+		svc := NewSoundEffectService(mockSoundEffectRepo, mockSystemSoundEffectRepo, mockAudioRepo, mockStorage)
+
+		soundEffects := []model.SoundEffect{
+			{
+				ID:        uuid.New(),
+				UserID:    userID,
+				Name:      "door_knock",
+				Audio:     model.Audio{ID: uuid.New(), Path: "audios/door.mp3", DurationMs: 1200},
+				CreatedAt: now,
+				UpdatedAt: now,
+			},
+		}
+
+		mockSoundEffectRepo.On("FindByUserID", mock.Anything, userID, repository.SoundEffectFilter{Limit: 20, Offset: 0}).Return(soundEffects, int64(1), nil)
+		mockStorage.On("GenerateSignedURL", mock.Anything, "audios/door.mp3", mock.Anything).Return("https://signed-url.example.com/door.mp3", nil)
+
+		result, err := svc.ListMySoundEffects(ctx, userID.String(), request.ListMySoundEffectsRequest{
+			PaginationRequest: request.PaginationRequest{Limit: 20, Offset: 0},
+		})
+
+		assert.NoError(t, err)
+		assert.Len(t, result.Data, 1)
+		assert.Equal(t, "door_knock", result.Data[0].Name)
+		assert.False(t, result.Data[0].IsSystem)
+		assert.Equal(t, "https://signed-url.example.com/door.mp3", result.Data[0].Audio.URL)
+		assert.Equal(t, 1200, result.Data[0].Audio.DurationMs)
+		assert.Equal(t, int64(1), result.Pagination.Total)
+	})
+
+	t.Run("正常系: include_system=true の場合はユーザー効果音の後にシステム効果音を含める", func(t *testing.T) {
+		mockSoundEffectRepo := new(mockSoundEffectRepository)
+		mockSystemSoundEffectRepo := new(mockSystemSoundEffectRepository)
+		mockAudioRepo := new(mockAudioRepository)
+		mockStorage := new(mockStorageClient)
+
+		svc := NewSoundEffectService(mockSoundEffectRepo, mockSystemSoundEffectRepo, mockAudioRepo, mockStorage)
+
+		soundEffects := []model.SoundEffect{
+			{
+				ID:        uuid.New(),
+				UserID:    userID,
+				Name:      "door_knock",
+				Audio:     model.Audio{ID: uuid.New(), Path: "audios/door.mp3"},
+				CreatedAt: now,
+				UpdatedAt: now,
+			},
+		}
+		systemSoundEffects := []model.SystemSoundEffect{
+			{
+				ID:        uuid.New(),
+				Name:      "applause",
+				Audio:     model.Audio{ID: uuid.New(), Path: "audios/applause.mp3"},
+				IsActive:  true,
+				CreatedAt: now,
+				UpdatedAt: now,
+			},
+		}
+
+		mockSoundEffectRepo.On("FindByUserID", mock.Anything, userID, repository.SoundEffectFilter{Limit: 0, Offset: 0}).Return(soundEffects, int64(1), nil)
+		mockSystemSoundEffectRepo.On("CountActive", mock.Anything).Return(int64(1), nil)
+		mockSoundEffectRepo.On("FindByUserID", mock.Anything, userID, repository.SoundEffectFilter{Limit: 20, Offset: 0}).Return(soundEffects, int64(1), nil)
+		mockSystemSoundEffectRepo.On("FindActive", mock.Anything, repository.SystemSoundEffectFilter{Limit: 19, Offset: 0}).Return(systemSoundEffects, int64(1), nil)
+		mockStorage.On("GenerateSignedURL", mock.Anything, mock.Anything, mock.Anything).Return("https://signed-url.example.com/sfx.mp3", nil)
+
+		result, err := svc.ListMySoundEffects(ctx, userID.String(), request.ListMySoundEffectsRequest{
+			PaginationRequest: request.PaginationRequest{Limit: 20, Offset: 0},
+			IncludeSystem:     true,
+		})
+
+		assert.NoError(t, err)
+		assert.Len(t, result.Data, 2)
+		assert.False(t, result.Data[0].IsSystem)
+		assert.True(t, result.Data[1].IsSystem)
+		assert.Equal(t, "applause", result.Data[1].Name)
+		assert.Equal(t, int64(2), result.Pagination.Total)
+	})
+}
+
+func TestCreateSoundEffect(t *testing.T) {
+	ctx := context.Background()
+	userID := uuid.New()
+	audioID := uuid.New()
+
+	t.Run("異常系: 同じ名前の効果音が既に存在する場合エラーを返す", func(t *testing.T) {
+		mockSoundEffectRepo := new(mockSoundEffectRepository)
+		mockSystemSoundEffectRepo := new(mockSystemSoundEffectRepository)
+		mockAudioRepo := new(mockAudioRepository)
+		mockStorage := new(mockStorageClient)
+
+		svc := NewSoundEffectService(mockSoundEffectRepo, mockSystemSoundEffectRepo, mockAudioRepo, mockStorage)
+
+		mockSoundEffectRepo.On("ExistsByUserIDAndName", mock.Anything, userID, "door_knock", (*uuid.UUID)(nil)).Return(true, nil)
+
+		result, err := svc.CreateSoundEffect(ctx, userID.String(), request.CreateSoundEffectRequest{
+			Name:    "door_knock",
+			AudioID: audioID.String(),
+		})
+
+		assert.Nil(t, result)
+		assert.True(t, apperror.IsCode(err, apperror.CodeDuplicateName))
+		mockAudioRepo.AssertNotCalled(t, "FindByID", mock.Anything, mock.Anything)
+	})
+}
+
+func TestDeleteMySoundEffect(t *testing.T) {
+	ctx := context.Background()
+	userID := uuid.New()
+	soundEffectID := uuid.New()
+	now := time.Now()
+
+	newSoundEffect := func(ownerID uuid.UUID) *model.SoundEffect {
+		return &model.SoundEffect{
+			ID:        soundEffectID,
+			UserID:    ownerID,
+			AudioID:   uuid.New(),
+			Name:      "door_knock",
+			CreatedAt: now,
+			UpdatedAt: now,
+		}
+	}
+
+	t.Run("正常系: 効果音を削除する", func(t *testing.T) {
+		mockSoundEffectRepo := new(mockSoundEffectRepository)
+		svc := NewSoundEffectService(mockSoundEffectRepo, new(mockSystemSoundEffectRepository), new(mockAudioRepository), new(mockStorageClient))
+
+		mockSoundEffectRepo.On("FindByID", mock.Anything, soundEffectID).Return(newSoundEffect(userID), nil)
+		mockSoundEffectRepo.On("IsUsedInAnyCue", mock.Anything, soundEffectID).Return(false, nil)
+		mockSoundEffectRepo.On("Delete", mock.Anything, soundEffectID).Return(nil)
+
+		err := svc.DeleteMySoundEffect(ctx, userID.String(), soundEffectID.String())
+
+		assert.NoError(t, err)
+		mockSoundEffectRepo.AssertExpectations(t)
+	})
+
+	t.Run("異常系: 他のユーザーの効果音を削除しようとした場合エラーを返す", func(t *testing.T) {
+		mockSoundEffectRepo := new(mockSoundEffectRepository)
+		svc := NewSoundEffectService(mockSoundEffectRepo, new(mockSystemSoundEffectRepository), new(mockAudioRepository), new(mockStorageClient))
+
+		mockSoundEffectRepo.On("FindByID", mock.Anything, soundEffectID).Return(newSoundEffect(uuid.New()), nil)
+
+		err := svc.DeleteMySoundEffect(ctx, userID.String(), soundEffectID.String())
+
+		assert.True(t, apperror.IsCode(err, apperror.CodeNotFound))
+		mockSoundEffectRepo.AssertNotCalled(t, "Delete", mock.Anything, mock.Anything)
+	})
+
+	t.Run("異常系: 効果音キューで使用中の場合エラーを返す", func(t *testing.T) {
+		mockSoundEffectRepo := new(mockSoundEffectRepository)
+		svc := NewSoundEffectService(mockSoundEffectRepo, new(mockSystemSoundEffectRepository), new(mockAudioRepository), new(mockStorageClient))
+
+		mockSoundEffectRepo.On("FindByID", mock.Anything, soundEffectID).Return(newSoundEffect(userID), nil)
+		mockSoundEffectRepo.On("IsUsedInAnyCue", mock.Anything, soundEffectID).Return(true, nil)
+
+		err := svc.DeleteMySoundEffect(ctx, userID.String(), soundEffectID.String())
+
+		assert.True(t, apperror.IsCode(err, apperror.CodeSoundEffectInUse))
+		mockSoundEffectRepo.AssertNotCalled(t, "Delete", mock.Anything, mock.Anything)
+	})
+}
+
+func TestResolveSFXLibrary(t *testing.T) {
+	ctx := context.Background()
+	userID := uuid.New()
+
+	t.Run("ユーザーの効果音を優先し、残りをシステム効果音で解決する", func(t *testing.T) {
+		mockSoundEffectRepo := new(mockSoundEffectRepository)
+		mockSystemSoundEffectRepo := new(mockSystemSoundEffectRepository)
+		userAudio := model.Audio{ID: uuid.New(), Path: "audios/door.mp3"}
+		systemAudio := model.Audio{ID: uuid.New(), Path: "audios/applause.mp3"}
+		mockSoundEffectRepo.On("FindByUserIDAndNames", ctx, userID, []string{"door", "applause"}).Return([]model.SoundEffect{{Name: "door", Audio: userAudio}}, nil)
+		mockSystemSoundEffectRepo.On("FindActiveByNames", ctx, []string{"applause"}).Return([]model.SystemSoundEffect{{Name: "applause", Audio: systemAudio}}, nil)
+
+		audios, err := resolveSFXLibrary(ctx, mockSoundEffectRepo, mockSystemSoundEffectRepo, userID, []string{"door", "applause"})
+
+		assert.NoError(t, err)
+		assert.Equal(t, map[string]model.Audio{"door": userAudio, "applause": systemAudio}, audios)
+	})
+
+	t.Run("見つからない効果音がある場合はバリデーションエラー", func(t *testing.T) {
+		mockSoundEffectRepo := new(mockSoundEffectRepository)
+		mockSystemSoundEffectRepo := new(mockSystemSoundEffectRepository)
+		mockSoundEffectRepo.On("FindByUserIDAndNames", ctx, userID, []string{"door", "unknown"}).Return([]model.SoundEffect{{Name: "door"}}, nil)
+		mockSystemSoundEffectRepo.On("FindActiveByNames", ctx, []string{"unknown"}).Return([]model.SystemSoundEffect{}, nil)
+
+		audios, err := resolveSFXLibrary(ctx, mockSoundEffectRepo, mockSystemSoundEffectRepo, userID, []string{"door", "unknown"})
+
+		assert.Nil(t, audios)
+		assert.True(t, apperror.IsCode(err, apperror.CodeValidation))
+		assert.Contains(t, err.Error(), "unknown")
+	})
+
+	t.Run("効果音名がない場合はリポジトリを呼ばない", func(t *testing.T) {
+		mockSoundEffectRepo := new(mockSoundEffectRepository)
+
+		audios, err := resolveSFXLibrary(ctx, mockSoundEffectRepo, nil, userID, nil)
+
+		assert.NoError(t, err)
+		assert.Empty(t, audios)
+		mockSoundEffectRepo.AssertNotCalled(t, "FindByUserIDAndNames", mock.Anything, mock.Anything, mock.Anything)
+	})
+}
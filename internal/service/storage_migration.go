@@ -0,0 +1,200 @@
+package service
+
+import (
+	"context"
+	"io"
+
+	"github.com/siropaca/anycast-backend/internal/apperror"
+	"github.com/siropaca/anycast-backend/internal/infrastructure/storage"
+	"github.com/siropaca/anycast-backend/internal/pkg/logger"
+	"github.com/siropaca/anycast-backend/internal/repository"
+)
+
+// StorageMigrationObject は移行対象のオブジェクトを表す
+type StorageMigrationObject struct {
+	Path        string
+	ContentType string
+}
+
+// StorageMigrationOptions はストレージ移行のオプションを表す
+type StorageMigrationOptions struct {
+	// DryRun が true の場合は移行対象の一覧を返すのみでコピーしない
+	DryRun bool
+	// Overwrite が true の場合は移行先に既に存在するオブジェクトも上書きする
+	Overwrite bool
+	// ExtraPaths は DB で管理していない追加の移行対象（効果音ライブラリなど）
+	ExtraPaths []string
+}
+
+// StorageMigrationResult はストレージ移行の結果を表す
+type StorageMigrationResult struct {
+	Objects      []StorageMigrationObject
+	CopiedCount  int
+	SkippedCount int
+	MissingPaths []string
+	FailedPaths  []string
+}
+
+// StorageMigrationService はストレージ間のオブジェクト移行サービスのインターフェースを表す
+type StorageMigrationService interface {
+	Migrate(ctx context.Context, src, dst storage.Client, opts StorageMigrationOptions) (*StorageMigrationResult, error)
+}
+
+type storageMigrationService struct {
+	audioRepo      repository.AudioRepository
+	imageRepo      repository.ImageRepository
+	videoRepo      repository.VideoRepository
+	hlsPackageRepo repository.EpisodeHLSPackageRepository
+}
+
+// NewStorageMigrationService は storageMigrationService を生成して StorageMigrationService として返す
+func NewStorageMigrationService(
+	audioRepo repository.AudioRepository,
+	imageRepo repository.ImageRepository,
+	videoRepo repository.VideoRepository,
+	hlsPackageRepo repository.EpisodeHLSPackageRepository,
+) StorageMigrationService {
+	return &storageMigrationService{
+		audioRepo:      audioRepo,
+		imageRepo:      imageRepo,
+		videoRepo:      videoRepo,
+		hlsPackageRepo: hlsPackageRepo,
+	}
+}
+
+// Migrate は DB が参照するオブジェクトを src から dst へ同じパスでコピーする
+//
+// パスを変えずにコピーするため、移行後に STORAGE_BACKEND を切り替えるだけで Audio.Path / Image.Path / Video.Path はそのまま使える。
+// 1 件のコピーに失敗しても残りの移行は続け、失敗したパスを結果に含める
+func (s *storageMigrationService) Migrate(ctx context.Context, src, dst storage.Client, opts StorageMigrationOptions) (*StorageMigrationResult, error) {
+	log := logger.FromContext(ctx)
+
+	objects, err := s.listObjects(ctx, opts.ExtraPaths)
+	if err != nil {
+		return nil, err
+	}
+
+	result := &StorageMigrationResult{Objects: objects}
+
+	log.Info("storage migration objects listed", "count", len(objects), "dry_run", opts.DryRun)
+
+	if opts.DryRun {
+		return result, nil
+	}
+
+	for _, obj := range objects {
+		if !opts.Overwrite {
+			exists, err := dst.Exists(ctx, obj.Path)
+			if err != nil {
+				log.Warn("failed to check destination object", "error", err, "path", obj.Path)
+				result.FailedPaths = append(result.FailedPaths, obj.Path)
+				continue
+			}
+			if exists {
+				result.SkippedCount++
+				continue
+			}
+		}
+
+		if err := copyObject(ctx, src, dst, obj); err != nil {
+			if apperror.IsCode(err, apperror.CodeNotFound) {
+				log.Warn("source object not found", "path", obj.Path)
+				result.MissingPaths = append(result.MissingPaths, obj.Path)
+				continue
+			}
+			log.Warn("failed to copy object", "error", err, "path", obj.Path)
+			result.FailedPaths = append(result.FailedPaths, obj.Path)
+			continue
+		}
+
+		result.CopiedCount++
+	}
+
+	log.Info("storage migration completed",
+		"copied", result.CopiedCount,
+		"skipped", result.SkippedCount,
+		"missing", len(result.MissingPaths),
+		"failed", len(result.FailedPaths),
+	)
+
+	return result, nil
+}
+
+// listObjects は DB が参照するオブジェクトと追加のパスを重複なく列挙する
+//
+// 外部 URL（OAuth のアバターなど）はストレージ上に存在しないため除外する
+func (s *storageMigrationService) listObjects(ctx context.Context, extraPaths []string) ([]StorageMigrationObject, error) {
+	var objects []StorageMigrationObject
+	seen := make(map[string]bool)
+	add := func(path, contentType string) {
+		if path == "" || storage.IsExternalURL(path) || seen[path] {
+			return
+		}
+		seen[path] = true
+		if contentType == "" {
+			contentType = storage.ContentTypeFromPath(path)
+		}
+		objects = append(objects, StorageMigrationObject{Path: path, ContentType: contentType})
+	}
+
+	audios, err := s.audioRepo.FindAll(ctx)
+	if err != nil {
+		return nil, err
+	}
+	for _, a := range audios {
+		add(a.Path, a.MimeType)
+	}
+
+	images, err := s.imageRepo.FindAll(ctx)
+	if err != nil {
+		return nil, err
+	}
+	for _, img := range images {
+		add(img.Path, img.MimeType)
+	}
+
+	videos, err := s.videoRepo.FindAll(ctx)
+	if err != nil {
+		return nil, err
+	}
+	for _, v := range videos {
+		add(v.Path, v.MimeType)
+	}
+
+	packages, err := s.hlsPackageRepo.FindAll(ctx)
+	if err != nil {
+		return nil, err
+	}
+	for i := range packages {
+		for _, p := range packages[i].FilePaths() {
+			add(p, "")
+		}
+	}
+
+	for _, p := range extraPaths {
+		add(p, "")
+	}
+
+	return objects, nil
+}
+
+// copyObject は src のオブジェクトをメモリに載せずに dst へストリーミングでコピーする
+func copyObject(ctx context.Context, src, dst storage.Client, obj StorageMigrationObject) error {
+	pr, pw := io.Pipe()
+
+	downloadErr := make(chan error, 1)
+	go func() {
+		_, err := src.DownloadStream(ctx, obj.Path, pw)
+		pw.CloseWithError(err)
+		downloadErr <- err
+	}()
+
+	_, uploadErr := dst.UploadStream(ctx, pr, obj.Path, obj.ContentType)
+	// アップロードが途中で失敗した場合もダウンロード側の書き込みを解放する
+	pr.CloseWithError(uploadErr)
+
+	if err := <-downloadErr; err != nil {
+		return err
+	}
+	return uploadErr
+}
@@ -0,0 +1,169 @@
+package service
+
+import (
+	"bytes"
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+
+	"github.com/siropaca/anycast-backend/internal/infrastructure/storage"
+	"github.com/siropaca/anycast-backend/internal/model"
+	"github.com/siropaca/anycast-backend/internal/pkg/uuid"
+)
+
+// mockEpisodeHLSPackageRepository は EpisodeHLSPackageRepository のモック
+type mockEpisodeHLSPackageRepository struct {
+	mock.Mock
+}
+
+func (m *mockEpisodeHLSPackageRepository) Replace(ctx context.Context, pkg *model.EpisodeHLSPackage) error {
+	args := m.Called(ctx, pkg)
+	return args.Error(0)
+}
+
+func (m *mockEpisodeHLSPackageRepository) DeleteByEpisodeID(ctx context.Context, episodeID uuid.UUID) error {
+	args := m.Called(ctx, episodeID)
+	return args.Error(0)
+}
+
+func (m *mockEpisodeHLSPackageRepository) FindAll(ctx context.Context) ([]model.EpisodeHLSPackage, error) {
+	args := m.Called(ctx)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).([]model.EpisodeHLSPackage), args.Error(1)
+}
+
+func newTestMigrationStorage(t *testing.T, files map[string]string) storage.Client {
+	t.Helper()
+
+	client, err := storage.NewLocalClient(t.TempDir(), "http://localhost:8081/api/v1", "secret")
+	require.NoError(t, err)
+
+	for p, data := range files {
+		_, err := client.Upload(context.Background(), []byte(data), p, "")
+		require.NoError(t, err)
+	}
+
+	return client
+}
+
+func readTestObject(t *testing.T, client storage.Client, path string) string {
+	t.Helper()
+
+	var buf bytes.Buffer
+	_, err := client.DownloadStream(context.Background(), path, &buf)
+	require.NoError(t, err)
+	return buf.String()
+}
+
+func TestStorageMigrationService_Migrate(t *testing.T) {
+	ctx := context.Background()
+
+	setupRepos := func() (*mockAudioRepository, *mockImageRepository, *mockVideoRepository, *mockEpisodeHLSPackageRepository) {
+		audioRepo := new(mockAudioRepository)
+		imageRepo := new(mockImageRepository)
+		videoRepo := new(mockVideoRepository)
+		hlsRepo := new(mockEpisodeHLSPackageRepository)
+
+		audioRepo.On("FindAll", mock.Anything).Return([]model.Audio{
+			{Path: "audios/a.mp3", MimeType: "audio/mpeg"},
+		}, nil)
+		imageRepo.On("FindAll", mock.Anything).Return([]model.Image{
+			{Path: "images/a.png", MimeType: "image/png"},
+			{Path: "https://example.com/avatar.png", MimeType: "image/png"},
+		}, nil)
+		videoRepo.On("FindAll", mock.Anything).Return([]model.Video{
+			{Path: "videos/a.mp4", MimeType: "video/mp4"},
+		}, nil)
+		hlsRepo.On("FindAll", mock.Anything).Return([]model.EpisodeHLSPackage{
+			{PathPrefix: "hls/pkg", SegmentCount: 1},
+		}, nil)
+
+		return audioRepo, imageRepo, videoRepo, hlsRepo
+	}
+
+	t.Run("DB が参照するオブジェクトを同じパスでコピーする", func(t *testing.T) {
+		audioRepo, imageRepo, videoRepo, hlsRepo := setupRepos()
+		src := newTestMigrationStorage(t, map[string]string{
+			"audios/a.mp3":             "audio",
+			"images/a.png":             "image",
+			"videos/a.mp4":             "video",
+			"hls/pkg/playlist.m3u8":    "playlist",
+			"hls/pkg/segment_00000.ts": "segment",
+			"sfx/chime.mp3":            "chime",
+			"tts-previews/unused.mp3":  "cache",
+		})
+		dst := newTestMigrationStorage(t, nil)
+		svc := NewStorageMigrationService(audioRepo, imageRepo, videoRepo, hlsRepo)
+
+		result, err := svc.Migrate(ctx, src, dst, StorageMigrationOptions{ExtraPaths: []string{"sfx/chime.mp3"}})
+
+		require.NoError(t, err)
+		assert.Len(t, result.Objects, 6)
+		assert.Equal(t, 6, result.CopiedCount)
+		assert.Empty(t, result.FailedPaths)
+		assert.Equal(t, "audio", readTestObject(t, dst, "audios/a.mp3"))
+		assert.Equal(t, "video", readTestObject(t, dst, "videos/a.mp4"))
+		assert.Equal(t, "segment", readTestObject(t, dst, "hls/pkg/segment_00000.ts"))
+		assert.Equal(t, "chime", readTestObject(t, dst, "sfx/chime.mp3"))
+
+		exists, err := dst.Exists(ctx, "tts-previews/unused.mp3")
+		require.NoError(t, err)
+		assert.False(t, exists)
+	})
+
+	t.Run("dry-run の場合はコピーしない", func(t *testing.T) {
+		audioRepo, imageRepo, videoRepo, hlsRepo := setupRepos()
+		src := newTestMigrationStorage(t, map[string]string{"audios/a.mp3": "audio"})
+		dst := newTestMigrationStorage(t, nil)
+		svc := NewStorageMigrationService(audioRepo, imageRepo, videoRepo, hlsRepo)
+
+		result, err := svc.Migrate(ctx, src, dst, StorageMigrationOptions{DryRun: true})
+
+		require.NoError(t, err)
+		assert.Len(t, result.Objects, 5)
+		assert.Equal(t, 0, result.CopiedCount)
+
+		exists, err := dst.Exists(ctx, "audios/a.mp3")
+		require.NoError(t, err)
+		assert.False(t, exists)
+	})
+
+	t.Run("移行先に存在するオブジェクトはスキップし、移行元にないものは記録する", func(t *testing.T) {
+		audioRepo, imageRepo, videoRepo, hlsRepo := setupRepos()
+		src := newTestMigrationStorage(t, map[string]string{
+			"audios/a.mp3":          "audio",
+			"images/a.png":          "image",
+			"videos/a.mp4":          "video",
+			"hls/pkg/playlist.m3u8": "playlist",
+		})
+		dst := newTestMigrationStorage(t, map[string]string{"audios/a.mp3": "existing"})
+		svc := NewStorageMigrationService(audioRepo, imageRepo, videoRepo, hlsRepo)
+
+		result, err := svc.Migrate(ctx, src, dst, StorageMigrationOptions{})
+
+		require.NoError(t, err)
+		assert.Equal(t, 3, result.CopiedCount)
+		assert.Equal(t, 1, result.SkippedCount)
+		assert.Equal(t, []string{"hls/pkg/segment_00000.ts"}, result.MissingPaths)
+		assert.Equal(t, "existing", readTestObject(t, dst, "audios/a.mp3"))
+	})
+
+	t.Run("overwrite の場合は移行先に存在するオブジェクトも上書きする", func(t *testing.T) {
+		audioRepo, imageRepo, videoRepo, hlsRepo := setupRepos()
+		src := newTestMigrationStorage(t, map[string]string{"audios/a.mp3": "audio"})
+		dst := newTestMigrationStorage(t, map[string]string{"audios/a.mp3": "existing"})
+		svc := NewStorageMigrationService(audioRepo, imageRepo, videoRepo, hlsRepo)
+
+		result, err := svc.Migrate(ctx, src, dst, StorageMigrationOptions{Overwrite: true})
+
+		require.NoError(t, err)
+		assert.Equal(t, 1, result.CopiedCount)
+		assert.Equal(t, 0, result.SkippedCount)
+		assert.Equal(t, "audio", readTestObject(t, dst, "audios/a.mp3"))
+	})
+}
@@ -0,0 +1,102 @@
+package service
+
+import (
+	"bytes"
+	"context"
+	"crypto/sha256"
+	"encoding/hex"
+	"encoding/json"
+	"strings"
+
+	"github.com/siropaca/anycast-backend/internal/apperror"
+	"github.com/siropaca/anycast-backend/internal/infrastructure/storage"
+	"github.com/siropaca/anycast-backend/internal/infrastructure/tts"
+	"github.com/siropaca/anycast-backend/internal/model"
+	"github.com/siropaca/anycast-backend/internal/pkg/logger"
+)
+
+// ttsPreviewer は短いテキストを 1 つの Voice で合成し、プレビュー用の MP3 を提供する
+//
+// 合成結果は Voice・音声合成パラメータ・感情・テキストから算出したハッシュをキーにストレージへキャッシュし、
+// 同じ内容のプレビューは再合成しない
+type ttsPreviewer struct {
+	storageClient storage.Client
+	ttsRegistry   *tts.Registry
+	ffmpegService FFmpegService
+}
+
+// preview はプレビュー音声の署名付き URL と、キャッシュ済みの音声を返したかどうかを返す
+func (p *ttsPreviewer) preview(ctx context.Context, voice model.Voice, settings model.VoiceSettings, text string, emotion *string) (string, bool, error) {
+	path := storage.GenerateTTSPreviewPath(ttsPreviewHash(voice, settings, text, emotion))
+
+	cached, err := p.storageClient.Exists(ctx, path)
+	if err != nil {
+		return "", false, err
+	}
+
+	if !cached {
+		data, err := p.synthesize(ctx, voice, settings, text, emotion)
+		if err != nil {
+			return "", false, err
+		}
+
+		if _, err := p.storageClient.Upload(ctx, data, path, mp3MimeType); err != nil {
+			return "", false, err
+		}
+	}
+
+	signedURL, err := p.storageClient.GenerateSignedURL(ctx, path, storage.SignedURLExpirationAudio)
+	if err != nil {
+		return "", false, err
+	}
+
+	return signedURL, cached, nil
+}
+
+// synthesize は Voice のプロバイダでテキストを合成し、MP3 に変換して返す
+func (p *ttsPreviewer) synthesize(ctx context.Context, voice model.Voice, settings model.VoiceSettings, text string, emotion *string) ([]byte, error) {
+	ttsClient, err := p.ttsRegistry.Get(tts.Provider(voice.Provider))
+	if err != nil {
+		return nil, apperror.ErrValidation.WithMessage("このボイスのプロバイダは利用できません").WithError(err)
+	}
+
+	result, err := ttsClient.Synthesize(ctx, text, emotion, voice.ProviderVoiceID, voice.Gender, toTTSVoiceSettings(settings))
+	if err != nil {
+		logger.FromContext(ctx).Error("failed to synthesize preview", "error", err, "voice_id", voice.ID)
+		return nil, apperror.ErrGenerationFailed.WithMessage("プレビュー音声の生成に失敗しました").WithError(err)
+	}
+
+	if result.Format == "mp3" {
+		return result.Data, nil
+	}
+
+	// プレビューは短い音声のためメモリ上で変換する
+	var buf bytes.Buffer
+	if err := p.ffmpegService.ConvertToMP3(ctx, bytes.NewReader(result.Data), &buf, result.Format, result.SampleRate); err != nil {
+		return nil, err
+	}
+	return buf.Bytes(), nil
+}
+
+// ttsPreviewHash はプレビュー音声のキャッシュキーとなるハッシュを算出する
+//
+// 合成結果に影響する値（プロバイダ・Voice・性別・音声合成パラメータ・感情・テキスト）をすべて含める
+func ttsPreviewHash(voice model.Voice, settings model.VoiceSettings, text string, emotion *string) string {
+	emotionValue := ""
+	if emotion != nil {
+		emotionValue = *emotion
+	}
+
+	// フィールド順が固定の構造体のため、同じ値なら常に同じ JSON になる
+	settingsJSON, _ := json.Marshal(settings)
+
+	sum := sha256.Sum256([]byte(strings.Join([]string{
+		voice.Provider,
+		voice.ProviderVoiceID,
+		string(voice.Gender),
+		string(settingsJSON),
+		emotionValue,
+		text,
+	}, "\x00")))
+	return hex.EncodeToString(sum[:])
+}
@@ -0,0 +1,60 @@
+package service
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+
+	"github.com/siropaca/anycast-backend/internal/infrastructure/tts"
+	"github.com/siropaca/anycast-backend/internal/model"
+)
+
+// mockTTSClient は tts.Client のモック
+type mockTTSClient struct {
+	mock.Mock
+}
+
+func (m *mockTTSClient) Synthesize(ctx context.Context, text string, emotion *string, voiceID string, gender model.Gender, settings *tts.VoiceSettings) (*tts.SynthesisResult, error) {
+	args := m.Called(ctx, text, emotion, voiceID, gender, settings)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).(*tts.SynthesisResult), args.Error(1)
+}
+
+func (m *mockTTSClient) SynthesizeMultiSpeaker(ctx context.Context, turns []tts.SpeakerTurn, voiceConfigs []tts.SpeakerVoiceConfig) (*tts.SynthesisResult, error) {
+	args := m.Called(ctx, turns, voiceConfigs)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).(*tts.SynthesisResult), args.Error(1)
+}
+
+func TestTTSPreviewHash(t *testing.T) {
+	voice := model.Voice{Provider: "google", ProviderVoiceID: "Zephyr", Gender: model.GenderFemale}
+	happy := "嬉しそうに"
+
+	t.Run("同じ内容なら同じハッシュを返す", func(t *testing.T) {
+		assert.Equal(t, ttsPreviewHash(voice, model.VoiceSettings{}, "こんにちは", &happy), ttsPreviewHash(voice, model.VoiceSettings{}, "こんにちは", &happy))
+	})
+
+	t.Run("感情が異なる場合は異なるハッシュを返す", func(t *testing.T) {
+		assert.NotEqual(t, ttsPreviewHash(voice, model.VoiceSettings{}, "こんにちは", &happy), ttsPreviewHash(voice, model.VoiceSettings{}, "こんにちは", nil))
+	})
+
+	t.Run("ボイスが異なる場合は異なるハッシュを返す", func(t *testing.T) {
+		other := voice
+		other.ProviderVoiceID = "Puck"
+
+		assert.NotEqual(t, ttsPreviewHash(voice, model.VoiceSettings{}, "こんにちは", nil), ttsPreviewHash(other, model.VoiceSettings{}, "こんにちは", nil))
+	})
+
+	t.Run("音声合成パラメータが異なる場合は異なるハッシュを返す", func(t *testing.T) {
+		rate := 1.2
+		settings := model.VoiceSettings{SpeakingRate: &rate}
+
+		assert.NotEqual(t, ttsPreviewHash(voice, model.VoiceSettings{}, "こんにちは", nil), ttsPreviewHash(voice, settings, "こんにちは", nil))
+	})
+}
@@ -3,8 +3,13 @@ package service
 import (
 	"context"
 	"slices"
+	"strings"
 
 	"github.com/siropaca/anycast-backend/internal/apperror"
+	"github.com/siropaca/anycast-backend/internal/dto/request"
+	"github.com/siropaca/anycast-backend/internal/dto/response"
+	"github.com/siropaca/anycast-backend/internal/infrastructure/storage"
+	"github.com/siropaca/anycast-backend/internal/infrastructure/tts"
 	"github.com/siropaca/anycast-backend/internal/model"
 	"github.com/siropaca/anycast-backend/internal/pkg/uuid"
 	"github.com/siropaca/anycast-backend/internal/repository"
@@ -16,16 +21,32 @@ type VoiceService interface {
 	GetVoice(ctx context.Context, userID string, id string) (*model.Voice, bool, error)
 	AddFavorite(ctx context.Context, userID string, voiceID string) (*model.FavoriteVoice, error)
 	RemoveFavorite(ctx context.Context, userID string, voiceID string) error
+	Audition(ctx context.Context, voiceID string, req request.AuditionVoiceRequest) (*response.VoiceAuditionDataResponse, error)
 }
 
 type voiceService struct {
 	voiceRepo    repository.VoiceRepository
 	favVoiceRepo repository.FavoriteVoiceRepository
+	ttsPreviewer *ttsPreviewer
 }
 
 // NewVoiceService は voiceService を生成して VoiceService として返す
-func NewVoiceService(voiceRepo repository.VoiceRepository, favVoiceRepo repository.FavoriteVoiceRepository) VoiceService {
-	return &voiceService{voiceRepo: voiceRepo, favVoiceRepo: favVoiceRepo}
+func NewVoiceService(
+	voiceRepo repository.VoiceRepository,
+	favVoiceRepo repository.FavoriteVoiceRepository,
+	storageClient storage.Client,
+	ttsRegistry *tts.Registry,
+	ffmpegService FFmpegService,
+) VoiceService {
+	return &voiceService{
+		voiceRepo:    voiceRepo,
+		favVoiceRepo: favVoiceRepo,
+		ttsPreviewer: &ttsPreviewer{
+			storageClient: storageClient,
+			ttsRegistry:   ttsRegistry,
+			ffmpegService: ffmpegService,
+		},
+	}
 }
 
 // ListVoices はフィルタ条件に基づいてボイス一覧を取得する（お気に入りボイス ID 一覧付き）
@@ -138,6 +159,46 @@ func (s *voiceService) RemoveFavorite(ctx context.Context, userID, voiceID strin
 	return s.favVoiceRepo.DeleteByUserIDAndVoiceID(ctx, uid, vid)
 }
 
+// Audition は任意の短いテキストを指定したボイス・感情で合成し、試聴用の署名付き URL を返す
+//
+// voiceSettings を指定するとキャラクターに設定する前の音声合成パラメータを試せる。
+// 合成結果は台本行プレビューと同じくテキスト・感情・ボイス・パラメータのハッシュでキャッシュする
+func (s *voiceService) Audition(ctx context.Context, voiceID string, req request.AuditionVoiceRequest) (*response.VoiceAuditionDataResponse, error) {
+	text := strings.TrimSpace(req.Text)
+	if text == "" {
+		return nil, apperror.ErrValidation.WithMessage("テキストを入力してください")
+	}
+
+	var emotion *string
+	if req.Emotion != nil && strings.TrimSpace(*req.Emotion) != "" {
+		trimmed := strings.TrimSpace(*req.Emotion)
+		emotion = &trimmed
+	}
+
+	voice, err := s.voiceRepo.FindActiveByID(ctx, voiceID)
+	if err != nil {
+		return nil, err
+	}
+
+	settings := toVoiceSettingsModel(req.VoiceSettings)
+	if err := validateVoiceSettings(voice, settings); err != nil {
+		return nil, err
+	}
+
+	url, cached, err := s.ttsPreviewer.preview(ctx, *voice, settings, text, emotion)
+	if err != nil {
+		return nil, err
+	}
+
+	return &response.VoiceAuditionDataResponse{
+		Data: response.VoiceAuditionResponse{
+			URL:      url,
+			MimeType: mp3MimeType,
+			Cached:   cached,
+		},
+	}, nil
+}
+
 // containsVoiceUUID はスライスに指定された UUID が含まれるかを返す
 func containsVoiceUUID(ids []uuid.UUID, id uuid.UUID) bool {
 	for _, v := range ids {
@@ -0,0 +1,59 @@
+package service
+
+import (
+	"github.com/siropaca/anycast-backend/internal/apperror"
+	"github.com/siropaca/anycast-backend/internal/dto/request"
+	"github.com/siropaca/anycast-backend/internal/dto/response"
+	"github.com/siropaca/anycast-backend/internal/infrastructure/tts"
+	"github.com/siropaca/anycast-backend/internal/model"
+)
+
+// validateVoiceSettings は音声合成パラメータがボイスのプロバイダで対応している範囲内かを検証する
+func validateVoiceSettings(voice *model.Voice, settings model.VoiceSettings) error {
+	if settings.IsEmpty() {
+		return nil
+	}
+	if err := tts.ValidateVoiceSettings(tts.Provider(voice.Provider), *toTTSVoiceSettings(settings)); err != nil {
+		return apperror.ErrValidation.WithMessage("voiceSettings が不正です: " + err.Error())
+	}
+	return nil
+}
+
+// toTTSVoiceSettings は音声合成パラメータを TTS クライアントに渡す形式に変換する（未指定の場合は nil）
+func toTTSVoiceSettings(settings model.VoiceSettings) *tts.VoiceSettings {
+	if settings.IsEmpty() {
+		return nil
+	}
+	return &tts.VoiceSettings{
+		SpeakingRate:    settings.SpeakingRate,
+		Pitch:           settings.Pitch,
+		Stability:       settings.Stability,
+		SimilarityBoost: settings.SimilarityBoost,
+		Style:           settings.Style,
+	}
+}
+
+// toVoiceSettingsModel はリクエストの音声合成パラメータをモデルに変換する（nil の場合は未指定）
+func toVoiceSettingsModel(req *request.VoiceSettingsRequest) model.VoiceSettings {
+	if req == nil {
+		return model.VoiceSettings{}
+	}
+	return model.VoiceSettings{
+		SpeakingRate:    req.SpeakingRate,
+		Pitch:           req.Pitch,
+		Stability:       req.Stability,
+		SimilarityBoost: req.SimilarityBoost,
+		Style:           req.Style,
+	}
+}
+
+// toVoiceSettingsResponse は音声合成パラメータをレスポンス DTO に変換する
+func toVoiceSettingsResponse(settings model.VoiceSettings) response.VoiceSettingsResponse {
+	return response.VoiceSettingsResponse{
+		SpeakingRate:    settings.SpeakingRate,
+		Pitch:           settings.Pitch,
+		Stability:       settings.Stability,
+		SimilarityBoost: settings.SimilarityBoost,
+		Style:           settings.Style,
+	}
+}
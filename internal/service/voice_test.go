@@ -6,8 +6,12 @@ import (
 
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
 
 	"github.com/siropaca/anycast-backend/internal/apperror"
+	"github.com/siropaca/anycast-backend/internal/dto/request"
+	"github.com/siropaca/anycast-backend/internal/infrastructure/storage"
+	"github.com/siropaca/anycast-backend/internal/infrastructure/tts"
 	"github.com/siropaca/anycast-backend/internal/model"
 	"github.com/siropaca/anycast-backend/internal/pkg/uuid"
 	"github.com/siropaca/anycast-backend/internal/repository"
@@ -76,7 +80,7 @@ func TestNewVoiceService(t *testing.T) {
 	t.Run("VoiceService を作成できる", func(t *testing.T) {
 		mockRepo := new(mockVoiceRepository)
 		mockFavRepo := new(mockFavoriteVoiceRepository)
-		svc := NewVoiceService(mockRepo, mockFavRepo)
+		svc := NewVoiceService(mockRepo, mockFavRepo, nil, nil, nil)
 
 		assert.NotNil(t, svc)
 	})
@@ -94,7 +98,7 @@ func TestVoiceService_ListVoices(t *testing.T) {
 		mockRepo.On("FindAll", mock.Anything, filter).Return(voices, nil)
 		mockFavRepo.On("FindVoiceIDsByUserID", mock.Anything, mock.Anything).Return([]uuid.UUID{}, nil)
 
-		svc := NewVoiceService(mockRepo, mockFavRepo)
+		svc := NewVoiceService(mockRepo, mockFavRepo, nil, nil, nil)
 		result, favIDs, err := svc.ListVoices(context.Background(), testUserID, filter)
 
 		assert.NoError(t, err)
@@ -117,7 +121,7 @@ func TestVoiceService_ListVoices(t *testing.T) {
 		mockRepo.On("FindAll", mock.Anything, filter).Return(voices, nil)
 		mockFavRepo.On("FindVoiceIDsByUserID", mock.Anything, mock.Anything).Return([]uuid.UUID{}, nil)
 
-		svc := NewVoiceService(mockRepo, mockFavRepo)
+		svc := NewVoiceService(mockRepo, mockFavRepo, nil, nil, nil)
 		result, _, err := svc.ListVoices(context.Background(), testUserID, filter)
 
 		assert.NoError(t, err)
@@ -133,7 +137,7 @@ func TestVoiceService_ListVoices(t *testing.T) {
 		mockRepo.On("FindAll", mock.Anything, filter).Return([]model.Voice{}, nil)
 		mockFavRepo.On("FindVoiceIDsByUserID", mock.Anything, mock.Anything).Return([]uuid.UUID{}, nil)
 
-		svc := NewVoiceService(mockRepo, mockFavRepo)
+		svc := NewVoiceService(mockRepo, mockFavRepo, nil, nil, nil)
 		result, _, err := svc.ListVoices(context.Background(), testUserID, filter)
 
 		assert.NoError(t, err)
@@ -147,7 +151,7 @@ func TestVoiceService_ListVoices(t *testing.T) {
 		filter := repository.VoiceFilter{}
 		mockRepo.On("FindAll", mock.Anything, filter).Return(nil, apperror.ErrInternal.WithMessage("Database error"))
 
-		svc := NewVoiceService(mockRepo, mockFavRepo)
+		svc := NewVoiceService(mockRepo, mockFavRepo, nil, nil, nil)
 		result, _, err := svc.ListVoices(context.Background(), testUserID, filter)
 
 		assert.Error(t, err)
@@ -172,7 +176,7 @@ func TestVoiceService_GetVoice(t *testing.T) {
 		mockRepo.On("FindByID", mock.Anything, voiceID.String()).Return(voice, nil)
 		mockFavRepo.On("ExistsByUserIDAndVoiceID", mock.Anything, mock.Anything, mock.Anything).Return(false, nil)
 
-		svc := NewVoiceService(mockRepo, mockFavRepo)
+		svc := NewVoiceService(mockRepo, mockFavRepo, nil, nil, nil)
 		result, isFav, err := svc.GetVoice(context.Background(), testUserID, voiceID.String())
 
 		assert.NoError(t, err)
@@ -188,7 +192,7 @@ func TestVoiceService_GetVoice(t *testing.T) {
 		mockFavRepo := new(mockFavoriteVoiceRepository)
 		mockRepo.On("FindByID", mock.Anything, voiceID.String()).Return(nil, apperror.ErrNotFound.WithMessage("Voice not found"))
 
-		svc := NewVoiceService(mockRepo, mockFavRepo)
+		svc := NewVoiceService(mockRepo, mockFavRepo, nil, nil, nil)
 		result, _, err := svc.GetVoice(context.Background(), testUserID, voiceID.String())
 
 		assert.Error(t, err)
@@ -201,7 +205,7 @@ func TestVoiceService_GetVoice(t *testing.T) {
 		mockFavRepo := new(mockFavoriteVoiceRepository)
 		mockRepo.On("FindByID", mock.Anything, voiceID.String()).Return(nil, apperror.ErrInternal.WithMessage("Database error"))
 
-		svc := NewVoiceService(mockRepo, mockFavRepo)
+		svc := NewVoiceService(mockRepo, mockFavRepo, nil, nil, nil)
 		result, _, err := svc.GetVoice(context.Background(), testUserID, voiceID.String())
 
 		assert.Error(t, err)
@@ -209,3 +213,86 @@ func TestVoiceService_GetVoice(t *testing.T) {
 		mockRepo.AssertExpectations(t)
 	})
 }
+
+func TestVoiceService_Audition(t *testing.T) {
+	ctx := context.Background()
+	voiceID := uuid.New()
+	voice := &model.Voice{
+		ID:              voiceID,
+		Provider:        string(tts.ProviderGoogle),
+		ProviderVoiceID: "Zephyr",
+		Gender:          model.GenderFemale,
+		IsActive:        true,
+	}
+
+	t.Run("テキストと感情をトリムして合成する", func(t *testing.T) {
+		mockRepo := new(mockVoiceRepository)
+		mockStorage := new(mockStorageClient)
+		mockTTS := new(mockTTSClient)
+		registry := tts.NewRegistry()
+		registry.Register(tts.ProviderGoogle, mockTTS)
+
+		emotion := "嬉しそうに"
+		path := storage.GenerateTTSPreviewPath(ttsPreviewHash(*voice, model.VoiceSettings{}, "こんにちは", &emotion))
+
+		mockRepo.On("FindActiveByID", ctx, voiceID.String()).Return(voice, nil)
+		mockStorage.On("Exists", ctx, path).Return(false, nil)
+		mockTTS.On("Synthesize", ctx, "こんにちは", &emotion, "Zephyr", model.GenderFemale, mock.Anything).
+			Return(&tts.SynthesisResult{Data: []byte("mp3"), Format: "mp3"}, nil)
+		mockStorage.On("Upload", ctx, []byte("mp3"), path, "audio/mpeg").Return(path, nil)
+		mockStorage.On("GenerateSignedURL", ctx, path, storage.SignedURLExpirationAudio).Return("https://example.com/audition.mp3", nil)
+
+		svc := &voiceService{
+			voiceRepo:    mockRepo,
+			ttsPreviewer: &ttsPreviewer{storageClient: mockStorage, ttsRegistry: registry},
+		}
+
+		rawEmotion := " 嬉しそうに "
+		result, err := svc.Audition(ctx, voiceID.String(), request.AuditionVoiceRequest{Text: "  こんにちは\n", Emotion: &rawEmotion})
+
+		require.NoError(t, err)
+		assert.Equal(t, "https://example.com/audition.mp3", result.Data.URL)
+		assert.False(t, result.Data.Cached)
+		mockStorage.AssertExpectations(t)
+		mockTTS.AssertExpectations(t)
+	})
+
+	t.Run("空白のみのテキストはバリデーションエラー", func(t *testing.T) {
+		svc := &voiceService{}
+
+		_, err := svc.Audition(ctx, voiceID.String(), request.AuditionVoiceRequest{Text: "   "})
+
+		var appErr *apperror.AppError
+		require.ErrorAs(t, err, &appErr)
+		assert.Equal(t, apperror.ErrValidation.Code, appErr.Code)
+	})
+
+	t.Run("ボイスのプロバイダが対応していないパラメータはバリデーションエラー", func(t *testing.T) {
+		mockRepo := new(mockVoiceRepository)
+		mockRepo.On("FindActiveByID", ctx, voiceID.String()).Return(voice, nil)
+
+		svc := &voiceService{voiceRepo: mockRepo}
+
+		stability := 0.5
+		_, err := svc.Audition(ctx, voiceID.String(), request.AuditionVoiceRequest{
+			Text:          "こんにちは",
+			VoiceSettings: &request.VoiceSettingsRequest{Stability: &stability},
+		})
+
+		var appErr *apperror.AppError
+		require.ErrorAs(t, err, &appErr)
+		assert.Equal(t, apperror.ErrValidation.Code, appErr.Code)
+	})
+
+	t.Run("ボイスが無効な場合はエラーを返す", func(t *testing.T) {
+		mockRepo := new(mockVoiceRepository)
+		mockRepo.On("FindActiveByID", ctx, voiceID.String()).Return(nil, apperror.ErrNotFound)
+
+		svc := &voiceService{voiceRepo: mockRepo}
+
+		_, err := svc.Audition(ctx, voiceID.String(), request.AuditionVoiceRequest{Text: "こんにちは"})
+
+		assert.Error(t, err)
+		mockRepo.AssertExpectations(t)
+	})
+}
@@ -0,0 +1,79 @@
+package service
+
+import (
+	"archive/zip"
+	"context"
+	"crypto/sha256"
+	"encoding/hex"
+	"fmt"
+	"io"
+	"time"
+
+	"github.com/siropaca/anycast-backend/internal/infrastructure/storage"
+)
+
+// zipArchiveFile は ZIP に含めるテキストファイル（メタデータ・台本・字幕など）を表す
+type zipArchiveFile struct {
+	name string
+	data []byte
+}
+
+// zipArchiveMedia は ZIP に含めるストレージ上のメディアファイルを表す
+type zipArchiveMedia struct {
+	name string
+	path string
+}
+
+// zipArchiveContents は ZIP の内容を表す
+type zipArchiveContents struct {
+	files []zipArchiveFile
+	media []zipArchiveMedia
+}
+
+// hash は ZIP のキャッシュキーとなるハッシュを算出する
+//
+// formatVersion には ZIP の形式のバージョンを渡す（形式を変えると古いキャッシュは使われなくなる）。
+// メディアファイルのパスは Audio・Image ごとに一意のため、パスが同じなら内容も同じとみなす
+func (c *zipArchiveContents) hash(formatVersion int) string {
+	h := sha256.New()
+	fmt.Fprintf(h, "v%d\x00", formatVersion)
+	for _, f := range c.files {
+		fmt.Fprintf(h, "%s\x00%d\x00", f.name, len(f.data))
+		h.Write(f.data)
+	}
+	for _, m := range c.media {
+		fmt.Fprintf(h, "%s\x00%s\x00", m.name, m.path)
+	}
+	return hex.EncodeToString(h.Sum(nil))
+}
+
+// writeZipArchive は ZIP を w に書き込む
+//
+// メディアファイルはストレージから ZIP へ直接書き込み、ファイル全体をメモリに保持しない。
+// 音声・画像は圧縮しても小さくならないため無圧縮で格納する
+func writeZipArchive(ctx context.Context, client storage.Client, contents *zipArchiveContents, w io.Writer) error {
+	zw := zip.NewWriter(w)
+	modified := time.Now().UTC()
+
+	for _, f := range contents.files {
+		fw, err := zw.CreateHeader(&zip.FileHeader{Name: f.name, Method: zip.Deflate, Modified: modified})
+		if err != nil {
+			return err
+		}
+		if _, err := fw.Write(f.data); err != nil {
+			return err
+		}
+	}
+
+	for _, m := range contents.media {
+		fw, err := zw.CreateHeader(&zip.FileHeader{Name: m.name, Method: zip.Store, Modified: modified})
+		if err != nil {
+			return err
+		}
+		if _, err := client.DownloadStream(ctx, m.path, fw); err != nil {
+			return fmt.Errorf("download %s: %w", m.path, err)
+		}
+	}
+
+	return zw.Close()
+}
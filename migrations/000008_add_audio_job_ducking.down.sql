@@ -0,0 +1,6 @@
+ALTER TABLE audio_jobs DROP COLUMN intro_swell_ms;
+ALTER TABLE audio_jobs DROP COLUMN ducking_release_ms;
+ALTER TABLE audio_jobs DROP COLUMN ducking_attack_ms;
+ALTER TABLE audio_jobs DROP COLUMN ducking_ratio;
+ALTER TABLE audio_jobs DROP COLUMN ducking_threshold_db;
+ALTER TABLE audio_jobs DROP COLUMN bgm_ducking;
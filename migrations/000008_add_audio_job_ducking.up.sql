@@ -0,0 +1,7 @@
+-- BGM ダッキング（サイドチェインコンプレッション）設定
+ALTER TABLE audio_jobs ADD COLUMN bgm_ducking BOOLEAN NOT NULL DEFAULT false;
+ALTER TABLE audio_jobs ADD COLUMN ducking_threshold_db DECIMAL(5, 2) NOT NULL DEFAULT -30.0;
+ALTER TABLE audio_jobs ADD COLUMN ducking_ratio DECIMAL(4, 2) NOT NULL DEFAULT 8.0;
+ALTER TABLE audio_jobs ADD COLUMN ducking_attack_ms INTEGER NOT NULL DEFAULT 20;
+ALTER TABLE audio_jobs ADD COLUMN ducking_release_ms INTEGER NOT NULL DEFAULT 300;
+ALTER TABLE audio_jobs ADD COLUMN intro_swell_ms INTEGER NOT NULL DEFAULT 0;
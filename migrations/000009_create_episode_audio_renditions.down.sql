@@ -0,0 +1,2 @@
+DROP TABLE IF EXISTS episode_audio_renditions;
+DROP TYPE IF EXISTS audio_format;
@@ -0,0 +1,16 @@
+-- 配信用音声のフォーマット
+CREATE TYPE audio_format AS ENUM ('mp3', 'aac', 'opus');
+
+-- エピソードの配信用音声（フォーマット・ビットレート別）
+CREATE TABLE episode_audio_renditions (
+	id UUID PRIMARY KEY DEFAULT gen_random_uuid(),
+	episode_id UUID NOT NULL REFERENCES episodes (id) ON DELETE CASCADE,
+	audio_id UUID NOT NULL REFERENCES audios (id) ON DELETE CASCADE,
+	format audio_format NOT NULL,
+	bitrate_kbps INTEGER NOT NULL,
+	created_at TIMESTAMP NOT NULL DEFAULT CURRENT_TIMESTAMP,
+	CONSTRAINT uq_episode_audio_renditions_episode_format_bitrate UNIQUE (episode_id, format, bitrate_kbps)
+);
+
+CREATE INDEX idx_episode_audio_renditions_episode_id ON episode_audio_renditions (episode_id);
+CREATE INDEX idx_episode_audio_renditions_audio_id ON episode_audio_renditions (audio_id);
@@ -0,0 +1,3 @@
+DROP TABLE IF EXISTS episode_hls_packages;
+
+ALTER TABLE audio_jobs DROP COLUMN IF EXISTS hls_enabled;
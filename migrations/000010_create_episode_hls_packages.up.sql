@@ -0,0 +1,18 @@
+-- 音声生成ジョブに HLS 出力の有無を追加
+ALTER TABLE audio_jobs ADD COLUMN hls_enabled BOOLEAN NOT NULL DEFAULT false;
+
+-- エピソードの HLS パッケージ（セグメント化した AAC + m3u8 プレイリスト）
+CREATE TABLE episode_hls_packages (
+	id UUID PRIMARY KEY DEFAULT gen_random_uuid(),
+	episode_id UUID NOT NULL REFERENCES episodes (id) ON DELETE CASCADE,
+	source_audio_id UUID NOT NULL REFERENCES audios (id) ON DELETE CASCADE,
+	path_prefix VARCHAR(1024) NOT NULL,
+	segment_count INTEGER NOT NULL,
+	segment_duration_sec INTEGER NOT NULL,
+	bitrate_kbps INTEGER NOT NULL,
+	duration_ms INTEGER NOT NULL,
+	created_at TIMESTAMP NOT NULL DEFAULT CURRENT_TIMESTAMP,
+	CONSTRAINT uq_episode_hls_packages_episode_id UNIQUE (episode_id)
+);
+
+CREATE INDEX idx_episode_hls_packages_source_audio_id ON episode_hls_packages (source_audio_id);
@@ -0,0 +1 @@
+ALTER TABLE audios DROP COLUMN IF EXISTS waveforms;
@@ -0,0 +1,2 @@
+-- 音声の波形ピークデータ（audiowaveform JSON 形式、解像度別）
+ALTER TABLE audios ADD COLUMN waveforms JSONB;
@@ -0,0 +1,2 @@
+-- キャラクターごとの音声合成パラメータ（読み上げ速度・ピッチ・ElevenLabs のボイス設定）
+ALTER TABLE characters ADD COLUMN voice_settings JSONB NOT NULL DEFAULT '{}';
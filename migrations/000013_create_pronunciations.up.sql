@@ -0,0 +1,14 @@
+-- 発音辞書（表記 → 読み）。channel_id が NULL の場合はユーザー辞書、指定されている場合はチャンネル辞書
+CREATE TABLE pronunciations (
+	id UUID PRIMARY KEY DEFAULT gen_random_uuid(),
+	user_id UUID NOT NULL REFERENCES users (id) ON DELETE CASCADE,
+	channel_id UUID REFERENCES channels (id) ON DELETE CASCADE,
+	surface VARCHAR(100) NOT NULL,
+	reading VARCHAR(200) NOT NULL,
+	created_at TIMESTAMP NOT NULL DEFAULT CURRENT_TIMESTAMP,
+	updated_at TIMESTAMP NOT NULL DEFAULT CURRENT_TIMESTAMP
+);
+
+CREATE UNIQUE INDEX uq_pronunciations_user_surface ON pronunciations (user_id, surface) WHERE channel_id IS NULL;
+CREATE UNIQUE INDEX uq_pronunciations_channel_surface ON pronunciations (channel_id, surface) WHERE channel_id IS NOT NULL;
+CREATE INDEX idx_pronunciations_channel_id ON pronunciations (channel_id);
@@ -0,0 +1,4 @@
+DROP TABLE IF EXISTS sfx_cues;
+DROP TABLE IF EXISTS system_sound_effects;
+DROP TABLE IF EXISTS sound_effects;
+DROP TYPE IF EXISTS sfx_cue_position;
@@ -0,0 +1,48 @@
+CREATE TYPE sfx_cue_position AS ENUM ('before', 'after');
+
+-- ユーザーが所有する効果音
+CREATE TABLE sound_effects (
+	id UUID PRIMARY KEY DEFAULT gen_random_uuid(),
+	user_id UUID NOT NULL REFERENCES users (id) ON DELETE CASCADE,
+	audio_id UUID NOT NULL REFERENCES audios (id) ON DELETE RESTRICT,
+	name VARCHAR(255) NOT NULL,
+	created_at TIMESTAMP NOT NULL DEFAULT CURRENT_TIMESTAMP,
+	updated_at TIMESTAMP NOT NULL DEFAULT CURRENT_TIMESTAMP,
+	UNIQUE (user_id, name)
+);
+
+CREATE INDEX idx_sound_effects_user_id ON sound_effects (user_id);
+CREATE INDEX idx_sound_effects_audio_id ON sound_effects (audio_id);
+
+-- システム効果音（管理者が提供）
+CREATE TABLE system_sound_effects (
+	id UUID PRIMARY KEY DEFAULT gen_random_uuid(),
+	audio_id UUID NOT NULL REFERENCES audios (id) ON DELETE RESTRICT,
+	name VARCHAR(255) NOT NULL UNIQUE,
+	sort_order INTEGER NOT NULL DEFAULT 0,
+	is_active BOOLEAN NOT NULL DEFAULT true,
+	created_at TIMESTAMP NOT NULL DEFAULT CURRENT_TIMESTAMP,
+	updated_at TIMESTAMP NOT NULL DEFAULT CURRENT_TIMESTAMP
+);
+
+CREATE INDEX idx_system_sound_effects_sort_order ON system_sound_effects (sort_order);
+CREATE INDEX idx_system_sound_effects_is_active ON system_sound_effects (is_active);
+CREATE INDEX idx_system_sound_effects_audio_id ON system_sound_effects (audio_id);
+
+-- 台本行の前後に鳴らす効果音キュー
+CREATE TABLE sfx_cues (
+	id UUID PRIMARY KEY DEFAULT gen_random_uuid(),
+	script_line_id UUID NOT NULL REFERENCES script_lines (id) ON DELETE CASCADE,
+	sound_effect_id UUID REFERENCES sound_effects (id) ON DELETE RESTRICT,
+	system_sound_effect_id UUID REFERENCES system_sound_effects (id) ON DELETE RESTRICT,
+	position sfx_cue_position NOT NULL,
+	volume_db DECIMAL(5, 2) NOT NULL DEFAULT 0.0,
+	created_at TIMESTAMP NOT NULL DEFAULT CURRENT_TIMESTAMP,
+	updated_at TIMESTAMP NOT NULL DEFAULT CURRENT_TIMESTAMP,
+	-- sound_effect_id と system_sound_effect_id はどちらか一方のみ設定する
+	CONSTRAINT chk_sfx_cues_sound_effect_exclusive CHECK ((sound_effect_id IS NULL) <> (system_sound_effect_id IS NULL))
+);
+
+CREATE INDEX idx_sfx_cues_script_line_id ON sfx_cues (script_line_id);
+CREATE INDEX idx_sfx_cues_sound_effect_id ON sfx_cues (sound_effect_id);
+CREATE INDEX idx_sfx_cues_system_sound_effect_id ON sfx_cues (system_sound_effect_id);
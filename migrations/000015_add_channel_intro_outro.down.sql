@@ -0,0 +1,10 @@
+DROP INDEX IF EXISTS idx_channels_outro_audio_id;
+DROP INDEX IF EXISTS idx_channels_intro_audio_id;
+
+ALTER TABLE channels DROP CONSTRAINT IF EXISTS chk_channels_outro_crossfade_ms;
+ALTER TABLE channels DROP CONSTRAINT IF EXISTS chk_channels_intro_crossfade_ms;
+
+ALTER TABLE channels DROP COLUMN outro_crossfade_ms;
+ALTER TABLE channels DROP COLUMN outro_audio_id;
+ALTER TABLE channels DROP COLUMN intro_crossfade_ms;
+ALTER TABLE channels DROP COLUMN intro_audio_id;
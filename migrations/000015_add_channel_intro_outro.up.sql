@@ -0,0 +1,11 @@
+-- チャンネルのイントロ・アウトロ（全エピソードの前後に自動で結合する音声）
+ALTER TABLE channels ADD COLUMN intro_audio_id UUID REFERENCES audios (id) ON DELETE SET NULL;
+ALTER TABLE channels ADD COLUMN intro_crossfade_ms INTEGER NOT NULL DEFAULT 0;
+ALTER TABLE channels ADD COLUMN outro_audio_id UUID REFERENCES audios (id) ON DELETE SET NULL;
+ALTER TABLE channels ADD COLUMN outro_crossfade_ms INTEGER NOT NULL DEFAULT 0;
+
+ALTER TABLE channels ADD CONSTRAINT chk_channels_intro_crossfade_ms CHECK (intro_crossfade_ms >= 0 AND intro_crossfade_ms <= 10000);
+ALTER TABLE channels ADD CONSTRAINT chk_channels_outro_crossfade_ms CHECK (outro_crossfade_ms >= 0 AND outro_crossfade_ms <= 10000);
+
+CREATE INDEX idx_channels_intro_audio_id ON channels (intro_audio_id);
+CREATE INDEX idx_channels_outro_audio_id ON channels (outro_audio_id);
@@ -0,0 +1,7 @@
+ALTER TABLE audio_jobs DROP CONSTRAINT IF EXISTS chk_audio_jobs_qa_max_retries;
+ALTER TABLE audio_jobs DROP CONSTRAINT IF EXISTS chk_audio_jobs_qa_similarity_threshold;
+
+ALTER TABLE audio_jobs DROP COLUMN qa_report;
+ALTER TABLE audio_jobs DROP COLUMN qa_max_retries;
+ALTER TABLE audio_jobs DROP COLUMN qa_similarity_threshold;
+ALTER TABLE audio_jobs DROP COLUMN qa_enabled;
@@ -0,0 +1,8 @@
+-- 音声合成後の発音 QA（STT による台本との一致度チェックと自動再合成）
+ALTER TABLE audio_jobs ADD COLUMN qa_enabled BOOLEAN NOT NULL DEFAULT true;
+ALTER TABLE audio_jobs ADD COLUMN qa_similarity_threshold DECIMAL(3, 2) NOT NULL DEFAULT 0.6;
+ALTER TABLE audio_jobs ADD COLUMN qa_max_retries INTEGER NOT NULL DEFAULT 2;
+ALTER TABLE audio_jobs ADD COLUMN qa_report JSONB;
+
+ALTER TABLE audio_jobs ADD CONSTRAINT chk_audio_jobs_qa_similarity_threshold CHECK (qa_similarity_threshold >= 0 AND qa_similarity_threshold <= 1);
+ALTER TABLE audio_jobs ADD CONSTRAINT chk_audio_jobs_qa_max_retries CHECK (qa_max_retries >= 0 AND qa_max_retries <= 5);
@@ -0,0 +1,2 @@
+-- チャンネルの露骨な表現の有無（RSS フィードの itunes:explicit に使用）
+ALTER TABLE channels ADD COLUMN explicit BOOLEAN NOT NULL DEFAULT false;
@@ -0,0 +1,14 @@
+-- 限定公開 RSS フィードのトークン（ユーザー × チャンネル単位、失効・有効期限あり）
+CREATE TABLE private_feed_tokens (
+	id UUID PRIMARY KEY DEFAULT gen_random_uuid(),
+	user_id UUID NOT NULL REFERENCES users (id) ON DELETE CASCADE,
+	channel_id UUID NOT NULL REFERENCES channels (id) ON DELETE CASCADE,
+	token VARCHAR(64) NOT NULL UNIQUE,
+	expires_at TIMESTAMP,
+	last_used_at TIMESTAMP,
+	created_at TIMESTAMP NOT NULL DEFAULT CURRENT_TIMESTAMP,
+	updated_at TIMESTAMP NOT NULL DEFAULT CURRENT_TIMESTAMP
+);
+
+CREATE INDEX idx_private_feed_tokens_user_id ON private_feed_tokens (user_id);
+CREATE INDEX idx_private_feed_tokens_channel_id ON private_feed_tokens (channel_id);
@@ -0,0 +1,5 @@
+DROP INDEX IF EXISTS idx_episodes_channel_id_source_guid;
+ALTER TABLE episodes DROP COLUMN source_guid;
+
+DROP TABLE IF EXISTS import_jobs;
+DROP TYPE IF EXISTS import_job_status;
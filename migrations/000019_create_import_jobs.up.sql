@@ -0,0 +1,32 @@
+-- 外部 RSS / Atom フィードからのポッドキャスト取り込みジョブ
+CREATE TYPE import_job_status AS ENUM ('pending', 'processing', 'completed', 'failed');
+
+CREATE TABLE import_jobs (
+	id UUID PRIMARY KEY DEFAULT gen_random_uuid(),
+	user_id UUID NOT NULL REFERENCES users (id) ON DELETE CASCADE,
+	channel_id UUID NOT NULL REFERENCES channels (id) ON DELETE CASCADE,
+	feed_url VARCHAR(2048) NOT NULL,
+	status import_job_status NOT NULL DEFAULT 'pending',
+	progress INTEGER NOT NULL DEFAULT 0,
+	-- 結果
+	total_episodes INTEGER NOT NULL DEFAULT 0,
+	imported_episodes INTEGER NOT NULL DEFAULT 0,
+	skipped_episodes INTEGER NOT NULL DEFAULT 0,
+	failed_episodes INTEGER NOT NULL DEFAULT 0,
+	error_message TEXT,
+	error_code VARCHAR(50),
+	-- タイムスタンプ
+	started_at TIMESTAMP,
+	completed_at TIMESTAMP,
+	created_at TIMESTAMP NOT NULL DEFAULT CURRENT_TIMESTAMP,
+	updated_at TIMESTAMP NOT NULL DEFAULT CURRENT_TIMESTAMP
+);
+
+CREATE INDEX idx_import_jobs_user_id ON import_jobs (user_id);
+CREATE INDEX idx_import_jobs_channel_id ON import_jobs (channel_id);
+CREATE INDEX idx_import_jobs_created_at ON import_jobs (created_at DESC);
+
+-- 取り込み元フィードの item の GUID（再取り込み時の重複防止）
+ALTER TABLE episodes ADD COLUMN source_guid VARCHAR(512);
+
+CREATE UNIQUE INDEX idx_episodes_channel_id_source_guid ON episodes (channel_id, source_guid) WHERE source_guid IS NOT NULL;
@@ -0,0 +1,7 @@
+DROP TABLE IF EXISTS clip_jobs;
+DROP TYPE IF EXISTS clip_aspect_ratio;
+DROP TYPE IF EXISTS clip_job_status;
+
+DROP TABLE IF EXISTS videos;
+
+ALTER TABLE audios DROP COLUMN line_timings;
@@ -0,0 +1,50 @@
+-- 音声内での台本行の再生区間（行ごとに合成した場合のみ）
+ALTER TABLE audios ADD COLUMN line_timings JSONB;
+
+-- 動画ファイル
+CREATE TABLE videos (
+	id UUID PRIMARY KEY DEFAULT gen_random_uuid(),
+	mime_type VARCHAR(100) NOT NULL,
+	path VARCHAR(1024) NOT NULL,
+	filename VARCHAR(255) NOT NULL,
+	file_size INTEGER NOT NULL,
+	duration_ms INTEGER NOT NULL,
+	width INTEGER NOT NULL,
+	height INTEGER NOT NULL,
+	created_at TIMESTAMP NOT NULL DEFAULT CURRENT_TIMESTAMP
+);
+
+-- エピソードのクリップ動画（オーディオグラム）生成ジョブ
+CREATE TYPE clip_job_status AS ENUM ('pending', 'processing', 'completed', 'failed');
+CREATE TYPE clip_aspect_ratio AS ENUM ('square', 'portrait', 'landscape');
+
+CREATE TABLE clip_jobs (
+	id UUID PRIMARY KEY DEFAULT gen_random_uuid(),
+	user_id UUID NOT NULL REFERENCES users (id) ON DELETE CASCADE,
+	episode_id UUID NOT NULL REFERENCES episodes (id) ON DELETE CASCADE,
+	audio_id UUID REFERENCES audios (id) ON DELETE SET NULL,
+	-- 切り出す範囲
+	start_ms INTEGER NOT NULL,
+	end_ms INTEGER NOT NULL,
+	start_script_line_id UUID REFERENCES script_lines (id) ON DELETE SET NULL,
+	end_script_line_id UUID REFERENCES script_lines (id) ON DELETE SET NULL,
+	-- 動画の設定
+	aspect_ratio clip_aspect_ratio NOT NULL DEFAULT 'square',
+	captions BOOLEAN NOT NULL DEFAULT true,
+	status clip_job_status NOT NULL DEFAULT 'pending',
+	progress INTEGER NOT NULL DEFAULT 0,
+	-- 結果
+	result_video_id UUID REFERENCES videos (id) ON DELETE SET NULL,
+	error_message TEXT,
+	error_code VARCHAR(50),
+	-- タイムスタンプ
+	started_at TIMESTAMP,
+	completed_at TIMESTAMP,
+	created_at TIMESTAMP NOT NULL DEFAULT CURRENT_TIMESTAMP,
+	updated_at TIMESTAMP NOT NULL DEFAULT CURRENT_TIMESTAMP,
+	CONSTRAINT chk_clip_jobs_range CHECK (start_ms >= 0 AND end_ms > start_ms)
+);
+
+CREATE INDEX idx_clip_jobs_user_id ON clip_jobs (user_id);
+CREATE INDEX idx_clip_jobs_episode_id ON clip_jobs (episode_id);
+CREATE INDEX idx_clip_jobs_created_at ON clip_jobs (created_at DESC);
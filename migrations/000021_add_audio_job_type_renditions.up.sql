@@ -0,0 +1,2 @@
+-- アップロードした音声から配信用音声を生成し直すジョブ
+ALTER TYPE audio_job_type ADD VALUE IF NOT EXISTS 'renditions';
@@ -0,0 +1,11 @@
+-- enum から値を削除できないため、型を作り直す
+DELETE FROM audio_jobs WHERE job_type = 'retag';
+
+ALTER TYPE audio_job_type RENAME TO audio_job_type_old;
+CREATE TYPE audio_job_type AS ENUM ('voice', 'full', 'remix', 'renditions');
+
+ALTER TABLE audio_jobs ALTER COLUMN job_type DROP DEFAULT;
+ALTER TABLE audio_jobs ALTER COLUMN job_type TYPE audio_job_type USING job_type::text::audio_job_type;
+ALTER TABLE audio_jobs ALTER COLUMN job_type SET DEFAULT 'voice';
+
+DROP TYPE audio_job_type_old;
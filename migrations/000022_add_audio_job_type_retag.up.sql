@@ -0,0 +1,2 @@
+-- エピソード情報の変更を既存の MP3 の ID3 タグに反映するジョブ
+ALTER TYPE audio_job_type ADD VALUE IF NOT EXISTS 'retag';
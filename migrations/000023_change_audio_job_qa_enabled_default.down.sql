@@ -0,0 +1 @@
+ALTER TABLE audio_jobs ALTER COLUMN qa_enabled SET DEFAULT true;
@@ -0,0 +1,2 @@
+-- 発音 QA はコストがかかるため、明示的に有効にした場合のみ実行する
+ALTER TABLE audio_jobs ALTER COLUMN qa_enabled SET DEFAULT false;
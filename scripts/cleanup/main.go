@@ -10,6 +10,7 @@ import (
 	"github.com/joho/godotenv"
 
 	"github.com/siropaca/anycast-backend/internal/config"
+	"github.com/siropaca/anycast-backend/internal/di"
 	"github.com/siropaca/anycast-backend/internal/infrastructure/storage"
 	"github.com/siropaca/anycast-backend/internal/pkg/db"
 	"github.com/siropaca/anycast-backend/internal/repository"
@@ -38,21 +39,21 @@ func main() {
 		os.Exit(1)
 	}
 
-	// GCS クライアント
+	// ストレージクライアント（STORAGE_BACKEND で選択）
 	var storageClient storage.Client
-	if cfg.GoogleCloudStorageBucketName != "" && cfg.GoogleCloudCredentialsJSON != "" {
-		storageClient, err = storage.NewGCSClient(ctx, cfg.GoogleCloudStorageBucketName, cfg.GoogleCloudCredentialsJSON)
-		if err != nil {
-			fmt.Fprintf(os.Stderr, "Warning: failed to create storage client: %v\n", err)
-		}
+	if sc, err := di.NewStorageClient(ctx, cfg, cfg.StorageBackend); err != nil {
+		fmt.Fprintf(os.Stderr, "Warning: failed to create storage client: %v\n", err)
+	} else {
+		storageClient = sc
 	}
 
 	// Repository
 	audioRepo := repository.NewAudioRepository(dbConn)
 	imageRepo := repository.NewImageRepository(dbConn)
+	videoRepo := repository.NewVideoRepository(dbConn)
 
 	// Service
-	cleanupService := service.NewCleanupService(audioRepo, imageRepo, storageClient)
+	cleanupService := service.NewCleanupService(audioRepo, imageRepo, videoRepo, storageClient)
 
 	// 実行
 	fmt.Printf("Cleaning up orphaned media files (dry-run: %v)\n\n", *dryRun)
@@ -74,11 +75,18 @@ func main() {
 		fmt.Printf("  - %s (%s, %d bytes)\n", image.ID, image.Filename, image.FileSize)
 	}
 
+	fmt.Printf("\nOrphaned videos: %d\n", len(result.OrphanedVideos))
+	for _, video := range result.OrphanedVideos {
+		fmt.Printf("  - %s (%s, %d bytes)\n", video.ID, video.Filename, video.FileSize)
+	}
+
 	if !*dryRun {
 		fmt.Printf("\nDeleted audios: %d\n", result.DeletedAudioCount)
 		fmt.Printf("Deleted images: %d\n", result.DeletedImageCount)
+		fmt.Printf("Deleted videos: %d\n", result.DeletedVideoCount)
 		fmt.Printf("Failed audios:  %d\n", result.FailedAudioCount)
 		fmt.Printf("Failed images:  %d\n", result.FailedImageCount)
+		fmt.Printf("Failed videos:  %d\n", result.FailedVideoCount)
 	} else {
 		fmt.Println("\n(dry-run mode: no files were deleted)")
 		fmt.Println("Run with --dry-run=false to actually delete files")
@@ -0,0 +1,153 @@
+// メディアファイルをストレージバックエンド間でコピーするスクリプト
+package main
+
+import (
+	"bufio"
+	"context"
+	"flag"
+	"fmt"
+	"os"
+	"strings"
+
+	"github.com/joho/godotenv"
+
+	"github.com/siropaca/anycast-backend/internal/config"
+	"github.com/siropaca/anycast-backend/internal/di"
+	"github.com/siropaca/anycast-backend/internal/pkg/db"
+	"github.com/siropaca/anycast-backend/internal/repository"
+	"github.com/siropaca/anycast-backend/internal/service"
+)
+
+func main() {
+	from := flag.String("from", "", "移行元のストレージバックエンド（gcs / s3 / local）")
+	to := flag.String("to", "", "移行先のストレージバックエンド（gcs / s3 / local）")
+	dryRun := flag.Bool("dry-run", true, "移行対象の一覧を表示するのみで実際のコピーは行わない（デフォルト: true）")
+	overwrite := flag.Bool("overwrite", false, "移行先に既に存在するファイルも上書きする")
+	pathsFile := flag.String("paths-file", "", "DB で管理していない追加の移行対象パス（1 行 1 パス、効果音ライブラリなど）")
+	flag.Parse()
+
+	if *from == "" || *to == "" {
+		fmt.Fprintln(os.Stderr, "Error: --from and --to are required")
+		os.Exit(1)
+	}
+	if *from == *to {
+		fmt.Fprintln(os.Stderr, "Error: --from and --to must be different")
+		os.Exit(1)
+	}
+
+	_ = godotenv.Load() //nolint:errcheck // .env ファイルがなくてもエラーにしない
+
+	cfg := config.Load()
+
+	if cfg.DatabaseURL == "" {
+		fmt.Fprintln(os.Stderr, "Error: DATABASE_URL is not set")
+		os.Exit(1)
+	}
+
+	var extraPaths []string
+	if *pathsFile != "" {
+		paths, err := readPathsFile(*pathsFile)
+		if err != nil {
+			fmt.Fprintf(os.Stderr, "Error: failed to read paths file: %v\n", err)
+			os.Exit(1)
+		}
+		extraPaths = paths
+	}
+
+	ctx := context.Background()
+
+	// DB 接続
+	dbConn, err := db.New(cfg.DatabaseURL, cfg.DBLogLevel)
+	if err != nil {
+		fmt.Fprintf(os.Stderr, "Error: failed to connect to database: %v\n", err)
+		os.Exit(1)
+	}
+
+	// ストレージクライアント
+	src, err := di.NewStorageClient(ctx, cfg, config.StorageBackend(*from))
+	if err != nil {
+		fmt.Fprintf(os.Stderr, "Error: failed to create source storage client: %v\n", err)
+		os.Exit(1)
+	}
+
+	dst, err := di.NewStorageClient(ctx, cfg, config.StorageBackend(*to))
+	if err != nil {
+		fmt.Fprintf(os.Stderr, "Error: failed to create destination storage client: %v\n", err)
+		os.Exit(1)
+	}
+
+	// Repository
+	audioRepo := repository.NewAudioRepository(dbConn)
+	imageRepo := repository.NewImageRepository(dbConn)
+	videoRepo := repository.NewVideoRepository(dbConn)
+	hlsPackageRepo := repository.NewEpisodeHLSPackageRepository(dbConn)
+
+	// Service
+	migrationService := service.NewStorageMigrationService(audioRepo, imageRepo, videoRepo, hlsPackageRepo)
+
+	// 実行
+	fmt.Printf("Migrating media files from %s to %s (dry-run: %v, overwrite: %v)\n\n", *from, *to, *dryRun, *overwrite)
+
+	result, err := migrationService.Migrate(ctx, src, dst, service.StorageMigrationOptions{
+		DryRun:     *dryRun,
+		Overwrite:  *overwrite,
+		ExtraPaths: extraPaths,
+	})
+	if err != nil {
+		fmt.Fprintf(os.Stderr, "Error: failed to migrate storage: %v\n", err)
+		os.Exit(1)
+	}
+
+	// 結果を表示
+	fmt.Printf("Objects: %d\n", len(result.Objects))
+
+	if *dryRun {
+		for _, obj := range result.Objects {
+			fmt.Printf("  - %s\n", obj.Path)
+		}
+		fmt.Println("\n(dry-run mode: no files were copied)")
+		fmt.Println("Run with --dry-run=false to actually copy files")
+		return
+	}
+
+	fmt.Printf("Copied:  %d\n", result.CopiedCount)
+	fmt.Printf("Skipped: %d (already exists in destination)\n", result.SkippedCount)
+
+	fmt.Printf("Missing: %d (not found in source)\n", len(result.MissingPaths))
+	for _, p := range result.MissingPaths {
+		fmt.Printf("  - %s\n", p)
+	}
+
+	fmt.Printf("Failed:  %d\n", len(result.FailedPaths))
+	for _, p := range result.FailedPaths {
+		fmt.Printf("  - %s\n", p)
+	}
+
+	if len(result.FailedPaths) > 0 {
+		os.Exit(1)
+	}
+}
+
+// readPathsFile は 1 行 1 パスのファイルを読み込む（空行と # で始まる行は無視する）
+func readPathsFile(name string) ([]string, error) {
+	f, err := os.Open(name)
+	if err != nil {
+		return nil, err
+	}
+	defer f.Close()
+
+	var paths []string
+	scanner := bufio.NewScanner(f)
+	for scanner.Scan() {
+		line := strings.TrimSpace(scanner.Text())
+		if line == "" || strings.HasPrefix(line, "#") {
+			continue
+		}
+		paths = append(paths, line)
+	}
+	if err := scanner.Err(); err != nil {
+		return nil, err
+	}
+
+	return paths, nil
+}